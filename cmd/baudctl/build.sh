@@ -0,0 +1,3 @@
+#!/bin/sh
+
+go build -o baudctl
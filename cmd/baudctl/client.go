@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc"
+	"gopkg.in/urfave/cli.v2"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+)
+
+// httpReply is the reply of the http apis of the masters.
+type httpReply struct {
+	Code int32           `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"`
+}
+
+// apiClient calls the http apis of a global or a zone master, the parameters go in the query.
+type apiClient struct {
+	name   string
+	addr   string
+	client *http.Client
+}
+
+func gmClient(ctx *cli.Context) *apiClient {
+	return &apiClient{name: flagGM, addr: ctx.String(flagGM), client: &http.Client{Timeout: ctx.Duration(flagTimeout)}}
+}
+
+func zmClient(ctx *cli.Context) *apiClient {
+	return &apiClient{name: flagZM, addr: ctx.String(flagZM), client: &http.Client{Timeout: ctx.Duration(flagTimeout)}}
+}
+
+// call sends the request, and decodes the data of the reply into data unless it is nil. It returns
+// the data as it came, which the json output prints.
+func (c *apiClient) call(method, uri string, params url.Values, data interface{}) (json.RawMessage, error) {
+	if c.addr == "" {
+		return nil, fmt.Errorf("missing the address of the master, set --%s", c.name)
+	}
+
+	u := url.URL{Scheme: "http", Host: c.addr, Path: uri, RawQuery: params.Encode()}
+	req, err := http.NewRequest(method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s %s: %s", method, uri, resp.Status)
+	}
+	reply := new(httpReply)
+	if err := json.Unmarshal(body, reply); err != nil {
+		return nil, fmt.Errorf("%s %s: invalid reply[%s]", method, uri, body)
+	}
+	if reply.Code != 0 {
+		return nil, fmt.Errorf("%s %s: %s(code %d)", method, uri, reply.Msg, reply.Code)
+	}
+	if data != nil && len(reply.Data) != 0 {
+		if err := json.Unmarshal(reply.Data, data); err != nil {
+			return nil, fmt.Errorf("%s %s: %v", method, uri, err)
+		}
+	}
+	return reply.Data, nil
+}
+
+func (c *apiClient) get(uri string, params url.Values, data interface{}) (json.RawMessage, error) {
+	return c.call(http.MethodGet, uri, params, data)
+}
+
+// masterRpc dials the grpc api of the zone master, the connection is closed by the returned func.
+func masterRpc(ctx *cli.Context) (masterpb.MasterRpcClient, func(), error) {
+	addr := ctx.String(flagZMRpc)
+	if addr == "" {
+		return nil, nil, errors.New("missing the grpc address of the zone master, set --" + flagZMRpc)
+	}
+
+	dialCtx, cancel := context.WithTimeout(context.Background(), ctx.Duration(flagTimeout))
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, addr, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial %s: %v", addr, err)
+	}
+	return masterpb.NewMasterRpcClient(conn), func() { conn.Close() }, nil
+}
+
+// rpcContext bounds a grpc call by the timeout flag.
+func rpcContext(ctx *cli.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), ctx.Duration(flagTimeout))
+}
+
+// watch runs fn every interval until it fails or the process is interrupted, the screen is cleared
+// before each run on the table output.
+func watch(ctx *cli.Context, interval time.Duration, fn func() error) error {
+	for {
+		if ctx.String(flagOutput) != outputJson {
+			fmt.Fprint(stdout, "\033[H\033[2J")
+			fmt.Fprintf(stdout, "every %s, %s\n\n", interval, time.Now().Format(time.RFC3339))
+		}
+		if err := fn(); err != nil {
+			return err
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/urfave/cli.v2"
+)
+
+var clusterCommand = &cli.Command{
+	Name:  "cluster",
+	Usage: "show the state of the zone",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "status",
+			Usage: "sum up the heartbeats of the partition servers and the partitions",
+			Flags: append([]cli.Flag{
+				&cli.DurationFlag{Name: "stale", Value: 30 * time.Second, Usage: "age a heartbeat is stale at"},
+			}, watchFlags...),
+			Action: clusterStatus,
+		},
+		{
+			Name:   "leaders",
+			Usage:  "show how the leaders spread over the partition servers",
+			Flags:  watchFlags,
+			Action: clusterLeaders,
+		},
+	},
+}
+
+// clusterSummary is the status of the zone summed up from the heartbeats.
+type clusterSummary struct {
+	Servers        int            `json:"servers"`
+	ServerStatus   map[string]int `json:"server_status"`
+	StaleServers   int            `json:"stale_servers"`
+	DiskStates     map[string]int `json:"disk_states"`
+	Draining       int            `json:"draining"`
+	InMaintenance  int            `json:"in_maintenance"`
+	DiskTotal      uint64         `json:"disk_total"`
+	DiskUsed       uint64         `json:"disk_used"`
+	Partitions     int            `json:"partitions"`
+	NoLeader       int            `json:"no_leader"`
+	StalePartition int            `json:"stale_partitions"`
+	Unhealthy      int            `json:"unhealthy"`
+	StorageBytes   uint64         `json:"storage_bytes"`
+	DocCount       uint64         `json:"doc_count"`
+	MinLeaders     int            `json:"min_leaders"`
+	MaxLeaders     int            `json:"max_leaders"`
+}
+
+func summarize(states []*psState, partitions []*zmPartition, stale time.Duration) *clusterSummary {
+	summary := &clusterSummary{
+		Servers:      len(states),
+		ServerStatus: make(map[string]int),
+		DiskStates:   make(map[string]int),
+		Partitions:   len(partitions),
+		MinLeaders:   -1,
+	}
+	for _, ps := range states {
+		summary.ServerStatus[ps.Status]++
+		summary.DiskStates[ps.DiskState]++
+		if time.Since(ps.LastHeartbeat) > stale {
+			summary.StaleServers++
+		}
+		switch ps.mode() {
+		case "draining":
+			summary.Draining++
+		case "maintenance":
+			summary.InMaintenance++
+		}
+		if ps.SysStats != nil {
+			summary.DiskTotal += ps.SysStats.DiskTotal
+			summary.DiskUsed += ps.SysStats.DiskUsed
+		}
+		if summary.MinLeaders < 0 || ps.Leaders < summary.MinLeaders {
+			summary.MinLeaders = ps.Leaders
+		}
+		if ps.Leaders > summary.MaxLeaders {
+			summary.MaxLeaders = ps.Leaders
+		}
+	}
+	if summary.MinLeaders < 0 {
+		summary.MinLeaders = 0
+	}
+
+	for _, p := range partitions {
+		if p.Leader == nil {
+			summary.NoLeader++
+		}
+		if p.LastHeartbeat.IsZero() || time.Since(p.LastHeartbeat) > stale {
+			summary.StalePartition++
+		}
+		if len(partitionProblems(p, stale)) != 0 {
+			summary.Unhealthy++
+		}
+		summary.StorageBytes += p.Statistics.Size_
+		summary.DocCount += p.Statistics.DocCount
+	}
+	return summary
+}
+
+func formatCounts(counts map[string]int) string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]string, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, fmt.Sprintf("%s=%d", key, counts[key]))
+	}
+	return strings.Join(items, " ")
+}
+
+func clusterStatus(ctx *cli.Context) error {
+	show := func() error {
+		states, _, err := getPSStates(ctx)
+		if err != nil {
+			return err
+		}
+		var partitions []*zmPartition
+		if _, err := zmClient(ctx).get("/manage/partition/list", nil, &partitions); err != nil {
+			return err
+		}
+
+		summary := summarize(states, partitions, ctx.Duration("stale"))
+		if ctx.String(flagOutput) == outputJson {
+			return printJson(summary)
+		}
+		return render(ctx, nil, func() *table {
+			t := newTable("ITEM", "VALUE")
+			t.add("servers", fmt.Sprintf("%d (%s)", summary.Servers, formatCounts(summary.ServerStatus)))
+			t.add("stale heartbeats", summary.StaleServers)
+			t.add("disk states", formatCounts(summary.DiskStates))
+			t.add("draining", summary.Draining)
+			t.add("in maintenance", summary.InMaintenance)
+			t.add("disk", fmt.Sprintf("%s / %s (%s)", formatBytes(summary.DiskUsed), formatBytes(summary.DiskTotal),
+				percent(summary.DiskUsed, summary.DiskTotal)))
+			t.add("partitions", summary.Partitions)
+			t.add("without leader", summary.NoLeader)
+			t.add("stale partitions", summary.StalePartition)
+			t.add("unhealthy", summary.Unhealthy)
+			t.add("data", fmt.Sprintf("%s, %d docs", formatBytes(summary.StorageBytes), summary.DocCount))
+			t.add("leaders per server", fmt.Sprintf("%d - %d", summary.MinLeaders, summary.MaxLeaders))
+			return t
+		})
+	}
+
+	if !ctx.Bool("watch") {
+		return show()
+	}
+	return watch(ctx, ctx.Duration("interval"), show)
+}
+
+func clusterLeaders(ctx *cli.Context) error {
+	show := func() error {
+		states, reply, err := getPSStates(ctx)
+		if err != nil {
+			return err
+		}
+		return render(ctx, reply, func() *table {
+			var total, max int
+			for _, ps := range states {
+				total += ps.Leaders
+				if ps.Leaders > max {
+					max = ps.Leaders
+				}
+			}
+			t := newTable("ID", "IP", "REPLICAS", "LEADERS", "SHARE", "")
+			for _, ps := range states {
+				bar := ""
+				if max != 0 {
+					bar = strings.Repeat("#", ps.Leaders*40/max)
+				}
+				t.add(ps.ID, ps.Ip, ps.Replicas, ps.Leaders, percent(uint64(ps.Leaders), uint64(total)), bar)
+			}
+			return t
+		})
+	}
+
+	if !ctx.Bool("watch") {
+		return show()
+	}
+	return watch(ctx, ctx.Duration("interval"), show)
+}
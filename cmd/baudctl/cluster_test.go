@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+func TestClusterStatus(t *testing.T) {
+	now := time.Now()
+	states := []*psState{
+		{ID: 1, Status: "registered", LastHeartbeat: now, DiskState: "DiskNormal", Leaders: 2,
+			SysStats: &masterpb.NodeSysStats{DiskTotal: 100, DiskUsed: 40}},
+		{ID: 2, Status: "registered", LastHeartbeat: now, DiskState: "DiskReadOnly", Draining: true,
+			SysStats: &masterpb.NodeSysStats{DiskTotal: 100, DiskUsed: 90}},
+		// restarting in maintenance, it sent no heartbeat for a while
+		{ID: 3, Status: "maintenance", LastHeartbeat: now.Add(-time.Minute), DiskState: "DiskNormal", Leaders: 1},
+	}
+	leader := &metapb.Replica{ID: 1, NodeID: 1}
+	partitions := []*zmPartition{
+		{Partition: metapb.Partition{ID: 1}, Leader: leader, LastHeartbeat: now,
+			Statistics: masterpb.PartitionStats{Size_: 1000, DocCount: 10}},
+		{Partition: metapb.Partition{ID: 2}, Leader: leader, LastHeartbeat: now,
+			Consistency: &consistencyResult{Divergent: []metapb.ReplicaID{2}},
+			Statistics:  masterpb.PartitionStats{Size_: 500, DocCount: 5}},
+		{Partition: metapb.Partition{ID: 3}},
+	}
+	statesData, _ := json.Marshal(states)
+	partitionsData, _ := json.Marshal(partitions)
+	zm := newFakeMaster(map[string]string{
+		"/manage/ps/status":      string(statesData),
+		"/manage/partition/list": string(partitionsData),
+	})
+	defer zm.Close()
+
+	out, err := runCtl("--zm", zm.addr(), "-o", "json", "cluster", "status", "--stale", "30s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	summary := new(clusterSummary)
+	if err := json.Unmarshal([]byte(out), summary); err != nil {
+		t.Fatalf("invalid json output %q: %v", out, err)
+	}
+	expect := &clusterSummary{
+		Servers:        3,
+		ServerStatus:   map[string]int{"registered": 2, "maintenance": 1},
+		StaleServers:   1,
+		DiskStates:     map[string]int{"DiskNormal": 2, "DiskReadOnly": 1},
+		Draining:       1,
+		InMaintenance:  1,
+		DiskTotal:      200,
+		DiskUsed:       130,
+		Partitions:     3,
+		NoLeader:       1,
+		StalePartition: 1,
+		Unhealthy:      2,
+		StorageBytes:   1500,
+		DocCount:       15,
+		MinLeaders:     0,
+		MaxLeaders:     2,
+	}
+	if !reflect.DeepEqual(summary, expect) {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+
+	out, err = runCtl("--zm", zm.addr(), "cluster", "status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"servers             3 (maintenance=1 registered=2)",
+		"disk                130B / 200B (65.0%)",
+		"unhealthy           2",
+		"leaders per server  0 - 2",
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Fatalf("line %q missing in the table:\n%s", line, out)
+		}
+	}
+
+	// the status needs both the servers and the partitions of the zone
+	delete(zm.replies, "/manage/partition/list")
+	if _, err := runCtl("--zm", zm.addr(), "cluster", "status"); err == nil {
+		t.Fatal("status without the partitions succeeded")
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	"gopkg.in/urfave/cli.v2"
+)
+
+// the scripts ask baudctl for the candidates through the completion flag of the cli
+const (
+	bashCompletion = `_baudctl_complete() {
+    local cur opts
+    COMPREPLY=()
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    opts=$( "${COMP_WORDS[@]:0:$COMP_CWORD}" --generate-completion 2>/dev/null )
+    COMPREPLY=( $(compgen -W "${opts}" -- "${cur}") )
+    return 0
+}
+complete -o default -F _baudctl_complete baudctl
+`
+	zshCompletion = `#compdef baudctl
+_baudctl() {
+    local -a opts
+    opts=("${(@f)$(${words[@]:0:$#words[@]-1} --generate-completion 2>/dev/null)}")
+    _describe 'values' opts
+}
+compdef _baudctl baudctl
+`
+)
+
+var completionCommand = &cli.Command{
+	Name:        "completion",
+	Usage:       "print the shell completion script, bash or zsh",
+	Description: "Load it with: source <(baudctl completion bash)",
+	Action: func(ctx *cli.Context) error {
+		switch shell := ctx.Args().First(); shell {
+		case "", "bash":
+			fmt.Fprint(stdout, bashCompletion)
+		case "zsh":
+			fmt.Fprint(stdout, zshCompletion)
+		default:
+			return fmt.Errorf("unknown shell[%s], bash or zsh", shell)
+		}
+		return nil
+	},
+}
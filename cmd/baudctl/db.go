@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"gopkg.in/urfave/cli.v2"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+var (
+	dbFlag = &cli.StringFlag{Name: "db", Usage: "db name"}
+	// the quota flags take 0 for unlimited
+	quotaFlags = []cli.Flag{
+		&cli.Uint64Flag{Name: "storage-bytes", Usage: "quota of the storage in bytes"},
+		&cli.Uint64Flag{Name: "doc-count", Usage: "quota of the documents"},
+		&cli.UintFlag{Name: "write-qps", Usage: "quota of the writes per second on each router"},
+	}
+
+	dbCommand = &cli.Command{
+		Name:  "db",
+		Usage: "manage the dbs",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "list the dbs",
+				Action: dbList,
+			},
+			{
+				Name:   "detail",
+				Usage:  "show a db",
+				Flags:  []cli.Flag{dbFlag},
+				Action: dbDetail,
+			},
+			{
+				Name:   "create",
+				Usage:  "create a db",
+				Flags:  []cli.Flag{dbFlag},
+				Action: dbCreate,
+			},
+			{
+				Name:   "delete",
+				Usage:  "delete a db",
+				Flags:  []cli.Flag{dbFlag},
+				Action: dbDelete,
+			},
+			{
+				Name:  "rename",
+				Usage: "rename a db",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "from", Usage: "current db name"},
+					&cli.StringFlag{Name: "to", Usage: "new db name"},
+				},
+				Action: dbRename,
+			},
+			{
+				Name:   "quota",
+				Usage:  "set the quota of a db",
+				Flags:  append([]cli.Flag{dbFlag}, quotaFlags...),
+				Action: dbQuota,
+			},
+			{
+				Name:   "usage",
+				Usage:  "show the usage of a db and its spaces against their quotas",
+				Flags:  []cli.Flag{dbFlag},
+				Action: dbUsage,
+			},
+		},
+	}
+)
+
+// requireFlags fails unless all the flags are set.
+func requireFlags(ctx *cli.Context, names ...string) error {
+	for _, name := range names {
+		if !ctx.IsSet(name) {
+			return fmt.Errorf("missing --%s", name)
+		}
+	}
+	return nil
+}
+
+func quotaParams(ctx *cli.Context, params url.Values) error {
+	if !ctx.IsSet("storage-bytes") && !ctx.IsSet("doc-count") && !ctx.IsSet("write-qps") {
+		return fmt.Errorf("missing --storage-bytes, --doc-count or --write-qps")
+	}
+	params.Set("storage_bytes", strconv.FormatUint(ctx.Uint64("storage-bytes"), 10))
+	params.Set("doc_count", strconv.FormatUint(ctx.Uint64("doc-count"), 10))
+	params.Set("write_qps", strconv.FormatUint(uint64(ctx.Uint("write-qps")), 10))
+	return nil
+}
+
+func formatQuota(quota *metapb.Quota) string {
+	if quota == nil || (quota.StorageBytes == 0 && quota.DocCount == 0 && quota.WriteQps == 0) {
+		return "-"
+	}
+	return fmt.Sprintf("storage=%s docs=%d qps=%d", formatBytes(quota.StorageBytes), quota.DocCount, quota.WriteQps)
+}
+
+func dbList(ctx *cli.Context) error {
+	var dbs []*metapb.DB
+	reply, err := gmClient(ctx).get("/manage/db/list", nil, &dbs)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table {
+		t := newTable("ID", "NAME", "QUOTA")
+		for _, db := range dbs {
+			t.add(db.ID, db.Name, formatQuota(db.Quota))
+		}
+		return t
+	})
+}
+
+func dbDetail(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "db"); err != nil {
+		return err
+	}
+	db := new(metapb.DB)
+	reply, err := gmClient(ctx).get("/manage/db/detail", url.Values{"db_name": {ctx.String("db")}}, db)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table {
+		t := newTable("ID", "NAME", "QUOTA")
+		t.add(db.ID, db.Name, formatQuota(db.Quota))
+		return t
+	})
+}
+
+func dbCreate(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "db"); err != nil {
+		return err
+	}
+	db := new(metapb.DB)
+	reply, err := gmClient(ctx).call(http.MethodPost, "/manage/db/create", url.Values{"db_name": {ctx.String("db")}}, db)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table {
+		t := newTable("ID", "NAME")
+		t.add(db.ID, db.Name)
+		return t
+	})
+}
+
+func dbDelete(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "db"); err != nil {
+		return err
+	}
+	if _, err := gmClient(ctx).call(http.MethodDelete, "/manage/db/delete", url.Values{"db_name": {ctx.String("db")}}, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "db[%s] deleted", ctx.String("db"))
+}
+
+func dbRename(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "from", "to"); err != nil {
+		return err
+	}
+	params := url.Values{"src_db_name": {ctx.String("from")}, "dest_db_name": {ctx.String("to")}}
+	if _, err := gmClient(ctx).call(http.MethodPut, "/manage/db/rename", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "db[%s] renamed to [%s]", ctx.String("from"), ctx.String("to"))
+}
+
+func dbQuota(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "db"); err != nil {
+		return err
+	}
+	params := url.Values{"db_name": {ctx.String("db")}}
+	if err := quotaParams(ctx, params); err != nil {
+		return err
+	}
+	if _, err := gmClient(ctx).call(http.MethodPut, "/manage/db/quota", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "quota of db[%s] updated", ctx.String("db"))
+}
+
+// quotaUsage mirrors the usage the global master sums up from the heartbeats of the leaders.
+type quotaUsage struct {
+	StorageBytes uint64        `json:"storage_bytes"`
+	DocCount     uint64        `json:"doc_count"`
+	Partitions   int           `json:"partitions"`
+	Exceeded     bool          `json:"exceeded"`
+	Quota        *metapb.Quota `json:"quota"`
+}
+
+type dbUsageReply struct {
+	quotaUsage
+	Spaces    map[string]*quotaUsage `json:"spaces"`
+	CheckTime time.Time              `json:"check_time"`
+}
+
+func dbUsage(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "db"); err != nil {
+		return err
+	}
+	usage := new(dbUsageReply)
+	reply, err := gmClient(ctx).get("/manage/db/usage", url.Values{"db_name": {ctx.String("db")}}, usage)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table {
+		t := newTable("NAME", "STORAGE", "DOCS", "PARTITIONS", "EXCEEDED", "QUOTA")
+		t.add(ctx.String("db"), formatBytes(usage.StorageBytes), usage.DocCount, usage.Partitions, usage.Exceeded,
+			formatQuota(usage.Quota))
+		names := make([]string, 0, len(usage.Spaces))
+		for name := range usage.Spaces {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			space := usage.Spaces[name]
+			t.add("  "+name, formatBytes(space.StorageBytes), space.DocCount, space.Partitions, space.Exceeded,
+				formatQuota(space.Quota))
+		}
+		return t
+	})
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/urfave/cli.v2"
+
+	"github.com/tiglabs/baudengine/util/server"
+)
+
+const (
+	flagGM      = "gm"
+	flagZM      = "zm"
+	flagZMRpc   = "zm-rpc"
+	flagOutput  = "output"
+	flagTimeout = "timeout"
+
+	outputTable = "table"
+	outputJson  = "json"
+)
+
+var (
+	app = &cli.App{
+		Name:                  "baudctl",
+		Usage:                 "baudctl [global options] command [command options]",
+		Description:           "Baud engine admin console, it manages the cluster through the apis of the global and the zone masters.",
+		EnableShellCompletion: true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    flagGM,
+				Value:   "127.0.0.1:8817",
+				EnvVars: []string{"BAUD_GM"},
+				Usage:   "http address of the global master",
+			},
+			&cli.StringFlag{
+				Name:    flagZM,
+				EnvVars: []string{"BAUD_ZM"},
+				Usage:   "http address of the zone master",
+			},
+			&cli.StringFlag{
+				Name:    flagZMRpc,
+				EnvVars: []string{"BAUD_ZM_RPC"},
+				Usage:   "grpc address of the zone master",
+			},
+			&cli.StringFlag{
+				Name:    flagOutput,
+				Aliases: []string{"o"},
+				Value:   outputTable,
+				Usage:   "output format, table or json",
+			},
+			&cli.DurationFlag{
+				Name:  flagTimeout,
+				Value: 10 * time.Second,
+				Usage: "timeout of each request",
+			},
+		},
+	}
+)
+
+func init() {
+	app.Commands = append(app.Commands,
+		dbCommand,
+		spaceCommand,
+		zoneCommand,
+		partitionCommand,
+		replicaCommand,
+		psCommand,
+		routeCommand,
+		clusterCommand,
+		completionCommand,
+		server.VersionCommand(),
+	)
+}
+
+func main() {
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintf(os.Stderr, "baudctl: %s\n", err)
+		os.Exit(1)
+	}
+}
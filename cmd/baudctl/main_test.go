@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeMaster serves the http apis of a master with the data of replies by path, the other paths
+// fail. It records the requests as "METHOD path?query".
+type fakeMaster struct {
+	*httptest.Server
+	replies map[string]string
+
+	lock     sync.Mutex
+	requests []string
+}
+
+func newFakeMaster(replies map[string]string) *fakeMaster {
+	m := &fakeMaster{replies: replies}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.lock.Lock()
+		m.requests = append(m.requests, r.Method+" "+r.URL.RequestURI())
+		m.lock.Unlock()
+
+		data, ok := m.replies[r.URL.Path]
+		if !ok {
+			fmt.Fprint(w, `{"code": 3, "msg": "not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"code": 0, "msg": "success", "data": %s}`, data)
+	}))
+	return m
+}
+
+func (m *fakeMaster) addr() string {
+	return strings.TrimPrefix(m.URL, "http://")
+}
+
+func (m *fakeMaster) takeRequests() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	requests := m.requests
+	m.requests = nil
+	return requests
+}
+
+// runCtl runs baudctl with the args and returns what it printed.
+func runCtl(args ...string) (string, error) {
+	out := new(bytes.Buffer)
+	stdout = out
+	defer func() { stdout = os.Stdout }()
+
+	err := app.Run(append([]string{"baudctl"}, args...))
+	return out.String(), err
+}
+
+func TestCommands(t *testing.T) {
+	gm := newFakeMaster(map[string]string{
+		"/manage/db/create":          `{"id": 1, "name": "foo"}`,
+		"/manage/db/rename":          `null`,
+		"/manage/db/quota":           `null`,
+		"/manage/replica/create":     `null`,
+		"/manage/node/drain_leaders": `[{"partition_id": 5, "replica_id": 9}]`,
+	})
+	defer gm.Close()
+	zm := newFakeMaster(map[string]string{
+		"/manage/ps/maintenance":  `"2026-10-19T10:00:00Z"`,
+		"/manage/ps/decommission": `null`,
+	})
+	defer zm.Close()
+	masters := []string{"--gm", gm.addr(), "--zm", zm.addr()}
+
+	for _, test := range []struct {
+		args []string
+		// the requests the gm and the zm got
+		gm, zm []string
+		out    string
+		err    string
+	}{
+		{
+			args: []string{"db", "create", "--db", "foo"},
+			gm:   []string{"POST /manage/db/create?db_name=foo"},
+			out:  "ID  NAME\n1   foo\n",
+		},
+		{
+			args: []string{"-o", "json", "db", "create", "--db", "foo"},
+			gm:   []string{"POST /manage/db/create?db_name=foo"},
+			out:  "{\n  \"id\": 1,\n  \"name\": \"foo\"\n}\n",
+		},
+		{
+			args: []string{"db", "rename", "--from", "a", "--to", "b"},
+			gm:   []string{"PUT /manage/db/rename?dest_db_name=b&src_db_name=a"},
+			out:  "db[a] renamed to [b]\n",
+		},
+		{
+			args: []string{"db", "quota", "--db", "foo", "--doc-count", "10"},
+			gm:   []string{"PUT /manage/db/quota?db_name=foo&doc_count=10&storage_bytes=0&write_qps=0"},
+			out:  "quota of db[foo] updated\n",
+		},
+		{
+			args: []string{"db", "quota", "--db", "foo"},
+			err:  "missing --storage-bytes, --doc-count or --write-qps",
+		},
+		{
+			args: []string{"db", "detail"},
+			err:  "missing --db",
+		},
+		{
+			args: []string{"replica", "create", "--id", "7", "--zone", "z1"},
+			gm:   []string{"POST /manage/replica/create?partition_id=7&zone_name=z1"},
+			out:  "replica of partition[7] added in zone[z1]\n",
+		},
+		{
+			args: []string{"ps", "drain-leaders", "--zone", "z1", "--id", "2"},
+			gm:   []string{"PUT /manage/node/drain_leaders?node_id=2&zone_name=z1"},
+			out:  "PARTITION  NEW_LEADER  ERROR\n5          9           \n",
+		},
+		{
+			args: []string{"ps", "maintenance", "start", "--id", "3", "--duration", "1h"},
+			zm:   []string{"POST /manage/ps/maintenance?duration=3600&id=3"},
+			out:  "ps[3] in maintenance until 2026-10-19T10:00:00Z\n",
+		},
+		{
+			args: []string{"-o", "json", "ps", "decommission", "cancel", "--id", "3"},
+			zm:   []string{"DELETE /manage/ps/decommission?id=3"},
+			out:  "{\n  \"result\": \"decommission of ps[3] canceled\"\n}\n",
+		},
+		{
+			args: []string{"zone", "delete", "--zone", "z1"},
+			gm:   []string{"DELETE /manage/zone/delete?zone_name=z1"},
+			err:  "DELETE /manage/zone/delete: not found(code 3)",
+		},
+		{
+			args: []string{"-o", "yaml", "db", "create", "--db", "foo"},
+			gm:   []string{"POST /manage/db/create?db_name=foo"},
+			err:  "unknown output[yaml], table or json",
+		},
+		{
+			args: []string{"route", "--db", "foo", "--space", "bar"},
+			err:  "missing the grpc address of the zone master, set --zm-rpc",
+		},
+	} {
+		name := strings.Join(test.args, " ")
+		out, err := runCtl(append(masters, test.args...)...)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Fatalf("%s: expect error %q, got %v", name, test.err, err)
+			}
+		} else if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		} else if out != test.out {
+			t.Fatalf("%s: expect output %q, got %q", name, test.out, out)
+		}
+		if requests := gm.takeRequests(); strings.Join(requests, ",") != strings.Join(test.gm, ",") {
+			t.Fatalf("%s: unexpected requests to the gm %v", name, requests)
+		}
+		if requests := zm.takeRequests(); strings.Join(requests, ",") != strings.Join(test.zm, ",") {
+			t.Fatalf("%s: unexpected requests to the zm %v", name, requests)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/urfave/cli.v2"
+)
+
+// stdout is where the replies are printed, the tests read them there.
+var stdout io.Writer = os.Stdout
+
+// table is the view of a reply on the table output, the json output prints the reply as it came.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func newTable(header ...string) *table {
+	return &table{header: header}
+}
+
+func (t *table) add(cells ...interface{}) {
+	row := make([]string, 0, len(cells))
+	for _, cell := range cells {
+		row = append(row, fmt.Sprint(cell))
+	}
+	t.rows = append(t.rows, row)
+}
+
+func (t *table) write(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(t.header, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// render prints the reply by the output flag, view builds the table of the reply.
+func render(ctx *cli.Context, reply json.RawMessage, view func() *table) error {
+	switch ctx.String(flagOutput) {
+	case outputJson:
+		return printJson(reply)
+	case outputTable:
+		return view().write(stdout)
+	default:
+		return fmt.Errorf("unknown output[%s], table or json", ctx.String(flagOutput))
+	}
+}
+
+func printJson(data interface{}) error {
+	enc := json.NewEncoder(stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+// renderDone prints the reply of an operation that returns no data.
+func renderDone(ctx *cli.Context, format string, args ...interface{}) error {
+	if ctx.String(flagOutput) == outputJson {
+		return printJson(map[string]string{"result": fmt.Sprintf(format, args...)})
+	}
+	fmt.Fprintf(stdout, format+"\n", args...)
+	return nil
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func percent(part, total uint64) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(part)*100/float64(total))
+}
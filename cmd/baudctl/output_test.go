@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTableWrite(t *testing.T) {
+	tb := newTable("ID", "NAME", "")
+	tb.add(1, "foo", true)
+	tb.add(100, "", nil)
+
+	out := new(bytes.Buffer)
+	if err := tb.write(out); err != nil {
+		t.Fatal(err)
+	}
+	expect := "ID   NAME  \n" +
+		"1    foo   true\n" +
+		"100        <nil>\n"
+	if out.String() != expect {
+		t.Fatalf("unexpected table %q", out.String())
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for _, test := range []struct {
+		got, expect string
+	}{
+		{formatBytes(0), "0B"},
+		{formatBytes(1023), "1023B"},
+		{formatBytes(1024), "1.0KiB"},
+		{formatBytes(1536 << 10), "1.5MiB"},
+		{formatBytes(3 << 40), "3.0TiB"},
+		{percent(1, 3), "33.3%"},
+		{percent(0, 0), "-"},
+	} {
+		if test.got != test.expect {
+			t.Fatalf("expect %s, got %s", test.expect, test.got)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/urfave/cli.v2"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+var (
+	partitionIdFlag = &cli.Uint64Flag{Name: "id", Usage: "partition id"}
+	watchFlags      = []cli.Flag{
+		&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Usage: "refresh until interrupted"},
+		&cli.DurationFlag{Name: "interval", Value: 5 * time.Second, Usage: "refresh interval of the watch"},
+	}
+
+	partitionCommand = &cli.Command{
+		Name:  "partition",
+		Usage: "manage the partitions",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "list the partitions of the cluster",
+				Action: partitionList,
+			},
+			{
+				Name:   "detail",
+				Usage:  "show a partition",
+				Flags:  []cli.Flag{dbFlag, spaceFlag, partitionIdFlag},
+				Action: partitionDetail,
+			},
+			{
+				Name:  "leader",
+				Usage: "move the leadership of a partition to a replica",
+				Flags: []cli.Flag{
+					partitionIdFlag,
+					&cli.Uint64Flag{Name: "replica", Usage: "id of the replica to lead"},
+				},
+				Action: partitionLeader,
+			},
+			{
+				Name:  "health",
+				Usage: "show the health of the partitions of the zone by their heartbeats",
+				Flags: append([]cli.Flag{
+					&cli.DurationFlag{Name: "stale", Value: 30 * time.Second, Usage: "age a heartbeat is stale at"},
+					&cli.BoolFlag{Name: "unhealthy", Usage: "show the unhealthy partitions only"},
+				}, watchFlags...),
+				Action: partitionHealth,
+			},
+			{
+				Name:   "consistency",
+				Usage:  "show the counts of the consistency checks of the zone",
+				Action: partitionConsistency,
+			},
+		},
+	}
+
+	replicaCommand = &cli.Command{
+		Name:  "replica",
+		Usage: "manage the replicas of the partitions",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "create",
+				Usage:  "add a replica of a partition in a zone",
+				Flags:  []cli.Flag{partitionIdFlag, zoneFlag},
+				Action: replicaCreate,
+			},
+			{
+				Name:  "delete",
+				Usage: "remove a replica of a partition",
+				Flags: []cli.Flag{
+					partitionIdFlag,
+					&cli.Uint64Flag{Name: "replica", Usage: "id of the replica to remove"},
+				},
+				Action: replicaDelete,
+			},
+		},
+	}
+)
+
+// gmPartition mirrors a partition of the global master.
+type gmPartition struct {
+	metapb.Partition
+	ReplicaLeader *metapb.Replica
+	Term          uint64
+}
+
+// zmPartition mirrors a partition of the zone master, with what the heartbeats of its leader tell.
+type zmPartition struct {
+	metapb.Partition
+	Leader        *metapb.Replica         `json:"leader"`
+	LastHeartbeat time.Time               `json:"last_heartbeat"`
+	Consistency   *consistencyResult      `json:"consistency"`
+	Statistics    masterpb.PartitionStats `json:"statistics"`
+}
+
+type consistencyResult struct {
+	Index     uint64             `json:"index"`
+	Divergent []metapb.ReplicaID `json:"divergent"`
+	Repaired  bool               `json:"repaired"`
+	Error     string             `json:"error"`
+	CheckTime time.Time          `json:"check_time"`
+}
+
+func formatReplicas(replicas []metapb.Replica) string {
+	ids := make([]string, 0, len(replicas))
+	for _, replica := range replicas {
+		ids = append(ids, fmt.Sprintf("%d@%d", replica.ID, replica.NodeID))
+	}
+	return strings.Join(ids, ",")
+}
+
+func formatLeader(leader *metapb.Replica) string {
+	if leader == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d@%d", leader.ID, leader.NodeID)
+}
+
+func partitionTable(partitions ...*gmPartition) *table {
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].ID < partitions[j].ID })
+	t := newTable("ID", "DB", "SPACE", "SLOTS", "STATUS", "REPLICAS", "LEADER", "TERM")
+	for _, p := range partitions {
+		t.add(p.ID, p.DB, p.Space, fmt.Sprintf("%d-%d", p.StartSlot, p.EndSlot), p.Status,
+			formatReplicas(p.Replicas), formatLeader(p.ReplicaLeader), p.Term)
+	}
+	return t
+}
+
+func partitionList(ctx *cli.Context) error {
+	var partitions []*gmPartition
+	reply, err := gmClient(ctx).get("/manage/partition/list", nil, &partitions)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table { return partitionTable(partitions...) })
+}
+
+func partitionDetail(ctx *cli.Context) error {
+	params, err := spaceParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := requireFlags(ctx, "id"); err != nil {
+		return err
+	}
+	params.Set("partition_id", strconv.FormatUint(ctx.Uint64("id"), 10))
+
+	partition := new(gmPartition)
+	reply, err := gmClient(ctx).get("/manage/partition/detail", params, partition)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table { return partitionTable(partition) })
+}
+
+func partitionLeader(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "id", "replica"); err != nil {
+		return err
+	}
+	params := url.Values{
+		"partition_id": {strconv.FormatUint(ctx.Uint64("id"), 10)},
+		"replica_id":   {strconv.FormatUint(ctx.Uint64("replica"), 10)},
+	}
+	if _, err := gmClient(ctx).call(http.MethodPut, "/manage/partition/leader", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "replica[%d] leads partition[%d]", ctx.Uint64("replica"), ctx.Uint64("id"))
+}
+
+// partitionProblems returns what is wrong with the partition, none for a healthy one.
+func partitionProblems(p *zmPartition, stale time.Duration) []string {
+	var problems []string
+	if p.Leader == nil {
+		problems = append(problems, "no leader")
+	}
+	if p.LastHeartbeat.IsZero() || time.Since(p.LastHeartbeat) > stale {
+		problems = append(problems, "stale heartbeat")
+	}
+	if c := p.Consistency; c != nil {
+		if c.Error != "" {
+			problems = append(problems, "check failed")
+		} else if len(c.Divergent) != 0 && !c.Repaired {
+			problems = append(problems, "divergent")
+		}
+	}
+	return problems
+}
+
+func formatAge(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return time.Since(t).Truncate(time.Second).String()
+}
+
+func partitionHealth(ctx *cli.Context) error {
+	show := func() error {
+		var partitions []*zmPartition
+		reply, err := zmClient(ctx).get("/manage/partition/list", nil, &partitions)
+		if err != nil {
+			return err
+		}
+		return render(ctx, reply, func() *table {
+			sort.Slice(partitions, func(i, j int) bool { return partitions[i].ID < partitions[j].ID })
+			t := newTable("ID", "SPACE", "LEADER", "REPLICAS", "HEARTBEAT", "SIZE", "DOCS", "HEALTH")
+			var unhealthy int
+			for _, p := range partitions {
+				problems := partitionProblems(p, ctx.Duration("stale"))
+				health := "ok"
+				if len(problems) != 0 {
+					unhealthy++
+					health = strings.Join(problems, ",")
+				} else if ctx.Bool("unhealthy") {
+					continue
+				}
+				t.add(p.ID, p.Space, formatLeader(p.Leader), formatReplicas(p.Replicas), formatAge(p.LastHeartbeat),
+					formatBytes(p.Statistics.Size_), p.Statistics.DocCount, health)
+			}
+			t.add("", "", "", "", "", "", "", fmt.Sprintf("%d/%d unhealthy", unhealthy, len(partitions)))
+			return t
+		})
+	}
+
+	if !ctx.Bool("watch") {
+		return show()
+	}
+	return watch(ctx, ctx.Duration("interval"), show)
+}
+
+type consistencyStats struct {
+	Checked    uint64    `json:"checked"`
+	Consistent uint64    `json:"consistent"`
+	Divergent  uint64    `json:"divergent"`
+	Repaired   uint64    `json:"repaired"`
+	Failed     uint64    `json:"failed"`
+	LastRun    time.Time `json:"last_run"`
+}
+
+func partitionConsistency(ctx *cli.Context) error {
+	stats := new(consistencyStats)
+	reply, err := zmClient(ctx).get("/manage/consistency/stats", nil, stats)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table {
+		t := newTable("CHECKED", "CONSISTENT", "DIVERGENT", "REPAIRED", "FAILED", "LAST_RUN")
+		t.add(stats.Checked, stats.Consistent, stats.Divergent, stats.Repaired, stats.Failed, formatAge(stats.LastRun))
+		return t
+	})
+}
+
+func replicaCreate(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "id", "zone"); err != nil {
+		return err
+	}
+	params := url.Values{
+		"partition_id": {strconv.FormatUint(ctx.Uint64("id"), 10)},
+		"zone_name":    {ctx.String("zone")},
+	}
+	if _, err := gmClient(ctx).call(http.MethodPost, "/manage/replica/create", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "replica of partition[%d] added in zone[%s]", ctx.Uint64("id"), ctx.String("zone"))
+}
+
+func replicaDelete(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "id", "replica"); err != nil {
+		return err
+	}
+	params := url.Values{
+		"partition_id": {strconv.FormatUint(ctx.Uint64("id"), 10)},
+		"replica_id":   {strconv.FormatUint(ctx.Uint64("replica"), 10)},
+	}
+	if _, err := gmClient(ctx).call(http.MethodDelete, "/manage/replica/delete", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "replica[%d] of partition[%d] removed", ctx.Uint64("replica"), ctx.Uint64("id"))
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"gopkg.in/urfave/cli.v2"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+var (
+	psIdFlag = &cli.UintFlag{Name: "id", Usage: "partition server id"}
+
+	psCommand = &cli.Command{
+		Name:  "ps",
+		Usage: "manage the partition servers of the zone",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "list the partition servers with their heartbeat state",
+				Flags:  watchFlags,
+				Action: psList,
+			},
+			{
+				Name:  "decommission",
+				Usage: "move the replicas off a partition server, which logs out then",
+				Subcommands: []*cli.Command{
+					{
+						Name:   "start",
+						Usage:  "start to decommission a partition server",
+						Flags:  []cli.Flag{psIdFlag},
+						Action: psDecommission(http.MethodPost),
+					},
+					{
+						Name:   "progress",
+						Usage:  "show how far the decommission went",
+						Flags:  []cli.Flag{psIdFlag},
+						Action: psDecommission(http.MethodGet),
+					},
+					{
+						Name:   "cancel",
+						Usage:  "cancel the decommission",
+						Flags:  []cli.Flag{psIdFlag},
+						Action: psDecommission(http.MethodDelete),
+					},
+				},
+			},
+			{
+				Name:  "maintenance",
+				Usage: "leave the replicas of a restarting partition server alone",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "start",
+						Usage: "put a partition server in maintenance",
+						Flags: []cli.Flag{
+							psIdFlag,
+							&cli.DurationFlag{Name: "duration", Value: 10 * time.Minute, Usage: "length of the maintenance"},
+						},
+						Action: psMaintenance,
+					},
+					{
+						Name:   "end",
+						Usage:  "end the maintenance of a partition server",
+						Flags:  []cli.Flag{psIdFlag},
+						Action: psMaintenanceEnd,
+					},
+				},
+			},
+			{
+				Name:   "drain-leaders",
+				Usage:  "move the leaders off a partition server",
+				Flags:  []cli.Flag{zoneFlag, psIdFlag},
+				Action: psDrainLeaders,
+			},
+		},
+	}
+)
+
+// psState mirrors the state of a partition server by its heartbeats.
+type psState struct {
+	ID               metapb.NodeID          `json:"id"`
+	Ip               string                 `json:"ip"`
+	Zone             string                 `json:"zone"`
+	Status           string                 `json:"status"`
+	LastHeartbeat    time.Time              `json:"last_heartbeat"`
+	DiskState        string                 `json:"disk_state"`
+	Draining         bool                   `json:"draining"`
+	MaintenanceUntil time.Time              `json:"maintenance_until"`
+	Replicas         int                    `json:"replicas"`
+	Leaders          int                    `json:"leaders"`
+	SysStats         *masterpb.NodeSysStats `json:"sys_stats"`
+}
+
+// mode sums up the flags of the ps that keep new replicas off it.
+func (ps *psState) mode() string {
+	switch {
+	case ps.Draining:
+		return "draining"
+	case ps.Status == "maintenance":
+		return "maintenance"
+	default:
+		return "-"
+	}
+}
+
+func getPSStates(ctx *cli.Context) ([]*psState, json.RawMessage, error) {
+	var states []*psState
+	reply, err := zmClient(ctx).get("/manage/ps/status", nil, &states)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].ID < states[j].ID })
+	return states, reply, nil
+}
+
+func psList(ctx *cli.Context) error {
+	show := func() error {
+		states, reply, err := getPSStates(ctx)
+		if err != nil {
+			return err
+		}
+		return render(ctx, reply, func() *table {
+			t := newTable("ID", "IP", "STATUS", "HEARTBEAT", "MODE", "DISK", "DISK_USED", "CPU", "REPLICAS", "LEADERS")
+			for _, ps := range states {
+				stats := ps.SysStats
+				if stats == nil {
+					stats = new(masterpb.NodeSysStats)
+				}
+				t.add(ps.ID, ps.Ip, ps.Status, formatAge(ps.LastHeartbeat), ps.mode(), ps.DiskState,
+					percent(stats.DiskUsed, stats.DiskTotal), strconv.FormatFloat(stats.CpuProcRate, 'f', 1, 64)+"%",
+					ps.Replicas, ps.Leaders)
+			}
+			return t
+		})
+	}
+
+	if !ctx.Bool("watch") {
+		return show()
+	}
+	return watch(ctx, ctx.Duration("interval"), show)
+}
+
+type decommissionProgress struct {
+	NodeID       metapb.NodeID      `json:"node_id"`
+	State        string             `json:"state"`
+	Partitions   int                `json:"partitions"`
+	LeadersMoved int                `json:"leaders_moved"`
+	Migrated     int                `json:"migrated"`
+	Current      metapb.PartitionID `json:"current"`
+	Error        string             `json:"error"`
+	StartTime    time.Time          `json:"start_time"`
+}
+
+func psParams(ctx *cli.Context) (url.Values, error) {
+	if err := requireFlags(ctx, "id"); err != nil {
+		return nil, err
+	}
+	return url.Values{"id": {strconv.FormatUint(uint64(ctx.Uint("id")), 10)}}, nil
+}
+
+func psDecommission(method string) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		params, err := psParams(ctx)
+		if err != nil {
+			return err
+		}
+		if method == http.MethodDelete {
+			if _, err := zmClient(ctx).call(method, "/manage/ps/decommission", params, nil); err != nil {
+				return err
+			}
+			return renderDone(ctx, "decommission of ps[%d] canceled", ctx.Uint("id"))
+		}
+
+		progress := new(decommissionProgress)
+		reply, err := zmClient(ctx).call(method, "/manage/ps/decommission", params, progress)
+		if err != nil {
+			return err
+		}
+		return render(ctx, reply, func() *table {
+			t := newTable("ID", "STATE", "PARTITIONS", "LEADERS_MOVED", "MIGRATED", "CURRENT", "STARTED", "ERROR")
+			t.add(progress.NodeID, progress.State, progress.Partitions, progress.LeadersMoved, progress.Migrated,
+				progress.Current, formatAge(progress.StartTime), progress.Error)
+			return t
+		})
+	}
+}
+
+func psMaintenance(ctx *cli.Context) error {
+	params, err := psParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.Set("duration", strconv.FormatInt(int64(ctx.Duration("duration")/time.Second), 10))
+
+	var until time.Time
+	if _, err := zmClient(ctx).call(http.MethodPost, "/manage/ps/maintenance", params, &until); err != nil {
+		return err
+	}
+	return renderDone(ctx, "ps[%d] in maintenance until %s", ctx.Uint("id"), until.Format(time.RFC3339))
+}
+
+func psMaintenanceEnd(ctx *cli.Context) error {
+	params, err := psParams(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := zmClient(ctx).call(http.MethodDelete, "/manage/ps/maintenance", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "maintenance of ps[%d] ended", ctx.Uint("id"))
+}
+
+type leaderChange struct {
+	PartitionId metapb.PartitionID `json:"partition_id"`
+	ReplicaId   metapb.ReplicaID   `json:"replica_id"`
+	Error       string             `json:"error"`
+}
+
+func psDrainLeaders(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "zone", "id"); err != nil {
+		return err
+	}
+	params := url.Values{
+		"zone_name": {ctx.String("zone")},
+		"node_id":   {strconv.FormatUint(uint64(ctx.Uint("id")), 10)},
+	}
+	var changes []*leaderChange
+	reply, err := gmClient(ctx).call(http.MethodPut, "/manage/node/drain_leaders", params, &changes)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table {
+		t := newTable("PARTITION", "NEW_LEADER", "ERROR")
+		for _, change := range changes {
+			t.add(change.PartitionId, change.ReplicaId, change.Error)
+		}
+		return t
+	})
+}
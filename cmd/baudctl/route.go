@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"gopkg.in/urfave/cli.v2"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+var routeCommand = &cli.Command{
+	Name:   "route",
+	Usage:  "show the routes of a space, as the routers see them, through the grpc api of the zone master",
+	Flags:  []cli.Flag{dbFlag, spaceFlag},
+	Action: routeShow,
+}
+
+func checkRpcHeader(header *metapb.ResponseHeader) error {
+	if header.Code != metapb.RESP_CODE_OK {
+		return fmt.Errorf("%s(code %d)", header.Message, header.Code)
+	}
+	return nil
+}
+
+// getRoutes reads the routes of the space from the first slot on. The zone master returns a few
+// routes for a slot, each page starts at the end slot of the last route of the page before.
+func getRoutes(ctx *cli.Context, client masterpb.MasterRpcClient, dbId metapb.DBID, spaceId metapb.SpaceID) ([]masterpb.Route, error) {
+	var routes []masterpb.Route
+	var slot metapb.SlotID
+	for {
+		rpcCtx, cancel := rpcContext(ctx)
+		resp, err := client.GetRoute(rpcCtx, &masterpb.GetRouteRequest{DB: dbId, Space: spaceId, Slot: slot})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkRpcHeader(&resp.ResponseHeader); err != nil {
+			if len(routes) != 0 {
+				// no route after the last one
+				return routes, nil
+			}
+			return nil, err
+		}
+		if len(resp.Routes) == 0 {
+			return routes, nil
+		}
+
+		routes = append(routes, resp.Routes...)
+		end := resp.Routes[len(resp.Routes)-1].EndSlot
+		if end <= slot || end == math.MaxUint32 {
+			return routes, nil
+		}
+		slot = end
+	}
+}
+
+func routeShow(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "db", "space"); err != nil {
+		return err
+	}
+	client, closeFunc, err := masterRpc(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	rpcCtx, cancel := rpcContext(ctx)
+	dbResp, err := client.GetDB(rpcCtx, &masterpb.GetDBRequest{DBName: ctx.String("db")})
+	cancel()
+	if err != nil {
+		return err
+	}
+	if err := checkRpcHeader(&dbResp.ResponseHeader); err != nil {
+		return err
+	}
+	rpcCtx, cancel = rpcContext(ctx)
+	spaceResp, err := client.GetSpace(rpcCtx, &masterpb.GetSpaceRequest{ID: dbResp.Db.ID, SpaceName: ctx.String("space")})
+	cancel()
+	if err != nil {
+		return err
+	}
+	if err := checkRpcHeader(&spaceResp.ResponseHeader); err != nil {
+		return err
+	}
+
+	routes, err := getRoutes(ctx, client, dbResp.Db.ID, spaceResp.Space.ID)
+	if err != nil {
+		return err
+	}
+	if ctx.String(flagOutput) == outputJson {
+		return printJson(routes)
+	}
+	return render(ctx, nil, func() *table {
+		t := newTable("PARTITION", "SLOTS", "STATUS", "LEADER", "NODES")
+		for _, route := range routes {
+			nodes := make([]string, 0, len(route.Nodes))
+			for _, node := range route.Nodes {
+				nodes = append(nodes, fmt.Sprintf("%d(%s)", node.ID, node.RpcAddr))
+			}
+			t.add(route.ID, fmt.Sprintf("%d-%d", route.StartSlot, route.EndSlot), route.Status, route.Leader,
+				strings.Join(nodes, ","))
+		}
+		return t
+	})
+}
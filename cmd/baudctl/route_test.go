@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"math"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+// rpcMaster serves the grpc api of a zone master with a space of two routes, GetRoute returns the
+// route of the slot only.
+type rpcMaster struct {
+	masterpb.MasterRpcServer
+	routes []masterpb.Route
+}
+
+func (m *rpcMaster) GetDB(ctx context.Context, req *masterpb.GetDBRequest) (*masterpb.GetDBResponse, error) {
+	if req.DBName != "db" {
+		return &masterpb.GetDBResponse{ResponseHeader: metapb.ResponseHeader{
+			Code: metapb.MASTER_RESP_CODE_DB_NOTEXISTS, Message: "db not exists"}}, nil
+	}
+	return &masterpb.GetDBResponse{Db: metapb.DB{ID: 1, Name: req.DBName}}, nil
+}
+
+func (m *rpcMaster) GetSpace(ctx context.Context, req *masterpb.GetSpaceRequest) (*masterpb.GetSpaceResponse, error) {
+	return &masterpb.GetSpaceResponse{Space: metapb.Space{ID: 2, DB: req.ID, Name: req.SpaceName}}, nil
+}
+
+func (m *rpcMaster) GetRoute(ctx context.Context, req *masterpb.GetRouteRequest) (*masterpb.GetRouteResponse, error) {
+	resp := new(masterpb.GetRouteResponse)
+	for _, route := range m.routes {
+		if route.DB == req.DB && route.Space == req.Space && route.StartSlot <= req.Slot && req.Slot < route.EndSlot {
+			resp.Routes = append(resp.Routes, route)
+		}
+	}
+	return resp, nil
+}
+
+func TestRouteShow(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	masterpb.RegisterMasterRpcServer(server, &rpcMaster{routes: []masterpb.Route{
+		{Partition: metapb.Partition{ID: 5, DB: 1, Space: 2, StartSlot: 0, EndSlot: 100, Status: metapb.PA_READWRITE},
+			Nodes: []*metapb.Node{{ID: 3, RpcAddr: "ps3:8000"}}, Leader: 3},
+		{Partition: metapb.Partition{ID: 6, DB: 1, Space: 2, StartSlot: 100, EndSlot: math.MaxUint32, Status: metapb.PA_READONLY},
+			Nodes: []*metapb.Node{{ID: 3, RpcAddr: "ps3:8000"}, {ID: 4, RpcAddr: "ps4:8000"}}, Leader: 4},
+	}})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	out, err := runCtl("--zm-rpc", listener.Addr().String(), "route", "--db", "db", "--space", "space")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "PARTITION  SLOTS           STATUS        LEADER  NODES\n" +
+		"5          0-100           PA_READWRITE  3       3(ps3:8000)\n" +
+		"6          100-4294967295  PA_READONLY   4       3(ps3:8000),4(ps4:8000)\n"
+	if out != expect {
+		t.Fatalf("unexpected routes %q", out)
+	}
+
+	if _, err := runCtl("--zm-rpc", listener.Addr().String(), "route", "--db", "other", "--space", "space"); err == nil ||
+		err.Error() != "db not exists(code 603)" {
+		t.Fatalf("unexpected error of a missing db: %v", err)
+	}
+}
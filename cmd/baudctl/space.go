@@ -0,0 +1,272 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gopkg.in/urfave/cli.v2"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+var (
+	spaceFlag = &cli.StringFlag{Name: "space", Usage: "space name"}
+	// the schema flag takes the schema, or @file to read it from the file
+	schemaFlag   = &cli.StringFlag{Name: "schema", Usage: "space schema in json, @file reads it from the file"}
+	replicaFlags = []cli.Flag{
+		&cli.UintFlag{Name: "replica-num", Usage: "replicas of each partition"},
+		&cli.UintFlag{Name: "min-zones", Usage: "zones the replicas of a partition spread over at least"},
+		&cli.BoolFlag{Name: "one-per-zone", Usage: "place at most one replica of a partition in each zone"},
+		&cli.StringFlag{Name: "leader-zone", Usage: "zone the leaders are preferred in"},
+	}
+
+	spaceCommand = &cli.Command{
+		Name:  "space",
+		Usage: "manage the spaces of a db",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "list the spaces of a db",
+				Flags:  []cli.Flag{dbFlag},
+				Action: spaceList,
+			},
+			{
+				Name:   "detail",
+				Usage:  "show a space",
+				Flags:  []cli.Flag{dbFlag, spaceFlag},
+				Action: spaceDetail,
+			},
+			{
+				Name:  "create",
+				Usage: "create a space",
+				Flags: append([]cli.Flag{
+					dbFlag, spaceFlag, schemaFlag,
+					&cli.StringFlag{Name: "partition-key", Usage: "field the documents are partitioned by"},
+					&cli.StringFlag{Name: "partition-func", Value: "hash", Usage: "function the documents are partitioned with"},
+					&cli.UintFlag{Name: "partition-num", Value: 1, Usage: "initial partitions"},
+				}, replicaFlags...),
+				Action: spaceCreate,
+			},
+			{
+				Name:   "delete",
+				Usage:  "delete a space",
+				Flags:  []cli.Flag{dbFlag, spaceFlag},
+				Action: spaceDelete,
+			},
+			{
+				Name:  "rename",
+				Usage: "rename a space",
+				Flags: []cli.Flag{
+					dbFlag,
+					&cli.StringFlag{Name: "from", Usage: "current space name"},
+					&cli.StringFlag{Name: "to", Usage: "new space name"},
+				},
+				Action: spaceRename,
+			},
+			{
+				Name:   "schema",
+				Usage:  "update the schema of a space",
+				Flags:  []cli.Flag{dbFlag, spaceFlag, schemaFlag},
+				Action: spaceSchema,
+			},
+			{
+				Name:   "policy",
+				Usage:  "update the replica policy of a space",
+				Flags:  append([]cli.Flag{dbFlag, spaceFlag}, replicaFlags...),
+				Action: spacePolicy,
+			},
+			{
+				Name:   "quota",
+				Usage:  "set the quota of a space",
+				Flags:  append([]cli.Flag{dbFlag, spaceFlag}, quotaFlags...),
+				Action: spaceQuota,
+			},
+		},
+	}
+)
+
+func spaceParams(ctx *cli.Context) (url.Values, error) {
+	if err := requireFlags(ctx, "db", "space"); err != nil {
+		return nil, err
+	}
+	return url.Values{"db_name": {ctx.String("db")}, "space_name": {ctx.String("space")}}, nil
+}
+
+func schemaParam(ctx *cli.Context, params url.Values) error {
+	if err := requireFlags(ctx, "schema"); err != nil {
+		return err
+	}
+	schema := ctx.String("schema")
+	if strings.HasPrefix(schema, "@") {
+		data, err := ioutil.ReadFile(schema[1:])
+		if err != nil {
+			return err
+		}
+		schema = string(data)
+	}
+	params.Set("space_schema", schema)
+	return nil
+}
+
+func replicaPolicyParams(ctx *cli.Context, params url.Values) {
+	if ctx.IsSet("replica-num") {
+		params.Set("replica_num", strconv.FormatUint(uint64(ctx.Uint("replica-num")), 10))
+	}
+	if ctx.IsSet("min-zones") {
+		params.Set("min_zones", strconv.FormatUint(uint64(ctx.Uint("min-zones")), 10))
+	}
+	if ctx.IsSet("one-per-zone") {
+		params.Set("one_per_zone", strconv.FormatBool(ctx.Bool("one-per-zone")))
+	}
+	if ctx.IsSet("leader-zone") {
+		params.Set("leader_zone", ctx.String("leader-zone"))
+	}
+}
+
+func formatReplicaPolicy(policy *metapb.ReplicaPolicy) string {
+	if policy == nil {
+		return "-"
+	}
+	s := fmt.Sprintf("replicas=%d", policy.ReplicaNum)
+	if policy.MinZones != 0 {
+		s += fmt.Sprintf(" min_zones=%d", policy.MinZones)
+	}
+	if policy.OnePerZone {
+		s += " one_per_zone"
+	}
+	if policy.LeaderZone != "" {
+		s += " leader_zone=" + policy.LeaderZone
+	}
+	return s
+}
+
+func spaceTable(spaces ...*metapb.Space) *table {
+	t := newTable("ID", "DB", "NAME", "STATUS", "REPLICAS", "QUOTA", "QUOTA_EXCEEDED")
+	for _, space := range spaces {
+		t.add(space.ID, space.DbName, space.Name, space.Status, formatReplicaPolicy(space.ReplicaPolicy),
+			formatQuota(space.Quota), space.QuotaExceeded)
+	}
+	return t
+}
+
+func spaceList(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "db"); err != nil {
+		return err
+	}
+	var spaces []*metapb.Space
+	reply, err := gmClient(ctx).get("/manage/space/list", url.Values{"db_name": {ctx.String("db")}}, &spaces)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table { return spaceTable(spaces...) })
+}
+
+func spaceDetail(ctx *cli.Context) error {
+	params, err := spaceParams(ctx)
+	if err != nil {
+		return err
+	}
+	space := new(metapb.Space)
+	reply, err := gmClient(ctx).get("/manage/space/detail", params, space)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table { return spaceTable(space) })
+}
+
+func spaceCreate(ctx *cli.Context) error {
+	params, err := spaceParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := requireFlags(ctx, "partition-key"); err != nil {
+		return err
+	}
+	if err := schemaParam(ctx, params); err != nil {
+		return err
+	}
+	params.Set("partition_key", ctx.String("partition-key"))
+	params.Set("partition_func", ctx.String("partition-func"))
+	params.Set("partition_num", strconv.FormatUint(uint64(ctx.Uint("partition-num")), 10))
+	replicaPolicyParams(ctx, params)
+
+	space := new(metapb.Space)
+	reply, err := gmClient(ctx).call(http.MethodPost, "/manage/space/create", params, space)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table { return spaceTable(space) })
+}
+
+func spaceDelete(ctx *cli.Context) error {
+	params, err := spaceParams(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := gmClient(ctx).call(http.MethodDelete, "/manage/space/delete", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "space[%s.%s] deleted", ctx.String("db"), ctx.String("space"))
+}
+
+func spaceRename(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "db", "from", "to"); err != nil {
+		return err
+	}
+	params := url.Values{
+		"db_name":         {ctx.String("db")},
+		"src_space_name":  {ctx.String("from")},
+		"dest_space_name": {ctx.String("to")},
+	}
+	if _, err := gmClient(ctx).call(http.MethodPut, "/manage/space/rename", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "space[%s.%s] renamed to [%s]", ctx.String("db"), ctx.String("from"), ctx.String("to"))
+}
+
+func spaceSchema(ctx *cli.Context) error {
+	params, err := spaceParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := schemaParam(ctx, params); err != nil {
+		return err
+	}
+	if _, err := gmClient(ctx).call(http.MethodPut, "/manage/space/schema", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "schema of space[%s.%s] updated", ctx.String("db"), ctx.String("space"))
+}
+
+func spacePolicy(ctx *cli.Context) error {
+	params, err := spaceParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := requireFlags(ctx, "replica-num"); err != nil {
+		return err
+	}
+	replicaPolicyParams(ctx, params)
+	if _, err := gmClient(ctx).call(http.MethodPut, "/manage/space/policy", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "replica policy of space[%s.%s] updated", ctx.String("db"), ctx.String("space"))
+}
+
+func spaceQuota(ctx *cli.Context) error {
+	params, err := spaceParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := quotaParams(ctx, params); err != nil {
+		return err
+	}
+	if _, err := gmClient(ctx).call(http.MethodPut, "/manage/space/quota", params, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "quota of space[%s.%s] updated", ctx.String("db"), ctx.String("space"))
+}
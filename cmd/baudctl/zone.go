@@ -0,0 +1,104 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+
+	"gopkg.in/urfave/cli.v2"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+var (
+	zoneFlag = &cli.StringFlag{Name: "zone", Usage: "zone name"}
+
+	zoneCommand = &cli.Command{
+		Name:  "zone",
+		Usage: "manage the zones",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "list the zones",
+				Action: zoneList,
+			},
+			{
+				Name:   "detail",
+				Usage:  "show a zone",
+				Flags:  []cli.Flag{zoneFlag},
+				Action: zoneDetail,
+			},
+			{
+				Name:  "create",
+				Usage: "create a zone",
+				Flags: []cli.Flag{
+					zoneFlag,
+					&cli.StringFlag{Name: "etcd-addr", Usage: "etcd addresses of the zone topo"},
+					&cli.StringFlag{Name: "root-dir", Usage: "root dir of the zone topo"},
+				},
+				Action: zoneCreate,
+			},
+			{
+				Name:   "delete",
+				Usage:  "delete a zone",
+				Flags:  []cli.Flag{zoneFlag},
+				Action: zoneDelete,
+			},
+		},
+	}
+)
+
+func zoneTable(zones ...*metapb.Zone) *table {
+	t := newTable("NAME", "SERVER_ADDRS", "ROOT_DIR")
+	for _, zone := range zones {
+		t.add(zone.Name, zone.ServerAddrs, zone.RootDir)
+	}
+	return t
+}
+
+func zoneList(ctx *cli.Context) error {
+	var zones []*metapb.Zone
+	reply, err := gmClient(ctx).get("/manage/zone/list", nil, &zones)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table { return zoneTable(zones...) })
+}
+
+func zoneDetail(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "zone"); err != nil {
+		return err
+	}
+	zone := new(metapb.Zone)
+	reply, err := gmClient(ctx).get("/manage/zone/detail", url.Values{"zone_name": {ctx.String("zone")}}, zone)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table { return zoneTable(zone) })
+}
+
+func zoneCreate(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "zone", "etcd-addr", "root-dir"); err != nil {
+		return err
+	}
+	params := url.Values{
+		"zone_name":      {ctx.String("zone")},
+		"zone_etcd_addr": {ctx.String("etcd-addr")},
+		"zone_root_dir":  {ctx.String("root-dir")},
+	}
+	zone := new(metapb.Zone)
+	reply, err := gmClient(ctx).call(http.MethodPost, "/manage/zone/create", params, zone)
+	if err != nil {
+		return err
+	}
+	return render(ctx, reply, func() *table { return zoneTable(zone) })
+}
+
+func zoneDelete(ctx *cli.Context) error {
+	if err := requireFlags(ctx, "zone"); err != nil {
+		return err
+	}
+	if _, err := gmClient(ctx).call(http.MethodDelete, "/manage/zone/delete", url.Values{"zone_name": {ctx.String("zone")}}, nil); err != nil {
+		return err
+	}
+	return renderDone(ctx, "zone[%s] deleted", ctx.String("zone"))
+}
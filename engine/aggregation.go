@@ -0,0 +1,200 @@
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// the functions of a metric
+const (
+	MetricCount = "count"
+	MetricSum   = "sum"
+	MetricMin   = "min"
+	MetricMax   = "max"
+)
+
+var ErrTooManyGroups = errors.New("the aggregation has more groups than its max groups")
+
+// Aggregation folds the hits of a search into groups by the values of the GroupBy fields, all the
+// hits fall into one group without GroupBy. Only the groups leave the partition, the router and
+// the client merge the groups of the partitions.
+type Aggregation struct {
+	GroupBy []string `json:"group_by,omitempty"`
+	Metrics []Metric `json:"metrics,omitempty"`
+	// MaxGroups bounds the groups a partition keeps, more fail the search, 0 is unbounded
+	MaxGroups int `json:"max_groups,omitempty"`
+}
+
+// Metric is a count, sum, min or max of the values of a field, a count without field counts the
+// hits.
+type Metric struct {
+	Func  string `json:"func"`
+	Field string `json:"field,omitempty"`
+}
+
+// Bucket is a group of hits, Key holds the values of the GroupBy fields, null for a missing field,
+// and Values the values of the metrics in order: a count, a sum, the min or the max, null if no
+// hit of the group has a value of the field.
+type Bucket struct {
+	Key    []interface{} `json:"key"`
+	Values []interface{} `json:"values"`
+}
+
+// Aggregator folds the documents into the buckets of an aggregation.
+type Aggregator struct {
+	agg     *Aggregation
+	buckets map[string]*Bucket
+	order   []string
+}
+
+func NewAggregator(agg *Aggregation) (*Aggregator, error) {
+	for _, metric := range agg.Metrics {
+		switch metric.Func {
+		case MetricCount:
+		case MetricSum, MetricMin, MetricMax:
+			if metric.Field == "" {
+				return nil, fmt.Errorf("metric %s without field", metric.Func)
+			}
+		default:
+			return nil, fmt.Errorf("unknown metric %s", metric.Func)
+		}
+	}
+	return &Aggregator{agg: agg, buckets: make(map[string]*Bucket)}, nil
+}
+
+// Fields returns the fields the documents must have for the aggregation.
+func (a *Aggregator) Fields() []string {
+	seen := make(map[string]bool)
+	var fields []string
+	add := func(field string) {
+		if field != "" && !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+	for _, field := range a.agg.GroupBy {
+		add(field)
+	}
+	for _, metric := range a.agg.Metrics {
+		add(metric.Field)
+	}
+	return fields
+}
+
+// Add folds the document into its bucket.
+func (a *Aggregator) Add(doc map[string]interface{}) error {
+	key := make([]interface{}, len(a.agg.GroupBy))
+	for i, field := range a.agg.GroupBy {
+		key[i] = doc[field]
+	}
+	data, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	bucket, ok := a.buckets[string(data)]
+	if !ok {
+		if a.agg.MaxGroups > 0 && len(a.buckets) >= a.agg.MaxGroups {
+			return ErrTooManyGroups
+		}
+		bucket = &Bucket{Key: key, Values: make([]interface{}, len(a.agg.Metrics))}
+		for i, metric := range a.agg.Metrics {
+			if metric.Func == MetricCount {
+				bucket.Values[i] = int64(0)
+			}
+		}
+		a.buckets[string(data)] = bucket
+		a.order = append(a.order, string(data))
+	}
+
+	for i, metric := range a.agg.Metrics {
+		if metric.Field == "" {
+			bucket.Values[i] = bucket.Values[i].(int64) + 1
+			continue
+		}
+		v, ok := doc[metric.Field]
+		if !ok || v == nil {
+			continue
+		}
+		switch metric.Func {
+		case MetricCount:
+			bucket.Values[i] = bucket.Values[i].(int64) + 1
+		case MetricSum:
+			if n, ok := NumberOf(v); ok {
+				sum, _ := bucket.Values[i].(float64)
+				bucket.Values[i] = sum + n
+			}
+		case MetricMin:
+			if bucket.Values[i] == nil || CompareValues(v, bucket.Values[i]) < 0 {
+				bucket.Values[i] = v
+			}
+		case MetricMax:
+			if bucket.Values[i] == nil || CompareValues(v, bucket.Values[i]) > 0 {
+				bucket.Values[i] = v
+			}
+		}
+	}
+	return nil
+}
+
+// Buckets returns the buckets in the order of their first documents.
+func (a *Aggregator) Buckets() []*Bucket {
+	buckets := make([]*Bucket, len(a.order))
+	for i, key := range a.order {
+		buckets[i] = a.buckets[key]
+	}
+	return buckets
+}
+
+// NumberOf returns the value as a number, the numbers of the json of the documents and of the
+// index are of several types.
+func NumberOf(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		n, err := v.Float64()
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// CompareValues orders the numbers by value, before the other values ordered by their text.
+func CompareValues(a, b interface{}) int {
+	x, xok := NumberOf(a)
+	y, yok := NumberOf(b)
+	switch {
+	case xok && yok:
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case xok:
+		return -1
+	case yok:
+		return 1
+	}
+	return strings.Compare(textOf(a), textOf(b))
+}
+
+func textOf(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
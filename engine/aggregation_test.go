@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAggregator(t *testing.T) {
+	agg := &Aggregation{
+		GroupBy: []string{"city"},
+		Metrics: []Metric{{Func: MetricCount}, {Func: MetricCount, Field: "age"}, {Func: MetricSum, Field: "age"},
+			{Func: MetricMin, Field: "age"}, {Func: MetricMax, Field: "name"}},
+		MaxGroups: 3,
+	}
+	aggregator, err := NewAggregator(agg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields := aggregator.Fields(); len(fields) != 3 || fields[0] != "city" || fields[1] != "age" || fields[2] != "name" {
+		t.Fatalf("unexpected fields %v", fields)
+	}
+	docs := []map[string]interface{}{
+		{"city": "bj", "age": float64(20), "name": "a"},
+		{"city": "sz", "age": float64(30), "name": "c"},
+		{"city": "bj", "age": float64(10), "name": "b"},
+		{"city": "bj", "name": "a"},
+		{"age": float64(1)},
+	}
+	for _, doc := range docs {
+		if err := aggregator.Add(doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, _ := json.Marshal(aggregator.Buckets())
+	expect := `[{"key":["bj"],"values":[3,2,30,10,"b"]},{"key":["sz"],"values":[1,1,30,30,"c"]},{"key":[null],"values":[1,1,1,1,null]}]`
+	if string(data) != expect {
+		t.Fatalf("buckets %s, expect %s", data, expect)
+	}
+	if err := aggregator.Add(map[string]interface{}{"city": "sh"}); err != ErrTooManyGroups {
+		t.Fatalf("unexpected error of too many groups: %v", err)
+	}
+
+	if _, err := NewAggregator(&Aggregation{Metrics: []Metric{{Func: MetricSum}}}); err == nil {
+		t.Fatal("sum without field")
+	}
+	if CompareValues(float64(2), json.Number("10")) >= 0 || CompareValues(float64(2), "1") >= 0 || CompareValues("a", "b") >= 0 {
+		t.Fatal("unexpected order")
+	}
+}
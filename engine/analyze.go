@@ -0,0 +1,37 @@
+package engine
+
+// AnalyzeRequest analyzes the text by the analyzer, by the tokenizer with the filters or by the
+// analyzer of the field in the mapping of the space, in this order, the default analyzer of the
+// mapping without any. The names are the ones of the analysis of the space or registered.
+type AnalyzeRequest struct {
+	Text        string   `json:"text"`
+	Analyzer    string   `json:"analyzer,omitempty"`
+	Tokenizer   string   `json:"tokenizer,omitempty"`
+	CharFilters []string `json:"char_filter,omitempty"`
+	Filters     []string `json:"filter,omitempty"`
+	Field       string   `json:"field,omitempty"`
+	// with explain the result has the text after every char filter and the tokens after the
+	// tokenizer and every token filter
+	Explain bool `json:"explain,omitempty"`
+}
+
+type AnalyzeToken struct {
+	Term     string `json:"term"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+	Position int    `json:"position"`
+	Type     string `json:"type"`
+}
+
+// AnalyzeStep is the text a char filter left or the tokens a tokenizer or a token filter left.
+type AnalyzeStep struct {
+	Name   string          `json:"name"`
+	Text   string          `json:"text,omitempty"`
+	Tokens []*AnalyzeToken `json:"tokens,omitempty"`
+}
+
+type AnalyzeResult struct {
+	Analyzer string          `json:"analyzer,omitempty"`
+	Tokens   []*AnalyzeToken `json:"tokens"`
+	Explain  []*AnalyzeStep  `json:"explain,omitempty"`
+}
@@ -0,0 +1,649 @@
+package bleve
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/analysis/char/asciifolding"
+	"github.com/blevesearch/bleve/analysis/lang/ar"
+	"github.com/blevesearch/bleve/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/analysis/lang/da"
+	"github.com/blevesearch/bleve/analysis/lang/de"
+	"github.com/blevesearch/bleve/analysis/lang/en"
+	"github.com/blevesearch/bleve/analysis/lang/es"
+	"github.com/blevesearch/bleve/analysis/lang/fi"
+	"github.com/blevesearch/bleve/analysis/lang/fr"
+	"github.com/blevesearch/bleve/analysis/lang/hu"
+	"github.com/blevesearch/bleve/analysis/lang/it"
+	"github.com/blevesearch/bleve/analysis/lang/nl"
+	"github.com/blevesearch/bleve/analysis/lang/no"
+	"github.com/blevesearch/bleve/analysis/lang/pt"
+	"github.com/blevesearch/bleve/analysis/lang/ro"
+	"github.com/blevesearch/bleve/analysis/lang/ru"
+	"github.com/blevesearch/bleve/analysis/lang/sv"
+	"github.com/blevesearch/bleve/analysis/lang/tr"
+	"github.com/blevesearch/bleve/analysis/token/edgengram"
+	"github.com/blevesearch/bleve/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/analysis/token/ngram"
+	"github.com/blevesearch/bleve/analysis/token/porter"
+	"github.com/blevesearch/bleve/analysis/token/shingle"
+	"github.com/blevesearch/bleve/analysis/token/stop"
+	"github.com/blevesearch/bleve/analysis/tokenizer/character"
+	"github.com/blevesearch/bleve/analysis/tokenizer/single"
+	bleveunicode "github.com/blevesearch/bleve/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/analysis/tokenmap"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/registry"
+	"github.com/tiglabs/baudengine/util/dict"
+)
+
+// Analysis is the analysis a space declares in its schema, the one the kernel builds too, see
+// engine/kernel/registry.Analysis. The building blocks of the kernel are mapped to the ones of
+// bleve, the character filter and tokenizer and the chinese tokenizer are registered below.
+type Analysis struct {
+	CharFilters map[string]map[string]interface{} `json:"char_filter,omitempty"`
+	Tokenizers  map[string]map[string]interface{} `json:"tokenizer,omitempty"`
+	Filters     map[string]map[string]interface{} `json:"filter,omitempty"`
+	Analyzers   map[string]map[string]interface{} `json:"analyzer,omitempty"`
+}
+
+const (
+	characterName = "baud_character"
+	// bleve has no dictionary of chinese, the text of han is indexed by bigrams
+	chineseName      = "baud_chinese"
+	ngramName        = "baud_ngram"
+	edgeNgramName    = "baud_edge_ngram"
+	asciiFoldingName = "baud_asciifolding"
+	dictStopName     = "baud_dict_stop"
+)
+
+// the names of bleve of the building blocks the kernel registers
+var (
+	charFilterNames = map[string]string{"character": characterName}
+	tokenizerNames  = map[string]string{
+		"unicode":    bleveunicode.Name,
+		"keyword":    single.Name,
+		"character":  characterName,
+		"chinese":    chineseName,
+		"ngram":      ngramName,
+		"edge_ngram": edgeNgramName,
+	}
+)
+
+// the token filters of the types of the kernel, defined in the index mapping
+var tokenFilterTypes = map[string]func(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error{
+	"lower": func(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error {
+		return im.AddCustomTokenFilter(name, map[string]interface{}{"type": lowercase.Name})
+	},
+	"stop":         addStopFilter,
+	"stemmer":      addStemmer,
+	"ngram":        addNgramFilter,
+	"edge_ngram":   addNgramFilter,
+	"shingle":      addShingleFilter,
+	"asciifolding": addAsciiFoldingFilter,
+	"synonym": func(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error {
+		return fmt.Errorf("the synonyms are not supported by the bleve engine")
+	},
+}
+
+// the token filters the kernel registers, of their types with the default parameters
+var tokenFilters = map[string]map[string]interface{}{
+	"lower":        {"type": "lower"},
+	"stop":         {"type": "stop"},
+	"stemmer":      {"type": "stemmer"},
+	"porter":       {"type": "stemmer", "language": "porter"},
+	"ngram":        {"type": "ngram"},
+	"edge_ngram":   {"type": "edge_ngram"},
+	"shingle":      {"type": "shingle"},
+	"asciifolding": {"type": "asciifolding"},
+}
+
+// the stemmers of bleve of the languages of the kernel
+var stemmers = map[string]string{
+	"arabic":     ar.StemmerName,
+	"danish":     da.SnowballStemmerName,
+	"dutch":      nl.SnowballStemmerName,
+	"english":    en.SnowballStemmerName,
+	"finnish":    fi.SnowballStemmerName,
+	"french":     fr.SnowballStemmerName,
+	"german":     de.SnowballStemmerName,
+	"hungarian":  hu.SnowballStemmerName,
+	"italian":    it.SnowballStemmerName,
+	"norwegian":  no.SnowballStemmerName,
+	"porter":     porter.Name,
+	"portuguese": pt.LightStemmerName,
+	"romanian":   ro.SnowballStemmerName,
+	"russian":    ru.SnowballStemmerName,
+	"spanish":    es.SnowballStemmerName,
+	"swedish":    sv.SnowballStemmerName,
+	"turkish":    tr.SnowballStemmerName,
+}
+
+// ParseAnalysis returns the analysis of the schema, nil if it declares none.
+func ParseAnalysis(schema []byte) (*Analysis, error) {
+	tmp := struct {
+		Analysis *Analysis `json:"analysis"`
+	}{}
+	if err := json.Unmarshal(schema, &tmp); err != nil {
+		return nil, err
+	}
+	return tmp.Analysis, nil
+}
+
+// apply defines the analysis in the custom analysis of the index mapping.
+func (a *Analysis) apply(im *mapping.IndexMappingImpl) error {
+	for name, config := range a.CharFilters {
+		typ, ok := charFilterNames[configType(config)]
+		if !ok {
+			return fmt.Errorf("char filter %s: unknown type %v", name, config["type"])
+		}
+		if err := im.AddCustomCharFilter(name, withType(config, typ)); err != nil {
+			return fmt.Errorf("char filter %s: %v", name, err)
+		}
+	}
+	for name, config := range a.Tokenizers {
+		typ, ok := tokenizerNames[configType(config)]
+		if !ok {
+			return fmt.Errorf("tokenizer %s: unknown type %v", name, config["type"])
+		}
+		if err := im.AddCustomTokenizer(name, withType(config, typ)); err != nil {
+			return fmt.Errorf("tokenizer %s: %v", name, err)
+		}
+	}
+	for name, config := range a.Filters {
+		add, ok := tokenFilterTypes[configType(config)]
+		if !ok {
+			return fmt.Errorf("filter %s: unknown type %v", name, config["type"])
+		}
+		if err := add(im, name, config); err != nil {
+			return fmt.Errorf("filter %s: %v", name, err)
+		}
+	}
+	for name, config := range a.Analyzers {
+		if err := a.addAnalyzer(im, name, config); err != nil {
+			return fmt.Errorf("analyzer %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// addStopFilter adds the stop filter of the stopwords or of the stop word dictionary of the
+// cluster, of the english ones without them.
+func addStopFilter(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error {
+	if dictName, ok := config["stopwords_dict"]; ok {
+		if _, ok := dictName.(string); !ok {
+			return fmt.Errorf("stopwords_dict: %v is not a string", dictName)
+		}
+		return im.AddCustomTokenFilter(name, map[string]interface{}{"type": dictStopName, "dict": dictName})
+	}
+	words, ok := config["stopwords"]
+	if !ok {
+		return im.AddCustomTokenFilter(name, map[string]interface{}{"type": stop.Name, "stop_token_map": en.StopName})
+	}
+	tokens, err := stringList(words)
+	if err != nil {
+		return fmt.Errorf("stopwords: %v", err)
+	}
+	list := make([]interface{}, len(tokens))
+	for i, token := range tokens {
+		list[i] = token
+	}
+	// the token map is of the filter only
+	mapName := name + "_stopwords"
+	if err := im.AddCustomTokenMap(mapName, map[string]interface{}{"type": tokenmap.Name, "tokens": list}); err != nil {
+		return err
+	}
+	return im.AddCustomTokenFilter(name, map[string]interface{}{"type": stop.Name, "stop_token_map": mapName})
+}
+
+// addAnalyzer adds a custom analyzer of the blocks of the space or the registered ones, an
+// analyzer of another type is the registered one of the type.
+func (a *Analysis) addAnalyzer(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error {
+	typ := configType(config)
+	if typ != "custom" {
+		return im.AddCustomAnalyzer(name, map[string]interface{}{"type": typ})
+	}
+	tokenizer, ok := config["tokenizer"].(string)
+	if !ok {
+		return fmt.Errorf("tokenizer %v is not a name", config["tokenizer"])
+	}
+	charFilters, err := stringList(config["char_filter"])
+	if err != nil {
+		return fmt.Errorf("char_filter: %v", err)
+	}
+	filters, err := stringList(config["filter"])
+	if err != nil {
+		return fmt.Errorf("filter: %v", err)
+	}
+	for i, filter := range charFilters {
+		charFilters[i] = a.blockName(a.CharFilters, charFilterNames, filter)
+	}
+	for i, filter := range filters {
+		if filters[i], err = a.tokenFilterName(im, filter); err != nil {
+			return err
+		}
+	}
+	return im.AddCustomAnalyzer(name, map[string]interface{}{
+		"type":          custom.Name,
+		"char_filters":  charFilters,
+		"tokenizer":     a.blockName(a.Tokenizers, tokenizerNames, tokenizer),
+		"token_filters": filters,
+	})
+}
+
+// blockName returns the name of bleve of a block an analyzer names, the blocks of the space keep
+// their names.
+func (a *Analysis) blockName(declared map[string]map[string]interface{}, names map[string]string, name string) string {
+	if _, ok := declared[name]; ok {
+		return name
+	}
+	if blockName, ok := names[name]; ok {
+		return blockName
+	}
+	return name
+}
+
+// tokenFilterName returns the name of bleve of a token filter an analyzer names, a filter the
+// kernel registers is defined of its type on its first use.
+func (a *Analysis) tokenFilterName(im *mapping.IndexMappingImpl, name string) (string, error) {
+	if _, ok := a.Filters[name]; ok {
+		return name, nil
+	}
+	config, ok := tokenFilters[name]
+	if !ok {
+		return name, nil
+	}
+	defined := "baud_" + name
+	if _, ok := im.CustomAnalysis.TokenFilters[defined]; ok {
+		return defined, nil
+	}
+	if err := tokenFilterTypes[configType(config)](im, defined, config); err != nil {
+		return "", fmt.Errorf("filter %s: %v", name, err)
+	}
+	return defined, nil
+}
+
+func addStemmer(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error {
+	language := "english"
+	if v, ok := config["language"]; ok {
+		if language, ok = v.(string); !ok {
+			return fmt.Errorf("language %v is not a string", v)
+		}
+	}
+	stemmer, ok := stemmers[language]
+	if !ok {
+		return fmt.Errorf("no stemmer of the language %q", language)
+	}
+	return im.AddCustomTokenFilter(name, map[string]interface{}{"type": stemmer})
+}
+
+func addNgramFilter(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error {
+	min, max, err := gramSizes(config, "min_gram", "max_gram", 1, 2)
+	if err != nil {
+		return err
+	}
+	if configType(config) == "edge_ngram" {
+		return im.AddCustomTokenFilter(name, map[string]interface{}{"type": edgengram.Name, "min": min, "max": max})
+	}
+	return im.AddCustomTokenFilter(name, map[string]interface{}{"type": ngram.Name, "min": min, "max": max})
+}
+
+func addShingleFilter(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error {
+	min, max, err := gramSizes(config, "min_shingle_size", "max_shingle_size", 2, 2)
+	if err != nil {
+		return err
+	}
+	shingleConfig := map[string]interface{}{"type": shingle.Name, "min": min, "max": max,
+		"output_original": true, "separator": " ", "filler": "_"}
+	for param, bleveParam := range map[string]string{"output_unigrams": "output_original", "token_separator": "separator", "filler_token": "filler"} {
+		if v, ok := config[param]; ok {
+			shingleConfig[bleveParam] = v
+		}
+	}
+	return im.AddCustomTokenFilter(name, shingleConfig)
+}
+
+func addAsciiFoldingFilter(im *mapping.IndexMappingImpl, name string, config map[string]interface{}) error {
+	preserve, ok := config["preserve_original"]
+	if !ok {
+		preserve = false
+	}
+	return im.AddCustomTokenFilter(name, map[string]interface{}{"type": asciiFoldingName, "preserve_original": preserve})
+}
+
+// gramSizes returns the sizes of the parameters, as the floats bleve reads.
+func gramSizes(config map[string]interface{}, minParam, maxParam string, min, max float64) (float64, float64, error) {
+	for param, size := range map[string]*float64{minParam: &min, maxParam: &max} {
+		switch v := config[param].(type) {
+		case nil:
+		case float64:
+			*size = v
+		case int:
+			*size = float64(v)
+		default:
+			return 0, 0, fmt.Errorf("%s %v is not an integer", param, v)
+		}
+	}
+	if min < 1 || max < min {
+		return 0, 0, fmt.Errorf("invalid sizes %v to %v", min, max)
+	}
+	return min, max, nil
+}
+
+func configType(config map[string]interface{}) string {
+	typ, _ := config["type"].(string)
+	return typ
+}
+
+// withType returns a copy of the config of the type.
+func withType(config map[string]interface{}, typ string) map[string]interface{} {
+	out := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		out[k] = v
+	}
+	out["type"] = typ
+	return out
+}
+
+// stringList returns a string or a list of strings as a list.
+func stringList(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return append([]string(nil), v...), nil
+	case []interface{}:
+		list := make([]string, len(v))
+		for i, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("%v is not a list of strings", v)
+			}
+			list[i] = s
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("%v is not a list of strings", v)
+}
+
+// the classes of the characters, as the kernel names them
+var charClasses = map[string]func(rune) bool{
+	"letter":      unicode.IsLetter,
+	"digit":       unicode.IsDigit,
+	"whitespace":  unicode.IsSpace,
+	"punctuation": unicode.IsPunct,
+	"symbol":      unicode.IsSymbol,
+}
+
+// charsOf returns the function telling whether a character is of the classes of the parameter
+// or of its chars, of the classes of def without them.
+func charsOf(config map[string]interface{}, classesParam string, def ...string) (func(rune) bool, error) {
+	names, err := stringList(config[classesParam])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", classesParam, err)
+	}
+	chars, _ := config["chars"].(string)
+	if len(names) == 0 && chars == "" {
+		names = def
+	}
+	var funcs []func(rune) bool
+	for _, name := range names {
+		f, ok := charClasses[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown character class %q", name)
+		}
+		funcs = append(funcs, f)
+	}
+	return func(r rune) bool {
+		for _, f := range funcs {
+			if f(r) {
+				return true
+			}
+		}
+		return strings.ContainsRune(chars, r)
+	}, nil
+}
+
+// characterCharFilter removes the characters of the classes and the chars from the text.
+type characterCharFilter struct {
+	remove func(rune) bool
+}
+
+func (f *characterCharFilter) Filter(input []byte) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if f.remove(r) {
+			return -1
+		}
+		return r
+	}, string(input)))
+}
+
+func characterCharFilterConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.CharFilter, error) {
+	remove, err := charsOf(config, "classes", "punctuation")
+	if err != nil {
+		return nil, err
+	}
+	return &characterCharFilter{remove: remove}, nil
+}
+
+func characterTokenizerConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.Tokenizer, error) {
+	in, err := charsOf(config, "token_chars", "letter", "digit")
+	if err != nil {
+		return nil, err
+	}
+	return character.NewCharacterTokenizer(in), nil
+}
+
+// chineseTokenizer tokenizes the text by unicode, the runs of han into bigrams. The words of the
+// user dictionary of the cluster are kept whole, the version stored when the text is tokenized.
+type chineseTokenizer struct {
+	tokenizer analysis.Tokenizer
+	bigram    analysis.TokenFilter
+	userDict  string
+}
+
+func (t *chineseTokenizer) Tokenize(input []byte) analysis.TokenStream {
+	stream := t.tokenizer.Tokenize(input)
+	if t.userDict != "" {
+		if d := dict.Load(t.userDict); d != nil && d.MaxLen() > 1 {
+			stream = userWords(stream, d)
+		}
+	}
+	return t.bigram.Filter(stream)
+}
+
+// userWords merges the longest runs of han in the dictionary into words, from the left.
+func userWords(input analysis.TokenStream, d *dict.Dict) analysis.TokenStream {
+	output := make(analysis.TokenStream, 0, len(input))
+	for i := 0; i < len(input); {
+		// the run of han of the token, of the adjacent ones
+		end := i
+		for end < len(input) && input[end].Type == analysis.Ideographic && (end == i || input[end-1].End == input[end].Start) {
+			end++
+		}
+		n := 0
+		for k := end - i; k > 1; k-- {
+			if k > d.MaxLen() {
+				continue
+			}
+			var word []byte
+			for _, token := range input[i : i+k] {
+				word = append(word, token.Term...)
+			}
+			if d.Contains(string(word)) {
+				// a word is not bigrammed
+				output = append(output, &analysis.Token{
+					Start:    input[i].Start,
+					End:      input[i+k-1].End,
+					Term:     word,
+					Position: input[i].Position,
+					Type:     analysis.AlphaNumeric,
+				})
+				n = k
+				break
+			}
+		}
+		if n == 0 {
+			output = append(output, input[i])
+			n = 1
+		}
+		i += n
+	}
+	return output
+}
+
+func chineseTokenizerConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.Tokenizer, error) {
+	t := &chineseTokenizer{tokenizer: bleveunicode.NewUnicodeTokenizer(), bigram: cjk.NewCJKBigramFilter(false)}
+	if userDict, ok := config["user_dict"]; ok {
+		if t.userDict, ok = userDict.(string); !ok {
+			return nil, fmt.Errorf("user_dict: %v is not a string", userDict)
+		}
+	}
+	return t, nil
+}
+
+// dictStopFilter removes the words of the stop word dictionary of the cluster, the version stored
+// when the tokens are filtered.
+type dictStopFilter struct {
+	dict string
+}
+
+func (f *dictStopFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	d := dict.Load(f.dict)
+	if d == nil {
+		return input
+	}
+	output := input[:0]
+	for _, token := range input {
+		if !d.Contains(string(token.Term)) {
+			output = append(output, token)
+		}
+	}
+	return output
+}
+
+func dictStopFilterConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.TokenFilter, error) {
+	name, _ := config["dict"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("no stop word dictionary")
+	}
+	return &dictStopFilter{dict: name}, nil
+}
+
+// ngramTokenizer tokenizes the words of the text into their n-grams, each at the next position,
+// the words are the runs of the token chars, the whole text without them.
+type ngramTokenizer struct {
+	min, max int
+	edge     bool
+	in       func(rune) bool
+}
+
+func (t *ngramTokenizer) Tokenize(input []byte) analysis.TokenStream {
+	var stream analysis.TokenStream
+	position := 1
+	var offsets []int
+	var runes []rune
+	word := func(end int) {
+		if len(runes) == 0 {
+			return
+		}
+		offsets = append(offsets, end)
+		for start := 0; start < len(runes); start++ {
+			for n := t.min; n <= t.max && start+n <= len(runes); n++ {
+				stream = append(stream, &analysis.Token{
+					Start:    offsets[start],
+					End:      offsets[start+n],
+					Term:     []byte(string(runes[start : start+n])),
+					Position: position,
+					Type:     analysis.AlphaNumeric,
+				})
+				position++
+			}
+			if t.edge {
+				break
+			}
+		}
+		offsets, runes = offsets[:0], runes[:0]
+	}
+	end := len(input)
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRune(input[i:])
+		if r == utf8.RuneError {
+			end = i
+			break
+		}
+		if t.in == nil || t.in(r) {
+			offsets = append(offsets, i)
+			runes = append(runes, r)
+		} else {
+			word(i)
+		}
+		i += size
+	}
+	word(end)
+	return stream
+}
+
+func ngramTokenizerConstructor(edge bool) registry.TokenizerConstructor {
+	return func(config map[string]interface{}, cache *registry.Cache) (analysis.Tokenizer, error) {
+		min, max, err := gramSizes(config, "min_gram", "max_gram", 1, 2)
+		if err != nil {
+			return nil, err
+		}
+		t := &ngramTokenizer{min: int(min), max: int(max), edge: edge}
+		if config["token_chars"] != nil || config["chars"] != nil {
+			if t.in, err = charsOf(config, "token_chars"); err != nil {
+				return nil, err
+			}
+		}
+		return t, nil
+	}
+}
+
+// asciiFoldingFilter folds the terms to ascii, the folded term is added at the position of the
+// original one when it is preserved.
+type asciiFoldingFilter struct {
+	folding          *asciifolding.AsciiFoldingFilter
+	preserveOriginal bool
+}
+
+func (f *asciiFoldingFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	output := make(analysis.TokenStream, 0, len(input))
+	for _, token := range input {
+		folded := f.folding.Filter(token.Term)
+		if !f.preserveOriginal {
+			token.Term = folded
+			output = append(output, token)
+			continue
+		}
+		output = append(output, token)
+		if string(folded) != string(token.Term) {
+			foldedToken := *token
+			foldedToken.Term = folded
+			output = append(output, &foldedToken)
+		}
+	}
+	return output
+}
+
+func asciiFoldingFilterConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.TokenFilter, error) {
+	preserve, _ := config["preserve_original"].(bool)
+	return &asciiFoldingFilter{folding: asciifolding.New(), preserveOriginal: preserve}, nil
+}
+
+func init() {
+	registry.RegisterCharFilter(characterName, characterCharFilterConstructor)
+	registry.RegisterTokenizer(characterName, characterTokenizerConstructor)
+	registry.RegisterTokenizer(chineseName, chineseTokenizerConstructor)
+	registry.RegisterTokenizer(ngramName, ngramTokenizerConstructor(false))
+	registry.RegisterTokenizer(edgeNgramName, ngramTokenizerConstructor(true))
+	registry.RegisterTokenFilter(asciiFoldingName, asciiFoldingFilterConstructor)
+	registry.RegisterTokenFilter(dictStopName, dictStopFilterConstructor)
+}
@@ -0,0 +1,191 @@
+package bleve
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/util/dict"
+)
+
+func analyzed(t *testing.T, schema, analyzer, text string) string {
+	a, err := ParseAnalysis([]byte(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapping := bleve.NewIndexMapping()
+	if err := a.apply(mapping); err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := mapping.AnalyzeText(analyzer, []byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var terms []string
+	for _, token := range tokens {
+		terms = append(terms, string(token.Term))
+	}
+	return strings.Join(terms, " ")
+}
+
+func TestAnalysis(t *testing.T) {
+	schema := `{
+  "analysis": {
+    "char_filter": {"no_dash": {"type": "character", "chars": "-"}},
+    "tokenizer": {"words": {"type": "character", "token_chars": ["letter", "digit"]}},
+    "filter": {"brand_stop": {"type": "stop", "stopwords": ["the", "of"]}},
+    "analyzer": {
+      "product": {"type": "custom", "char_filter": ["no_dash"], "tokenizer": "words", "filter": ["lower", "brand_stop"]},
+      "code": {"type": "custom", "tokenizer": "keyword", "filter": "lower"}
+    }
+  },
+  "mappings": {
+    "baud": {
+      "properties": {
+        "name": {"type": "text", "analyzer": "product"}
+      }
+    }
+  }
+}`
+	if text := analyzed(t, schema, "product", "The Wi-Fi Router of AX3000!"); text != "wifi router ax3000" {
+		t.Fatalf("product analyzed to %q", text)
+	}
+	if text := analyzed(t, schema, "code", "AB-12 x"); text != "ab-12 x" {
+		t.Fatalf("code analyzed to %q", text)
+	}
+
+	filters := `{
+  "analysis": {
+    "tokenizer": {"grams": {"type": "edge_ngram", "min_gram": 1, "max_gram": 3, "token_chars": ["letter"]}},
+    "filter": {"folding": {"type": "asciifolding", "preserve_original": true}},
+    "analyzer": {
+      "english": {"type": "custom", "tokenizer": "unicode", "filter": ["lower", "stemmer"]},
+      "prefix": {"type": "custom", "tokenizer": "grams"},
+      "folded": {"type": "custom", "tokenizer": "unicode", "filter": ["folding"]},
+      "phrases": {"type": "custom", "tokenizer": "unicode", "filter": ["shingle"]}
+    }
+  }
+}`
+	if text := analyzed(t, filters, "english", "Running Foxes"); text != "run fox" {
+		t.Fatalf("english analyzed to %q", text)
+	}
+	if text := analyzed(t, filters, "prefix", "Fox 9 den"); text != "F Fo Fox d de den" {
+		t.Fatalf("prefix analyzed to %q", text)
+	}
+	if text := analyzed(t, filters, "folded", "crème brûlée"); text != "crème creme brûlée brulee" {
+		t.Fatalf("folded analyzed to %q", text)
+	}
+	if text := analyzed(t, filters, "phrases", "quick brown fox"); text != "quick brown quick brown fox brown fox" {
+		t.Fatalf("phrases analyzed to %q", text)
+	}
+
+	clear()
+	defer clear()
+	index := blever(t, schema)
+	index.Close()
+
+	for _, bad := range []string{
+		`{"analysis": {"tokenizer": {"t": {"type": "unknown"}}}}`,
+		`{"analysis": {"tokenizer": {"t": {"type": "character", "token_chars": ["vowel"]}}}}`,
+		`{"analysis": {"analyzer": {"a": {"type": "custom", "tokenizer": "missing"}}}}`,
+		`{"analysis": {"analyzer": {"a": {"type": "custom", "tokenizer": "keyword", "filter": [1]}}}}`,
+		`{"analysis": {"filter": {"f": {"type": "stemmer", "language": "klingon"}}}}`,
+		`{"analysis": {"filter": {"f": {"type": "ngram", "min_gram": 3, "max_gram": 2}}}}`,
+		`{"analysis": {"filter": {"f": {"type": "synonym", "synonyms": ["a, b"]}}}}`,
+	} {
+		a, err := ParseAnalysis([]byte(bad))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := a.apply(bleve.NewIndexMapping()); err == nil {
+			t.Errorf("%s: applied", bad)
+		}
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	schema := `{
+  "analysis": {
+    "char_filter": {"no_dash": {"type": "character", "chars": "-"}},
+    "analyzer": {
+      "product": {"type": "custom", "char_filter": ["no_dash"], "tokenizer": "unicode", "filter": ["lower", "stemmer"]}
+    }
+  },
+  "mappings": {
+    "baud": {
+      "properties": {
+        "name": {"type": "text", "analyzer": "product"}
+      }
+    }
+  }
+}`
+	analyzer, err := NewAnalyzer([]byte(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := analyzer.Analyze(&engine.AnalyzeRequest{Text: "Wi-Fi Routers", Field: "name", Explain: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Analyzer != "product" || len(result.Tokens) != 2 {
+		t.Fatalf("%+v", result)
+	}
+	if token := result.Tokens[1]; token.Term != "router" || token.Start != 5 || token.End != 12 || token.Position != 2 || token.Type != "alphanumeric" {
+		t.Fatalf("%+v", token)
+	}
+	var steps []string
+	for _, step := range result.Explain {
+		var terms []string
+		for _, token := range step.Tokens {
+			terms = append(terms, token.Term)
+		}
+		steps = append(steps, step.Name+":"+step.Text+strings.Join(terms, ","))
+	}
+	if text := strings.Join(steps, " "); text != "no_dash:WiFi Routers unicode:WiFi,Routers lower:wifi,routers stemmer:wifi,router" {
+		t.Fatalf("explained %s", text)
+	}
+
+	result, err = analyzer.Analyze(&engine.AnalyzeRequest{Text: "Wi-Fi", Tokenizer: "keyword", CharFilters: []string{"no_dash"}, Filters: []string{"lower"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Analyzer != "" || len(result.Tokens) != 1 || result.Tokens[0].Term != "wifi" {
+		t.Fatalf("%+v", result)
+	}
+	if _, err := analyzer.Analyze(&engine.AnalyzeRequest{Text: "x", Analyzer: "missing"}); err == nil {
+		t.Fatal("unknown analyzer")
+	}
+
+	// the registered analysis only
+	analyzer, _ = NewAnalyzer(nil)
+	if result, err = analyzer.Analyze(&engine.AnalyzeRequest{Text: "The Foxes", Analyzer: "en"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Tokens) != 1 || result.Tokens[0].Term != "fox" || result.Tokens[0].Position != 2 {
+		t.Fatalf("%+v", result.Tokens)
+	}
+}
+
+func TestDictionaries(t *testing.T) {
+	schema := `{
+  "analysis": {
+    "tokenizer": {"zh": {"type": "chinese", "user_dict": "test_brands"}},
+    "filter": {"zh_stop": {"type": "stop", "stopwords_dict": "test_stop"}},
+    "analyzer": {"product": {"type": "custom", "tokenizer": "zh", "filter": ["zh_stop"]}}
+  }
+}`
+	if text := analyzed(t, schema, "product", "的小米手机"); text != "的小 小米 米手 手机" {
+		t.Fatalf("product analyzed to %q", text)
+	}
+	dict.Store(dict.New("test_brands", 1, []string{"小米手机"}))
+	dict.Store(dict.New("test_stop", 1, []string{"的"}))
+	if text := analyzed(t, schema, "product", "的小米手机"); text != "小米手机" {
+		t.Fatalf("product analyzed to %q", text)
+	}
+	// the analyzers follow the version stored
+	dict.Store(dict.New("test_brands", 2, []string{"小米"}))
+	if text := analyzed(t, schema, "product", "的小米手机"); text != "小米 手机" {
+		t.Fatalf("product analyzed to %q", text)
+	}
+}
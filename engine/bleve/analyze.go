@@ -0,0 +1,181 @@
+package bleve
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+// adhocAnalyzer is the name of the analyzer of the tokenizer and the filters of a request
+const adhocAnalyzer = "_analyze"
+
+// Analyzer analyzes texts as the index of the schema does, the router runs it for the _analyze
+// API without a partition.
+type Analyzer struct {
+	schema   []byte
+	mapping  *mapping.IndexMappingImpl
+	analysis *Analysis
+}
+
+// NewAnalyzer returns the analyzer of the schema, of the registered analysis only for an empty
+// schema.
+func NewAnalyzer(schema []byte) (*Analyzer, error) {
+	if len(schema) == 0 {
+		return &Analyzer{mapping: bleve.NewIndexMapping()}, nil
+	}
+	im, analysis, err := newIndexMapping(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &Analyzer{schema: schema, mapping: im, analysis: analysis}, nil
+}
+
+func (a *Analyzer) Analyze(req *engine.AnalyzeRequest) (*engine.AnalyzeResult, error) {
+	im, name, err := a.analyzerOf(req)
+	if err != nil {
+		return nil, err
+	}
+	analyzer := im.AnalyzerNamed(name)
+	if analyzer == nil {
+		return nil, fmt.Errorf("unknown analyzer %q", name)
+	}
+	result := &engine.AnalyzeResult{Analyzer: name}
+	if name == adhocAnalyzer {
+		result.Analyzer = ""
+	}
+	text := []byte(req.Text)
+	if !req.Explain {
+		result.Tokens = analyzeTokens(analyzer.Analyze(text))
+		return result, nil
+	}
+
+	charFilters, tokenizer, filters := a.blockNames(req, name, analyzer)
+	for i, filter := range analyzer.CharFilters {
+		text = filter.Filter(text)
+		result.Explain = append(result.Explain, &engine.AnalyzeStep{Name: charFilters[i], Text: string(text)})
+	}
+	tokens := analyzer.Tokenizer.Tokenize(text)
+	result.Explain = append(result.Explain, &engine.AnalyzeStep{Name: tokenizer, Tokens: analyzeTokens(tokens)})
+	for i, filter := range analyzer.TokenFilters {
+		tokens = filter.Filter(tokens)
+		result.Explain = append(result.Explain, &engine.AnalyzeStep{Name: filters[i], Tokens: analyzeTokens(tokens)})
+	}
+	result.Tokens = analyzeTokens(tokens)
+	return result, nil
+}
+
+// analyzerOf returns the mapping and the name of the analyzer of the request, the tokenizer and
+// the filters are an analyzer of a mapping of their own.
+func (a *Analyzer) analyzerOf(req *engine.AnalyzeRequest) (*mapping.IndexMappingImpl, string, error) {
+	switch {
+	case req.Analyzer != "":
+		return a.mapping, req.Analyzer, nil
+	case req.Tokenizer != "":
+		im, analysis := bleve.NewIndexMapping(), a.analysis
+		if len(a.schema) > 0 {
+			var err error
+			if im, analysis, err = newIndexMapping(a.schema); err != nil {
+				return nil, "", err
+			}
+		}
+		if analysis == nil {
+			analysis = &Analysis{}
+		}
+		config := map[string]interface{}{
+			"type":        "custom",
+			"char_filter": req.CharFilters,
+			"tokenizer":   req.Tokenizer,
+			"filter":      req.Filters,
+		}
+		if err := analysis.addAnalyzer(im, adhocAnalyzer, config); err != nil {
+			return nil, "", err
+		}
+		return im, adhocAnalyzer, nil
+	case req.Field != "":
+		return a.mapping, a.fieldAnalyzer(req.Field), nil
+	}
+	return a.mapping, a.mapping.DefaultAnalyzer, nil
+}
+
+// fieldAnalyzer returns the analyzer of the field of the path, ParseSchema keeps the fields of a
+// document by their names.
+func (a *Analyzer) fieldAnalyzer(path string) string {
+	doc := a.mapping.DefaultMapping
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		if doc = doc.Properties[name]; doc == nil {
+			return a.mapping.AnalyzerNameForPath(path)
+		}
+	}
+	for _, field := range doc.Fields {
+		if field.Name == names[len(names)-1] && field.Analyzer != "" {
+			return field.Analyzer
+		}
+	}
+	return a.mapping.AnalyzerNameForPath(path)
+}
+
+// blockNames returns the names of the blocks of the analyzer, as the analysis of the space or
+// the request names them, of their types for the analyzers of bleve.
+func (a *Analyzer) blockNames(req *engine.AnalyzeRequest, name string, custom *analysis.Analyzer) (charFilters []string, tokenizer string, filters []string) {
+	var config map[string]interface{}
+	switch {
+	case name == adhocAnalyzer:
+		config = map[string]interface{}{"char_filter": req.CharFilters, "tokenizer": req.Tokenizer, "filter": req.Filters}
+	case a.analysis != nil:
+		config = a.analysis.Analyzers[name]
+	}
+	charFilters, _ = stringList(config["char_filter"])
+	tokenizer, _ = config["tokenizer"].(string)
+	filters, _ = stringList(config["filter"])
+	if len(charFilters) != len(custom.CharFilters) || tokenizer == "" || len(filters) != len(custom.TokenFilters) {
+		charFilters, filters = nil, nil
+		for _, filter := range custom.CharFilters {
+			charFilters = append(charFilters, typeName(filter))
+		}
+		tokenizer = typeName(custom.Tokenizer)
+		for _, filter := range custom.TokenFilters {
+			filters = append(filters, typeName(filter))
+		}
+	}
+	return
+}
+
+func typeName(v interface{}) string {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+var tokenTypes = map[analysis.TokenType]string{
+	analysis.AlphaNumeric: "alphanumeric",
+	analysis.Ideographic:  "ideographic",
+	analysis.Numeric:      "numeric",
+	analysis.DateTime:     "datetime",
+	analysis.Shingle:      "shingle",
+	analysis.Single:       "single",
+	analysis.Double:       "double",
+	analysis.Boolean:      "boolean",
+}
+
+// analyzeTokens copies the tokens, the filters change them in place.
+func analyzeTokens(stream analysis.TokenStream) []*engine.AnalyzeToken {
+	tokens := make([]*engine.AnalyzeToken, 0, len(stream))
+	for _, token := range stream {
+		tokens = append(tokens, &engine.AnalyzeToken{
+			Term:     string(token.Term),
+			Start:    token.Start,
+			End:      token.End,
+			Position: token.Position,
+			Type:     tokenTypes[token.Type],
+		})
+	}
+	return tokens
+}
@@ -12,7 +12,6 @@ var _ store.KVIterator  = &Iterator{}
 
 type Iterator struct {
 	close  sync.Once
-	tx     *badger.Txn
 	iter   *badger.Iterator
 	prefix []byte
 	start  []byte
@@ -119,12 +118,10 @@ func (i *Iterator) Close() error {
 		return nil
 	}
 	i.close.Do(func() {
+		// the transaction belongs to the reader, it is discarded when the reader is closed
 		if i.iter != nil {
 			i.iter.Close()
 		}
-		if i.tx != nil {
-			i.tx.Discard()
-		}
 	})
 
 	return nil
@@ -46,7 +46,6 @@ func (r *Reader)PrefixIterator(prefix []byte) store.KVIterator {
 	opts.PrefetchSize = 10
 	it := r.tx.NewIterator(opts)
 	rv := &Iterator{
-		tx:     r.tx,
 		iter:   it,
 		prefix: prefix,
 	}
@@ -62,7 +61,6 @@ func (r *Reader)RangeIterator(start, end []byte) store.KVIterator {
 	opts.PrefetchSize = 10
 	it := r.tx.NewIterator(opts)
 	rv := &Iterator{
-		tx:    r.tx,
 		iter:  it,
 		start: start,
 		end:   end,
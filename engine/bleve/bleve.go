@@ -8,6 +8,7 @@ import (
 	"github.com/blevesearch/bleve"
 	"github.com/tiglabs/baudengine/engine"
 	"github.com/blevesearch/bleve/index/store"
+	"github.com/blevesearch/bleve/mapping"
 	"github.com/tiglabs/baudengine/engine/bleve/badgerdb"
 )
 
@@ -23,15 +24,10 @@ type Bleve struct {
 
 func New(cfg engine.EngineConfig) (engine.Engine, error) {
 
-	docMappings, err := ParseSchema([]byte(cfg.Schema))
+	mapping, _, err := newIndexMapping([]byte(cfg.Schema))
 	if err != nil {
 		return nil, err
 	}
-	if docMappings == nil {
-		return nil, errors.New("invalid schema")
-	}
-	mapping := bleve.NewIndexMapping()
-	mapping.DefaultMapping = docMappings[0]
     kvconfig := make(map[string]interface{})
     kvconfig["path"] = path.Join(cfg.Path, "baud.bleve", "data")
     kvconfig["sync"] = false
@@ -44,6 +40,32 @@ func New(cfg engine.EngineConfig) (engine.Engine, error) {
 	return &Bleve{index: index}, nil
 }
 
+// newIndexMapping returns the index mapping of the schema with its analysis.
+func newIndexMapping(schema []byte) (*mapping.IndexMappingImpl, *Analysis, error) {
+	docMappings, err := ParseSchema(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	if docMappings == nil {
+		return nil, nil, errors.New("invalid schema")
+	}
+	im := bleve.NewIndexMapping()
+	analysis, err := ParseAnalysis(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	if analysis != nil {
+		if err := analysis.apply(im); err != nil {
+			return nil, nil, err
+		}
+	}
+	im.DefaultMapping = docMappings[0]
+	if err := im.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return im, analysis, nil
+}
+
 func(b *Bleve)NewWriteBatch() engine.Batch {
 	return NewBatch(b.index)
 }
@@ -147,3 +147,145 @@ func TestTermSearchDoc(t *testing.T) {
     data, _ :=json.Marshal(res)
     fmt.Println(string(data))
 }
+
+func TestInternal(t *testing.T) {
+	clear()
+	schema := `{
+  "mappings": {
+    "baud": {
+      "properties": {
+        "name": {
+          "type": "string"
+        }
+      }
+    }
+  }
+}`
+	index := blever(t, schema)
+	defer func() {
+		index.Close()
+		clear()
+	}()
+	batch := index.NewWriteBatch()
+	if err := batch.SetInternal([]byte("k1"), []byte("v1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	v, err := index.GetInternal([]byte("k1"))
+	if err != nil || string(v) != "v1" {
+		t.Fatalf("get internal failed: %s %v", v, err)
+	}
+	if err := index.DeleteInternal([]byte("k1")); err != nil {
+		t.Fatal(err)
+	}
+	if v, err = index.GetInternal([]byte("k1")); err != nil || v != nil {
+		t.Fatalf("internal value is not deleted: %s %v", v, err)
+	}
+}
+
+func TestInternalIterator(t *testing.T) {
+	clear()
+	schema := `{
+  "mappings": {
+    "baud": {
+      "properties": {
+        "name": {
+          "type": "string"
+        }
+      }
+    }
+  }
+}`
+	index := blever(t, schema)
+	defer func() {
+		index.Close()
+		clear()
+	}()
+	batch := index.NewWriteBatch()
+	for _, k := range []string{"a1", "b1", "b2", "b3", "c1"} {
+		batch.SetInternal([]byte(k), []byte("v"+k))
+	}
+	if err := batch.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	iter, err := index.NewInternalIterator([]byte("b"), []byte("b3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	iter.Close()
+	if fmt.Sprint(keys) != "[b1 b2]" {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+	iter, err = index.NewInternalIterator([]byte("b3"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+	if !iter.Valid() || string(iter.Key()) != "b3" || string(iter.Value()) != "vb3" {
+		t.Fatal("bad internal iterator")
+	}
+}
+
+func TestSearchSnapshot(t *testing.T) {
+	clear()
+	schema := `{
+  "mappings": {
+    "baud": {
+      "properties": {
+        "name": { "type": "string", "store": true },
+        "age":  { "type": "integer", "store": true }
+      }
+    }
+  }
+}`
+	index := blever(t, schema)
+	defer func() {
+		index.Close()
+		clear()
+	}()
+	for i := 0; i < 3; i++ {
+		doc := map[string]interface{}{"name": fmt.Sprintf("n%d", i), "age": i}
+		if err := index.AddDocument(context.Background(), engine.DOC_ID(fmt.Sprintf("doc_%d", i)), doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	snapshot, err := index.NewSearchSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snapshot.Close()
+	if err := index.AddDocument(context.Background(), engine.DOC_ID("doc_3"), map[string]interface{}{"name": "n3", "age": 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := index.DeleteDocument(context.Background(), engine.DOC_ID("doc_0")); err != nil {
+		t.Fatal(err)
+	}
+
+	// the windows of the snapshot are cut from the documents as they were
+	var ids []string
+	for from := 0; from < 4; from += 2 {
+		res, err := snapshot.Search(context.Background(), &engine.SearchRequest{Query: []byte(`{"match_all": {}}`),
+			From: from, Size: 2, Sort: []string{"age"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Hits.Total != 3 {
+			t.Fatalf("the snapshot sees %d documents", res.Hits.Total)
+		}
+		for _, hit := range res.Hits.Hits {
+			ids = append(ids, hit.Id)
+		}
+	}
+	if fmt.Sprint(ids) != "[doc_0 doc_1 doc_2]" {
+		t.Fatalf("unexpected hits %v", ids)
+	}
+	if res, err := index.Search(context.Background(), &engine.SearchRequest{Query: []byte(`{"match_all": {}}`), Size: 10}); err != nil || res.Hits.Total != 3 {
+		t.Fatalf("the index sees %v, %v", res, err)
+	}
+}
@@ -56,4 +56,35 @@ func (iter *Iterator) Value() []byte {
 		return nil
 	}
 	return  iter.iter.Value()
-}
\ No newline at end of file
+}
+
+const internalRowPrefix = 'i'
+
+var _ engine.Iterator = &InternalIterator{}
+
+// InternalIterator iterates the internal rows of the index, the keys are returned without the row prefix.
+type InternalIterator struct {
+	reader store.KVReader
+	iter   store.KVIterator
+}
+
+func (iter *InternalIterator) Close() error {
+	iter.iter.Close()
+	return iter.reader.Close()
+}
+
+func (iter *InternalIterator) Next() {
+	iter.iter.Next()
+}
+
+func (iter *InternalIterator) Valid() bool {
+	return iter.iter.Valid()
+}
+
+func (iter *InternalIterator) Key() []byte {
+	return iter.iter.Key()[1:]
+}
+
+func (iter *InternalIterator) Value() []byte {
+	return iter.iter.Value()
+}
@@ -3,6 +3,7 @@ package bleve
 import (
 	"errors"
 	"encoding/json"
+	"sort"
 	"github.com/blevesearch/bleve/mapping"
 )
 
@@ -103,7 +104,14 @@ func (d *DocumentMapping)UnmarshalJSON(schema []byte) error {
     if err != nil {
     	return err
     }
-    for name, data := range tmp.Properties {
+    // the fields are added in the order of their names, so every replica stores the same mapping
+    names := make([]string, 0, len(tmp.Properties))
+    for name := range tmp.Properties {
+    	names = append(names, name)
+    }
+    sort.Strings(names)
+    for _, name := range names {
+    	data := tmp.Properties[name]
     	f := NewFieldMapping(name)
     	err = json.Unmarshal(data, f)
     	if err != nil {
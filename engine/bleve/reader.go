@@ -30,7 +30,7 @@ func(r *Bleve)GetApplyID() (uint64, error) {
 
 func(r *Bleve)GetDocument(ctx context.Context, docID engine.DOC_ID) (engine.DOCUMENT, bool) {
 	_doc, err := r.index.Document(docID.ToString())
-	if err != nil {
+	if err != nil || _doc == nil {
 		// todo panic ???
 		return nil, false
 	}
@@ -76,6 +76,32 @@ func(r *Bleve)GetDocument(ctx context.Context, docID engine.DOC_ID) (engine.DOCU
 	return doc, true
 }
 
+func(r *Bleve)GetInternal(key []byte) ([]byte, error) {
+	return r.index.GetInternal(key)
+}
+
+func(r *Bleve)NewInternalIterator(start, end []byte) (engine.Iterator, error) {
+	_, kvstore, err := r.index.Advanced()
+	if err != nil {
+		return nil, err
+	}
+	reader, err := kvstore.Reader()
+	if err != nil {
+		return nil, err
+	}
+	// internal rows are the keys prefixed with 'i'
+	startKey := append([]byte{internalRowPrefix}, start...)
+	endKey := []byte{internalRowPrefix + 1}
+	if end != nil {
+		endKey = append([]byte{internalRowPrefix}, end...)
+	}
+	return &InternalIterator{reader: reader, iter: reader.RangeIterator(startKey, endKey)}, nil
+}
+
+func(r *Bleve)DocCount() (uint64, error) {
+	return r.index.DocCount()
+}
+
 func(r *Bleve)Search(ctx context.Context, req *engine.SearchRequest)(*engine.SearchResult, error) {
 	q, err := query.ParseQuery(req.Query)
 	if err != nil {
@@ -86,6 +112,9 @@ func(r *Bleve)Search(ctx context.Context, req *engine.SearchRequest)(*engine.Sea
 		searchReq.Explain = true
 	}
 	searchReq.Fields = req.Fields
+	if len(req.Sort) > 0 {
+		searchReq.SortBy(req.Sort)
+	}
 	searchReq.IncludeLocations = req.IncludeLocations
 	result, err := r.index.SearchInContext(ctx, searchReq)
 	if err != nil {
@@ -121,6 +150,7 @@ func bleveResultToBaudResult(_index, _type string, r *bleve.SearchResult) *engin
 			Id: doc.ID,
 			Score: doc.Score,
 			Source: doc.Fields,
+			Sort: doc.Sort,
 		}
 		hits =  append(hits, hit)
 	}
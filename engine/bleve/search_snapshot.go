@@ -0,0 +1,127 @@
+package bleve
+
+import (
+	"context"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/collector"
+	bquery "github.com/blevesearch/bleve/search/query"
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/engine/bleve/query"
+)
+
+var _ engine.SearchSnapshot = &SearchSnapshot{}
+
+// SearchSnapshot keeps an index reader open, the searches run on the index as the reader saw it.
+type SearchSnapshot struct {
+	reader  index.IndexReader
+	mapping mapping.IndexMapping
+}
+
+func (b *Bleve) NewSearchSnapshot() (engine.SearchSnapshot, error) {
+	i, _, err := b.index.Advanced()
+	if err != nil {
+		return nil, err
+	}
+	reader, err := i.Reader()
+	if err != nil {
+		return nil, err
+	}
+	return &SearchSnapshot{reader: reader, mapping: b.index.Mapping()}, nil
+}
+
+// Search runs the query as the search of the index does, on the reader of the snapshot.
+func (ss *SearchSnapshot) Search(ctx context.Context, req *engine.SearchRequest) (*engine.SearchResult, error) {
+	start := time.Now()
+	q, err := query.ParseQuery(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	sort := search.SortOrder{&search.SortScore{Desc: true}}
+	if len(req.Sort) > 0 {
+		sort = search.ParseSortOrderStrings(req.Sort)
+	}
+	coll := collector.NewTopNCollector(req.Size, req.From, sort)
+	if err := ss.collect(ctx, q, coll, req.Explain); err != nil {
+		return nil, err
+	}
+	hits := coll.Results()
+	if len(req.Fields) > 0 {
+		for _, hit := range hits {
+			if err := ss.loadFields(hit, req.Fields); err != nil {
+				return nil, err
+			}
+		}
+	}
+	result := &bleve.SearchResult{
+		Status:   &bleve.SearchStatus{Total: 1, Successful: 1},
+		Hits:     hits,
+		Total:    coll.Total(),
+		MaxScore: coll.MaxScore(),
+		Took:     time.Since(start),
+	}
+	return bleveResultToBaudResult(req.Index, req.Type, result), nil
+}
+
+// collect closes the searcher before the fields are loaded, the transaction of the reader
+// allows a single iterator at a time.
+func (ss *SearchSnapshot) collect(ctx context.Context, q bquery.Query, coll search.Collector, explain bool) error {
+	searcher, err := q.Searcher(ss.reader, ss.mapping, search.SearcherOptions{Explain: explain})
+	if err != nil {
+		return err
+	}
+	defer searcher.Close()
+	return coll.Collect(ctx, searcher, ss.reader)
+}
+
+// loadFields adds the stored fields asked to the hit, "*" asks all of them.
+func (ss *SearchSnapshot) loadFields(hit *search.DocumentMatch, fields []string) error {
+	doc, err := ss.reader.Document(hit.ID)
+	if err != nil || doc == nil {
+		return err
+	}
+	for _, field := range doc.Fields {
+		if !fieldAsked(field.Name(), fields) {
+			continue
+		}
+		var value interface{}
+		switch f := field.(type) {
+		case *document.TextField:
+			value = string(f.Value())
+		case *document.NumericField:
+			value, err = f.Number()
+		case *document.DateTimeField:
+			var t time.Time
+			if t, err = f.DateTime(); err == nil {
+				value = t.Format(time.RFC3339)
+			}
+		case *document.BooleanField:
+			value, err = f.Boolean()
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		hit.AddFieldValue(field.Name(), value)
+	}
+	return nil
+}
+
+func fieldAsked(name string, fields []string) bool {
+	for _, field := range fields {
+		if field == "*" || field == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (ss *SearchSnapshot) Close() error {
+	return ss.reader.Close()
+}
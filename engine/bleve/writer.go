@@ -5,8 +5,6 @@ import (
 	"encoding/binary"
 
 	"github.com/blevesearch/bleve"
-	"github.com/blevesearch/bleve/index"
-	"github.com/blevesearch/bleve/document"
 	"github.com/tiglabs/baudengine/engine"
 )
 
@@ -61,15 +59,46 @@ func(w *Bleve) DeleteDocument(ctx context.Context, docID engine.DOC_ID) (count i
 	return batch.DeleteDocument(ctx, docID)
 }
 
+func(w *Bleve) SetInternal(key, value []byte) error {
+	return w.index.SetInternal(key, value)
+}
+
+func(w *Bleve) DeleteInternal(key []byte) error {
+	return w.index.DeleteInternal(key)
+}
+
 var _ engine.Batch = &Batch{}
 
 type Batch struct {
 	index bleve.Index
 	batch *bleve.Batch
+	// the documents indexed (true) or deleted (false) by the batch, so it sees its own writes
+	pending map[string]bool
 }
 
 func NewBatch(index bleve.Index) *Batch {
-	return &Batch{index: index, batch: index.NewBatch()}
+	return &Batch{index: index, batch: index.NewBatch(), pending: make(map[string]bool)}
+}
+
+// exists looks the document up in the batch first, then in the index.
+func (b *Batch) exists(docID engine.DOC_ID) (bool, error) {
+	if indexed, ok := b.pending[docID.ToString()]; ok {
+		return indexed, nil
+	}
+	_index, _, err := b.index.Advanced()
+	if err != nil {
+		return false, err
+	}
+	reader, err := _index.Reader()
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+	_doc, err := reader.Document(docID.ToString())
+	if err != nil {
+		return false, err
+	}
+	return _doc != nil, nil
 }
 
 func(b *Batch) SetApplyID(applyID uint64) error {
@@ -82,64 +111,50 @@ func(b *Batch) SetApplyID(applyID uint64) error {
 }
 
 func (b *Batch)AddDocument(ctx context.Context, docID engine.DOC_ID, doc interface{}) error {
-	return b.batch.Index(docID.ToString(), doc)
+	if err := b.batch.Index(docID.ToString(), doc); err != nil {
+		return err
+	}
+	b.pending[docID.ToString()] = true
+	return nil
 }
 
 func(b *Batch) UpdateDocument(ctx context.Context, docID engine.DOC_ID, doc interface{}, upsert bool) (found bool, err error) {
-	var _doc *document.Document
-	var _index index.Index
-	var reader index.IndexReader
-	_index, _, err = b.index.Advanced()
-	if err != nil {
-		return
-	}
-	reader, err = _index.Reader()
-	if err != nil {
-		return
-	}
-	_doc, err = reader.Document(docID.ToString())
-	if err != nil {
+	if found, err = b.exists(docID); err != nil {
 		return false, err
 	}
-	if _doc != nil {
-		found = true
-	}
 	if !upsert && !found {
 		return
 	}
-	err =  b.batch.Index(docID.ToString(), doc)
+	err = b.AddDocument(ctx, docID, doc)
 	return
 }
 
 func(b *Batch) DeleteDocument(ctx context.Context, docID engine.DOC_ID) (int, error) {
-	var _doc *document.Document
-	var _index index.Index
-	var reader index.IndexReader
-	var err error
-	_index, _, err = b.index.Advanced()
-	if err != nil {
-		return 0, err
-	}
-	reader, err = _index.Reader()
-	if err != nil {
+	found, err := b.exists(docID)
+	if err != nil || !found {
 		return 0, err
 	}
-	_doc, err = reader.Document(docID.ToString())
-	if err != nil {
-		return 0, err
-	}
-	if _doc == nil {
-		return 0, nil
-	}
 	b.batch.Delete(docID.ToString())
+	b.pending[docID.ToString()] = false
 	return 1, nil
 }
 
+func(b *Batch) SetInternal(key, value []byte) error {
+	b.batch.SetInternal(key, value)
+	return nil
+}
+
+func(b *Batch) DeleteInternal(key []byte) error {
+	b.batch.DeleteInternal(key)
+	return nil
+}
+
 func (b *Batch) Commit() error {
 	return b.index.Batch(b.batch)
 }
 
 func (b *Batch) Rollback() error {
 	b.batch.Reset()
+	b.pending = make(map[string]bool)
 	return nil
 }
\ No newline at end of file
@@ -24,6 +24,13 @@ type Snapshot interface {
 	NewIterator() Iterator
 }
 
+// SearchSnapshot searches the index as it was when the snapshot was taken, the later writes are
+// not seen by its searches.
+type SearchSnapshot interface {
+	io.Closer
+	Search(ctx context.Context, req *SearchRequest) (*SearchResult, error)
+}
+
 // Iterator is an interface for iterating over key/value pairs in an engine.
 type Iterator interface {
 	io.Closer
@@ -39,6 +46,12 @@ type Reader interface {
 	GetApplyID() (uint64, error)
 	GetDocument(ctx context.Context, docID DOC_ID) (DOCUMENT, bool)
 	Search(ctx context.Context, req *SearchRequest)(*SearchResult, error)
+	// GetInternal reads a raw value kept outside the search index, nil if not found.
+	GetInternal(key []byte) ([]byte, error)
+	// NewInternalIterator iterates the raw values with keys in [start, end), a nil end is unbounded.
+	NewInternalIterator(start, end []byte) (Iterator, error)
+	// DocCount returns the number of the documents in the search index.
+	DocCount() (uint64, error)
 }
 
 // Writer is the write interface to an engine's data.
@@ -47,6 +60,9 @@ type Writer interface {
 	AddDocument(ctx context.Context, docID DOC_ID, doc interface{}) error
 	UpdateDocument(ctx context.Context, docID DOC_ID, doc interface{}, upsert bool) (found bool, err error)
 	DeleteDocument(ctx context.Context, docID DOC_ID) (int, error)
+	// SetInternal writes a raw value outside the search index, e.g. blob chunks.
+	SetInternal(key, value []byte) error
+	DeleteInternal(key []byte) error
 }
 
 // ReadWriter is the read/write interface to an engine's data.
@@ -68,4 +84,5 @@ type Engine interface {
 	NewWriteBatch() Batch
 	NewSnapshot() (Snapshot, error)
 	ApplySnapshot(ctx context.Context, iter Iterator) error
+	NewSearchSnapshot() (SearchSnapshot, error)
 }
@@ -9,3 +9,28 @@ type Analyzer interface {
 type DateTimeParser interface {
 	ParseDateTime(string) (time.Time, error)
 }
+
+var _ Analyzer = &CustomAnalyzer{}
+
+// CustomAnalyzer runs the text through the char filters, then tokenizes it and runs the tokens
+// through the token filters.
+type CustomAnalyzer struct {
+	CharFilters  []TextFilter
+	Tokenizer    Tokenizer
+	TokenFilters []TokenFilter
+}
+
+func (a *CustomAnalyzer) Analyze(input []byte) TokenSet {
+	if len(a.CharFilters) > 0 {
+		text := []rune(string(input))
+		for _, filter := range a.CharFilters {
+			text = filter.Filter(text)
+		}
+		input = []byte(string(text))
+	}
+	tokens := a.Tokenizer.Tokenize(input)
+	for _, filter := range a.TokenFilters {
+		tokens = filter.Filter(tokens)
+	}
+	return tokens
+}
@@ -0,0 +1,94 @@
+package asciifolding
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const Name = "asciifolding"
+
+// the letters the decomposition doesn't fold
+var letters = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ø': "o", 'Ø': "O",
+	'đ': "d", 'Đ': "D", 'ð': "d", 'Ð': "D", 'þ': "th", 'Þ': "TH", 'ł': "l", 'Ł': "L",
+	'ı': "i", 'ħ': "h", 'Ħ': "H", 'ŋ': "n", 'Ŋ': "N", 'ſ': "s",
+	'‘': "'", '’': "'", '‚': "'", '“': "\"", '”': "\"", '„': "\"", '–': "-", '—': "-",
+}
+
+var _ analysis.TokenFilter = &FoldingFilter{}
+
+// FoldingFilter folds the letters, the digits and the symbols of the terms to their ascii
+// equivalents: the accents are removed and the ligatures split. With the original preserved the
+// folded term is added at the position of the original one when they differ.
+type FoldingFilter struct {
+	preserveOriginal bool
+}
+
+func New(preserveOriginal bool) *FoldingFilter {
+	return &FoldingFilter{preserveOriginal: preserveOriginal}
+}
+
+func (ff *FoldingFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	if !ff.preserveOriginal {
+		for _, token := range input {
+			token.Term = Fold(token.Term)
+		}
+		return input
+	}
+	output := make(analysis.TokenSet, 0, len(input))
+	for _, token := range input {
+		output = append(output, token)
+		if folded := Fold(token.Term); string(folded) != string(token.Term) {
+			output = append(output, &analysis.Token{
+				Start:    token.Start,
+				End:      token.End,
+				Term:     folded,
+				Position: token.Position,
+				Type:     token.Type,
+			})
+		}
+	}
+	return output
+}
+
+// Fold returns the term folded to ascii, the characters without an equivalent are kept.
+func Fold(term []byte) []byte {
+	ascii := true
+	for _, b := range term {
+		if b >= 0x80 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return term
+	}
+	var out strings.Builder
+	for _, r := range norm.NFKD.String(string(term)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if s, ok := letters[r]; ok {
+			out.WriteString(s)
+			continue
+		}
+		out.WriteRune(r)
+	}
+	return []byte(out.String())
+}
+
+func init() {
+	registry.RegisterTokenFilter(Name, New(false))
+	registry.RegisterTokenFilterType(Name, func(config map[string]interface{}) (analysis.TokenFilter, error) {
+		preserve, err := registry.ConfigBool(config, "preserve_original", false)
+		if err != nil {
+			return nil, err
+		}
+		return New(preserve), nil
+	})
+}
@@ -0,0 +1,30 @@
+package asciifolding
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func TestFold(t *testing.T) {
+	for in, out := range map[string]string{
+		"café":       "cafe",
+		"Ærøskøbing": "AEroskobing",
+		"straße":     "strasse",
+		"ﬁne":        "fine",
+		"Łódź":       "Lodz",
+		"路由器":        "路由器",
+		"plain":      "plain",
+	} {
+		if folded := string(Fold([]byte(in))); folded != out {
+			t.Errorf("%s folded to %s", in, folded)
+		}
+	}
+	set := New(true).Filter(analysis.TokenSet{
+		&analysis.Token{Term: []byte("crème"), Position: 1},
+		&analysis.Token{Term: []byte("brulee"), Position: 2},
+	})
+	if len(set) != 3 || string(set[1].Term) != "creme" || set[1].Position != 1 || string(set[2].Term) != "brulee" {
+		t.Fatalf("%v", set)
+	}
+}
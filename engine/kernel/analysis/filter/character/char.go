@@ -1,9 +1,16 @@
 package character
 
 import (
+	"fmt"
+	"strings"
+	"unicode"
+
 	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
 )
 
+const Name = "character"
+
 var _ analysis.CharFilter = &CharacterFilter{}
 
 type FilterOutFunc func(r rune) bool
@@ -20,4 +27,78 @@ func (cf *CharacterFilter) Filter(r rune) bool {
 	return cf.filterOut(r)
 }
 
+// the classes of the characters the parameters of the analysis name
+var classes = map[string]func(rune) bool{
+	"letter":      unicode.IsLetter,
+	"digit":       unicode.IsDigit,
+	"whitespace":  unicode.IsSpace,
+	"punctuation": unicode.IsPunct,
+	"symbol":      unicode.IsSymbol,
+}
+
+// Classes returns the function telling whether a character is of one of the classes or one of
+// the chars.
+func Classes(names []string, chars string) (FilterOutFunc, error) {
+	var funcs []func(rune) bool
+	for _, name := range names {
+		f, ok := classes[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown character class %q", name)
+		}
+		funcs = append(funcs, f)
+	}
+	return func(r rune) bool {
+		for _, f := range funcs {
+			if f(r) {
+				return true
+			}
+		}
+		return strings.ContainsRune(chars, r)
+	}, nil
+}
+
+var _ analysis.TextFilter = &TextFilter{}
+
+// TextFilter is the char filter removing the characters the filter filters out from the text.
+type TextFilter struct {
+	filter analysis.CharFilter
+}
 
+func NewTextFilter(f FilterOutFunc) *TextFilter {
+	return &TextFilter{filter: New(f)}
+}
+
+func (tf *TextFilter) Filter(text []rune) []rune {
+	out := text[:0]
+	for _, r := range text {
+		if !tf.filter.Filter(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// the char filter of the analysis of a space, removing the characters of the classes and the
+// chars, the punctuation without them
+func newTextFilter(config map[string]interface{}) (analysis.TextFilter, error) {
+	names, err := registry.ConfigStrings(config, "classes")
+	if err != nil {
+		return nil, err
+	}
+	chars, err := registry.ConfigString(config, "chars", "")
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 && chars == "" {
+		names = []string{"punctuation"}
+	}
+	f, err := Classes(names, chars)
+	if err != nil {
+		return nil, err
+	}
+	return NewTextFilter(f), nil
+}
+
+func init() {
+	registry.RegisterCharFilterType(Name, newTextFilter)
+}
@@ -2,6 +2,7 @@ package lower
 
 import (
 	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
 	"unicode/utf8"
 	"unicode"
 )
@@ -58,4 +59,11 @@ func toLowerCopy(s []byte) []byte {
 	}
 	return b[0:nbytes]
 
-}
\ No newline at end of file
+}
+
+func init() {
+	registry.RegisterTokenFilter(Name, New())
+	registry.RegisterTokenFilterType(Name, func(config map[string]interface{}) (analysis.TokenFilter, error) {
+		return New(), nil
+	})
+}
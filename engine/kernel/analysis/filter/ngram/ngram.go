@@ -0,0 +1,112 @@
+package ngram
+
+import (
+	"fmt"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const (
+	Name     = "ngram"
+	EdgeName = "edge_ngram"
+)
+
+var _ analysis.TokenFilter = &NgramFilter{}
+
+// NgramFilter replaces a token by the n-grams of its term, of min to max characters. The
+// n-grams are at the position and the offsets of the token, a phrase of n-grams matches the
+// phrase of the words. The edge filter keeps the n-grams of the start of the term only, for
+// prefix matching.
+type NgramFilter struct {
+	min, max int
+	edge     bool
+}
+
+func New(min, max int) (*NgramFilter, error) {
+	if err := checkGrams(min, max); err != nil {
+		return nil, err
+	}
+	return &NgramFilter{min: min, max: max}, nil
+}
+
+func NewEdge(min, max int) (*NgramFilter, error) {
+	if err := checkGrams(min, max); err != nil {
+		return nil, err
+	}
+	return &NgramFilter{min: min, max: max, edge: true}, nil
+}
+
+func checkGrams(min, max int) error {
+	if min < 1 || max < min {
+		return fmt.Errorf("invalid gram sizes %d to %d", min, max)
+	}
+	return nil
+}
+
+func (nf *NgramFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	output := make(analysis.TokenSet, 0, len(input))
+	for _, token := range input {
+		runes := []rune(string(token.Term))
+		for _, gram := range Grams(runes, nf.min, nf.max, nf.edge) {
+			output = append(output, &analysis.Token{
+				Start:    token.Start,
+				End:      token.End,
+				Term:     []byte(string(gram)),
+				Position: token.Position,
+				Type:     token.Type,
+			})
+		}
+	}
+	return output
+}
+
+// Grams returns the n-grams of the runes of min to max runes, ordered by their start then by
+// their length, of the start only for the edge n-grams.
+func Grams(runes []rune, min, max int, edge bool) [][]rune {
+	var grams [][]rune
+	for start := 0; start < len(runes); start++ {
+		for n := min; n <= max && start+n <= len(runes); n++ {
+			grams = append(grams, runes[start:start+n])
+		}
+		if edge {
+			break
+		}
+	}
+	return grams
+}
+
+// GramSizes returns the min_gram and the max_gram parameters of the config.
+func GramSizes(config map[string]interface{}, min, max int) (int, int, error) {
+	min, err := registry.ConfigInt(config, "min_gram", min)
+	if err != nil {
+		return 0, 0, err
+	}
+	max, err = registry.ConfigInt(config, "max_gram", max)
+	if err != nil {
+		return 0, 0, err
+	}
+	return min, max, nil
+}
+
+func init() {
+	// of one and two characters as ES
+	ngram, _ := New(1, 2)
+	edge, _ := NewEdge(1, 2)
+	registry.RegisterTokenFilter(Name, ngram)
+	registry.RegisterTokenFilter(EdgeName, edge)
+	registry.RegisterTokenFilterType(Name, func(config map[string]interface{}) (analysis.TokenFilter, error) {
+		min, max, err := GramSizes(config, 1, 2)
+		if err != nil {
+			return nil, err
+		}
+		return New(min, max)
+	})
+	registry.RegisterTokenFilterType(EdgeName, func(config map[string]interface{}) (analysis.TokenFilter, error) {
+		min, max, err := GramSizes(config, 1, 2)
+		if err != nil {
+			return nil, err
+		}
+		return NewEdge(min, max)
+	})
+}
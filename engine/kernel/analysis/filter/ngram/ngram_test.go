@@ -0,0 +1,51 @@
+package ngram
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func TestNgram(t *testing.T) {
+	input := func() analysis.TokenSet {
+		return analysis.TokenSet{
+			&analysis.Token{Start: 0, End: 4, Term: []byte("wifi"), Position: 1},
+			&analysis.Token{Start: 5, End: 8, Term: []byte("路由器"), Position: 2},
+		}
+	}
+	f, _ := New(2, 3)
+	set := f.Filter(input())
+	expect := []struct {
+		term     string
+		position int
+	}{{"wi", 1}, {"wif", 1}, {"if", 1}, {"ifi", 1}, {"fi", 1}, {"路由", 2}, {"路由器", 2}, {"由器", 2}}
+	if len(set) != len(expect) {
+		t.Fatalf("%d grams", len(set))
+	}
+	for i, e := range expect {
+		if string(set[i].Term) != e.term || set[i].Position != e.position {
+			t.Errorf("gram %d: %s at %d", i, set[i].Term, set[i].Position)
+		}
+	}
+	if set[0].Start != 0 || set[0].End != 4 || set[7].Start != 5 {
+		t.Errorf("offsets of the token")
+	}
+
+	f, _ = NewEdge(1, 3)
+	set = f.Filter(input())
+	expect = []struct {
+		term     string
+		position int
+	}{{"w", 1}, {"wi", 1}, {"wif", 1}, {"路", 2}, {"路由", 2}, {"路由器", 2}}
+	if len(set) != len(expect) {
+		t.Fatalf("%d edge grams", len(set))
+	}
+	for i, e := range expect {
+		if string(set[i].Term) != e.term || set[i].Position != e.position {
+			t.Errorf("edge gram %d: %s at %d", i, set[i].Term, set[i].Position)
+		}
+	}
+	if _, err := New(3, 2); err == nil {
+		t.Fatal("invalid gram sizes")
+	}
+}
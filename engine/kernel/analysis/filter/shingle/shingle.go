@@ -0,0 +1,111 @@
+package shingle
+
+import (
+	"fmt"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+	"github.com/tiglabs/baudengine/util/bytes"
+)
+
+const Name = "shingle"
+
+var _ analysis.TokenFilter = &ShingleFilter{}
+
+// ShingleFilter adds the shingles of the tokens, the terms of min to max consecutive positions
+// joined by the separator, at the position of their first token. A position without a token, of
+// a removed stop word, is the filler in the shingles.
+type ShingleFilter struct {
+	min, max       int
+	outputUnigrams bool
+	separator      []byte
+	filler         []byte
+}
+
+func New(min, max int, outputUnigrams bool, separator, filler string) (*ShingleFilter, error) {
+	if min < 2 || max < min {
+		return nil, fmt.Errorf("invalid shingle sizes %d to %d", min, max)
+	}
+	return &ShingleFilter{
+		min:            min,
+		max:            max,
+		outputUnigrams: outputUnigrams,
+		separator:      []byte(separator),
+		filler:         []byte(filler),
+	}, nil
+}
+
+func (sf *ShingleFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	// the first token of each position, the tokens stacked on it don't start shingles
+	var slots analysis.TokenSet
+	for _, token := range input {
+		if len(slots) == 0 || token.Position > slots[len(slots)-1].Position {
+			slots = append(slots, token)
+		}
+	}
+	output := make(analysis.TokenSet, 0, len(input)*(sf.max-sf.min+2))
+	k := 0
+	for _, token := range input {
+		if sf.outputUnigrams {
+			output = append(output, token)
+		}
+		if k >= len(slots) || token != slots[k] {
+			continue
+		}
+		term := append([]byte(nil), token.Term...)
+		end, position, j := token.End, token.Position, k+1
+		for size := 2; size <= sf.max && j < len(slots); size++ {
+			position++
+			term = append(term, sf.separator...)
+			if slots[j].Position == position {
+				term = append(term, slots[j].Term...)
+				end = slots[j].End
+				j++
+			} else {
+				term = append(term, sf.filler...)
+			}
+			if size >= sf.min {
+				output = append(output, &analysis.Token{
+					Start:    token.Start,
+					End:      end,
+					Term:     bytes.CloneBytes(term),
+					Position: token.Position,
+					Type:     analysis.Text,
+				})
+			}
+		}
+		k++
+	}
+	return output
+}
+
+// the shingle filter of the analysis of a space, of two words with the unigrams as ES
+func newFilter(config map[string]interface{}) (analysis.TokenFilter, error) {
+	min, err := registry.ConfigInt(config, "min_shingle_size", 2)
+	if err != nil {
+		return nil, err
+	}
+	max, err := registry.ConfigInt(config, "max_shingle_size", 2)
+	if err != nil {
+		return nil, err
+	}
+	unigrams, err := registry.ConfigBool(config, "output_unigrams", true)
+	if err != nil {
+		return nil, err
+	}
+	separator, err := registry.ConfigString(config, "token_separator", " ")
+	if err != nil {
+		return nil, err
+	}
+	filler, err := registry.ConfigString(config, "filler_token", "_")
+	if err != nil {
+		return nil, err
+	}
+	return New(min, max, unigrams, separator, filler)
+}
+
+func init() {
+	shingle, _ := New(2, 2, true, " ", "_")
+	registry.RegisterTokenFilter(Name, shingle)
+	registry.RegisterTokenFilterType(Name, newFilter)
+}
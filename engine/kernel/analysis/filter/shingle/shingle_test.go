@@ -0,0 +1,46 @@
+package shingle
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func TestShingle(t *testing.T) {
+	// "the" removed at 3
+	input := analysis.TokenSet{
+		&analysis.Token{Start: 0, End: 5, Term: []byte("quick"), Position: 1},
+		&analysis.Token{Start: 6, End: 9, Term: []byte("fox"), Position: 2},
+		&analysis.Token{Start: 6, End: 9, Term: []byte("vixen"), Position: 2},
+		&analysis.Token{Start: 14, End: 18, Term: []byte("dogs"), Position: 4},
+	}
+	f, _ := New(2, 3, true, " ", "_")
+	set := f.Filter(input)
+	expect := []struct {
+		term       string
+		start, end int
+		position   int
+	}{
+		{"quick", 0, 5, 1}, {"quick fox", 0, 9, 1}, {"quick fox _", 0, 9, 1},
+		{"fox", 6, 9, 2}, {"fox _", 6, 9, 2}, {"fox _ dogs", 6, 18, 2},
+		{"vixen", 6, 9, 2},
+		{"dogs", 14, 18, 4},
+	}
+	if len(set) != len(expect) {
+		t.Fatalf("%d tokens: %v", len(set), set)
+	}
+	for i, e := range expect {
+		if token := set[i]; string(token.Term) != e.term || token.Start != e.start || token.End != e.end || token.Position != e.position {
+			t.Errorf("token %d: %s %d %d %d", i, token.Term, token.Start, token.End, token.Position)
+		}
+	}
+
+	f, _ = New(2, 2, false, "_", "")
+	set = f.Filter(input[:2])
+	if len(set) != 1 || string(set[0].Term) != "quick_fox" || set[0].Position != 1 {
+		t.Fatalf("%v", set)
+	}
+	if _, err := New(1, 2, true, " ", "_"); err == nil {
+		t.Fatal("invalid shingle sizes")
+	}
+}
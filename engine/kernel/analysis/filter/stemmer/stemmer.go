@@ -0,0 +1,102 @@
+package stemmer
+
+import (
+	"fmt"
+
+	snowball "github.com/blevesearch/snowballstem"
+	"github.com/blevesearch/snowballstem/arabic"
+	"github.com/blevesearch/snowballstem/danish"
+	"github.com/blevesearch/snowballstem/dutch"
+	"github.com/blevesearch/snowballstem/english"
+	"github.com/blevesearch/snowballstem/finnish"
+	"github.com/blevesearch/snowballstem/french"
+	"github.com/blevesearch/snowballstem/german"
+	"github.com/blevesearch/snowballstem/hungarian"
+	"github.com/blevesearch/snowballstem/irish"
+	"github.com/blevesearch/snowballstem/italian"
+	"github.com/blevesearch/snowballstem/norwegian"
+	"github.com/blevesearch/snowballstem/porter"
+	"github.com/blevesearch/snowballstem/portuguese"
+	"github.com/blevesearch/snowballstem/romanian"
+	"github.com/blevesearch/snowballstem/russian"
+	"github.com/blevesearch/snowballstem/spanish"
+	"github.com/blevesearch/snowballstem/swedish"
+	"github.com/blevesearch/snowballstem/tamil"
+	"github.com/blevesearch/snowballstem/turkish"
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const (
+	Name = "stemmer"
+	// the original porter algorithm of english, the english snowball stemmer is its successor
+	PorterName = "porter"
+)
+
+// the snowball stemmers of the languages
+var languages = map[string]func(*snowball.Env) bool{
+	"arabic":     arabic.Stem,
+	"danish":     danish.Stem,
+	"dutch":      dutch.Stem,
+	"english":    english.Stem,
+	"finnish":    finnish.Stem,
+	"french":     french.Stem,
+	"german":     german.Stem,
+	"hungarian":  hungarian.Stem,
+	"irish":      irish.Stem,
+	"italian":    italian.Stem,
+	"norwegian":  norwegian.Stem,
+	"porter":     porter.Stem,
+	"portuguese": portuguese.Stem,
+	"romanian":   romanian.Stem,
+	"russian":    russian.Stem,
+	"spanish":    spanish.Stem,
+	"swedish":    swedish.Stem,
+	"tamil":      tamil.Stem,
+	"turkish":    turkish.Stem,
+}
+
+var _ analysis.TokenFilter = &StemmerFilter{}
+
+// StemmerFilter replaces the terms by their stems, the tokens keep their positions. The tokens
+// of the keyword tokenizer are not stemmed.
+type StemmerFilter struct {
+	stem func(*snowball.Env) bool
+}
+
+func New(language string) (*StemmerFilter, error) {
+	stem, ok := languages[language]
+	if !ok {
+		return nil, fmt.Errorf("no stemmer of the language %q", language)
+	}
+	return &StemmerFilter{stem: stem}, nil
+}
+
+func (sf *StemmerFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	for _, token := range input {
+		if token.Type == analysis.KeyWord {
+			continue
+		}
+		env := snowball.NewEnv(string(token.Term))
+		sf.stem(env)
+		token.Term = []byte(env.Current())
+	}
+	return input
+}
+
+// the stemmer of the analysis of a space, of the language, english without it
+func newFilter(config map[string]interface{}) (analysis.TokenFilter, error) {
+	language, err := registry.ConfigString(config, "language", "english")
+	if err != nil {
+		return nil, err
+	}
+	return New(language)
+}
+
+func init() {
+	english, _ := New("english")
+	porter, _ := New(PorterName)
+	registry.RegisterTokenFilter(Name, english)
+	registry.RegisterTokenFilter(PorterName, porter)
+	registry.RegisterTokenFilterType(Name, newFilter)
+}
@@ -0,0 +1,37 @@
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func TestStemmer(t *testing.T) {
+	for _, test := range []struct {
+		language string
+		in, out  string
+	}{
+		{"english", "running", "run"},
+		{"english", "generously", "generous"},
+		{"porter", "generalizations", "gener"},
+		{"french", "continuellement", "continuel"},
+		{"german", "häuser", "haus"},
+	} {
+		f, err := New(test.language)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set := f.Filter(analysis.TokenSet{&analysis.Token{Term: []byte(test.in), Position: 3}})
+		if string(set[0].Term) != test.out || set[0].Position != 3 {
+			t.Errorf("%s: %s stemmed to %s at %d", test.language, test.in, set[0].Term, set[0].Position)
+		}
+	}
+	f, _ := New("english")
+	set := f.Filter(analysis.TokenSet{&analysis.Token{Term: []byte("running"), Type: analysis.KeyWord}})
+	if string(set[0].Term) != "running" {
+		t.Fatal("keyword stemmed")
+	}
+	if _, err := New("klingon"); err == nil {
+		t.Fatal("unknown language")
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"io"
 	"fmt"
 	"os"
+	"strings"
+	"sync/atomic"
 
 	"github.com/heidawei/gotrie/trie"
 	"github.com/tiglabs/baudengine/kernel/analysis"
 	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/kernel/config"
+	"github.com/tiglabs/baudengine/util/dict"
 )
 
 var _ analysis.TokenFilter = &StopFilter{}
@@ -18,6 +21,8 @@ const Name = "stop"
 
 type StopFilter struct {
 	dict   *trie.Trie
+	// the trie of the stop word dictionary of the cluster followed
+	words atomic.Value
 }
 
 func New() *StopFilter {
@@ -26,6 +31,30 @@ func New() *StopFilter {
 	return &StopFilter{}
 }
 
+// NewWithWords returns the filter of the stop words.
+func NewWithWords(words []string) *StopFilter {
+	sf := &StopFilter{dict: trie.NewTrie()}
+	for _, word := range words {
+		sf.dict.ReplaceOrInsert([]byte(word), nil)
+	}
+	return sf
+}
+
+// NewWithDict returns the filter of the stop word dictionary of the cluster, the words of the
+// versions stored replace the ones of the filter, no word is stopped before one is stored.
+func NewWithDict(name string) *StopFilter {
+	sf := &StopFilter{}
+	sf.words.Store(trie.NewTrie())
+	dict.Watch(name, func(d *dict.Dict) {
+		words := trie.NewTrie()
+		for _, word := range d.Words {
+			words.ReplaceOrInsert([]byte(word), nil)
+		}
+		sf.words.Store(words)
+	})
+	return sf
+}
+
 func (sf *StopFilter) loadDict() error {
 	sf.dict = trie.NewTrie()
 	f, err := os.Open(config.GetWordDictPath("stop_word.dict"))
@@ -36,27 +65,36 @@ func (sf *StopFilter) loadDict() error {
 	reader := bufio.NewReader(f)
 	for {
 		line, err := reader.ReadString('\n')
+		if word := strings.TrimSpace(line); word != "" {
+			sf.dict.ReplaceOrInsert([]byte(word), nil)
+		}
 		if err != nil {
 			if err == io.EOF {
 				return nil
 			}
 			return err
 		}
-		sf.dict.ReplaceOrInsert([]byte(line), nil)
 	}
 	return nil
 }
 
 func (sf *StopFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	if words, ok := sf.words.Load().(*trie.Trie); ok {
+		return filter(input, words)
+	}
 	if sf.dict == nil {
 		err := sf.loadDict()
 		if err != nil {
 			panic(fmt.Sprintf("load dict failed, err %v", err))
 		}
 	}
+	return filter(input, sf.dict)
+}
+
+func filter(input analysis.TokenSet, words *trie.Trie) analysis.TokenSet {
 	index := 0
 	for _, token := range input {
-		_, isStopToken := sf.dict.Find(token.Term)
+		_, isStopToken := words.Find(token.Term)
 		if !isStopToken {
 			input[index] = token
 			index++
@@ -66,6 +104,26 @@ func (sf *StopFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
 	return input[:index]
 }
 
+// the filter of the analysis of a space, of the stopwords or of the stop word dictionary
+func newFilter(config map[string]interface{}) (analysis.TokenFilter, error) {
+	name, err := registry.ConfigString(config, "stopwords_dict", "")
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		return NewWithDict(name), nil
+	}
+	words, err := registry.ConfigStrings(config, "stopwords")
+	if err != nil {
+		return nil, err
+	}
+	if words == nil {
+		return registry.GetTokenFilter(Name), nil
+	}
+	return NewWithWords(words), nil
+}
+
 func init() {
 	registry.RegisterTokenFilter(Name, New())
+	registry.RegisterTokenFilterType(Name, newFilter)
 }
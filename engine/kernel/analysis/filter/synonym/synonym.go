@@ -0,0 +1,291 @@
+package synonym
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const Name = "synonym"
+
+// The formats of the rules.
+const (
+	// Solr: "ipod, i-pod, i pod" are equivalent, "usa, united states => america" replaces the
+	// synonyms of the left by the ones of the right.
+	Solr = "solr"
+	// WordNet prolog, the words of a synset are equivalent:
+	// s(100001740,1,'entity',n,1,11).
+	WordNet = "wordnet"
+)
+
+var _ analysis.TokenFilter = &SynonymFilter{}
+
+// SynonymFilter replaces the tokens of the synonyms of the rules by the synonyms they are mapped
+// to. A synonym of several words matches the tokens of consecutive positions, the ones a stop
+// word was removed from don't match. The synonyms are at the position of the first token, their
+// words at the next positions, a phrase of the synonyms matches.
+//
+// The equivalent synonyms are mapped to all of them when expanded, to the first one otherwise.
+type SynonymFilter struct {
+	mappings   map[string][][]string
+	maxWords   int
+	ignoreCase bool
+}
+
+// New returns the filter of the rules of the format.
+func New(rules []string, format string, expand, ignoreCase bool) (*SynonymFilter, error) {
+	return Parse(strings.NewReader(strings.Join(rules, "\n")), format, expand, ignoreCase)
+}
+
+// Parse reads the rules of the format.
+func Parse(r io.Reader, format string, expand, ignoreCase bool) (*SynonymFilter, error) {
+	sf := &SynonymFilter{mappings: make(map[string][][]string), ignoreCase: ignoreCase}
+	var err error
+	switch format {
+	case Solr, "":
+		err = sf.parseSolr(r, expand)
+	case WordNet:
+		err = sf.parseWordNet(r, expand)
+	default:
+		err = fmt.Errorf("unknown format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sf, nil
+}
+
+func (sf *SynonymFilter) parseSolr(r io.Reader, expand bool) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		sides := strings.Split(line, "=>")
+		switch len(sides) {
+		case 1:
+			synonyms, err := sf.synonyms(sides[0])
+			if err != nil {
+				return fmt.Errorf("line %d: %v", n, err)
+			}
+			sf.equivalent(synonyms, expand)
+		case 2:
+			from, err := sf.synonyms(sides[0])
+			if err != nil {
+				return fmt.Errorf("line %d: %v", n, err)
+			}
+			to, err := sf.synonyms(sides[1])
+			if err != nil {
+				return fmt.Errorf("line %d: %v", n, err)
+			}
+			for _, synonym := range from {
+				sf.add(synonym, to...)
+			}
+		default:
+			return fmt.Errorf("line %d: more than one =>", n)
+		}
+	}
+	return scanner.Err()
+}
+
+func (sf *SynonymFilter) parseWordNet(r io.Reader, expand bool) error {
+	var synset string
+	var synonyms [][]string
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		// s(synset_id,w_num,'word',ss_type,sense_number,tag_count).
+		first, last := strings.IndexByte(line, '\''), strings.LastIndexByte(line, '\'')
+		comma := strings.IndexByte(line, ',')
+		if !strings.HasPrefix(line, "s(") || comma < 0 || first < 0 || last <= first {
+			return fmt.Errorf("line %d: not a synset of wordnet", n)
+		}
+		if id := line[2:comma]; id != synset {
+			sf.equivalent(synonyms, expand)
+			synset, synonyms = id, nil
+		}
+		synonym := sf.words(strings.Replace(line[first+1:last], "''", "'", -1))
+		if len(synonym) == 0 {
+			return fmt.Errorf("line %d: empty word", n)
+		}
+		synonyms = append(synonyms, synonym)
+	}
+	sf.equivalent(synonyms, expand)
+	return scanner.Err()
+}
+
+// synonyms returns the synonyms separated by commas, a comma escaped by a backslash is of the
+// synonym.
+func (sf *SynonymFilter) synonyms(side string) ([][]string, error) {
+	var synonyms [][]string
+	var synonym []rune
+	escaped := false
+	appendSynonym := func() error {
+		words := sf.words(string(synonym))
+		if len(words) == 0 {
+			return fmt.Errorf("empty synonym")
+		}
+		synonyms = append(synonyms, words)
+		synonym = synonym[:0]
+		return nil
+	}
+	for _, r := range side {
+		switch {
+		case escaped:
+			synonym = append(synonym, r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			if err := appendSynonym(); err != nil {
+				return nil, err
+			}
+		default:
+			synonym = append(synonym, r)
+		}
+	}
+	if err := appendSynonym(); err != nil {
+		return nil, err
+	}
+	return synonyms, nil
+}
+
+func (sf *SynonymFilter) words(synonym string) []string {
+	if sf.ignoreCase {
+		synonym = strings.ToLower(synonym)
+	}
+	return strings.Fields(synonym)
+}
+
+func (sf *SynonymFilter) equivalent(synonyms [][]string, expand bool) {
+	if len(synonyms) == 0 {
+		return
+	}
+	for _, synonym := range synonyms {
+		if expand {
+			sf.add(synonym, synonyms...)
+		} else {
+			sf.add(synonym, synonyms[0])
+		}
+	}
+}
+
+// add maps the synonym to the others, once each.
+func (sf *SynonymFilter) add(synonym []string, to ...[]string) {
+	key := strings.Join(synonym, " ")
+	mapped := sf.mappings[key]
+next:
+	for _, t := range to {
+		for _, m := range mapped {
+			if strings.Join(m, " ") == strings.Join(t, " ") {
+				continue next
+			}
+		}
+		mapped = append(mapped, t)
+	}
+	sf.mappings[key] = mapped
+	if len(synonym) > sf.maxWords {
+		sf.maxWords = len(synonym)
+	}
+}
+
+func (sf *SynonymFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	output := make(analysis.TokenSet, 0, len(input))
+	for i := 0; i < len(input); {
+		n, mapped := sf.match(input[i:])
+		if n == 0 {
+			output = append(output, input[i])
+			i++
+			continue
+		}
+		first, last := input[i], input[i+n-1]
+		for _, synonym := range mapped {
+			for j, word := range synonym {
+				output = append(output, &analysis.Token{
+					Start:    first.Start,
+					End:      last.End,
+					Term:     []byte(word),
+					Position: first.Position + j,
+					Type:     first.Type,
+				})
+			}
+		}
+		i += n
+	}
+	// the words of the synonyms longer than the matched tokens are at the positions of the next
+	// tokens
+	sort.SliceStable(output, func(i, j int) bool {
+		return output[i].Position < output[j].Position
+	})
+	return output
+}
+
+// match returns the number of tokens of the longest synonym the tokens start with and the
+// synonyms it is mapped to.
+func (sf *SynonymFilter) match(tokens analysis.TokenSet) (int, [][]string) {
+	words := make([]string, 0, sf.maxWords)
+	for i, token := range tokens {
+		if i == sf.maxWords || (i > 0 && token.Position != tokens[0].Position+i) {
+			break
+		}
+		word := string(token.Term)
+		if sf.ignoreCase {
+			word = strings.ToLower(word)
+		}
+		words = append(words, word)
+	}
+	for n := len(words); n > 0; n-- {
+		if mapped, ok := sf.mappings[strings.Join(words[:n], " ")]; ok {
+			return n, mapped
+		}
+	}
+	return 0, nil
+}
+
+// the synonym filter of the analysis of a space, of the synonyms or of the file of the
+// synonyms_path, expanded
+func newFilter(config map[string]interface{}) (analysis.TokenFilter, error) {
+	format, err := registry.ConfigString(config, "format", Solr)
+	if err != nil {
+		return nil, err
+	}
+	expand, err := registry.ConfigBool(config, "expand", true)
+	if err != nil {
+		return nil, err
+	}
+	ignoreCase, err := registry.ConfigBool(config, "ignore_case", false)
+	if err != nil {
+		return nil, err
+	}
+	path, err := registry.ConfigString(config, "synonyms_path", "")
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return Parse(f, format, expand, ignoreCase)
+	}
+	rules, err := registry.ConfigStrings(config, "synonyms")
+	if err != nil {
+		return nil, err
+	}
+	return New(rules, format, expand, ignoreCase)
+}
+
+func init() {
+	registry.RegisterTokenFilterType(Name, newFilter)
+}
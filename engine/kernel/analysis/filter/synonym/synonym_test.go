@@ -0,0 +1,94 @@
+package synonym
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func tokens(text string) analysis.TokenSet {
+	var set analysis.TokenSet
+	start := 0
+	for i, word := range strings.Fields(text) {
+		start = strings.Index(text[start:], word) + start
+		if word != "_" {
+			set = append(set, &analysis.Token{Start: start, End: start + len(word), Term: []byte(word), Position: i + 1})
+		}
+		start += len(word)
+	}
+	return set
+}
+
+func terms(set analysis.TokenSet) string {
+	var list []string
+	for _, token := range set {
+		list = append(list, fmt.Sprintf("%s/%d", token.Term, token.Position))
+	}
+	return strings.Join(list, " ")
+}
+
+func TestSolr(t *testing.T) {
+	rules := []string{
+		"# the products",
+		"ipod, i-pod, i pod",
+		"ny => new york",
+		"united states, usa => america",
+		`a\,b => ab`,
+	}
+	f, err := New(rules, Solr, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for in, out := range map[string]string{
+		"my i pod case":        "my/1 ipod/2 i-pod/2 i/2 pod/3 case/4",
+		"ny pizza":             "new/1 york/2 pizza/2",
+		"the united states of": "the/1 america/2 of/4",
+		"usa usa":              "america/1 america/2",
+		"a,b":                  "ab/1",
+		// the stop word removed between the words
+		"united _ states": "united/1 states/3",
+	} {
+		if got := terms(f.Filter(tokens(in))); got != out {
+			t.Errorf("%s: %s", in, got)
+		}
+	}
+	set := f.Filter(tokens("x ny"))
+	if set[1].Start != 2 || set[1].End != 4 {
+		t.Errorf("offsets of the synonym %d %d", set[1].Start, set[1].End)
+	}
+
+	f, _ = New(rules, Solr, false, false)
+	if got := terms(f.Filter(tokens("i pod"))); got != "ipod/1" {
+		t.Errorf("contracted to %s", got)
+	}
+	f, _ = New([]string{"TV, television"}, Solr, true, true)
+	if got := terms(f.Filter(tokens("Big TV"))); got != "Big/1 tv/2 television/2" {
+		t.Errorf("ignoring the case %s", got)
+	}
+	for _, bad := range []string{"a => b => c", "a, , b", " => b"} {
+		if _, err := New([]string{bad}, Solr, true, false); err == nil {
+			t.Errorf("%s parsed", bad)
+		}
+	}
+}
+
+func TestWordNet(t *testing.T) {
+	rules := []string{
+		"s(100002137,1,'abstraction',n,6,0).",
+		"s(100002137,2,'abstract entity',n,1,0).",
+		"s(100002452,1,'thing',n,12,0).",
+		"s(100002452,2,'o''clock',n,12,0).",
+	}
+	f, err := New(rules, WordNet, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := terms(f.Filter(tokens("abstract entity thing"))); got != "abstraction/1 abstract/1 entity/2 thing/3 o'clock/3" {
+		t.Errorf("%s", got)
+	}
+	if _, err := New([]string{"not a synset"}, WordNet, true, false); err == nil {
+		t.Fatal("parsed")
+	}
+}
@@ -5,9 +5,12 @@ import (
 
 	"github.com/tiglabs/baudengine/kernel/analysis"
 	"github.com/tiglabs/baudengine/kernel/analysis/filter/character"
+	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/util/bytes"
 )
 
+const Name = "character"
+
 type Tokenizer struct {
 	filter  analysis.CharFilter
 }
@@ -51,3 +54,34 @@ func (t *Tokenizer) Tokenize(input []byte) analysis.TokenSet {
 	return sets
 }
 
+// NewTokenChars returns the tokenizer whose tokens are of the characters of the classes, see
+// character.Classes.
+func NewTokenChars(classes []string, chars string) (*Tokenizer, error) {
+	in, err := character.Classes(classes, chars)
+	if err != nil {
+		return nil, err
+	}
+	return NewCharTokenizer(func(r rune) bool {
+		return !in(r)
+	}), nil
+}
+
+// the tokenizer of the analysis of a space, of the token_chars, letters and digits without them
+func newTokenizer(config map[string]interface{}) (analysis.Tokenizer, error) {
+	classes, err := registry.ConfigStrings(config, "token_chars")
+	if err != nil {
+		return nil, err
+	}
+	chars, err := registry.ConfigString(config, "chars", "")
+	if err != nil {
+		return nil, err
+	}
+	if len(classes) == 0 && chars == "" {
+		classes = []string{"letter", "digit"}
+	}
+	return NewTokenChars(classes, chars)
+}
+
+func init() {
+	registry.RegisterTokenizerType(Name, newTokenizer)
+}
@@ -1,12 +1,25 @@
 package chinese
 
 import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+
 	"github.com/tiglabs/baudengine/kernel/analysis"
 	"github.com/yanyiwu/gojieba"
 	"github.com/tiglabs/baudengine/kernel/config"
+	"github.com/tiglabs/baudengine/kernel/registry"
+	"github.com/tiglabs/baudengine/util/dict"
+	"github.com/tiglabs/baudengine/util/log"
 )
 
+const Name = "chinese"
+
 type ZhTokenizer struct {
+	// the tokenizer is replaced when a version of the user dictionary is stored, the texts being
+	// tokenized are tokenized by the old one
+	lock          sync.RWMutex
 	tokenizer     *gojieba.Jieba
 }
 
@@ -24,17 +37,68 @@ func NewZh() *ZhTokenizer {
 
 func NewZhTokenizer(dictpath, hmmpath, userdictpath, idf, stop_words string) *ZhTokenizer {
 	x := gojieba.NewJieba(dictpath, hmmpath, userdictpath, idf, stop_words)
-	return &ZhTokenizer{x}
+	return &ZhTokenizer{tokenizer: x}
+}
+
+// NewZhWithDict returns the tokenizer of the user dictionary of the cluster in addition to the
+// one of the word dict path, the tokenizer is rebuilt of each version stored.
+func NewZhWithDict(name string) *ZhTokenizer {
+	x := NewZh()
+	dict.Watch(name, func(d *dict.Dict) {
+		if err := x.loadUserDict(d); err != nil {
+			log.Error("load the version %d of the user dictionary %s failed, err %v", d.Version, d.Name, err)
+		}
+	})
+	return x
+}
+
+// loadUserDict replaces the tokenizer by the one of the words of the user dictionary.
+func (x *ZhTokenizer) loadUserDict(d *dict.Dict) error {
+	userdictpath := config.GetWordDictPath("user.dict")
+	words, err := ioutil.ReadFile(userdictpath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	f, err := ioutil.TempFile("", "baud_user_"+d.Name)
+	if err != nil {
+		return err
+	}
+	// jieba reads the dictionaries when it is built
+	defer os.Remove(f.Name())
+	if len(words) > 0 {
+		words = append(words, '\n')
+	}
+	words = append(words, strings.Join(d.Words, "\n")...)
+	if _, err := f.Write(words); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	tokenizer := gojieba.NewJieba(config.GetWordDictPath("baud_zh.dict"), config.GetWordDictPath("hmm_model.utf8"),
+		f.Name(), config.GetWordDictPath("idf.utf8"), config.GetWordDictPath("stop.dict"))
+
+	x.lock.Lock()
+	old := x.tokenizer
+	x.tokenizer = tokenizer
+	x.lock.Unlock()
+	old.Free()
+	return nil
 }
 
 func (x *ZhTokenizer) Free() {
+	x.lock.Lock()
+	defer x.lock.Unlock()
 	x.tokenizer.Free()
 }
 
 func (x *ZhTokenizer) Tokenize(input []byte) analysis.TokenSet {
 	result := make(analysis.TokenSet, 0)
 	pos := 1
+	x.lock.RLock()
 	words := x.tokenizer.Tokenize(string(input), gojieba.SearchMode, false)
+	x.lock.RUnlock()
 	for _, word := range words {
 		token := analysis.Token{
 			Term:     []byte(word.Str),
@@ -48,3 +112,17 @@ func (x *ZhTokenizer) Tokenize(input []byte) analysis.TokenSet {
 	}
 	return result
 }
+
+func init() {
+	// the dictionaries are loaded by the first analysis naming the tokenizer
+	registry.RegisterTokenizerType(Name, func(config map[string]interface{}) (analysis.Tokenizer, error) {
+		name, err := registry.ConfigString(config, "user_dict", "")
+		if err != nil {
+			return nil, err
+		}
+		if name != "" {
+			return NewZhWithDict(name), nil
+		}
+		return NewZh(), nil
+	})
+}
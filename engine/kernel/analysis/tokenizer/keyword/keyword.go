@@ -21,4 +21,7 @@ func (t *Tokenizer) Tokenize(input []byte) analysis.TokenSet {
 
 func init() {
 	registry.RegisterTokenizer(Name, New())
+	registry.RegisterTokenizerType(Name, func(config map[string]interface{}) (analysis.Tokenizer, error) {
+		return New(), nil
+	})
 }
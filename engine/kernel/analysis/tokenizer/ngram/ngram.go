@@ -0,0 +1,126 @@
+package ngram
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/analysis/filter/character"
+	"github.com/tiglabs/baudengine/kernel/analysis/filter/ngram"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const (
+	Name     = "ngram"
+	EdgeName = "edge_ngram"
+)
+
+var _ analysis.Tokenizer = &Tokenizer{}
+
+// Tokenizer tokenizes the words of the text into their n-grams of min to max characters, each
+// n-gram at the next position with its own offsets. The words are the runs of the token
+// characters, the whole text without them. The edge tokenizer keeps the n-grams of the start
+// of the words only.
+type Tokenizer struct {
+	min, max int
+	edge     bool
+	in       character.FilterOutFunc
+}
+
+func New(min, max int, in character.FilterOutFunc) (*Tokenizer, error) {
+	if min < 1 || max < min {
+		return nil, fmt.Errorf("invalid gram sizes %d to %d", min, max)
+	}
+	return &Tokenizer{min: min, max: max, in: in}, nil
+}
+
+func NewEdge(min, max int, in character.FilterOutFunc) (*Tokenizer, error) {
+	t, err := New(min, max, in)
+	if err != nil {
+		return nil, err
+	}
+	t.edge = true
+	return t, nil
+}
+
+func (t *Tokenizer) Tokenize(input []byte) analysis.TokenSet {
+	var sets analysis.TokenSet
+	pos := 1
+	// the byte offsets of the runes of the word and the one of its end
+	var offsets []int
+	var runes []rune
+	word := func(end int) {
+		if len(runes) == 0 {
+			return
+		}
+		offsets = append(offsets, end)
+		for start := 0; start < len(runes); start++ {
+			for n := t.min; n <= t.max && start+n <= len(runes); n++ {
+				sets = append(sets, &analysis.Token{
+					Start:    offsets[start],
+					End:      offsets[start+n],
+					Term:     []byte(string(runes[start : start+n])),
+					Position: pos,
+					Type:     analysis.Text,
+				})
+				pos++
+			}
+			if t.edge {
+				break
+			}
+		}
+		offsets, runes = offsets[:0], runes[:0]
+	}
+	end := len(input)
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRune(input[i:])
+		if r == utf8.RuneError {
+			end = i
+			break
+		}
+		if t.in == nil || t.in(r) {
+			offsets = append(offsets, i)
+			runes = append(runes, r)
+		} else {
+			word(i)
+		}
+		i += size
+	}
+	word(end)
+	return sets
+}
+
+// the tokenizer of the analysis of a space, of the token_chars, of the whole text without them
+func newTokenizer(config map[string]interface{}, edge bool) (analysis.Tokenizer, error) {
+	min, max, err := ngram.GramSizes(config, 1, 2)
+	if err != nil {
+		return nil, err
+	}
+	classes, err := registry.ConfigStrings(config, "token_chars")
+	if err != nil {
+		return nil, err
+	}
+	chars, err := registry.ConfigString(config, "chars", "")
+	if err != nil {
+		return nil, err
+	}
+	var in character.FilterOutFunc
+	if len(classes) > 0 || chars != "" {
+		if in, err = character.Classes(classes, chars); err != nil {
+			return nil, err
+		}
+	}
+	if edge {
+		return NewEdge(min, max, in)
+	}
+	return New(min, max, in)
+}
+
+func init() {
+	registry.RegisterTokenizerType(Name, func(config map[string]interface{}) (analysis.Tokenizer, error) {
+		return newTokenizer(config, false)
+	})
+	registry.RegisterTokenizerType(EdgeName, func(config map[string]interface{}) (analysis.Tokenizer, error) {
+		return newTokenizer(config, true)
+	})
+}
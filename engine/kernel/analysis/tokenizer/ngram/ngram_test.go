@@ -0,0 +1,43 @@
+package ngram
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestTokenize(t *testing.T) {
+	tokenizer, _ := New(2, 2, nil)
+	set := tokenizer.Tokenize([]byte("a路由"))
+	expect := []struct {
+		term       string
+		start, end int
+		position   int
+	}{{"a路", 0, 4, 1}, {"路由", 1, 7, 2}}
+	if len(set) != len(expect) {
+		t.Fatalf("%d tokens", len(set))
+	}
+	for i, e := range expect {
+		if token := set[i]; string(token.Term) != e.term || token.Start != e.start || token.End != e.end || token.Position != e.position {
+			t.Errorf("token %d: %v", i, token)
+		}
+	}
+
+	tokenizer, _ = NewEdge(1, 3, func(r rune) bool { return unicode.IsLetter(r) })
+	set = tokenizer.Tokenize([]byte("Quick fox"))
+	expect = []struct {
+		term       string
+		start, end int
+		position   int
+	}{{"Q", 0, 1, 1}, {"Qu", 0, 2, 2}, {"Qui", 0, 3, 3}, {"f", 6, 7, 4}, {"fo", 6, 8, 5}, {"fox", 6, 9, 6}}
+	if len(set) != len(expect) {
+		t.Fatalf("%d edge tokens", len(set))
+	}
+	for i, e := range expect {
+		if token := set[i]; string(token.Term) != e.term || token.Start != e.start || token.End != e.end || token.Position != e.position {
+			t.Errorf("edge token %d: %v", i, token)
+		}
+	}
+	if _, err := New(0, 2, nil); err == nil {
+		t.Fatal("invalid gram sizes")
+	}
+}
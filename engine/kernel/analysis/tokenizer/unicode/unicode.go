@@ -50,11 +50,14 @@ func (ut *UnicodeTokenizer) Tokenize(input []byte) analysis.TokenSet {
 		}
 	}
 
-	return nil
+	return sets
 }
 
 
 func init() {
 	registry.RegisterTokenizer(Name, NewUnicodeTokenizer())
+	registry.RegisterTokenizerType(Name, func(config map[string]interface{}) (analysis.Tokenizer, error) {
+		return NewUnicodeTokenizer(), nil
+	})
 }
 
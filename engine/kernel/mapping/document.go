@@ -11,6 +11,7 @@ import (
 	"sync/atomic"
 
 	"github.com/tiglabs/baudengine/kernel/document"
+	"github.com/tiglabs/baudengine/kernel/registry"
 )
 
 type DocumentMapping struct {
@@ -978,6 +979,18 @@ func parseSchema(data []byte) ([]*DocumentMapping, error) {
 	return nil, errors.New("invalid schema")
 }
 
+// ParseAnalysis builds the analysis the schema declares, the analyzers of the field mappings are
+// named from it, see registry.Analysis.
+func ParseAnalysis(data []byte) (*registry.Scope, error) {
+	schema := struct {
+		Analysis *registry.Analysis `json:"analysis"`
+	}{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+	return registry.NewScope(schema.Analysis)
+}
+
 type valSlice []reflect.Value
 
 func (p valSlice) Len() int {
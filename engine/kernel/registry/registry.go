@@ -28,17 +28,53 @@ func GetTokenFilter(name string) analysis.TokenFilter {
 	return analyzers.GetTokenFilter(name)
 }
 
+func RegisterCharFilter(name string, filter analysis.TextFilter) {
+	analyzers.RegisterCharFilter(name, filter)
+}
+
+func GetCharFilter(name string) analysis.TextFilter {
+	return analyzers.GetCharFilter(name)
+}
+
+// The constructors build the tokenizers and the filters of a type with the parameters a space
+// declares in the analysis of its schema, see Scope.
+type TokenizerConstructor func(config map[string]interface{}) (analysis.Tokenizer, error)
+type TokenFilterConstructor func(config map[string]interface{}) (analysis.TokenFilter, error)
+type CharFilterConstructor func(config map[string]interface{}) (analysis.TextFilter, error)
+
+func RegisterTokenizerType(typ string, constructor TokenizerConstructor) {
+	analyzers.RegisterTokenizerType(typ, constructor)
+}
+
+func RegisterTokenFilterType(typ string, constructor TokenFilterConstructor) {
+	analyzers.RegisterTokenFilterType(typ, constructor)
+}
+
+func RegisterCharFilterType(typ string, constructor CharFilterConstructor) {
+	analyzers.RegisterCharFilterType(typ, constructor)
+}
+
 type Registry struct {
-	analyzers map[string]analysis.Analyzer
-	tokenizer map[string]analysis.Tokenizer
-	filter    map[string]analysis.TokenFilter
+	analyzers  map[string]analysis.Analyzer
+	tokenizer  map[string]analysis.Tokenizer
+	filter     map[string]analysis.TokenFilter
+	charFilter map[string]analysis.TextFilter
+
+	tokenizerTypes  map[string]TokenizerConstructor
+	filterTypes     map[string]TokenFilterConstructor
+	charFilterTypes map[string]CharFilterConstructor
 }
 
 func NewRegistry() *Registry {
 	return &Registry{
-		analyzers: make(map[string]analysis.Analyzer),
-		tokenizer: make(map[string]analysis.Tokenizer),
-		filter: make(map[string]analysis.TokenFilter)}
+		analyzers:       make(map[string]analysis.Analyzer),
+		tokenizer:       make(map[string]analysis.Tokenizer),
+		filter:          make(map[string]analysis.TokenFilter),
+		charFilter:      make(map[string]analysis.TextFilter),
+		tokenizerTypes:  make(map[string]TokenizerConstructor),
+		filterTypes:     make(map[string]TokenFilterConstructor),
+		charFilterTypes: make(map[string]CharFilterConstructor),
+	}
 }
 
 func (r *Registry) RegisterAnalyzer(name string, analyzer analysis.Analyzer) {
@@ -89,6 +125,41 @@ func (r *Registry) GetTokenFilter(name string) analysis.TokenFilter {
 	return nil
 }
 
+func (r *Registry) RegisterCharFilter(name string, filter analysis.TextFilter) {
+	if _, ok := r.charFilter[name]; ok {
+		return
+	}
+	r.charFilter[name] = filter
+}
+
+func (r *Registry) GetCharFilter(name string) analysis.TextFilter {
+	if cf, ok := r.charFilter[name]; ok {
+		return cf
+	}
+	return nil
+}
+
+func (r *Registry) RegisterTokenizerType(typ string, constructor TokenizerConstructor) {
+	if _, ok := r.tokenizerTypes[typ]; ok {
+		return
+	}
+	r.tokenizerTypes[typ] = constructor
+}
+
+func (r *Registry) RegisterTokenFilterType(typ string, constructor TokenFilterConstructor) {
+	if _, ok := r.filterTypes[typ]; ok {
+		return
+	}
+	r.filterTypes[typ] = constructor
+}
+
+func (r *Registry) RegisterCharFilterType(typ string, constructor CharFilterConstructor) {
+	if _, ok := r.charFilterTypes[typ]; ok {
+		return
+	}
+	r.charFilterTypes[typ] = constructor
+}
+
 func init() {
 	analyzers = NewRegistry()
-}
\ No newline at end of file
+}
@@ -0,0 +1,258 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+// CustomAnalyzer is the type of an analyzer composed of the char filters, the tokenizer and the
+// token filters it names.
+const CustomAnalyzer = "custom"
+
+// Analysis is the analysis a space declares in its schema, ES style:
+//
+//	"analysis": {
+//	  "char_filter": {"no_dash": {"type": "character", "chars": "-"}},
+//	  "tokenizer": {"words": {"type": "character", "token_chars": ["letter", "digit"]}},
+//	  "filter": {"brand_stop": {"type": "stop", "stopwords": ["the", "of"]}},
+//	  "analyzer": {"brand": {"type": "custom", "char_filter": ["no_dash"], "tokenizer": "words",
+//	    "filter": ["lower", "brand_stop"]}}
+//	}
+//
+// A char filter, a tokenizer or a token filter is built by the constructor registered for its
+// type with its parameters, an analyzer names the ones of the space or the registered ones. The
+// user dictionary of a chinese tokenizer ("user_dict") and the stop words of a stop filter
+// ("stopwords_dict") may be dictionaries of the cluster, hot swapped as their versions are pushed,
+// see util/dict.
+type Analysis struct {
+	CharFilters map[string]map[string]interface{} `json:"char_filter,omitempty"`
+	Tokenizers  map[string]map[string]interface{} `json:"tokenizer,omitempty"`
+	Filters     map[string]map[string]interface{} `json:"filter,omitempty"`
+	Analyzers   map[string]map[string]interface{} `json:"analyzer,omitempty"`
+}
+
+// Scope holds the analysis of a space, the names it declares shadow the registered ones for
+// the space only.
+type Scope struct {
+	charFilters map[string]analysis.TextFilter
+	tokenizers  map[string]analysis.Tokenizer
+	filters     map[string]analysis.TokenFilter
+	analyzers   map[string]analysis.Analyzer
+}
+
+// NewScope builds the analysis of a space, a nil analysis is the registered one only.
+func NewScope(a *Analysis) (*Scope, error) {
+	s := &Scope{
+		charFilters: make(map[string]analysis.TextFilter),
+		tokenizers:  make(map[string]analysis.Tokenizer),
+		filters:     make(map[string]analysis.TokenFilter),
+		analyzers:   make(map[string]analysis.Analyzer),
+	}
+	if a == nil {
+		return s, nil
+	}
+	for name, config := range a.CharFilters {
+		constructor, ok := analyzers.charFilterTypes[configType(config)]
+		if !ok {
+			return nil, fmt.Errorf("char filter %s: unknown type %v", name, config["type"])
+		}
+		filter, err := constructor(config)
+		if err != nil {
+			return nil, fmt.Errorf("char filter %s: %v", name, err)
+		}
+		s.charFilters[name] = filter
+	}
+	for name, config := range a.Tokenizers {
+		constructor, ok := analyzers.tokenizerTypes[configType(config)]
+		if !ok {
+			return nil, fmt.Errorf("tokenizer %s: unknown type %v", name, config["type"])
+		}
+		tokenizer, err := constructor(config)
+		if err != nil {
+			return nil, fmt.Errorf("tokenizer %s: %v", name, err)
+		}
+		s.tokenizers[name] = tokenizer
+	}
+	for name, config := range a.Filters {
+		constructor, ok := analyzers.filterTypes[configType(config)]
+		if !ok {
+			return nil, fmt.Errorf("filter %s: unknown type %v", name, config["type"])
+		}
+		filter, err := constructor(config)
+		if err != nil {
+			return nil, fmt.Errorf("filter %s: %v", name, err)
+		}
+		s.filters[name] = filter
+	}
+	for name, config := range a.Analyzers {
+		analyzer, err := s.newAnalyzer(config)
+		if err != nil {
+			return nil, fmt.Errorf("analyzer %s: %v", name, err)
+		}
+		s.analyzers[name] = analyzer
+	}
+	return s, nil
+}
+
+// newAnalyzer builds a custom analyzer, an analyzer of another type is the registered one of
+// the type.
+func (s *Scope) newAnalyzer(config map[string]interface{}) (analysis.Analyzer, error) {
+	typ := configType(config)
+	if typ != CustomAnalyzer {
+		if analyzer := analyzers.GetAnalyzer(typ); analyzer != nil {
+			return analyzer, nil
+		}
+		return nil, fmt.Errorf("unknown type %v", config["type"])
+	}
+	name, err := ConfigString(config, "tokenizer", "")
+	if err != nil {
+		return nil, err
+	}
+	custom := &analysis.CustomAnalyzer{}
+	if custom.Tokenizer, err = s.Tokenizer(name); err != nil {
+		return nil, err
+	}
+	names, err := ConfigStrings(config, "char_filter")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		filter, err := s.CharFilter(name)
+		if err != nil {
+			return nil, err
+		}
+		custom.CharFilters = append(custom.CharFilters, filter)
+	}
+	if names, err = ConfigStrings(config, "filter"); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		filter, err := s.TokenFilter(name)
+		if err != nil {
+			return nil, err
+		}
+		custom.TokenFilters = append(custom.TokenFilters, filter)
+	}
+	return custom, nil
+}
+
+// AnalyzerNamed returns the analyzer of the name, of the space or registered, nil if there is
+// none.
+func (s *Scope) AnalyzerNamed(name string) analysis.Analyzer {
+	if analyzer, ok := s.analyzers[name]; ok {
+		return analyzer
+	}
+	return analyzers.GetAnalyzer(name)
+}
+
+// Tokenizer returns the tokenizer of the name: of the space, registered or the one of the
+// registered type without parameters.
+func (s *Scope) Tokenizer(name string) (analysis.Tokenizer, error) {
+	if tokenizer, ok := s.tokenizers[name]; ok {
+		return tokenizer, nil
+	}
+	if tokenizer := analyzers.GetTokenizer(name); tokenizer != nil {
+		return tokenizer, nil
+	}
+	if constructor, ok := analyzers.tokenizerTypes[name]; ok {
+		return constructor(nil)
+	}
+	return nil, fmt.Errorf("unknown tokenizer %q", name)
+}
+
+// TokenFilter returns the token filter of the name, as Tokenizer.
+func (s *Scope) TokenFilter(name string) (analysis.TokenFilter, error) {
+	if filter, ok := s.filters[name]; ok {
+		return filter, nil
+	}
+	if filter := analyzers.GetTokenFilter(name); filter != nil {
+		return filter, nil
+	}
+	if constructor, ok := analyzers.filterTypes[name]; ok {
+		return constructor(nil)
+	}
+	return nil, fmt.Errorf("unknown filter %q", name)
+}
+
+// CharFilter returns the char filter of the name, as Tokenizer.
+func (s *Scope) CharFilter(name string) (analysis.TextFilter, error) {
+	if filter, ok := s.charFilters[name]; ok {
+		return filter, nil
+	}
+	if filter := analyzers.GetCharFilter(name); filter != nil {
+		return filter, nil
+	}
+	if constructor, ok := analyzers.charFilterTypes[name]; ok {
+		return constructor(nil)
+	}
+	return nil, fmt.Errorf("unknown char filter %q", name)
+}
+
+func configType(config map[string]interface{}) string {
+	typ, _ := config["type"].(string)
+	return typ
+}
+
+// ConfigString returns the string parameter of the config, def without it.
+func ConfigString(config map[string]interface{}, name, def string) (string, error) {
+	v, ok := config[name]
+	if !ok {
+		return def, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("parameter %s is not a string: %v", name, v)
+	}
+	return s, nil
+}
+
+// ConfigStrings returns the parameter of the config of a string or of a list of strings.
+func ConfigStrings(config map[string]interface{}, name string) ([]string, error) {
+	switch v := config[name].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		list := make([]string, len(v))
+		for i, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("parameter %s is not a list of strings: %v", name, v)
+			}
+			list[i] = s
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("parameter %s is not a list of strings: %v", name, v)
+	}
+}
+
+// ConfigInt returns the integer parameter of the config, def without it.
+func ConfigInt(config map[string]interface{}, name string, def int) (int, error) {
+	switch v := config[name].(type) {
+	case nil:
+		return def, nil
+	case int:
+		return v, nil
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), nil
+		}
+	}
+	return 0, fmt.Errorf("parameter %s is not an integer: %v", name, config[name])
+}
+
+// ConfigBool returns the boolean parameter of the config, def without it.
+func ConfigBool(config map[string]interface{}, name string, def bool) (bool, error) {
+	switch v := config[name].(type) {
+	case nil:
+		return def, nil
+	case bool:
+		return v, nil
+	}
+	return false, fmt.Errorf("parameter %s is not a boolean: %v", name, config[name])
+}
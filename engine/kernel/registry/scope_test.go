@@ -0,0 +1,74 @@
+package registry_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/character"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/lower"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/tokenizer/character"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/tokenizer/keyword"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+func terms(tokens analysis.TokenSet) string {
+	var list []string
+	for _, token := range tokens {
+		list = append(list, string(token.Term))
+	}
+	return strings.Join(list, " ")
+}
+
+func TestScope(t *testing.T) {
+	config := `{
+		"char_filter": {"no_dash": {"type": "character", "chars": "-"}},
+		"tokenizer": {"words": {"type": "character", "token_chars": ["letter", "digit"]}},
+		"analyzer": {
+			"product": {"type": "custom", "char_filter": ["no_dash"], "tokenizer": "words", "filter": ["lower"]},
+			"code": {"type": "custom", "tokenizer": "keyword", "filter": "lower"}
+		}
+	}`
+	var a registry.Analysis
+	if err := json.Unmarshal([]byte(config), &a); err != nil {
+		t.Fatal(err)
+	}
+	scope, err := registry.NewScope(&a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := terms(scope.AnalyzerNamed("product").Analyze([]byte("Wi-Fi Router, AX3000!"))); text != "wifi router ax3000" {
+		t.Fatalf("product analyzed to %q", text)
+	}
+	if text := terms(scope.AnalyzerNamed("code").Analyze([]byte("AB-12 x"))); text != "ab-12 x" {
+		t.Fatalf("code analyzed to %q", text)
+	}
+	if scope.AnalyzerNamed("unknown") != nil {
+		t.Fatal("unknown analyzer")
+	}
+	if _, err := scope.Tokenizer("keyword"); err != nil {
+		t.Fatal(err)
+	}
+	// the analysis of the space is not the one of the others
+	other, _ := registry.NewScope(nil)
+	if other.AnalyzerNamed("product") != nil {
+		t.Fatal("analyzer of another space")
+	}
+
+	for _, bad := range []string{
+		`{"tokenizer": {"t": {"type": "unknown"}}}`,
+		`{"tokenizer": {"t": {"type": "character", "token_chars": ["vowel"]}}}`,
+		`{"analyzer": {"a": {"type": "custom", "tokenizer": "missing"}}}`,
+		`{"analyzer": {"a": {"type": "custom", "tokenizer": "keyword", "filter": ["missing"]}}}`,
+		`{"analyzer": {"a": {"type": "custom", "tokenizer": "keyword", "filter": [1]}}}`,
+	} {
+		var a registry.Analysis
+		if err := json.Unmarshal([]byte(bad), &a); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := registry.NewScope(&a); err == nil {
+			t.Errorf("%s: built", bad)
+		}
+	}
+}
@@ -16,6 +16,8 @@ type SearchRequest struct {
 	// default 0
 	From      int    `json:"from,omitempty"`
 	Fields    []string `json:"fields"`
+	// "-field" sorts descending, default by score
+	Sort      []string `json:"sort,omitempty"`
 	Query     []byte `json:"query"`
 	Explain   bool   `json:"explain,omitempty"`
 	Timeout   time.Duration `json:"time_out,omitempty"`
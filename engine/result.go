@@ -12,6 +12,7 @@ type HitDoc struct {
 	Id         string       `json:"_id"`
 	Score      float64      `json:"_score"`
 	Source     interface{}       `json:"_source"`
+	Sort       []string     `json:"sort,omitempty"`
 }
 
 type Hits struct {
@@ -3,12 +3,14 @@ package gm
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/util"
 	"github.com/tiglabs/baudengine/util/log"
 	"github.com/tiglabs/baudengine/util/netutil"
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -33,6 +35,16 @@ const (
 	PARTITION_ID    = "partition_id"
 	SPACE_SCHEMA    = "space_schema"
 	REPLICA_ID      = "replica_id"
+	NODE_ID         = "node_id"
+	DICT_NAME       = "dict_name"
+	DICT_WORDS      = "dict_words"
+	REPLICA_NUM     = "replica_num"
+	ONE_PER_ZONE    = "one_per_zone"
+	MIN_ZONES       = "min_zones"
+	LEADER_ZONE     = "leader_zone"
+	STORAGE_BYTES   = "storage_bytes"
+	DOC_COUNT       = "doc_count"
+	WRITE_QPS       = "write_qps"
 )
 
 type ApiServer struct {
@@ -97,18 +109,29 @@ func (s *ApiServer) initAdminHandler() {
 	s.httpServer.Handle(netutil.PUT, "/manage/db/rename", s.handleDbRename)
 	s.httpServer.Handle(netutil.GET, "/manage/db/list", s.handleDbList)
 	s.httpServer.Handle(netutil.GET, "/manage/db/detail", s.handleDbDetail)
+	s.httpServer.Handle(netutil.PUT, "/manage/db/quota", s.handleDbQuota)
+	s.httpServer.Handle(netutil.GET, "/manage/db/usage", s.handleDbUsage)
 
 	s.httpServer.Handle(netutil.POST, "/manage/space/create", s.handleSpaceCreate)
 	s.httpServer.Handle(netutil.DELETE, "/manage/space/delete", s.handleSpaceDelete)
 	s.httpServer.Handle(netutil.PUT, "/manage/space/rename", s.handleSpaceRename)
+	s.httpServer.Handle(netutil.PUT, "/manage/space/schema", s.handleSpaceSchema)
+	s.httpServer.Handle(netutil.PUT, "/manage/space/policy", s.handleSpacePolicy)
+	s.httpServer.Handle(netutil.PUT, "/manage/space/quota", s.handleSpaceQuota)
 	s.httpServer.Handle(netutil.GET, "/manage/space/list", s.handleSpaceList)
 	s.httpServer.Handle(netutil.GET, "/manage/space/detail", s.handleSpaceDetail)
 
 	s.httpServer.Handle(netutil.GET, "/manage/partition/list", s.handlePartitionList)
 	s.httpServer.Handle(netutil.GET, "/manage/partition/detail", s.handlePartitionDetail)
+	s.httpServer.Handle(netutil.PUT, "/manage/partition/leader", s.handlePartitionLeader)
+	s.httpServer.Handle(netutil.PUT, "/manage/node/drain_leaders", s.handleNodeDrainLeaders)
 
 	s.httpServer.Handle(netutil.POST, "/manage/replica/create", s.handleReplicaCreate)
 	s.httpServer.Handle(netutil.DELETE, "/manage/replica/delete", s.handleReplicaDelete)
+
+	s.httpServer.Handle(netutil.POST, "/manage/dict/put", s.handleDictPut)
+	s.httpServer.Handle(netutil.GET, "/manage/dict/list", s.handleDictList)
+	s.httpServer.Handle(netutil.GET, "/manage/dict/detail", s.handleDictDetail)
 }
 
 func (s *ApiServer) handleZoneCreate(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
@@ -261,6 +284,49 @@ func (s *ApiServer) handleDbDetail(w http.ResponseWriter, r *http.Request, param
 	sendReply(w, newHttpSucReply(db))
 }
 
+func (s *ApiServer) handleDbQuota(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	quota, err := checkQuotaParams(w, r)
+	if err != nil {
+		return
+	}
+
+	if err := s.cluster.UpdateDbQuota(dbName, quota); err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(""))
+}
+
+// handleDbUsage replies the usage of the db and of its spaces against their quotas, as of the last
+// quota check of the leader.
+func (s *ApiServer) handleDbUsage(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+
+	db := s.cluster.DbCache.FindDbByName(dbName)
+	if db == nil {
+		sendReply(w, newHttpErrReply(ErrDbNotExists))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(s.cluster.quotaUsages.getDbUsage(db)))
+}
+
 func (s *ApiServer) handleSpaceCreate(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
 	if err := s.checkLeader(w); err != nil {
 		return
@@ -291,11 +357,19 @@ func (s *ApiServer) handleSpaceCreate(w http.ResponseWriter, r *http.Request, pa
 	if err != nil {
 		return
 	}
+	var replicaPolicy *metapb.ReplicaPolicy
+	if r.FormValue(REPLICA_NUM) != "" {
+		replicaPolicy, err = checkReplicaPolicyParams(w, r)
+		if err != nil {
+			return
+		}
+	}
 
 	policy := &PartitionPolicy{
 		Key:      partitionKey,
 		Function: partitionFunc,
 		Number:   partitionNum,
+		Replicas: replicaPolicy,
 	}
 	space, err := s.cluster.CreateSpace(dbName, spaceName, spaceSchema, policy)
 	if err != nil {
@@ -353,6 +427,84 @@ func (s *ApiServer) handleSpaceRename(w http.ResponseWriter, r *http.Request, pa
 	sendReply(w, newHttpSucReply(""))
 }
 
+func (s *ApiServer) handleSpaceSchema(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	spaceName, err := checkMissingParam(w, r, SPACE_NAME)
+	if err != nil {
+		return
+	}
+	spaceSchema, err := checkMissingParam(w, r, SPACE_SCHEMA)
+	if err != nil {
+		return
+	}
+
+	if err := s.cluster.UpdateSpaceSchema(dbName, spaceName, spaceSchema); err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(""))
+}
+
+func (s *ApiServer) handleSpacePolicy(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	spaceName, err := checkMissingParam(w, r, SPACE_NAME)
+	if err != nil {
+		return
+	}
+	replicaPolicy, err := checkReplicaPolicyParams(w, r)
+	if err != nil {
+		return
+	}
+
+	if err := s.cluster.UpdateSpaceReplicaPolicy(dbName, spaceName, replicaPolicy); err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(""))
+}
+
+func (s *ApiServer) handleSpaceQuota(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	spaceName, err := checkMissingParam(w, r, SPACE_NAME)
+	if err != nil {
+		return
+	}
+	quota, err := checkQuotaParams(w, r)
+	if err != nil {
+		return
+	}
+
+	if err := s.cluster.UpdateSpaceQuota(dbName, spaceName, quota); err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(""))
+}
+
 func (s *ApiServer) handleSpaceList(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
 	dbName, err := checkMissingParam(w, r, DB_NAME)
 	if err != nil {
@@ -390,7 +542,29 @@ func (s *ApiServer) handleSpaceDetail(w http.ResponseWriter, r *http.Request, pa
 		return
 	}
 
-	sendReply(w, newHttpSucReply(space))
+	sendReply(w, newHttpSucReply(newSpaceDetail(space)))
+}
+
+// SpaceDetail is the space with how its partitions violate its replica policy.
+type SpaceDetail struct {
+	*Space
+	Violations map[metapb.PartitionID][]string `json:"replica_policy_violations"`
+}
+
+func newSpaceDetail(space *Space) *SpaceDetail {
+	space.propertyLock.RLock()
+	defer space.propertyLock.RUnlock()
+
+	policy := replicaPolicyOf(space)
+	violations := make(map[metapb.PartitionID][]string)
+	for _, partition := range space.partitions {
+		replicas, leader := partition.getReplicasAndLeader()
+		if partitionViolations := policyViolations(policy, replicas, leader); len(partitionViolations) != 0 {
+			violations[partition.ID] = partitionViolations
+		}
+	}
+
+	return &SpaceDetail{Space: space, Violations: violations}
 }
 
 func (s *ApiServer) handlePartitionList(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
@@ -431,6 +605,44 @@ func (s *ApiServer) handlePartitionDetail(w http.ResponseWriter, r *http.Request
 	sendReply(w, newHttpSucReply(partition))
 }
 
+func (s *ApiServer) handlePartitionLeader(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	partitionId, err := checkMissingAndUint64Param(w, r, PARTITION_ID)
+	if err != nil {
+		return
+	}
+	replicaId, err := checkMissingAndUint64Param(w, r, REPLICA_ID)
+	if err != nil {
+		return
+	}
+	if err := s.cluster.ChangeLeader(partitionId, replicaId); err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(""))
+}
+
+func (s *ApiServer) handleNodeDrainLeaders(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	zoneName, err := checkMissingParam(w, r, ZONE_NAME)
+	if err != nil {
+		return
+	}
+	nodeId, err := checkMissingAndUint32Param(w, r, NODE_ID)
+	if err != nil {
+		return
+	}
+
+	sendReply(w, newHttpSucReply(s.cluster.DrainLeaders(zoneName, nodeId)))
+}
+
 func (s *ApiServer) handleReplicaCreate(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
 	if err := s.checkLeader(w); err != nil {
 		return
@@ -475,6 +687,67 @@ func (s *ApiServer) handleReplicaDelete(w http.ResponseWriter, r *http.Request,
 	sendReply(w, newHttpSucReply(""))
 }
 
+func (s *ApiServer) handleDictPut(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dictName, err := checkMissingParam(w, r, DICT_NAME)
+	if err != nil {
+		return
+	}
+	// one word a line, an empty dictionary has no words
+	words := make([]string, 0)
+	for _, word := range strings.Split(r.FormValue(DICT_WORDS), "\n") {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, word)
+		}
+	}
+
+	dict, err := s.cluster.PutDict(dictName, words)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(map[string]interface{}{"name": dict.Name, "version": dict.Dictionary.Version}))
+}
+
+func (s *ApiServer) handleDictList(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	versions, err := s.cluster.GetAllDictVersions()
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(versions))
+}
+
+func (s *ApiServer) handleDictDetail(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	dictName, err := checkMissingParam(w, r, DICT_NAME)
+	if err != nil {
+		return
+	}
+
+	dict, err := s.cluster.GetDict(dictName)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+	partitions, err := s.cluster.GetDictPartitions(dictName)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(map[string]interface{}{
+		"name":       dict.Name,
+		"version":    dict.Dictionary.Version,
+		"words":      dict.Words,
+		"partitions": partitions,
+	}))
+}
+
 // http protocal
 
 type HttpReply struct {
@@ -588,6 +861,55 @@ func checkMissingAndUint64Param(w http.ResponseWriter, r *http.Request, paramNam
 	return uint64(paramValInt), nil
 }
 
+// checkReplicaPolicyParams reads the replica policy of the request, the replica number is required.
+func checkReplicaPolicyParams(w http.ResponseWriter, r *http.Request) (*metapb.ReplicaPolicy, error) {
+	replicaNum, err := checkMissingAndUint32Param(w, r, REPLICA_NUM)
+	if err != nil {
+		return nil, err
+	}
+	policy := &metapb.ReplicaPolicy{
+		ReplicaNum: replicaNum,
+		LeaderZone: r.FormValue(LEADER_ZONE),
+	}
+	if r.FormValue(MIN_ZONES) != "" {
+		if policy.MinZones, err = checkMissingAndUint32Param(w, r, MIN_ZONES); err != nil {
+			return nil, err
+		}
+	}
+	if onePerZone := r.FormValue(ONE_PER_ZONE); onePerZone != "" {
+		if policy.OnePerZone, err = strconv.ParseBool(onePerZone); err != nil {
+			reply := newHttpErrReply(ErrParamError)
+			newMsg := fmt.Sprintf("%s, unmatched type[%s]", reply.Msg, ONE_PER_ZONE)
+			reply.Msg = newMsg
+			sendReply(w, reply)
+			return nil, ErrParamError
+		}
+	}
+	return policy, nil
+}
+
+// checkQuotaParams reads the quota of the request, a missing or zero limit is unlimited.
+func checkQuotaParams(w http.ResponseWriter, r *http.Request) (*metapb.Quota, error) {
+	quota := new(metapb.Quota)
+	var err error
+	if r.FormValue(STORAGE_BYTES) != "" {
+		if quota.StorageBytes, err = checkMissingAndUint64Param(w, r, STORAGE_BYTES); err != nil {
+			return nil, err
+		}
+	}
+	if r.FormValue(DOC_COUNT) != "" {
+		if quota.DocCount, err = checkMissingAndUint64Param(w, r, DOC_COUNT); err != nil {
+			return nil, err
+		}
+	}
+	if r.FormValue(WRITE_QPS) != "" {
+		if quota.WriteQps, err = checkMissingAndUint32Param(w, r, WRITE_QPS); err != nil {
+			return nil, err
+		}
+	}
+	return quota, nil
+}
+
 func sendReply(w http.ResponseWriter, httpReply *HttpReply) {
 	reply, err := json.Marshal(httpReply)
 	if err != nil {
@@ -17,6 +17,8 @@ type Cluster struct {
 
 	DbCache        *DBCache
 	PartitionCache *PartitionCache
+	// the usages of the last quota check
+	quotaUsages *QuotaUsages
 
 	cancelDBWatch        topo.CancelFunc
 	cancelSpaceWatch     topo.CancelFunc
@@ -32,6 +34,7 @@ func NewCluster(ctx context.Context, config *Config, gm *GM) *Cluster {
 		gm:             gm,
 		DbCache:        NewDBCache(),
 		PartitionCache: NewPartitionCache(),
+		quotaUsages:    NewQuotaUsages(),
 	}
 }
 
@@ -419,6 +422,16 @@ func (c *Cluster) RenameDb(srcDbName, destDbName string) error {
 
 // space
 func (c *Cluster) CreateSpace(dbName, spaceName, spaceSchema string, policy *PartitionPolicy) (*Space, error) {
+	if policy.Replicas != nil {
+		zonesName, err := c.GetAllZonesName()
+		if err != nil {
+			return nil, err
+		}
+		if err := validateReplicaPolicy(policy.Replicas, zonesName); err != nil {
+			return nil, err
+		}
+	}
+
 	c.clusterLock.Lock()
 	defer c.clusterLock.Unlock()
 
@@ -513,6 +526,125 @@ func (c *Cluster) RenameSpace(dbName, srcSpaceName, destSpaceName string) error
 	return nil
 }
 
+func (c *Cluster) UpdateSpaceSchema(dbName, spaceName, spaceSchema string) error {
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	db := c.DbCache.FindDbByName(dbName)
+	if db == nil {
+		return ErrDbNotExists
+	}
+	space := db.SpaceCache.FindSpaceByName(spaceName)
+	if space == nil {
+		return ErrSpaceNotExists
+	}
+
+	oldSchema := space.Schema
+	space.setSchema(spaceSchema)
+	if err := space.update(); err != nil {
+		space.setSchema(oldSchema)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSpaceReplicaPolicy replaces the replica policy of the space, the workers converge the
+// partitions to it.
+func (c *Cluster) UpdateSpaceReplicaPolicy(dbName, spaceName string, policy *metapb.ReplicaPolicy) error {
+	zonesName, err := c.GetAllZonesName()
+	if err != nil {
+		return err
+	}
+	if err := validateReplicaPolicy(policy, zonesName); err != nil {
+		return err
+	}
+
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	db := c.DbCache.FindDbByName(dbName)
+	if db == nil {
+		return ErrDbNotExists
+	}
+	space := db.SpaceCache.FindSpaceByName(spaceName)
+	if space == nil {
+		return ErrSpaceNotExists
+	}
+
+	oldPolicy := space.ReplicaPolicy
+	space.setReplicaPolicy(policy)
+	if err := space.update(); err != nil {
+		space.setReplicaPolicy(oldPolicy)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDbQuota replaces the quota of the db, the quota worker checks the usage against it.
+func (c *Cluster) UpdateDbQuota(dbName string, quota *metapb.Quota) error {
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	db := c.DbCache.FindDbByName(dbName)
+	if db == nil {
+		return ErrDbNotExists
+	}
+
+	oldQuota := db.Quota
+	db.setQuota(quota)
+	if err := db.update(); err != nil {
+		db.setQuota(oldQuota)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSpaceQuota replaces the quota of the space, the quota worker checks the usage against it.
+func (c *Cluster) UpdateSpaceQuota(dbName, spaceName string, quota *metapb.Quota) error {
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	db := c.DbCache.FindDbByName(dbName)
+	if db == nil {
+		return ErrDbNotExists
+	}
+	space := db.SpaceCache.FindSpaceByName(spaceName)
+	if space == nil {
+		return ErrSpaceNotExists
+	}
+
+	oldQuota := space.Quota
+	space.setQuota(quota)
+	if err := space.update(); err != nil {
+		space.setQuota(oldQuota)
+		return err
+	}
+
+	return nil
+}
+
+// setSpaceQuotaExceeded marks the space over its quota or back under it, the zone masters watch
+// the mark.
+func (c *Cluster) setSpaceQuotaExceeded(space *Space, exceeded bool) error {
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	if space.isQuotaExceeded() == exceeded {
+		return nil
+	}
+	space.setQuotaExceeded(exceeded)
+	if err := space.update(); err != nil {
+		space.setQuotaExceeded(!exceeded)
+		return err
+	}
+	log.Info("space[%v] of db[%v] quota exceeded[%v]", space.Name, space.DbName, exceeded)
+
+	return nil
+}
+
 // replica
 func (c *Cluster) CreateReplica(partitionId metapb.PartitionID, replicaZoneName string) error {
 	c.clusterLock.Lock()
@@ -612,3 +744,101 @@ func (c *Cluster) DeleteReplica(partitionId metapb.PartitionID, replicaId metapb
 
 	return nil
 }
+
+// leader
+
+// LeaderChange is the result of moving the leadership of a partition.
+type LeaderChange struct {
+	PartitionId metapb.PartitionID `json:"partition_id"`
+	ReplicaId   metapb.ReplicaID   `json:"replica_id"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// ChangeLeader moves the leadership of the partition to the replica, it returns when the zone
+// master of the replica has seen the new leader in the heartbeats.
+func (c *Cluster) ChangeLeader(partitionId metapb.PartitionID, replicaId metapb.ReplicaID) error {
+	partition := c.PartitionCache.FindPartitionById(partitionId)
+	if partition == nil {
+		log.Error("partition not found, partitionId:[%d]", partitionId)
+		return ErrPartitionNotExists
+	}
+	replica := partition.findReplicaById(replicaId)
+	if replica == nil {
+		log.Error("partition replica not exist, partitionId:[%d], replicaId:[%d]", partitionId, replicaId)
+		return ErrReplicaNotExists
+	}
+
+	return c.changeLeader(partition, replica)
+}
+
+// DrainLeaders moves the leaderships of the partitions led by the node of the zone to the other
+// replicas, the ones of the leader zones of the spaces first.
+func (c *Cluster) DrainLeaders(zoneName string, nodeId metapb.NodeID) []*LeaderChange {
+	changes := make([]*LeaderChange, 0)
+	for _, partition := range c.PartitionCache.GetAllPartitions() {
+		replicas, leader := partition.getReplicasAndLeader()
+		if leader == nil || leader.Zone != zoneName || leader.NodeID != nodeId {
+			continue
+		}
+
+		change := &LeaderChange{PartitionId: partition.ID}
+		changes = append(changes, change)
+		replica := c.selectDrainTarget(partition, replicas, leader)
+		if replica == nil {
+			change.Error = ErrReplicaNotExists.Error()
+			continue
+		}
+		change.ReplicaId = replica.ID
+		if err := c.changeLeader(partition, replica); err != nil {
+			change.Error = err.Error()
+		}
+	}
+
+	return changes
+}
+
+// selectDrainTarget returns the follower to lead the partition instead of the leader, nil when
+// the partition has none.
+func (c *Cluster) selectDrainTarget(partition *Partition, replicas []metapb.Replica, leader *metapb.Replica) *metapb.Replica {
+	var leaderZone string
+	if db := c.DbCache.FindDbById(partition.DB); db != nil {
+		if space := db.SpaceCache.FindSpaceById(partition.Space); space != nil {
+			leaderZone = replicaPolicyOf(space).LeaderZone
+		}
+	}
+
+	var target *metapb.Replica
+	for i := range replicas {
+		replica := &replicas[i]
+		if replica.ID == leader.ID || (replica.Zone == leader.Zone && replica.NodeID == leader.NodeID) {
+			continue
+		}
+		if target == nil || (replica.Zone == leaderZone && target.Zone != leaderZone) {
+			target = replica
+		}
+	}
+	return target
+}
+
+func (c *Cluster) changeLeader(partition *Partition, replica *metapb.Replica) error {
+	replicaZoneAddr, err := getZMLeaderAddr(replica.Zone, c.config.ClusterCfg.GmNodeId)
+	if err != nil {
+		log.Error("getZMLeaderAddr() replicaZoneAddr error. err:[%v]", err)
+		return err
+	}
+	if replicaZoneAddr == "" {
+		log.Info("getZMLeaderAddr() replicaZoneAddr has no leader now.")
+		return ErrNoMSLeader
+	}
+	isGrabed, err := partition.grabPartitionTaskLock(topo.GlobalZone, "partition", string(partition.ID))
+	if err != nil {
+		log.Error("partition grab Partition Task error, partition:[%d]", partition.ID)
+		return err
+	}
+	if !isGrabed {
+		log.Info("partition has task now, partition:[%d]", partition.ID)
+		return ErrPartitionHasTaskNow
+	}
+
+	return GetZoneMasterRpcClientSingle(c.config).ChangeLeader(replicaZoneAddr, partition.ID, replica)
+}
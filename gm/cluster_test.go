@@ -0,0 +1,42 @@
+package gm
+
+import (
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"testing"
+)
+
+func TestSelectDrainTarget(t *testing.T) {
+	c := &Cluster{DbCache: NewDBCache(), PartitionCache: NewPartitionCache()}
+	db := NewDBByTopo(&topo.DBTopo{DB: &metapb.DB{ID: 1, Name: "db1"}})
+	db.SpaceCache.AddSpace(NewSpaceByTopo(&topo.SpaceTopo{Space: &metapb.Space{ID: 2, DB: 1, Name: "space1",
+		ReplicaPolicy: &metapb.ReplicaPolicy{ReplicaNum: 3, LeaderZone: "beijing"}}}))
+	c.DbCache.AddDb(db)
+	partition := NewPartitionByTopo(&topo.PartitionTopo{Partition: &metapb.Partition{ID: 3, DB: 1, Space: 2}})
+
+	replicas := []metapb.Replica{
+		{ID: 1, Zone: "shanghai", NodeID: 1},
+		{ID: 2, Zone: "shanghai", NodeID: 1},
+		{ID: 3, Zone: "shanghai", NodeID: 2},
+		{ID: 4, Zone: "beijing", NodeID: 1},
+	}
+	leader := &replicas[0]
+	// the followers of the leader zone of the space lead first
+	if target := c.selectDrainTarget(partition, replicas, leader); target == nil || target.ID != 4 {
+		t.Fatalf("drain target: %v", target)
+	}
+	// a follower on the node drained does not lead
+	if target := c.selectDrainTarget(partition, replicas[:3], leader); target == nil || target.ID != 3 {
+		t.Fatalf("drain target: %v", target)
+	}
+	if target := c.selectDrainTarget(partition, replicas[:2], leader); target != nil {
+		t.Fatalf("drain target of a partition without other node: %v", target)
+	}
+
+	// a partition of a space not cached drains to its first follower
+	other := NewPartitionByTopo(&topo.PartitionTopo{Partition: &metapb.Partition{ID: 5, DB: 1, Space: 6}})
+	replicas = []metapb.Replica{replicas[0], replicas[2], replicas[3]}
+	if target := c.selectDrainTarget(other, replicas, &replicas[0]); target == nil || target.ID != 3 {
+		t.Fatalf("drain target: %v", target)
+	}
+}
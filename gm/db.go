@@ -93,6 +93,13 @@ func (db *DB) rename(newDbName string) {
 	db.Name = newDbName
 }
 
+func (db *DB) setQuota(quota *metapb.Quota) {
+	db.propertyLock.Lock()
+	defer db.propertyLock.Unlock()
+
+	db.Quota = quota
+}
+
 type DBCache struct {
 	lock     sync.RWMutex
 	dbs      map[metapb.DBID]*DB
@@ -0,0 +1,116 @@
+package gm
+
+import (
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util/log"
+	"golang.org/x/net/context"
+	"strings"
+)
+
+// DictPartition is the version of a dictionary a partition indexes with, as its leader reported.
+type DictPartition struct {
+	Zone        string             `json:"zone"`
+	PartitionId metapb.PartitionID `json:"partition_id"`
+	Version     uint64             `json:"version"`
+}
+
+// PutDict stores the words as the next version of the dictionary, the zone masters push it to the
+// partition servers by the heartbeats. The versions of a dictionary only grow, so a dictionary is
+// emptied instead of deleted.
+func (c *Cluster) PutDict(name string, words []string) (*topo.DictTopo, error) {
+	if len(name) == 0 || strings.Contains(name, "/") {
+		return nil, ErrParamError
+	}
+
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), ETCD_TIMEOUT)
+	defer cancel()
+
+	dict, err := TopoServer.GetDict(ctx, name)
+	if err == topo.ErrNoNode {
+		dict, err = TopoServer.AddDict(ctx, &metapb.Dictionary{Name: name, Version: 1, Words: words})
+		if err != nil {
+			log.Error("TopoServer AddDict error, err: [%v]", err)
+			return nil, err
+		}
+		return dict, nil
+	}
+	if err != nil {
+		log.Error("TopoServer GetDict error, err: [%v]", err)
+		return nil, err
+	}
+
+	dict.Dictionary = &metapb.Dictionary{Name: name, Version: dict.Dictionary.Version + 1, Words: words}
+	if err := TopoServer.UpdateDict(ctx, dict); err != nil {
+		log.Error("TopoServer UpdateDict error, err: [%v]", err)
+		return nil, err
+	}
+
+	return dict, nil
+}
+
+func (c *Cluster) GetDict(name string) (*topo.DictTopo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ETCD_TIMEOUT)
+	defer cancel()
+
+	dict, err := TopoServer.GetDict(ctx, name)
+	if err == topo.ErrNoNode {
+		return nil, ErrDictNotExists
+	}
+	if err != nil {
+		log.Error("TopoServer GetDict error, err: [%v]", err)
+		return nil, err
+	}
+
+	return dict, nil
+}
+
+// GetAllDictVersions returns the current versions of the dictionaries by name.
+func (c *Cluster) GetAllDictVersions() (map[string]uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ETCD_TIMEOUT)
+	defer cancel()
+
+	dicts, err := TopoServer.GetAllDicts(ctx)
+	if err != nil && err != topo.ErrNoNode {
+		log.Error("TopoServer GetAllDicts error, err: [%v]", err)
+		return nil, err
+	}
+
+	versions := make(map[string]uint64, len(dicts))
+	for _, dict := range dicts {
+		versions[dict.Name] = dict.Dictionary.Version
+	}
+
+	return versions, nil
+}
+
+// GetDictPartitions returns the versions of the dictionary the partitions of all zones index with,
+// the partitions not indexing with it are left out.
+func (c *Cluster) GetDictPartitions(name string) ([]*DictPartition, error) {
+	zoneNames, err := c.GetAllZonesName()
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := make([]*DictPartition, 0)
+	for _, zoneName := range zoneNames {
+		partitionIds, err := getPartitionIdsByZone(zoneName)
+		if err != nil {
+			return nil, err
+		}
+		for _, partitionId := range partitionIds {
+			info, err := getPartitionInfoByZone(zoneName, partitionId)
+			if err != nil {
+				return nil, err
+			}
+			if version, ok := info.Dicts[name]; ok {
+				partitions = append(partitions, &DictPartition{Zone: zoneName, PartitionId: partitionId, Version: version})
+			}
+		}
+	}
+
+	return partitions, nil
+}
@@ -31,6 +31,8 @@ var (
 	ErrLocalDbOpsFailed                = errors.New("local storage db operation error")
 	ErrUnknownRaftCmdType              = errors.New("unknown raft command type")
 	ErrRouteNotFound                   = errors.New("route not found")
+	ErrDictNotExists                   = errors.New("dictionary not exists")
+	ErrLeaderChangeTimeout             = errors.New("leader change timeout")
 
 	ErrRpcGetClientFailed  = errors.New("get rpc client handle is failed")
 	ErrRpcInvalidResp      = errors.New("invalid rpc response")
@@ -73,6 +75,8 @@ const (
 	ERRCODE_LOCALDB_OPTFAILED
 
 	ERRCODE_METHOD_NOT_IMPLEMENT
+	ERRCODE_DICT_NOTEXISTS
+	ERRCODE_LEADER_CHANGE_TIMEOUT
 
 //	ERRCODE_UNKNOWN_RAFTCMDTYPE
 )
@@ -92,9 +96,11 @@ var Err2CodeMap = map[error]int32{
 	ErrSpaceNotExists: ERRCODE_SPACE_NOTEXISTS,
 	ErrPSNotExists:    ERRCODE_PS_NOTEXISTS,
 
-	ErrGenIdFailed:        ERRCODE_GENID_FAILED,
-	ErrLocalDbOpsFailed:   ERRCODE_LOCALDB_OPTFAILED,
-	ErrMethodNotImplement: ERRCODE_METHOD_NOT_IMPLEMENT,
+	ErrGenIdFailed:         ERRCODE_GENID_FAILED,
+	ErrLocalDbOpsFailed:    ERRCODE_LOCALDB_OPTFAILED,
+	ErrMethodNotImplement:  ERRCODE_METHOD_NOT_IMPLEMENT,
+	ErrDictNotExists:       ERRCODE_DICT_NOTEXISTS,
+	ErrLeaderChangeTimeout: ERRCODE_LEADER_CHANGE_TIMEOUT,
 }
 
 var Err2RpcCodeMap = map[error]metapb.RespCode{
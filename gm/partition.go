@@ -112,6 +112,16 @@ func (p *Partition) countReplicas() int {
 	return len(p.Replicas)
 }
 
+// getReplicasAndLeader returns a copy of the replicas and the leader of the partition.
+func (p *Partition) getReplicasAndLeader() ([]metapb.Replica, *metapb.Replica) {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	replicas := make([]metapb.Replica, len(p.Replicas))
+	copy(replicas, p.Replicas)
+	return replicas, p.ReplicaLeader
+}
+
 func (p *Partition) getAllReplicas() []*metapb.Replica {
 	p.propertyLock.RLock()
 	defer p.propertyLock.RUnlock()
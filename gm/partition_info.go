@@ -38,6 +38,44 @@ func getPartitionIdsByZone(zoneName string) ([]metapb.PartitionID, error) {
 	return partitionIds, nil
 }
 
+// getLatestPartitionInfos gets the latest partitionInfo of each partition from different zones, by
+// the conf version and then by the raft term.
+func getLatestPartitionInfos(zonesMap map[string]*Zone) map[metapb.PartitionID]*masterpb.PartitionInfo {
+	partitionInfosCacheMap := make(map[metapb.PartitionID]*masterpb.PartitionInfo)
+	for _, zoneMap := range zonesMap {
+		partitionIdsInZone, err := getPartitionIdsByZone(zoneMap.Name)
+		if err != nil {
+			log.Error("getPartitionIdsByZone error, err:[%v]", err)
+			continue
+		}
+		for _, partitionIdInZone := range partitionIdsInZone {
+			partitionInfo, err := getPartitionInfoByZone(zoneMap.Name, partitionIdInZone)
+			if err != nil {
+				log.Error("getPartitionInfoByZone error, err:[%v]", err)
+				continue
+			}
+			if partitionInfo == nil {
+				continue
+			}
+			partitionInfoInCacheMap := partitionInfosCacheMap[partitionInfo.ID]
+			if partitionInfoInCacheMap == nil {
+				partitionInfosCacheMap[partitionInfo.ID] = partitionInfo
+				continue
+			}
+			if partitionInfo.Epoch.ConfVersion > partitionInfoInCacheMap.Epoch.ConfVersion {
+				partitionInfosCacheMap[partitionInfo.ID] = partitionInfo
+				continue
+			} else if partitionInfo.Epoch.ConfVersion == partitionInfoInCacheMap.Epoch.ConfVersion {
+				if partitionInfo.RaftStatus.Term > partitionInfoInCacheMap.RaftStatus.Term {
+					partitionInfosCacheMap[partitionInfo.ID] = partitionInfo
+					continue
+				}
+			}
+		}
+	}
+	return partitionInfosCacheMap
+}
+
 func pickLeaderReplica(partitionInfo *masterpb.PartitionInfo) *metapb.Replica {
 	if partitionInfo == nil || !partitionInfo.IsLeader {
 		return nil
@@ -0,0 +1,172 @@
+package gm
+
+import (
+	"fmt"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"math/rand"
+)
+
+// the replica policy of the spaces declaring none
+func defaultReplicaPolicy() *metapb.ReplicaPolicy {
+	return &metapb.ReplicaPolicy{ReplicaNum: FIXED_REPLICA_NUM}
+}
+
+func replicaPolicyOf(space *Space) *metapb.ReplicaPolicy {
+	if space.ReplicaPolicy == nil || space.ReplicaPolicy.ReplicaNum == 0 {
+		return defaultReplicaPolicy()
+	}
+	return space.ReplicaPolicy
+}
+
+// validateReplicaPolicy checks the policy can be met by the zones of the cluster.
+func validateReplicaPolicy(policy *metapb.ReplicaPolicy, zonesName []string) error {
+	zones := dataZones(zonesName)
+	if policy.ReplicaNum == 0 || policy.MinZones > policy.ReplicaNum {
+		return ErrParamError
+	}
+	if int(policy.MinZones) > len(zones) || (policy.OnePerZone && int(policy.ReplicaNum) > len(zones)) {
+		return ErrParamError
+	}
+	if policy.LeaderZone != "" {
+		if _, ok := zones[policy.LeaderZone]; !ok {
+			return ErrZoneNotExists
+		}
+	}
+	return nil
+}
+
+// the zones of the partition servers, by name
+func dataZones(zonesName []string) map[string]struct{} {
+	zones := make(map[string]struct{}, len(zonesName))
+	for _, zoneName := range zonesName {
+		if zoneName != topo.GlobalZone {
+			zones[zoneName] = struct{}{}
+		}
+	}
+	return zones
+}
+
+// the numbers of the replicas of the partition in the zones
+func replicasByZone(replicas []metapb.Replica) map[string]int {
+	counts := make(map[string]int)
+	for _, replica := range replicas {
+		counts[replica.Zone]++
+	}
+	return counts
+}
+
+// policyViolations returns how the replicas and the leader of the partition violate the policy.
+func policyViolations(policy *metapb.ReplicaPolicy, replicas []metapb.Replica, leader *metapb.Replica) []string {
+	var violations []string
+	if n := len(replicas); n < int(policy.ReplicaNum) {
+		violations = append(violations, fmt.Sprintf("under replicated: %d of %d replicas", n, policy.ReplicaNum))
+	} else if n > int(policy.ReplicaNum) {
+		violations = append(violations, fmt.Sprintf("over replicated: %d of %d replicas", n, policy.ReplicaNum))
+	}
+	counts := replicasByZone(replicas)
+	if policy.OnePerZone {
+		for zoneName, count := range counts {
+			if count > 1 {
+				violations = append(violations, fmt.Sprintf("%d replicas in zone %s", count, zoneName))
+			}
+		}
+	}
+	if len(counts) < int(policy.MinZones) {
+		violations = append(violations, fmt.Sprintf("replicas in %d of at least %d zones", len(counts), policy.MinZones))
+	}
+	if policy.LeaderZone != "" {
+		if leader == nil {
+			violations = append(violations, "no leader")
+		} else if leader.Zone != policy.LeaderZone {
+			violations = append(violations, fmt.Sprintf("leader in zone %s, not %s", leader.Zone, policy.LeaderZone))
+		}
+	}
+	return violations
+}
+
+// zonesViolated reports whether the zones of the replicas violate the policy.
+func zonesViolated(policy *metapb.ReplicaPolicy, replicas []metapb.Replica) bool {
+	counts := replicasByZone(replicas)
+	if len(counts) < int(policy.MinZones) {
+		return true
+	}
+	if policy.OnePerZone {
+		for _, count := range counts {
+			if count > 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// selectReplicaZone returns the zone of the replica to add to the partition, the zone of the leader
+// without a replica in it, then a zone without replicas, then the zone of the fewest replicas. It
+// returns "" when the policy allows no zone.
+func selectReplicaZone(policy *metapb.ReplicaPolicy, replicas []metapb.Replica, zonesName []string) string {
+	zones := dataZones(zonesName)
+	counts := replicasByZone(replicas)
+	if policy.LeaderZone != "" && counts[policy.LeaderZone] == 0 {
+		if _, ok := zones[policy.LeaderZone]; ok {
+			return policy.LeaderZone
+		}
+	}
+
+	var candidates []string
+	fewest := -1
+	for zoneName := range zones {
+		count := counts[zoneName]
+		if policy.OnePerZone && count > 0 {
+			continue
+		}
+		if fewest < 0 || count < fewest {
+			candidates, fewest = candidates[:0], count
+		}
+		if count == fewest {
+			candidates = append(candidates, zoneName)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[rand.Intn(len(candidates))]
+}
+
+// selectReplicaToRemove returns the replica to remove from the partition, a follower of the zone
+// of the most replicas, one the policy keeps the zones of if it can. It returns nil when the
+// partition has no follower.
+func selectReplicaToRemove(policy *metapb.ReplicaPolicy, replicas []metapb.Replica, leader *metapb.Replica) *metapb.Replica {
+	counts := replicasByZone(replicas)
+	var selected *metapb.Replica
+	for i := range replicas {
+		replica := &replicas[i]
+		if leader != nil && replica.ID == leader.ID {
+			continue
+		}
+		if policy.LeaderZone != "" && replica.Zone == policy.LeaderZone && counts[replica.Zone] == 1 {
+			continue
+		}
+		if selected == nil || counts[replica.Zone] > counts[selected.Zone] {
+			selected = replica
+		}
+	}
+	if selected == nil || (counts[selected.Zone] == 1 && len(counts) <= int(policy.MinZones)) {
+		return nil
+	}
+	return selected
+}
+
+// selectLeaderReplica returns the replica of the leader zone to be the leader of the partition,
+// nil when the leader is in the leader zone or no replica is.
+func selectLeaderReplica(policy *metapb.ReplicaPolicy, replicas []metapb.Replica, leader *metapb.Replica) *metapb.Replica {
+	if policy.LeaderZone == "" || leader == nil || leader.Zone == policy.LeaderZone {
+		return nil
+	}
+	for i := range replicas {
+		if replicas[i].Zone == policy.LeaderZone {
+			return &replicas[i]
+		}
+	}
+	return nil
+}
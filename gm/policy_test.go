@@ -0,0 +1,45 @@
+package gm
+
+import (
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"testing"
+)
+
+func TestReplicaPolicy(t *testing.T) {
+	zonesName := []string{topo.GlobalZone, "beijing", "shanghai", "guangzhou"}
+	policy := &metapb.ReplicaPolicy{ReplicaNum: 3, OnePerZone: true, MinZones: 2, LeaderZone: "beijing"}
+	if err := validateReplicaPolicy(policy, zonesName); err != nil {
+		t.Fatalf("validate policy: %v", err)
+	}
+	if err := validateReplicaPolicy(&metapb.ReplicaPolicy{ReplicaNum: 4, OnePerZone: true}, zonesName); err != ErrParamError {
+		t.Fatalf("validate policy of more replicas than zones: %v", err)
+	}
+	if err := validateReplicaPolicy(&metapb.ReplicaPolicy{ReplicaNum: 3, LeaderZone: "tianjin"}, zonesName); err != ErrZoneNotExists {
+		t.Fatalf("validate policy of unknown leader zone: %v", err)
+	}
+
+	replicas := []metapb.Replica{{ID: 1, Zone: "shanghai"}, {ID: 2, Zone: "shanghai"}}
+	leader := &replicas[0]
+	if violations := policyViolations(policy, replicas, leader); len(violations) != 4 {
+		t.Fatalf("violations: %v", violations)
+	}
+	if zoneName := selectReplicaZone(policy, replicas, zonesName); zoneName != "beijing" {
+		t.Fatalf("replica zone: %s", zoneName)
+	}
+
+	replicas = append(replicas, metapb.Replica{ID: 3, Zone: "beijing"}, metapb.Replica{ID: 4, Zone: "guangzhou"})
+	leader = &replicas[0]
+	if replica := selectReplicaToRemove(policy, replicas, leader); replica == nil || replica.ID != 2 {
+		t.Fatalf("replica to remove: %v", replica)
+	}
+	if replica := selectLeaderReplica(policy, replicas, leader); replica == nil || replica.ID != 3 {
+		t.Fatalf("leader replica: %v", replica)
+	}
+
+	replicas = replicas[1:]
+	leader = &replicas[1]
+	if violations := policyViolations(policy, replicas, leader); len(violations) != 0 {
+		t.Fatalf("violations: %v", violations)
+	}
+}
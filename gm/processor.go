@@ -19,6 +19,7 @@ const (
 	EVENT_TYPE_PARTITION_CREATE
 	EVENT_TYPE_PARTITION_DELETE       // partition is in cluster
 	EVENT_TYPE_FORCE_PARTITION_DELETE // partition is not in cluster
+	EVENT_TYPE_PARTITION_CHANGE_LEADER
 )
 
 var (
@@ -116,7 +117,8 @@ func (pm *ProcessorManager) PushEvent(event *ProcessorEvent) error {
 
 	if event.typ == EVENT_TYPE_PARTITION_CREATE ||
 		event.typ == EVENT_TYPE_PARTITION_DELETE ||
-		event.typ == EVENT_TYPE_FORCE_PARTITION_DELETE {
+		event.typ == EVENT_TYPE_FORCE_PARTITION_DELETE ||
+		event.typ == EVENT_TYPE_PARTITION_CHANGE_LEADER {
 
 		if len(pm.pp.eventCh) >= PARTITION_CHANNEL_LIMIT*0.9 {
 			log.Error("partition channel will full, reject event[%v]", event)
@@ -157,6 +159,12 @@ type PartitionForceDeleteBody struct {
 	partitionId   metapb.PartitionID
 }
 
+type PartitionChangeLeaderBody struct {
+	replicaZMAddr string
+	partitionId   metapb.PartitionID
+	replica       *metapb.Replica
+}
+
 func NewPartitionCreateEvent(
 	replicaZMAddr string,
 	replicaZoneName string,
@@ -201,6 +209,20 @@ func NewPartitionForceDeleteEvent(
 	}
 }
 
+func NewPartitionChangeLeaderEvent(
+	replicaZMAddr string,
+	partitionId metapb.PartitionID,
+	replica *metapb.Replica) *ProcessorEvent {
+	return &ProcessorEvent{
+		typ: EVENT_TYPE_PARTITION_CHANGE_LEADER,
+		body: &PartitionChangeLeaderBody{
+			replicaZMAddr: replicaZMAddr,
+			partitionId:   partitionId,
+			replica:       replica,
+		},
+	}
+}
+
 type Processor interface {
 	Run()
 	Close()
@@ -260,6 +282,13 @@ func (pp *PartitionProcessor) Run() {
 					log.Debug("EVENT_TYPE_FORCE_PARTITION_DELETE replicaZMAddr: [%s], replicaLeaderZMAddr:[%s], partitionId:[%d], replica:[%v]", body.replicaZMAddr, body.replicaLeaderZMAddr, body.partitionId, body.replica)
 					pp.forceDeletePartition(body.replicaZMAddr, body.partitionId)
 				}()
+			} else if event.typ == EVENT_TYPE_PARTITION_CHANGE_LEADER {
+				go func() {
+					defer pp.wg.Done()
+					body := event.body.(*PartitionChangeLeaderBody)
+					log.Debug("EVENT_TYPE_PARTITION_CHANGE_LEADER replicaZMAddr: [%s], partitionId:[%d], replica:[%v]", body.replicaZMAddr, body.partitionId, body.replica)
+					pp.changeLeader(body.replicaZMAddr, body.partitionId, body.replica)
+				}()
 			}
 		}
 	}
@@ -316,3 +345,10 @@ func (pp *PartitionProcessor) forceDeletePartition(replicaZMAddr string, partiti
 		return
 	}
 }
+
+func (pp *PartitionProcessor) changeLeader(replicaZMAddr string, partitionId metapb.PartitionID, replica *metapb.Replica) {
+	if err := GetZoneMasterRpcClientSingle(pp.cluster.gm.config).ChangeLeader(replicaZMAddr, partitionId, replica); err != nil {
+		log.Error("Rpc fail to change leader of partitionId:[%d] to replica[%v] in replicaZMAddr:[%s]. err[%v]", partitionId, replica, replicaZMAddr, err)
+		return
+	}
+}
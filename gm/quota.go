@@ -0,0 +1,162 @@
+package gm
+
+import (
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+	"sync"
+	"time"
+)
+
+const (
+	// the zone masters write the statistics of the partitions once a minute
+	QUOTA_CHECK_INTERVAL = time.Minute
+)
+
+// QuotaUsage is the usage of a db or a space, summed up from the statistics the leaders of its
+// partitions reported.
+type QuotaUsage struct {
+	StorageBytes uint64 `json:"storage_bytes"`
+	DocCount     uint64 `json:"doc_count"`
+	Partitions   int    `json:"partitions"`
+	// the usage of the space, or of its db, is over the quota
+	Exceeded bool `json:"exceeded"`
+}
+
+func (u *QuotaUsage) add(stats *masterpb.PartitionStats) {
+	u.StorageBytes += stats.Size_
+	u.DocCount += stats.DocCount
+	u.Partitions++
+}
+
+func (u *QuotaUsage) over(quota *metapb.Quota) bool {
+	if quota == nil {
+		return false
+	}
+	return (quota.StorageBytes != 0 && u.StorageBytes >= quota.StorageBytes) ||
+		(quota.DocCount != 0 && u.DocCount >= quota.DocCount)
+}
+
+// QuotaUsages keeps the usages of the last quota check.
+type QuotaUsages struct {
+	lock      sync.RWMutex
+	dbs       map[metapb.DBID]QuotaUsage
+	spaces    map[metapb.SpaceID]QuotaUsage
+	checkTime time.Time
+}
+
+func NewQuotaUsages() *QuotaUsages {
+	return &QuotaUsages{
+		dbs:    make(map[metapb.DBID]QuotaUsage),
+		spaces: make(map[metapb.SpaceID]QuotaUsage),
+	}
+}
+
+func (u *QuotaUsages) set(dbs map[metapb.DBID]QuotaUsage, spaces map[metapb.SpaceID]QuotaUsage) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	u.dbs, u.spaces, u.checkTime = dbs, spaces, time.Now()
+}
+
+// DbUsage is the usage of a db with the usages of its spaces by name.
+type DbUsage struct {
+	QuotaUsage
+	Quota     *metapb.Quota          `json:"quota"`
+	Spaces    map[string]*SpaceUsage `json:"spaces"`
+	CheckTime time.Time              `json:"check_time"`
+}
+
+type SpaceUsage struct {
+	QuotaUsage
+	Quota *metapb.Quota `json:"quota"`
+}
+
+func (u *QuotaUsages) getDbUsage(db *DB) *DbUsage {
+	u.lock.RLock()
+	defer u.lock.RUnlock()
+
+	usage := &DbUsage{
+		QuotaUsage: u.dbs[db.ID],
+		Quota:      db.Quota,
+		Spaces:     make(map[string]*SpaceUsage),
+		CheckTime:  u.checkTime,
+	}
+	for _, space := range db.SpaceCache.GetAllSpaces() {
+		usage.Spaces[space.Name] = &SpaceUsage{QuotaUsage: u.spaces[space.ID], Quota: space.Quota}
+	}
+	return usage
+}
+
+// QuotaWorker sums up the usages of the dbs and the spaces, and marks the spaces over their quota
+// or the quota of their db. The zone masters pass the marks to the partition servers, which
+// reject the writes adding data to the spaces marked.
+type QuotaWorker struct {
+	cluster *Cluster
+}
+
+func NewQuotaWorker(cluster *Cluster) *QuotaWorker {
+	return &QuotaWorker{
+		cluster: cluster,
+	}
+}
+
+func (w *QuotaWorker) getName() string {
+	return "Quota-Worker"
+}
+
+func (w *QuotaWorker) getInterval() time.Duration {
+	return QUOTA_CHECK_INTERVAL
+}
+
+func (w *QuotaWorker) run() {
+	zonesMap, err := w.cluster.GetAllZonesMap()
+	if err != nil {
+		log.Error("GetAllZonesMap error, err:[%v]", err)
+		return
+	}
+
+	dbUsages, spaceUsages := w.sumUsages(getLatestPartitionInfos(zonesMap))
+	for _, db := range w.cluster.DbCache.GetAllDBs() {
+		for _, space := range db.SpaceCache.GetAllSpaces() {
+			exceeded := spaceUsages[space.ID].Exceeded
+			if err := w.cluster.setSpaceQuotaExceeded(space, exceeded); err != nil {
+				log.Error("fail to mark the quota of space[%v] exceeded[%v]. err:[%v]", space.Name, exceeded, err)
+			}
+		}
+	}
+	w.cluster.quotaUsages.set(dbUsages, spaceUsages)
+}
+
+// sumUsages sums up the statistics the leaders reported by the dbs and the spaces of their
+// partitions, and checks them against the quotas of the dbs and the spaces cached.
+func (w *QuotaWorker) sumUsages(partitionInfos map[metapb.PartitionID]*masterpb.PartitionInfo) (map[metapb.DBID]QuotaUsage, map[metapb.SpaceID]QuotaUsage) {
+	dbUsages := make(map[metapb.DBID]QuotaUsage)
+	spaceUsages := make(map[metapb.SpaceID]QuotaUsage)
+	for partitionId, partitionInfo := range partitionInfos {
+		if !partitionInfo.IsLeader {
+			continue
+		}
+		partition := w.cluster.PartitionCache.FindPartitionById(partitionId)
+		if partition == nil {
+			continue
+		}
+		dbUsage, spaceUsage := dbUsages[partition.DB], spaceUsages[partition.Space]
+		dbUsage.add(&partitionInfo.Statistics)
+		spaceUsage.add(&partitionInfo.Statistics)
+		dbUsages[partition.DB], spaceUsages[partition.Space] = dbUsage, spaceUsage
+	}
+
+	for _, db := range w.cluster.DbCache.GetAllDBs() {
+		dbUsage := dbUsages[db.ID]
+		dbUsage.Exceeded = dbUsage.over(db.Quota)
+		dbUsages[db.ID] = dbUsage
+
+		for _, space := range db.SpaceCache.GetAllSpaces() {
+			spaceUsage := spaceUsages[space.ID]
+			spaceUsage.Exceeded = dbUsage.Exceeded || spaceUsage.over(space.Quota)
+			spaceUsages[space.ID] = spaceUsage
+		}
+	}
+	return dbUsages, spaceUsages
+}
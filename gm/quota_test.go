@@ -0,0 +1,72 @@
+package gm
+
+import (
+	"fmt"
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"testing"
+)
+
+func TestSumUsages(t *testing.T) {
+	c := &Cluster{DbCache: NewDBCache(), PartitionCache: NewPartitionCache(), quotaUsages: NewQuotaUsages()}
+	addDb := func(id metapb.DBID, quota *metapb.Quota, spaces ...*metapb.Space) {
+		db := NewDBByTopo(&topo.DBTopo{DB: &metapb.DB{ID: id, Name: fmt.Sprintf("db%d", id), Quota: quota}})
+		for _, space := range spaces {
+			db.SpaceCache.AddSpace(NewSpaceByTopo(&topo.SpaceTopo{Space: space}))
+		}
+		c.DbCache.AddDb(db)
+	}
+	// the first space is over its quota, the second is not, the spaces of the second db are over
+	// the quota of their db only
+	addDb(1, nil,
+		&metapb.Space{ID: 11, DB: 1, Name: "space1", Quota: &metapb.Quota{StorageBytes: 100}},
+		&metapb.Space{ID: 12, DB: 1, Name: "space2", Quota: &metapb.Quota{DocCount: 100}})
+	addDb(2, &metapb.Quota{DocCount: 15},
+		&metapb.Space{ID: 21, DB: 2, Name: "space1"},
+		&metapb.Space{ID: 22, DB: 2, Name: "space2"})
+
+	infos := make(map[metapb.PartitionID]*masterpb.PartitionInfo)
+	addPartition := func(id metapb.PartitionID, db metapb.DBID, space metapb.SpaceID, size, docs uint64, leader bool) {
+		c.PartitionCache.AddPartition(NewPartitionByTopo(&topo.PartitionTopo{Partition: &metapb.Partition{ID: id, DB: db, Space: space}}))
+		infos[id] = &masterpb.PartitionInfo{ID: id, IsLeader: leader,
+			Statistics: masterpb.PartitionStats{Size_: size, DocCount: docs}}
+	}
+	addPartition(1, 1, 11, 60, 5, true)
+	addPartition(2, 1, 11, 40, 5, true)
+	addPartition(3, 1, 12, 50, 99, true)
+	addPartition(4, 2, 21, 10, 10, true)
+	addPartition(5, 2, 22, 10, 10, true)
+	// the partitions not led, or not cached, are not counted
+	addPartition(6, 1, 12, 50, 50, false)
+	infos[7] = &masterpb.PartitionInfo{ID: 7, IsLeader: true, Statistics: masterpb.PartitionStats{Size_: 1000, DocCount: 1000}}
+
+	dbUsages, spaceUsages := NewQuotaWorker(c).sumUsages(infos)
+	expectDbs := map[metapb.DBID]QuotaUsage{
+		1: {StorageBytes: 150, DocCount: 109, Partitions: 3},
+		2: {StorageBytes: 20, DocCount: 20, Partitions: 2, Exceeded: true},
+	}
+	expectSpaces := map[metapb.SpaceID]QuotaUsage{
+		11: {StorageBytes: 100, DocCount: 10, Partitions: 2, Exceeded: true},
+		12: {StorageBytes: 50, DocCount: 99, Partitions: 1},
+		21: {StorageBytes: 10, DocCount: 10, Partitions: 1, Exceeded: true},
+		22: {StorageBytes: 10, DocCount: 10, Partitions: 1, Exceeded: true},
+	}
+	for id, expect := range expectDbs {
+		if dbUsages[id] != expect {
+			t.Fatalf("usage of db %d: expect %+v, got %+v", id, expect, dbUsages[id])
+		}
+	}
+	for id, expect := range expectSpaces {
+		if spaceUsages[id] != expect {
+			t.Fatalf("usage of space %d: expect %+v, got %+v", id, expect, spaceUsages[id])
+		}
+	}
+
+	c.quotaUsages.set(dbUsages, spaceUsages)
+	usage := c.quotaUsages.getDbUsage(c.DbCache.FindDbById(1))
+	if usage.QuotaUsage != expectDbs[1] || len(usage.Spaces) != 2 || usage.Spaces["space1"].QuotaUsage != expectSpaces[11] ||
+		usage.Spaces["space1"].Quota.StorageBytes != 100 {
+		t.Fatalf("unexpected usage of db %+v", usage)
+	}
+}
@@ -12,6 +12,7 @@ type PartitionPolicy struct {
 	Key      string
 	Function string
 	Number   uint64
+	Replicas *metapb.ReplicaPolicy
 }
 
 type Space struct {
@@ -46,6 +47,7 @@ func NewSpace(dbId metapb.DBID, dbName, spaceName, spaceSchema string, policy *P
 			KeyField: policy.Key,
 			KeyFunc:  policy.Function,
 		},
+		ReplicaPolicy: policy.Replicas,
 	}
 
 	spaceTopo := &topo.SpaceTopo{
@@ -130,6 +132,41 @@ func (s *Space) rename(newName string) {
 	s.Name = newName
 }
 
+func (s *Space) setSchema(schema string) {
+	s.propertyLock.Lock()
+	defer s.propertyLock.Unlock()
+
+	s.Schema = schema
+}
+
+func (s *Space) setReplicaPolicy(policy *metapb.ReplicaPolicy) {
+	s.propertyLock.Lock()
+	defer s.propertyLock.Unlock()
+
+	s.ReplicaPolicy = policy
+}
+
+func (s *Space) setQuota(quota *metapb.Quota) {
+	s.propertyLock.Lock()
+	defer s.propertyLock.Unlock()
+
+	s.Quota = quota
+}
+
+func (s *Space) setQuotaExceeded(exceeded bool) {
+	s.propertyLock.Lock()
+	defer s.propertyLock.Unlock()
+
+	s.QuotaExceeded = exceeded
+}
+
+func (s *Space) isQuotaExceeded() bool {
+	s.propertyLock.RLock()
+	defer s.propertyLock.RUnlock()
+
+	return s.QuotaExceeded
+}
+
 // SpaceCache
 
 type SpaceCache struct {
@@ -36,6 +36,7 @@ func NewWorkerManager(cluster *Cluster) *WorkerManager {
 
 func (wm *WorkerManager) Start() error {
 	wm.addWorker(NewSpaceStateTransitionWorker(wm.cluster))
+	wm.addWorker(NewQuotaWorker(wm.cluster))
 
 	wm.workersLock.RLock()
 	defer wm.workersLock.RUnlock()
@@ -139,40 +140,7 @@ func (w *SpaceStateTransitionWorker) run() {
 		log.Error("GetAllZonesName error, err:[%v]", err)
 		return
 	}
-	partitionInfosCacheMap := make(map[metapb.PartitionID]*masterpb.PartitionInfo)
-
-	// Get latest partitionInfo from different zones
-	for _, zoneMap := range zonesMap {
-		partitionIdsInZone, err := getPartitionIdsByZone(zoneMap.Name)
-		if err != nil {
-			log.Error("getPartitionIdsByZone error, err:[%v]", err)
-			continue
-		}
-		for _, partitionIdInZone := range partitionIdsInZone {
-			partitionInfo, err := getPartitionInfoByZone(zoneMap.Name, partitionIdInZone)
-			if err != nil {
-				log.Error("getPartitionInfoByZone error, err:[%v]", err)
-				continue
-			}
-			if partitionInfo == nil {
-				continue
-			}
-			partitionInfoInCacheMap := partitionInfosCacheMap[partitionInfo.ID]
-			if partitionInfoInCacheMap == nil {
-				partitionInfosCacheMap[partitionInfo.ID] = partitionInfo
-				continue
-			}
-			if partitionInfo.Epoch.ConfVersion > partitionInfoInCacheMap.Epoch.ConfVersion {
-				partitionInfosCacheMap[partitionInfo.ID] = partitionInfo
-				continue
-			} else if partitionInfo.Epoch.ConfVersion == partitionInfoInCacheMap.Epoch.ConfVersion {
-				if partitionInfo.RaftStatus.Term > partitionInfoInCacheMap.RaftStatus.Term {
-					partitionInfosCacheMap[partitionInfo.ID] = partitionInfo
-					continue
-				}
-			}
-		}
-	}
+	partitionInfosCacheMap := getLatestPartitionInfos(zonesMap)
 
 	for partitionId, partitionInfoInCacheMap := range partitionInfosCacheMap {
 		replicaLeaderMeta := pickLeaderReplica(partitionInfoInCacheMap)
@@ -212,7 +180,7 @@ func (w *SpaceStateTransitionWorker) run() {
 						return
 					}
 				} else if space.Status == metapb.SS_Running {
-					err := handleSpaceStateSSRunning(db, space, partitionsMap, zonesName)
+					err := handleSpaceStateSSRunning(db, space, partitionsMap, zonesName, w.cluster)
 					if err != nil {
 						log.Error("handleSpaceStateSSRunning error, err:[%v]", err)
 						return
@@ -255,28 +223,12 @@ func handleSpaceStateSSInit(db *DB, space *Space, partitionsMap map[metapb.Parti
 		log.Error("space has no partition, db:[%s], space:[%s]", db.Name, space.Name)
 		return nil
 	}
+	policy := replicaPolicyOf(space)
 	for _, partition := range partitionsMap {
-		if partition.countReplicas() < FIXED_REPLICA_NUM {
+		replicas, _ := partition.getReplicasAndLeader()
+		if len(replicas) < int(policy.ReplicaNum) {
 			isSpaceReady = false
-			replicaZoneName := NewZoneSelector().SelectTarget(zonesName)
-			replicaZoneAddr, replicaLeaderZoneAddr, err := getReplicaZoneAddrAndReplicaLeaderZoneAddrForCreate(replicaZoneName, partition, cluster)
-			if err != nil {
-				log.Error("getReplicaZoneAddrAndRelicaLeaderZoneAddr error, err:[%v]", err)
-				continue
-			}
-			isGrabed, err := partition.grabPartitionTaskLock(topo.GlobalZone, "partition", string(partition.ID))
-			if err != nil {
-				log.Error("partition grab Partition Task error, db:[%s], space:[%s], partition:[%d]", db.Name, space.Name, partition.ID)
-				continue
-			}
-			if !isGrabed {
-				log.Info("partition has task now, db:[%s], space:[%s], partition:[%d]", db.Name, space.Name, partition.ID)
-				continue
-			}
-			if err := GetPMSingle(cluster).PushEvent(NewPartitionCreateEvent(replicaZoneAddr, replicaZoneName, replicaLeaderZoneAddr, partition)); err != nil {
-				log.Error("fail to push event for creating partition[%v].", partition)
-				continue
-			}
+			addReplica(db, space, partition, selectReplicaZone(policy, replicas, zonesName), cluster)
 		}
 	}
 	if isSpaceReady {
@@ -286,23 +238,111 @@ func handleSpaceStateSSInit(db *DB, space *Space, partitionsMap map[metapb.Parti
 	return nil
 }
 
-func handleSpaceStateSSRunning(db *DB, space *Space, partitionsMap map[metapb.PartitionID]*Partition, zonesName []string) error {
+// handleSpaceStateSSRunning converges the partitions to the replica policy of the space, one step
+// per partition and run: missing replicas are added first, then extra ones are removed, and last
+// the leader is moved to the leader zone.
+func handleSpaceStateSSRunning(db *DB, space *Space, partitionsMap map[metapb.PartitionID]*Partition, zonesName []string, cluster *Cluster) error {
 	if partitionsMap == nil || len(partitionsMap) == 0 {
 		log.Error("space has no partition, db:[%s], space:[%s]", db.Name, space.Name)
 		return nil
 	}
+	policy := replicaPolicyOf(space)
 	for _, partition := range partitionsMap {
-		if partition.countReplicas() != FIXED_REPLICA_NUM {
-			if partition.countReplicas() < FIXED_REPLICA_NUM {
-				// TODO add UMP告警
-			} else if partition.countReplicas() > FIXED_REPLICA_NUM {
-				// TODO add UMP告警
+		replicas, leader := partition.getReplicasAndLeader()
+		violations := policyViolations(policy, replicas, leader)
+		if len(violations) == 0 {
+			continue
+		}
+		log.Warn("partition[%d] violates the replica policy, db:[%s], space:[%s], violations:%v", partition.ID, db.Name, space.Name, violations)
+		if leader == nil {
+			// repaired when the partition has a leader again
+			continue
+		}
+
+		if len(replicas) < int(policy.ReplicaNum) {
+			addReplica(db, space, partition, selectReplicaZone(policy, replicas, zonesName), cluster)
+		} else if zonesViolated(policy, replicas) && len(replicas) <= int(policy.ReplicaNum) {
+			// add a replica in an empty zone, a later run removes one of the crowded zone
+			if zoneName := selectReplicaZone(policy, replicas, zonesName); zoneName != "" && replicasByZone(replicas)[zoneName] == 0 {
+				addReplica(db, space, partition, zoneName, cluster)
+			}
+		} else if len(replicas) > int(policy.ReplicaNum) {
+			if replica := selectReplicaToRemove(policy, replicas, leader); replica != nil {
+				removeReplica(db, space, partition, replica, cluster)
 			}
+		} else if replica := selectLeaderReplica(policy, replicas, leader); replica != nil {
+			changeLeader(db, space, partition, replica, cluster)
 		}
 	}
 	return nil
 }
 
+// addReplica pushes the event creating a replica of the partition in the zone.
+func addReplica(db *DB, space *Space, partition *Partition, replicaZoneName string, cluster *Cluster) {
+	if replicaZoneName == "" {
+		log.Error("no zone for a replica by the replica policy, db:[%s], space:[%s], partition:[%d]", db.Name, space.Name, partition.ID)
+		return
+	}
+	replicaZoneAddr, replicaLeaderZoneAddr, err := getReplicaZoneAddrAndReplicaLeaderZoneAddrForCreate(replicaZoneName, partition, cluster)
+	if err != nil {
+		log.Error("getReplicaZoneAddrAndRelicaLeaderZoneAddr error, err:[%v]", err)
+		return
+	}
+	if !grabPartitionTask(db, space, partition) {
+		return
+	}
+	if err := GetPMSingle(cluster).PushEvent(NewPartitionCreateEvent(replicaZoneAddr, replicaZoneName, replicaLeaderZoneAddr, partition)); err != nil {
+		log.Error("fail to push event for creating partition[%v].", partition)
+	}
+}
+
+// removeReplica pushes the event removing the replica of the partition.
+func removeReplica(db *DB, space *Space, partition *Partition, replica *metapb.Replica, cluster *Cluster) {
+	replicaZoneAddr, replica, replicaLeaderZoneAddr, err := getReplicaZoneAddrAndReplicaLeaderZoneAddrForDelete(partition, nil, replica, cluster)
+	if err != nil {
+		log.Error("getReplicaZoneAddrAndRelicaLeaderZoneAddr error, err:[%v]", err)
+		return
+	}
+	if !grabPartitionTask(db, space, partition) {
+		return
+	}
+	if err := GetPMSingle(cluster).PushEvent(NewPartitionDeleteEvent(replicaZoneAddr, replicaLeaderZoneAddr, partition.ID, replica)); err != nil {
+		log.Error("fail to push event for deleting replica[%v] of partition[%v].", replica, partition)
+	}
+}
+
+// changeLeader pushes the event moving the leadership of the partition to the replica.
+func changeLeader(db *DB, space *Space, partition *Partition, replica *metapb.Replica, cluster *Cluster) {
+	replicaZoneAddr, err := getZMLeaderAddr(replica.Zone, cluster.config.ClusterCfg.GmNodeId)
+	if err != nil {
+		log.Error("getZMLeaderAddr() replicaZoneAddr error. err:[%v]", err)
+		return
+	}
+	if replicaZoneAddr == "" {
+		log.Info("getZMLeaderAddr() replicaZoneAddr has no leader now.")
+		return
+	}
+	if !grabPartitionTask(db, space, partition) {
+		return
+	}
+	if err := GetPMSingle(cluster).PushEvent(NewPartitionChangeLeaderEvent(replicaZoneAddr, partition.ID, replica)); err != nil {
+		log.Error("fail to push event for changing leader of partition[%v] to replica[%v].", partition, replica)
+	}
+}
+
+func grabPartitionTask(db *DB, space *Space, partition *Partition) bool {
+	isGrabed, err := partition.grabPartitionTaskLock(topo.GlobalZone, "partition", string(partition.ID))
+	if err != nil {
+		log.Error("partition grab Partition Task error, db:[%s], space:[%s], partition:[%d]", db.Name, space.Name, partition.ID)
+		return false
+	}
+	if !isGrabed {
+		log.Info("partition has task now, db:[%s], space:[%s], partition:[%d]", db.Name, space.Name, partition.ID)
+		return false
+	}
+	return true
+}
+
 func handleSpaceStateSSDeleting(db *DB, space *Space, partitionsMap map[metapb.PartitionID]*Partition, zonesName []string, cluster *Cluster) error {
 	var isSpaceCanDelete = true
 	if partitionsMap == nil || len(partitionsMap) == 0 {
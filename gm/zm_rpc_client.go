@@ -16,6 +16,8 @@ import (
 //go:generate mockgen -destination zm_rpc_client_mock.go -package gm github.com/tiglabs/baudengine/gm ZoneMasterRpcClient
 const (
 	ZONE_MASTER_GRPC_REQUEST_TIMEOUT = 5 * time.Second
+	// the zone master waits for the leader change in the heartbeats
+	ZONE_MASTER_CHANGE_LEADER_TIMEOUT = 15 * time.Second
 )
 
 var (
@@ -29,6 +31,7 @@ type ZoneMasterRpcClient interface {
 	DeletePartition(addr string, partitionId metapb.PartitionID) error
 	AddReplica(addr string, partitionId metapb.PartitionID, replica *metapb.Replica) error
 	RemoveReplica(addr string, partitionId metapb.PartitionID, replica *metapb.Replica) error
+	ChangeLeader(addr string, partitionId metapb.PartitionID, replica *metapb.Replica) error
 	Close()
 }
 
@@ -221,3 +224,40 @@ func (c *ZoneMasterRpcClientImpl) RemoveReplica(addr string, partitionId metapb.
 		return ErrRpcInvokeFailed
 	}
 }
+
+func (c *ZoneMasterRpcClientImpl) ChangeLeader(addr string, partitionId metapb.PartitionID, replica *metapb.Replica) error {
+	log.Info("change leader of partitionId[%d] to replica[%v] into addr[%s]", partitionId, replica, addr)
+	client, err := c.getClient(addr)
+	if err != nil {
+		return err
+	}
+
+	req := &masterpb.ChangeLeaderRequest{
+		RequestHeader: metapb.RequestHeader{},
+		PartitionID:   partitionId,
+		Replica:       *replica,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ZONE_MASTER_CHANGE_LEADER_TIMEOUT)
+	defer cancel()
+	resp, err := client.ChangeLeader(ctx, req)
+	if err != nil {
+		if status, ok := status.FromError(err); ok {
+			err = status.Err()
+		}
+		log.Error("grpc invoke is failed. err[%v]", err)
+		return ErrRpcInvokeFailed
+	}
+	if resp == nil {
+		return ErrRpcInvalidResp
+	}
+
+	if resp.ResponseHeader.Code == metapb.RESP_CODE_OK {
+		return nil
+	} else if resp.ResponseHeader.Code == metapb.RESP_CODE_TIMEOUT {
+		log.Error("grpc ChangeLeader response timeout[%v]", resp.ResponseHeader)
+		return ErrLeaderChangeTimeout
+	} else {
+		log.Error("grpc ChangeLeader response err[%v]", resp.ResponseHeader)
+		return ErrRpcInvokeFailed
+	}
+}
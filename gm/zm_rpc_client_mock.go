@@ -45,6 +45,18 @@ func (mr *MockZoneMasterRpcClientMockRecorder) AddReplica(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplica", reflect.TypeOf((*MockZoneMasterRpcClient)(nil).AddReplica), arg0, arg1, arg2)
 }
 
+// ChangeLeader mocks base method
+func (m *MockZoneMasterRpcClient) ChangeLeader(arg0 string, arg1 metapb.PartitionID, arg2 *metapb.Replica) error {
+	ret := m.ctrl.Call(m, "ChangeLeader", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeLeader indicates an expected call of ChangeLeader
+func (mr *MockZoneMasterRpcClientMockRecorder) ChangeLeader(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeLeader", reflect.TypeOf((*MockZoneMasterRpcClient)(nil).ChangeLeader), arg0, arg1, arg2)
+}
+
 // Close mocks base method
 func (m *MockZoneMasterRpcClient) Close() {
 	m.ctrl.Call(m, "Close")
@@ -0,0 +1,312 @@
+// Package auth is the user store of BaudEngine: the users, their password hashes and their
+// grants, kept as the objects of the space "users" of the db "system". MyGate authenticates the
+// connections of the MySQL protocol and the router the requests of its http api against it.
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// SystemDB is the db of the users, shared with the table definitions of MyGate
+	SystemDB = "system"
+	// UsersSpace is the space of the users keyed by name
+	UsersSpace = "users"
+	// UsersSchema is the mapping of the users space
+	UsersSchema = `{"mappings":{"users":{"properties":{"name":{"type":"keyword"}}}}}`
+
+	// RootUser has every privilege, it is not stored, its password is configured
+	RootUser = "root"
+)
+
+// the authentication plugins of the users, named as by MySQL
+const (
+	NativePassword      = "mysql_native_password"
+	CachingSha2Password = "caching_sha2_password"
+)
+
+// the key derivation of the passwords of caching_sha2_password, PBKDF2 of HMAC-SHA256 over a random
+// salt, kept as "$pbkdf2-sha256$<iterations>$<hex salt>$<hex key>"
+const (
+	kdfPrefix     = "$pbkdf2-sha256$"
+	kdfIterations = 10000
+	kdfSaltSize   = 16
+)
+
+// fastAuth caches the SHA256(SHA256(password)) of the passwords of caching_sha2_password checked,
+// by their stored hash, so the key is derived once per password. It is never stored.
+var fastAuth sync.Map
+
+// the privileges of the grants
+const (
+	Select      = "SELECT"
+	Insert      = "INSERT"
+	Update      = "UPDATE"
+	Delete      = "DELETE"
+	Create      = "CREATE"
+	Drop        = "DROP"
+	Alter       = "ALTER"
+	Index       = "INDEX"
+	CreateUser  = "CREATE USER"
+	GrantOption = "GRANT OPTION"
+	// All is every privilege but GRANT OPTION
+	All = "ALL PRIVILEGES"
+)
+
+// Privileges are the privileges a grant may hold, in the order they are shown.
+var Privileges = []string{Select, Insert, Update, Delete, Create, Drop, Alter, Index, CreateUser, GrantOption}
+
+// Wildcard is the db or the table of a grant on all of them.
+const Wildcard = "*"
+
+// User is a user of the store, AuthString is the hash of the password by the plugin, see
+// HashPassword.
+type User struct {
+	Name       string   `json:"name"`
+	Host       string   `json:"host,omitempty"`
+	Plugin     string   `json:"plugin"`
+	AuthString string   `json:"auth_string"`
+	Grants     []*Grant `json:"grants,omitempty"`
+}
+
+// Grant holds the privileges of a user on a table, on all the tables of a db if Table is "*" and
+// on all the dbs if DB is "*" too. A table is a space of the db.
+type Grant struct {
+	DB         string   `json:"db"`
+	Table      string   `json:"table"`
+	Privileges []string `json:"privileges"`
+}
+
+// NewUser returns the user of the password hashed by the plugin.
+func NewUser(name, host, plugin, password string) (*User, error) {
+	if plugin == "" {
+		plugin = NativePassword
+	}
+	authString, err := HashPassword(plugin, password)
+	if err != nil {
+		return nil, err
+	}
+	return &User{Name: name, Host: host, Plugin: plugin, AuthString: authString}, nil
+}
+
+// Root returns the root user of the password.
+func Root(password string) *User {
+	root, _ := NewUser(RootUser, "", NativePassword, password)
+	root.Grants = []*Grant{{DB: Wildcard, Table: Wildcard, Privileges: []string{All, GrantOption}}}
+	return root
+}
+
+// HashPassword hashes the password by the plugin, the empty password has the empty hash. The hash
+// of mysql_native_password is "*" and the hex of SHA1(SHA1(password)) as MySQL keeps it, the hash
+// of caching_sha2_password the key derived from the password and a random salt, see kdfPrefix.
+func HashPassword(plugin, password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	switch plugin {
+	case NativePassword:
+		stage1 := sha1.Sum([]byte(password))
+		stage2 := sha1.Sum(stage1[:])
+		return "*" + strings.ToUpper(hex.EncodeToString(stage2[:])), nil
+	case CachingSha2Password:
+		salt := make([]byte, kdfSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		key := pbkdf2([]byte(password), salt, kdfIterations)
+		return fmt.Sprintf("%s%d$%s$%s", kdfPrefix, kdfIterations, hex.EncodeToString(salt), hex.EncodeToString(key)), nil
+	}
+	return "", fmt.Errorf("unknown authentication plugin %s", plugin)
+}
+
+// CheckPassword checks the clear text password.
+func (u *User) CheckPassword(password string) bool {
+	switch {
+	case u.AuthString == "" || password == "":
+		return u.AuthString == "" && password == ""
+	case u.Plugin == CachingSha2Password:
+		return u.checkDerived(password)
+	}
+	hash, err := HashPassword(u.Plugin, password)
+	return err == nil && subtle.ConstantTimeCompare([]byte(hash), []byte(u.AuthString)) == 1
+}
+
+// checkDerived checks the password of caching_sha2_password against its fast digest once it was
+// checked, else by deriving its key.
+func (u *User) checkDerived(password string) bool {
+	stage1 := sha256.Sum256([]byte(password))
+	digest := sha256.Sum256(stage1[:])
+	if cached, ok := fastAuth.Load(u.AuthString); ok {
+		return subtle.ConstantTimeCompare(cached.([]byte), digest[:]) == 1
+	}
+
+	parts := strings.Split(strings.TrimPrefix(u.AuthString, kdfPrefix), "$")
+	if !strings.HasPrefix(u.AuthString, kdfPrefix) || len(parts) != 3 {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[0])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	salt, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+	key, err := hex.DecodeString(parts[2])
+	if err != nil || subtle.ConstantTimeCompare(pbkdf2([]byte(password), salt, iterations), key) != 1 {
+		return false
+	}
+	fastAuth.Store(u.AuthString, digest[:])
+	return true
+}
+
+// pbkdf2 derives a key of the size of SHA256 from the password by PBKDF2 of HMAC-SHA256, RFC 8018.
+func pbkdf2(password, salt []byte, iterations int) []byte {
+	prf := hmac.New(sha256.New, password)
+	prf.Write(salt)
+	var block [4]byte
+	binary.BigEndian.PutUint32(block[:], 1)
+	prf.Write(block[:])
+	u := prf.Sum(nil)
+	key := append([]byte(nil), u...)
+	for i := 1; i < iterations; i++ {
+		prf.Reset()
+		prf.Write(u)
+		u = prf.Sum(u[:0])
+		for j := range key {
+			key[j] ^= u[j]
+		}
+	}
+	return key
+}
+
+// CheckScramble checks the reply of a client to the salt of mysql_native_password:
+// SHA1(password) XOR SHA1(salt + SHA1(SHA1(password))).
+func (u *User) CheckScramble(salt, reply []byte) bool {
+	if u.Plugin != NativePassword {
+		return false
+	}
+	if u.AuthString == "" {
+		return len(reply) == 0
+	}
+	stage2, err := hex.DecodeString(strings.TrimPrefix(u.AuthString, "*"))
+	if err != nil || len(reply) != sha1.Size {
+		return false
+	}
+	h := sha1.New()
+	h.Write(salt)
+	h.Write(stage2)
+	stage1 := h.Sum(nil)
+	for i := range stage1 {
+		stage1[i] ^= reply[i]
+	}
+	check := sha1.Sum(stage1)
+	return bytes.Equal(check[:], stage2)
+}
+
+// Allowed tells whether the user holds the privilege on the table of the db, by a grant on the
+// table, on the db or on all the dbs. The table "*" asks for the privilege on all the tables.
+func (u *User) Allowed(privilege, db, table string) bool {
+	for _, g := range u.Grants {
+		if !g.covers(db, table) {
+			continue
+		}
+		for _, p := range g.Privileges {
+			if p == privilege || p == All && privilege != GrantOption {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// covers tells whether the grant is on the table of the db.
+func (g *Grant) covers(db, table string) bool {
+	if g.DB == Wildcard {
+		return true
+	}
+	if !strings.EqualFold(g.DB, db) {
+		return false
+	}
+	return g.Table == Wildcard || g.Table == table
+}
+
+// Grant adds the privileges on the table of the db.
+func (u *User) Grant(privileges []string, db, table string) {
+	g := u.grant(db, table)
+	if g == nil {
+		g = &Grant{DB: db, Table: table}
+		u.Grants = append(u.Grants, g)
+	}
+	for _, p := range privileges {
+		if !contains(g.Privileges, p) {
+			g.Privileges = append(g.Privileges, p)
+		}
+	}
+}
+
+// Revoke removes the privileges on the table of the db, ALL PRIVILEGES removes all of them but
+// GRANT OPTION. A grant left without privileges is dropped, ok is false if there is no grant on
+// the table.
+func (u *User) Revoke(privileges []string, db, table string) (ok bool) {
+	g := u.grant(db, table)
+	if g == nil {
+		return false
+	}
+	var kept []string
+	for _, p := range g.Privileges {
+		if !contains(privileges, p) && !(contains(privileges, All) && p != GrantOption) {
+			kept = append(kept, p)
+		}
+	}
+	g.Privileges = kept
+	if len(kept) > 0 {
+		return true
+	}
+	for i, other := range u.Grants {
+		if other == g {
+			u.Grants = append(u.Grants[:i], u.Grants[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// grant returns the grant on exactly the table of the db.
+func (u *User) grant(db, table string) *Grant {
+	for _, g := range u.Grants {
+		if g.DB == db && g.Table == table {
+			return g
+		}
+	}
+	return nil
+}
+
+// ParsePrivilege returns the privilege of the name as written in a GRANT, "ALL" is ALL PRIVILEGES.
+func ParsePrivilege(name string) (string, bool) {
+	name = strings.ToUpper(strings.Join(strings.Fields(name), " "))
+	if name == "ALL" || name == All {
+		return All, true
+	}
+	return name, contains(Privileges, name)
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
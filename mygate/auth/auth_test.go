@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// scramble is the reply of a client of mysql_native_password to the salt.
+func scramble(salt []byte, password string) []byte {
+	stage1 := sha1.Sum([]byte(password))
+	stage2 := sha1.Sum(stage1[:])
+	h := sha1.New()
+	h.Write(salt)
+	h.Write(stage2[:])
+	reply := h.Sum(nil)
+	for i := range reply {
+		reply[i] ^= stage1[i]
+	}
+	return reply
+}
+
+func TestPassword(t *testing.T) {
+	u, err := NewUser("alice", "%", "", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the hash of PASSWORD('password') of MySQL
+	if u.Plugin != NativePassword || u.AuthString != "*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19" {
+		t.Fatalf("unexpected user %v", u)
+	}
+	salt := []byte("01234567890123456789")
+	if !u.CheckScramble(salt, scramble(salt, "password")) {
+		t.Fatal("the scramble of the password is rejected")
+	}
+	if u.CheckScramble(salt, scramble(salt, "secret")) || u.CheckScramble(salt, nil) {
+		t.Fatal("the scramble of another password is accepted")
+	}
+	if !u.CheckPassword("password") || u.CheckPassword("secret") {
+		t.Fatal("unexpected check of the clear text password")
+	}
+
+	sha2, err := NewUser("bob", "", CachingSha2Password, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sha2.CheckPassword("password") || sha2.CheckPassword("") || sha2.CheckScramble(salt, scramble(salt, "password")) {
+		t.Fatal("unexpected check of the password of caching_sha2_password")
+	}
+	// the password checked once is checked by its fast digest
+	if _, ok := fastAuth.Load(sha2.AuthString); !ok || !sha2.CheckPassword("password") || sha2.CheckPassword("secret") {
+		t.Fatal("unexpected check of the password of caching_sha2_password by its fast digest")
+	}
+	// the hash is salted
+	other, _ := NewUser("bob", "", CachingSha2Password, "password")
+	if !strings.HasPrefix(sha2.AuthString, kdfPrefix) || other.AuthString == sha2.AuthString || !other.CheckPassword("password") {
+		t.Fatalf("unexpected hashes %s, %s", sha2.AuthString, other.AuthString)
+	}
+	broken := &User{Name: "bob", Plugin: CachingSha2Password, AuthString: kdfPrefix + "x$00$00"}
+	if broken.CheckPassword("password") {
+		t.Fatal("the password of a broken hash is accepted")
+	}
+
+	empty, _ := NewUser("guest", "", NativePassword, "")
+	if !empty.CheckScramble(salt, nil) || empty.CheckScramble(salt, scramble(salt, "password")) {
+		t.Fatal("unexpected check of the empty password")
+	}
+	if _, err := NewUser("carol", "", "sha256_password", "password"); err == nil {
+		t.Fatal("user of an unknown plugin")
+	}
+}
+
+func TestPbkdf2(t *testing.T) {
+	// the first block of the test vector of PBKDF2-HMAC-SHA256 of RFC 7914
+	key := pbkdf2([]byte("passwd"), []byte("salt"), 1)
+	if hex.EncodeToString(key) != "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc" {
+		t.Fatalf("unexpected key %x", key)
+	}
+	key = pbkdf2([]byte("Password"), []byte("NaCl"), 80000)
+	if hex.EncodeToString(key) != "4ddcd8f60b98be21830cee5ef22701f9641a4418d04c0414aeff08876b34ab56" {
+		t.Fatalf("unexpected key %x", key)
+	}
+}
+
+func TestGrants(t *testing.T) {
+	u, _ := NewUser("alice", "", "", "password")
+	u.Grant([]string{Select, Insert}, "shop", Wildcard)
+	u.Grant([]string{Delete}, "shop", "orders")
+	u.Grant([]string{Select}, "shop", Wildcard)
+
+	tests := []struct {
+		privilege, db, table string
+		allowed              bool
+	}{
+		{Select, "shop", "orders", true},
+		{Insert, "SHOP", "items", true},
+		{Delete, "shop", "orders", true},
+		{Delete, "shop", "items", false},
+		{Delete, "shop", Wildcard, false},
+		{Select, "bank", "orders", false},
+		{CreateUser, Wildcard, Wildcard, false},
+	}
+	for _, test := range tests {
+		if allowed := u.Allowed(test.privilege, test.db, test.table); allowed != test.allowed {
+			t.Errorf("%s on %s.%s: allowed %v, expect %v", test.privilege, test.db, test.table, allowed, test.allowed)
+		}
+	}
+	if len(u.Grants) != 2 || len(u.Grants[0].Privileges) != 2 {
+		t.Fatalf("unexpected grants %v", u.Grants)
+	}
+
+	if !u.Revoke([]string{Delete}, "shop", "orders") || len(u.Grants) != 1 {
+		t.Fatalf("unexpected grants after revoke %v", u.Grants)
+	}
+	if u.Revoke([]string{Delete}, "shop", "orders") {
+		t.Fatal("revoke of a grant that does not exist")
+	}
+
+	root := Root("secret")
+	if !root.Allowed(CreateUser, Wildcard, Wildcard) || !root.Allowed(GrantOption, "shop", "orders") || !root.CheckPassword("secret") {
+		t.Fatal("root lacks a privilege")
+	}
+	root.Revoke([]string{All}, Wildcard, Wildcard)
+	if root.Allowed(Select, "shop", "orders") || !root.Allowed(GrantOption, "shop", "orders") {
+		t.Fatalf("unexpected grants of root after revoke %v", root.Grants[0])
+	}
+
+	if p, ok := ParsePrivilege(" grant  option"); !ok || p != GrantOption {
+		t.Fatalf("unexpected privilege %s", p)
+	}
+	if p, ok := ParsePrivilege("all"); !ok || p != All {
+		t.Fatalf("unexpected privilege %s", p)
+	}
+	if _, ok := ParsePrivilege("super"); ok {
+		t.Fatal("unknown privilege parsed")
+	}
+}
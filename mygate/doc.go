@@ -2,5 +2,94 @@
 
 The MySQL Protocol Gateway of BaudEngine
 
+## Data model
+a database is a db of BaudEngine, a table is the space of the same name, a row is an object of the
+space keyed by its primary key (the column named by -mysql_primary_key, "id" by default). the
+object keeps the columns of the row and "_table", the table of the row.
+
+## DML
+the gate reaches the spaces through the http api of a router (-router_addr).
+INSERT/REPLACE: a bulk of creates routed by the primary key, INSERT fails a row whose key exists
+with a duplicate entry error, INSERT IGNORE skips it, REPLACE overwrites it.
+SELECT: a WHERE binding nothing but the primary key ("id = 1", "id IN (1, 2)") is a point lookup,
+any other is a search of the space: =, !=, <, <=, >, >=, BETWEEN, IN, LIKE, AND, OR and NOT become
+the query dsl of the engine, ORDER BY the sort and LIMIT the window.
+MATCH (columns) AGAINST ('text' [IN NATURAL LANGUAGE MODE | IN BOOLEAN MODE]): a full text search
+of the engine, a match or multi_match query or, in BOOLEAN MODE, a bool query of the words (+, -,
+"phrase", word*, (...), > < and ~). in the select list and ORDER BY a MATCH is the relevance of the
+row, the score of its hit, a MATCH the WHERE lacks scores the rows without filtering them.
+UPDATE/DELETE: the rows are matched as by SELECT, then partially updated or deleted by id.
+a statement without LIMIT reads or changes at most -mysql_max_rows rows, it fails if more match.
+GROUP BY, HAVING, DISTINCT and COUNT, SUM, AVG, MIN and MAX: the partitions fold their rows into
+buckets of partial aggregates, the gate merges the buckets, computes AVG of a sum and a count and
+applies HAVING, ORDER BY (the groups are ordered by their keys without it) and LIMIT.
+COUNT(DISTINCT ...), the aggregates of expressions and the groups of the primary key are folded in
+the gate from the rows, read in pages of -mysql_max_rows sorted by id. the groups are at most
+-mysql_max_groups and, when folded in the gate, take at most -mysql_memory_budget bytes, more fail
+the query. the columns out of the aggregates must be grouped.
+EXPLAIN SELECT: the fragments of the plan, what every partition runs, how the router merges the
+partitions and what the gate computes.
+the columns of the result are typed by their declared types or, for a table without definition,
+by their values: integer, double, varchar, tinyint for bool and json for objects and arrays.
+
+## DDL
+the dbs and the spaces are managed through the http api of the global master (-master_addr), the
+table definitions are kept in the space "tables" of the db "system".
+CREATE/DROP DATABASE [IF [NOT] EXISTS]: a db of the master.
+CREATE TABLE [IF NOT EXISTS] t (...) [PARTITION BY KEY (column) [PARTITIONS n]]: a space whose
+mapping has a field of every column, the primary key of one column is the object id, the space is
+split by the primary key, the one key PARTITION BY may name, into n partitions (-mysql_partitions).
+the rows of a defined table are checked: unknown columns, NULL in NOT NULL columns and values not
+of the column type fail, the missing columns take their defaults.
+ALTER TABLE t ADD [COLUMN] ..., CREATE [UNIQUE] INDEX i ON t (...), DROP INDEX i ON t: the mapping
+of the space is replaced. every field is indexed by the engine, an index of more columns is a
+field "_index_<name>" of the values of its columns, written to the existing rows when created,
+kept by INSERT and UPDATE and searched when a WHERE binds all its columns by equality.
+DROP TABLE [IF EXISTS]: the space and the definition are dropped.
+the tables of the db "information_schema" and the db "system" are read only to the users.
+
+## Users
+the users are kept in the space "users" of the db "system" (see mygate/auth), with
+-mysql_auth_server_impl baudengine, the default, the connections are authenticated against them:
+the users of mysql_native_password by the scramble of the handshake, the users of
+caching_sha2_password by their password in clear text, over TLS unless
+-mysql_allow_clear_text_without_tls, checked against a salted PBKDF2 of the password. root holds
+every privilege, it is not stored, its password is -mysql_root_password, MyGate does not start
+without it. a user is matched by name, the host is kept but not matched.
+CREATE USER [IF NOT EXISTS] u [IDENTIFIED [WITH plugin] BY 'password'], DROP USER [IF EXISTS] u:
+need CREATE USER on *.*.
+GRANT privileges ON {*.* | db.* | db.t | t} TO u [WITH GRANT OPTION], REVOKE ... FROM u: the
+privileges are SELECT, INSERT, UPDATE, DELETE, CREATE, DROP, ALTER, INDEX, CREATE USER, GRANT OPTION
+and ALL, a user grants the privileges they hold on the target with GRANT OPTION.
+SHOW GRANTS [FOR u]: the grants of the user, of another user need SELECT on system.users.
+every statement is checked before it runs: SELECT, EXPLAIN and the SHOWs of a table need SELECT,
+INSERT needs INSERT (REPLACE DELETE too, ON DUPLICATE KEY UPDATE UPDATE too), UPDATE needs UPDATE,
+DELETE needs DELETE, CREATE/DROP DATABASE need CREATE/DROP on db.*, CREATE TABLE needs CREATE,
+ALTER TABLE needs ALTER, CREATE/DROP INDEX need INDEX and DROP TABLE needs DROP. a user is cached
+for -mysql_user_ttl, the grants of the other gates show after it.
+the gate reaches the router as -router_user (-router_password), the router checks its http api
+against the same users.
+
+## Prepared statements
+a prepared statement is normalized, its literals become bind variables, and the plan of the
+normalized SQL is cached by the connection (-mysql_plan_cache_size), the prepares of a statement
+differing only in its literals share the plan. the ? and the literals are bound at the execution,
+the search queries of the statement carry the values. the state of a connection, its prepared
+statements, their cursors and its user variables, is kept in the ClientData of the connection.
+PREPARE stmt FROM {'sql' | @variable}, EXECUTE stmt [USING @variable, ...], {DEALLOCATE | DROP}
+PREPARE stmt and SET @variable = literal prepare and execute the statements over COM_QUERY.
+COM_STMT_PREPARE, COM_STMT_EXECUTE, COM_STMT_FETCH and COM_STMT_CLOSE are served by the methods of
+the handler of the same names, the listener of vitess v2.2 does not hand them over: the gate reads
+them before vitess does, in the binary protocol, and terminates TLS itself (-mysql_ssl_cert,
+-mysql_ssl_key) so they are served over TLS too. the reply of a prepared SELECT defines its
+columns when the plan knows them, a row not encoded fails the execution before any row is sent. a
+SELECT executed with a cursor is read in windows of -mysql_max_rows, it is not bound by it. the engine
+has no point in time search, the rows written between two fetches may show twice or not at all.
+
+## Introspection
+SHOW DATABASES, SHOW [FULL] TABLES [FROM db] [LIKE ...|WHERE ...], DESCRIBE t, SHOW COLUMNS FROM t,
+SHOW INDEX FROM t, SHOW CREATE TABLE t and SELECT of information_schema.SCHEMATA, TABLES, COLUMNS
+and STATISTICS, filtered, ordered and limited in the gate.
+
 */
 package mygate
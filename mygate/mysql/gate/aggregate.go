@@ -0,0 +1,710 @@
+package mysql
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	mysqlMaxGroups    = flag.Int("mysql_max_groups", 100000, "The most groups of an aggregate query, in every partition and in the gate.")
+	mysqlMemoryBudget = flag.Int("mysql_memory_budget", 64<<20, "The bytes the groups of a query folded in the gate may take.")
+)
+
+// the metrics of the partitions, see engine.Aggregation, and the distinct count the gate keeps
+// itself
+const (
+	metricCount         = "count"
+	metricSum           = "sum"
+	metricMin           = "min"
+	metricMax           = "max"
+	metricCountDistinct = "count_distinct"
+)
+
+// the field of the object id, the streamed rows are paged in its order
+const idField = "_id"
+
+// the bytes of a group and of a value beyond their text, the estimate of the memory budget
+const (
+	groupOverhead = 64
+	valueOverhead = 16
+)
+
+var aggregateFuncs = map[string]bool{"count": true, "sum": true, "avg": true, "min": true, "max": true}
+
+// metric is a metric of the groups, arg is the argument the gate evaluates on a streamed row, nil
+// for the count of the rows.
+type metric struct {
+	Metric
+	arg sqlparser.Expr
+}
+
+// aggregate is an aggregate function of the query computed from its metrics, AVG from a sum and
+// a count.
+type aggregate struct {
+	name    string
+	metrics []int
+}
+
+// aggregateOutput is a column of the result, key holds its value in the rows.
+type aggregateOutput struct {
+	name string
+	key  string
+	expr sqlparser.Expr
+}
+
+// aggregatePlan is how a SELECT of groups runs. If every metric is of a column the partitions fold
+// their rows into buckets, the pushdown, else the rows are streamed to the gate which folds them
+// itself. The gate merges the groups, computes the aggregates and applies HAVING, ORDER BY and
+// LIMIT.
+type aggregatePlan struct {
+	t          *table
+	where      *sqlparser.Where
+	groupBy    []string
+	metrics    []metric
+	aggregates map[string]*aggregate
+	outputs    []aggregateOutput
+	having     sqlparser.Expr
+	orderBy    sqlparser.OrderBy
+	limit      *sqlparser.Limit
+	// distinct drops the duplicated rows of the result
+	distinct bool
+	pushdown bool
+}
+
+// isAggregate tells whether the SELECT groups the rows, by GROUP BY, DISTINCT, HAVING or an
+// aggregate function.
+func isAggregate(sel *sqlparser.Select) bool {
+	if sel.Distinct != "" || len(sel.GroupBy) > 0 || sel.Having != nil {
+		return true
+	}
+	found := false
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if f, ok := node.(*sqlparser.FuncExpr); ok && aggregateFuncs[f.Name.Lowered()] {
+			found = true
+		}
+		return !found, nil
+	}, sel.SelectExprs)
+	return found
+}
+
+// planAggregate plans the SELECT on the table, the columns out of the aggregates must be grouped.
+// A DISTINCT of columns groups by the columns.
+func planAggregate(t *table, sel *sqlparser.Select) (*aggregatePlan, error) {
+	p := &aggregatePlan{
+		t:          t,
+		where:      sel.Where,
+		aggregates: make(map[string]*aggregate),
+		orderBy:    sel.OrderBy,
+		limit:      sel.Limit,
+		pushdown:   true,
+	}
+	if sel.Having != nil {
+		p.having = sel.Having.Expr
+	}
+	for _, expr := range sel.GroupBy {
+		column, ok := columnName(expr)
+		if !ok {
+			return nil, errNotSupported("GROUP BY %s is not on a column", sqlparser.String(expr))
+		}
+		p.groupBy = append(p.groupBy, column)
+	}
+	for i, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errNotSupported("select expression %s is not supported with groups", sqlparser.String(expr))
+		}
+		out := aggregateOutput{name: sqlparser.String(aliased.Expr), key: "#" + strconv.Itoa(i), expr: aliased.Expr}
+		if column, ok := columnName(aliased.Expr); ok {
+			// the grouped columns keep their declared types
+			out.name, out.key = column, column
+		}
+		if !aliased.As.IsEmpty() {
+			out.name = aliased.As.String()
+		}
+		p.outputs = append(p.outputs, out)
+	}
+
+	exprs := []sqlparser.Expr{p.having}
+	for _, out := range p.outputs {
+		exprs = append(exprs, out.expr)
+	}
+	for _, order := range p.orderBy {
+		exprs = append(exprs, order.Expr)
+	}
+	for _, expr := range exprs {
+		if err := p.addAggregates(expr); err != nil {
+			return nil, err
+		}
+	}
+	if sel.Distinct != "" {
+		if len(p.groupBy) == 0 && len(p.aggregates) == 0 {
+			for _, out := range p.outputs {
+				column, ok := columnName(out.expr)
+				if !ok {
+					return nil, errNotSupported("DISTINCT %s is not on a column", sqlparser.String(out.expr))
+				}
+				p.groupBy = append(p.groupBy, column)
+			}
+		} else {
+			p.distinct = true
+		}
+	}
+
+	for _, out := range p.outputs {
+		if err := p.checkGrouped(out.expr, false); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.checkGrouped(p.having, true); err != nil {
+		return nil, err
+	}
+	for _, order := range p.orderBy {
+		if err := p.checkGrouped(order.Expr, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, column := range p.groupBy {
+		// the objects may lack the field of the primary key, the gate reads it from the id
+		if column == t.primaryKey {
+			p.pushdown = false
+		}
+	}
+	return p, nil
+}
+
+// addAggregates adds the aggregate functions of the expression.
+func (p *aggregatePlan) addAggregates(expr sqlparser.Expr) error {
+	if expr == nil {
+		return nil
+	}
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		f, ok := node.(*sqlparser.FuncExpr)
+		if !ok || !aggregateFuncs[f.Name.Lowered()] {
+			return true, nil
+		}
+		return false, p.addAggregate(f)
+	}, expr)
+}
+
+func (p *aggregatePlan) addAggregate(f *sqlparser.FuncExpr) error {
+	key := sqlparser.String(f)
+	if _, ok := p.aggregates[key]; ok {
+		return nil
+	}
+	name := f.Name.Lowered()
+	if len(f.Exprs) != 1 || f.Distinct && name != "count" {
+		return errNotSupported("aggregate %s is not supported", key)
+	}
+	var arg sqlparser.Expr
+	switch expr := f.Exprs[0].(type) {
+	case *sqlparser.StarExpr:
+		if name != "count" || f.Distinct {
+			return errNotSupported("aggregate %s is not supported", key)
+		}
+	case *sqlparser.AliasedExpr:
+		arg = expr.Expr
+		err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if f, ok := node.(*sqlparser.FuncExpr); ok && aggregateFuncs[f.Name.Lowered()] {
+				return false, mysql.NewSQLError(mysql.ERInvalidGroupFuncUse, mysql.SSUnknownSQLState, "Invalid use of group function")
+			}
+			return true, nil
+		}, arg)
+		if err != nil {
+			return err
+		}
+	default:
+		return errNotSupported("aggregate %s is not supported", key)
+	}
+
+	agg := &aggregate{name: name}
+	switch {
+	case f.Distinct:
+		agg.metrics = []int{p.metric(metricCountDistinct, arg)}
+	case name == "avg":
+		agg.metrics = []int{p.metric(metricSum, arg), p.metric(metricCount, arg)}
+	default:
+		agg.metrics = []int{p.metric(name, arg)}
+	}
+	p.aggregates[key] = agg
+	return nil
+}
+
+// metric returns the index of the metric of the function on the argument, added if new. A metric
+// the partitions can not fold, of an expression or a distinct count, turns the pushdown off.
+func (p *aggregatePlan) metric(fn string, arg sqlparser.Expr) int {
+	m := metric{Metric: Metric{Func: fn}, arg: arg}
+	if arg != nil {
+		if column, ok := columnName(arg); ok && column != p.t.primaryKey {
+			m.Field = column
+		} else {
+			p.pushdown = false
+		}
+	}
+	if fn == metricCountDistinct {
+		p.pushdown = false
+	}
+	for i, other := range p.metrics {
+		if other.Func == fn && exprText(other.arg) == exprText(arg) {
+			return i
+		}
+	}
+	p.metrics = append(p.metrics, m)
+	return len(p.metrics) - 1
+}
+
+func exprText(expr sqlparser.Expr) string {
+	if expr == nil {
+		return ""
+	}
+	return sqlparser.String(expr)
+}
+
+// checkGrouped fails a column out of the aggregates which is not grouped, HAVING and ORDER BY may
+// name the columns of the result too.
+func (p *aggregatePlan) checkGrouped(expr sqlparser.Expr, aliases bool) error {
+	if expr == nil {
+		return nil
+	}
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			return !aggregateFuncs[node.Name.Lowered()], nil
+		case *sqlparser.ColName:
+			column := node.Name.String()
+			if p.grouped(column) >= 0 || aliases && p.output(column) != nil {
+				return false, nil
+			}
+			return false, mysql.NewSQLError(mysql.ERWrongFieldWithGroup, mysql.SSUnknownSQLState, "'%s' isn't in GROUP BY", column)
+		}
+		return true, nil
+	}, expr)
+}
+
+// grouped returns the index of the column in GROUP BY, -1 if it is not grouped.
+func (p *aggregatePlan) grouped(column string) int {
+	for i, grouped := range p.groupBy {
+		if grouped == column {
+			return i
+		}
+	}
+	return -1
+}
+
+// output returns the column of the result of the name, the names are case insensitive.
+func (p *aggregatePlan) output(name string) *aggregateOutput {
+	for i := range p.outputs {
+		if strings.EqualFold(p.outputs[i].name, name) {
+			return &p.outputs[i]
+		}
+	}
+	return nil
+}
+
+// aggregation is the aggregation the partitions run for the pushdown.
+func (p *aggregatePlan) aggregation(maxGroups int) *Aggregation {
+	agg := &Aggregation{GroupBy: p.groupBy, MaxGroups: maxGroups}
+	for _, m := range p.metrics {
+		agg.Metrics = append(agg.Metrics, m.Metric)
+	}
+	return agg
+}
+
+// fields returns the columns the streamed rows must have.
+func (p *aggregatePlan) fields() []string {
+	seen := make(map[string]bool)
+	var fields []string
+	add := func(column string) {
+		if !seen[column] {
+			seen[column] = true
+			fields = append(fields, column)
+		}
+	}
+	for _, column := range p.groupBy {
+		add(column)
+	}
+	for _, m := range p.metrics {
+		if m.arg == nil {
+			continue
+		}
+		sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if column, ok := node.(*sqlparser.ColName); ok {
+				add(column.Name.String())
+			}
+			return true, nil
+		}, m.arg)
+	}
+	if len(fields) == 0 {
+		fields = append(fields, p.t.primaryKey)
+	}
+	return fields
+}
+
+func (e *executor) execAggregate(ctx context.Context, t *table, sel *sqlparser.Select) (*sqltypes.Result, error) {
+	p, err := planAggregate(t, sel)
+	if err != nil {
+		return nil, err
+	}
+	groups := newGroupTable(p.metrics, e.maxGroups, e.memoryBudget)
+	if p.pushdown {
+		err = e.pushdownGroups(ctx, p, groups)
+	} else {
+		err = e.streamGroups(ctx, p, groups)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p.result(groups)
+}
+
+// pushdownGroups merges the buckets the partitions fold.
+func (e *executor) pushdownGroups(ctx context.Context, p *aggregatePlan, groups *groupTable) error {
+	query, err := searchQuery(p.t, p.where)
+	if err != nil {
+		return err
+	}
+	result, err := e.backend.Search(ctx, p.t.db, p.t.space, &SearchRequest{
+		Query:       query,
+		Aggregation: p.aggregation(e.maxGroups),
+	})
+	if err != nil {
+		return err
+	}
+	for _, bucket := range result.Buckets {
+		if err := groups.addBucket(bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamGroups folds the rows of the search in the gate. The rows are read in pages of maxRows
+// sorted by id, the router merges the pages of the partitions, so that only a page and the groups
+// are held.
+func (e *executor) streamGroups(ctx context.Context, p *aggregatePlan, groups *groupTable) error {
+	query, err := searchQuery(p.t, p.where)
+	if err != nil {
+		return err
+	}
+	fields := p.fields()
+	for from := 0; ; from += e.maxRows {
+		result, err := e.backend.Search(ctx, p.t.db, p.t.space, &SearchRequest{
+			Query:  query,
+			From:   from,
+			Size:   e.maxRows,
+			Sort:   []string{idField},
+			Fields: fields,
+		})
+		if err != nil {
+			return err
+		}
+		for _, hit := range result.Hits {
+			row := p.t.row(hit.ID, hit.Source)
+			key := make([]interface{}, len(p.groupBy))
+			for i, column := range p.groupBy {
+				key[i] = row[column]
+			}
+			if err := groups.addRow(key, row); err != nil {
+				return err
+			}
+		}
+		if len(result.Hits) < e.maxRows || uint64(from+e.maxRows) >= result.Total {
+			return nil
+		}
+	}
+}
+
+// groupRow is a row of the result of a group, order holds the values it is sorted by.
+type groupRow struct {
+	row   map[string]interface{}
+	order []interface{}
+}
+
+// result computes the rows of the groups and applies HAVING, ORDER BY and LIMIT. The groups are
+// ordered by their keys without ORDER BY, and an aggregate without GROUP BY has a row even of no
+// rows.
+func (p *aggregatePlan) result(groups *groupTable) (*sqltypes.Result, error) {
+	if len(groups.order) == 0 && len(p.groupBy) == 0 {
+		if _, err := groups.group([]interface{}{}); err != nil {
+			return nil, err
+		}
+	}
+	var rows []*groupRow
+	for _, g := range groups.order {
+		row := make(map[string]interface{}, len(p.outputs))
+		value := p.groupValue(groups, g, nil)
+		for _, out := range p.outputs {
+			v, err := evaluate(out.expr, value)
+			if err != nil {
+				return nil, err
+			}
+			row[out.key] = v
+		}
+		value = p.groupValue(groups, g, row)
+		if p.having != nil {
+			ok, err := matchCondition(p.having, func(expr sqlparser.Expr) (interface{}, error) {
+				return evaluate(expr, value)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		r := &groupRow{row: row, order: g.key}
+		if len(p.orderBy) > 0 {
+			r.order = make([]interface{}, len(p.orderBy))
+			for i, order := range p.orderBy {
+				v, err := evaluate(order.Expr, value)
+				if err != nil {
+					return nil, err
+				}
+				r.order[i] = v
+			}
+		}
+		rows = append(rows, r)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		for k := range rows[i].order {
+			desc := k < len(p.orderBy) && p.orderBy[k].Direction == sqlparser.DescScr
+			a, b := rows[i].order[k], rows[j].order[k]
+			c := 0
+			switch {
+			case a == nil && b == nil:
+			case a == nil:
+				// NULL first
+				c = -1
+			case b == nil:
+				c = 1
+			default:
+				c = compareValues(a, b)
+			}
+			if c != 0 {
+				return (c < 0) != desc
+			}
+		}
+		return false
+	})
+
+	var result []map[string]interface{}
+	seen := make(map[string]bool)
+	for _, r := range rows {
+		if p.distinct {
+			values := make([]interface{}, len(p.outputs))
+			for i, out := range p.outputs {
+				values[i] = r.row[out.key]
+			}
+			data, _ := json.Marshal(values)
+			if seen[string(data)] {
+				continue
+			}
+			seen[string(data)] = true
+		}
+		result = append(result, r.row)
+	}
+	offset, count, err := limitWindow(p.limit)
+	if err != nil {
+		return nil, err
+	}
+	if offset > len(result) {
+		offset = len(result)
+	}
+	result = result[offset:]
+	if count >= 0 && count < len(result) {
+		result = result[:count]
+	}
+	columns := make([]resultColumn, len(p.outputs))
+	for i, out := range p.outputs {
+		columns[i] = resultColumn{name: out.name, column: out.key}
+	}
+	return rowsResult(p.t, columns, result), nil
+}
+
+// groupValue values the leaves of the expressions on a group: the aggregates, the grouped columns
+// and, once the row of the result is built, its columns by name.
+func (p *aggregatePlan) groupValue(groups *groupTable, g *group, row map[string]interface{}) valueOf {
+	return func(expr sqlparser.Expr) (interface{}, error) {
+		if f, ok := expr.(*sqlparser.FuncExpr); ok {
+			if agg, ok := p.aggregates[sqlparser.String(f)]; ok {
+				return groups.value(agg, g), nil
+			}
+		}
+		if column, ok := columnName(expr); ok {
+			if i := p.grouped(column); i >= 0 {
+				return g.key[i], nil
+			}
+			if out := p.output(column); out != nil && row != nil {
+				return row[out.key], nil
+			}
+			return nil, errUnknownColumn(column)
+		}
+		return literal(expr)
+	}
+}
+
+// group holds the values of the metrics of a group and the distinct values of its distinct
+// counts.
+type group struct {
+	key      []interface{}
+	values   []interface{}
+	distinct []map[string]bool
+}
+
+// groupTable holds the groups of a query in the gate, bounded by the max groups and by the memory
+// budget, which counts the keys, the values and the distinct values.
+type groupTable struct {
+	metrics   []metric
+	groups    map[string]*group
+	order     []*group
+	maxGroups int
+	budget    int
+	size      int
+}
+
+func newGroupTable(metrics []metric, maxGroups, budget int) *groupTable {
+	return &groupTable{metrics: metrics, groups: make(map[string]*group), maxGroups: maxGroups, budget: budget}
+}
+
+func (gt *groupTable) charge(bytes int) error {
+	gt.size += bytes
+	if gt.budget > 0 && gt.size > gt.budget {
+		return mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState,
+			"the groups of the query take more than %d bytes of memory, narrow it by WHERE", gt.budget)
+	}
+	return nil
+}
+
+// group returns the group of the key, added if new.
+func (gt *groupTable) group(key []interface{}) (*group, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	if g, ok := gt.groups[string(data)]; ok {
+		return g, nil
+	}
+	if gt.maxGroups > 0 && len(gt.groups) >= gt.maxGroups {
+		return nil, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState,
+			"the query has more than %d groups", gt.maxGroups)
+	}
+	if err := gt.charge(groupOverhead + 2*len(data) + len(gt.metrics)*valueOverhead); err != nil {
+		return nil, err
+	}
+	g := &group{key: key, values: make([]interface{}, len(gt.metrics)), distinct: make([]map[string]bool, len(gt.metrics))}
+	for i, m := range gt.metrics {
+		switch m.Func {
+		case metricCount:
+			g.values[i] = int64(0)
+		case metricCountDistinct:
+			g.distinct[i] = make(map[string]bool)
+		}
+	}
+	gt.groups[string(data)] = g
+	gt.order = append(gt.order, g)
+	return g, nil
+}
+
+// addRow folds the row into the group of the key.
+func (gt *groupTable) addRow(key []interface{}, row map[string]interface{}) error {
+	g, err := gt.group(key)
+	if err != nil {
+		return err
+	}
+	value := rowValue(row)
+	for i, m := range gt.metrics {
+		if m.arg == nil {
+			g.values[i] = g.values[i].(int64) + 1
+			continue
+		}
+		v, err := evaluate(m.arg, value)
+		if err != nil {
+			return err
+		}
+		switch {
+		case v == nil:
+		case m.Func == metricCount:
+			g.values[i] = g.values[i].(int64) + 1
+		case m.Func == metricCountDistinct:
+			data, _ := json.Marshal(v)
+			if !g.distinct[i][string(data)] {
+				if err := gt.charge(len(data) + valueOverhead); err != nil {
+					return err
+				}
+				g.distinct[i][string(data)] = true
+			}
+		default:
+			g.values[i] = foldMetric(m.Func, g.values[i], v)
+		}
+	}
+	return nil
+}
+
+// addBucket merges the bucket of a partition into its group.
+func (gt *groupTable) addBucket(bucket Bucket) error {
+	if len(bucket.Values) != len(gt.metrics) {
+		return fmt.Errorf("a bucket of %d values for %d metrics", len(bucket.Values), len(gt.metrics))
+	}
+	g, err := gt.group(bucket.Key)
+	if err != nil {
+		return err
+	}
+	for i, m := range gt.metrics {
+		v := bucket.Values[i]
+		switch {
+		case v == nil:
+		case m.Func == metricCount:
+			n, _ := number(v)
+			g.values[i] = g.values[i].(int64) + int64(n)
+		default:
+			g.values[i] = foldMetric(m.Func, g.values[i], v)
+		}
+	}
+	return nil
+}
+
+// foldMetric folds a value, or the value of a bucket, into the sum, the min or the max.
+func foldMetric(fn string, acc, v interface{}) interface{} {
+	switch fn {
+	case metricSum:
+		if n, ok := number(v); ok {
+			sum, _ := acc.(float64)
+			return sum + n
+		}
+	case metricMin:
+		if acc == nil || compareValues(v, acc) < 0 {
+			return v
+		}
+	case metricMax:
+		if acc == nil || compareValues(v, acc) > 0 {
+			return v
+		}
+	}
+	return acc
+}
+
+// value returns the value of the aggregate of the group, AVG is NULL without values.
+func (gt *groupTable) value(agg *aggregate, g *group) interface{} {
+	if agg.name == "avg" {
+		sum, ok := number(g.values[agg.metrics[0]])
+		count := g.values[agg.metrics[1]].(int64)
+		if !ok || count == 0 {
+			return nil
+		}
+		return sum / float64(count)
+	}
+	i := agg.metrics[0]
+	if gt.metrics[i].Func == metricCountDistinct {
+		return int64(len(g.distinct[i]))
+	}
+	return g.values[i]
+}
@@ -0,0 +1,121 @@
+package mysql
+
+import (
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+// resultText joins the values of the rows by "," and the rows by ";".
+func resultText(rows [][]string) string {
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = strings.Join(row, ",")
+	}
+	return strings.Join(lines, ";")
+}
+
+func TestExecuteAggregate(t *testing.T) {
+	backend := newMemBackend()
+	e := newExecutor(backend, newMemMaster(backend), 2)
+	c := &mysql.Conn{SchemaName: "test"}
+	mustExec(t, e, c, "insert into user (id, city, age, name) values (1, 'bj', 20, 'a'), (2, 'bj', 30, 'b'), "+
+		"(3, 'sz', 40, 'c'), (4, 'sz', NULL, 'd'), (5, 'sh', 10, 'a')")
+
+	tests := []struct {
+		sql    string
+		rows   string
+		pushed bool
+	}{
+		{"select city, count(*), sum(age), avg(age), min(age), max(name) from user group by city",
+			"bj,2,50,25,20,b;sh,1,10,10,10,a;sz,2,40,40,40,d", true},
+		{"select city, count(*) as c from user group by city having c > 1 order by city desc limit 1", "sz,2", true},
+		{"select city from user group by city having max(age) >= 30 order by count(age) desc, city", "bj;sz", true},
+		{"select count(*), count(age), sum(age) / count(*) from user", "5,4,20", true},
+		{"select count(*), max(age) from nobody", "0,", true},
+		{"select distinct city from user", "bj;sh;sz", true},
+		{"select count(distinct name), sum(age * 2) from user", "4,200", false},
+		{"select id, count(*) from user group by id limit 1, 2", "2,1;3,1", false},
+	}
+	for _, test := range tests {
+		backend.lastSearch = nil
+		result := mustExec(t, e, c, test.sql)
+		var rows [][]string
+		for _, row := range result.Rows {
+			values := make([]string, len(row))
+			for i, v := range row {
+				values[i] = v.ToString()
+			}
+			rows = append(rows, values)
+		}
+		if text := resultText(rows); text != test.rows {
+			t.Errorf("%s: rows %s, expect %s", test.sql, text, test.rows)
+		}
+		search := backend.lastSearch
+		if pushed := search.Aggregation != nil; pushed != test.pushed {
+			t.Errorf("%s: pushed down %v, expect %v", test.sql, pushed, test.pushed)
+		}
+		if !test.pushed && (len(search.Sort) != 1 || search.Sort[0] != idField || search.Size != 2) {
+			t.Errorf("%s: unexpected search of the streamed rows %v", test.sql, search)
+		}
+	}
+
+	result := mustExec(t, e, c, "select city as c, count(*) as n from user group by city")
+	if result.Fields[0].Name != "c" || result.Fields[1].Name != "n" {
+		t.Fatalf("unexpected fields %v", result.Fields)
+	}
+
+	if _, err := execSQL(t, e, c, "select name, count(*) from user group by city"); err == nil {
+		t.Fatal("select of a column not grouped")
+	} else if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != mysql.ERWrongFieldWithGroup {
+		t.Fatalf("unexpected error of a column not grouped: %v", err)
+	}
+	if _, err := execSQL(t, e, c, "select sum(count(*)) from user"); err == nil {
+		t.Fatal("select of a nested aggregate")
+	}
+
+	e.maxGroups = 2
+	if _, err := execSQL(t, e, c, "select city, count(*) from user group by city"); err == nil {
+		t.Fatal("select of more groups than the max")
+	}
+	e.maxGroups = 0
+	e.memoryBudget = groupOverhead + 50
+	if _, err := execSQL(t, e, c, "select count(distinct name) from user"); err == nil || !strings.Contains(err.Error(), "bytes of memory") {
+		t.Fatalf("unexpected error of the memory budget: %v", err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	backend := newMemBackend()
+	e := newExecutor(backend, newMemMaster(backend), 100)
+	c := &mysql.Conn{SchemaName: "test"}
+
+	tests := []struct {
+		sql    string
+		stages string
+		detail string
+	}{
+		{"explain select * from user where id = 1", "partition;gate", `get by id ["1"]`},
+		{"explain select name from user where age > 3 order by age desc limit 5", "partition;router;gate", `the top 5`},
+		{"explain select city, count(*) from user group by city", "partition;router;gate", `"group_by":["city"]`},
+		{"explain select count(distinct name) from user", "partition;router;gate", `in pages of 100`},
+		{"explain select * from information_schema.tables", "gate", ""},
+	}
+	for _, test := range tests {
+		result := mustExec(t, e, c, test.sql)
+		var stages []string
+		for _, row := range result.Rows {
+			stages = append(stages, row[1].ToString())
+		}
+		if strings.Join(stages, ";") != test.stages {
+			t.Errorf("%s: stages %v, expect %s", test.sql, stages, test.stages)
+		}
+		if !strings.Contains(result.Rows[0][3].ToString(), test.detail) {
+			t.Errorf("%s: detail %s, expect %s", test.sql, result.Rows[0][3].ToString(), test.detail)
+		}
+	}
+	if len(backend.spaces) != 0 || backend.lastSearch != nil {
+		t.Fatal("explain ran the select")
+	}
+}
@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"net"
+
+	"golang.org/x/net/context"
+
+	"github.com/tiglabs/baudengine/mygate/auth"
+
+	"vitess.io/vitess/go/mysql"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// baudAuthServerImpl is the -mysql_auth_server_impl authenticating against the users stored in the
+// cluster.
+const baudAuthServerImpl = "baudengine"
+
+// authServer authenticates the connections against the user store. The users of
+// mysql_native_password are checked by the scramble of the handshake, the users of
+// caching_sha2_password send their password in clear text, over TLS unless
+// -mysql_allow_clear_text_without_tls. The stmtConns of the listener of conns terminate the TLS
+// of its connections, vitess does not know them.
+type authServer struct {
+	users *userStore
+	conns *stmtHandler
+}
+
+func newAuthServer(users *userStore) *authServer {
+	return &authServer{users: users}
+}
+
+func (a *authServer) AuthMethod(user string) (string, error) {
+	u, err := a.users.user(context.Background(), user)
+	if err != nil {
+		return "", err
+	}
+	if u != nil && u.Plugin == auth.CachingSha2Password {
+		return mysql.MysqlClearPassword, nil
+	}
+	return mysql.MysqlNativePassword, nil
+}
+
+func (a *authServer) Salt() ([]byte, error) {
+	return mysql.NewSalt()
+}
+
+func (a *authServer) ValidateHash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (mysql.Getter, error) {
+	u, err := a.users.user(context.Background(), user)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || !u.CheckScramble(salt, authResponse) {
+		return nil, errAccessDenied(user)
+	}
+	return &userData{name: user}, nil
+}
+
+func (a *authServer) Negotiate(c *mysql.Conn, user string, remoteAddr net.Addr) (mysql.Getter, error) {
+	if a.conns != nil && !*mysqlAllowClearTextWithoutTLS && !a.conns.secure(c.ConnectionID) {
+		return nil, mysql.NewSQLError(mysql.CRServerHandshakeErr, mysql.SSUnknownSQLState, "Cannot use clear text authentication over non-SSL connections.")
+	}
+	password, err := mysql.AuthServerReadPacketString(c)
+	if err != nil {
+		return nil, err
+	}
+	u, err := a.users.user(context.Background(), user)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || !u.CheckPassword(password) {
+		return nil, errAccessDenied(user)
+	}
+	return &userData{name: user}, nil
+}
+
+func errAccessDenied(user string) error {
+	return mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%s'", user)
+}
+
+// userData is the caller of the statements of a connection.
+type userData struct {
+	name string
+}
+
+func (d *userData) Get() *querypb.VTGateCallerID {
+	return &querypb.VTGateCallerID{Username: d.name}
+}
@@ -0,0 +1,212 @@
+package mysql
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/context"
+)
+
+var (
+	routerAddr     = flag.String("router_addr", "127.0.0.1:9000", "The http address of the router serving the spaces of the gate.")
+	routerUser     = flag.String("router_user", "root", "The user the gate authenticates as to the router, it checks the grants of its own users itself.")
+	routerPassword = flag.String("router_password", "", "The password of -router_user.")
+)
+
+// the reply codes of the router, see router/errors.go
+const (
+	routerCodeSuccess  = 0
+	routerCodeNotFound = 4
+)
+
+// Backend is how the gate reaches the objects of the spaces, the router implements it over http.
+type Backend interface {
+	// Get reads the object of the space by id, nil if it does not exist.
+	Get(ctx context.Context, db, space, id string) (map[string]interface{}, error)
+	// Bulk writes the objects of the space, the results are in write order.
+	Bulk(ctx context.Context, db, space string, writes []Write) ([]WriteResult, error)
+	// Search runs the query on all the partitions of the space.
+	Search(ctx context.Context, db, space string, request *SearchRequest) (*SearchResult, error)
+}
+
+// Write is a create, update or delete of an object, the update replaces the whole object.
+type Write struct {
+	Op           string                 `json:"op"`
+	ID           string                 `json:"id"`
+	Doc          map[string]interface{} `json:"doc,omitempty"`
+	Precondition *Precondition          `json:"precondition,omitempty"`
+}
+
+// Precondition is evaluated by the partition when the write is applied.
+type Precondition struct {
+	NotExists bool                   `json:"not_exists,omitempty"`
+	Match     map[string]interface{} `json:"match,omitempty"`
+}
+
+type WriteResult struct {
+	ID     string `json:"_id"`
+	Result string `json:"_result,omitempty"`
+	Error  string `json:"_error,omitempty"`
+}
+
+// the results of the writes which changed an object
+const (
+	writeCreated = "CREATED"
+	writeUpdated = "UPDATED"
+	writeDeleted = "DELETED"
+)
+
+// SearchRequest is a query in the dsl of the engine, "-field" in Sort is descending.
+type SearchRequest struct {
+	Query       interface{}  `json:"query"`
+	From        int          `json:"from"`
+	Size        int          `json:"size"`
+	Sort        []string     `json:"sort,omitempty"`
+	Fields      []string     `json:"fields,omitempty"`
+	Aggregation *Aggregation `json:"aggregation,omitempty"`
+	// keeps the snapshots of the partitions for the next searches of the scroll this long, the
+	// search of ScrollID without it closes the scroll
+	Scroll   string `json:"scroll,omitempty"`
+	ScrollID string `json:"scroll_id,omitempty"`
+}
+
+// Aggregation folds all the hits of every partition into buckets by the values of GroupBy, see
+// engine.Aggregation.
+type Aggregation struct {
+	GroupBy   []string `json:"group_by,omitempty"`
+	Metrics   []Metric `json:"metrics,omitempty"`
+	MaxGroups int      `json:"max_groups,omitempty"`
+}
+
+// Metric is a count, sum, min or max of a field, a count without field counts the hits.
+type Metric struct {
+	Func  string `json:"func"`
+	Field string `json:"field,omitempty"`
+}
+
+// Bucket is a group of a partition, the values are the metrics in order.
+type Bucket struct {
+	Key    []interface{} `json:"key"`
+	Values []interface{} `json:"values"`
+}
+
+type SearchHit struct {
+	ID     string                 `json:"_id"`
+	Score  float64                `json:"_score"`
+	Source map[string]interface{} `json:"_source"`
+}
+
+type SearchResult struct {
+	Total uint64      `json:"total"`
+	Hits  []SearchHit `json:"hits"`
+	// the buckets of the aggregation, a group has a bucket of every partition holding it
+	Buckets []Bucket `json:"buckets,omitempty"`
+	// the scroll of the next search
+	ScrollID string `json:"scroll_id,omitempty"`
+}
+
+type routerReply struct {
+	Code int32           `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// routerBackend calls the http api of a router, as the user of the basic authentication.
+type routerBackend struct {
+	addr     string
+	user     string
+	password string
+	client   *http.Client
+}
+
+func newRouterBackend(addr, user, password string) *routerBackend {
+	return &routerBackend{addr: addr, user: user, password: password, client: &http.Client{}}
+}
+
+func (b *routerBackend) Get(ctx context.Context, db, space, id string) (map[string]interface{}, error) {
+	var doc map[string]interface{}
+	found, err := b.call(ctx, http.MethodGet, b.path("_txn", db, space, id), nil, &doc)
+	if err != nil || !found {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (b *routerBackend) Bulk(ctx context.Context, db, space string, writes []Write) ([]WriteResult, error) {
+	request := struct {
+		Requests []Write `json:"requests"`
+	}{writes}
+	var results []WriteResult
+	if _, err := b.call(ctx, http.MethodPost, b.path("_bulk", db, space), request, &results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(writes) {
+		return nil, fmt.Errorf("router replied %d results to %d writes", len(results), len(writes))
+	}
+	return results, nil
+}
+
+func (b *routerBackend) Search(ctx context.Context, db, space string, request *SearchRequest) (*SearchResult, error) {
+	result := new(SearchResult)
+	if _, err := b.call(ctx, http.MethodPost, b.path("_search", db, space), request, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (b *routerBackend) path(api string, elems ...string) string {
+	path := "http://" + b.addr + "/" + api
+	for _, elem := range elems {
+		path += "/" + url.PathEscape(elem)
+	}
+	return path
+}
+
+// call sends the request to the router and decodes the data of the reply into data, found is
+// false if the router replied not found.
+func (b *routerBackend) call(ctx context.Context, method, path string, request, data interface{}) (found bool, err error) {
+	var body bytes.Buffer
+	if request != nil {
+		if err := json.NewEncoder(&body).Encode(request); err != nil {
+			return false, err
+		}
+	}
+	req, err := http.NewRequest(method, path, &body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if b.user != "" {
+		req.SetBasicAuth(b.user, b.password)
+	}
+	resp, err := b.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	var reply routerReply
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return false, err
+	}
+	switch reply.Code {
+	case routerCodeSuccess:
+	case routerCodeNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("router: %s", reply.Msg)
+	}
+	if data != nil && len(reply.Data) > 0 {
+		// keep the integers apart from the floats for the column types
+		decoder := json.NewDecoder(bytes.NewReader(reply.Data))
+		decoder.UseNumber()
+		if err := decoder.Decode(data); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
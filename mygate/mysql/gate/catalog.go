@@ -0,0 +1,356 @@
+package mysql
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/tiglabs/baudengine/mygate/auth"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var mysqlCatalogTTL = flag.Duration("mysql_catalog_ttl", 10*time.Second, "How long a table definition is cached, the DDL of the other gates shows after it.")
+
+const (
+	// systemDB keeps the schema of the tables, the definitions the engine mapping can not express,
+	// and the users
+	systemDB = auth.SystemDB
+	// catalogSpace is the space of the system db of the table definitions, keyed by "db.table"
+	catalogSpace = "tables"
+	// informationSchema is the db of the catalog views
+	informationSchema = "information_schema"
+
+	// indexFieldPrefix names the field of an object keeping the values of a compound index
+	indexFieldPrefix = "_index_"
+)
+
+// tableDef is the definition of a table created by CREATE TABLE.
+type tableDef struct {
+	DB           string       `json:"db"`
+	Name         string       `json:"name"`
+	Columns      []*columnDef `json:"columns"`
+	PrimaryKey   string       `json:"primary_key"`
+	PartitionKey string       `json:"partition_key"`
+	Partitions   int          `json:"partitions"`
+	Indexes      []*indexDef  `json:"indexes,omitempty"`
+}
+
+// columnDef is a column of a table, Type is the column type as declared, "varchar(20)".
+type columnDef struct {
+	Name    string      `json:"name"`
+	Type    string      `json:"type"`
+	NotNull bool        `json:"not_null,omitempty"`
+	Default interface{} `json:"default,omitempty"`
+	// DefaultNow is DEFAULT CURRENT_TIMESTAMP
+	DefaultNow bool `json:"default_now,omitempty"`
+}
+
+// indexDef is a secondary index of a table. Every field of an object is indexed by the engine, an
+// index of more than one column is a field of its own keeping the values of the columns.
+type indexDef struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique,omitempty"`
+}
+
+func (d *tableDef) column(name string) *columnDef {
+	for _, col := range d.Columns {
+		if strings.EqualFold(col.Name, name) {
+			return col
+		}
+	}
+	return nil
+}
+
+func (d *tableDef) index(name string) *indexDef {
+	for _, idx := range d.Indexes {
+		if strings.EqualFold(idx.Name, name) {
+			return idx
+		}
+	}
+	return nil
+}
+
+// compound tells whether the index has a field of its own.
+func (idx *indexDef) compound() bool {
+	return len(idx.Columns) > 1
+}
+
+func (idx *indexDef) field() string {
+	return indexFieldPrefix + idx.Name
+}
+
+// baseType is the type of the column without its length and options, "varchar".
+func (col *columnDef) baseType() string {
+	typ := col.Type
+	if i := strings.IndexAny(typ, "( "); i >= 0 {
+		typ = typ[:i]
+	}
+	return typ
+}
+
+func (col *columnDef) unsigned() bool {
+	return strings.HasSuffix(col.Type, " unsigned")
+}
+
+// fieldTypes maps the column types to the field types of the engine mapping, see
+// engine/kernel/mapping.
+var fieldTypes = map[string]string{
+	"bit":        "long",
+	"bool":       "boolean",
+	"boolean":    "boolean",
+	"tinyint":    "byte",
+	"smallint":   "short",
+	"mediumint":  "integer",
+	"int":        "integer",
+	"integer":    "integer",
+	"bigint":     "long",
+	"float":      "float",
+	"double":     "double",
+	"real":       "double",
+	"decimal":    "double",
+	"numeric":    "double",
+	"char":       "keyword",
+	"varchar":    "keyword",
+	"binary":     "keyword",
+	"varbinary":  "keyword",
+	"enum":       "keyword",
+	"set":        "keyword",
+	"time":       "keyword",
+	"tinytext":   "text",
+	"text":       "text",
+	"mediumtext": "text",
+	"longtext":   "text",
+	"tinyblob":   "text",
+	"blob":       "text",
+	"mediumblob": "text",
+	"longblob":   "text",
+	"date":       "date",
+	"datetime":   "date",
+	"timestamp":  "date",
+	"year":       "short",
+	"json":       "object",
+}
+
+// sqlTypes maps the column types to the types of the protocol.
+var sqlTypes = map[string]querypb.Type{
+	"bit":        sqltypes.Bit,
+	"bool":       sqltypes.Int8,
+	"boolean":    sqltypes.Int8,
+	"tinyint":    sqltypes.Int8,
+	"smallint":   sqltypes.Int16,
+	"mediumint":  sqltypes.Int24,
+	"int":        sqltypes.Int32,
+	"integer":    sqltypes.Int32,
+	"bigint":     sqltypes.Int64,
+	"float":      sqltypes.Float32,
+	"double":     sqltypes.Float64,
+	"real":       sqltypes.Float64,
+	"decimal":    sqltypes.Decimal,
+	"numeric":    sqltypes.Decimal,
+	"char":       sqltypes.Char,
+	"varchar":    sqltypes.VarChar,
+	"binary":     sqltypes.Binary,
+	"varbinary":  sqltypes.VarBinary,
+	"enum":       sqltypes.Enum,
+	"set":        sqltypes.Set,
+	"time":       sqltypes.Time,
+	"tinytext":   sqltypes.Text,
+	"text":       sqltypes.Text,
+	"mediumtext": sqltypes.Text,
+	"longtext":   sqltypes.Text,
+	"tinyblob":   sqltypes.Blob,
+	"blob":       sqltypes.Blob,
+	"mediumblob": sqltypes.Blob,
+	"longblob":   sqltypes.Blob,
+	"date":       sqltypes.Date,
+	"datetime":   sqltypes.Datetime,
+	"timestamp":  sqltypes.Timestamp,
+	"year":       sqltypes.Year,
+	"json":       sqltypes.TypeJSON,
+}
+
+var unsignedTypes = map[querypb.Type]querypb.Type{
+	sqltypes.Int8:  sqltypes.Uint8,
+	sqltypes.Int16: sqltypes.Uint16,
+	sqltypes.Int24: sqltypes.Uint24,
+	sqltypes.Int32: sqltypes.Uint32,
+	sqltypes.Int64: sqltypes.Uint64,
+}
+
+func (col *columnDef) sqlType() querypb.Type {
+	typ := sqlTypes[col.baseType()]
+	if unsigned, ok := unsignedTypes[typ]; ok && col.unsigned() {
+		return unsigned
+	}
+	return typ
+}
+
+// schema is the mapping of the space of the table, see engine/bleve.ParseSchema.
+func (d *tableDef) schema() string {
+	properties := make(map[string]interface{}, len(d.Columns)+len(d.Indexes)+1)
+	for _, col := range d.Columns {
+		properties[col.Name] = map[string]string{"type": fieldTypes[col.baseType()]}
+	}
+	for _, idx := range d.Indexes {
+		if idx.compound() {
+			properties[idx.field()] = map[string]string{"type": "keyword"}
+		}
+	}
+	properties[tableField] = map[string]string{"type": "keyword"}
+	data, _ := json.Marshal(map[string]interface{}{
+		"mappings": map[string]interface{}{
+			d.Name: map[string]interface{}{"properties": properties},
+		},
+	})
+	return string(data)
+}
+
+// catalogSchema is the mapping of the catalog space.
+var catalogSchema = `{"mappings":{"tables":{"properties":{"db":{"type":"keyword"},"name":{"type":"keyword"}}}}}`
+
+// catalog keeps the table definitions in the catalog space of the system db and caches them.
+type catalog struct {
+	backend Backend
+	master  Master
+	ttl     time.Duration
+
+	lock   sync.Mutex
+	ready  bool
+	tables map[string]*cachedDef
+}
+
+type cachedDef struct {
+	def    *tableDef
+	expire time.Time
+}
+
+func newCatalog(backend Backend, master Master, ttl time.Duration) *catalog {
+	return &catalog{backend: backend, master: master, ttl: ttl, tables: make(map[string]*cachedDef)}
+}
+
+func catalogKey(db, name string) string {
+	return db + "." + name
+}
+
+// prepare creates the system db and the catalog space if they do not exist, the router reads
+// them from the master on first use.
+func (c *catalog) prepare(ctx context.Context) error {
+	c.lock.Lock()
+	ready := c.ready
+	c.lock.Unlock()
+	if ready {
+		return nil
+	}
+
+	if err := c.master.CreateDB(ctx, systemDB); err != nil && err != errDupDB {
+		return err
+	}
+	policy := &PartitionPolicy{Key: "db", Function: "hash", Number: 1}
+	if err := c.master.CreateSpace(ctx, systemDB, catalogSpace, catalogSchema, policy); err != nil && err != errDupSpace {
+		return err
+	}
+	c.lock.Lock()
+	c.ready = true
+	c.lock.Unlock()
+	return nil
+}
+
+// definition returns the definition of the table, nil if it was not created by CREATE TABLE.
+func (c *catalog) definition(ctx context.Context, db, name string) (*tableDef, error) {
+	key := catalogKey(db, name)
+	c.lock.Lock()
+	cached, ok := c.tables[key]
+	c.lock.Unlock()
+	if ok && time.Now().Before(cached.expire) {
+		return cached.def, nil
+	}
+
+	if err := c.prepare(ctx); err != nil {
+		return nil, err
+	}
+	obj, err := c.backend.Get(ctx, systemDB, catalogSpace, key)
+	if err != nil {
+		return nil, err
+	}
+	var def *tableDef
+	if obj != nil {
+		def = new(tableDef)
+		if err := convertObject(obj, def); err != nil {
+			return nil, err
+		}
+	}
+	c.lock.Lock()
+	c.tables[key] = &cachedDef{def: def, expire: time.Now().Add(c.ttl)}
+	c.lock.Unlock()
+	return def, nil
+}
+
+// define writes the definition of the table.
+func (c *catalog) define(ctx context.Context, def *tableDef) error {
+	doc := make(map[string]interface{})
+	if err := convertObject(def, &doc); err != nil {
+		return err
+	}
+	return c.write(ctx, Write{Op: "create", ID: catalogKey(def.DB, def.Name), Doc: doc}, def)
+}
+
+// forget deletes the definition of the table if there is one.
+func (c *catalog) forget(ctx context.Context, db, name string) error {
+	if def, err := c.definition(ctx, db, name); err != nil || def == nil {
+		return err
+	}
+	return c.write(ctx, Write{Op: "delete", ID: catalogKey(db, name)}, &tableDef{DB: db, Name: name})
+}
+
+func (c *catalog) write(ctx context.Context, w Write, def *tableDef) error {
+	results, err := c.backend.Bulk(ctx, systemDB, catalogSpace, []Write{w})
+	if err != nil {
+		return err
+	}
+	if results[0].Error != "" {
+		return mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "write of the definition of table %s failed: %s", w.ID, results[0].Error)
+	}
+
+	key := catalogKey(def.DB, def.Name)
+	c.lock.Lock()
+	if w.Op == "delete" {
+		c.tables[key] = &cachedDef{expire: time.Now().Add(c.ttl)}
+	} else {
+		c.tables[key] = &cachedDef{def: def, expire: time.Now().Add(c.ttl)}
+	}
+	c.lock.Unlock()
+	return nil
+}
+
+// table returns the table of the db, a table without definition is the space of the same name
+// keyed by -mysql_primary_key.
+func (c *catalog) table(ctx context.Context, db, name string) (*table, error) {
+	def, err := c.definition(ctx, db, name)
+	if err != nil {
+		return nil, err
+	}
+	if def == nil {
+		return &table{db: db, name: name, space: name, primaryKey: *mysqlPrimaryKey}, nil
+	}
+	return &table{db: db, name: name, space: name, primaryKey: def.PrimaryKey, def: def}, nil
+}
+
+// convertObject converts between the objects and the definitions through json.
+func convertObject(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(to)
+}
@@ -0,0 +1,606 @@
+package mysql
+
+import (
+	"flag"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var mysqlPartitions = flag.Int("mysql_partitions", 4, "The partitions of a table created without PARTITIONS.")
+
+// The parser drops the details of the statements below, the gate matches them itself and hands
+// the column and index definitions back to the parser as the spec of a CREATE TABLE:
+//   CREATE DATABASE [IF NOT EXISTS] db, DROP DATABASE [IF EXISTS] db
+//   CREATE TABLE [IF NOT EXISTS] ... [PARTITION BY {KEY|HASH} (column) [PARTITIONS n]]
+//   ALTER TABLE t ADD [COLUMN] definition | (definitions...)
+//   CREATE [UNIQUE] INDEX i ON t (columns...), DROP INDEX i ON t, ALTER TABLE t DROP INDEX i
+//   DESCRIBE t, SHOW [FULL] COLUMNS FROM t, SHOW INDEX FROM t, SHOW CREATE TABLE t
+//   EXPLAIN SELECT ...
+// the statements on the users, see users.go, and the prepared statements, see prepared.go.
+
+const (
+	namePattern      = "(`[^`]+`|[\\w$]+)"
+	tableNamePattern = "(" + namePattern + "(?:\\." + namePattern + ")?)"
+)
+
+var (
+	createDatabasePattern  = regexp.MustCompile(`(?is)^\s*create\s+(?:database|schema)\s+(if\s+not\s+exists\s+)?` + namePattern + `(\s+.*)?$`)
+	dropDatabasePattern    = regexp.MustCompile(`(?is)^\s*drop\s+(?:database|schema)\s+(if\s+exists\s+)?` + namePattern + `\s*$`)
+	createTablePattern     = regexp.MustCompile(`(?is)^(\s*create\s+table\s+)(if\s+not\s+exists\s+)?`)
+	partitionByPattern     = regexp.MustCompile(`(?is)\s+partition\s+by\s+(?:linear\s+)?(?:key|hash)\s*\(\s*` + namePattern + `\s*\)(?:\s+partitions\s+(\d+))?\s*$`)
+	alterTableAddPattern   = regexp.MustCompile(`(?is)^\s*alter\s+table\s+` + tableNamePattern + `\s+add\s+(?:column\s+)?(.+?)\s*$`)
+	createIndexPattern     = regexp.MustCompile(`(?is)^\s*create\s+(unique\s+)?index\s+` + namePattern + `\s+(?:using\s+\w+\s+)?on\s+` + tableNamePattern + `\s*(\(.+\))\s*$`)
+	dropIndexPattern       = regexp.MustCompile(`(?is)^\s*drop\s+index\s+` + namePattern + `\s+on\s+` + tableNamePattern + `\s*$`)
+	alterDropIndexPattern  = regexp.MustCompile(`(?is)^\s*alter\s+table\s+` + tableNamePattern + `\s+drop\s+(?:index|key)\s+` + namePattern + `\s*$`)
+	explainSelectPattern   = regexp.MustCompile(`(?is)^\s*explain\s+(select\s.+)$`)
+	describePattern        = regexp.MustCompile(`(?is)^\s*(?:describe|desc|explain)\s+` + tableNamePattern + `\s*$`)
+	showColumnsPattern     = regexp.MustCompile(`(?is)^\s*show\s+(?:full\s+)?(?:columns|fields)\s+(?:from|in)\s+` + tableNamePattern + `(?:\s+(?:from|in)\s+` + namePattern + `)?\s*$`)
+	showIndexPattern       = regexp.MustCompile(`(?is)^\s*show\s+(?:index|indexes|keys)\s+(?:from|in)\s+` + tableNamePattern + `(?:\s+(?:from|in)\s+` + namePattern + `)?\s*$`)
+	showCreateTablePattern = regexp.MustCompile(`(?is)^\s*show\s+create\s+table\s+` + tableNamePattern + `\s*$`)
+)
+
+type createDatabase struct {
+	name        string
+	ifNotExists bool
+}
+
+type dropDatabase struct {
+	name     string
+	ifExists bool
+}
+
+type createTable struct {
+	ddl          *sqlparser.DDL
+	ifNotExists  bool
+	partitionKey string
+	partitions   int
+}
+
+// alterTable adds the columns and the indexes of the spec to the table, index tells it is a
+// CREATE INDEX.
+type alterTable struct {
+	table sqlparser.TableName
+	spec  *sqlparser.TableSpec
+	index bool
+}
+
+type dropIndex struct {
+	table sqlparser.TableName
+	index string
+}
+
+type showColumns struct {
+	table sqlparser.TableName
+}
+
+type showIndex struct {
+	table sqlparser.TableName
+}
+
+type showCreateTable struct {
+	table sqlparser.TableName
+}
+
+type explainSelect struct {
+	sel *sqlparser.Select
+}
+
+// unquote returns the name without its backquotes.
+func unquote(name string) string {
+	if len(name) >= 2 && name[0] == '`' {
+		return name[1 : len(name)-1]
+	}
+	return name
+}
+
+// tableNameOf returns the table name of the submatches of tableNamePattern, db of a SHOW ...
+// FROM t FROM db qualifies it.
+func tableNameOf(match []string, db string) sqlparser.TableName {
+	name := sqlparser.TableName{Name: sqlparser.NewTableIdent(unquote(match[1]))}
+	if match[2] != "" {
+		name = sqlparser.TableName{Qualifier: name.Name, Name: sqlparser.NewTableIdent(unquote(match[2]))}
+	}
+	if db != "" {
+		name.Qualifier = sqlparser.NewTableIdent(unquote(db))
+	}
+	return name
+}
+
+// tableSpec parses the column and index definitions as the spec of a CREATE TABLE. The parser
+// takes an index only after a column, the spec is led by a placeholder column it drops.
+func tableSpec(definitions string) (*sqlparser.TableSpec, error) {
+	stmt, err := sqlparser.ParseStrictDDL("create table t (`_` int, " + definitions + ")")
+	if err != nil {
+		return nil, err
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.TableSpec == nil {
+		return nil, errNotSupported("definitions %s are not supported", definitions)
+	}
+	ddl.TableSpec.Columns = ddl.TableSpec.Columns[1:]
+	return ddl.TableSpec, nil
+}
+
+// parseGateStatement parses the statements the gate matches itself, ok is false for the others.
+func parseGateStatement(sql string) (stmt interface{}, ok bool, err error) {
+	if m := createDatabasePattern.FindStringSubmatch(sql); m != nil {
+		return &createDatabase{name: unquote(m[2]), ifNotExists: m[1] != ""}, true, nil
+	}
+	if m := dropDatabasePattern.FindStringSubmatch(sql); m != nil {
+		return &dropDatabase{name: unquote(m[2]), ifExists: m[1] != ""}, true, nil
+	}
+	if m := createTablePattern.FindStringSubmatch(sql); m != nil {
+		create := &createTable{ifNotExists: m[2] != "", partitions: *mysqlPartitions}
+		// the parser takes neither IF NOT EXISTS nor the partitioning
+		sql = m[1] + sql[len(m[0]):]
+		if m := partitionByPattern.FindStringSubmatchIndex(sql); m != nil {
+			create.partitionKey = unquote(sql[m[2]:m[3]])
+			if m[4] >= 0 {
+				if create.partitions, err = strconv.Atoi(sql[m[4]:m[5]]); err != nil || create.partitions <= 0 {
+					return nil, true, errNotSupported("PARTITIONS %s is not supported", sql[m[4]:m[5]])
+				}
+			}
+			sql = sql[:m[0]]
+		}
+		parsed, err := sqlparser.ParseStrictDDL(sql)
+		if err != nil {
+			return nil, true, err
+		}
+		ddl, isDDL := parsed.(*sqlparser.DDL)
+		if !isDDL || ddl.TableSpec == nil {
+			return nil, true, errNotSupported("statement %s is not supported", sql)
+		}
+		create.ddl = ddl
+		return create, true, nil
+	}
+	if m := alterTableAddPattern.FindStringSubmatch(sql); m != nil {
+		definitions := m[4]
+		if strings.HasPrefix(definitions, "(") && strings.HasSuffix(definitions, ")") {
+			definitions = definitions[1 : len(definitions)-1]
+		}
+		spec, err := tableSpec(definitions)
+		if err != nil {
+			return nil, true, err
+		}
+		return &alterTable{table: tableNameOf(m[1:], ""), spec: spec}, true, nil
+	}
+	if m := createIndexPattern.FindStringSubmatch(sql); m != nil {
+		spec, err := tableSpec(m[1] + "index " + m[2] + " " + m[6])
+		if err != nil {
+			return nil, true, err
+		}
+		return &alterTable{table: tableNameOf(m[3:], ""), spec: spec, index: true}, true, nil
+	}
+	if m := dropIndexPattern.FindStringSubmatch(sql); m != nil {
+		return &dropIndex{table: tableNameOf(m[2:], ""), index: unquote(m[1])}, true, nil
+	}
+	if m := alterDropIndexPattern.FindStringSubmatch(sql); m != nil {
+		return &dropIndex{table: tableNameOf(m[1:], ""), index: unquote(m[4])}, true, nil
+	}
+	if m := explainSelectPattern.FindStringSubmatch(sql); m != nil {
+		parsed, err := sqlparser.Parse(m[1])
+		if err != nil {
+			return nil, true, err
+		}
+		sel, ok := parsed.(*sqlparser.Select)
+		if !ok {
+			return nil, true, errNotSupported("EXPLAIN of %s is not supported", m[1])
+		}
+		return &explainSelect{sel: sel}, true, nil
+	}
+	if m := describePattern.FindStringSubmatch(sql); m != nil {
+		return &showColumns{table: tableNameOf(m[1:], "")}, true, nil
+	}
+	if m := showColumnsPattern.FindStringSubmatch(sql); m != nil {
+		return &showColumns{table: tableNameOf(m[1:], m[4])}, true, nil
+	}
+	if m := showIndexPattern.FindStringSubmatch(sql); m != nil {
+		return &showIndex{table: tableNameOf(m[1:], m[4])}, true, nil
+	}
+	if m := showCreateTablePattern.FindStringSubmatch(sql); m != nil {
+		return &showCreateTable{table: tableNameOf(m[1:], "")}, true, nil
+	}
+	if stmt, ok, err := parseUserStatement(sql); ok {
+		return stmt, ok, err
+	}
+	return parsePreparedStatement(sql)
+}
+
+// reservedDB tells whether the db is kept by the gate, the DDL of the users may not change it.
+func reservedDB(db string) bool {
+	return strings.EqualFold(db, systemDB) || strings.EqualFold(db, informationSchema)
+}
+
+func errReservedDB(db string) error {
+	return mysql.NewSQLError(mysql.ERDBAccessDenied, mysql.SSAccessDeniedError, "Access denied to database '%s'", db)
+}
+
+func (e *executor) execCreateDatabase(ctx context.Context, stmt *createDatabase) (*sqltypes.Result, error) {
+	// the reserved dbs exist as far as the users see
+	if !reservedDB(stmt.name) {
+		switch err := e.master.CreateDB(ctx, stmt.name); err {
+		case nil:
+			return &sqltypes.Result{RowsAffected: 1}, nil
+		case errDupDB:
+		default:
+			return nil, err
+		}
+	}
+	if stmt.ifNotExists {
+		return &sqltypes.Result{}, nil
+	}
+	return nil, mysql.NewSQLError(mysql.ERDbCreateExists, mysql.SSUnknownSQLState, "Can't create database '%s'; database exists", stmt.name)
+}
+
+// execDropDatabase drops the tables of the db and then the db.
+func (e *executor) execDropDatabase(ctx context.Context, c *mysql.Conn, stmt *dropDatabase) (*sqltypes.Result, error) {
+	if reservedDB(stmt.name) {
+		return nil, errReservedDB(stmt.name)
+	}
+	spaces, err := e.master.Spaces(ctx, stmt.name)
+	if err == errDBNotExists {
+		if stmt.ifExists {
+			return &sqltypes.Result{}, nil
+		}
+		return nil, mysql.NewSQLError(mysql.ERDbDropExists, mysql.SSUnknownSQLState, "Can't drop database '%s'; database doesn't exist", stmt.name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, space := range spaces {
+		if err := e.master.DropSpace(ctx, stmt.name, space); err != nil && err != errSpaceNotExists {
+			return nil, err
+		}
+		if err := e.catalog.forget(ctx, stmt.name, space); err != nil {
+			return nil, err
+		}
+	}
+	if err := e.master.DropDB(ctx, stmt.name); err != nil && err != errDBNotExists {
+		return nil, err
+	}
+	if c.SchemaName == stmt.name {
+		c.SchemaName = ""
+	}
+	return &sqltypes.Result{RowsAffected: uint64(len(spaces))}, nil
+}
+
+func qualifiedDB(db string, name sqlparser.TableName) (string, error) {
+	if !name.Qualifier.IsEmpty() {
+		db = name.Qualifier.String()
+	}
+	if db == "" {
+		return "", errNoDB
+	}
+	return db, nil
+}
+
+func errUnknownDB(db string) error {
+	return mysql.NewSQLError(mysql.ERBadDb, "42000", "Unknown database '%s'", db)
+}
+
+func errNoSuchTable(db, name string) error {
+	return mysql.NewSQLError(mysql.ERNoSuchTable, "42S02", "Table '%s.%s' doesn't exist", db, name)
+}
+
+// execCreateTable creates the space of the table through the master and writes its definition.
+func (e *executor) execCreateTable(ctx context.Context, db string, stmt *createTable) (*sqltypes.Result, error) {
+	db, err := qualifiedDB(db, stmt.ddl.NewName)
+	if err != nil {
+		return nil, err
+	}
+	if reservedDB(db) {
+		return nil, errReservedDB(db)
+	}
+	def, err := tableDefinition(db, stmt.ddl.NewName.Name.String(), stmt.ddl.TableSpec)
+	if err != nil {
+		return nil, err
+	}
+	def.PartitionKey, def.Partitions = def.PrimaryKey, stmt.partitions
+	if stmt.partitionKey != "" {
+		col := def.column(stmt.partitionKey)
+		if col == nil {
+			return nil, mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Unknown column '%s' in 'partition function'", stmt.partitionKey)
+		}
+		// the router places the writes and the lookups of the gate by the id
+		if col.Name != def.PrimaryKey {
+			return nil, errNotSupported("the rows are partitioned by the primary key %s, not by %s", def.PrimaryKey, col.Name)
+		}
+	}
+
+	errExists := mysql.NewSQLError(mysql.ERTableExists, "42S01", "Table '%s' already exists", def.Name)
+	if existing, err := e.catalog.definition(ctx, db, def.Name); err != nil {
+		return nil, err
+	} else if existing != nil {
+		if stmt.ifNotExists {
+			return &sqltypes.Result{}, nil
+		}
+		return nil, errExists
+	}
+	policy := &PartitionPolicy{Key: def.PartitionKey, Function: "hash", Number: def.Partitions}
+	switch err := e.master.CreateSpace(ctx, db, def.Name, def.schema(), policy); err {
+	case nil:
+	case errDBNotExists:
+		return nil, errUnknownDB(db)
+	case errDupSpace:
+		// a space created without the gate
+		if stmt.ifNotExists {
+			return &sqltypes.Result{}, nil
+		}
+		return nil, errExists
+	default:
+		return nil, err
+	}
+	if err := e.catalog.define(ctx, def); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// tableDefinition returns the definition of the spec, the primary key is a single column.
+func tableDefinition(db, name string, spec *sqlparser.TableSpec) (*tableDef, error) {
+	def := &tableDef{DB: db, Name: name}
+	for _, column := range spec.Columns {
+		col, primary, err := columnDefinition(column)
+		if err != nil {
+			return nil, err
+		}
+		if def.column(col.Name) != nil {
+			return nil, mysql.NewSQLError(mysql.ERDupFieldName, "42S21", "Duplicate column name '%s'", col.Name)
+		}
+		def.Columns = append(def.Columns, col)
+		if primary {
+			if def.PrimaryKey != "" {
+				return nil, mysql.NewSQLError(mysql.ERUnknownError, "42000", "Multiple primary key defined")
+			}
+			def.PrimaryKey = col.Name
+		}
+	}
+	for _, index := range spec.Indexes {
+		if index.Info.Primary {
+			if def.PrimaryKey != "" {
+				return nil, mysql.NewSQLError(mysql.ERUnknownError, "42000", "Multiple primary key defined")
+			}
+			if len(index.Columns) != 1 {
+				return nil, errNotSupported("a PRIMARY KEY of more than one column is not supported")
+			}
+			col := def.column(index.Columns[0].Column.String())
+			if col == nil {
+				return nil, errNoKeyColumn(index.Columns[0].Column.String())
+			}
+			col.NotNull = true
+			def.PrimaryKey = col.Name
+			continue
+		}
+		if _, err := addIndex(def, index); err != nil {
+			return nil, err
+		}
+	}
+	if def.PrimaryKey == "" {
+		return nil, errNotSupported("a table without PRIMARY KEY is not supported, the primary key is the id of the object of a row")
+	}
+	return def, nil
+}
+
+// columnDefinition returns the definition of the column, primary tells whether it is declared
+// PRIMARY KEY.
+func columnDefinition(column *sqlparser.ColumnDefinition) (col *columnDef, primary bool, err error) {
+	ct := &column.Type
+	if ct.Autoincrement {
+		return nil, false, errNotSupported("AUTO_INCREMENT is not supported, the rows carry their primary key")
+	}
+	typ := strings.ToLower(ct.Type)
+	if _, ok := fieldTypes[typ]; !ok {
+		return nil, false, errNotSupported("column type %s is not supported", ct.Type)
+	}
+	switch {
+	case ct.Length != nil && ct.Scale != nil:
+		typ += "(" + string(ct.Length.Val) + "," + string(ct.Scale.Val) + ")"
+	case ct.Length != nil:
+		typ += "(" + string(ct.Length.Val) + ")"
+	case len(ct.EnumValues) > 0:
+		typ += "(" + strings.Join(ct.EnumValues, ",") + ")"
+	}
+	if ct.Unsigned {
+		typ += " unsigned"
+	}
+	col = &columnDef{Name: column.Name.String(), Type: typ, NotNull: bool(ct.NotNull)}
+
+	if ct.Default != nil {
+		if ct.Default.Type == sqlparser.ValArg {
+			// DEFAULT NULL and DEFAULT CURRENT_TIMESTAMP
+			col.DefaultNow = strings.EqualFold(string(ct.Default.Val), "current_timestamp")
+		} else if col.Default, err = literal(ct.Default); err != nil {
+			return nil, false, err
+		}
+	}
+	// the parser keeps the key of the column only in its text
+	primary = strings.HasSuffix(sqlparser.String(ct), " primary key")
+	if primary {
+		col.NotNull = true
+	}
+	return col, primary, nil
+}
+
+func errNoKeyColumn(column string) error {
+	return mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Key column '%s' doesn't exist in table", column)
+}
+
+// addIndex adds the index to the definition.
+func addIndex(def *tableDef, index *sqlparser.IndexDefinition) (*indexDef, error) {
+	idx := &indexDef{Name: index.Info.Name.String(), Unique: index.Info.Unique}
+	for _, column := range index.Columns {
+		col := def.column(column.Column.String())
+		if col == nil {
+			return nil, errNoKeyColumn(column.Column.String())
+		}
+		idx.Columns = append(idx.Columns, col.Name)
+	}
+	if def.index(idx.Name) != nil || strings.EqualFold(idx.Name, "primary") {
+		return nil, mysql.NewSQLError(mysql.ERDupKeyName, "42000", "Duplicate key name '%s'", idx.Name)
+	}
+	def.Indexes = append(def.Indexes, idx)
+	return idx, nil
+}
+
+// definedTable returns the table of the name, which must have been created by CREATE TABLE.
+func (e *executor) definedTable(ctx context.Context, db string, name sqlparser.TableName) (*table, error) {
+	t, err := e.tableOfName(ctx, db, name)
+	if err != nil {
+		return nil, err
+	}
+	if reservedDB(t.db) {
+		return nil, errReservedDB(t.db)
+	}
+	if t.def == nil {
+		return nil, errNotSupported("table %s.%s was not created by CREATE TABLE, it has no definition to alter", t.db, t.name)
+	}
+	return t, nil
+}
+
+// changeDefinition updates the schema of the space of the table and then its definition.
+func (e *executor) changeDefinition(ctx context.Context, t *table, def *tableDef) error {
+	switch err := e.master.UpdateSchema(ctx, t.db, t.space, def.schema()); err {
+	case nil:
+	case errDBNotExists, errSpaceNotExists:
+		return errNoSuchTable(t.db, t.name)
+	default:
+		return err
+	}
+	t.def = def
+	return e.catalog.define(ctx, def)
+}
+
+// execAlterTable adds the columns and the indexes to the table. The rows written before a
+// compound index get its field.
+func (e *executor) execAlterTable(ctx context.Context, db string, stmt *alterTable) (*sqltypes.Result, error) {
+	t, err := e.definedTable(ctx, db, stmt.table)
+	if err != nil {
+		return nil, err
+	}
+	def := *t.def
+	def.Columns = append([]*columnDef(nil), t.def.Columns...)
+	def.Indexes = append([]*indexDef(nil), t.def.Indexes...)
+
+	for _, column := range stmt.spec.Columns {
+		col, primary, err := columnDefinition(column)
+		if err != nil {
+			return nil, err
+		}
+		if primary {
+			return nil, errNotSupported("the primary key of a table can not be changed")
+		}
+		if def.column(col.Name) != nil {
+			return nil, mysql.NewSQLError(mysql.ERDupFieldName, "42S21", "Duplicate column name '%s'", col.Name)
+		}
+		def.Columns = append(def.Columns, col)
+	}
+	var added []*indexDef
+	for _, index := range stmt.spec.Indexes {
+		if index.Info.Primary {
+			return nil, errNotSupported("the primary key of a table can not be changed")
+		}
+		idx, err := addIndex(&def, index)
+		if err != nil {
+			return nil, err
+		}
+		added = append(added, idx)
+	}
+
+	if err := e.changeDefinition(ctx, t, &def); err != nil {
+		return nil, err
+	}
+	for _, idx := range added {
+		if idx.compound() {
+			if err := e.backfill(ctx, t, idx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// backfill writes the field of the compound index to the rows of the table, page by page of
+// the primary key order.
+func (e *executor) backfill(ctx context.Context, t *table, idx *indexDef) error {
+	query, err := searchQuery(t, nil)
+	if err != nil {
+		return err
+	}
+	fields := append([]string{t.primaryKey}, idx.Columns...)
+	for from := 0; ; from += e.maxRows {
+		result, err := e.backend.Search(ctx, t.db, t.space, &SearchRequest{
+			Query:  query,
+			From:   from,
+			Size:   e.maxRows,
+			Sort:   []string{t.primaryKey},
+			Fields: fields,
+		})
+		if err != nil {
+			return err
+		}
+		var ids []string
+		for _, hit := range result.Hits {
+			if _, ok := indexKey(idx, t.row(hit.ID, hit.Source)); ok {
+				ids = append(ids, hit.ID)
+			}
+		}
+		// the definition of the table has the index, the field is computed with the others
+		if _, err := e.updateRows(ctx, t, ids, nil); err != nil {
+			return err
+		}
+		if len(result.Hits) < e.maxRows {
+			return nil
+		}
+	}
+}
+
+func (e *executor) execDropIndex(ctx context.Context, db string, stmt *dropIndex) (*sqltypes.Result, error) {
+	t, err := e.definedTable(ctx, db, stmt.table)
+	if err != nil {
+		return nil, err
+	}
+	def := *t.def
+	def.Indexes = nil
+	for _, idx := range t.def.Indexes {
+		if !strings.EqualFold(idx.Name, stmt.index) {
+			def.Indexes = append(def.Indexes, idx)
+		}
+	}
+	if len(def.Indexes) == len(t.def.Indexes) {
+		return nil, mysql.NewSQLError(mysql.ERKeyDoesNotExist, "42000", "Key '%s' doesn't exist in table '%s'", stmt.index, t.name)
+	}
+	if err := e.changeDefinition(ctx, t, &def); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// execDropTable drops the space of the table through the master and then its definition.
+func (e *executor) execDropTable(ctx context.Context, db string, ddl *sqlparser.DDL) (*sqltypes.Result, error) {
+	db, err := qualifiedDB(db, ddl.Table)
+	if err != nil {
+		return nil, err
+	}
+	if reservedDB(db) {
+		return nil, errReservedDB(db)
+	}
+	name := ddl.Table.Name.String()
+	dropErr := e.master.DropSpace(ctx, db, name)
+	if dropErr != nil && dropErr != errSpaceNotExists && dropErr != errDBNotExists {
+		return nil, dropErr
+	}
+	if err := e.catalog.forget(ctx, db, name); err != nil {
+		return nil, err
+	}
+	if dropErr != nil && !ddl.IfExists {
+		return nil, mysql.NewSQLError(mysql.ERBadTable, "42S02", "Unknown table '%s.%s'", db, name)
+	}
+	return &sqltypes.Result{}, nil
+}
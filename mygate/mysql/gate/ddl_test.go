@@ -0,0 +1,154 @@
+package mysql
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func expectSQLError(t *testing.T, e *executor, c *mysql.Conn, sql string, number int) {
+	_, err := execSQL(t, e, c, sql)
+	if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != number {
+		t.Fatalf("%s: unexpected error %v, expect %d", sql, err, number)
+	}
+}
+
+func columnValues(result *sqltypes.Result, column int) []string {
+	values := make([]string, len(result.Rows))
+	for i, row := range result.Rows {
+		values[i] = row[column].ToString()
+	}
+	return values
+}
+
+func TestExecuteDDL(t *testing.T) {
+	backend := newMemBackend()
+	master := newMemMaster(backend)
+	e := newExecutor(backend, master, 100)
+	c := &mysql.Conn{}
+
+	mustExec(t, e, c, "create database shop")
+	expectSQLError(t, e, c, "create database shop", mysql.ERDbCreateExists)
+	mustExec(t, e, c, "create database if not exists shop")
+	expectSQLError(t, e, c, "create database system", mysql.ERDbCreateExists)
+	mustExec(t, e, c, "use shop")
+
+	mustExec(t, e, c, "create table user (id bigint primary key, name varchar(20) not null, age int default 18, "+
+		"city varchar(20), index city_age (city, age)) partition by key (id) partitions 8")
+	expectSQLError(t, e, c, "create table user (id bigint primary key)", mysql.ERTableExists)
+	mustExec(t, e, c, "create table if not exists user (id bigint primary key)")
+	if _, err := execSQL(t, e, c, "create table t (id int primary key, a int) partition by hash (a)"); err == nil {
+		t.Fatal("partition by a column other than the primary key")
+	}
+	schema := master.dbs["shop"]["user"]
+	if !strings.Contains(schema, `"_index_city_age":{"type":"keyword"}`) || !strings.Contains(schema, `"age":{"type":"integer"}`) {
+		t.Fatalf("unexpected schema %s", schema)
+	}
+	def, err := e.catalog.definition(context.Background(), "shop", "user")
+	if err != nil || def == nil || def.Partitions != 8 || def.PartitionKey != "id" || def.PrimaryKey != "id" {
+		t.Fatalf("unexpected definition %v: %v", def, err)
+	}
+
+	// the columns are checked and converted, the missing ones take their defaults
+	mustExec(t, e, c, "insert into user (id, name) values (1, 'a')")
+	if age := backend.spaces["user"]["1"]["age"]; age != json.Number("18") {
+		t.Fatalf("unexpected default %v", age)
+	}
+	expectSQLError(t, e, c, "insert into user (id) values (3)", mysql.ERBadNullError)
+	expectSQLError(t, e, c, "insert into user (id, name, email) values (3, 'c', 'x')", mysql.ERBadFieldError)
+	expectSQLError(t, e, c, "insert into user (id, name, age) values (3, 'c', 'x')", mysql.ERTruncatedWrongValueForField)
+	mustExec(t, e, c, "insert into user (id, name, age, city) values (2, 'b', '30', 'sz')")
+	if key := backend.spaces["user"]["2"]["_index_city_age"]; key != "sz\x1f30" {
+		t.Fatalf("unexpected index field %q", key)
+	}
+
+	result := mustExec(t, e, c, "select * from user where id = 2")
+	if len(result.Fields) != 4 || result.Fields[2].Name != "age" || result.Fields[2].Type != sqltypes.Int32 {
+		t.Fatalf("unexpected fields %v", result.Fields)
+	}
+	mustExec(t, e, c, "select * from user where city = 'sz' and age = 30")
+	if data, _ := json.Marshal(backend.lastSearch.Query); !strings.Contains(string(data), `{"term":{"_index_city_age":"sz\u001f30"}}`) {
+		t.Fatalf("search without the index: %s", data)
+	}
+	mustExec(t, e, c, "update user set city = 'bj' where id = 2")
+	if key := backend.spaces["user"]["2"]["_index_city_age"]; key != "bj\x1f30" {
+		t.Fatalf("index field not updated: %q", key)
+	}
+
+	// the rows written before a compound index get its field
+	mustExec(t, e, c, "alter table user add column email varchar(64)")
+	mustExec(t, e, c, "update user set email = 'a@x' where id = 1")
+	mustExec(t, e, c, "create unique index name_email on user (name, email)")
+	if key := backend.spaces["user"]["1"]["_index_name_email"]; key != "a\x1fa@x" {
+		t.Fatalf("index not backfilled: %q", key)
+	}
+	expectSQLError(t, e, c, "create index name_email on user (name)", mysql.ERDupKeyName)
+	expectSQLError(t, e, c, "alter table user add column email text", mysql.ERDupFieldName)
+
+	result = mustExec(t, e, c, "describe user")
+	if fields := columnValues(result, 0); strings.Join(fields, ",") != "id,name,age,city,email" {
+		t.Fatalf("unexpected columns %v", fields)
+	}
+	if keys := columnValues(result, 3); strings.Join(keys, ",") != "PRI,MUL,,MUL," {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+	if types := columnValues(result, 1); types[1] != "varchar(20)" || result.Rows[2][4].ToString() != "18" {
+		t.Fatalf("unexpected types %v", types)
+	}
+	result = mustExec(t, e, c, "show index from user")
+	if names := columnValues(result, 2); strings.Join(names, ",") != "PRIMARY,city_age,city_age,name_email,name_email" {
+		t.Fatalf("unexpected indexes %v", names)
+	}
+	result = mustExec(t, e, c, "show create table user")
+	if create := result.Rows[0][1].ToString(); !strings.Contains(create, "PARTITION BY KEY (`id`) PARTITIONS 8") ||
+		!strings.Contains(create, "UNIQUE KEY `name_email` (`name`,`email`)") {
+		t.Fatalf("unexpected create table %s", create)
+	}
+
+	mustExec(t, e, c, "create table item (sku varchar(32), price double, primary key (sku))")
+	result = mustExec(t, e, c, "show tables")
+	if result.Fields[0].Name != "Tables_in_shop" || strings.Join(columnValues(result, 0), ",") != "item,user" {
+		t.Fatalf("unexpected tables %v", result)
+	}
+	result = mustExec(t, e, c, "show full tables like 'u%'")
+	if len(result.Rows) != 1 || result.Rows[0][1].ToString() != "BASE TABLE" {
+		t.Fatalf("unexpected tables %v", result)
+	}
+	result = mustExec(t, e, c, "show databases")
+	if dbs := strings.Join(columnValues(result, 0), ","); dbs != "information_schema,shop,system" {
+		t.Fatalf("unexpected databases %s", dbs)
+	}
+
+	result = mustExec(t, e, c, "select column_name, data_type, column_key from information_schema.columns "+
+		"where table_schema = 'shop' and table_name = 'item' order by ordinal_position desc")
+	if len(result.Rows) != 2 || result.Rows[0][0].ToString() != "price" || result.Rows[1][2].ToString() != "PRI" {
+		t.Fatalf("unexpected columns %v", result.Rows)
+	}
+	result = mustExec(t, e, c, "select TABLE_NAME from information_schema.TABLES where TABLE_SCHEMA = 'shop' and table_name like 'i%'")
+	if len(result.Rows) != 1 || result.Rows[0][0].ToString() != "item" {
+		t.Fatalf("unexpected tables %v", result.Rows)
+	}
+	expectSQLError(t, e, c, "insert into information_schema.tables (id) values (1)", mysql.ERDBAccessDenied)
+
+	mustExec(t, e, c, "drop index city_age on user")
+	mustExec(t, e, c, "drop table user")
+	if _, ok := master.dbs["shop"]["user"]; ok {
+		t.Fatal("space not dropped")
+	}
+	if def, _ := e.catalog.definition(context.Background(), "shop", "user"); def != nil {
+		t.Fatal("definition not dropped")
+	}
+	expectSQLError(t, e, c, "drop table user", mysql.ERBadTable)
+	mustExec(t, e, c, "drop table if exists user")
+
+	mustExec(t, e, c, "drop database shop")
+	if _, ok := master.dbs["shop"]; ok || c.SchemaName != "" {
+		t.Fatalf("database not dropped")
+	}
+	expectSQLError(t, e, c, "drop database shop", mysql.ERDbDropExists)
+}
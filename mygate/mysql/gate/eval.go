@@ -0,0 +1,223 @@
+package mysql
+
+import (
+	"math"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// The gate evaluates the expressions the engine can not: the WHERE of the views, the arguments of
+// the aggregates the partitions can not fold and the HAVING and ORDER BY of the groups. A valueOf
+// values the leaves, the columns of a row or the aggregates of a group.
+
+type valueOf func(expr sqlparser.Expr) (interface{}, error)
+
+// matchCondition evaluates the condition, the conditions of searchQuery are supported. NULL
+// matches nothing but IS NULL.
+func matchCondition(expr sqlparser.Expr, value valueOf) (bool, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, err := matchCondition(expr.Left, value)
+		if err != nil || !left {
+			return false, err
+		}
+		return matchCondition(expr.Right, value)
+	case *sqlparser.OrExpr:
+		left, err := matchCondition(expr.Left, value)
+		if err != nil || left {
+			return left, err
+		}
+		return matchCondition(expr.Right, value)
+	case *sqlparser.NotExpr:
+		ok, err := matchCondition(expr.Expr, value)
+		return !ok, err
+	case *sqlparser.ParenExpr:
+		return matchCondition(expr.Expr, value)
+	case sqlparser.BoolVal:
+		return bool(expr), nil
+	case *sqlparser.IsExpr:
+		v, err := value(expr.Expr)
+		if err != nil {
+			return false, err
+		}
+		switch expr.Operator {
+		case sqlparser.IsNullStr:
+			return v == nil, nil
+		case sqlparser.IsNotNullStr:
+			return v != nil, nil
+		}
+	case *sqlparser.RangeCond:
+		v, err := value(expr.Left)
+		if err != nil {
+			return false, err
+		}
+		from, err := value(expr.From)
+		if err != nil {
+			return false, err
+		}
+		to, err := value(expr.To)
+		if err != nil {
+			return false, err
+		}
+		in := v != nil && from != nil && to != nil && compareValues(v, from) >= 0 && compareValues(v, to) <= 0
+		return in == (expr.Operator == sqlparser.BetweenStr), nil
+	case *sqlparser.ComparisonExpr:
+		return compareCondition(expr, value)
+	}
+	return false, errNotSupported("condition %s is not supported", sqlparser.String(expr))
+}
+
+func compareCondition(cmp *sqlparser.ComparisonExpr, value valueOf) (bool, error) {
+	left, err := value(cmp.Left)
+	if err != nil {
+		return false, err
+	}
+	if cmp.Operator == sqlparser.InStr || cmp.Operator == sqlparser.NotInStr {
+		tuple, ok := cmp.Right.(sqlparser.ValTuple)
+		if !ok {
+			return false, errNotSupported("condition %s is not supported", sqlparser.String(cmp))
+		}
+		in := false
+		for _, expr := range tuple {
+			v, err := value(expr)
+			if err != nil {
+				return false, err
+			}
+			if left != nil && v != nil && compareValues(left, v) == 0 {
+				in = true
+			}
+		}
+		return left != nil && in == (cmp.Operator == sqlparser.InStr), nil
+	}
+	right, err := value(cmp.Right)
+	if err != nil {
+		return false, err
+	}
+	if left == nil || right == nil {
+		// nothing compares to NULL
+		return false, nil
+	}
+	switch cmp.Operator {
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		return likeMatch(valueText(right), valueText(left)) == (cmp.Operator == sqlparser.LikeStr), nil
+	case sqlparser.EqualStr:
+		return compareValues(left, right) == 0, nil
+	case sqlparser.NotEqualStr:
+		return compareValues(left, right) != 0, nil
+	case sqlparser.LessThanStr:
+		return compareValues(left, right) < 0, nil
+	case sqlparser.LessEqualStr:
+		return compareValues(left, right) <= 0, nil
+	case sqlparser.GreaterThanStr:
+		return compareValues(left, right) > 0, nil
+	case sqlparser.GreaterEqualStr:
+		return compareValues(left, right) >= 0, nil
+	}
+	return false, errNotSupported("condition %s is not supported", sqlparser.String(cmp))
+}
+
+// evaluate computes the arithmetic of the leaves: +, -, *, /, DIV and % of numbers, NULL if an
+// operand is NULL or not a number or on a division by zero. A condition is 1 or 0.
+func evaluate(expr sqlparser.Expr, value valueOf) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.ParenExpr:
+		return evaluate(expr.Expr, value)
+	case *sqlparser.UnaryExpr:
+		if expr.Operator == sqlparser.UMinusStr {
+			v, err := evaluate(expr.Expr, value)
+			if err != nil {
+				return nil, err
+			}
+			switch v := v.(type) {
+			case int64:
+				return -v, nil
+			case nil:
+				return nil, nil
+			}
+			if n, ok := number(v); ok {
+				return -n, nil
+			}
+			return nil, nil
+		}
+	case *sqlparser.BinaryExpr:
+		left, err := evaluate(expr.Left, value)
+		if err != nil {
+			return nil, err
+		}
+		right, err := evaluate(expr.Right, value)
+		if err != nil {
+			return nil, err
+		}
+		return arithmetic(expr, left, right)
+	case *sqlparser.AndExpr, *sqlparser.OrExpr, *sqlparser.NotExpr, *sqlparser.IsExpr, *sqlparser.RangeCond,
+		*sqlparser.ComparisonExpr:
+		ok, err := matchCondition(expr, func(expr sqlparser.Expr) (interface{}, error) {
+			return evaluate(expr, value)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	}
+	return value(expr)
+}
+
+func arithmetic(expr *sqlparser.BinaryExpr, left, right interface{}) (interface{}, error) {
+	a, aok := number(left)
+	b, bok := number(right)
+	if !aok || !bok {
+		return nil, nil
+	}
+	x, xint := left.(int64)
+	y, yint := right.(int64)
+	integers := xint && yint
+	switch expr.Operator {
+	case sqlparser.PlusStr:
+		if integers {
+			return x + y, nil
+		}
+		return a + b, nil
+	case sqlparser.MinusStr:
+		if integers {
+			return x - y, nil
+		}
+		return a - b, nil
+	case sqlparser.MultStr:
+		if integers {
+			return x * y, nil
+		}
+		return a * b, nil
+	case sqlparser.DivStr:
+		if b == 0 {
+			return nil, nil
+		}
+		return a / b, nil
+	case sqlparser.IntDivStr:
+		if b == 0 {
+			return nil, nil
+		}
+		return int64(math.Trunc(a / b)), nil
+	case sqlparser.ModStr:
+		if b == 0 {
+			return nil, nil
+		}
+		if integers {
+			return x % y, nil
+		}
+		return math.Mod(a, b), nil
+	}
+	return nil, errNotSupported("operator %s is not supported", expr.Operator)
+}
+
+// rowValue values the columns of a row and the literals.
+func rowValue(row map[string]interface{}) valueOf {
+	return func(expr sqlparser.Expr) (interface{}, error) {
+		if column, ok := columnName(expr); ok {
+			return row[column], nil
+		}
+		return literal(expr)
+	}
+}
@@ -0,0 +1,639 @@
+package mysql
+
+import (
+	"flag"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var mysqlMaxRows = flag.Int("mysql_max_rows", 10000, "The most rows a statement without LIMIT reads or changes.")
+
+// the cause of a failed precondition reported by the partitions, see ps/storage
+const preconditionFailed = "precondition failed"
+
+// maxUpdateAttempts bounds the reads and writes of a row changed by others during an UPDATE
+const maxUpdateAttempts = 3
+
+var errNoDB = mysql.NewSQLError(mysql.ERNoDb, "3D000", "No database selected")
+
+// executor runs the statements of the connections on the backend, a table is a space of the db
+// and a row is an object of the space.
+type executor struct {
+	backend Backend
+	master  Master
+	catalog *catalog
+	maxRows int
+	// the bounds of the groups of an aggregate query
+	maxGroups    int
+	memoryBudget int
+	users        *userStore
+	// checkGrants tells whether the statements are checked against the grants of the user of
+	// the connection
+	checkGrants bool
+}
+
+func newExecutor(backend Backend, master Master, maxRows int) *executor {
+	return &executor{
+		backend:      backend,
+		master:       master,
+		catalog:      newCatalog(backend, master, *mysqlCatalogTTL),
+		maxRows:      maxRows,
+		maxGroups:    *mysqlMaxGroups,
+		memoryBudget: *mysqlMemoryBudget,
+		users:        newUserStore(backend, master, *mysqlUserTTL, *mysqlRootPassword),
+	}
+}
+
+// run executes a statement of the query, the statements the gate matches itself first. The
+// privileges of the statement are checked before it runs.
+func (e *executor) run(ctx context.Context, c *mysql.Conn, sql string) (*sqltypes.Result, error) {
+	stmt, ok, err := parseGateStatement(sql)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		statement, err := sqlparser.ParseStrictDDL(sql)
+		if err != nil {
+			return nil, err
+		}
+		return e.runStatement(ctx, c, statement)
+	}
+	if err := e.authorize(ctx, c, stmt); err != nil {
+		return nil, err
+	}
+
+	switch stmt := stmt.(type) {
+	case *createDatabase:
+		return e.execCreateDatabase(ctx, stmt)
+	case *dropDatabase:
+		return e.execDropDatabase(ctx, c, stmt)
+	case *createTable:
+		return e.execCreateTable(ctx, c.SchemaName, stmt)
+	case *alterTable:
+		return e.execAlterTable(ctx, c.SchemaName, stmt)
+	case *dropIndex:
+		return e.execDropIndex(ctx, c.SchemaName, stmt)
+	case *showColumns:
+		return e.execShowColumns(ctx, c.SchemaName, stmt)
+	case *showIndex:
+		return e.execShowIndex(ctx, c.SchemaName, stmt)
+	case *showCreateTable:
+		return e.execShowCreateTable(ctx, c.SchemaName, stmt)
+	case *explainSelect:
+		return e.execExplain(ctx, c.SchemaName, stmt.sel)
+	case *createUser:
+		return e.execCreateUser(ctx, stmt)
+	case *dropUser:
+		return e.execDropUser(ctx, stmt)
+	case *grantPrivileges:
+		return e.execGrant(ctx, c.SchemaName, stmt)
+	case *showGrants:
+		return e.execShowGrants(ctx, c, stmt)
+	case *prepareStatement:
+		return e.execPrepare(ctx, c, stmt)
+	case *executeStatement:
+		return e.execExecute(ctx, c, stmt)
+	case *deallocateStatement:
+		return e.execDeallocate(ctx, c, stmt)
+	}
+	return nil, errNotSupported("statement %s is not supported", sql)
+}
+
+// runStatement runs the parsed statement, as the user of the connection.
+func (e *executor) runStatement(ctx context.Context, c *mysql.Conn, stmt sqlparser.Statement) (*sqltypes.Result, error) {
+	if err := e.authorize(ctx, c, stmt); err != nil {
+		return nil, err
+	}
+	return e.execute(ctx, c, stmt)
+}
+
+func (e *executor) execute(ctx context.Context, c *mysql.Conn, stmt sqlparser.Statement) (*sqltypes.Result, error) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return e.execSelect(ctx, c.SchemaName, stmt)
+	case *sqlparser.Insert:
+		return e.execInsert(ctx, c.SchemaName, stmt)
+	case *sqlparser.Update:
+		return e.execUpdate(ctx, c.SchemaName, stmt)
+	case *sqlparser.Delete:
+		return e.execDelete(ctx, c.SchemaName, stmt)
+	case *sqlparser.DDL:
+		if stmt.Action == sqlparser.DropStr {
+			return e.execDropTable(ctx, c.SchemaName, stmt)
+		}
+	case *sqlparser.Show:
+		return e.execShow(ctx, c.SchemaName, stmt)
+	case *sqlparser.Use:
+		c.SchemaName = stmt.DBName.String()
+		return &sqltypes.Result{}, nil
+	case *sqlparser.Set:
+		if err := setVariables(c, stmt); err != nil {
+			return nil, err
+		}
+		return &sqltypes.Result{}, nil
+	}
+	return nil, errNotSupported("statement %s is not supported", sqlparser.String(stmt))
+}
+
+// tableOf returns the table of a single table statement.
+func (e *executor) tableOf(ctx context.Context, db string, exprs sqlparser.TableExprs) (*table, error) {
+	if len(exprs) != 1 {
+		return nil, errNotSupported("statements on more than one table are not supported")
+	}
+	aliased, ok := exprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, errNotSupported("table %s is not supported", sqlparser.String(exprs[0]))
+	}
+	name, ok := aliased.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil, errNotSupported("table %s is not supported", sqlparser.String(aliased.Expr))
+	}
+	return e.tableOfName(ctx, db, name)
+}
+
+func (e *executor) tableOfName(ctx context.Context, db string, name sqlparser.TableName) (*table, error) {
+	db, err := qualifiedDB(db, name)
+	if err != nil {
+		return nil, err
+	}
+	return e.catalog.table(ctx, db, name.Name.String())
+}
+
+func (e *executor) execSelect(ctx context.Context, db string, sel *sqlparser.Select) (*sqltypes.Result, error) {
+	if selectsDual(sel.From) {
+		return selectDual(db, sel)
+	}
+	if name, ok := informationSchemaTable(db, sel.From); ok {
+		v, err := e.informationSchemaView(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return selectView(v, sel)
+	}
+	t, err := e.tableOf(ctx, db, sel.From)
+	if err != nil {
+		return nil, err
+	}
+	if isAggregate(sel) {
+		return e.execAggregate(ctx, t, sel)
+	}
+
+	var (
+		columns []resultColumn
+		fields  []string
+		star    bool
+		scored  []*sqlparser.MatchExpr
+		aliases = make(map[string]*sqlparser.MatchExpr)
+	)
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			star = true
+		case *sqlparser.AliasedExpr:
+			if m, ok := expr.Expr.(*sqlparser.MatchExpr); ok {
+				// the relevance of the row
+				name := sqlparser.String(m)
+				if !expr.As.IsEmpty() {
+					name = expr.As.String()
+					aliases[strings.ToLower(name)] = m
+				}
+				columns = append(columns, resultColumn{name: name, column: scoreField})
+				fields = append(fields, scoreField)
+				scored = append(scored, m)
+				continue
+			}
+			column, ok := columnName(expr.Expr)
+			if !ok {
+				return nil, errNotSupported("select expression %s is not supported", sqlparser.String(expr))
+			}
+			name := column
+			if !expr.As.IsEmpty() {
+				name = expr.As.String()
+			}
+			columns = append(columns, resultColumn{name: name, column: column})
+			fields = append(fields, column)
+		default:
+			return nil, errNotSupported("select expression %s is not supported", sqlparser.String(expr))
+		}
+	}
+	if star {
+		if len(columns) > 0 {
+			return nil, errNotSupported("* mixed with columns is not supported")
+		}
+		fields = []string{"*"}
+	}
+
+	orderBy := make(sqlparser.OrderBy, len(sel.OrderBy))
+	for i, order := range sel.OrderBy {
+		orderBy[i] = order
+		if column, ok := columnName(order.Expr); ok && aliases[strings.ToLower(column)] != nil {
+			orderBy[i] = &sqlparser.Order{Expr: aliases[strings.ToLower(column)], Direction: order.Direction}
+		}
+		scored = append(scored, matchExprs(orderBy[i].Expr)...)
+	}
+
+	rows, err := e.rows(ctx, t, scoredWhere(sel.Where, scored), orderBy, sel.Limit, fields)
+	if err != nil {
+		return nil, err
+	}
+	if star {
+		columns = starColumns(t, rows)
+	}
+	return rowsResult(t, columns, rows), nil
+}
+
+// rows reads the rows of the table matching the WHERE, by id if it binds the primary key or by a
+// search of all the partitions. The search reads the snapshot of the scroll of the context, if
+// any, the first search opens it.
+func (e *executor) rows(ctx context.Context, t *table, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, fields []string) ([]map[string]interface{}, error) {
+	offset, count, err := limitWindow(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if ids, ok := pointKeys(where, t.primaryKey); ok && len(orderBy) == 0 {
+		var rows []map[string]interface{}
+		for _, id := range ids {
+			obj, err := e.backend.Get(ctx, t.db, t.space, id)
+			if err != nil {
+				return nil, err
+			}
+			if obj != nil && t.owns(obj) {
+				rows = append(rows, t.row(id, obj))
+			}
+		}
+		if offset >= len(rows) {
+			return nil, nil
+		}
+		rows = rows[offset:]
+		if count >= 0 && count < len(rows) {
+			rows = rows[:count]
+		}
+		return rows, nil
+	}
+
+	query, err := searchQuery(t, where)
+	if err != nil {
+		return nil, err
+	}
+	sort, err := sortFields(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	size := count
+	if size < 0 || size > e.maxRows {
+		size = e.maxRows
+	}
+	// the relevance is the score of the hit, not a field
+	var score bool
+	var searchFields []string
+	for _, field := range fields {
+		if field == scoreField {
+			score = true
+		} else {
+			searchFields = append(searchFields, field)
+		}
+	}
+	request := &SearchRequest{
+		Query:  query,
+		From:   offset,
+		Size:   size,
+		Sort:   sort,
+		Fields: searchFields,
+	}
+	scroll := scrollOf(ctx)
+	if scroll != nil {
+		request.Scroll, request.ScrollID = mysqlCursorKeepAlive.String(), scroll.id
+	}
+	result, err := e.backend.Search(ctx, t.db, t.space, request)
+	if err != nil {
+		return nil, err
+	}
+	if scroll != nil {
+		scroll.db, scroll.space, scroll.id = t.db, t.space, result.ScrollID
+	}
+	if result.Total > uint64(offset+size) && (count < 0 || count > size) {
+		return nil, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState,
+			"the statement matches more than %d rows, add a LIMIT", e.maxRows)
+	}
+	rows := make([]map[string]interface{}, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		row := t.row(hit.ID, hit.Source)
+		if score {
+			row[scoreField] = hit.Score
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// closeScroll closes the snapshots of the scroll, a search of the scroll without keep alive is its
+// last one.
+func (e *executor) closeScroll(ctx context.Context, s *scroll) error {
+	_, err := e.backend.Search(ctx, s.db, s.space, &SearchRequest{Query: matchAllQuery(), ScrollID: s.id})
+	s.id = ""
+	return err
+}
+
+// informationSchemaTable returns the name of the table of information_schema the FROM reads.
+func informationSchemaTable(db string, exprs sqlparser.TableExprs) (string, bool) {
+	if len(exprs) != 1 {
+		return "", false
+	}
+	aliased, ok := exprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return "", false
+	}
+	name, ok := aliased.Expr.(sqlparser.TableName)
+	if !ok {
+		return "", false
+	}
+	if !name.Qualifier.IsEmpty() {
+		db = name.Qualifier.String()
+	}
+	return name.Name.String(), strings.EqualFold(db, informationSchema)
+}
+
+// selectsDual tells whether the FROM is dual.
+func selectsDual(exprs sqlparser.TableExprs) bool {
+	if len(exprs) != 1 {
+		return false
+	}
+	if aliased, ok := exprs[0].(*sqlparser.AliasedTableExpr); ok {
+		if name, ok := aliased.Expr.(sqlparser.TableName); ok && name.Name.String() == "dual" {
+			return true
+		}
+	}
+	return false
+}
+
+// selectDual answers the SELECT without table the drivers send, of literals, DATABASE() and
+// the system variables.
+func selectDual(db string, sel *sqlparser.Select) (*sqltypes.Result, error) {
+	t := &table{db: db, name: ""}
+	row := make(map[string]interface{})
+	var columns []resultColumn
+	for i, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errNotSupported("select expression %s is not supported", sqlparser.String(expr))
+		}
+		name := sqlparser.String(aliased.Expr)
+		if !aliased.As.IsEmpty() {
+			name = aliased.As.String()
+		}
+		column := strconv.Itoa(i)
+		columns = append(columns, resultColumn{name: name, column: column})
+
+		switch value := aliased.Expr.(type) {
+		case *sqlparser.FuncExpr:
+			if !value.Name.EqualString("database") || len(value.Exprs) > 0 {
+				return nil, errNotSupported("function %s is not supported", sqlparser.String(value))
+			}
+			if db != "" {
+				row[column] = db
+			}
+		case *sqlparser.ColName:
+			// @@version_comment and the like
+			if !strings.HasPrefix(value.Name.String(), "@@") {
+				return nil, errNotSupported("select expression %s is not supported", sqlparser.String(value))
+			}
+			if strings.HasPrefix(value.Name.Lowered(), "@@version") {
+				row[column] = *mysqlServerVersion
+			}
+		default:
+			v, err := literal(value)
+			if err != nil {
+				return nil, err
+			}
+			row[column] = v
+		}
+	}
+	return rowsResult(t, columns, []map[string]interface{}{row}), nil
+}
+
+func (e *executor) execInsert(ctx context.Context, db string, ins *sqlparser.Insert) (*sqltypes.Result, error) {
+	if len(ins.OnDup) > 0 {
+		return nil, errNotSupported("ON DUPLICATE KEY UPDATE is not supported")
+	}
+	t, err := e.tableOfName(ctx, db, ins.Table)
+	if err != nil {
+		return nil, err
+	}
+	if reservedDB(t.db) {
+		return nil, errReservedDB(t.db)
+	}
+	if len(ins.Columns) == 0 {
+		return nil, errNotSupported("INSERT without the column list is not supported")
+	}
+	values, ok := ins.Rows.(sqlparser.Values)
+	if !ok {
+		return nil, errNotSupported("INSERT ... SELECT is not supported")
+	}
+
+	// REPLACE overwrites the row of the key, INSERT creates it only if there is none
+	replace := ins.Action == sqlparser.ReplaceStr
+	writes := make([]Write, 0, len(values))
+	for _, tuple := range values {
+		if len(tuple) != len(ins.Columns) {
+			return nil, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "Column count doesn't match value count")
+		}
+		row := make(map[string]interface{}, len(tuple))
+		for i, expr := range tuple {
+			v, err := literal(expr)
+			if err != nil {
+				return nil, err
+			}
+			row[ins.Columns[i].String()] = v
+		}
+		if row, err = t.insertRow(row); err != nil {
+			return nil, err
+		}
+		id, err := keyString(row[t.primaryKey])
+		if err != nil {
+			return nil, err
+		}
+		write := Write{Op: "create", ID: id, Doc: t.object(row)}
+		if !replace {
+			write.Precondition = &Precondition{NotExists: true}
+		}
+		writes = append(writes, write)
+	}
+
+	results, err := e.backend.Bulk(ctx, t.db, t.space, writes)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	for _, r := range results {
+		switch {
+		case r.Error == preconditionFailed:
+			if ins.Ignore == "" {
+				return nil, mysql.NewSQLError(mysql.ERDupEntry, mysql.SSDupKey, "Duplicate entry '%s' for key 'PRIMARY'", r.ID)
+			}
+		case r.Error != "":
+			return nil, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "insert of row '%s' failed: %s", r.ID, r.Error)
+		case r.Result == writeCreated || r.Result == writeUpdated:
+			result.RowsAffected++
+		}
+	}
+	return result, nil
+}
+
+func (e *executor) execUpdate(ctx context.Context, db string, upd *sqlparser.Update) (*sqltypes.Result, error) {
+	t, err := e.tableOf(ctx, db, upd.TableExprs)
+	if err != nil {
+		return nil, err
+	}
+	if reservedDB(t.db) {
+		return nil, errReservedDB(t.db)
+	}
+	doc := make(map[string]interface{}, len(upd.Exprs))
+	for _, expr := range upd.Exprs {
+		column := expr.Name.Name.String()
+		if column == t.primaryKey {
+			return nil, errNotSupported("update of the primary key is not supported")
+		}
+		v, err := literal(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		doc[column] = v
+	}
+	if doc, err = t.updateRow(doc); err != nil {
+		return nil, err
+	}
+
+	ids, err := e.matchKeys(ctx, t, upd.Where, upd.OrderBy, upd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return e.updateRows(ctx, t, ids, doc)
+}
+
+// updateRows sets the columns of the rows. The partition replaces the whole object on an update,
+// so every object is read, the columns set on it and written back on the condition that it is
+// still the one read. The rows changed in between are read and written again.
+func (e *executor) updateRows(ctx context.Context, t *table, ids []string, doc map[string]interface{}) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for attempt := 0; len(ids) > 0; attempt++ {
+		if attempt == maxUpdateAttempts {
+			return nil, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState,
+				"row '%s' kept changing during the update, try again", ids[0])
+		}
+		writes := make([]Write, 0, len(ids))
+		for _, id := range ids {
+			obj, err := e.backend.Get(ctx, t.db, t.space, id)
+			if err != nil {
+				return nil, err
+			}
+			if obj == nil || !t.owns(obj) {
+				continue
+			}
+			match := make(map[string]interface{}, len(obj))
+			for field, v := range obj {
+				match[field] = v
+			}
+			row := t.row(id, obj)
+			for column, v := range doc {
+				row[column] = v
+			}
+			// the fields of the compound indexes are computed again from the columns
+			for _, idx := range t.compoundIndexes() {
+				delete(row, idx.field())
+			}
+			writes = append(writes, Write{Op: "update", ID: id, Doc: t.object(row), Precondition: &Precondition{Match: match}})
+		}
+		if len(writes) == 0 {
+			break
+		}
+
+		results, err := e.backend.Bulk(ctx, t.db, t.space, writes)
+		if err != nil {
+			return nil, err
+		}
+		ids = ids[:0]
+		for _, r := range results {
+			switch {
+			case r.Error == preconditionFailed:
+				ids = append(ids, r.ID)
+			case r.Error != "":
+				return nil, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "write of row '%s' failed: %s", r.ID, r.Error)
+			case r.Result == writeUpdated:
+				result.RowsAffected++
+			}
+		}
+	}
+	return result, nil
+}
+
+func (e *executor) execDelete(ctx context.Context, db string, del *sqlparser.Delete) (*sqltypes.Result, error) {
+	if len(del.Targets) > 0 {
+		return nil, errNotSupported("DELETE of more than one table is not supported")
+	}
+	t, err := e.tableOf(ctx, db, del.TableExprs)
+	if err != nil {
+		return nil, err
+	}
+	if reservedDB(t.db) {
+		return nil, errReservedDB(t.db)
+	}
+
+	ids, err := e.matchKeys(ctx, t, del.Where, del.OrderBy, del.Limit)
+	if err != nil {
+		return nil, err
+	}
+	writes := make([]Write, len(ids))
+	for i, id := range ids {
+		writes[i] = Write{Op: "delete", ID: id, Precondition: t.precondition()}
+	}
+	return e.change(ctx, t, writes, writeDeleted)
+}
+
+// matchKeys returns the ids of the rows the WHERE matches, the ids bound by it are taken as they
+// are, the writes to them are no-ops if they do not exist.
+func (e *executor) matchKeys(ctx context.Context, t *table, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit) ([]string, error) {
+	if ids, ok := pointKeys(where, t.primaryKey); ok && len(orderBy) == 0 && limit == nil {
+		return ids, nil
+	}
+	rows, err := e.rows(ctx, t, where, orderBy, limit, []string{t.primaryKey})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		if ids[i], err = keyString(row[t.primaryKey]); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// change writes the rows, the rows affected are the ones whose write had the result.
+func (e *executor) change(ctx context.Context, t *table, writes []Write, affected string) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	if len(writes) == 0 {
+		return result, nil
+	}
+	results, err := e.backend.Bulk(ctx, t.db, t.space, writes)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		switch {
+		case r.Error == preconditionFailed:
+			// a row of another table of the space
+		case r.Error != "":
+			return nil, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "write of row '%s' failed: %s", r.ID, r.Error)
+		case r.Result == affected:
+			result.RowsAffected++
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,410 @@
+package mysql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// memBackend keeps the objects in memory, a search returns all the objects of the space by id or
+// their buckets. An update replaces the whole object, as the partitions do.
+type memBackend struct {
+	spaces     map[string]map[string]map[string]interface{}
+	lastSearch *SearchRequest
+	// beforeBulk runs before the writes of a bulk are applied, as a writer racing the bulk
+	beforeBulk func()
+	// scrolls are the copies of the spaces the scrolls were opened on
+	scrolls   map[string]map[string]map[string]interface{}
+	scrollSeq int
+}
+
+func newMemBackend() *memBackend {
+	return &memBackend{
+		spaces:  make(map[string]map[string]map[string]interface{}),
+		scrolls: make(map[string]map[string]map[string]interface{}),
+	}
+}
+
+// copyObject copies the object through json, as the router would send it
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	data, _ := json.Marshal(obj)
+	var out map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	decoder.Decode(&out)
+	return out
+}
+
+func (b *memBackend) Get(ctx context.Context, db, space, id string) (map[string]interface{}, error) {
+	if obj, ok := b.spaces[space][id]; ok {
+		return copyObject(obj), nil
+	}
+	return nil, nil
+}
+
+// matches evaluates the precondition of a write against the object, nil if it does not exist.
+func matches(cond *Precondition, obj map[string]interface{}) bool {
+	if cond == nil {
+		return true
+	}
+	if cond.NotExists {
+		return obj == nil
+	}
+	if len(cond.Match) > 0 && obj == nil {
+		return false
+	}
+	match := copyObject(cond.Match)
+	for field, value := range match {
+		if v, ok := obj[field]; !ok || !reflect.DeepEqual(v, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *memBackend) Bulk(ctx context.Context, db, space string, writes []Write) ([]WriteResult, error) {
+	if b.beforeBulk != nil {
+		b.beforeBulk()
+	}
+	objs := b.spaces[space]
+	if objs == nil {
+		objs = make(map[string]map[string]interface{})
+		b.spaces[space] = objs
+	}
+	results := make([]WriteResult, len(writes))
+	for i, w := range writes {
+		results[i].ID = w.ID
+		_, exists := objs[w.ID]
+		if !matches(w.Precondition, objs[w.ID]) {
+			results[i].Error = preconditionFailed
+			continue
+		}
+		switch w.Op {
+		case "create":
+			objs[w.ID] = copyObject(w.Doc)
+			results[i].Result = writeCreated
+		case "update":
+			if !exists {
+				results[i].Result = "NOT_FOUND"
+				continue
+			}
+			objs[w.ID] = copyObject(w.Doc)
+			results[i].Result = writeUpdated
+		case "delete":
+			if !exists {
+				results[i].Result = "NOT_FOUND"
+				continue
+			}
+			delete(objs, w.ID)
+			results[i].Result = writeDeleted
+		}
+	}
+	return results, nil
+}
+
+func (b *memBackend) Search(ctx context.Context, db, space string, request *SearchRequest) (*SearchResult, error) {
+	b.lastSearch = request
+	objs := b.spaces[space]
+	if request.ScrollID != "" {
+		var ok bool
+		if objs, ok = b.scrolls[request.ScrollID]; !ok {
+			return nil, errors.New("scroll not found")
+		}
+		delete(b.scrolls, request.ScrollID)
+	}
+	var ids []string
+	for id := range objs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	result := &SearchResult{Total: uint64(len(ids))}
+	if agg := request.Aggregation; agg != nil {
+		// every object is a bucket of its own, as if of a partition of its own
+		for _, id := range ids {
+			obj := copyObject(b.spaces[space][id])
+			var bucket Bucket
+			for _, field := range agg.GroupBy {
+				bucket.Key = append(bucket.Key, obj[field])
+			}
+			for _, m := range agg.Metrics {
+				v := obj[m.Field]
+				if m.Func == "count" {
+					if m.Field == "" || v != nil {
+						v = int64(1)
+					} else {
+						v = int64(0)
+					}
+				}
+				bucket.Values = append(bucket.Values, v)
+			}
+			result.Buckets = append(result.Buckets, bucket)
+		}
+		return result, nil
+	}
+	for i := request.From; i < len(ids) && i < request.From+request.Size; i++ {
+		result.Hits = append(result.Hits, SearchHit{ID: ids[i], Source: copyObject(objs[ids[i]])})
+	}
+	if request.Scroll != "" {
+		// the scroll keeps the objects as they are at its first page
+		if request.ScrollID == "" {
+			snapshot := make(map[string]map[string]interface{}, len(objs))
+			for id, obj := range objs {
+				snapshot[id] = copyObject(obj)
+			}
+			objs = snapshot
+			b.scrollSeq++
+			request.ScrollID = fmt.Sprintf("scroll%d", b.scrollSeq)
+		}
+		b.scrolls[request.ScrollID] = objs
+		result.ScrollID = request.ScrollID
+	}
+	return result, nil
+}
+
+// memMaster keeps the dbs and the schemas of their spaces in memory, the objects of a dropped space
+// are dropped from the backend.
+type memMaster struct {
+	backend *memBackend
+	dbs     map[string]map[string]string
+}
+
+func newMemMaster(backend *memBackend) *memMaster {
+	return &memMaster{backend: backend, dbs: make(map[string]map[string]string)}
+}
+
+func (m *memMaster) DBs(ctx context.Context) ([]string, error) {
+	var names []string
+	for name := range m.dbs {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (m *memMaster) CreateDB(ctx context.Context, db string) error {
+	if _, ok := m.dbs[db]; ok {
+		return errDupDB
+	}
+	m.dbs[db] = make(map[string]string)
+	return nil
+}
+
+func (m *memMaster) DropDB(ctx context.Context, db string) error {
+	if _, ok := m.dbs[db]; !ok {
+		return errDBNotExists
+	}
+	delete(m.dbs, db)
+	return nil
+}
+
+func (m *memMaster) Spaces(ctx context.Context, db string) ([]string, error) {
+	spaces, ok := m.dbs[db]
+	if !ok {
+		return nil, errDBNotExists
+	}
+	var names []string
+	for name := range spaces {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (m *memMaster) CreateSpace(ctx context.Context, db, space, schema string, policy *PartitionPolicy) error {
+	spaces, ok := m.dbs[db]
+	if !ok {
+		return errDBNotExists
+	}
+	if _, ok := spaces[space]; ok {
+		return errDupSpace
+	}
+	spaces[space] = schema
+	return nil
+}
+
+func (m *memMaster) DropSpace(ctx context.Context, db, space string) error {
+	spaces, ok := m.dbs[db]
+	if !ok {
+		return errDBNotExists
+	}
+	if _, ok := spaces[space]; !ok {
+		return errSpaceNotExists
+	}
+	delete(spaces, space)
+	delete(m.backend.spaces, space)
+	return nil
+}
+
+func (m *memMaster) UpdateSchema(ctx context.Context, db, space, schema string) error {
+	spaces, ok := m.dbs[db]
+	if !ok {
+		return errDBNotExists
+	}
+	if _, ok := spaces[space]; !ok {
+		return errSpaceNotExists
+	}
+	spaces[space] = schema
+	return nil
+}
+
+func execSQL(t *testing.T, e *executor, c *mysql.Conn, sql string) (*sqltypes.Result, error) {
+	return e.run(context.Background(), c, sql)
+}
+
+func mustExec(t *testing.T, e *executor, c *mysql.Conn, sql string) *sqltypes.Result {
+	result, err := execSQL(t, e, c, sql)
+	if err != nil {
+		t.Fatalf("%s: %v", sql, err)
+	}
+	return result
+}
+
+func TestExecuteDML(t *testing.T) {
+	backend := newMemBackend()
+	e := newExecutor(backend, newMemMaster(backend), 100)
+	c := &mysql.Conn{}
+
+	if _, err := execSQL(t, e, c, "select * from user"); err == nil {
+		t.Fatal("select without a database")
+	}
+	mustExec(t, e, c, "use test")
+
+	result := mustExec(t, e, c, "insert into user (id, name, age) values (1, 'a', 20), (2, 'b', 30.5)")
+	if result.RowsAffected != 2 {
+		t.Fatalf("insert affected %d rows", result.RowsAffected)
+	}
+	if _, err := execSQL(t, e, c, "insert into user (id, name) values (2, 'c')"); err == nil {
+		t.Fatal("insert of a duplicate key")
+	} else if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != mysql.ERDupEntry {
+		t.Fatalf("unexpected error of a duplicate key: %v", err)
+	}
+	if result := mustExec(t, e, c, "insert ignore into user (id, name) values (2, 'c')"); result.RowsAffected != 0 {
+		t.Fatalf("insert ignore affected %d rows", result.RowsAffected)
+	}
+
+	// a point lookup by the primary key
+	result = mustExec(t, e, c, "select name, age as years from user where id = 1")
+	if len(result.Rows) != 1 || len(result.Fields) != 2 {
+		t.Fatalf("unexpected result %v", result)
+	}
+	if result.Fields[1].Name != "years" || result.Fields[1].Type != sqltypes.Int64 || result.Rows[0][1].ToString() != "20" {
+		t.Fatalf("unexpected column %v = %v", result.Fields[1], result.Rows[0][1])
+	}
+	if backend.lastSearch != nil {
+		t.Fatal("point lookup searched")
+	}
+
+	// a search, the integers mixed with floats are floats
+	result = mustExec(t, e, c, "select * from user where age > 10 order by age desc limit 10")
+	if len(result.Rows) != 2 || result.Fields[0].Name != "id" {
+		t.Fatalf("unexpected result %v", result)
+	}
+	for _, field := range result.Fields {
+		if field.Name == tableField {
+			t.Fatal("table field selected")
+		}
+		if field.Name == "age" && field.Type != sqltypes.Float64 {
+			t.Fatalf("unexpected type of age: %v", field.Type)
+		}
+	}
+	if search := backend.lastSearch; search.Size != 10 || len(search.Sort) != 1 || search.Sort[0] != "-age" {
+		t.Fatalf("unexpected search %v", search)
+	}
+
+	if result := mustExec(t, e, c, "update user set name = 'x' where id in (1, 3)"); result.RowsAffected != 1 {
+		t.Fatalf("update affected %d rows", result.RowsAffected)
+	}
+	// the update is written as the whole object, the other columns are kept
+	if obj := backend.spaces["user"]["1"]; obj["name"] != "x" || obj["age"] != json.Number("20") || obj[tableField] != "user" {
+		t.Fatalf("row not updated: %v", obj)
+	}
+	// a row changed between the read and the write is read again
+	raced := false
+	backend.beforeBulk = func() {
+		if !raced {
+			raced = true
+			backend.spaces["user"]["1"]["age"] = json.Number("21")
+		}
+	}
+	if result := mustExec(t, e, c, "update user set name = 'y' where id = 1"); result.RowsAffected != 1 {
+		t.Fatalf("update affected %d rows", result.RowsAffected)
+	}
+	if obj := backend.spaces["user"]["1"]; obj["name"] != "y" || obj["age"] != json.Number("21") {
+		t.Fatalf("the racing write is lost: %v", obj)
+	}
+	backend.beforeBulk = nil
+	if _, err := execSQL(t, e, c, "update user set id = 3 where id = 1"); err == nil {
+		t.Fatal("update of the primary key")
+	}
+	if result := mustExec(t, e, c, "delete from user where name = 'b'"); result.RowsAffected != 2 {
+		// the memory backend ignores the query
+		t.Fatalf("delete affected %d rows", result.RowsAffected)
+	}
+	if len(backend.spaces["user"]) != 0 {
+		t.Fatalf("rows not deleted: %v", backend.spaces["user"])
+	}
+}
+
+func TestMaxRows(t *testing.T) {
+	backend := newMemBackend()
+	e := newExecutor(backend, newMemMaster(backend), 2)
+	c := &mysql.Conn{SchemaName: "test"}
+
+	mustExec(t, e, c, "insert into user (id) values ('a'), ('b'), ('c')")
+	if _, err := execSQL(t, e, c, "select * from user"); err == nil {
+		t.Fatal("select of more rows than the max")
+	}
+	if result := mustExec(t, e, c, "select id from user limit 1, 2"); len(result.Rows) != 2 || result.Rows[0][0].ToString() != "b" {
+		t.Fatalf("unexpected result %v", result)
+	}
+}
+
+func TestSearchQuery(t *testing.T) {
+	tests := []struct {
+		where string
+		query string
+	}{
+		{"name = 'a'", `{"term":{"name":"a"}}`},
+		{"age = 3", `{"range":{"age":{"gte":3,"lte":3}}}`},
+		{"3 < age", `{"range":{"age":{"gt":3}}}`},
+		{"age between 1 and 2", `{"range":{"age":{"gte":1,"lte":2}}}`},
+		{"name like 'ab%'", `{"prefix":{"name":"ab"}}`},
+		{"name like 'a_b%'", `{"wildcard":{"name":"a?b*"}}`},
+		{"name != 'a'", `{"bool":{"must_not":[{"term":{"name":"a"}}]}}`},
+		{"a = 'x' and b = 'y' and c = 'z'", `{"bool":{"must":[{"term":{"a":"x"}},{"term":{"b":"y"}},{"term":{"c":"z"}}]}}`},
+		{"a = 'x' or (b = 'y' and c = 'z')", `{"bool":{"minimum_should_match":1,"should":[{"term":{"a":"x"}},{"bool":{"must":[{"term":{"b":"y"}},{"term":{"c":"z"}}]}}]}}`},
+		{"name in ('a', 'b')", `{"bool":{"minimum_should_match":1,"should":[{"term":{"name":"a"}},{"term":{"name":"b"}}]}}`},
+	}
+	user := &table{db: "test", name: "user", space: "user", primaryKey: "id"}
+	for _, test := range tests {
+		stmt, err := sqlparser.Parse("select * from user where " + test.where)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q, err := searchQuery(user, stmt.(*sqlparser.Select).Where)
+		if err != nil {
+			t.Fatalf("%s: %v", test.where, err)
+		}
+		data, _ := json.Marshal(q)
+		if string(data) != test.query {
+			t.Errorf("%s: query %s, expect %s", test.where, data, test.query)
+		}
+	}
+
+	stmt, _ := sqlparser.Parse("select * from user where id in (1, '2', 1)")
+	if ids, ok := pointKeys(stmt.(*sqlparser.Select).Where, "id"); !ok || len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+		t.Fatalf("unexpected point keys %v", ids)
+	}
+	stmt, _ = sqlparser.Parse("select * from user where id = 1 and name = 'a'")
+	if _, ok := pointKeys(stmt.(*sqlparser.Select).Where, "id"); ok {
+		t.Fatal("point lookup with another condition")
+	}
+}
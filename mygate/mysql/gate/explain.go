@@ -0,0 +1,131 @@
+package mysql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// the stages of a plan, in the order the rows pass them
+const (
+	stagePartition = "partition"
+	stageRouter    = "router"
+	stageGate      = "gate"
+)
+
+// execExplain lists the fragments of the plan of the SELECT: what every partition runs, how the
+// router merges the partitions and what the gate computes itself.
+func (e *executor) execExplain(ctx context.Context, db string, sel *sqlparser.Select) (*sqltypes.Result, error) {
+	v := newView(db, "explain", "id", "stage", "target", "detail")
+	add := func(stage, target, detail string) {
+		v.add(int64(len(v.rows)+1), stage, target, detail)
+	}
+	if name, ok := informationSchemaTable(db, sel.From); ok {
+		add(stageGate, informationSchema+"."+name, "built from the catalog, filtered, sorted and limited in the gate")
+		return v.result(), nil
+	}
+	if len(sel.From) == 1 {
+		if aliased, ok := sel.From[0].(*sqlparser.AliasedTableExpr); ok {
+			if name, ok := aliased.Expr.(sqlparser.TableName); ok && name.Name.String() == "dual" {
+				add(stageGate, "dual", "evaluated in the gate")
+				return v.result(), nil
+			}
+		}
+	}
+	t, err := e.tableOf(ctx, db, sel.From)
+	if err != nil {
+		return nil, err
+	}
+	target := t.db + "." + t.space
+	query, err := searchQuery(t, sel.Where)
+	if err != nil {
+		return nil, err
+	}
+
+	if isAggregate(sel) {
+		p, err := planAggregate(t, sel)
+		if err != nil {
+			return nil, err
+		}
+		if p.pushdown {
+			add(stagePartition, target, "search "+jsonText(query)+" aggregation "+jsonText(p.aggregation(e.maxGroups)))
+			add(stageRouter, target, "concatenate the buckets of the partitions")
+			add(stageGate, t.name, p.describe(fmt.Sprintf("merge the buckets into at most %d groups", e.maxGroups)))
+		} else {
+			add(stagePartition, target, fmt.Sprintf("search %s fields %s sorted by %s, in pages of %d",
+				jsonText(query), jsonText(p.fields()), idField, e.maxRows))
+			add(stageRouter, target, "k-way merge of the pages of the partitions by "+idField)
+			add(stageGate, t.name, p.describe(fmt.Sprintf("fold the rows into at most %d groups within %d bytes",
+				e.maxGroups, e.memoryBudget)))
+		}
+		return v.result(), nil
+	}
+
+	offset, count, err := limitWindow(sel.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if ids, ok := pointKeys(sel.Where, t.primaryKey); ok && len(sel.OrderBy) == 0 {
+		add(stagePartition, target, "get by id "+jsonText(ids))
+		add(stageGate, t.name, "project the rows")
+		return v.result(), nil
+	}
+	sort, err := sortFields(sel.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	size := count
+	if size < 0 || size > e.maxRows {
+		size = e.maxRows
+	}
+	order := "score"
+	if len(sort) > 0 {
+		order = jsonText(sort)
+	}
+	add(stagePartition, target, fmt.Sprintf("search %s sorted by %s, the top %d", jsonText(query), order, offset+size))
+	add(stageRouter, target, fmt.Sprintf("k-way merge of the partitions by %s, rows %d to %d", order, offset, offset+size))
+	add(stageGate, t.name, "project the rows")
+	return v.result(), nil
+}
+
+// describe tells what the gate computes from the groups.
+func (p *aggregatePlan) describe(fold string) string {
+	parts := []string{fold}
+	if len(p.groupBy) > 0 {
+		parts[0] += " by " + strings.Join(p.groupBy, ", ")
+	}
+	var aggregates []string
+	for _, m := range p.metrics {
+		if m.arg == nil {
+			aggregates = append(aggregates, m.Func+"(*)")
+		} else {
+			aggregates = append(aggregates, m.Func+"("+sqlparser.String(m.arg)+")")
+		}
+	}
+	if len(aggregates) > 0 {
+		parts = append(parts, "of "+strings.Join(aggregates, ", "))
+	}
+	if p.having != nil {
+		parts = append(parts, "having "+sqlparser.String(p.having))
+	}
+	if p.distinct {
+		parts = append(parts, "distinct")
+	}
+	if len(p.orderBy) > 0 {
+		parts = append(parts, strings.TrimSpace(sqlparser.String(p.orderBy)))
+	}
+	if p.limit != nil {
+		parts = append(parts, strings.TrimSpace(sqlparser.String(p.limit)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func jsonText(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
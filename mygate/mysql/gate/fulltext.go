@@ -0,0 +1,246 @@
+package mysql
+
+import (
+	"strings"
+	"unicode"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// MATCH (columns) AGAINST (text [mode]) is a full text search of the engine:
+//   NATURAL LANGUAGE MODE -> a match query of the column or a multi_match of the columns
+//   BOOLEAN MODE          -> a bool query of the words: +word must, -word must not, a word without
+//                            operator should, "a phrase" a phrase query, word* a prefix query,
+//                            (...) a group, >word and <word raise and lower the relevance, ~word
+//                            lowers it
+// In the select list and in ORDER BY a MATCH is the relevance of the row, the score of its hit.
+
+// scoreField is the field of the relevance in the rows and the sort of the engine.
+const scoreField = "_score"
+
+// the boosts of the relevance operators of BOOLEAN MODE
+const (
+	boostRaise  = 2.0
+	boostLower  = 0.5
+	boostNegate = 0.2
+)
+
+// fullTextQuery compiles the MATCH into the query dsl of the engine.
+func fullTextQuery(m *sqlparser.MatchExpr) (interface{}, error) {
+	var columns []string
+	for _, expr := range m.Columns {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errNotSupported("MATCH of %s is not supported", sqlparser.String(expr))
+		}
+		column, ok := columnName(aliased.Expr)
+		if !ok {
+			return nil, errNotSupported("MATCH of %s is not supported", sqlparser.String(expr))
+		}
+		columns = append(columns, column)
+	}
+	v, err := literal(m.Expr)
+	if err != nil {
+		return nil, err
+	}
+	text, ok := v.(string)
+	if !ok {
+		return nil, errNotSupported("AGAINST %s is not a string", sqlparser.String(m.Expr))
+	}
+
+	switch m.Option {
+	case "", sqlparser.NaturalLanguageModeStr:
+		if len(columns) == 1 {
+			return map[string]interface{}{"match": map[string]interface{}{columns[0]: text}}, nil
+		}
+		return map[string]interface{}{"multi_match": map[string]interface{}{"query": text, "fields": columns}}, nil
+	case sqlparser.BooleanModeStr:
+		p := &booleanParser{text: text, columns: columns}
+		q, err := p.group()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos < len(p.text) {
+			return nil, errNotSupported("unbalanced ) in %q", text)
+		}
+		return q, nil
+	}
+	return nil, errNotSupported("MATCH%s is not supported", m.Option)
+}
+
+// booleanParser parses the search string of BOOLEAN MODE.
+type booleanParser struct {
+	text    string
+	pos     int
+	columns []string
+}
+
+// group parses the words up to the end of the text or of the group.
+func (p *booleanParser) group() (interface{}, error) {
+	var must, should, mustNot []interface{}
+	for {
+		for p.pos < len(p.text) && unicode.IsSpace(rune(p.text[p.pos])) {
+			p.pos++
+		}
+		if p.pos >= len(p.text) || p.text[p.pos] == ')' {
+			break
+		}
+		var operator byte
+		if strings.IndexByte("+-<>~", p.text[p.pos]) >= 0 {
+			operator = p.text[p.pos]
+			p.pos++
+		}
+		clause, err := p.clause()
+		if err != nil {
+			return nil, err
+		}
+		if clause == nil {
+			continue
+		}
+		switch operator {
+		case '+':
+			must = append(must, clause)
+		case '-':
+			mustNot = append(mustNot, clause)
+		case '>':
+			should = append(should, boostQuery(clause, boostRaise))
+		case '<':
+			should = append(should, boostQuery(clause, boostLower))
+		case '~':
+			should = append(should, boostQuery(clause, boostNegate))
+		default:
+			should = append(should, clause)
+		}
+	}
+
+	q := make(map[string]interface{})
+	switch {
+	case len(must) > 0:
+		// the words without operator only rank the rows
+		q["must"] = must
+		if len(should) > 0 {
+			q["should"] = should
+		}
+	case len(should) > 0:
+		q["should"] = should
+		q["minimum_should_match"] = 1
+	default:
+		// the exclusions only drop the rows the other words match, alone they match nothing
+		return notQuery(matchAllQuery()), nil
+	}
+	if len(mustNot) > 0 {
+		q["must_not"] = mustNot
+	}
+	return map[string]interface{}{"bool": q}, nil
+}
+
+// clause parses a group, a phrase or a word, nil for an empty word.
+func (p *booleanParser) clause() (interface{}, error) {
+	switch p.text[p.pos] {
+	case '(':
+		p.pos++
+		q, err := p.group()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.text) {
+			return nil, errNotSupported("unbalanced ( in %q", p.text)
+		}
+		p.pos++
+		return q, nil
+	case '"':
+		end := strings.IndexByte(p.text[p.pos+1:], '"')
+		if end < 0 {
+			return nil, errNotSupported("unbalanced \" in %q", p.text)
+		}
+		phrase := p.text[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		if strings.TrimSpace(phrase) == "" {
+			return nil, nil
+		}
+		return p.columnsQuery(func(column string) interface{} {
+			return map[string]interface{}{"match": map[string]interface{}{column: map[string]interface{}{"query": phrase, "type": "phrase"}}}
+		}), nil
+	}
+	start := p.pos
+	for p.pos < len(p.text) && !unicode.IsSpace(rune(p.text[p.pos])) && strings.IndexByte(`()"`, p.text[p.pos]) < 0 {
+		p.pos++
+	}
+	word := p.text[start:p.pos]
+	if prefix := strings.TrimSuffix(word, "*"); prefix != word {
+		if prefix == "" {
+			return nil, nil
+		}
+		return p.columnsQuery(func(column string) interface{} {
+			// the prefix is not analyzed, the text of the index is lower case
+			return map[string]interface{}{"prefix": map[string]interface{}{column: strings.ToLower(prefix)}}
+		}), nil
+	}
+	if word == "" {
+		return nil, nil
+	}
+	return p.columnsQuery(func(column string) interface{} {
+		return map[string]interface{}{"match": map[string]interface{}{column: word}}
+	}), nil
+}
+
+// columnsQuery returns the query of the columns, any of them matching.
+func (p *booleanParser) columnsQuery(query func(column string) interface{}) interface{} {
+	if len(p.columns) == 1 {
+		return query(p.columns[0])
+	}
+	clauses := make([]interface{}, len(p.columns))
+	for i, column := range p.columns {
+		clauses[i] = query(column)
+	}
+	return boolQuery("should", clauses)
+}
+
+func boostQuery(q interface{}, boost float64) interface{} {
+	return map[string]interface{}{"bool": map[string]interface{}{"should": []interface{}{q}, "boost": boost}}
+}
+
+// matchExprs returns the MATCH expressions of the expression.
+func matchExprs(expr sqlparser.Expr) []*sqlparser.MatchExpr {
+	var exprs []*sqlparser.MatchExpr
+	if expr == nil {
+		return nil
+	}
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if m, ok := node.(*sqlparser.MatchExpr); ok {
+			exprs = append(exprs, m)
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return exprs
+}
+
+// scoredWhere returns the WHERE scoring the MATCH expressions of the select list and ORDER BY
+// which the WHERE lacks, by a clause every row matches: MATCH(...) AGAINST(...) OR TRUE.
+func scoredWhere(where *sqlparser.Where, scored []*sqlparser.MatchExpr) *sqlparser.Where {
+	var filtered []string
+	if where != nil {
+		for _, m := range matchExprs(where.Expr) {
+			filtered = append(filtered, sqlparser.String(m))
+		}
+	}
+	for _, m := range scored {
+		text := sqlparser.String(m)
+		found := false
+		for _, f := range filtered {
+			found = found || f == text
+		}
+		if found {
+			continue
+		}
+		filtered = append(filtered, text)
+		var clause sqlparser.Expr = &sqlparser.ParenExpr{Expr: &sqlparser.OrExpr{Left: m, Right: sqlparser.BoolVal(true)}}
+		if where == nil {
+			where = &sqlparser.Where{Type: sqlparser.WhereStr, Expr: clause}
+		} else {
+			where = &sqlparser.Where{Type: where.Type, Expr: &sqlparser.AndExpr{Left: where.Expr, Right: clause}}
+		}
+	}
+	return where
+}
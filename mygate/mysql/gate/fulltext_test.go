@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestFullTextQuery(t *testing.T) {
+	tests := []struct {
+		match string
+		query string
+	}{
+		{"match(title) against ('red fox')", `{"match":{"title":"red fox"}}`},
+		{"match(title, body) against ('red fox' in natural language mode)", `{"multi_match":{"fields":["title","body"],"query":"red fox"}}`},
+		{"match(title) against ('+red -blue fox' in boolean mode)",
+			`{"bool":{"must":[{"match":{"title":"red"}}],"must_not":[{"match":{"title":"blue"}}],"should":[{"match":{"title":"fox"}}]}}`},
+		{"match(title) against ('red fo*' in boolean mode)",
+			`{"bool":{"minimum_should_match":1,"should":[{"match":{"title":"red"}},{"prefix":{"title":"fo"}}]}}`},
+		{`match(title) against ('+"red fox" +(>dog <cat)' in boolean mode)`,
+			`{"bool":{"must":[{"match":{"title":{"query":"red fox","type":"phrase"}}},{"bool":{"minimum_should_match":1,"should":[{"bool":{"boost":2,"should":[{"match":{"title":"dog"}}]}},{"bool":{"boost":0.5,"should":[{"match":{"title":"cat"}}]}}]}}]}}`},
+		{"match(title, body) against ('+fox' in boolean mode)",
+			`{"bool":{"must":[{"bool":{"minimum_should_match":1,"should":[{"match":{"title":"fox"}},{"match":{"body":"fox"}}]}}]}}`},
+		{"match(title) against ('-fox' in boolean mode)", `{"bool":{"must_not":[{"match_all":{}}]}}`},
+	}
+	for _, test := range tests {
+		stmt, err := sqlparser.Parse("select * from t where " + test.match)
+		if err != nil {
+			t.Fatalf("%s: %v", test.match, err)
+		}
+		q, err := fullTextQuery(stmt.(*sqlparser.Select).Where.Expr.(*sqlparser.MatchExpr))
+		if err != nil {
+			t.Fatalf("%s: %v", test.match, err)
+		}
+		data, _ := json.Marshal(q)
+		if string(data) != test.query {
+			t.Errorf("%s: query %s, expect %s", test.match, data, test.query)
+		}
+	}
+
+	for _, match := range []string{"match(title) against ('(fox' in boolean mode)", "match(title) against ('fox)' in boolean mode)",
+		"match(title) against ('fox' with query expansion)"} {
+		stmt, err := sqlparser.Parse("select * from t where " + match)
+		if err != nil {
+			t.Fatalf("%s: %v", match, err)
+		}
+		if _, err := fullTextQuery(stmt.(*sqlparser.Select).Where.Expr.(*sqlparser.MatchExpr)); err == nil {
+			t.Errorf("%s: compiled", match)
+		}
+	}
+}
+
+func TestSelectRelevance(t *testing.T) {
+	backend := newMemBackend()
+	e := newExecutor(backend, newMemMaster(backend), 100)
+	c := &mysql.Conn{SchemaName: "test"}
+	mustExec(t, e, c, "insert into doc (id, title) values (1, 'red fox'), (2, 'blue fox')")
+
+	result := mustExec(t, e, c, "select id, match(title) against ('fox') as score from doc where category = 'a' order by score desc limit 10")
+	if len(result.Rows) != 2 || result.Fields[1].Name != "score" || result.Fields[1].Type != sqltypes.Float64 {
+		t.Fatalf("unexpected result %v", result)
+	}
+	search := backend.lastSearch
+	if len(search.Sort) != 1 || search.Sort[0] != "-_score" {
+		t.Fatalf("unexpected sort %v", search.Sort)
+	}
+	for _, field := range search.Fields {
+		if field == scoreField {
+			t.Fatal("the relevance searched as a field")
+		}
+	}
+	data, _ := json.Marshal(search.Query)
+	// the relevance is scored without filtering the rows
+	expect := `{"bool":{"must":[{"term":{"category":"a"}},{"bool":{"minimum_should_match":1,"should":[{"match":{"title":"fox"}},{"match_all":{}}]}}]}}`
+	if string(data) != expect {
+		t.Fatalf("query %s, expect %s", data, expect)
+	}
+
+	mustExec(t, e, c, "select id from doc where match(title) against ('fox') order by match(title) against ('fox') desc")
+	data, _ = json.Marshal(backend.lastSearch.Query)
+	if expect := `{"match":{"title":"fox"}}`; string(data) != expect {
+		t.Fatalf("query %s, expect %s", data, expect)
+	}
+}
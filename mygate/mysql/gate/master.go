@@ -0,0 +1,210 @@
+package mysql
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/tiglabs/baudengine/util"
+	"github.com/tiglabs/baudengine/util/discovery"
+	"golang.org/x/net/context"
+)
+
+var masterAddr = flag.String("master_addr", "127.0.0.1:8817", "The comma separated http addresses of the global masters managing the dbs and the spaces of the gate, the only way to find the leader.")
+
+// the reply codes of the global master, see gm/errors.go
+const (
+	masterCodeSuccess        = 0
+	masterCodeNotLeader      = 5
+	masterCodeNoLeader       = 6
+	masterCodeDupDB          = 7
+	masterCodeDBNotExists    = 8
+	masterCodeDupSpace       = 9
+	masterCodeSpaceNotExists = 10
+)
+
+var (
+	errDupDB          = errors.New("duplicated database")
+	errDBNotExists    = errors.New("db not exists")
+	errDupSpace       = errors.New("duplicated space")
+	errSpaceNotExists = errors.New("space not exists")
+)
+
+// Master is how the gate manages the dbs and the spaces, the global master implements it over http.
+type Master interface {
+	// DBs returns the names of the dbs.
+	DBs(ctx context.Context) ([]string, error)
+	CreateDB(ctx context.Context, db string) error
+	DropDB(ctx context.Context, db string) error
+	// Spaces returns the names of the spaces of the db.
+	Spaces(ctx context.Context, db string) ([]string, error)
+	// CreateSpace creates a space of the partitions split by the key field.
+	CreateSpace(ctx context.Context, db, space, schema string, policy *PartitionPolicy) error
+	DropSpace(ctx context.Context, db, space string) error
+	// UpdateSchema replaces the schema of the space.
+	UpdateSchema(ctx context.Context, db, space, schema string) error
+}
+
+// PartitionPolicy is how the objects of a space are split into partitions.
+type PartitionPolicy struct {
+	Key      string
+	Function string
+	Number   int
+}
+
+type masterReply struct {
+	Code int32           `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// the tries of a call to the global masters, they back off as util.DefaultRetryOption
+const masterRetries = 5
+
+// errNotLeader is the reply of a master which is not the leader, the call goes on at another one.
+var errNotLeader = errors.New("master is not the leader")
+
+// masterClient calls the http api of the leader of the global masters.
+type masterClient struct {
+	masters *discovery.Masters
+	client  *http.Client
+}
+
+func newMasterClient(addrs string) *masterClient {
+	return &masterClient{masters: discovery.NewMasters(discovery.ParseAddrs(addrs), nil), client: &http.Client{}}
+}
+
+func (m *masterClient) DBs(ctx context.Context) ([]string, error) {
+	var dbs []struct {
+		Name string `json:"name"`
+	}
+	if err := m.call(ctx, http.MethodGet, "/manage/db/list", nil, &dbs); err != nil {
+		return nil, err
+	}
+	names := make([]string, len(dbs))
+	for i, db := range dbs {
+		names[i] = db.Name
+	}
+	return names, nil
+}
+
+func (m *masterClient) CreateDB(ctx context.Context, db string) error {
+	return m.call(ctx, http.MethodPost, "/manage/db/create", url.Values{"db_name": {db}}, nil)
+}
+
+func (m *masterClient) DropDB(ctx context.Context, db string) error {
+	return m.call(ctx, http.MethodDelete, "/manage/db/delete", url.Values{"db_name": {db}}, nil)
+}
+
+func (m *masterClient) Spaces(ctx context.Context, db string) ([]string, error) {
+	var spaces []struct {
+		Name string `json:"name"`
+	}
+	if err := m.call(ctx, http.MethodGet, "/manage/space/list", url.Values{"db_name": {db}}, &spaces); err != nil {
+		return nil, err
+	}
+	names := make([]string, len(spaces))
+	for i, space := range spaces {
+		names[i] = space.Name
+	}
+	return names, nil
+}
+
+func (m *masterClient) CreateSpace(ctx context.Context, db, space, schema string, policy *PartitionPolicy) error {
+	return m.call(ctx, http.MethodPost, "/manage/space/create", url.Values{
+		"db_name":        {db},
+		"space_name":     {space},
+		"space_schema":   {schema},
+		"partition_key":  {policy.Key},
+		"partition_func": {policy.Function},
+		"partition_num":  {strconv.Itoa(policy.Number)},
+	}, nil)
+}
+
+func (m *masterClient) DropSpace(ctx context.Context, db, space string) error {
+	return m.call(ctx, http.MethodDelete, "/manage/space/delete", url.Values{"db_name": {db}, "space_name": {space}}, nil)
+}
+
+func (m *masterClient) UpdateSchema(ctx context.Context, db, space, schema string) error {
+	return m.call(ctx, http.MethodPut, "/manage/space/schema", url.Values{
+		"db_name":      {db},
+		"space_name":   {space},
+		"space_schema": {schema},
+	}, nil)
+}
+
+// call sends the params to the leader of the masters, trying the others with backoff while the
+// master called is down or not the leader, see send.
+func (m *masterClient) call(ctx context.Context, method, path string, params url.Values, data interface{}) error {
+	retryOpt := util.DefaultRetryOption
+	retryOpt.MaxRetries = masterRetries
+	retryOpt.Context = ctx
+
+	var replyErr error
+	err := m.masters.Call(&retryOpt, func(addr string) error {
+		err := m.send(ctx, addr, method, path, params, data)
+		if e, ok := err.(replyError); ok {
+			replyErr = e.err
+			return nil
+		}
+		return err
+	})
+	if err == nil {
+		err = replyErr
+	}
+	return err
+}
+
+// replyError is the error the master replied, the call is not tried again.
+type replyError struct {
+	err error
+}
+
+func (e replyError) Error() string {
+	return e.err.Error()
+}
+
+// send sends the params to the master at addr in the query, as it reads them whatever the method,
+// and decodes the data of the reply into data. The codes of the dbs and the spaces which exist or
+// not are returned as their errors.
+func (m *masterClient) send(ctx context.Context, addr, method, path string, params url.Values, data interface{}) error {
+	req, err := http.NewRequest(method, "http://"+addr+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return replyError{err}
+	}
+	resp, err := m.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var reply masterReply
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return err
+	}
+	switch reply.Code {
+	case masterCodeSuccess:
+	case masterCodeNotLeader, masterCodeNoLeader:
+		return errNotLeader
+	case masterCodeDupDB:
+		return replyError{errDupDB}
+	case masterCodeDBNotExists:
+		return replyError{errDBNotExists}
+	case masterCodeDupSpace:
+		return replyError{errDupSpace}
+	case masterCodeSpaceNotExists:
+		return replyError{errSpaceNotExists}
+	default:
+		return replyError{fmt.Errorf("master: %s", reply.Msg)}
+	}
+	if data != nil && len(reply.Data) > 0 {
+		if err := json.Unmarshal(reply.Data, data); err != nil {
+			return replyError{err}
+		}
+	}
+	return nil
+}
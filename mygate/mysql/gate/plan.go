@@ -0,0 +1,459 @@
+package mysql
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// The clauses of a statement are planned into a request of the engine:
+//   WHERE    -> the query dsl, a bool query of term, terms, range, prefix and wildcard queries and
+//               of the full text queries of MATCH, see fulltext.go
+//   ORDER BY -> the sort fields, "-field" for DESC, "_score" for a MATCH
+//   LIMIT    -> from and size
+// A WHERE binding nothing but the primary key is a point lookup of the objects by id instead.
+
+func errNotSupported(format string, args ...interface{}) error {
+	return mysql.NewSQLError(mysql.ERNotSupportedYet, mysql.SSUnknownSQLState, format, args...)
+}
+
+// literal converts a literal of the statement to its json value, nil for NULL.
+func literal(expr sqlparser.Expr) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return string(expr.Val), nil
+		case sqlparser.IntVal:
+			if n, err := strconv.ParseInt(string(expr.Val), 10, 64); err == nil {
+				return n, nil
+			}
+			return strconv.ParseFloat(string(expr.Val), 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(expr.Val), 64)
+		case sqlparser.HexVal:
+			data, err := hex.DecodeString(string(expr.Val))
+			return string(data), err
+		case sqlparser.HexNum:
+			n, err := strconv.ParseUint(string(expr.Val[2:]), 16, 64)
+			return int64(n), err
+		}
+	case *sqlparser.NullVal:
+		return nil, nil
+	case sqlparser.BoolVal:
+		return bool(expr), nil
+	case *sqlparser.ParenExpr:
+		return literal(expr.Expr)
+	case *sqlparser.UnaryExpr:
+		if expr.Operator == sqlparser.UMinusStr {
+			v, err := literal(expr.Expr)
+			if err != nil {
+				return nil, err
+			}
+			switch v := v.(type) {
+			case int64:
+				return -v, nil
+			case float64:
+				return -v, nil
+			}
+		}
+	}
+	return nil, errNotSupported("expression %s is not a literal", sqlparser.String(expr))
+}
+
+// keyString returns the object id of a primary key value.
+func keyString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		if v != "" {
+			return v, nil
+		}
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case json.Number:
+		return v.String(), nil
+	}
+	return "", mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "bad primary key value %v", v)
+}
+
+func columnName(expr sqlparser.Expr) (string, bool) {
+	if col, ok := expr.(*sqlparser.ColName); ok {
+		return col.Name.String(), true
+	}
+	return "", false
+}
+
+// pointKeys returns the ids bound by a WHERE of "pk = value" or "pk IN (values...)", ok is false
+// for any other WHERE.
+func pointKeys(where *sqlparser.Where, pk string) (ids []string, ok bool) {
+	if where == nil {
+		return nil, false
+	}
+	expr := where.Expr
+	for {
+		paren, isParen := expr.(*sqlparser.ParenExpr)
+		if !isParen {
+			break
+		}
+		expr = paren.Expr
+	}
+	cmp, isCmp := expr.(*sqlparser.ComparisonExpr)
+	if !isCmp {
+		return nil, false
+	}
+	if column, isCol := columnName(cmp.Left); !isCol || column != pk {
+		return nil, false
+	}
+	var values sqlparser.Exprs
+	switch cmp.Operator {
+	case sqlparser.EqualStr:
+		values = sqlparser.Exprs{cmp.Right}
+	case sqlparser.InStr:
+		tuple, isTuple := cmp.Right.(sqlparser.ValTuple)
+		if !isTuple {
+			return nil, false
+		}
+		values = sqlparser.Exprs(tuple)
+	default:
+		return nil, false
+	}
+
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		v, err := literal(value)
+		if err != nil {
+			return nil, false
+		}
+		if v == nil {
+			// nothing equals NULL
+			continue
+		}
+		id, err := keyString(v)
+		if err != nil {
+			return nil, false
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, true
+}
+
+// searchQuery returns the query dsl of the WHERE on the objects of the table.
+func searchQuery(t *table, where *sqlparser.Where) (interface{}, error) {
+	var must []interface{}
+	if t.shared() {
+		must = append(must, termQuery(tableField, t.name))
+	}
+	if where != nil {
+		q, err := filterQuery(where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		must = append(must, q)
+		must = append(must, indexQueries(t, where.Expr)...)
+	}
+	switch len(must) {
+	case 0:
+		return matchAllQuery(), nil
+	case 1:
+		return must[0], nil
+	}
+	return boolQuery("must", must), nil
+}
+
+// indexQueries returns the terms of the compound indexes whose columns the top level equalities of
+// the WHERE all bind, the rows are found by the one field of the index.
+func indexQueries(t *table, expr sqlparser.Expr) []interface{} {
+	indexes := t.compoundIndexes()
+	if len(indexes) == 0 {
+		return nil
+	}
+	bound := make(map[string]interface{})
+	for _, operand := range operands(&sqlparser.AndExpr{}, expr, nil) {
+		cmp, ok := operand.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualStr {
+			continue
+		}
+		left, right := cmp.Left, cmp.Right
+		if _, ok := columnName(left); !ok {
+			left, right = right, left
+		}
+		column, ok := columnName(left)
+		if !ok {
+			continue
+		}
+		col := t.def.column(column)
+		v, err := literal(right)
+		if col == nil || err != nil || v == nil {
+			continue
+		}
+		// the values of the index field are of the type of the column
+		if v, err = columnValueOf(col, v); err == nil {
+			bound[col.Name] = v
+		}
+	}
+
+	var queries []interface{}
+	for _, idx := range indexes {
+		if key, ok := indexKey(idx, bound); ok {
+			queries = append(queries, termQuery(idx.field(), key))
+		}
+	}
+	return queries
+}
+
+func filterQuery(expr sqlparser.Expr) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return joinQuery("must", expr)
+	case *sqlparser.OrExpr:
+		return joinQuery("should", expr)
+	case *sqlparser.NotExpr:
+		q, err := filterQuery(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return notQuery(q), nil
+	case *sqlparser.ParenExpr:
+		return filterQuery(expr.Expr)
+	case sqlparser.BoolVal:
+		if expr {
+			return matchAllQuery(), nil
+		}
+		return notQuery(matchAllQuery()), nil
+	case *sqlparser.ComparisonExpr:
+		return comparisonQuery(expr)
+	case *sqlparser.MatchExpr:
+		return fullTextQuery(expr)
+	case *sqlparser.RangeCond:
+		column, ok := columnName(expr.Left)
+		if !ok {
+			break
+		}
+		from, err := literal(expr.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := literal(expr.To)
+		if err != nil {
+			return nil, err
+		}
+		q := rangeQuery(column, map[string]interface{}{"gte": from, "lte": to})
+		if expr.Operator == sqlparser.NotBetweenStr {
+			return notQuery(q), nil
+		}
+		return q, nil
+	}
+	return nil, errNotSupported("condition %s is not supported", sqlparser.String(expr))
+}
+
+// joinQuery turns a chain of AND or OR into one bool query.
+func joinQuery(occur string, expr sqlparser.Expr) (interface{}, error) {
+	var clauses []interface{}
+	for _, operand := range operands(expr, expr, nil) {
+		q, err := filterQuery(operand)
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, q)
+	}
+	return boolQuery(occur, clauses), nil
+}
+
+// operands appends the operands of the chain of the operator of root to out.
+func operands(root, expr sqlparser.Expr, out []sqlparser.Expr) []sqlparser.Expr {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		if _, ok := root.(*sqlparser.AndExpr); ok {
+			return operands(root, e.Right, operands(root, e.Left, out))
+		}
+	case *sqlparser.OrExpr:
+		if _, ok := root.(*sqlparser.OrExpr); ok {
+			return operands(root, e.Right, operands(root, e.Left, out))
+		}
+	}
+	return append(out, expr)
+}
+
+var mirroredOperators = map[string]string{
+	sqlparser.EqualStr:        sqlparser.EqualStr,
+	sqlparser.NotEqualStr:     sqlparser.NotEqualStr,
+	sqlparser.LessThanStr:     sqlparser.GreaterThanStr,
+	sqlparser.GreaterThanStr:  sqlparser.LessThanStr,
+	sqlparser.LessEqualStr:    sqlparser.GreaterEqualStr,
+	sqlparser.GreaterEqualStr: sqlparser.LessEqualStr,
+}
+
+var rangeOperators = map[string]string{
+	sqlparser.LessThanStr:     "lt",
+	sqlparser.GreaterThanStr:  "gt",
+	sqlparser.LessEqualStr:    "lte",
+	sqlparser.GreaterEqualStr: "gte",
+}
+
+func comparisonQuery(cmp *sqlparser.ComparisonExpr) (interface{}, error) {
+	operator, left, right := cmp.Operator, cmp.Left, cmp.Right
+	if _, ok := columnName(left); !ok {
+		// value op column
+		if mirrored, ok := mirroredOperators[operator]; ok {
+			operator, left, right = mirrored, right, left
+		}
+	}
+	column, ok := columnName(left)
+	if !ok {
+		return nil, errNotSupported("condition %s is not on a column", sqlparser.String(cmp))
+	}
+
+	switch operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, errNotSupported("condition %s is not supported", sqlparser.String(cmp))
+		}
+		var clauses []interface{}
+		for _, expr := range tuple {
+			v, err := literal(expr)
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				clauses = append(clauses, equalQuery(column, v))
+			}
+		}
+		q := boolQuery("should", clauses)
+		if operator == sqlparser.NotInStr {
+			return notQuery(q), nil
+		}
+		return q, nil
+	}
+
+	v, err := literal(right)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, errNotSupported("comparison with NULL in %s", sqlparser.String(cmp))
+	}
+	switch operator {
+	case sqlparser.EqualStr:
+		return equalQuery(column, v), nil
+	case sqlparser.NotEqualStr:
+		return notQuery(equalQuery(column, v)), nil
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		pattern, ok := v.(string)
+		if !ok {
+			break
+		}
+		q, err := likeQuery(column, pattern)
+		if err != nil {
+			return nil, err
+		}
+		if operator == sqlparser.NotLikeStr {
+			return notQuery(q), nil
+		}
+		return q, nil
+	}
+	if op, ok := rangeOperators[operator]; ok {
+		return rangeQuery(column, map[string]interface{}{op: v}), nil
+	}
+	return nil, errNotSupported("condition %s is not supported", sqlparser.String(cmp))
+}
+
+// equalQuery matches the term of a string or a bool, a number is matched as a range of itself.
+func equalQuery(column string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		return termQuery(column, v)
+	case bool:
+		if v {
+			return termQuery(column, "T")
+		}
+		return termQuery(column, "F")
+	}
+	return rangeQuery(column, map[string]interface{}{"gte": v, "lte": v})
+}
+
+// likeQuery turns a LIKE pattern to a prefix query if it ends with the only '%', or to a wildcard
+// query, '%' and '_' match as '*' and '?'.
+func likeQuery(column, pattern string) (interface{}, error) {
+	if strings.ContainsAny(pattern, "\\*?") {
+		return nil, errNotSupported("LIKE pattern '%s' is not supported", pattern)
+	}
+	if prefix := strings.TrimSuffix(pattern, "%"); len(prefix) == len(pattern)-1 && !strings.ContainsAny(prefix, "%_") {
+		return map[string]interface{}{"prefix": map[string]interface{}{column: prefix}}, nil
+	}
+	wildcard := strings.NewReplacer("%", "*", "_", "?").Replace(pattern)
+	return map[string]interface{}{"wildcard": map[string]interface{}{column: wildcard}}, nil
+}
+
+func termQuery(column, term string) interface{} {
+	return map[string]interface{}{"term": map[string]interface{}{column: term}}
+}
+
+func rangeQuery(column string, bounds map[string]interface{}) interface{} {
+	return map[string]interface{}{"range": map[string]interface{}{column: bounds}}
+}
+
+func matchAllQuery() interface{} {
+	return map[string]interface{}{"match_all": map[string]interface{}{}}
+}
+
+func notQuery(q interface{}) interface{} {
+	return boolQuery("must_not", []interface{}{q})
+}
+
+func boolQuery(occur string, clauses []interface{}) interface{} {
+	inner := map[string]interface{}{occur: clauses}
+	if occur == "should" {
+		inner["minimum_should_match"] = 1
+	}
+	return map[string]interface{}{"bool": inner}
+}
+
+// sortFields returns the sort of the ORDER BY, a MATCH sorts by the relevance.
+func sortFields(orderBy sqlparser.OrderBy) ([]string, error) {
+	var fields []string
+	for _, order := range orderBy {
+		column, ok := columnName(order.Expr)
+		if _, match := order.Expr.(*sqlparser.MatchExpr); match {
+			column, ok = scoreField, true
+		}
+		if !ok {
+			return nil, errNotSupported("ORDER BY %s is not on a column", sqlparser.String(order.Expr))
+		}
+		if order.Direction == sqlparser.DescScr {
+			column = "-" + column
+		}
+		fields = append(fields, column)
+	}
+	return fields, nil
+}
+
+// limitWindow returns the offset and the row count of the LIMIT, count is -1 without LIMIT.
+func limitWindow(limit *sqlparser.Limit) (offset, count int, err error) {
+	if limit == nil {
+		return 0, -1, nil
+	}
+	parse := func(expr sqlparser.Expr) (int, error) {
+		v, err := literal(expr)
+		if n, ok := v.(int64); err == nil && ok && n >= 0 {
+			return int(n), nil
+		}
+		return 0, errNotSupported("LIMIT %s is not a number", sqlparser.String(expr))
+	}
+	if limit.Offset != nil {
+		if offset, err = parse(limit.Offset); err != nil {
+			return
+		}
+	}
+	count, err = parse(limit.Rowcount)
+	return
+}
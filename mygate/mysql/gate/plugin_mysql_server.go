@@ -17,6 +17,7 @@ limitations under the License.
 package mysql
 
 import (
+	"crypto/tls"
 	"flag"
 	"fmt"
 	"net"
@@ -31,6 +32,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttls"
 )
@@ -40,7 +42,7 @@ var (
 	mysqlServerBindAddress        = flag.String("mysql_server_bind_address", "0.0.0.0", "Binds on this address when listening to MySQL binary protocol. Useful to restrict listening to 'localhost' only for instance.")
 	mysqlServerSocketPath         = flag.String("mysql_server_socket_path", "/tmp/mysql.sock", "This option specifies the Unix socket file to use when listening for local connections. By default it will be empty and it won't listen to a unix socket")
 	mysqlTCPVersion               = flag.String("mysql_tcp_version", "tcp", "Select tcp, tcp4, or tcp6 to control the socket type.")
-	mysqlAuthServerImpl           = flag.String("mysql_auth_server_impl", "none", "Which auth server implementation to use.")
+	mysqlAuthServerImpl           = flag.String("mysql_auth_server_impl", baudAuthServerImpl, "Which auth server implementation to use, baudengine checks the users and the grants stored in the cluster.")
 	mysqlAllowClearTextWithoutTLS = flag.Bool("mysql_allow_clear_text_without_tls", false, "If set, the server will allow the use of a clear text password over non-SSL connections.")
 	mysqlServerVersion            = flag.String("mysql_server_version", mysql.DefaultServerVersion, "MySQL server version to advertise.")
 
@@ -58,34 +60,45 @@ var (
 )
 
 // gateHandler implements the Listener interface.
-// It stores the Session in the ClientData of a Connection, if a transaction
-// is in progress.
+// It stores the session of a Connection, its prepared statements and user
+// variables, in the ClientData of the Connection.
 type gateHandler struct {
+	executor *executor
 }
 
-func newGateHandler() *gateHandler {
-	return &gateHandler{}
+func newGateHandler(backend Backend, master Master) *gateHandler {
+	e := newExecutor(backend, master, *mysqlMaxRows)
+	e.checkGrants = *mysqlAuthServerImpl == baudAuthServerImpl
+	return &gateHandler{executor: e}
 }
 
 func (vh *gateHandler) NewConnection(c *mysql.Conn) {
+	c.ClientData = newSession()
 }
 
 func (vh *gateHandler) ConnectionClosed(c *mysql.Conn) {
-	var cancel context.CancelFunc
-	if *mysqlQueryTimeout != 0 {
-		_, cancel = context.WithTimeout(context.Background(), *mysqlQueryTimeout)
-		defer cancel()
+	// the prepared statements go with the session, the scrolls of their cursors are closed
+	if s, ok := c.ClientData.(*session); ok {
+		ctx, cancel := queryContext()
+		for _, ps := range s.stmts {
+			vh.executor.closeCursor(ctx, ps)
+		}
+		cancel()
 	}
+	c.ClientData = nil
 }
 
-func (vh *gateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
-	var cancel context.CancelFunc
+// queryContext returns the context of a statement, bound by -mysql_server_query_timeout.
+func queryContext() (context.Context, context.CancelFunc) {
 	if *mysqlQueryTimeout != 0 {
-		_, cancel = context.WithTimeout(context.Background(), *mysqlQueryTimeout)
-		defer cancel()
-	} else {
-		_ = context.Background()
+		return context.WithTimeout(context.Background(), *mysqlQueryTimeout)
 	}
+	return context.WithCancel(context.Background())
+}
+
+func (vh *gateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	ctx, cancel := queryContext()
+	defer cancel()
 
 	// Fill in the ImmediateCallerID with the UserData returned by
 	// the AuthServer plugin for that user. If nothing was
@@ -93,24 +106,97 @@ func (vh *gateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqlt
 	// user used for authentication to a Vitess User used for
 	// Table ACLs and Vitess authentication in general.
 	im := c.UserData.Get()
-	log.Info("user: %s, query: %s", im.Username, query)
-
 	stmts, err := sqlparser.SplitStatementToPieces(query)
 	if err != nil {
+		log.Info("user: %s, query: %s", im.Username, redactStatement(query))
 		err = mysql.NewSQLErrorFromError(err)
 		return err
 	}
+	log.Info("user: %s, query: %s", im.Username, redactQuery(query))
+	// the statements run in order, the result of the last one is sent
+	result := &sqltypes.Result{}
 	for _, stmt := range stmts {
-		log.Info("split query : %s", stmt)
-		statement, err := sqlparser.ParseStrictDDL(stmt)
-		if err != nil {
-			err = mysql.NewSQLErrorFromError(err)
-			return err
+		if result, err = vh.executor.run(ctx, c, stmt); err != nil {
+			log.Error("execute query[%s] failed: %v", redactStatement(stmt), err)
+			return mysql.NewSQLErrorFromError(err)
 		}
-		log.Info("statement type: %T", statement)
+	}
+	return callback(result)
+}
 
+// ComPrepare prepares the statement of COM_STMT_PREPARE, it returns the id of the statement, the
+// number of its parameters and the columns of its result if they are known.
+func (vh *gateHandler) ComPrepare(c *mysql.Conn, query string) (uint32, int, []*querypb.Field, error) {
+	ctx, cancel := queryContext()
+	defer cancel()
+	ps, err := vh.executor.prepare(ctx, c, query)
+	if err != nil {
+		log.Error("prepare query[%s] failed: %v", redactStatement(query), err)
+		return 0, 0, nil, mysql.NewSQLErrorFromError(err)
 	}
-	return callback(&sqltypes.Result{})
+	return ps.id, ps.plan.params, ps.fields, nil
+}
+
+// ComStmtExecute executes the prepared statement of COM_STMT_EXECUTE with the values of its
+// parameters, the rows of a SELECT executed with a cursor are fetched by ComStmtFetch.
+func (vh *gateHandler) ComStmtExecute(c *mysql.Conn, stmtID uint32, params []*querypb.BindVariable, withCursor bool, callback func(*sqltypes.Result) error) error {
+	ctx, cancel := queryContext()
+	defer cancel()
+	ps, err := sessionOf(c).statement(stmtID, "COM_STMT_EXECUTE")
+	if err != nil {
+		return err
+	}
+	result, err := vh.executor.executePrepared(ctx, c, ps, params, withCursor)
+	if err != nil {
+		log.Error("execute statement[%s] failed: %v", redactStatement(ps.plan.key), err)
+		return mysql.NewSQLErrorFromError(err)
+	}
+	return callback(result)
+}
+
+// ComStmtFetch fetches the next rows of the cursor of the statement.
+func (vh *gateHandler) ComStmtFetch(c *mysql.Conn, stmtID uint32, rows int, callback func(*sqltypes.Result) error) error {
+	ctx, cancel := queryContext()
+	defer cancel()
+	ps, err := sessionOf(c).statement(stmtID, "COM_STMT_FETCH")
+	if err != nil {
+		return err
+	}
+	result, err := vh.executor.fetch(ctx, ps, rows)
+	if err != nil {
+		log.Error("fetch statement[%s] failed: %v", redactStatement(ps.plan.key), err)
+		return mysql.NewSQLErrorFromError(err)
+	}
+	return callback(result)
+}
+
+// ComStmtClose closes the statement and its cursor, COM_STMT_CLOSE has no reply.
+func (vh *gateHandler) ComStmtClose(c *mysql.Conn, stmtID uint32) {
+	ctx, cancel := queryContext()
+	defer cancel()
+	s := sessionOf(c)
+	if ps, err := s.statement(stmtID, "COM_STMT_CLOSE"); err == nil {
+		vh.executor.closeCursor(ctx, ps)
+		s.close(ps)
+	}
+}
+
+// ComStmtReset closes the cursor of the statement of COM_STMT_RESET.
+func (vh *gateHandler) ComStmtReset(c *mysql.Conn, stmtID uint32) error {
+	ctx, cancel := queryContext()
+	defer cancel()
+	ps, err := sessionOf(c).statement(stmtID, "COM_STMT_RESET")
+	if err != nil {
+		return err
+	}
+	vh.executor.closeCursor(ctx, ps)
+	return nil
+}
+
+// cursorOpen is true if the statement has a cursor with rows left to fetch.
+func (vh *gateHandler) cursorOpen(c *mysql.Conn, stmtID uint32) bool {
+	ps, err := sessionOf(c).statement(stmtID, "")
+	return err == nil && ps.cursor != nil && !ps.cursor.exhausted()
 }
 
 var mysqlListener *mysql.Listener
@@ -124,8 +210,6 @@ func InitMySQLProtocol() {
 		return
 	}
 
-	authServer := mysql.GetAuthServer(*mysqlAuthServerImpl)
-
 	switch *mysqlTCPVersion {
 	case "tcp", "tcp4", "tcp6":
 		// Valid flag value.
@@ -135,23 +219,31 @@ func InitMySQLProtocol() {
 
 	// Create a Listener.
 	var err error
-	vh := newGateHandler()
-	if *mysqlServerPort >= 0 {
-		mysqlListener, err = mysql.NewListener(*mysqlTCPVersion, net.JoinHostPort(*mysqlServerBindAddress, fmt.Sprintf("%v", *mysqlServerPort)), authServer, vh, *mysqlConnReadTimeout, *mysqlConnWriteTimeout)
-		if err != nil {
-			log.Fatal("mysql.NewListener failed: %v", err)
-		}
-		if *mysqlServerVersion != "" {
-			mysqlListener.ServerVersion = *mysqlServerVersion
+	vh := newGateHandler(newRouterBackend(*routerAddr, *routerUser, *routerPassword), newMasterClient(*masterAddr))
+	if *mysqlAuthServerImpl == baudAuthServerImpl {
+		// root would log in without a password
+		if *mysqlRootPassword == "" {
+			log.Fatal("-mysql_root_password must be set with -mysql_auth_server_impl %s", baudAuthServerImpl)
 		}
+		mysql.RegisterAuthServerImpl(baudAuthServerImpl, newAuthServer(vh.executor.users))
+	}
+	authServer := mysql.GetAuthServer(*mysqlAuthServerImpl)
+	if *mysqlServerPort >= 0 {
+		var tlsConfig *tls.Config
 		if *mysqlSslCert != "" && *mysqlSslKey != "" {
-			mysqlListener.TLSConfig, err = vttls.ServerConfig(*mysqlSslCert, *mysqlSslKey, *mysqlSslCa)
+			tlsConfig, err = vttls.ServerConfig(*mysqlSslCert, *mysqlSslKey, *mysqlSslCa)
 			if err != nil {
 				log.Fatal("grpcutils.TLSServerConfig failed: %v", err)
 				return
 			}
 		}
-		mysqlListener.AllowClearTextWithoutTLS = *mysqlAllowClearTextWithoutTLS
+		mysqlListener, err = newListener(*mysqlTCPVersion, net.JoinHostPort(*mysqlServerBindAddress, fmt.Sprintf("%v", *mysqlServerPort)), authServer, vh, tlsConfig)
+		if err != nil {
+			log.Fatal("mysql.NewListener failed: %v", err)
+		}
+		if *mysqlServerVersion != "" {
+			mysqlListener.ServerVersion = *mysqlServerVersion
+		}
 
 		// Check for the connection threshold
 		if *mysqlSlowConnectWarnThreshold != 0 {
@@ -178,10 +270,48 @@ func InitMySQLProtocol() {
 	log.Info("server started")
 }
 
+// newListener listens on the address, the COM_STMT_* commands of the connections of a gateHandler
+// are served by their stmtConns. The connections switch to TLS by the config if not nil: with the
+// authServer of the gate the stmtConns terminate TLS, so that the commands of a connection over
+// TLS are still readable, and the authServer refuses the clear text passwords without it.
+func newListener(protocol, address string, authSrv mysql.AuthServer, handler mysql.Handler, tlsConfig *tls.Config) (*mysql.Listener, error) {
+	vh, ok := handler.(*gateHandler)
+	if !ok {
+		listener, err := mysql.NewListener(protocol, address, authSrv, handler, *mysqlConnReadTimeout, *mysqlConnWriteTimeout)
+		if err != nil {
+			return nil, err
+		}
+		listener.TLSConfig, listener.AllowClearTextWithoutTLS = tlsConfig, *mysqlAllowClearTextWithoutTLS
+		return listener, nil
+	}
+	l, err := net.Listen(protocol, address)
+	if err != nil {
+		return nil, err
+	}
+	// the connection ids are counted by each listener, every one has its own registry
+	sh := newStmtHandler(vh, nil)
+	gateAuth, ok := authSrv.(*authServer)
+	if ok {
+		sh.tlsConfig = tlsConfig
+		authSrv = &authServer{users: gateAuth.users, conns: sh}
+	}
+	listener, err := mysql.NewFromListener(&stmtListener{Listener: l, handler: sh}, authSrv, sh, *mysqlConnReadTimeout, *mysqlConnWriteTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		// vitess sees the connections in the clear
+		listener.AllowClearTextWithoutTLS = true
+	} else {
+		listener.TLSConfig, listener.AllowClearTextWithoutTLS = tlsConfig, *mysqlAllowClearTextWithoutTLS
+	}
+	return listener, nil
+}
+
 // newMysqlUnixSocket creates a new unix socket mysql listener. If a socket file already exists, attempts
 // to clean it up.
 func newMysqlUnixSocket(address string, authServer mysql.AuthServer, handler mysql.Handler) (*mysql.Listener, error) {
-	listener, err := mysql.NewListener("unix", address, authServer, handler, *mysqlConnReadTimeout, *mysqlConnWriteTimeout)
+	listener, err := newListener("unix", address, authServer, handler, nil)
 	switch err := err.(type) {
 	case nil:
 		return listener, nil
@@ -202,7 +332,7 @@ func newMysqlUnixSocket(address string, authServer mysql.AuthServer, handler mys
 			log.Error("Couldn't remove existent socket file: %s", address)
 			return nil, err
 		}
-		listener, listenerErr := mysql.NewListener("unix", address, authServer, handler, *mysqlConnReadTimeout, *mysqlConnWriteTimeout)
+		listener, listenerErr := newListener("unix", address, authServer, handler, nil)
 		return listener, listenerErr
 	default:
 		return nil, err
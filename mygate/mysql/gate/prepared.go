@@ -0,0 +1,720 @@
+package mysql
+
+import (
+	"container/list"
+	"flag"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/tiglabs/baudengine/util/log"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	mysqlPlanCacheSize   = flag.Int("mysql_plan_cache_size", 256, "The most plans of the prepared statements a connection caches.")
+	mysqlCursorKeepAlive = flag.Duration("mysql_cursor_keep_alive", 5*time.Minute, "How long the partitions keep the snapshot of a cursor between its fetches.")
+)
+
+// A prepared statement is normalized, its literals are turned into bind variables and the plan of
+// the normalized SQL is cached by the connection: the prepares of the same statement but for the
+// literals share it. At the execution the normalized SQL is parsed again and the ? of the
+// statement and its literals are bound into the parsed statement as values, they are never
+// written into SQL. A cursor fetches the rows of a SELECT in windows of -mysql_max_rows, the
+// statement is not bound by it. The windows are searched on the snapshot of a scroll the first
+// one opens (see the scroll of the router), the rows written between the fetches are not seen.
+// The scroll is closed with the cursor, or expires after -mysql_cursor_keep_alive.
+//
+// The listener of vitess v2.2 does not hand COM_STMT_PREPARE, COM_STMT_EXECUTE, COM_STMT_FETCH
+// and COM_STMT_CLOSE to the handler, the stmtConn of the connection serves them with the
+// gateHandler methods (see prepared_conn.go). The gate matches the statements below itself, they
+// prepare and execute the statements over COM_QUERY:
+//   PREPARE stmt FROM {'sql' | @variable}
+//   EXECUTE stmt [USING @variable [, @variable] ...]
+//   {DEALLOCATE | DROP} PREPARE stmt
+// and SET @variable = literal sets a user variable of the connection.
+
+// erUnsupportedPS is the error number of MySQL for a statement that can not be prepared
+const erUnsupportedPS = 1295
+
+const (
+	stringPattern   = `('(?:[^'\\]|\\.|'')*'|"(?:[^"\\]|\\.|"")*")`
+	variablePattern = `@[\w$.]+`
+)
+
+var (
+	preparePattern    = regexp.MustCompile(`(?is)^\s*prepare\s+` + namePattern + `\s+from\s+(?:` + stringPattern + `|(` + variablePattern + `))\s*$`)
+	executePattern    = regexp.MustCompile(`(?is)^\s*execute\s+` + namePattern + `(?:\s+using\s+(` + variablePattern + `(?:\s*,\s*` + variablePattern + `)*))?\s*$`)
+	deallocatePattern = regexp.MustCompile(`(?is)^\s*(?:deallocate|drop)\s+prepare\s+` + namePattern + `\s*$`)
+)
+
+// prepareStatement prepares the SQL or the SQL of the user variable as the statement of the name.
+type prepareStatement struct {
+	name     string
+	sql      string
+	variable string
+}
+
+type executeStatement struct {
+	name      string
+	variables []string
+}
+
+type deallocateStatement struct {
+	name string
+}
+
+// parsePreparedStatement parses PREPARE, EXECUTE and DEALLOCATE PREPARE, ok is false for the
+// others.
+func parsePreparedStatement(sql string) (stmt interface{}, ok bool, err error) {
+	if m := preparePattern.FindStringSubmatch(sql); m != nil {
+		prepare := &prepareStatement{name: strings.ToLower(unquote(m[1])), variable: strings.ToLower(m[3])}
+		if m[2] != "" {
+			// the parser unescapes the string
+			parsed, err := sqlparser.Parse("select " + m[2])
+			if err != nil {
+				return nil, true, err
+			}
+			v, err := literal(parsed.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr)
+			if err != nil {
+				return nil, true, err
+			}
+			prepare.sql = v.(string)
+		}
+		return prepare, true, nil
+	}
+	if m := executePattern.FindStringSubmatch(sql); m != nil {
+		execute := &executeStatement{name: strings.ToLower(unquote(m[1]))}
+		if m[2] != "" {
+			for _, variable := range strings.Split(m[2], ",") {
+				execute.variables = append(execute.variables, strings.ToLower(strings.TrimSpace(variable)))
+			}
+		}
+		return execute, true, nil
+	}
+	if m := deallocatePattern.FindStringSubmatch(sql); m != nil {
+		return &deallocateStatement{name: strings.ToLower(unquote(m[1]))}, true, nil
+	}
+	return nil, false, nil
+}
+
+// plan is a normalized statement, the ? of the statement are the bind variables v1 to vN the
+// parser names them, its literals are bv1, bv2 and so on. The statements the gate matches itself
+// are not normalized, they run as they are.
+type plan struct {
+	key        string
+	normalized bool
+	params     int
+}
+
+// planCache keeps the plans of a connection, the least recently used one is dropped beyond size.
+type planCache struct {
+	size  int
+	lru   *list.List
+	plans map[string]*list.Element
+}
+
+func newPlanCache(size int) *planCache {
+	return &planCache{size: size, lru: list.New(), plans: make(map[string]*list.Element)}
+}
+
+func (pc *planCache) get(key string) *plan {
+	elem, ok := pc.plans[key]
+	if !ok {
+		return nil
+	}
+	pc.lru.MoveToFront(elem)
+	return elem.Value.(*plan)
+}
+
+func (pc *planCache) add(p *plan) {
+	pc.plans[p.key] = pc.lru.PushFront(p)
+	for pc.lru.Len() > pc.size {
+		oldest := pc.lru.Back()
+		pc.lru.Remove(oldest)
+		delete(pc.plans, oldest.Value.(*plan).key)
+	}
+}
+
+// preparedStatement is a statement prepared by the connection, of the plan and the values of the
+// literals of the statement. fields are the columns of its result known when it is prepared.
+type preparedStatement struct {
+	id       uint32
+	plan     *plan
+	literals map[string]*querypb.BindVariable
+	fields   []*querypb.Field
+	cursor   *cursor
+}
+
+// session is the state of a connection, kept in its ClientData: its prepared statements, the
+// names PREPARE gave them and its user variables.
+type session struct {
+	plans     *planCache
+	lastID    uint32
+	stmts     map[uint32]*preparedStatement
+	names     map[string]*preparedStatement
+	variables map[string]*querypb.BindVariable
+}
+
+func newSession() *session {
+	return &session{
+		plans:     newPlanCache(*mysqlPlanCacheSize),
+		stmts:     make(map[uint32]*preparedStatement),
+		names:     make(map[string]*preparedStatement),
+		variables: make(map[string]*querypb.BindVariable),
+	}
+}
+
+// sessionOf returns the session of the connection.
+func sessionOf(c *mysql.Conn) *session {
+	s, ok := c.ClientData.(*session)
+	if !ok {
+		s = newSession()
+		c.ClientData = s
+	}
+	return s
+}
+
+func (s *session) statement(id uint32, command string) (*preparedStatement, error) {
+	ps, ok := s.stmts[id]
+	if !ok {
+		return nil, mysql.NewSQLError(mysql.ERUnknownStmtHandler, mysql.SSUnknownSQLState, "Unknown prepared statement handler (%d) given to %s", id, command)
+	}
+	return ps, nil
+}
+
+func (s *session) close(ps *preparedStatement) {
+	delete(s.stmts, ps.id)
+	for name, named := range s.names {
+		if named == ps {
+			delete(s.names, name)
+		}
+	}
+}
+
+// prepare normalizes the statement and returns it prepared with the plan of the connection, the
+// statements the gate matches itself are taken as they are.
+func (e *executor) prepare(ctx context.Context, c *mysql.Conn, sql string) (*preparedStatement, error) {
+	s := sessionOf(c)
+	key := sql
+	literals := make(map[string]*querypb.BindVariable)
+	var parsed sqlparser.Statement
+	stmt, ok, err := parseGateStatement(sql)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		switch stmt.(type) {
+		case *prepareStatement, *executeStatement, *deallocateStatement:
+			return nil, mysql.NewSQLError(erUnsupportedPS, mysql.SSUnknownSQLState, "This command is not supported in the prepared statement protocol yet")
+		}
+	} else {
+		if parsed, err = sqlparser.ParseStrictDDL(sql); err != nil {
+			return nil, err
+		}
+		sqlparser.Normalize(parsed, literals, "bv")
+		key = sqlparser.String(parsed)
+	}
+
+	p := s.plans.get(key)
+	if p == nil {
+		p = &plan{key: key, normalized: parsed != nil}
+		if parsed != nil {
+			for name := range sqlparser.GetBindvars(parsed) {
+				if n, err := strconv.Atoi(strings.TrimPrefix(name, "v")); err == nil && name[0] == 'v' && n > p.params {
+					p.params = n
+				}
+			}
+		}
+		s.plans.add(p)
+	}
+	s.lastID++
+	ps := &preparedStatement{id: s.lastID, plan: p, literals: literals}
+	if parsed != nil {
+		ps.fields = e.preparedFields(ctx, c.SchemaName, parsed)
+	}
+	s.stmts[ps.id] = ps
+	return ps, nil
+}
+
+// preparedFields returns the columns of the result of a SELECT before it runs, nil if they are
+// not known: the columns of SELECT * of a table without definition are told by its rows. The type
+// of a column is its declared one, else VARCHAR, the execution tells the types of the values.
+func (e *executor) preparedFields(ctx context.Context, db string, stmt sqlparser.Statement) []*querypb.Field {
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil
+	}
+	var t *table
+	if _, ok := informationSchemaTable(db, sel.From); !ok && !selectsDual(sel.From) {
+		t, _ = e.tableOf(ctx, db, sel.From)
+	}
+	var fields []*querypb.Field
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			if t == nil || t.def == nil {
+				return nil
+			}
+			for _, col := range t.def.Columns {
+				fields = append(fields, preparedField(t, col.Name, col.Name))
+			}
+		case *sqlparser.AliasedExpr:
+			name, ok := columnName(expr.Expr)
+			column := name
+			if !ok {
+				name = sqlparser.String(expr.Expr)
+			}
+			if !expr.As.IsEmpty() {
+				name = expr.As.String()
+			}
+			fields = append(fields, preparedField(t, name, column))
+		default:
+			return nil
+		}
+	}
+	return fields
+}
+
+func preparedField(t *table, name, column string) *querypb.Field {
+	field := &querypb.Field{Name: name, Type: sqltypes.VarChar, OrgName: column}
+	if t != nil {
+		field.Table, field.OrgTable, field.Database = t.name, t.name, t.db
+		if t.def != nil {
+			if def := t.def.column(column); def != nil && def.sqlType() != 0 {
+				field.Type = def.sqlType()
+			}
+		}
+	}
+	field.Charset = columnCharset(field.Type)
+	return field
+}
+
+// bind returns the prepared statement parsed with the values of its parameters bound.
+func (ps *preparedStatement) bind(params []*querypb.BindVariable) (sqlparser.Statement, error) {
+	if len(params) != ps.plan.params {
+		return nil, mysql.NewSQLError(mysql.ERWrongArguments, mysql.SSUnknownSQLState, "Incorrect arguments to EXECUTE")
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(ps.literals)+len(params))
+	for name, bv := range ps.literals {
+		bindVars[name] = bv
+	}
+	for i, bv := range params {
+		bindVars[fmt.Sprintf("v%d", i+1)] = bv
+	}
+	stmt, err := sqlparser.ParseStrictDDL(ps.plan.key)
+	if err != nil {
+		return nil, err
+	}
+	if err := bindStatement(stmt, bindVars); err != nil {
+		return nil, mysql.NewSQLError(mysql.ERWrongArguments, mysql.SSUnknownSQLState, "Incorrect arguments to EXECUTE: %v", err)
+	}
+	return stmt, nil
+}
+
+// bindStatement replaces the bind variables of the statement by their values. A value is bound
+// in place, as the normalizer of the parser turned it into a bind variable, a NULL or a list is
+// bound into the expression holding it.
+func bindStatement(stmt sqlparser.Statement, bindVars map[string]*querypb.BindVariable) error {
+	var bindErr error
+	bind := func(expr sqlparser.Expr) sqlparser.Expr {
+		if bindErr != nil {
+			return expr
+		}
+		bound, err := boundExpr(expr, bindVars)
+		if err != nil {
+			bindErr = err
+			return expr
+		}
+		return bound
+	}
+	bindExprs := func(exprs []sqlparser.Expr) {
+		for i := range exprs {
+			exprs[i] = bind(exprs[i])
+		}
+	}
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.SQLVal:
+			if bound, ok := bind(node).(*sqlparser.SQLVal); ok {
+				*node = *bound
+			}
+		case *sqlparser.ComparisonExpr:
+			node.Left, node.Right = bind(node.Left), bind(node.Right)
+		case *sqlparser.RangeCond:
+			node.Left, node.From, node.To = bind(node.Left), bind(node.From), bind(node.To)
+		case *sqlparser.AndExpr:
+			node.Left, node.Right = bind(node.Left), bind(node.Right)
+		case *sqlparser.OrExpr:
+			node.Left, node.Right = bind(node.Left), bind(node.Right)
+		case *sqlparser.NotExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.IsExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.ParenExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.BinaryExpr:
+			node.Left, node.Right = bind(node.Left), bind(node.Right)
+		case *sqlparser.UnaryExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.CaseExpr:
+			node.Expr, node.Else = bind(node.Expr), bind(node.Else)
+		case *sqlparser.When:
+			node.Cond, node.Val = bind(node.Cond), bind(node.Val)
+		case *sqlparser.ConvertExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.MatchExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.AliasedExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.UpdateExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.SetExpr:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.Where:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.Order:
+			node.Expr = bind(node.Expr)
+		case *sqlparser.Limit:
+			node.Offset, node.Rowcount = bind(node.Offset), bind(node.Rowcount)
+		case sqlparser.ValTuple:
+			bindExprs(node)
+		case sqlparser.GroupBy:
+			bindExprs(node)
+		}
+		return bindErr == nil, bindErr
+	}, stmt)
+	if err != nil {
+		return err
+	}
+	// a bind variable left is in an expression the gate does not bind into
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.SQLVal:
+			if node.Type == sqlparser.ValArg {
+				return false, fmt.Errorf("bind variable %s is not supported in %s", node.Val, sqlparser.String(stmt))
+			}
+		case sqlparser.ListArg:
+			return false, fmt.Errorf("bind variable %s is not supported in %s", node, sqlparser.String(stmt))
+		}
+		return true, nil
+	}, stmt)
+}
+
+// boundExpr returns the value of the bind variable of the expression, the other expressions are
+// returned as they are.
+func boundExpr(expr sqlparser.Expr, bindVars map[string]*querypb.BindVariable) (sqlparser.Expr, error) {
+	var name string
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		if expr.Type != sqlparser.ValArg {
+			return expr, nil
+		}
+		name = strings.TrimPrefix(string(expr.Val), ":")
+	case sqlparser.ListArg:
+		name = strings.TrimPrefix(string(expr), "::")
+	default:
+		return expr, nil
+	}
+	bv, ok := bindVars[name]
+	if !ok {
+		return nil, fmt.Errorf("missing bind variable %s", name)
+	}
+	if bv.Type == querypb.Type_TUPLE {
+		if _, ok := expr.(sqlparser.ListArg); !ok {
+			return nil, fmt.Errorf("list bind variable %s used as a value", name)
+		}
+		tuple := make(sqlparser.ValTuple, 0, len(bv.Values))
+		for _, v := range bv.Values {
+			tuple = append(tuple, valueExpr(v.Type, v.Value))
+		}
+		return tuple, nil
+	}
+	if _, ok := expr.(sqlparser.ListArg); ok {
+		return nil, fmt.Errorf("value bind variable %s used as a list", name)
+	}
+	return valueExpr(bv.Type, bv.Value), nil
+}
+
+// valueExpr returns the literal of the value, as the parser returns the literal of its SQL.
+func valueExpr(typ querypb.Type, value []byte) sqlparser.Expr {
+	switch {
+	case typ == querypb.Type_NULL_TYPE:
+		return &sqlparser.NullVal{}
+	case sqltypes.IsIntegral(typ):
+		return sqlparser.NewIntVal(value)
+	case sqltypes.IsFloat(typ) || typ == querypb.Type_DECIMAL:
+		return sqlparser.NewFloatVal(value)
+	}
+	return sqlparser.NewStrVal(value)
+}
+
+// executePrepared executes the prepared statement with the values of its parameters. The result
+// of a SELECT executed with a cursor has no rows, they are fetched from the cursor.
+func (e *executor) executePrepared(ctx context.Context, c *mysql.Conn, ps *preparedStatement, params []*querypb.BindVariable, withCursor bool) (*sqltypes.Result, error) {
+	e.closeCursor(ctx, ps)
+	if !ps.plan.normalized {
+		if len(params) != 0 {
+			return nil, mysql.NewSQLError(mysql.ERWrongArguments, mysql.SSUnknownSQLState, "Incorrect arguments to EXECUTE")
+		}
+		return e.run(ctx, c, ps.plan.key)
+	}
+	stmt, err := ps.bind(params)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !withCursor || !ok {
+		// a cursor is only of a SELECT
+		return e.runStatement(ctx, c, stmt)
+	}
+	if err := e.authorize(ctx, c, sel); err != nil {
+		return nil, err
+	}
+	cur, err := e.openCursor(ctx, c.SchemaName, sel)
+	if err != nil {
+		return nil, err
+	}
+	ps.cursor = cur
+	return &sqltypes.Result{Fields: cur.fields}, nil
+}
+
+// fetch returns the next rows of the cursor of the prepared statement, the result has no rows
+// once the cursor is done.
+func (e *executor) fetch(ctx context.Context, ps *preparedStatement, rows int) (*sqltypes.Result, error) {
+	if ps.cursor == nil {
+		return nil, mysql.NewSQLError(mysql.ERUnknownStmtHandler, mysql.SSUnknownSQLState, "statement %d has no open cursor", ps.id)
+	}
+	return ps.cursor.next(ctx, e, rows)
+}
+
+// closeCursor drops the cursor of the statement and closes its scroll.
+func (e *executor) closeCursor(ctx context.Context, ps *preparedStatement) {
+	if ps.cursor != nil {
+		ps.cursor.close(ctx, e)
+		ps.cursor = nil
+	}
+}
+
+// scroll is the search snapshot of a cursor, opened by the search of its first window.
+type scroll struct {
+	db, space string
+	id        string
+}
+
+type scrollKey struct{}
+
+// withScroll returns the context whose searches read the snapshot of the scroll.
+func withScroll(ctx context.Context, s *scroll) context.Context {
+	return context.WithValue(ctx, scrollKey{}, s)
+}
+
+func scrollOf(ctx context.Context) *scroll {
+	s, _ := ctx.Value(scrollKey{}).(*scroll)
+	return s
+}
+
+// cursor reads the rows of a SELECT in windows of the LIMIT of the statement, the rows of a
+// window are kept until fetched. The windows are searched on the snapshot of the scroll of the
+// cursor. The fields are the ones of the first window, the columns of a table without a
+// definition the rows of the later windows add are dropped.
+type cursor struct {
+	db  string
+	sel *sqlparser.Select
+	// the window of the LIMIT of the statement, count is -1 without
+	offset, count int
+	read          int
+	done          bool
+	scroll        *scroll
+	fields        []*querypb.Field
+	rows          [][]sqltypes.Value
+}
+
+func (e *executor) openCursor(ctx context.Context, db string, sel *sqlparser.Select) (*cursor, error) {
+	offset, count, err := limitWindow(sel.Limit)
+	if err != nil {
+		return nil, err
+	}
+	cur := &cursor{db: db, sel: sel, offset: offset, count: count, scroll: &scroll{}}
+	if _, ok := informationSchemaTable(db, sel.From); ok || selectsDual(sel.From) || isAggregate(sel) {
+		// the rows are read at once
+		result, err := e.execSelect(ctx, db, sel)
+		if err != nil {
+			return nil, err
+		}
+		cur.fields, cur.rows, cur.done = result.Fields, result.Rows, true
+		return cur, nil
+	}
+	if err := cur.window(ctx, e); err != nil {
+		return nil, err
+	}
+	return cur, nil
+}
+
+// window reads the next window of the rows.
+func (cur *cursor) window(ctx context.Context, e *executor) error {
+	size := e.maxRows
+	if cur.count >= 0 && cur.count-cur.read < size {
+		size = cur.count - cur.read
+	}
+	if size <= 0 {
+		cur.done = true
+		cur.close(ctx, e)
+		return nil
+	}
+	cur.sel.Limit = &sqlparser.Limit{
+		Offset:   sqlparser.NewIntVal([]byte(strconv.Itoa(cur.offset + cur.read))),
+		Rowcount: sqlparser.NewIntVal([]byte(strconv.Itoa(size))),
+	}
+	result, err := e.execSelect(withScroll(ctx, cur.scroll), cur.db, cur.sel)
+	if err != nil {
+		return err
+	}
+	cur.read += len(result.Rows)
+	if cur.done = len(result.Rows) < size; cur.done {
+		cur.close(ctx, e)
+	}
+	if cur.fields == nil {
+		cur.fields = result.Fields
+		cur.rows = result.Rows
+		return nil
+	}
+	positions := make(map[string]int, len(result.Fields))
+	for i, field := range result.Fields {
+		positions[field.Name] = i
+	}
+	for _, row := range result.Rows {
+		values := make([]sqltypes.Value, len(cur.fields))
+		for i, field := range cur.fields {
+			if j, ok := positions[field.Name]; ok {
+				values[i] = row[j]
+			}
+		}
+		cur.rows = append(cur.rows, values)
+	}
+	return nil
+}
+
+func (cur *cursor) next(ctx context.Context, e *executor, rows int) (*sqltypes.Result, error) {
+	for len(cur.rows) < rows && !cur.done {
+		if err := cur.window(ctx, e); err != nil {
+			return nil, err
+		}
+	}
+	if rows > len(cur.rows) {
+		rows = len(cur.rows)
+	}
+	result := &sqltypes.Result{Fields: cur.fields, Rows: cur.rows[:rows]}
+	cur.rows = cur.rows[rows:]
+	return result, nil
+}
+
+// exhausted is true once the last row of the cursor is fetched.
+func (cur *cursor) exhausted() bool {
+	return cur.done && len(cur.rows) == 0
+}
+
+// close closes the scroll of the cursor, the partitions would keep its snapshots until it
+// expires.
+func (cur *cursor) close(ctx context.Context, e *executor) {
+	if cur.scroll == nil || cur.scroll.id == "" {
+		return
+	}
+	if err := e.closeScroll(ctx, cur.scroll); err != nil {
+		log.Warn("close the scroll of the cursor on %s.%s error: %v", cur.scroll.db, cur.scroll.space, err)
+	}
+	cur.scroll = nil
+}
+
+func (e *executor) execPrepare(ctx context.Context, c *mysql.Conn, stmt *prepareStatement) (*sqltypes.Result, error) {
+	s := sessionOf(c)
+	sql := stmt.sql
+	if stmt.variable != "" {
+		bv, ok := s.variables[stmt.variable]
+		if !ok || bv.Type == querypb.Type_NULL_TYPE {
+			return nil, mysql.NewSQLError(mysql.ERSyntaxError, "42000", "You have an error in your SQL syntax near 'NULL'")
+		}
+		sql = string(bv.Value)
+	}
+	ps, err := e.prepare(ctx, c, sql)
+	if err != nil {
+		return nil, err
+	}
+	// a statement prepared again under the name replaces the former one
+	if former, ok := s.names[stmt.name]; ok {
+		e.closeCursor(ctx, former)
+		s.close(former)
+	}
+	s.names[stmt.name] = ps
+	return &sqltypes.Result{}, nil
+}
+
+func (e *executor) execExecute(ctx context.Context, c *mysql.Conn, stmt *executeStatement) (*sqltypes.Result, error) {
+	s := sessionOf(c)
+	ps, ok := s.names[stmt.name]
+	if !ok {
+		return nil, mysql.NewSQLError(mysql.ERUnknownStmtHandler, mysql.SSUnknownSQLState, "Unknown prepared statement handler (%s) given to EXECUTE", stmt.name)
+	}
+	params := make([]*querypb.BindVariable, len(stmt.variables))
+	for i, variable := range stmt.variables {
+		// a variable never set is NULL
+		if params[i] = s.variables[variable]; params[i] == nil {
+			params[i] = sqltypes.ValueBindVariable(sqltypes.NULL)
+		}
+	}
+	return e.executePrepared(ctx, c, ps, params, false)
+}
+
+func (e *executor) execDeallocate(ctx context.Context, c *mysql.Conn, stmt *deallocateStatement) (*sqltypes.Result, error) {
+	s := sessionOf(c)
+	ps, ok := s.names[stmt.name]
+	if !ok {
+		return nil, mysql.NewSQLError(mysql.ERUnknownStmtHandler, mysql.SSUnknownSQLState, "Unknown prepared statement handler (%s) given to DEALLOCATE PREPARE", stmt.name)
+	}
+	e.closeCursor(ctx, ps)
+	s.close(ps)
+	return &sqltypes.Result{}, nil
+}
+
+// setVariables sets the user variables of the SET, the session variables of the drivers are
+// accepted and ignored.
+func setVariables(c *mysql.Conn, set *sqlparser.Set) error {
+	for _, expr := range set.Exprs {
+		name := expr.Name.Lowered()
+		if !strings.HasPrefix(name, "@") || strings.HasPrefix(name, "@@") {
+			continue
+		}
+		v, err := literal(expr.Expr)
+		if err != nil {
+			return err
+		}
+		bv, err := bindVariable(v)
+		if err != nil {
+			return err
+		}
+		sessionOf(c).variables[name] = bv
+	}
+	return nil
+}
+
+// bindVariable returns the bind variable of a literal.
+func bindVariable(v interface{}) (*querypb.BindVariable, error) {
+	switch v := v.(type) {
+	case nil:
+		return sqltypes.ValueBindVariable(sqltypes.NULL), nil
+	case bool:
+		if v {
+			return sqltypes.Int64BindVariable(1), nil
+		}
+		return sqltypes.Int64BindVariable(0), nil
+	}
+	return sqltypes.BuildBindVariable(v)
+}
@@ -0,0 +1,926 @@
+package mysql
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"sync"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The listener wraps every connection in a stmtConn, which reads the packets of the client before
+// the vitess connection does: the COM_STMT_* commands are served in the binary protocol and never
+// reach vitess, the other packets are passed on as they are. The connection id in the handshake
+// of the server finds the mysql.Conn of the connection in the registry of the stmtHandler of the
+// listener. With a TLS config the stmtConn terminates the TLS of the connection: vitess is told
+// nothing of it, the sequences of the packets of the connection phase are shifted by the request
+// to switch to TLS which it does not see. Without one, the packets of a connection switching to
+// TLS are passed on.
+
+const (
+	comStmtPrepare      = 0x16
+	comStmtExecute      = 0x17
+	comStmtSendLongData = 0x18
+	comStmtClose        = 0x19
+	comStmtReset        = 0x1a
+	comStmtFetch        = 0x1c
+
+	capabilityClientSSL          = 0x00000800
+	capabilityClientDeprecateEOF = 0x01000000
+
+	serverStatusAutocommit   = 0x0002
+	serverStatusCursorExists = 0x0040
+	serverStatusLastRowSent  = 0x0080
+
+	// the cursor types of COM_STMT_EXECUTE, read only, for update and scrollable
+	cursorTypeMask = 0x07
+
+	maxPacketSize = 1<<24 - 1
+)
+
+// the column types of the binary protocol
+const (
+	typeTiny       = 0x01
+	typeShort      = 0x02
+	typeLong       = 0x03
+	typeFloat      = 0x04
+	typeDouble     = 0x05
+	typeNull       = 0x06
+	typeTimestamp  = 0x07
+	typeLongLong   = 0x08
+	typeInt24      = 0x09
+	typeDate       = 0x0a
+	typeTime       = 0x0b
+	typeDateTime   = 0x0c
+	typeYear       = 0x0d
+	typeDecimal    = 0x00
+	typeNewDecimal = 0xf6
+	typeVarString  = 0xfd
+
+	unsignedFlag  = 0x20
+	binaryFlag    = 0x80
+	utf8Charset   = 33
+	binaryCharset = 63
+)
+
+var errMalformedPacket = mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "malformed packet")
+
+// stmtListener wraps the connections it accepts in stmtConns.
+type stmtListener struct {
+	net.Listener
+	handler *stmtHandler
+}
+
+func (l *stmtListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return newStmtConn(conn, l.handler), nil
+}
+
+// stmtHandler is the gateHandler of a listener, it keeps the connections of the listener by their
+// id for their stmtConns, and the ids of the connections over TLS for the authServer.
+type stmtHandler struct {
+	*gateHandler
+	tlsConfig *tls.Config
+	mu        sync.Mutex
+	conns     map[uint32]*mysql.Conn
+	secured   map[uint32]bool
+}
+
+func newStmtHandler(vh *gateHandler, tlsConfig *tls.Config) *stmtHandler {
+	return &stmtHandler{
+		gateHandler: vh,
+		tlsConfig:   tlsConfig,
+		conns:       make(map[uint32]*mysql.Conn),
+		secured:     make(map[uint32]bool),
+	}
+}
+
+func (sh *stmtHandler) NewConnection(c *mysql.Conn) {
+	sh.gateHandler.NewConnection(c)
+	sh.mu.Lock()
+	sh.conns[c.ConnectionID] = c
+	sh.mu.Unlock()
+}
+
+func (sh *stmtHandler) ConnectionClosed(c *mysql.Conn) {
+	sh.mu.Lock()
+	delete(sh.conns, c.ConnectionID)
+	sh.mu.Unlock()
+	sh.gateHandler.ConnectionClosed(c)
+}
+
+func (sh *stmtHandler) conn(id uint32) *mysql.Conn {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.conns[id]
+}
+
+func (sh *stmtHandler) setSecure(id uint32, secure bool) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if secure {
+		sh.secured[id] = true
+	} else {
+		delete(sh.secured, id)
+	}
+}
+
+// secure tells if the connection switched to TLS.
+func (sh *stmtHandler) secure(id uint32) bool {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.secured[id]
+}
+
+// stmtParams keeps what COM_STMT_EXECUTE of a statement does not repeat: the number of its
+// parameters, the types last sent and the values sent by COM_STMT_SEND_LONG_DATA.
+type stmtParams struct {
+	count    int
+	types    []byte
+	longData map[int][]byte
+}
+
+// stmtConn serves the COM_STMT_* commands of a connection, the vitess connection reads the other
+// packets from it. Both run in the goroutine of the vitess connection: a command is served while
+// vitess waits for the next one, its replies are never mixed with the ones of vitess.
+type stmtConn struct {
+	net.Conn
+	handler *stmtHandler
+	reader  *bufio.Reader
+	writer  *bufio.Writer
+	// the bytes of the packets passed on which vitess has not read yet
+	pending []byte
+	// the TLS terminated by the stmtConn, the shift of the sequences of the connection phase and
+	// the bytes left of the packet vitess is writing
+	tlsConn   *tls.Conn
+	shift     uint8
+	writeLeft int
+
+	connID        uint32
+	serverCaps    uint32
+	handshook     bool
+	clientReplied bool
+	deprecateEOF  bool
+	passThrough   bool
+	// the last packet passed on is continued by the next one
+	continued bool
+
+	// the sequence of the next packet of the reply
+	seq   uint8
+	stmts map[uint32]*stmtParams
+}
+
+func newStmtConn(conn net.Conn, handler *stmtHandler) *stmtConn {
+	return &stmtConn{
+		Conn:    conn,
+		handler: handler,
+		reader:  bufio.NewReader(conn),
+		writer:  bufio.NewWriter(conn),
+		stmts:   make(map[uint32]*stmtParams),
+	}
+}
+
+func (sc *stmtConn) Read(p []byte) (int, error) {
+	for len(sc.pending) == 0 {
+		if sc.passThrough {
+			return sc.reader.Read(p)
+		}
+		header, payload, err := sc.readPacket()
+		if err != nil {
+			return 0, err
+		}
+		served, err := sc.intercept(header, payload)
+		if err != nil {
+			return 0, err
+		}
+		if !served {
+			header[3] -= sc.shift
+			sc.pending = append(header, payload...)
+		}
+	}
+	n := copy(p, sc.pending)
+	sc.pending = sc.pending[n:]
+	return n, nil
+}
+
+func (sc *stmtConn) Write(p []byte) (int, error) {
+	n := len(p)
+	if !sc.handshook {
+		sc.handshook = true
+		p = sc.parseHandshake(p)
+	} else if sc.shift != 0 {
+		p = sc.shiftWrite(p)
+	}
+	if sc.tlsConn != nil {
+		_, err := sc.tlsConn.Write(p)
+		return n, err
+	}
+	_, err := sc.Conn.Write(p)
+	return n, err
+}
+
+func (sc *stmtConn) Close() error {
+	sc.handler.setSecure(sc.connID, false)
+	return sc.Conn.Close()
+}
+
+// parseHandshake reads the connection id and the capabilities of the handshake v10 of the server:
+// the protocol version, the server version ended by 0, the connection id, 8 bytes of the salt, a
+// filler, the lower capabilities, the charset, the status and the upper capabilities. With a TLS
+// config, the handshake returned offers the switch to TLS.
+func (sc *stmtConn) parseHandshake(p []byte) []byte {
+	if len(p) < 5 || p[4] != 10 {
+		sc.passThrough = true
+		return p
+	}
+	data := p[5:]
+	end := bytes.IndexByte(data, 0)
+	if end < 0 || len(data) < end+1+4+8+1+7 {
+		sc.passThrough = true
+		return p
+	}
+	data = data[end+1:]
+	sc.connID = binary.LittleEndian.Uint32(data)
+	data = data[4+8+1:]
+	sc.serverCaps = uint32(binary.LittleEndian.Uint16(data)) | uint32(binary.LittleEndian.Uint16(data[5:]))<<16
+	if sc.handler.tlsConfig == nil {
+		return p
+	}
+	p = append([]byte(nil), p...)
+	caps := p[5+end+1+4+8+1:]
+	binary.LittleEndian.PutUint16(caps, binary.LittleEndian.Uint16(caps)|capabilityClientSSL)
+	sc.serverCaps |= capabilityClientSSL
+	return p
+}
+
+// shiftWrite returns the packets of vitess with their sequences shifted, the OK or the ERR packet
+// ending the connection phase ends the shift. The header of a packet is written at once.
+func (sc *stmtConn) shiftWrite(p []byte) []byte {
+	p = append([]byte(nil), p...)
+	for i := 0; i < len(p) && sc.shift != 0; {
+		if sc.writeLeft > 0 {
+			n := len(p) - i
+			if n > sc.writeLeft {
+				n = sc.writeLeft
+			}
+			i, sc.writeLeft = i+n, sc.writeLeft-n
+			continue
+		}
+		if len(p)-i < 4 {
+			break
+		}
+		sc.writeLeft = int(p[i]) | int(p[i+1])<<8 | int(p[i+2])<<16
+		p[i+3] += sc.shift
+		i += 4
+		if sc.writeLeft > 0 && i < len(p) && (p[i] == 0x00 || p[i] == 0xff) {
+			sc.shift = 0
+		}
+	}
+	return p
+}
+
+// startTLS switches the connection to TLS, the bytes of the client already read are the start of
+// its handshake.
+func (sc *stmtConn) startTLS() error {
+	tlsConn := tls.Server(&bufferedConn{Conn: sc.Conn, reader: sc.reader}, sc.handler.tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		return err
+	}
+	sc.tlsConn = tlsConn
+	sc.reader, sc.writer = bufio.NewReader(tlsConn), bufio.NewWriter(tlsConn)
+	sc.shift = 1
+	sc.handler.setSecure(sc.connID, true)
+	return nil
+}
+
+// bufferedConn reads the connection through its reader.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (bc *bufferedConn) Read(p []byte) (int, error) {
+	return bc.reader.Read(p)
+}
+
+func (sc *stmtConn) readPacket() (header, payload []byte, err error) {
+	header = make([]byte, 4)
+	if _, err = io.ReadFull(sc.reader, header); err != nil {
+		return nil, nil, err
+	}
+	payload = make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+	if _, err = io.ReadFull(sc.reader, payload); err != nil {
+		return nil, nil, err
+	}
+	return header, payload, nil
+}
+
+// intercept serves the packet if it is a COM_STMT_* command, a command is the first packet of
+// sequence 0 once the client replied the handshake.
+func (sc *stmtConn) intercept(header, payload []byte) (bool, error) {
+	continued := sc.continued
+	sc.continued = len(payload) == maxPacketSize
+	if !sc.clientReplied {
+		// the handshake response, or the request to switch to TLS
+		if len(payload) < 4 {
+			sc.clientReplied = true
+			return false, nil
+		}
+		caps := binary.LittleEndian.Uint32(payload)
+		if caps&capabilityClientSSL != 0 && sc.handler.tlsConfig != nil {
+			if sc.tlsConn == nil {
+				return true, sc.startTLS()
+			}
+			// vitess reads the response in the clear
+			binary.LittleEndian.PutUint32(payload, caps&^capabilityClientSSL)
+		}
+		sc.clientReplied = true
+		sc.deprecateEOF = caps&sc.serverCaps&capabilityClientDeprecateEOF != 0
+		sc.passThrough = caps&capabilityClientSSL != 0 && sc.tlsConn == nil
+		return false, nil
+	}
+	if continued || header[3] != 0 || len(payload) == 0 {
+		return false, nil
+	}
+	switch payload[0] {
+	case comStmtPrepare, comStmtExecute, comStmtSendLongData, comStmtClose, comStmtReset, comStmtFetch:
+	default:
+		return false, nil
+	}
+
+	seq := header[3]
+	for sc.continued {
+		next, more, err := sc.readPacket()
+		if err != nil {
+			return false, err
+		}
+		seq = next[3]
+		payload = append(payload, more...)
+		sc.continued = len(more) == maxPacketSize
+	}
+	sc.seq = seq + 1
+	return true, sc.serve(payload)
+}
+
+func (sc *stmtConn) serve(payload []byte) error {
+	c := sc.handler.conn(sc.connID)
+	if c == nil {
+		return fmt.Errorf("connection %d is not registered", sc.connID)
+	}
+	var err error
+	switch payload[0] {
+	case comStmtPrepare:
+		err = sc.prepare(c, string(payload[1:]))
+	case comStmtExecute:
+		err = sc.execute(c, payload[1:])
+	case comStmtFetch:
+		err = sc.fetch(c, payload[1:])
+	case comStmtReset:
+		err = sc.reset(c, payload[1:])
+	case comStmtSendLongData:
+		// no reply, a malformed packet is dropped
+		if len(payload) >= 7 {
+			if sp, ok := sc.stmts[binary.LittleEndian.Uint32(payload[1:])]; ok {
+				param := int(binary.LittleEndian.Uint16(payload[5:]))
+				if sp.longData == nil {
+					sp.longData = make(map[int][]byte)
+				}
+				sp.longData[param] = append(sp.longData[param], payload[7:]...)
+			}
+		}
+	case comStmtClose:
+		// no reply
+		if len(payload) >= 5 {
+			id := binary.LittleEndian.Uint32(payload[1:])
+			delete(sc.stmts, id)
+			sc.handler.ComStmtClose(c, id)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return sc.writer.Flush()
+}
+
+// prepare replies the id of the statement, the definitions of its parameters and of the columns
+// of its plan. The columns are defined again by the reply of the execution.
+func (sc *stmtConn) prepare(c *mysql.Conn, query string) error {
+	id, params, fields, err := sc.handler.ComPrepare(c, query)
+	if err != nil {
+		return sc.writeError(err)
+	}
+	sc.stmts[id] = &stmtParams{count: params}
+	data := []byte{0x00}
+	data = appendUint32(data, id)
+	data = appendUint16(data, uint16(len(fields)))
+	data = appendUint16(data, uint16(params))
+	// a filler and no warnings
+	data = append(data, 0, 0, 0)
+	if err := sc.writePacket(data); err != nil {
+		return err
+	}
+	if params > 0 {
+		for i := 0; i < params; i++ {
+			if err := sc.writePacket(columnDefinition(&querypb.Field{Name: "?", Type: querypb.Type_VARBINARY})); err != nil {
+				return err
+			}
+		}
+		if err := sc.writeMetadataEnd(); err != nil {
+			return err
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	for _, field := range fields {
+		if err := sc.writePacket(columnDefinition(field)); err != nil {
+			return err
+		}
+	}
+	return sc.writeMetadataEnd()
+}
+
+// execute replies the result of the statement, the columns of a cursor with rows left are
+// followed by no rows, the rows are fetched.
+func (sc *stmtConn) execute(c *mysql.Conn, data []byte) error {
+	// the id, the flags and the iteration count
+	if len(data) < 9 {
+		return sc.writeError(errMalformedPacket)
+	}
+	id := binary.LittleEndian.Uint32(data)
+	withCursor := data[4]&cursorTypeMask != 0
+	sp, ok := sc.stmts[id]
+	if !ok {
+		return sc.writeError(mysql.NewSQLError(mysql.ERUnknownStmtHandler, mysql.SSUnknownSQLState, "Unknown prepared statement handler (%d) given to COM_STMT_EXECUTE", id))
+	}
+	params, err := sp.decode(data[9:])
+	sp.longData = nil
+	if err != nil {
+		return sc.writeError(err)
+	}
+
+	var result *sqltypes.Result
+	err = sc.handler.ComStmtExecute(c, id, params, withCursor, func(r *sqltypes.Result) error {
+		result = r
+		return nil
+	})
+	if err != nil {
+		return sc.writeError(err)
+	}
+	if len(result.Fields) == 0 {
+		return sc.writeOK(result.RowsAffected, result.InsertID, serverStatusAutocommit)
+	}
+	// a row not encoded fails the reply before any packet of it
+	encoded, err := encodeRows(result)
+	if err != nil {
+		return sc.writeError(err)
+	}
+	if err := sc.writePacket(appendLenEncInt(nil, uint64(len(result.Fields)))); err != nil {
+		return err
+	}
+	for _, field := range result.Fields {
+		if err := sc.writePacket(columnDefinition(field)); err != nil {
+			return err
+		}
+	}
+	if withCursor && sc.handler.cursorOpen(c, id) {
+		return sc.writeEnd(serverStatusAutocommit | serverStatusCursorExists)
+	}
+	if err := sc.writeMetadataEnd(); err != nil {
+		return err
+	}
+	if err := sc.writeRows(encoded); err != nil {
+		return err
+	}
+	return sc.writeEnd(serverStatusAutocommit)
+}
+
+// fetch replies the next rows of the cursor, the status tells if rows are left.
+func (sc *stmtConn) fetch(c *mysql.Conn, data []byte) error {
+	if len(data) < 8 {
+		return sc.writeError(errMalformedPacket)
+	}
+	id, rows := binary.LittleEndian.Uint32(data), binary.LittleEndian.Uint32(data[4:])
+	var result *sqltypes.Result
+	err := sc.handler.ComStmtFetch(c, id, int(rows), func(r *sqltypes.Result) error {
+		result = r
+		return nil
+	})
+	if err != nil {
+		return sc.writeError(err)
+	}
+	encoded, err := encodeRows(result)
+	if err != nil {
+		return sc.writeError(err)
+	}
+	if err := sc.writeRows(encoded); err != nil {
+		return err
+	}
+	status := uint16(serverStatusAutocommit | serverStatusCursorExists)
+	if !sc.handler.cursorOpen(c, id) {
+		status = serverStatusAutocommit | serverStatusLastRowSent
+	}
+	return sc.writeEnd(status)
+}
+
+// reset drops the long data and the cursor of the statement.
+func (sc *stmtConn) reset(c *mysql.Conn, data []byte) error {
+	if len(data) < 4 {
+		return sc.writeError(errMalformedPacket)
+	}
+	id := binary.LittleEndian.Uint32(data)
+	if sp, ok := sc.stmts[id]; ok {
+		sp.longData = nil
+	}
+	if err := sc.handler.ComStmtReset(c, id); err != nil {
+		return sc.writeError(err)
+	}
+	return sc.writeOK(0, 0, serverStatusAutocommit)
+}
+
+// encodeRows returns the rows of the result in the binary protocol.
+func encodeRows(result *sqltypes.Result) ([][]byte, error) {
+	rows := make([][]byte, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		data, err := binaryRow(result.Fields, row)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, data)
+	}
+	return rows, nil
+}
+
+func (sc *stmtConn) writeRows(rows [][]byte) error {
+	for _, data := range rows {
+		if err := sc.writePacket(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writePacket writes the data in packets of the sequence of the reply, data of the max size or
+// more is continued by the next packet.
+func (sc *stmtConn) writePacket(data []byte) error {
+	for {
+		n := len(data)
+		if n > maxPacketSize {
+			n = maxPacketSize
+		}
+		if _, err := sc.writer.Write([]byte{byte(n), byte(n >> 8), byte(n >> 16), sc.seq}); err != nil {
+			return err
+		}
+		sc.seq++
+		if _, err := sc.writer.Write(data[:n]); err != nil {
+			return err
+		}
+		if data = data[n:]; n < maxPacketSize {
+			return nil
+		}
+	}
+}
+
+func (sc *stmtConn) writeOK(affectedRows, insertID uint64, status uint16) error {
+	return sc.writePacket(okPacket(0x00, affectedRows, insertID, status))
+}
+
+// writeMetadataEnd ends the definitions of the columns or the parameters, a client deprecating
+// EOF is sent nothing.
+func (sc *stmtConn) writeMetadataEnd() error {
+	if sc.deprecateEOF {
+		return nil
+	}
+	return sc.writePacket(eofPacket(serverStatusAutocommit))
+}
+
+// writeEnd ends the rows, by an OK packet of the EOF header for a client deprecating EOF.
+func (sc *stmtConn) writeEnd(status uint16) error {
+	if sc.deprecateEOF {
+		return sc.writePacket(okPacket(0xfe, 0, 0, status))
+	}
+	return sc.writePacket(eofPacket(status))
+}
+
+func (sc *stmtConn) writeError(err error) error {
+	num, state, message := mysql.ERUnknownError, mysql.SSUnknownSQLState, err.Error()
+	if sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError); ok {
+		num, state, message = sqlErr.Number(), sqlErr.SQLState(), sqlErr.Message
+	}
+	if len(state) != 5 {
+		state = mysql.SSUnknownSQLState
+	}
+	data := []byte{0xff, byte(num), byte(num >> 8), '#'}
+	data = append(data, state...)
+	data = append(data, message...)
+	return sc.writePacket(data)
+}
+
+func okPacket(header byte, affectedRows, insertID uint64, status uint16) []byte {
+	data := []byte{header}
+	data = appendLenEncInt(data, affectedRows)
+	data = appendLenEncInt(data, insertID)
+	data = appendUint16(data, status)
+	// no warnings
+	return appendUint16(data, 0)
+}
+
+// eofPacket is the header, no warnings and the status.
+func eofPacket(status uint16) []byte {
+	return appendUint16([]byte{0xfe, 0, 0}, status)
+}
+
+// columnType returns the type, the flags and the charset of the column of the values of the type:
+// the integers and the floats are written as 8 byte numbers, the others as strings.
+func columnType(typ querypb.Type) (byte, uint16, uint16) {
+	switch {
+	case typ == querypb.Type_NULL_TYPE:
+		return typeNull, binaryFlag, binaryCharset
+	case sqltypes.IsSigned(typ):
+		return typeLongLong, binaryFlag, binaryCharset
+	case sqltypes.IsUnsigned(typ):
+		return typeLongLong, unsignedFlag | binaryFlag, binaryCharset
+	case sqltypes.IsFloat(typ):
+		return typeDouble, binaryFlag, binaryCharset
+	case sqltypes.IsBinary(typ):
+		return typeVarString, binaryFlag, binaryCharset
+	}
+	return typeVarString, 0, utf8Charset
+}
+
+// columnDefinition returns the column definition 41 of the field.
+func columnDefinition(field *querypb.Field) []byte {
+	typ, flags, charset := columnType(field.Type)
+	data := appendLenEncString(nil, "def")
+	for _, s := range []string{field.Database, field.Table, field.OrgTable, field.Name, field.OrgName} {
+		data = appendLenEncString(data, s)
+	}
+	// the length of the fixed fields
+	data = append(data, 0x0c)
+	data = appendUint16(data, charset)
+	data = appendUint32(data, field.ColumnLength)
+	data = append(data, typ)
+	data = appendUint16(data, flags)
+	// the decimals and a filler
+	return append(data, byte(field.Decimals), 0, 0)
+}
+
+// binaryRow returns the row in the binary protocol: a header, the bitmap of the null values,
+// offset by 2 bits, and the values not null in the types of their columns.
+func binaryRow(fields []*querypb.Field, row []sqltypes.Value) ([]byte, error) {
+	data := make([]byte, 1+(len(fields)+7+2)/8)
+	for i, field := range fields {
+		typ, flags, _ := columnType(field.Type)
+		if i >= len(row) || row[i].IsNull() || typ == typeNull {
+			data[1+(i+2)/8] |= 1 << uint((i+2)%8)
+			continue
+		}
+		s := row[i].ToString()
+		switch {
+		case typ == typeLongLong && flags&unsignedFlag != 0:
+			n, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			data = appendUint64(data, n)
+		case typ == typeLongLong:
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			data = appendUint64(data, uint64(n))
+		case typ == typeDouble:
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, err
+			}
+			data = appendUint64(data, math.Float64bits(f))
+		default:
+			data = appendLenEncString(data, s)
+		}
+	}
+	return data, nil
+}
+
+// decode reads the parameters of COM_STMT_EXECUTE: the bitmap of the null values, the flag of the
+// types sent, the types and the values not null. A parameter sent by COM_STMT_SEND_LONG_DATA has
+// no value.
+func (sp *stmtParams) decode(data []byte) ([]*querypb.BindVariable, error) {
+	if sp.count == 0 {
+		return nil, nil
+	}
+	nullLen := (sp.count + 7) / 8
+	if len(data) < nullLen+1 {
+		return nil, errMalformedPacket
+	}
+	nulls := data[:nullLen]
+	if data = data[nullLen:]; data[0] == 1 {
+		if len(data) < 1+2*sp.count {
+			return nil, errMalformedPacket
+		}
+		sp.types = append(sp.types[:0], data[1:1+2*sp.count]...)
+		data = data[1+2*sp.count:]
+	} else {
+		data = data[1:]
+	}
+	if len(sp.types) != 2*sp.count {
+		return nil, mysql.NewSQLError(mysql.ERWrongArguments, mysql.SSUnknownSQLState, "Incorrect arguments to EXECUTE: the types of the parameters are not sent")
+	}
+
+	params := make([]*querypb.BindVariable, sp.count)
+	for i := range params {
+		if nulls[i/8]&(1<<uint(i%8)) != 0 {
+			params[i] = sqltypes.ValueBindVariable(sqltypes.NULL)
+			continue
+		}
+		if long, ok := sp.longData[i]; ok {
+			params[i] = sqltypes.BytesBindVariable(long)
+			continue
+		}
+		bv, n, err := decodeValue(sp.types[2*i], sp.types[2*i+1]&0x80 != 0, data)
+		if err != nil {
+			return nil, err
+		}
+		params[i], data = bv, data[n:]
+	}
+	return params, nil
+}
+
+// decodeValue returns the value of the type at the head of the data and its length.
+func decodeValue(typ byte, unsigned bool, data []byte) (*querypb.BindVariable, int, error) {
+	integer := func(size int) (*querypb.BindVariable, int, error) {
+		if len(data) < size {
+			return nil, 0, errMalformedPacket
+		}
+		var n uint64
+		for i := size - 1; i >= 0; i-- {
+			n = n<<8 | uint64(data[i])
+		}
+		if unsigned {
+			return sqltypes.Uint64BindVariable(n), size, nil
+		}
+		// sign extend the value
+		shift := uint(64 - 8*size)
+		return sqltypes.Int64BindVariable(int64(n<<shift) >> shift), size, nil
+	}
+
+	switch typ {
+	case typeNull:
+		return sqltypes.ValueBindVariable(sqltypes.NULL), 0, nil
+	case typeTiny:
+		return integer(1)
+	case typeShort, typeYear:
+		return integer(2)
+	case typeLong, typeInt24:
+		return integer(4)
+	case typeLongLong:
+		return integer(8)
+	case typeFloat:
+		if len(data) < 4 {
+			return nil, 0, errMalformedPacket
+		}
+		return sqltypes.Float64BindVariable(float64(math.Float32frombits(binary.LittleEndian.Uint32(data)))), 4, nil
+	case typeDouble:
+		if len(data) < 8 {
+			return nil, 0, errMalformedPacket
+		}
+		return sqltypes.Float64BindVariable(math.Float64frombits(binary.LittleEndian.Uint64(data))), 8, nil
+	case typeDate, typeDateTime, typeTimestamp:
+		s, n, err := decodeDateTime(data)
+		if err != nil {
+			return nil, 0, err
+		}
+		return sqltypes.StringBindVariable(s), n, nil
+	case typeTime:
+		s, n, err := decodeTime(data)
+		if err != nil {
+			return nil, 0, err
+		}
+		return sqltypes.StringBindVariable(s), n, nil
+	}
+	length, n, ok := readLenEncInt(data)
+	if !ok || uint64(len(data)-n) < length {
+		return nil, 0, errMalformedPacket
+	}
+	value := data[n : n+int(length)]
+	switch typ {
+	case typeDecimal, typeNewDecimal:
+		return &querypb.BindVariable{Type: querypb.Type_DECIMAL, Value: value}, n + int(length), nil
+	}
+	return sqltypes.BytesBindVariable(value), n + int(length), nil
+}
+
+// decodeDateTime reads a date of the length, the year, the month and the day, followed by the
+// hour, the minute and the second, and the microseconds if the length covers them.
+func decodeDateTime(data []byte) (string, int, error) {
+	if len(data) < 1 || len(data) < 1+int(data[0]) {
+		return "", 0, errMalformedPacket
+	}
+	length, d := int(data[0]), data[1:]
+	if length == 0 {
+		return "0000-00-00 00:00:00", 1, nil
+	}
+	if length < 4 {
+		return "", 0, errMalformedPacket
+	}
+	s := fmt.Sprintf("%04d-%02d-%02d", binary.LittleEndian.Uint16(d), d[2], d[3])
+	if length >= 7 {
+		s += fmt.Sprintf(" %02d:%02d:%02d", d[4], d[5], d[6])
+	}
+	if length >= 11 {
+		s += fmt.Sprintf(".%06d", binary.LittleEndian.Uint32(d[7:]))
+	}
+	return s, 1 + length, nil
+}
+
+// decodeTime reads a time of the length, the sign, the days, the hour, the minute and the second,
+// and the microseconds if the length covers them.
+func decodeTime(data []byte) (string, int, error) {
+	if len(data) < 1 || len(data) < 1+int(data[0]) {
+		return "", 0, errMalformedPacket
+	}
+	length, d := int(data[0]), data[1:]
+	if length == 0 {
+		return "00:00:00", 1, nil
+	}
+	if length < 8 {
+		return "", 0, errMalformedPacket
+	}
+	var sign string
+	if d[0] == 1 {
+		sign = "-"
+	}
+	hours := binary.LittleEndian.Uint32(d[1:])*24 + uint32(d[5])
+	s := fmt.Sprintf("%s%02d:%02d:%02d", sign, hours, d[6], d[7])
+	if length >= 12 {
+		s += fmt.Sprintf(".%06d", binary.LittleEndian.Uint32(d[8:]))
+	}
+	return s, 1 + length, nil
+}
+
+func readLenEncInt(data []byte) (uint64, int, bool) {
+	if len(data) == 0 {
+		return 0, 0, false
+	}
+	size := 0
+	switch data[0] {
+	case 0xfc:
+		size = 2
+	case 0xfd:
+		size = 3
+	case 0xfe:
+		size = 8
+	case 0xfb, 0xff:
+		return 0, 0, false
+	default:
+		return uint64(data[0]), 1, true
+	}
+	if len(data) < 1+size {
+		return 0, 0, false
+	}
+	var n uint64
+	for i := size; i >= 1; i-- {
+		n = n<<8 | uint64(data[i])
+	}
+	return n, 1 + size, true
+}
+
+func appendLenEncInt(data []byte, n uint64) []byte {
+	switch {
+	case n < 251:
+		return append(data, byte(n))
+	case n < 1<<16:
+		return appendUint16(append(data, 0xfc), uint16(n))
+	case n < 1<<24:
+		return append(data, 0xfd, byte(n), byte(n>>8), byte(n>>16))
+	}
+	return appendUint64(append(data, 0xfe), n)
+}
+
+func appendLenEncString(data []byte, s string) []byte {
+	return append(appendLenEncInt(data, uint64(len(s))), s...)
+}
+
+func appendUint16(data []byte, n uint16) []byte {
+	return append(data, byte(n), byte(n>>8))
+}
+
+func appendUint32(data []byte, n uint32) []byte {
+	return append(data, byte(n), byte(n>>8), byte(n>>16), byte(n>>24))
+}
+
+func appendUint64(data []byte, n uint64) []byte {
+	return appendUint32(appendUint32(data, uint32(n)), uint32(n>>32))
+}
@@ -0,0 +1,205 @@
+package mysql
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestStmtConn(t *testing.T) {
+	backend := newMemBackend()
+	sh := newStmtHandler(&gateHandler{executor: newExecutor(backend, newMemMaster(backend), 2)}, nil)
+	c := &mysql.Conn{SchemaName: "test", ConnectionID: 1}
+	sh.NewConnection(c)
+	mustExec(t, sh.executor, c, "insert into user (id, name, age) values (1, 'a', 20), (2, 'b', 30), (3, 'c', 40)")
+
+	server, client := net.Pipe()
+	defer client.Close()
+	sc := newStmtConn(server, sh)
+	// as if the handshake was done
+	sc.handshook, sc.clientReplied, sc.connID = true, true, c.ConnectionID
+	// the packets passed on to vitess
+	passed := make(chan []byte, 1)
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, err := sc.Read(buf)
+			if err != nil {
+				close(passed)
+				return
+			}
+			passed <- append([]byte(nil), buf[:n]...)
+		}
+	}()
+
+	var seq byte
+	write := func(payload ...byte) {
+		n := len(payload)
+		if _, err := client.Write(append([]byte{byte(n), byte(n >> 8), byte(n >> 16), 0}, payload...)); err != nil {
+			t.Fatal(err)
+		}
+		seq = 1
+	}
+	read := func() []byte {
+		header := make([]byte, 4)
+		if _, err := io.ReadFull(client, header); err != nil {
+			t.Fatal(err)
+		}
+		if header[3] != seq {
+			t.Fatalf("packet of sequence %d, expect %d", header[3], seq)
+		}
+		seq++
+		payload := make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+		if _, err := io.ReadFull(client, payload); err != nil {
+			t.Fatal(err)
+		}
+		return payload
+	}
+	status := func(eof []byte) uint16 {
+		if eof[0] != 0xfe || len(eof) != 5 {
+			t.Fatalf("unexpected eof packet %v", eof)
+		}
+		return binary.LittleEndian.Uint16(eof[3:])
+	}
+	names := func(rows int) []string {
+		var names []string
+		for i := 0; i < rows; i++ {
+			// the header, the null bitmap and the name
+			row := read()
+			if row[0] != 0x00 || int(row[2]) != len(row)-3 {
+				t.Fatalf("unexpected row %v", row)
+			}
+			names = append(names, string(row[3:]))
+		}
+		return names
+	}
+
+	write(append([]byte{comStmtPrepare}, "select name from user where age > ?"...)...)
+	reply := read()
+	if reply[0] != 0x00 || binary.LittleEndian.Uint16(reply[5:]) != 1 || binary.LittleEndian.Uint16(reply[7:]) != 1 {
+		t.Fatalf("unexpected reply of the prepare %v", reply)
+	}
+	id := reply[1:5]
+	if param := read(); param[len(param)-6] != typeVarString {
+		t.Fatalf("unexpected parameter %v", param)
+	}
+	status(read())
+	if column := read(); column[len(column)-6] != typeVarString {
+		t.Fatalf("unexpected column of the prepare %v", column)
+	}
+	status(read())
+
+	// executed with a cursor, the rows are fetched
+	execute := append([]byte{comStmtExecute}, id...)
+	// a read only cursor, one iteration, no null, the types and 25
+	execute = append(execute, 0x01, 1, 0, 0, 0)
+	execute = append(execute, 0x00, 1, typeLongLong, 0)
+	execute = append(execute, appendUint64(nil, 25)...)
+	write(execute...)
+	if count := read(); len(count) != 1 || count[0] != 1 {
+		t.Fatalf("unexpected column count %v", count)
+	}
+	if column := read(); column[len(column)-6] != typeVarString {
+		t.Fatalf("unexpected column %v", column)
+	}
+	if s := status(read()); s&serverStatusCursorExists == 0 {
+		t.Fatalf("no cursor in the status %x", s)
+	}
+	fetch := func(rows uint32) {
+		write(appendUint32(append([]byte{comStmtFetch}, id...), rows)...)
+	}
+	fetch(2)
+	fetched := names(2)
+	if s := status(read()); s&serverStatusCursorExists == 0 {
+		t.Fatalf("no cursor in the status %x", s)
+	}
+	fetch(10)
+	fetched = append(fetched, names(1)...)
+	if s := status(read()); s&serverStatusLastRowSent == 0 {
+		t.Fatalf("last row not sent in the status %x", s)
+	}
+	if text := resultText([][]string{fetched}); text != "a,b,c" {
+		t.Fatalf("fetched %s", text)
+	}
+
+	write(append([]byte{comStmtExecute}, 9, 0, 0, 0, 0, 1, 0, 0, 0)...)
+	if reply := read(); reply[0] != 0xff || binary.LittleEndian.Uint16(reply[1:]) != mysql.ERUnknownStmtHandler {
+		t.Fatalf("unexpected reply of an unknown statement %v", reply)
+	}
+
+	// the close has no reply, the next command is passed on
+	write(append([]byte{comStmtClose}, id...)...)
+	write(append([]byte{mysql.ComQuery}, "select 1"...)...)
+	if packet := <-passed; string(packet[5:]) != "select 1" {
+		t.Fatalf("unexpected packet passed %v", packet)
+	}
+	if s := sessionOf(c); len(s.stmts) != 0 || len(sc.stmts) != 0 || len(backend.scrolls) != 0 {
+		t.Fatalf("the statement is left open: %v %v %v", s.stmts, sc.stmts, backend.scrolls)
+	}
+}
+
+func TestShiftWrite(t *testing.T) {
+	sc := &stmtConn{shift: 1}
+	// an auth switch request written in two parts, and the OK packet ending the connection phase
+	p := sc.shiftWrite([]byte{3, 0, 0, 2, 0xfe, 'a'})
+	p = append(p, sc.shiftWrite([]byte{'b', 3, 0, 0, 4, 0x00, 0, 0})...)
+	if p[3] != 3 || p[10] != 5 || sc.shift != 0 {
+		t.Fatalf("unexpected packets %v, shift %d", p, sc.shift)
+	}
+	// the command phase is not shifted
+	sc.writeLeft = 0
+	if p := sc.shiftWrite([]byte{1, 0, 0, 1, 0x00}); p[3] != 1 {
+		t.Fatalf("unexpected packet %v", p)
+	}
+}
+
+func TestEncodeRows(t *testing.T) {
+	fields := []*querypb.Field{{Name: "age", Type: querypb.Type_INT64}}
+	result := &sqltypes.Result{Fields: fields, Rows: [][]sqltypes.Value{
+		{sqltypes.MakeTrusted(querypb.Type_INT64, []byte("1"))},
+		{sqltypes.MakeTrusted(querypb.Type_INT64, []byte("x"))},
+	}}
+	if _, err := encodeRows(result); err == nil {
+		t.Fatal("a row not encoded is not an error")
+	}
+	result.Rows = result.Rows[:1]
+	if rows, err := encodeRows(result); err != nil || len(rows) != 1 {
+		t.Fatalf("unexpected rows %v: %v", rows, err)
+	}
+}
+
+func TestDecodeParams(t *testing.T) {
+	sp := &stmtParams{count: 5, longData: map[int][]byte{3: []byte("long")}}
+	// the second parameter is null, the fourth sent as long data
+	data := []byte{0x02, 1, typeTiny, 0, typeTiny, 0, typeShort, 0x80, typeVarString, 0, typeDateTime, 0}
+	data = append(data, 0xff, 0xff, 0xff, 7, 0xe2, 0x07, 12, 31, 23, 59, 1)
+	params, err := sp.decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []*querypb.BindVariable{
+		sqltypes.Int64BindVariable(-1),
+		sqltypes.ValueBindVariable(sqltypes.NULL),
+		sqltypes.Uint64BindVariable(65535),
+		sqltypes.BytesBindVariable([]byte("long")),
+		sqltypes.StringBindVariable("2018-12-31 23:59:01"),
+	}
+	for i, bv := range params {
+		if bv.Type != expect[i].Type || string(bv.Value) != string(expect[i].Value) {
+			t.Fatalf("parameter %d is %v, expect %v", i, bv, expect[i])
+		}
+	}
+
+	// the types are kept for the executions which do not send them
+	if params, err = sp.decode([]byte{0x1b, 0, 1, 0}); err != nil || len(params) != 5 || params[2].Type != querypb.Type_UINT64 {
+		t.Fatalf("unexpected parameters %v: %v", params, err)
+	}
+	if _, err := sp.decode([]byte{0x00, 1, typeTiny}); err == nil {
+		t.Fatal("a malformed packet is decoded")
+	}
+}
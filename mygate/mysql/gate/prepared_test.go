@@ -0,0 +1,179 @@
+package mysql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestPrepare(t *testing.T) {
+	backend := newMemBackend()
+	e := newExecutor(backend, newMemMaster(backend), 2)
+	c := &mysql.Conn{SchemaName: "test"}
+	ctx := context.Background()
+	mustExec(t, e, c, "insert into user (id, name, age) values (1, 'a', 20), (2, 'b', 30), (3, 'c', 40), (4, 'd', 50), (5, 'e', 60)")
+
+	first, err := e.prepare(ctx, c, "select name from user where age > ? and name != 'x' limit 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := e.prepare(ctx, c, "select name from user where age > ? and name != 'y' limit 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the statements but for the literals share the plan
+	if first.plan != second.plan || first.plan.params != 1 || first.id == second.id || sessionOf(c).plans.lru.Len() != 1 {
+		t.Fatalf("unexpected plans %v %v", first.plan, second.plan)
+	}
+
+	if _, err := e.executePrepared(ctx, c, second, []*querypb.BindVariable{sqltypes.Int64BindVariable(35)}, false); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(backend.lastSearch.Query)
+	if expect := `{"bool":{"must":[{"range":{"age":{"gt":35}}},{"bool":{"must_not":[{"term":{"name":"y"}}]}}]}}`; string(data) != expect {
+		t.Fatalf("query %s, expect %s", data, expect)
+	}
+	if _, err := e.executePrepared(ctx, c, first, nil, false); err == nil {
+		t.Fatal("execute without the parameter")
+	} else if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != mysql.ERWrongArguments {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	point, err := e.prepare(ctx, c, "select name from user where id = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := e.executePrepared(ctx, c, point, []*querypb.BindVariable{sqltypes.StringBindVariable("it's")}, false)
+	if err != nil || len(result.Rows) != 0 {
+		t.Fatalf("unexpected result %v: %v", result, err)
+	}
+
+	// a cursor is not bound by the max rows
+	all, err := e.prepare(ctx, c, "select id from user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.executePrepared(ctx, c, all, nil, false); err == nil {
+		t.Fatal("select of more rows than the max")
+	}
+	result, err = e.executePrepared(ctx, c, all, nil, true)
+	if err != nil || len(result.Fields) != 1 || len(result.Rows) != 0 {
+		t.Fatalf("unexpected result of the cursor %v: %v", result, err)
+	}
+	var ids []string
+	for i, rows := range []int{3, 3, 3} {
+		result, err := e.fetch(ctx, all, rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range result.Rows {
+			ids = append(ids, row[0].ToString())
+		}
+		if i == 0 {
+			// the windows are read from the snapshot of the first one
+			mustExec(t, e, c, "delete from user where id = 5")
+			mustExec(t, e, c, "insert into user (id, name, age) values (6, 'f', 70)")
+		}
+	}
+	if text := resultText([][]string{ids}); text != "1,2,3,4,5" {
+		t.Fatalf("fetched %s", text)
+	}
+	if len(backend.scrolls) != 0 || all.cursor == nil || !all.cursor.exhausted() {
+		t.Fatalf("the scroll of the fetched cursor is open: %v", backend.scrolls)
+	}
+	// a cursor closed before its last row closes its scroll
+	if _, err := e.executePrepared(ctx, c, all, nil, true); err != nil || len(backend.scrolls) != 1 {
+		t.Fatalf("unexpected scrolls %v: %v", backend.scrolls, err)
+	}
+	e.closeCursor(ctx, all)
+	if len(backend.scrolls) != 0 {
+		t.Fatalf("the scroll of the closed cursor is open: %v", backend.scrolls)
+	}
+	if _, err := e.fetch(ctx, point, 1); err == nil {
+		t.Fatal("fetch without a cursor")
+	}
+}
+
+func TestPrepareStatements(t *testing.T) {
+	backend := newMemBackend()
+	e := newExecutor(backend, newMemMaster(backend), 100)
+	c := &mysql.Conn{SchemaName: "test"}
+	mustExec(t, e, c, "insert into user (id, name) values (1, 'a'), (2, 'b')")
+
+	mustExec(t, e, c, "set @id = 2, @name = 'c', @@autocommit = 1")
+	mustExec(t, e, c, `prepare find from 'select name from user where id = ?'`)
+	if result := mustExec(t, e, c, "execute find using @id"); len(result.Rows) != 1 || result.Rows[0][0].ToString() != "b" {
+		t.Fatalf("unexpected result %v", result)
+	}
+	// a variable never set is NULL
+	if result := mustExec(t, e, c, "execute find using @unknown"); len(result.Rows) != 0 {
+		t.Fatalf("unexpected result %v", result)
+	}
+	expectError(t, e, c, "execute find", mysql.ERWrongArguments)
+
+	mustExec(t, e, c, "set @sql = 'update user set name = ? where id = ?'")
+	mustExec(t, e, c, "prepare rename from @sql")
+	if result := mustExec(t, e, c, "execute rename using @name, @id"); result.RowsAffected != 1 || backend.spaces["user"]["2"]["name"] != "c" {
+		t.Fatalf("unexpected result %v of the update", result)
+	}
+
+	mustExec(t, e, c, "deallocate prepare find")
+	expectError(t, e, c, "execute find", mysql.ERUnknownStmtHandler)
+	expectError(t, e, c, "drop prepare find", mysql.ERUnknownStmtHandler)
+	expectError(t, e, c, "prepare nested from 'execute rename'", erUnsupportedPS)
+	if s := sessionOf(c); len(s.stmts) != 1 || s.names["rename"] == nil {
+		t.Fatalf("unexpected statements %v", s.stmts)
+	}
+}
+
+func TestPlanCache(t *testing.T) {
+	pc := newPlanCache(2)
+	for _, key := range []string{"a", "b", "a", "c"} {
+		if pc.get(key) == nil {
+			pc.add(&plan{key: key})
+		}
+	}
+	if pc.get("b") != nil || pc.get("a") == nil || pc.get("c") == nil {
+		t.Fatal("the least recently used plan is kept")
+	}
+}
+
+func TestBindStatement(t *testing.T) {
+	for _, tc := range []struct {
+		sql    string
+		expect string
+	}{
+		{"select a from t where a = :v1 and b in ::bv1", "select a from t where a = null and b in ('x', 2)"},
+		{"select a, :v2 from t where a between :v2 and 3.5 order by a limit :v2", "select a, 7 from t where a between 7 and 3.5 order by a asc limit 7"},
+		{"update t set a = :v3 where b = 'c'", "update t set a = 'it\\'s' where b = 'c'"},
+		{"insert into t (a, b) values (:v2, :v1)", "insert into t(a, b) values (7, null)"},
+	} {
+		stmt, err := sqlparser.Parse(tc.sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = bindStatement(stmt, map[string]*querypb.BindVariable{
+			"v1":  sqltypes.ValueBindVariable(sqltypes.NULL),
+			"v2":  sqltypes.Int64BindVariable(7),
+			"v3":  sqltypes.StringBindVariable("it's"),
+			"bv1": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{{Type: querypb.Type_VARCHAR, Value: []byte("x")}, {Type: querypb.Type_INT64, Value: []byte("2")}}},
+		})
+		if err != nil {
+			t.Fatalf("bind %s: %v", tc.sql, err)
+		}
+		if sql := sqlparser.String(stmt); sql != tc.expect {
+			t.Fatalf("bound %s, expect %s", sql, tc.expect)
+		}
+	}
+
+	stmt, _ := sqlparser.Parse("select a from t where a = :v4")
+	if err := bindStatement(stmt, nil); err == nil {
+		t.Fatal("a parameter without a value is bound")
+	}
+}
@@ -0,0 +1,191 @@
+package mysql
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// resultColumn is a column of a result, name labels the column of the table in the result.
+type resultColumn struct {
+	name   string
+	column string
+}
+
+// starColumns returns the columns of SELECT *, the defined columns in order or, as the objects of a
+// table without definition have no fixed set of fields, the primary key first and the others by
+// name.
+func starColumns(t *table, rows []map[string]interface{}) []resultColumn {
+	if t.def != nil {
+		columns := make([]resultColumn, len(t.def.Columns))
+		for i, col := range t.def.Columns {
+			columns[i] = resultColumn{name: col.Name, column: col.Name}
+		}
+		return columns
+	}
+	names := make(map[string]bool)
+	for _, row := range rows {
+		for column := range row {
+			if column != t.primaryKey && column != tableField && !strings.HasPrefix(column, indexFieldPrefix) {
+				names[column] = true
+			}
+		}
+	}
+	columns := make([]resultColumn, 0, len(names)+1)
+	for name := range names {
+		columns = append(columns, resultColumn{name: name, column: name})
+	}
+	sort.Slice(columns, func(i, j int) bool { return columns[i].name < columns[j].name })
+	return append([]resultColumn{{name: t.primaryKey, column: t.primaryKey}}, columns...)
+}
+
+// rowsResult builds the result of the rows of the table, the type of a column is its declared type
+// or is told by its values.
+func rowsResult(t *table, columns []resultColumn, rows []map[string]interface{}) *sqltypes.Result {
+	result := &sqltypes.Result{
+		Fields:       make([]*querypb.Field, len(columns)),
+		Rows:         make([][]sqltypes.Value, len(rows)),
+		RowsAffected: uint64(len(rows)),
+	}
+	values := make([]interface{}, len(rows))
+	for i, col := range columns {
+		for j, row := range rows {
+			values[j] = row[col.column]
+		}
+		typ := columnType(values)
+		if t.def != nil {
+			if def := t.def.column(col.column); def != nil {
+				typ = declaredType(def, typ)
+			}
+		}
+		result.Fields[i] = &querypb.Field{
+			Name:     col.name,
+			Type:     typ,
+			Table:    t.name,
+			OrgTable: t.name,
+			Database: t.db,
+			OrgName:  col.column,
+			Charset:  columnCharset(typ),
+		}
+		for j := range rows {
+			if i == 0 {
+				result.Rows[j] = make([]sqltypes.Value, len(columns))
+			}
+			result.Rows[j][i] = columnValue(typ, values[j])
+		}
+	}
+	return result
+}
+
+func valueType(v interface{}) querypb.Type {
+	switch v := v.(type) {
+	case nil:
+		return sqltypes.Null
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return sqltypes.Int64
+		}
+		return sqltypes.Float64
+	case int64:
+		return sqltypes.Int64
+	case float64:
+		return sqltypes.Float64
+	case string:
+		return sqltypes.VarChar
+	case bool:
+		return sqltypes.Int8
+	}
+	return sqltypes.TypeJSON
+}
+
+// columnType is the type of all the values, integers mixed with floats are floats and any other
+// mix is text.
+func columnType(values []interface{}) querypb.Type {
+	typ := sqltypes.Null
+	for _, v := range values {
+		switch t := valueType(v); {
+		case t == sqltypes.Null || t == typ:
+		case typ == sqltypes.Null:
+			typ = t
+		case (typ == sqltypes.Int64 || typ == sqltypes.Float64) && (t == sqltypes.Int64 || t == sqltypes.Float64):
+			typ = sqltypes.Float64
+		default:
+			return sqltypes.VarChar
+		}
+	}
+	if typ == sqltypes.Null {
+		return sqltypes.VarChar
+	}
+	return typ
+}
+
+// declaredType is the type of the column as declared if its values, of the inferred type, are
+// sent as it: the numbers as numbers and the text as text.
+func declaredType(col *columnDef, inferred querypb.Type) querypb.Type {
+	typ := col.sqlType()
+	switch {
+	case typ == 0:
+	case inferred == sqltypes.TypeJSON:
+		if typ == sqltypes.TypeJSON {
+			return typ
+		}
+	case sqltypes.IsIntegral(typ):
+		if inferred == sqltypes.Int64 || inferred == sqltypes.Int8 {
+			return typ
+		}
+	case sqltypes.IsFloat(typ) || typ == sqltypes.Decimal:
+		if inferred == sqltypes.Int64 || inferred == sqltypes.Float64 {
+			return typ
+		}
+	case sqltypes.IsQuoted(typ):
+		if inferred == sqltypes.VarChar {
+			return typ
+		}
+	}
+	return inferred
+}
+
+func columnCharset(typ querypb.Type) uint32 {
+	if typ == sqltypes.VarChar || typ == sqltypes.Char || typ == sqltypes.Text || typ == sqltypes.Enum ||
+		typ == sqltypes.Set || typ == sqltypes.TypeJSON {
+		return mysql.CharacterSetUtf8
+	}
+	return mysql.CharacterSetBinary
+}
+
+func columnValue(typ querypb.Type, v interface{}) sqltypes.Value {
+	if v == nil {
+		return sqltypes.NULL
+	}
+	if typ == sqltypes.TypeJSON {
+		data, _ := json.Marshal(v)
+		return sqltypes.MakeTrusted(typ, data)
+	}
+	return sqltypes.MakeTrusted(typ, []byte(valueText(v)))
+}
+
+// valueText is the text of a value in the protocol, a bool is 1 or 0.
+func valueText(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
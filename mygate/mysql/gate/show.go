@@ -0,0 +1,536 @@
+package mysql
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// view is a table the gate builds in memory, the results of SHOW and the tables of
+// information_schema, the columns are in order.
+type view struct {
+	db      string
+	name    string
+	columns []string
+	rows    []map[string]interface{}
+}
+
+func newView(db, name string, columns ...string) *view {
+	return &view{db: db, name: name, columns: columns}
+}
+
+// add appends a row of the values of the columns in order.
+func (v *view) add(values ...interface{}) {
+	row := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		row[v.columns[i]] = value
+	}
+	v.rows = append(v.rows, row)
+}
+
+// column returns the column of the name, the names are case insensitive.
+func (v *view) column(name string) (string, bool) {
+	for _, column := range v.columns {
+		if strings.EqualFold(column, name) {
+			return column, true
+		}
+	}
+	return "", false
+}
+
+func (v *view) result() *sqltypes.Result {
+	columns := make([]resultColumn, len(v.columns))
+	for i, column := range v.columns {
+		columns[i] = resultColumn{name: column, column: column}
+	}
+	return rowsResult(&table{db: v.db, name: v.name}, columns, v.rows)
+}
+
+// filter keeps the rows matching the WHERE.
+func (v *view) filter(where *sqlparser.Where) error {
+	if where == nil {
+		return nil
+	}
+	var rows []map[string]interface{}
+	for _, row := range v.rows {
+		ok, err := matchCondition(where.Expr, func(expr sqlparser.Expr) (interface{}, error) {
+			return v.value(expr, row)
+		})
+		if err != nil {
+			return err
+		}
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+	v.rows = rows
+	return nil
+}
+
+// value returns the value of a column of the row or of a literal.
+func (v *view) value(expr sqlparser.Expr, row map[string]interface{}) (interface{}, error) {
+	if name, ok := columnName(expr); ok {
+		column, ok := v.column(name)
+		if !ok {
+			return nil, errUnknownColumn(name)
+		}
+		return row[column], nil
+	}
+	return literal(expr)
+}
+
+func errUnknownColumn(column string) error {
+	return mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Unknown column '%s' in 'where clause'", column)
+}
+
+// compareValues orders numbers by value and the others by text, case insensitively as the
+// collation of the catalog.
+func compareValues(a, b interface{}) int {
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(strings.ToLower(valueText(a)), strings.ToLower(valueText(b)))
+}
+
+func number(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// likeMatch matches the text to a LIKE pattern case insensitively.
+func likeMatch(pattern, text string) bool {
+	var expr strings.Builder
+	expr.WriteString("(?is)^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			expr.WriteString(".*")
+		case r == '_':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+	matched, _ := regexp.MatchString(expr.String(), text)
+	return matched
+}
+
+// selectView runs the SELECT on the view.
+func selectView(v *view, sel *sqlparser.Select) (*sqltypes.Result, error) {
+	if sel.Distinct != "" || len(sel.GroupBy) > 0 || sel.Having != nil {
+		return nil, errNotSupported("DISTINCT, GROUP BY and HAVING are not supported")
+	}
+	var columns []resultColumn
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			for _, column := range v.columns {
+				columns = append(columns, resultColumn{name: column, column: column})
+			}
+		case *sqlparser.AliasedExpr:
+			name, ok := columnName(expr.Expr)
+			if !ok {
+				return nil, errNotSupported("select expression %s is not supported", sqlparser.String(expr))
+			}
+			column, ok := v.column(name)
+			if !ok {
+				return nil, errUnknownColumn(name)
+			}
+			if !expr.As.IsEmpty() {
+				name = expr.As.String()
+			}
+			columns = append(columns, resultColumn{name: name, column: column})
+		default:
+			return nil, errNotSupported("select expression %s is not supported", sqlparser.String(expr))
+		}
+	}
+	if err := v.filter(sel.Where); err != nil {
+		return nil, err
+	}
+
+	for i := len(sel.OrderBy) - 1; i >= 0; i-- {
+		order := sel.OrderBy[i]
+		name, ok := columnName(order.Expr)
+		if !ok {
+			return nil, errNotSupported("ORDER BY %s is not on a column", sqlparser.String(order.Expr))
+		}
+		column, ok := v.column(name)
+		if !ok {
+			return nil, errUnknownColumn(name)
+		}
+		desc := order.Direction == sqlparser.DescScr
+		sort.SliceStable(v.rows, func(i, j int) bool {
+			a, b := v.rows[i][column], v.rows[j][column]
+			switch {
+			case a == nil || b == nil:
+				// NULL first
+				return (a == nil && b != nil) != desc
+			case desc:
+				return compareValues(a, b) > 0
+			}
+			return compareValues(a, b) < 0
+		})
+	}
+	offset, count, err := limitWindow(sel.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if offset > len(v.rows) {
+		offset = len(v.rows)
+	}
+	rows := v.rows[offset:]
+	if count >= 0 && count < len(rows) {
+		rows = rows[:count]
+	}
+	return rowsResult(&table{db: v.db, name: v.name}, columns, rows), nil
+}
+
+// columnKey is the key of the column in DESCRIBE: PRI for the primary key, UNI and MUL for the
+// first column of a unique or another index.
+func columnKey(t *table, column string) string {
+	if column == t.primaryKey {
+		return "PRI"
+	}
+	if t.def != nil {
+		for _, idx := range t.def.Indexes {
+			if idx.Columns[0] == column {
+				if idx.Unique && !idx.compound() {
+					return "UNI"
+				}
+				return "MUL"
+			}
+		}
+	}
+	return ""
+}
+
+func nullable(col *columnDef) string {
+	if col.NotNull {
+		return "NO"
+	}
+	return "YES"
+}
+
+func columnDefault(col *columnDef) interface{} {
+	if col.DefaultNow {
+		return "CURRENT_TIMESTAMP"
+	}
+	if col.Default == nil {
+		return nil
+	}
+	return valueText(col.Default)
+}
+
+func (e *executor) execShowColumns(ctx context.Context, db string, stmt *showColumns) (*sqltypes.Result, error) {
+	t, err := e.existingTable(ctx, db, stmt.table)
+	if err != nil {
+		return nil, err
+	}
+	v := newView(t.db, t.name, "Field", "Type", "Null", "Key", "Default", "Extra")
+	for _, col := range t.columns() {
+		v.add(col.Name, col.Type, nullable(col), columnKey(t, col.Name), columnDefault(col), "")
+	}
+	return v.result(), nil
+}
+
+func (e *executor) execShowIndex(ctx context.Context, db string, stmt *showIndex) (*sqltypes.Result, error) {
+	t, err := e.existingTable(ctx, db, stmt.table)
+	if err != nil {
+		return nil, err
+	}
+	v := newView(t.db, t.name, "Table", "Non_unique", "Key_name", "Seq_in_index", "Column_name", "Null", "Index_type")
+	for _, idx := range indexesOf(t) {
+		nonUnique := int64(1)
+		if idx.Unique {
+			nonUnique = 0
+		}
+		for i, column := range idx.Columns {
+			null := ""
+			if col := t.column(column); col != nil && !col.NotNull {
+				null = "YES"
+			}
+			v.add(t.name, nonUnique, idx.Name, int64(i+1), column, null, "BTREE")
+		}
+	}
+	return v.result(), nil
+}
+
+// indexesOf returns the indexes of the table, the primary key first.
+func indexesOf(t *table) []*indexDef {
+	indexes := []*indexDef{{Name: "PRIMARY", Columns: []string{t.primaryKey}, Unique: true}}
+	if t.def != nil {
+		indexes = append(indexes, t.def.Indexes...)
+	}
+	return indexes
+}
+
+func (t *table) column(name string) *columnDef {
+	for _, col := range t.columns() {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+func (e *executor) execShowCreateTable(ctx context.Context, db string, stmt *showCreateTable) (*sqltypes.Result, error) {
+	t, err := e.existingTable(ctx, db, stmt.table)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, col := range t.columns() {
+		line := "  `" + col.Name + "` " + col.Type
+		if col.NotNull {
+			line += " NOT NULL"
+		}
+		if col.DefaultNow {
+			line += " DEFAULT CURRENT_TIMESTAMP"
+		} else if col.Default != nil {
+			line += " DEFAULT '" + strings.Replace(valueText(col.Default), "'", "''", -1) + "'"
+		}
+		lines = append(lines, line)
+	}
+	for _, idx := range indexesOf(t) {
+		columns := "(`" + strings.Join(idx.Columns, "`,`") + "`)"
+		switch {
+		case idx.Name == "PRIMARY":
+			lines = append(lines, "  PRIMARY KEY "+columns)
+		case idx.Unique:
+			lines = append(lines, "  UNIQUE KEY `"+idx.Name+"` "+columns)
+		default:
+			lines = append(lines, "  KEY `"+idx.Name+"` "+columns)
+		}
+	}
+	create := "CREATE TABLE `" + t.name + "` (\n" + strings.Join(lines, ",\n") + "\n)"
+	if t.def != nil {
+		create += "\nPARTITION BY KEY (`" + t.def.PartitionKey + "`) PARTITIONS " + strconv.Itoa(t.def.Partitions)
+	}
+	v := newView(t.db, t.name, "Table", "Create Table")
+	v.add(t.name, create)
+	return v.result(), nil
+}
+
+// existingTable returns the table of the name, which must be a space of the db.
+func (e *executor) existingTable(ctx context.Context, db string, name sqlparser.TableName) (*table, error) {
+	t, err := e.tableOfName(ctx, db, name)
+	if err != nil {
+		return nil, err
+	}
+	if t.def != nil {
+		return t, nil
+	}
+	spaces, err := e.tableNames(ctx, t.db)
+	if err != nil {
+		return nil, err
+	}
+	for _, space := range spaces {
+		if space == t.name {
+			return t, nil
+		}
+	}
+	return nil, errNoSuchTable(t.db, t.name)
+}
+
+// databases returns the names of the dbs, information_schema included.
+func (e *executor) databases(ctx context.Context) ([]string, error) {
+	dbs, err := e.master.DBs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dbs = append(dbs, informationSchema)
+	sort.Strings(dbs)
+	return dbs, nil
+}
+
+// tableNames returns the names of the tables of the db, the spaces of the db.
+func (e *executor) tableNames(ctx context.Context, db string) ([]string, error) {
+	if strings.EqualFold(db, informationSchema) {
+		return informationSchemaTables, nil
+	}
+	names, err := e.master.Spaces(ctx, db)
+	if err == errDBNotExists {
+		return nil, errUnknownDB(db)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// execShow answers SHOW DATABASES and SHOW TABLES.
+func (e *executor) execShow(ctx context.Context, db string, show *sqlparser.Show) (*sqltypes.Result, error) {
+	switch show.Type {
+	case "databases":
+		dbs, err := e.databases(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v := newView("", "SCHEMATA", "Database")
+		for _, name := range dbs {
+			v.add(name)
+		}
+		return v.result(), nil
+	case "tables":
+		opt := show.ShowTablesOpt
+		if opt.DbName != "" {
+			db = opt.DbName
+		}
+		if db == "" {
+			return nil, errNoDB
+		}
+		names, err := e.tableNames(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		v := newView(db, "TABLES", "Tables_in_"+db)
+		if opt.Full != "" {
+			v.columns = append(v.columns, "Table_type")
+		}
+		for _, name := range names {
+			if opt.Full != "" {
+				v.add(name, tableType(db))
+			} else {
+				v.add(name)
+			}
+		}
+		if filter := opt.Filter; filter != nil {
+			if filter.Like != "" {
+				var rows []map[string]interface{}
+				for _, row := range v.rows {
+					if likeMatch(filter.Like, row[v.columns[0]].(string)) {
+						rows = append(rows, row)
+					}
+				}
+				v.rows = rows
+			} else if err := v.filter(sqlparser.NewWhere(sqlparser.WhereStr, filter.Filter)); err != nil {
+				return nil, err
+			}
+		}
+		return v.result(), nil
+	}
+	return nil, errNotSupported("SHOW %s is not supported", show.Type)
+}
+
+func tableType(db string) string {
+	if strings.EqualFold(db, informationSchema) {
+		return "SYSTEM VIEW"
+	}
+	return "BASE TABLE"
+}
+
+// the tables of information_schema
+var informationSchemaTables = []string{"COLUMNS", "SCHEMATA", "STATISTICS", "TABLES"}
+
+// informationSchemaView builds the table of information_schema from the dbs, the spaces and the
+// table definitions.
+func (e *executor) informationSchemaView(ctx context.Context, name string) (*view, error) {
+	var v *view
+	switch strings.ToUpper(name) {
+	case "SCHEMATA":
+		v = newView(informationSchema, "SCHEMATA", "CATALOG_NAME", "SCHEMA_NAME", "DEFAULT_CHARACTER_SET_NAME", "DEFAULT_COLLATION_NAME", "SQL_PATH")
+	case "TABLES":
+		v = newView(informationSchema, "TABLES", "TABLE_CATALOG", "TABLE_SCHEMA", "TABLE_NAME", "TABLE_TYPE", "ENGINE", "TABLE_ROWS", "TABLE_COLLATION", "TABLE_COMMENT")
+	case "COLUMNS":
+		v = newView(informationSchema, "COLUMNS", "TABLE_CATALOG", "TABLE_SCHEMA", "TABLE_NAME", "COLUMN_NAME", "ORDINAL_POSITION", "COLUMN_DEFAULT",
+			"IS_NULLABLE", "DATA_TYPE", "CHARACTER_MAXIMUM_LENGTH", "COLUMN_TYPE", "COLUMN_KEY", "EXTRA", "COLUMN_COMMENT")
+	case "STATISTICS":
+		v = newView(informationSchema, "STATISTICS", "TABLE_CATALOG", "TABLE_SCHEMA", "TABLE_NAME", "NON_UNIQUE", "INDEX_SCHEMA", "INDEX_NAME",
+			"SEQ_IN_INDEX", "COLUMN_NAME", "NULLABLE", "INDEX_TYPE")
+	default:
+		return nil, errNoSuchTable(informationSchema, name)
+	}
+
+	dbs, err := e.databases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range dbs {
+		if v.name == "SCHEMATA" {
+			v.add("def", db, "utf8", "utf8_general_ci", nil)
+			continue
+		}
+		names, err := e.tableNames(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if strings.EqualFold(db, informationSchema) {
+				if v.name == "TABLES" {
+					v.add("def", db, name, tableType(db), nil, nil, "utf8_general_ci", "")
+				}
+				continue
+			}
+			t, err := e.catalog.table(ctx, db, name)
+			if err != nil {
+				return nil, err
+			}
+			e.addInformationSchemaRows(v, t)
+		}
+	}
+	return v, nil
+}
+
+func (e *executor) addInformationSchemaRows(v *view, t *table) {
+	switch v.name {
+	case "TABLES":
+		v.add("def", t.db, t.name, tableType(t.db), "BaudEngine", nil, "utf8_general_ci", "")
+	case "COLUMNS":
+		for i, col := range t.columns() {
+			var length interface{}
+			if typ := col.sqlType(); sqltypes.IsQuoted(typ) {
+				if n, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(col.Type[len(col.baseType()):], "("), ")"), 10, 64); err == nil {
+					length = n
+				}
+			}
+			v.add("def", t.db, t.name, col.Name, int64(i+1), columnDefault(col), nullable(col), col.baseType(), length, col.Type,
+				columnKey(t, col.Name), "", "")
+		}
+	case "STATISTICS":
+		for _, idx := range indexesOf(t) {
+			nonUnique := int64(1)
+			if idx.Unique {
+				nonUnique = 0
+			}
+			for i, column := range idx.Columns {
+				null := ""
+				if col := t.column(column); col != nil && !col.NotNull {
+					null = "YES"
+				}
+				v.add("def", t.db, t.name, nonUnique, t.db, idx.Name, int64(i+1), column, null, "BTREE")
+			}
+		}
+	}
+}
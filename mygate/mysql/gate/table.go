@@ -0,0 +1,234 @@
+package mysql
+
+import (
+	"encoding/json"
+	"flag"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+var mysqlPrimaryKey = flag.String("mysql_primary_key", "id", "The primary key column of the tables without definition, its value is the id of the object of a row.")
+
+// tableField is the field of an object keeping the table of its row
+const tableField = "_table"
+
+// indexSeparator joins the values of the columns of a compound index
+const indexSeparator = "\x1f"
+
+// table maps a table to a space of the db of the same name, the rows are objects keyed by the
+// primary key. Tables sharing a partitioning key may share a space, their objects are told apart
+// by tableField. A table created by CREATE TABLE has a definition, the others take the rows as
+// they are.
+type table struct {
+	db         string
+	name       string
+	space      string
+	primaryKey string
+	def        *tableDef
+}
+
+// shared tells whether the space may hold the rows of other tables.
+func (t *table) shared() bool {
+	return t.space != t.name
+}
+
+// columns returns the columns of the definition, a table without definition has the primary key.
+func (t *table) columns() []*columnDef {
+	if t.def == nil {
+		return []*columnDef{{Name: t.primaryKey, Type: "varchar(255)", NotNull: true}}
+	}
+	return t.def.Columns
+}
+
+// compoundIndexes returns the indexes of the table having a field of their own.
+func (t *table) compoundIndexes() []*indexDef {
+	if t.def == nil {
+		return nil
+	}
+	var indexes []*indexDef
+	for _, idx := range t.def.Indexes {
+		if idx.compound() {
+			indexes = append(indexes, idx)
+		}
+	}
+	return indexes
+}
+
+// object returns the object of a row, the row holds the primary key.
+func (t *table) object(row map[string]interface{}) map[string]interface{} {
+	obj := make(map[string]interface{}, len(row)+1)
+	for column, value := range row {
+		obj[column] = value
+	}
+	obj[tableField] = t.name
+	for _, idx := range t.compoundIndexes() {
+		if key, ok := indexKey(idx, row); ok {
+			obj[idx.field()] = key
+		}
+	}
+	return obj
+}
+
+// indexKey returns the value of the field of the compound index, ok is false if a column of the
+// index is missing or NULL.
+func indexKey(idx *indexDef, row map[string]interface{}) (key string, ok bool) {
+	values := make([]string, len(idx.Columns))
+	for i, column := range idx.Columns {
+		v := row[column]
+		if v == nil {
+			return "", false
+		}
+		values[i] = valueText(v)
+	}
+	return strings.Join(values, indexSeparator), true
+}
+
+// owns tells whether the object is a row of the table.
+func (t *table) owns(obj map[string]interface{}) bool {
+	if !t.shared() {
+		return true
+	}
+	name, _ := obj[tableField].(string)
+	return name == t.name
+}
+
+// row returns the row of the object by id, the primary key is the id if the object lacks it.
+func (t *table) row(id string, obj map[string]interface{}) map[string]interface{} {
+	if obj == nil {
+		obj = make(map[string]interface{})
+	}
+	if _, ok := obj[t.primaryKey]; !ok {
+		obj[t.primaryKey] = id
+	}
+	return obj
+}
+
+// precondition keeps the writes by id to the objects of the table.
+func (t *table) precondition() *Precondition {
+	if !t.shared() {
+		return nil
+	}
+	return &Precondition{Match: map[string]interface{}{tableField: t.name}}
+}
+
+// insertRow checks the inserted row against the definition and returns it with the columns
+// named as defined, the values converted to the types of the columns and the missing columns set
+// to their defaults.
+func (t *table) insertRow(row map[string]interface{}) (map[string]interface{}, error) {
+	if t.def == nil {
+		return row, nil
+	}
+	checked, err := t.checkColumns(row)
+	if err != nil {
+		return nil, err
+	}
+	for _, col := range t.def.Columns {
+		if _, ok := checked[col.Name]; ok {
+			continue
+		}
+		switch {
+		case col.DefaultNow:
+			checked[col.Name] = time.Now().Format("2006-01-02 15:04:05")
+		case col.Default != nil:
+			value, err := columnValueOf(col, col.Default)
+			if err != nil {
+				return nil, err
+			}
+			checked[col.Name] = value
+		case col.NotNull:
+			return nil, mysql.NewSQLError(mysql.ERBadNullError, "23000", "Field '%s' doesn't have a default value", col.Name)
+		}
+	}
+	return checked, nil
+}
+
+// updateRow checks the columns set by an UPDATE against the definition.
+func (t *table) updateRow(doc map[string]interface{}) (map[string]interface{}, error) {
+	if t.def == nil {
+		return doc, nil
+	}
+	return t.checkColumns(doc)
+}
+
+func (t *table) checkColumns(row map[string]interface{}) (map[string]interface{}, error) {
+	checked := make(map[string]interface{}, len(row))
+	for column, v := range row {
+		col := t.def.column(column)
+		if col == nil {
+			return nil, mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Unknown column '%s' in 'field list'", column)
+		}
+		if v == nil {
+			if col.NotNull {
+				return nil, mysql.NewSQLError(mysql.ERBadNullError, "23000", "Column '%s' cannot be null", col.Name)
+			}
+			checked[col.Name] = nil
+			continue
+		}
+		value, err := columnValueOf(col, v)
+		if err != nil {
+			return nil, err
+		}
+		checked[col.Name] = value
+	}
+	return checked, nil
+}
+
+// columnValueOf converts the literal to the type of the column: the numbers of the text columns
+// are text, the text of the numeric columns is parsed and the numbers of the bool columns are
+// bools.
+func columnValueOf(col *columnDef, v interface{}) (interface{}, error) {
+	typ := col.sqlType()
+	switch base := col.baseType(); {
+	case base == "bool" || base == "boolean":
+		switch v := v.(type) {
+		case int64:
+			return v != 0, nil
+		case bool:
+			return v, nil
+		}
+	case sqltypes.IsIntegral(typ) || typ == sqltypes.Bit || typ == sqltypes.Year:
+		switch v := v.(type) {
+		case int64:
+			return v, nil
+		case bool:
+			if v {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		case float64:
+			if v == math.Trunc(v) {
+				return int64(v), nil
+			}
+		case json.Number:
+			if n, err := v.Int64(); err == nil {
+				return n, nil
+			}
+		case string:
+			if n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+				return n, nil
+			}
+		}
+	case sqltypes.IsFloat(typ) || typ == sqltypes.Decimal:
+		switch v := v.(type) {
+		case int64, float64:
+			return v, nil
+		case json.Number:
+			return v.Float64()
+		case string:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+				return f, nil
+			}
+		}
+	case typ == sqltypes.TypeJSON:
+		return v, nil
+	default:
+		return valueText(v), nil
+	}
+	return nil, mysql.NewSQLError(mysql.ERTruncatedWrongValueForField, mysql.SSUnknownSQLState,
+		"Incorrect %s value: '%s' for column '%s'", col.baseType(), valueText(v), col.Name)
+}
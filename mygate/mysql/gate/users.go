@@ -0,0 +1,582 @@
+package mysql
+
+import (
+	"flag"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/tiglabs/baudengine/mygate/auth"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	mysqlRootPassword = flag.String("mysql_root_password", "", "The password of root, the user holding every privilege.")
+	mysqlUserTTL      = flag.Duration("mysql_user_ttl", 10*time.Second, "How long a user is cached, the grants of the other gates show after it.")
+)
+
+// The users of the gate are kept in the users space of the system db, see mygate/auth, the
+// router checks its requests against the same users. The gate matches the statements below
+// itself, a user is matched by name, the host is kept but not matched:
+//   CREATE USER [IF NOT EXISTS] user [IDENTIFIED [WITH plugin] BY 'password']
+//   DROP USER [IF EXISTS] user
+//   GRANT privileges ON {*.* | db.* | db.table | table} TO user [WITH GRANT OPTION]
+//   REVOKE privileges ON {*.* | db.* | db.table | table} FROM user
+//   SHOW GRANTS [FOR user]
+
+// the error numbers of MySQL the vitess constants lack
+const (
+	erNonexistingGrant        = 1141
+	erSpecificAccessDenied    = 1227
+	erCantCreateUserWithGrant = 1410
+	erCannotUser              = 1396
+)
+
+const (
+	quotedPattern = "('[^']*'|\"[^\"]*\"|`[^`]+`"
+	userPattern   = quotedPattern + `|[\w$.-]+)(?:@` + quotedPattern + `|[\w$.%-]+))?`
+	targetPattern = "(\\*|`[^`]+`|[\\w$]+)(?:\\.(\\*|`[^`]+`|[\\w$]+))?"
+)
+
+var (
+	createUserPattern = regexp.MustCompile(`(?is)^\s*create\s+user\s+(if\s+not\s+exists\s+)?` + userPattern +
+		`(?:\s+identified\s+(?:with\s+('?\w+'?)(?:\s+by\s+'([^']*)')?|by\s+'([^']*)'))?\s*$`)
+	dropUserPattern   = regexp.MustCompile(`(?is)^\s*drop\s+user\s+(if\s+exists\s+)?` + userPattern + `\s*$`)
+	grantPattern      = regexp.MustCompile(`(?is)^\s*grant\s+(.+?)\s+on\s+(?:table\s+)?` + targetPattern + `\s+to\s+` + userPattern + `(\s+with\s+grant\s+option)?\s*$`)
+	revokePattern     = regexp.MustCompile(`(?is)^\s*revoke\s+(.+?)\s+on\s+(?:table\s+)?` + targetPattern + `\s+from\s+` + userPattern + `\s*$`)
+	showGrantsPattern = regexp.MustCompile(`(?is)^\s*show\s+grants(?:\s+for\s+` + userPattern + `)?\s*$`)
+)
+
+// sensitivePattern matches the statements which may carry a password, they are never logged
+var sensitivePattern = regexp.MustCompile(`(?is)^\s*(create\s+user|alter\s+user|set\s+password|grant|revoke)\b`)
+
+// redactStatement returns the statement as it may be logged, a statement on the users is cut to
+// its verb.
+func redactStatement(sql string) string {
+	if m := sensitivePattern.FindStringSubmatch(sql); m != nil {
+		return strings.ToUpper(strings.Join(strings.Fields(m[1]), " ")) + " <redacted>"
+	}
+	return sql
+}
+
+// redactQuery redacts every statement of the query.
+func redactQuery(query string) string {
+	stmts, err := sqlparser.SplitStatementToPieces(query)
+	if err != nil {
+		return redactStatement(query)
+	}
+	for i, stmt := range stmts {
+		stmts[i] = redactStatement(stmt)
+	}
+	return strings.Join(stmts, "; ")
+}
+
+type createUser struct {
+	name, host  string
+	plugin      string
+	password    string
+	ifNotExists bool
+}
+
+type dropUser struct {
+	name     string
+	ifExists bool
+}
+
+// grantPrivileges grants or revokes the privileges on the table of the db, the db is empty for
+// the db of the connection.
+type grantPrivileges struct {
+	privileges []string
+	db, table  string
+	name       string
+	revoke     bool
+}
+
+type showGrants struct {
+	name string
+}
+
+// unquoteUser returns the user or the host without its quotes.
+func unquoteUser(name string) string {
+	if len(name) >= 2 && strings.IndexByte("'\"`", name[0]) >= 0 {
+		return name[1 : len(name)-1]
+	}
+	return name
+}
+
+// parseUserStatement parses the statements on the users, ok is false for the others.
+func parseUserStatement(sql string) (stmt interface{}, ok bool, err error) {
+	if m := createUserPattern.FindStringSubmatch(sql); m != nil {
+		create := &createUser{name: unquoteUser(m[2]), host: unquoteUser(m[3]), plugin: unquoteUser(m[4]), ifNotExists: m[1] != ""}
+		create.password = m[5] + m[6]
+		if create.plugin == "" {
+			create.plugin = auth.NativePassword
+		}
+		create.plugin = strings.ToLower(create.plugin)
+		return create, true, nil
+	}
+	if m := dropUserPattern.FindStringSubmatch(sql); m != nil {
+		return &dropUser{name: unquoteUser(m[2]), ifExists: m[1] != ""}, true, nil
+	}
+	if m := grantPattern.FindStringSubmatch(sql); m != nil {
+		grant, err := parseGrant(m[1], m[2], m[3], m[4])
+		if err != nil {
+			return nil, true, err
+		}
+		if m[6] != "" {
+			grant.privileges = append(grant.privileges, auth.GrantOption)
+		}
+		return grant, true, nil
+	}
+	if m := revokePattern.FindStringSubmatch(sql); m != nil {
+		grant, err := parseGrant(m[1], m[2], m[3], m[4])
+		if err != nil {
+			return nil, true, err
+		}
+		grant.revoke = true
+		return grant, true, nil
+	}
+	if m := showGrantsPattern.FindStringSubmatch(sql); m != nil {
+		return &showGrants{name: unquoteUser(m[1])}, true, nil
+	}
+	return nil, false, nil
+}
+
+// parseGrant parses the privileges, the target db.table of a GRANT or a REVOKE and its user.
+func parseGrant(privileges, first, second, user string) (*grantPrivileges, error) {
+	grant := &grantPrivileges{name: unquoteUser(user), table: unquote(first)}
+	if second != "" {
+		grant.db, grant.table = unquote(first), unquote(second)
+	}
+	if grant.db == auth.Wildcard && grant.table != auth.Wildcard {
+		return nil, mysql.NewSQLError(mysql.ERSyntaxError, "42000", "You have an error in your SQL syntax near '*.%s'", grant.table)
+	}
+	for _, name := range strings.Split(privileges, ",") {
+		privilege, ok := auth.ParsePrivilege(name)
+		if !ok {
+			return nil, mysql.NewSQLError(mysql.ERSyntaxError, "42000", "Illegal privilege %s", strings.TrimSpace(name))
+		}
+		grant.privileges = append(grant.privileges, privilege)
+	}
+	return grant, nil
+}
+
+// userStore keeps the users in the users space of the system db and caches them, root is not
+// stored, its password is -mysql_root_password.
+type userStore struct {
+	backend Backend
+	master  Master
+	ttl     time.Duration
+	root    *auth.User
+
+	lock  sync.Mutex
+	ready bool
+	users map[string]*cachedUser
+}
+
+type cachedUser struct {
+	user   *auth.User
+	expire time.Time
+}
+
+func newUserStore(backend Backend, master Master, ttl time.Duration, rootPassword string) *userStore {
+	return &userStore{
+		backend: backend,
+		master:  master,
+		ttl:     ttl,
+		root:    auth.Root(rootPassword),
+		users:   make(map[string]*cachedUser),
+	}
+}
+
+// prepare creates the system db and the users space if they do not exist.
+func (s *userStore) prepare(ctx context.Context) error {
+	s.lock.Lock()
+	ready := s.ready
+	s.lock.Unlock()
+	if ready {
+		return nil
+	}
+
+	if err := s.master.CreateDB(ctx, auth.SystemDB); err != nil && err != errDupDB {
+		return err
+	}
+	policy := &PartitionPolicy{Key: "name", Function: "hash", Number: 1}
+	if err := s.master.CreateSpace(ctx, auth.SystemDB, auth.UsersSpace, auth.UsersSchema, policy); err != nil && err != errDupSpace {
+		return err
+	}
+	s.lock.Lock()
+	s.ready = true
+	s.lock.Unlock()
+	return nil
+}
+
+// user returns the user of the name, nil if there is none.
+func (s *userStore) user(ctx context.Context, name string) (*auth.User, error) {
+	if name == auth.RootUser {
+		return s.root, nil
+	}
+	s.lock.Lock()
+	cached, ok := s.users[name]
+	s.lock.Unlock()
+	if ok && time.Now().Before(cached.expire) {
+		return cached.user, nil
+	}
+
+	if err := s.prepare(ctx); err != nil {
+		return nil, err
+	}
+	obj, err := s.backend.Get(ctx, auth.SystemDB, auth.UsersSpace, name)
+	if err != nil {
+		return nil, err
+	}
+	var u *auth.User
+	if obj != nil {
+		u = new(auth.User)
+		if err := convertObject(obj, u); err != nil {
+			return nil, err
+		}
+	}
+	s.cache(name, u)
+	return u, nil
+}
+
+func (s *userStore) cache(name string, u *auth.User) {
+	s.lock.Lock()
+	s.users[name] = &cachedUser{user: u, expire: time.Now().Add(s.ttl)}
+	s.lock.Unlock()
+}
+
+// put writes the user, created is false if the user exists and create is set.
+func (s *userStore) put(ctx context.Context, u *auth.User, create bool) (created bool, err error) {
+	if err := s.prepare(ctx); err != nil {
+		return false, err
+	}
+	doc := make(map[string]interface{})
+	if err := convertObject(u, &doc); err != nil {
+		return false, err
+	}
+	w := Write{Op: "create", ID: u.Name, Doc: doc}
+	if create {
+		w.Precondition = &Precondition{NotExists: true}
+	}
+	results, err := s.backend.Bulk(ctx, auth.SystemDB, auth.UsersSpace, []Write{w})
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case strings.Contains(results[0].Error, preconditionFailed):
+		return false, nil
+	case results[0].Error != "":
+		return false, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "write of user %s failed: %s", u.Name, results[0].Error)
+	}
+	s.cache(u.Name, u)
+	return true, nil
+}
+
+// drop deletes the user, found is false if there is none.
+func (s *userStore) drop(ctx context.Context, name string) (found bool, err error) {
+	if err := s.prepare(ctx); err != nil {
+		return false, err
+	}
+	results, err := s.backend.Bulk(ctx, auth.SystemDB, auth.UsersSpace, []Write{{Op: "delete", ID: name}})
+	if err != nil {
+		return false, err
+	}
+	if results[0].Error != "" {
+		return false, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "delete of user %s failed: %s", name, results[0].Error)
+	}
+	s.cache(name, nil)
+	return results[0].Result == writeDeleted, nil
+}
+
+func errCannotUser(operation, name string) error {
+	return mysql.NewSQLError(erCannotUser, mysql.SSUnknownSQLState, "Operation %s failed for '%s'", operation, name)
+}
+
+func (e *executor) execCreateUser(ctx context.Context, stmt *createUser) (*sqltypes.Result, error) {
+	if stmt.name == auth.RootUser {
+		return nil, errCannotUser("CREATE USER", stmt.name)
+	}
+	u, err := auth.NewUser(stmt.name, stmt.host, stmt.plugin, stmt.password)
+	if err != nil {
+		return nil, errNotSupported("authentication plugin %s is not supported", stmt.plugin)
+	}
+	created, err := e.users.put(ctx, u, true)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		if stmt.ifNotExists {
+			return &sqltypes.Result{}, nil
+		}
+		return nil, errCannotUser("CREATE USER", stmt.name)
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func (e *executor) execDropUser(ctx context.Context, stmt *dropUser) (*sqltypes.Result, error) {
+	if stmt.name == auth.RootUser {
+		return nil, errCannotUser("DROP USER", stmt.name)
+	}
+	found, err := e.users.drop(ctx, stmt.name)
+	if err != nil {
+		return nil, err
+	}
+	if !found && !stmt.ifExists {
+		return nil, errCannotUser("DROP USER", stmt.name)
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// grantTarget returns the db and the table of the GRANT, the db of the connection if it names
+// none.
+func grantTarget(db string, stmt *grantPrivileges) (string, string, error) {
+	if stmt.db != "" {
+		return stmt.db, stmt.table, nil
+	}
+	if db == "" {
+		return "", "", errNoDB
+	}
+	return db, stmt.table, nil
+}
+
+func (e *executor) execGrant(ctx context.Context, db string, stmt *grantPrivileges) (*sqltypes.Result, error) {
+	db, table, err := grantTarget(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	if stmt.name == auth.RootUser {
+		return nil, errNotSupported("the privileges of %s can not be changed", auth.RootUser)
+	}
+	u, err := e.users.user(ctx, stmt.name)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		if stmt.revoke {
+			return nil, mysql.NewSQLError(erNonexistingGrant, "42000", "There is no such grant defined for user '%s'", stmt.name)
+		}
+		return nil, mysql.NewSQLError(erCantCreateUserWithGrant, "42000", "You are not allowed to create a user with GRANT")
+	}
+	// the user of the cache is shared with the connections checked against it
+	changed := *u
+	changed.Grants = make([]*auth.Grant, len(u.Grants))
+	for i, g := range u.Grants {
+		copied := *g
+		copied.Privileges = append([]string(nil), g.Privileges...)
+		changed.Grants[i] = &copied
+	}
+	if stmt.revoke {
+		if !changed.Revoke(stmt.privileges, db, table) {
+			return nil, mysql.NewSQLError(erNonexistingGrant, "42000", "There is no such grant defined for user '%s' on %s.%s", stmt.name, db, table)
+		}
+	} else {
+		changed.Grant(stmt.privileges, db, table)
+	}
+	if _, err := e.users.put(ctx, &changed, false); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// execShowGrants lists the grants of the user as the GRANT statements giving them.
+func (e *executor) execShowGrants(ctx context.Context, c *mysql.Conn, stmt *showGrants) (*sqltypes.Result, error) {
+	name := stmt.name
+	if name == "" {
+		name = c.User
+	}
+	u, err := e.users.user(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, mysql.NewSQLError(erNonexistingGrant, "42000", "There is no such grant defined for user '%s'", name)
+	}
+	host := u.Host
+	if host == "" {
+		host = "%"
+	}
+	v := newView("", "grants", "Grants for "+u.Name+"@"+host)
+	account := "'" + u.Name + "'@'" + host + "'"
+	if len(u.Grants) == 0 {
+		v.add("GRANT USAGE ON *.* TO " + account)
+	}
+	for _, g := range u.Grants {
+		var privileges []string
+		for _, p := range append([]string{auth.All}, auth.Privileges...) {
+			if p != auth.GrantOption && grantHolds(g, p) {
+				privileges = append(privileges, p)
+			}
+		}
+		if grantHolds(g, auth.All) {
+			privileges = privileges[:1]
+		}
+		if len(privileges) == 0 {
+			privileges = []string{"USAGE"}
+		}
+		target := grantName(g.DB) + "." + grantName(g.Table)
+		text := "GRANT " + strings.Join(privileges, ", ") + " ON " + target + " TO " + account
+		if grantHolds(g, auth.GrantOption) {
+			text += " WITH GRANT OPTION"
+		}
+		v.add(text)
+	}
+	return v.result(), nil
+}
+
+func grantHolds(g *auth.Grant, privilege string) bool {
+	for _, p := range g.Privileges {
+		if p == privilege {
+			return true
+		}
+	}
+	return false
+}
+
+func grantName(name string) string {
+	if name == auth.Wildcard {
+		return name
+	}
+	return "`" + name + "`"
+}
+
+// privilege is a privilege a statement needs on a table of a db, on all the tables of the db if
+// the table is "*" and on all the dbs if the db is "*" too.
+type privilege struct {
+	name      string
+	db, table string
+}
+
+// privilegesOf returns the privileges the statement of the connection needs.
+func privilegesOf(c *mysql.Conn, stmt interface{}) ([]privilege, error) {
+	db := c.SchemaName
+	var needed []privilege
+	need := func(name string, table sqlparser.TableName) error {
+		qualified, err := qualifiedDB(db, table)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(qualified, informationSchema) {
+			needed = append(needed, privilege{name: name, db: qualified, table: table.Name.String()})
+		}
+		return nil
+	}
+	// needTables needs the privilege on every table the statement reads from or writes to
+	needTables := func(name string, node sqlparser.SQLNode) error {
+		var tables []sqlparser.TableName
+		sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if aliased, ok := node.(*sqlparser.AliasedTableExpr); ok {
+				if table, ok := aliased.Expr.(sqlparser.TableName); ok && !(table.Qualifier.IsEmpty() && table.Name.String() == "dual") {
+					tables = append(tables, table)
+				}
+			}
+			return true, nil
+		}, node)
+		for _, table := range tables {
+			if err := need(name, table); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var err error
+	switch stmt := stmt.(type) {
+	case *createDatabase:
+		needed = append(needed, privilege{name: auth.Create, db: stmt.name, table: auth.Wildcard})
+	case *dropDatabase:
+		needed = append(needed, privilege{name: auth.Drop, db: stmt.name, table: auth.Wildcard})
+	case *createTable:
+		err = need(auth.Create, stmt.ddl.NewName)
+	case *alterTable:
+		if stmt.index {
+			err = need(auth.Index, stmt.table)
+		} else {
+			err = need(auth.Alter, stmt.table)
+		}
+	case *dropIndex:
+		err = need(auth.Index, stmt.table)
+	case *showColumns:
+		err = need(auth.Select, stmt.table)
+	case *showIndex:
+		err = need(auth.Select, stmt.table)
+	case *showCreateTable:
+		err = need(auth.Select, stmt.table)
+	case *explainSelect:
+		err = needTables(auth.Select, stmt.sel)
+	case *createUser, *dropUser:
+		needed = append(needed, privilege{name: auth.CreateUser, db: auth.Wildcard, table: auth.Wildcard})
+	case *grantPrivileges:
+		// a user grants only the privileges they hold
+		target, table, err := grantTarget(db, stmt)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range append(stmt.privileges, auth.GrantOption) {
+			needed = append(needed, privilege{name: p, db: target, table: table})
+		}
+	case *showGrants:
+		if stmt.name != "" && stmt.name != c.User {
+			needed = append(needed, privilege{name: auth.Select, db: auth.SystemDB, table: auth.UsersSpace})
+		}
+	case *sqlparser.Select:
+		err = needTables(auth.Select, stmt)
+	case *sqlparser.Union:
+		err = needTables(auth.Select, stmt)
+	case *sqlparser.Insert:
+		if err = need(auth.Insert, stmt.Table); err == nil && stmt.Action == sqlparser.ReplaceStr {
+			err = need(auth.Delete, stmt.Table)
+		}
+		if err == nil && len(stmt.OnDup) > 0 {
+			err = need(auth.Update, stmt.Table)
+		}
+	case *sqlparser.Update:
+		err = needTables(auth.Update, stmt.TableExprs)
+	case *sqlparser.Delete:
+		err = needTables(auth.Delete, stmt.TableExprs)
+	case *sqlparser.DDL:
+		if stmt.Action == sqlparser.DropStr {
+			err = need(auth.Drop, stmt.Table)
+		}
+	}
+	return needed, err
+}
+
+// authorize checks that the user of the connection holds the privileges the statement needs.
+func (e *executor) authorize(ctx context.Context, c *mysql.Conn, stmt interface{}) error {
+	if !e.checkGrants {
+		return nil
+	}
+	needed, err := privilegesOf(c, stmt)
+	if err != nil || len(needed) == 0 {
+		return err
+	}
+	u, err := e.users.user(ctx, c.User)
+	if err != nil {
+		return err
+	}
+	if u == nil {
+		// dropped since the connection was authenticated
+		return mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%s'", c.User)
+	}
+	for _, p := range needed {
+		if u.Allowed(p.name, p.db, p.table) {
+			continue
+		}
+		switch {
+		case p.db == auth.Wildcard:
+			return mysql.NewSQLError(erSpecificAccessDenied, "42000", "Access denied; you need (at least one of) the %s privilege(s) for this operation", p.name)
+		case p.table == auth.Wildcard:
+			return mysql.NewSQLError(mysql.ERDBAccessDenied, "42000", "Access denied for user '%s' to database '%s'", c.User, p.db)
+		}
+		return mysql.NewSQLError(mysql.ERTableAccessDenied, "42000", "%s command denied to user '%s' for table '%s'", p.name, c.User, p.table)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package mysql
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/tiglabs/baudengine/mygate/auth"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+// expectError runs the statement and checks the number of the error it fails with.
+func expectError(t *testing.T, e *executor, c *mysql.Conn, sql string, number int) {
+	_, err := execSQL(t, e, c, sql)
+	if err == nil {
+		t.Fatalf("%s: succeeded", sql)
+	}
+	if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != number {
+		t.Fatalf("%s: unexpected error %v, expect errno %d", sql, err, number)
+	}
+}
+
+func TestUsers(t *testing.T) {
+	backend := newMemBackend()
+	e := newExecutor(backend, newMemMaster(backend), 100)
+	e.checkGrants = true
+	root := &mysql.Conn{User: auth.RootUser, SchemaName: "shop"}
+	mustExec(t, e, root, "create database shop")
+	mustExec(t, e, root, "create table orders (id int primary key, amount int)")
+	mustExec(t, e, root, "create user 'alice'@'%' identified by 'password'")
+	mustExec(t, e, root, "create user bob identified with 'caching_sha2_password' by 'password'")
+	expectError(t, e, root, "create user alice", erCannotUser)
+	mustExec(t, e, root, "create user if not exists alice")
+
+	stored := backend.spaces[auth.UsersSpace]["alice"]
+	if stored["plugin"] != auth.NativePassword || stored["auth_string"] != "*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19" || stored["host"] != "%" {
+		t.Fatalf("unexpected stored user %v", stored)
+	}
+	if u, _ := e.users.user(context.Background(), "bob"); u == nil || u.Plugin != auth.CachingSha2Password || !u.CheckPassword("password") {
+		t.Fatalf("unexpected user %v", u)
+	}
+
+	alice := &mysql.Conn{User: "alice", SchemaName: "shop"}
+	expectError(t, e, alice, "select * from orders", mysql.ERTableAccessDenied)
+	mustExec(t, e, root, "grant select on shop.* to alice")
+	mustExec(t, e, alice, "select * from orders")
+	expectError(t, e, alice, "insert into orders (id, amount) values (1, 10)", mysql.ERTableAccessDenied)
+	mustExec(t, e, root, "grant insert, update on orders to 'alice'@'%'")
+	mustExec(t, e, alice, "insert into orders (id, amount) values (1, 10)")
+	mustExec(t, e, alice, "update orders set amount = 20 where id = 1")
+	expectError(t, e, alice, "delete from orders where id = 1", mysql.ERTableAccessDenied)
+	expectError(t, e, alice, "drop database shop", mysql.ERDBAccessDenied)
+	expectError(t, e, alice, "create user carol", erSpecificAccessDenied)
+	expectError(t, e, alice, "grant select on shop.* to bob", mysql.ERDBAccessDenied)
+	expectError(t, e, alice, "show grants for bob", mysql.ERTableAccessDenied)
+	expectError(t, e, root, "grant select on shop.* to carol", erCantCreateUserWithGrant)
+	expectError(t, e, root, "grant super on *.* to alice", mysql.ERSyntaxError)
+
+	result := mustExec(t, e, alice, "show grants")
+	var grants [][]string
+	for _, row := range result.Rows {
+		grants = append(grants, []string{row[0].ToString()})
+	}
+	expect := "GRANT SELECT ON `shop`.* TO 'alice'@'%';GRANT INSERT, UPDATE ON `shop`.`orders` TO 'alice'@'%'"
+	if text := resultText(grants); text != expect || result.Fields[0].Name != "Grants for alice@%" {
+		t.Fatalf("grants %s, expect %s", text, expect)
+	}
+
+	// the grant option lets alice grant the privileges of the table it holds
+	mustExec(t, e, root, "grant select on shop.orders to alice with grant option")
+	mustExec(t, e, alice, "grant select on orders to bob")
+	expectError(t, e, alice, "grant delete on orders to bob", mysql.ERTableAccessDenied)
+	result = mustExec(t, e, root, "show grants for bob")
+	if len(result.Rows) != 1 || result.Rows[0][0].ToString() != "GRANT SELECT ON `shop`.`orders` TO 'bob'@'%'" {
+		t.Fatalf("unexpected grants of bob %v", result.Rows)
+	}
+
+	mustExec(t, e, root, "revoke select on shop.* from alice")
+	mustExec(t, e, root, "revoke all privileges, grant option on shop.orders from alice")
+	expectError(t, e, alice, "select * from orders", mysql.ERTableAccessDenied)
+	expectError(t, e, root, "revoke select on shop.* from alice", erNonexistingGrant)
+	if result := mustExec(t, e, alice, "show grants"); result.Rows[0][0].ToString() != "GRANT USAGE ON *.* TO 'alice'@'%'" {
+		t.Fatalf("unexpected grants after revoke %v", result.Rows)
+	}
+
+	mustExec(t, e, root, "drop user alice")
+	expectError(t, e, alice, "insert into orders (id, amount) values (2, 10)", mysql.ERAccessDeniedError)
+	expectError(t, e, root, "drop user alice", erCannotUser)
+	mustExec(t, e, root, "drop user if exists alice")
+	expectError(t, e, root, "drop user root", erCannotUser)
+
+	// without checks every connection holds every privilege
+	e.checkGrants = false
+	mustExec(t, e, &mysql.Conn{SchemaName: "shop"}, "delete from orders where id = 1")
+}
+
+func TestRedactQuery(t *testing.T) {
+	tests := []struct {
+		query, logged string
+	}{
+		{"select * from orders where id = 'password'", "select * from orders where id = 'password'"},
+		{"create user 'alice'@'%' identified by 'secret'", "CREATE USER <redacted>"},
+		{" Set  Password for alice = 'secret'", "SET PASSWORD <redacted>"},
+		{"alter user alice identified by 'secret'", "ALTER USER <redacted>"},
+		{"grant select on shop.* to alice", "GRANT <redacted>"},
+		{"use shop; create user bob identified by 'secret'", "use shop; CREATE USER <redacted>"},
+	}
+	for _, test := range tests {
+		if logged := redactQuery(test.query); logged != test.logged {
+			t.Errorf("%s: logged as %q, expect %q", test.query, logged, test.logged)
+		}
+	}
+}
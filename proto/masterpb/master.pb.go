@@ -17,6 +17,9 @@
 		GetSpaceResponse
 		GetRouteRequest
 		GetRouteResponse
+		WatchRoutesRequest
+		RouteEvent
+		WatchRoutesResponse
 		PSRegisterRequest
 		PSRegisterResponse
 		CreatePartitionRequest
@@ -30,6 +33,7 @@
 		PSConfig
 		PSHeartbeatRequest
 		PSHeartbeatResponse
+		ReplicaDiskState
 		PartitionInfo
 		RuntimeInfo
 		RaftStatus
@@ -39,7 +43,7 @@
 */
 package masterpb
 
-import proto "github.com/golang/protobuf/proto"
+import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
@@ -54,6 +58,7 @@ import binary "encoding/binary"
 
 import strings "strings"
 import reflect "reflect"
+import sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -66,7 +71,29 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type RouteEventType int32
+
+const (
+	// the epoch, the leader or the slots of the partition changed
+	RouteEventType_RouteUpdate RouteEventType = 0
+	RouteEventType_RouteDelete RouteEventType = 1
+)
+
+var RouteEventType_name = map[int32]string{
+	0: "RouteUpdate",
+	1: "RouteDelete",
+}
+var RouteEventType_value = map[string]int32{
+	"RouteUpdate": 0,
+	"RouteDelete": 1,
+}
+
+func (x RouteEventType) String() string {
+	return proto.EnumName(RouteEventType_name, int32(x))
+}
+func (RouteEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMaster, []int{0} }
 
 type ReplicaChangeType int32
 
@@ -87,7 +114,35 @@ var ReplicaChangeType_value = map[string]int32{
 func (x ReplicaChangeType) String() string {
 	return proto.EnumName(ReplicaChangeType_name, int32(x))
 }
-func (ReplicaChangeType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMaster, []int{0} }
+func (ReplicaChangeType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMaster, []int{1} }
+
+// DiskState is where the disk usage of a ps stands against its watermarks.
+type DiskState int32
+
+const (
+	// under the high watermark, or back under the low watermark
+	DiskState_DiskNormal DiskState = 0
+	// over the high watermark, the partitions are read-only
+	DiskState_DiskReadOnly DiskState = 1
+	// over the flood watermark, new replicas are refused as well
+	DiskState_DiskFull DiskState = 2
+)
+
+var DiskState_name = map[int32]string{
+	0: "DiskNormal",
+	1: "DiskReadOnly",
+	2: "DiskFull",
+}
+var DiskState_value = map[string]int32{
+	"DiskNormal":   0,
+	"DiskReadOnly": 1,
+	"DiskFull":     2,
+}
+
+func (x DiskState) String() string {
+	return proto.EnumName(DiskState_name, int32(x))
+}
+func (DiskState) EnumDescriptor() ([]byte, []int) { return fileDescriptorMaster, []int{2} }
 
 type GMaster struct {
 	Id      uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -176,6 +231,34 @@ func (m *GetRouteResponse) Reset()                    { *m = GetRouteResponse{}
 func (*GetRouteResponse) ProtoMessage()               {}
 func (*GetRouteResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{8} }
 
+type WatchRoutesRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	DB                 github_com_tiglabs_baudengine_proto_metapb.DBID    `protobuf:"varint,2,opt,name=db,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.DBID" json:"db,omitempty"`
+	Space              github_com_tiglabs_baudengine_proto_metapb.SpaceID `protobuf:"varint,3,opt,name=space,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.SpaceID" json:"space,omitempty"`
+}
+
+func (m *WatchRoutesRequest) Reset()                    { *m = WatchRoutesRequest{} }
+func (*WatchRoutesRequest) ProtoMessage()               {}
+func (*WatchRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{9} }
+
+type RouteEvent struct {
+	Type  RouteEventType `protobuf:"varint,1,opt,name=type,proto3,enum=RouteEventType" json:"type,omitempty"`
+	Route Route          `protobuf:"bytes,2,opt,name=route" json:"route"`
+}
+
+func (m *RouteEvent) Reset()                    { *m = RouteEvent{} }
+func (*RouteEvent) ProtoMessage()               {}
+func (*RouteEvent) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{10} }
+
+type WatchRoutesResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Events              []RouteEvent `protobuf:"bytes,2,rep,name=events" json:"events"`
+}
+
+func (m *WatchRoutesResponse) Reset()                    { *m = WatchRoutesResponse{} }
+func (*WatchRoutesResponse) ProtoMessage()               {}
+func (*WatchRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{11} }
+
 type PSRegisterRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	NodeID             github_com_tiglabs_baudengine_proto_metapb.NodeID `protobuf:"varint,2,opt,name=nodeID,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.NodeID" json:"nodeID,omitempty"`
@@ -185,7 +268,7 @@ type PSRegisterRequest struct {
 
 func (m *PSRegisterRequest) Reset()                    { *m = PSRegisterRequest{} }
 func (*PSRegisterRequest) ProtoMessage()               {}
-func (*PSRegisterRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{9} }
+func (*PSRegisterRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{12} }
 
 type PSRegisterResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -196,7 +279,7 @@ type PSRegisterResponse struct {
 
 func (m *PSRegisterResponse) Reset()                    { *m = PSRegisterResponse{} }
 func (*PSRegisterResponse) ProtoMessage()               {}
-func (*PSRegisterResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{10} }
+func (*PSRegisterResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{13} }
 
 type CreatePartitionRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -205,7 +288,7 @@ type CreatePartitionRequest struct {
 
 func (m *CreatePartitionRequest) Reset()                    { *m = CreatePartitionRequest{} }
 func (*CreatePartitionRequest) ProtoMessage()               {}
-func (*CreatePartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{11} }
+func (*CreatePartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{14} }
 
 type CreatePartitionResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -214,7 +297,7 @@ type CreatePartitionResponse struct {
 
 func (m *CreatePartitionResponse) Reset()                    { *m = CreatePartitionResponse{} }
 func (*CreatePartitionResponse) ProtoMessage()               {}
-func (*CreatePartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{12} }
+func (*CreatePartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{15} }
 
 type DeletePartitionRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -224,7 +307,7 @@ type DeletePartitionRequest struct {
 
 func (m *DeletePartitionRequest) Reset()                    { *m = DeletePartitionRequest{} }
 func (*DeletePartitionRequest) ProtoMessage()               {}
-func (*DeletePartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{13} }
+func (*DeletePartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{16} }
 
 type DeletePartitionResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -232,7 +315,7 @@ type DeletePartitionResponse struct {
 
 func (m *DeletePartitionResponse) Reset()                    { *m = DeletePartitionResponse{} }
 func (*DeletePartitionResponse) ProtoMessage()               {}
-func (*DeletePartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{14} }
+func (*DeletePartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{17} }
 
 type ChangeReplicaRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -243,7 +326,7 @@ type ChangeReplicaRequest struct {
 
 func (m *ChangeReplicaRequest) Reset()                    { *m = ChangeReplicaRequest{} }
 func (*ChangeReplicaRequest) ProtoMessage()               {}
-func (*ChangeReplicaRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{15} }
+func (*ChangeReplicaRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{18} }
 
 type ChangeReplicaResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -251,16 +334,18 @@ type ChangeReplicaResponse struct {
 
 func (m *ChangeReplicaResponse) Reset()                    { *m = ChangeReplicaResponse{} }
 func (*ChangeReplicaResponse) ProtoMessage()               {}
-func (*ChangeReplicaResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{16} }
+func (*ChangeReplicaResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{19} }
 
 type ChangeLeaderRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	// the replica to be the leader
+	Replica meta.Replica `protobuf:"bytes,3,opt,name=replica" json:"replica"`
 }
 
 func (m *ChangeLeaderRequest) Reset()                    { *m = ChangeLeaderRequest{} }
 func (*ChangeLeaderRequest) ProtoMessage()               {}
-func (*ChangeLeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{17} }
+func (*ChangeLeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{20} }
 
 type ChangeLeaderResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -268,7 +353,7 @@ type ChangeLeaderResponse struct {
 
 func (m *ChangeLeaderResponse) Reset()                    { *m = ChangeLeaderResponse{} }
 func (*ChangeLeaderResponse) ProtoMessage()               {}
-func (*ChangeLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{18} }
+func (*ChangeLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{21} }
 
 type PSConfig struct {
 	RPCPort                 int    `protobuf:"varint,1,opt,name=rpc_port,json=rpcPort,proto3,casttype=int" json:"rpc_port,omitempty"`
@@ -284,26 +369,47 @@ type PSConfig struct {
 
 func (m *PSConfig) Reset()                    { *m = PSConfig{} }
 func (*PSConfig) ProtoMessage()               {}
-func (*PSConfig) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{19} }
+func (*PSConfig) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{22} }
 
 type PSHeartbeatRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	NodeID             github_com_tiglabs_baudengine_proto_metapb.NodeID `protobuf:"varint,2,opt,name=nodeID,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.NodeID" json:"nodeID,omitempty"`
 	Partitions         []PartitionInfo                                   `protobuf:"bytes,3,rep,name=partitions" json:"partitions"`
 	SysStats           NodeSysStats                                      `protobuf:"bytes,4,opt,name=sys_stats,json=sysStats" json:"sys_stats"`
+	// the versions of the dictionaries of the ps by name
+	Dicts     map[string]uint64 `protobuf:"bytes,5,rep,name=dicts" json:"dicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	DiskState DiskState         `protobuf:"varint,6,opt,name=disk_state,json=diskState,proto3,enum=DiskState" json:"disk_state,omitempty"`
 }
 
 func (m *PSHeartbeatRequest) Reset()                    { *m = PSHeartbeatRequest{} }
 func (*PSHeartbeatRequest) ProtoMessage()               {}
-func (*PSHeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{20} }
+func (*PSHeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{23} }
 
 type PSHeartbeatResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the dictionaries newer than the ones of the ps
+	Dicts []meta.Dictionary `protobuf:"bytes,2,rep,name=dicts" json:"dicts"`
+	// the spaces over their quota
+	QuotaExceededSpaces []github_com_tiglabs_baudengine_proto_metapb.SpaceID `protobuf:"varint,3,rep,packed,name=quota_exceeded_spaces,json=quotaExceededSpaces,casttype=github.com/tiglabs/baudengine/proto/metapb.SpaceID" json:"quota_exceeded_spaces,omitempty"`
+	// the replicas over the high disk watermark of the partitions the ps leads
+	ReplicaDiskStates []ReplicaDiskState `protobuf:"bytes,4,rep,name=replica_disk_states,json=replicaDiskStates" json:"replica_disk_states"`
 }
 
 func (m *PSHeartbeatResponse) Reset()                    { *m = PSHeartbeatResponse{} }
 func (*PSHeartbeatResponse) ProtoMessage()               {}
-func (*PSHeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{21} }
+func (*PSHeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{24} }
+
+// ReplicaDiskState is the disk state of the ps of a replica, the leader takes no writes adding
+// data while a replica can not store them.
+type ReplicaDiskState struct {
+	PartitionID github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	NodeID      github_com_tiglabs_baudengine_proto_metapb.NodeID      `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.NodeID" json:"node_id,omitempty"`
+	DiskState   DiskState                                              `protobuf:"varint,3,opt,name=disk_state,json=diskState,proto3,enum=DiskState" json:"disk_state,omitempty"`
+}
+
+func (m *ReplicaDiskState) Reset()                    { *m = ReplicaDiskState{} }
+func (*ReplicaDiskState) ProtoMessage()               {}
+func (*ReplicaDiskState) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{25} }
 
 type PartitionInfo struct {
 	ID         github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"id,omitempty"`
@@ -312,11 +418,13 @@ type PartitionInfo struct {
 	Epoch      meta.PartitionEpoch                                    `protobuf:"bytes,4,opt,name=epoch" json:"epoch"`
 	Statistics PartitionStats                                         `protobuf:"bytes,5,opt,name=statistics" json:"statistics"`
 	RaftStatus *RaftStatus                                            `protobuf:"bytes,6,opt,name=raft_status,json=raftStatus" json:"raft_status,omitempty"`
+	// the versions of the dictionaries the partition indexes with by name
+	Dicts map[string]uint64 `protobuf:"bytes,7,rep,name=dicts" json:"dicts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (m *PartitionInfo) Reset()                    { *m = PartitionInfo{} }
 func (*PartitionInfo) ProtoMessage()               {}
-func (*PartitionInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{22} }
+func (*PartitionInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{26} }
 
 type RuntimeInfo struct {
 	AppVersion string `protobuf:"bytes,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
@@ -327,7 +435,7 @@ type RuntimeInfo struct {
 
 func (m *RuntimeInfo) Reset()                    { *m = RuntimeInfo{} }
 func (*RuntimeInfo) ProtoMessage()               {}
-func (*RuntimeInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{23} }
+func (*RuntimeInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{27} }
 
 type RaftStatus struct {
 	meta.Replica `protobuf:"bytes,1,opt,name=replica,embedded=replica" json:"replica"`
@@ -340,7 +448,7 @@ type RaftStatus struct {
 
 func (m *RaftStatus) Reset()                    { *m = RaftStatus{} }
 func (*RaftStatus) ProtoMessage()               {}
-func (*RaftStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{24} }
+func (*RaftStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{28} }
 
 type RaftFollowerStatus struct {
 	meta.Replica `protobuf:"bytes,1,opt,name=replica,embedded=replica" json:"replica"`
@@ -353,7 +461,7 @@ type RaftFollowerStatus struct {
 
 func (m *RaftFollowerStatus) Reset()                    { *m = RaftFollowerStatus{} }
 func (*RaftFollowerStatus) ProtoMessage()               {}
-func (*RaftFollowerStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{25} }
+func (*RaftFollowerStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{29} }
 
 type NodeSysStats struct {
 	// Memory
@@ -390,7 +498,7 @@ type NodeSysStats struct {
 
 func (m *NodeSysStats) Reset()                    { *m = NodeSysStats{} }
 func (*NodeSysStats) ProtoMessage()               {}
-func (*NodeSysStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{26} }
+func (*NodeSysStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{30} }
 
 type PartitionStats struct {
 	Size_                  uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
@@ -399,11 +507,12 @@ type PartitionStats struct {
 	BytesOutPerSec         uint64 `protobuf:"varint,4,opt,name=bytes_out_per_sec,json=bytesOutPerSec,proto3" json:"bytes_out_per_sec,omitempty"`
 	TotalCommandsProcessed uint64 `protobuf:"varint,5,opt,name=total_commands_processed,json=totalCommandsProcessed,proto3" json:"total_commands_processed,omitempty"`
 	KeyspaceMisses         uint64 `protobuf:"varint,6,opt,name=keyspace_misses,json=keyspaceMisses,proto3" json:"keyspace_misses,omitempty"`
+	DocCount               uint64 `protobuf:"varint,7,opt,name=doc_count,json=docCount,proto3" json:"doc_count,omitempty"`
 }
 
 func (m *PartitionStats) Reset()                    { *m = PartitionStats{} }
 func (*PartitionStats) ProtoMessage()               {}
-func (*PartitionStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{27} }
+func (*PartitionStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{31} }
 
 func init() {
 	proto.RegisterType((*GMaster)(nil), "GMaster")
@@ -415,6 +524,9 @@ func init() {
 	proto.RegisterType((*GetSpaceResponse)(nil), "GetSpaceResponse")
 	proto.RegisterType((*GetRouteRequest)(nil), "GetRouteRequest")
 	proto.RegisterType((*GetRouteResponse)(nil), "GetRouteResponse")
+	proto.RegisterType((*WatchRoutesRequest)(nil), "WatchRoutesRequest")
+	proto.RegisterType((*RouteEvent)(nil), "RouteEvent")
+	proto.RegisterType((*WatchRoutesResponse)(nil), "WatchRoutesResponse")
 	proto.RegisterType((*PSRegisterRequest)(nil), "PSRegisterRequest")
 	proto.RegisterType((*PSRegisterResponse)(nil), "PSRegisterResponse")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "CreatePartitionRequest")
@@ -428,13 +540,16 @@ func init() {
 	proto.RegisterType((*PSConfig)(nil), "PSConfig")
 	proto.RegisterType((*PSHeartbeatRequest)(nil), "PSHeartbeatRequest")
 	proto.RegisterType((*PSHeartbeatResponse)(nil), "PSHeartbeatResponse")
+	proto.RegisterType((*ReplicaDiskState)(nil), "ReplicaDiskState")
 	proto.RegisterType((*PartitionInfo)(nil), "PartitionInfo")
 	proto.RegisterType((*RuntimeInfo)(nil), "RuntimeInfo")
 	proto.RegisterType((*RaftStatus)(nil), "RaftStatus")
 	proto.RegisterType((*RaftFollowerStatus)(nil), "RaftFollowerStatus")
 	proto.RegisterType((*NodeSysStats)(nil), "NodeSysStats")
 	proto.RegisterType((*PartitionStats)(nil), "PartitionStats")
+	proto.RegisterEnum("RouteEventType", RouteEventType_name, RouteEventType_value)
 	proto.RegisterEnum("ReplicaChangeType", ReplicaChangeType_name, ReplicaChangeType_value)
+	proto.RegisterEnum("DiskState", DiskState_name, DiskState_value)
 }
 func (this *GMaster) Equal(that interface{}) bool {
 	if that == nil {
@@ -707,6 +822,95 @@ func (this *GetRouteResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WatchRoutesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchRoutesRequest)
+	if !ok {
+		that2, ok := that.(WatchRoutesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.DB != that1.DB {
+		return false
+	}
+	if this.Space != that1.Space {
+		return false
+	}
+	return true
+}
+func (this *RouteEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteEvent)
+	if !ok {
+		that2, ok := that.(RouteEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.Route.Equal(&that1.Route) {
+		return false
+	}
+	return true
+}
+func (this *WatchRoutesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchRoutesResponse)
+	if !ok {
+		that2, ok := that.(WatchRoutesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !this.Events[i].Equal(&that1.Events[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *PSRegisterRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -965,6 +1169,9 @@ func (this *ChangeLeaderRequest) Equal(that interface{}) bool {
 	if this.PartitionID != that1.PartitionID {
 		return false
 	}
+	if !this.Replica.Equal(&that1.Replica) {
+		return false
+	}
 	return true
 }
 func (this *ChangeLeaderResponse) Equal(that interface{}) bool {
@@ -1075,6 +1282,17 @@ func (this *PSHeartbeatRequest) Equal(that interface{}) bool {
 	if !this.SysStats.Equal(&that1.SysStats) {
 		return false
 	}
+	if len(this.Dicts) != len(that1.Dicts) {
+		return false
+	}
+	for i := range this.Dicts {
+		if this.Dicts[i] != that1.Dicts[i] {
+			return false
+		}
+	}
+	if this.DiskState != that1.DiskState {
+		return false
+	}
 	return true
 }
 func (this *PSHeartbeatResponse) Equal(that interface{}) bool {
@@ -1099,6 +1317,60 @@ func (this *PSHeartbeatResponse) Equal(that interface{}) bool {
 	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
 		return false
 	}
+	if len(this.Dicts) != len(that1.Dicts) {
+		return false
+	}
+	for i := range this.Dicts {
+		if !this.Dicts[i].Equal(&that1.Dicts[i]) {
+			return false
+		}
+	}
+	if len(this.QuotaExceededSpaces) != len(that1.QuotaExceededSpaces) {
+		return false
+	}
+	for i := range this.QuotaExceededSpaces {
+		if this.QuotaExceededSpaces[i] != that1.QuotaExceededSpaces[i] {
+			return false
+		}
+	}
+	if len(this.ReplicaDiskStates) != len(that1.ReplicaDiskStates) {
+		return false
+	}
+	for i := range this.ReplicaDiskStates {
+		if !this.ReplicaDiskStates[i].Equal(&that1.ReplicaDiskStates[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReplicaDiskState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicaDiskState)
+	if !ok {
+		that2, ok := that.(ReplicaDiskState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if this.NodeID != that1.NodeID {
+		return false
+	}
+	if this.DiskState != that1.DiskState {
+		return false
+	}
 	return true
 }
 func (this *PartitionInfo) Equal(that interface{}) bool {
@@ -1138,6 +1410,14 @@ func (this *PartitionInfo) Equal(that interface{}) bool {
 	if !this.RaftStatus.Equal(that1.RaftStatus) {
 		return false
 	}
+	if len(this.Dicts) != len(that1.Dicts) {
+		return false
+	}
+	for i := range this.Dicts {
+		if this.Dicts[i] != that1.Dicts[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *RuntimeInfo) Equal(that interface{}) bool {
@@ -1365,6 +1645,9 @@ func (this *PartitionStats) Equal(that interface{}) bool {
 	if this.KeyspaceMisses != that1.KeyspaceMisses {
 		return false
 	}
+	if this.DocCount != that1.DocCount {
+		return false
+	}
 	return true
 }
 
@@ -1388,6 +1671,8 @@ type MasterRpcClient interface {
 	DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error)
 	ChangeReplica(ctx context.Context, in *ChangeReplicaRequest, opts ...grpc.CallOption) (*ChangeReplicaResponse, error)
 	ChangeLeader(ctx context.Context, in *ChangeLeaderRequest, opts ...grpc.CallOption) (*ChangeLeaderResponse, error)
+	// pushes the route changes of the partitions of the space
+	WatchRoutes(ctx context.Context, in *WatchRoutesRequest, opts ...grpc.CallOption) (MasterRpc_WatchRoutesClient, error)
 }
 
 type masterRpcClient struct {
@@ -1479,6 +1764,38 @@ func (c *masterRpcClient) ChangeLeader(ctx context.Context, in *ChangeLeaderRequ
 	return out, nil
 }
 
+func (c *masterRpcClient) WatchRoutes(ctx context.Context, in *WatchRoutesRequest, opts ...grpc.CallOption) (MasterRpc_WatchRoutesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_MasterRpc_serviceDesc.Streams[0], c.cc, "/MasterRpc/WatchRoutes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &masterRpcWatchRoutesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MasterRpc_WatchRoutesClient interface {
+	Recv() (*WatchRoutesResponse, error)
+	grpc.ClientStream
+}
+
+type masterRpcWatchRoutesClient struct {
+	grpc.ClientStream
+}
+
+func (x *masterRpcWatchRoutesClient) Recv() (*WatchRoutesResponse, error) {
+	m := new(WatchRoutesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for MasterRpc service
 
 type MasterRpcServer interface {
@@ -1491,6 +1808,8 @@ type MasterRpcServer interface {
 	DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error)
 	ChangeReplica(context.Context, *ChangeReplicaRequest) (*ChangeReplicaResponse, error)
 	ChangeLeader(context.Context, *ChangeLeaderRequest) (*ChangeLeaderResponse, error)
+	// pushes the route changes of the partitions of the space
+	WatchRoutes(*WatchRoutesRequest, MasterRpc_WatchRoutesServer) error
 }
 
 func RegisterMasterRpcServer(s *grpc.Server, srv MasterRpcServer) {
@@ -1659,6 +1978,27 @@ func _MasterRpc_ChangeLeader_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterRpc_WatchRoutes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRoutesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MasterRpcServer).WatchRoutes(m, &masterRpcWatchRoutesServer{stream})
+}
+
+type MasterRpc_WatchRoutesServer interface {
+	Send(*WatchRoutesResponse) error
+	grpc.ServerStream
+}
+
+type masterRpcWatchRoutesServer struct {
+	grpc.ServerStream
+}
+
+func (x *masterRpcWatchRoutesServer) Send(m *WatchRoutesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _MasterRpc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "MasterRpc",
 	HandlerType: (*MasterRpcServer)(nil),
@@ -1700,7 +2040,13 @@ var _MasterRpc_serviceDesc = grpc.ServiceDesc{
 			Handler:    _MasterRpc_ChangeLeader_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRoutes",
+			Handler:       _MasterRpc_WatchRoutes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "master.proto",
 }
 
@@ -2128,7 +2474,7 @@ func (m *GetRouteResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PSRegisterRequest) Marshal() (dAtA []byte, err error) {
+func (m *WatchRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2138,7 +2484,7 @@ func (m *PSRegisterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PSRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2151,21 +2497,43 @@ func (m *PSRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n10
-	if m.NodeID != 0 {
+	if m.DB != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintMaster(dAtA, i, uint64(m.NodeID))
+		i = encodeVarintMaster(dAtA, i, uint64(m.DB))
 	}
-	if len(m.Ip) > 0 {
-		dAtA[i] = 0x1a
+	if m.Space != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.Ip)))
-		i += copy(dAtA[i:], m.Ip)
+		i = encodeVarintMaster(dAtA, i, uint64(m.Space))
 	}
-	dAtA[i] = 0x22
+	return i, nil
+}
+
+func (m *RouteEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.Type))
+	}
+	dAtA[i] = 0x12
 	i++
-	i = encodeVarintMaster(dAtA, i, uint64(m.RuntimeInfo.Size()))
-	n11, err := m.RuntimeInfo.MarshalTo(dAtA[i:])
+	i = encodeVarintMaster(dAtA, i, uint64(m.Route.Size()))
+	n11, err := m.Route.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
@@ -2173,7 +2541,7 @@ func (m *PSRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PSRegisterResponse) Marshal() (dAtA []byte, err error) {
+func (m *WatchRoutesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2183,7 +2551,7 @@ func (m *PSRegisterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PSRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2196,14 +2564,9 @@ func (m *PSRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n12
-	if m.NodeID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMaster(dAtA, i, uint64(m.NodeID))
-	}
-	if len(m.Partitions) > 0 {
-		for _, msg := range m.Partitions {
-			dAtA[i] = 0x22
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintMaster(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -2216,7 +2579,7 @@ func (m *PSRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CreatePartitionRequest) Marshal() (dAtA []byte, err error) {
+func (m *PSRegisterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2226,7 +2589,7 @@ func (m *CreatePartitionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreatePartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PSRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2239,18 +2602,29 @@ func (m *CreatePartitionRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n13
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMaster(dAtA, i, uint64(m.Partition.Size()))
-	n14, err := m.Partition.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
+	if m.NodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.NodeID))
+	}
+	if len(m.Ip) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Ip)))
+		i += copy(dAtA[i:], m.Ip)
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.RuntimeInfo.Size()))
+	n14, err := m.RuntimeInfo.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
 	i += n14
 	return i, nil
 }
 
-func (m *CreatePartitionResponse) Marshal() (dAtA []byte, err error) {
+func (m *PSRegisterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2260,7 +2634,7 @@ func (m *CreatePartitionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreatePartitionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PSRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2273,14 +2647,91 @@ func (m *CreatePartitionResponse) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n15
+	if m.NodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.NodeID))
+	}
+	if len(m.Partitions) > 0 {
+		for _, msg := range m.Partitions {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CreatePartitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
+	n16, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.Partition.Size()))
+	n17, err := m.Partition.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	return i, nil
+}
+
+func (m *CreatePartitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePartitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n18, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
-	n16, err := m.Replica.MarshalTo(dAtA[i:])
+	n19, err := m.Replica.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n19
 	return i, nil
 }
 
@@ -2302,11 +2753,11 @@ func (m *DeletePartitionRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n17, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n20, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n20
 	if m.PartitionID != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2338,11 +2789,11 @@ func (m *DeletePartitionResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n18, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n21, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n21
 	return i, nil
 }
 
@@ -2364,11 +2815,11 @@ func (m *ChangeReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n19, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n22, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n22
 	if m.Type != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2382,11 +2833,11 @@ func (m *ChangeReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
-	n20, err := m.Replica.MarshalTo(dAtA[i:])
+	n23, err := m.Replica.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n23
 	return i, nil
 }
 
@@ -2408,11 +2859,11 @@ func (m *ChangeReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n21, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n24, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n24
 	return i, nil
 }
 
@@ -2434,16 +2885,24 @@ func (m *ChangeLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n22, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n25, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n25
 	if m.PartitionID != 0 {
 		dAtA[i] = 0x10
 		i++
 		i = encodeVarintMaster(dAtA, i, uint64(m.PartitionID))
 	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
+	n26, err := m.Replica.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
 	return i, nil
 }
 
@@ -2465,11 +2924,11 @@ func (m *ChangeLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n23, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n27, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n27
 	return i, nil
 }
 
@@ -2554,11 +3013,11 @@ func (m *PSHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n24, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n28, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n28
 	if m.NodeID != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2579,11 +3038,32 @@ func (m *PSHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.SysStats.Size()))
-	n25, err := m.SysStats.MarshalTo(dAtA[i:])
+	n29, err := m.SysStats.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n29
+	if len(m.Dicts) > 0 {
+		for k, _ := range m.Dicts {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Dicts[k]
+			mapSize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + sovMaster(uint64(v))
+			i = encodeVarintMaster(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(v))
+		}
+	}
+	if m.DiskState != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.DiskState))
+	}
 	return i, nil
 }
 
@@ -2605,11 +3085,85 @@ func (m *PSHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n26, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n30, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n30
+	if len(m.Dicts) > 0 {
+		for _, msg := range m.Dicts {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.QuotaExceededSpaces) > 0 {
+		dAtA32 := make([]byte, len(m.QuotaExceededSpaces)*10)
+		var j31 int
+		for _, num := range m.QuotaExceededSpaces {
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(j31))
+		i += copy(dAtA[i:], dAtA32[:j31])
+	}
+	if len(m.ReplicaDiskStates) > 0 {
+		for _, msg := range m.ReplicaDiskStates {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ReplicaDiskState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicaDiskState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.PartitionID))
+	}
+	if m.NodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.NodeID))
+	}
+	if m.DiskState != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.DiskState))
+	}
 	return i, nil
 }
 
@@ -2651,28 +3205,44 @@ func (m *PartitionInfo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Epoch.Size()))
-	n27, err := m.Epoch.MarshalTo(dAtA[i:])
+	n33, err := m.Epoch.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n33
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Statistics.Size()))
-	n28, err := m.Statistics.MarshalTo(dAtA[i:])
+	n34, err := m.Statistics.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n34
 	if m.RaftStatus != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintMaster(dAtA, i, uint64(m.RaftStatus.Size()))
-		n29, err := m.RaftStatus.MarshalTo(dAtA[i:])
+		n35, err := m.RaftStatus.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n35
+	}
+	if len(m.Dicts) > 0 {
+		for k, _ := range m.Dicts {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Dicts[k]
+			mapSize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + sovMaster(uint64(v))
+			i = encodeVarintMaster(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(v))
+		}
 	}
 	return i, nil
 }
@@ -2737,11 +3307,11 @@ func (m *RaftStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
-	n30, err := m.Replica.MarshalTo(dAtA[i:])
+	n36, err := m.Replica.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n36
 	if m.Term != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2795,11 +3365,11 @@ func (m *RaftFollowerStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
-	n31, err := m.Replica.MarshalTo(dAtA[i:])
+	n37, err := m.Replica.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n37
 	if m.Match != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2992,6 +3562,11 @@ func (m *PartitionStats) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMaster(dAtA, i, uint64(m.KeyspaceMisses))
 	}
+	if m.DocCount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.DocCount))
+	}
 	return i, nil
 }
 
@@ -3113,14 +3688,52 @@ func NewPopulatedGetRouteResponse(r randyMaster, easy bool) *GetRouteResponse {
 	return this
 }
 
-func NewPopulatedPSRegisterRequest(r randyMaster, easy bool) *PSRegisterRequest {
-	this := &PSRegisterRequest{}
+func NewPopulatedWatchRoutesRequest(r randyMaster, easy bool) *WatchRoutesRequest {
+	this := &WatchRoutesRequest{}
 	v13 := meta.NewPopulatedRequestHeader(r, easy)
 	this.RequestHeader = *v13
+	this.DB = github_com_tiglabs_baudengine_proto_metapb.DBID(r.Uint32())
+	this.Space = github_com_tiglabs_baudengine_proto_metapb.SpaceID(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRouteEvent(r randyMaster, easy bool) *RouteEvent {
+	this := &RouteEvent{}
+	this.Type = RouteEventType([]int32{0, 1}[r.Intn(2)])
+	v14 := NewPopulatedRoute(r, easy)
+	this.Route = *v14
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedWatchRoutesResponse(r randyMaster, easy bool) *WatchRoutesResponse {
+	this := &WatchRoutesResponse{}
+	v15 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v15
+	if r.Intn(10) != 0 {
+		v16 := r.Intn(5)
+		this.Events = make([]RouteEvent, v16)
+		for i := 0; i < v16; i++ {
+			v17 := NewPopulatedRouteEvent(r, easy)
+			this.Events[i] = *v17
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPSRegisterRequest(r randyMaster, easy bool) *PSRegisterRequest {
+	this := &PSRegisterRequest{}
+	v18 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v18
 	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
 	this.Ip = string(randStringMaster(r))
-	v14 := NewPopulatedRuntimeInfo(r, easy)
-	this.RuntimeInfo = *v14
+	v19 := NewPopulatedRuntimeInfo(r, easy)
+	this.RuntimeInfo = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3128,15 +3741,15 @@ func NewPopulatedPSRegisterRequest(r randyMaster, easy bool) *PSRegisterRequest
 
 func NewPopulatedPSRegisterResponse(r randyMaster, easy bool) *PSRegisterResponse {
 	this := &PSRegisterResponse{}
-	v15 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v15
+	v20 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v20
 	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
 	if r.Intn(10) != 0 {
-		v16 := r.Intn(5)
-		this.Partitions = make([]meta.Partition, v16)
-		for i := 0; i < v16; i++ {
-			v17 := meta.NewPopulatedPartition(r, easy)
-			this.Partitions[i] = *v17
+		v21 := r.Intn(5)
+		this.Partitions = make([]meta.Partition, v21)
+		for i := 0; i < v21; i++ {
+			v22 := meta.NewPopulatedPartition(r, easy)
+			this.Partitions[i] = *v22
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3146,10 +3759,10 @@ func NewPopulatedPSRegisterResponse(r randyMaster, easy bool) *PSRegisterRespons
 
 func NewPopulatedCreatePartitionRequest(r randyMaster, easy bool) *CreatePartitionRequest {
 	this := &CreatePartitionRequest{}
-	v18 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v18
-	v19 := meta.NewPopulatedPartition(r, easy)
-	this.Partition = *v19
+	v23 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v23
+	v24 := meta.NewPopulatedPartition(r, easy)
+	this.Partition = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3157,10 +3770,10 @@ func NewPopulatedCreatePartitionRequest(r randyMaster, easy bool) *CreatePartiti
 
 func NewPopulatedCreatePartitionResponse(r randyMaster, easy bool) *CreatePartitionResponse {
 	this := &CreatePartitionResponse{}
-	v20 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v20
-	v21 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v21
+	v25 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v25
+	v26 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3168,8 +3781,8 @@ func NewPopulatedCreatePartitionResponse(r randyMaster, easy bool) *CreatePartit
 
 func NewPopulatedDeletePartitionRequest(r randyMaster, easy bool) *DeletePartitionRequest {
 	this := &DeletePartitionRequest{}
-	v22 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v22
+	v27 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v27
 	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
 	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
@@ -3179,8 +3792,8 @@ func NewPopulatedDeletePartitionRequest(r randyMaster, easy bool) *DeletePartiti
 
 func NewPopulatedDeletePartitionResponse(r randyMaster, easy bool) *DeletePartitionResponse {
 	this := &DeletePartitionResponse{}
-	v23 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v23
+	v28 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v28
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3188,12 +3801,12 @@ func NewPopulatedDeletePartitionResponse(r randyMaster, easy bool) *DeletePartit
 
 func NewPopulatedChangeReplicaRequest(r randyMaster, easy bool) *ChangeReplicaRequest {
 	this := &ChangeReplicaRequest{}
-	v24 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v24
+	v29 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v29
 	this.Type = ReplicaChangeType([]int32{0, 1}[r.Intn(2)])
 	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
-	v25 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v25
+	v30 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v30
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3201,8 +3814,8 @@ func NewPopulatedChangeReplicaRequest(r randyMaster, easy bool) *ChangeReplicaRe
 
 func NewPopulatedChangeReplicaResponse(r randyMaster, easy bool) *ChangeReplicaResponse {
 	this := &ChangeReplicaResponse{}
-	v26 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v26
+	v31 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v31
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3210,9 +3823,11 @@ func NewPopulatedChangeReplicaResponse(r randyMaster, easy bool) *ChangeReplicaR
 
 func NewPopulatedChangeLeaderRequest(r randyMaster, easy bool) *ChangeLeaderRequest {
 	this := &ChangeLeaderRequest{}
-	v27 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v27
+	v32 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v32
 	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v33 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v33
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3220,8 +3835,8 @@ func NewPopulatedChangeLeaderRequest(r randyMaster, easy bool) *ChangeLeaderRequ
 
 func NewPopulatedChangeLeaderResponse(r randyMaster, easy bool) *ChangeLeaderResponse {
 	this := &ChangeLeaderResponse{}
-	v28 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v28
+	v34 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v34
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3245,19 +3860,28 @@ func NewPopulatedPSConfig(r randyMaster, easy bool) *PSConfig {
 
 func NewPopulatedPSHeartbeatRequest(r randyMaster, easy bool) *PSHeartbeatRequest {
 	this := &PSHeartbeatRequest{}
-	v29 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v29
+	v35 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v35
 	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
 	if r.Intn(10) != 0 {
-		v30 := r.Intn(5)
-		this.Partitions = make([]PartitionInfo, v30)
-		for i := 0; i < v30; i++ {
-			v31 := NewPopulatedPartitionInfo(r, easy)
-			this.Partitions[i] = *v31
+		v36 := r.Intn(5)
+		this.Partitions = make([]PartitionInfo, v36)
+		for i := 0; i < v36; i++ {
+			v37 := NewPopulatedPartitionInfo(r, easy)
+			this.Partitions[i] = *v37
+		}
+	}
+	v38 := NewPopulatedNodeSysStats(r, easy)
+	this.SysStats = *v38
+	if r.Intn(10) != 0 {
+		v39 := r.Intn(10)
+		this.Dicts = make(map[string]uint64)
+		for i := 0; i < v39; i++ {
+			v40 := randStringMaster(r)
+			this.Dicts[v40] = uint64(uint64(r.Uint32()))
 		}
 	}
-	v32 := NewPopulatedNodeSysStats(r, easy)
-	this.SysStats = *v32
+	this.DiskState = DiskState([]int32{0, 1, 2}[r.Intn(3)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3265,8 +3889,39 @@ func NewPopulatedPSHeartbeatRequest(r randyMaster, easy bool) *PSHeartbeatReques
 
 func NewPopulatedPSHeartbeatResponse(r randyMaster, easy bool) *PSHeartbeatResponse {
 	this := &PSHeartbeatResponse{}
-	v33 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v33
+	v41 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v41
+	if r.Intn(10) != 0 {
+		v42 := r.Intn(5)
+		this.Dicts = make([]meta.Dictionary, v42)
+		for i := 0; i < v42; i++ {
+			v43 := meta.NewPopulatedDictionary(r, easy)
+			this.Dicts[i] = *v43
+		}
+	}
+	v44 := r.Intn(10)
+	this.QuotaExceededSpaces = make([]github_com_tiglabs_baudengine_proto_metapb.SpaceID, v44)
+	for i := 0; i < v44; i++ {
+		this.QuotaExceededSpaces[i] = github_com_tiglabs_baudengine_proto_metapb.SpaceID(r.Uint32())
+	}
+	if r.Intn(10) != 0 {
+		v45 := r.Intn(5)
+		this.ReplicaDiskStates = make([]ReplicaDiskState, v45)
+		for i := 0; i < v45; i++ {
+			v46 := NewPopulatedReplicaDiskState(r, easy)
+			this.ReplicaDiskStates[i] = *v46
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReplicaDiskState(r randyMaster, easy bool) *ReplicaDiskState {
+	this := &ReplicaDiskState{}
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
+	this.DiskState = DiskState([]int32{0, 1, 2}[r.Intn(3)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3277,13 +3932,21 @@ func NewPopulatedPartitionInfo(r randyMaster, easy bool) *PartitionInfo {
 	this.ID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
 	this.IsLeader = bool(bool(r.Intn(2) == 0))
 	this.Status = meta.PartitionStatus([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	v34 := meta.NewPopulatedPartitionEpoch(r, easy)
-	this.Epoch = *v34
-	v35 := NewPopulatedPartitionStats(r, easy)
-	this.Statistics = *v35
+	v47 := meta.NewPopulatedPartitionEpoch(r, easy)
+	this.Epoch = *v47
+	v48 := NewPopulatedPartitionStats(r, easy)
+	this.Statistics = *v48
 	if r.Intn(10) != 0 {
 		this.RaftStatus = NewPopulatedRaftStatus(r, easy)
 	}
+	if r.Intn(10) != 0 {
+		v49 := r.Intn(10)
+		this.Dicts = make(map[string]uint64)
+		for i := 0; i < v49; i++ {
+			v50 := randStringMaster(r)
+			this.Dicts[v50] = uint64(uint64(r.Uint32()))
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3302,18 +3965,18 @@ func NewPopulatedRuntimeInfo(r randyMaster, easy bool) *RuntimeInfo {
 
 func NewPopulatedRaftStatus(r randyMaster, easy bool) *RaftStatus {
 	this := &RaftStatus{}
-	v36 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v36
+	v51 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v51
 	this.Term = uint64(uint64(r.Uint32()))
 	this.Index = uint64(uint64(r.Uint32()))
 	this.Commit = uint64(uint64(r.Uint32()))
 	this.Applied = uint64(uint64(r.Uint32()))
 	if r.Intn(10) != 0 {
-		v37 := r.Intn(5)
-		this.Followers = make([]RaftFollowerStatus, v37)
-		for i := 0; i < v37; i++ {
-			v38 := NewPopulatedRaftFollowerStatus(r, easy)
-			this.Followers[i] = *v38
+		v52 := r.Intn(5)
+		this.Followers = make([]RaftFollowerStatus, v52)
+		for i := 0; i < v52; i++ {
+			v53 := NewPopulatedRaftFollowerStatus(r, easy)
+			this.Followers[i] = *v53
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3323,8 +3986,8 @@ func NewPopulatedRaftStatus(r randyMaster, easy bool) *RaftStatus {
 
 func NewPopulatedRaftFollowerStatus(r randyMaster, easy bool) *RaftFollowerStatus {
 	this := &RaftFollowerStatus{}
-	v39 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v39
+	v54 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v54
 	this.Match = uint64(uint64(r.Uint32()))
 	this.Commit = uint64(uint64(r.Uint32()))
 	this.Next = uint64(uint64(r.Uint32()))
@@ -3370,6 +4033,7 @@ func NewPopulatedPartitionStats(r randyMaster, easy bool) *PartitionStats {
 	this.BytesOutPerSec = uint64(uint64(r.Uint32()))
 	this.TotalCommandsProcessed = uint64(uint64(r.Uint32()))
 	this.KeyspaceMisses = uint64(uint64(r.Uint32()))
+	this.DocCount = uint64(uint64(r.Uint32()))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3394,9 +4058,9 @@ func randUTF8RuneMaster(r randyMaster) rune {
 	return rune(ru + 61)
 }
 func randStringMaster(r randyMaster) string {
-	v40 := r.Intn(100)
-	tmps := make([]rune, v40)
-	for i := 0; i < v40; i++ {
+	v55 := r.Intn(100)
+	tmps := make([]rune, v55)
+	for i := 0; i < v55; i++ {
 		tmps[i] = randUTF8RuneMaster(r)
 	}
 	return string(tmps)
@@ -3418,11 +4082,11 @@ func randFieldMaster(dAtA []byte, r randyMaster, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMaster(dAtA, uint64(key))
-		v41 := r.Int63()
+		v56 := r.Int63()
 		if r.Intn(2) == 0 {
-			v41 *= -1
+			v56 *= -1
 		}
-		dAtA = encodeVarintPopulateMaster(dAtA, uint64(v41))
+		dAtA = encodeVarintPopulateMaster(dAtA, uint64(v56))
 	case 1:
 		dAtA = encodeVarintPopulateMaster(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3574,6 +4238,45 @@ func (m *GetRouteResponse) Size() (n int) {
 	return n
 }
 
+func (m *WatchRoutesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovMaster(uint64(l))
+	if m.DB != 0 {
+		n += 1 + sovMaster(uint64(m.DB))
+	}
+	if m.Space != 0 {
+		n += 1 + sovMaster(uint64(m.Space))
+	}
+	return n
+}
+
+func (m *RouteEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMaster(uint64(m.Type))
+	}
+	l = m.Route.Size()
+	n += 1 + l + sovMaster(uint64(l))
+	return n
+}
+
+func (m *WatchRoutesResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovMaster(uint64(l))
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PSRegisterRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -3682,6 +4385,8 @@ func (m *ChangeLeaderRequest) Size() (n int) {
 	if m.PartitionID != 0 {
 		n += 1 + sovMaster(uint64(m.PartitionID))
 	}
+	l = m.Replica.Size()
+	n += 1 + l + sovMaster(uint64(l))
 	return n
 }
 
@@ -3742,6 +4447,17 @@ func (m *PSHeartbeatRequest) Size() (n int) {
 	}
 	l = m.SysStats.Size()
 	n += 1 + l + sovMaster(uint64(l))
+	if len(m.Dicts) > 0 {
+		for k, v := range m.Dicts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + sovMaster(uint64(v))
+			n += mapEntrySize + 1 + sovMaster(uint64(mapEntrySize))
+		}
+	}
+	if m.DiskState != 0 {
+		n += 1 + sovMaster(uint64(m.DiskState))
+	}
 	return n
 }
 
@@ -3750,6 +4466,40 @@ func (m *PSHeartbeatResponse) Size() (n int) {
 	_ = l
 	l = m.ResponseHeader.Size()
 	n += 1 + l + sovMaster(uint64(l))
+	if len(m.Dicts) > 0 {
+		for _, e := range m.Dicts {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	if len(m.QuotaExceededSpaces) > 0 {
+		l = 0
+		for _, e := range m.QuotaExceededSpaces {
+			l += sovMaster(uint64(e))
+		}
+		n += 1 + sovMaster(uint64(l)) + l
+	}
+	if len(m.ReplicaDiskStates) > 0 {
+		for _, e := range m.ReplicaDiskStates {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReplicaDiskState) Size() (n int) {
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		n += 1 + sovMaster(uint64(m.PartitionID))
+	}
+	if m.NodeID != 0 {
+		n += 1 + sovMaster(uint64(m.NodeID))
+	}
+	if m.DiskState != 0 {
+		n += 1 + sovMaster(uint64(m.DiskState))
+	}
 	return n
 }
 
@@ -3773,6 +4523,14 @@ func (m *PartitionInfo) Size() (n int) {
 		l = m.RaftStatus.Size()
 		n += 1 + l + sovMaster(uint64(l))
 	}
+	if len(m.Dicts) > 0 {
+		for k, v := range m.Dicts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + sovMaster(uint64(v))
+			n += mapEntrySize + 1 + sovMaster(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -3926,6 +4684,9 @@ func (m *PartitionStats) Size() (n int) {
 	if m.KeyspaceMisses != 0 {
 		n += 1 + sovMaster(uint64(m.KeyspaceMisses))
 	}
+	if m.DocCount != 0 {
+		n += 1 + sovMaster(uint64(m.DocCount))
+	}
 	return n
 }
 
@@ -4047,6 +4808,40 @@ func (this *GetRouteResponse) String() string {
 	}, "")
 	return s
 }
+func (this *WatchRoutesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchRoutesRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`DB:` + fmt.Sprintf("%v", this.DB) + `,`,
+		`Space:` + fmt.Sprintf("%v", this.Space) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RouteEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RouteEvent{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Route:` + strings.Replace(strings.Replace(this.Route.String(), "Route", "Route", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchRoutesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchRoutesResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Events:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Events), "RouteEvent", "RouteEvent", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PSRegisterRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -4146,6 +4941,7 @@ func (this *ChangeLeaderRequest) String() string {
 	s := strings.Join([]string{`&ChangeLeaderRequest{`,
 		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
 		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Replica:` + strings.Replace(strings.Replace(this.Replica.String(), "Replica", "meta.Replica", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4182,11 +4978,23 @@ func (this *PSHeartbeatRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForDicts := make([]string, 0, len(this.Dicts))
+	for k, _ := range this.Dicts {
+		keysForDicts = append(keysForDicts, k)
+	}
+	sortkeys.Strings(keysForDicts)
+	mapStringForDicts := "map[string]uint64{"
+	for _, k := range keysForDicts {
+		mapStringForDicts += fmt.Sprintf("%v: %v,", k, this.Dicts[k])
+	}
+	mapStringForDicts += "}"
 	s := strings.Join([]string{`&PSHeartbeatRequest{`,
 		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
 		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
 		`Partitions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Partitions), "PartitionInfo", "PartitionInfo", 1), `&`, ``, 1) + `,`,
 		`SysStats:` + strings.Replace(strings.Replace(this.SysStats.String(), "NodeSysStats", "NodeSysStats", 1), `&`, ``, 1) + `,`,
+		`Dicts:` + mapStringForDicts + `,`,
+		`DiskState:` + fmt.Sprintf("%v", this.DiskState) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4197,6 +5005,21 @@ func (this *PSHeartbeatResponse) String() string {
 	}
 	s := strings.Join([]string{`&PSHeartbeatResponse{`,
 		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Dicts:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Dicts), "Dictionary", "meta.Dictionary", 1), `&`, ``, 1) + `,`,
+		`QuotaExceededSpaces:` + fmt.Sprintf("%v", this.QuotaExceededSpaces) + `,`,
+		`ReplicaDiskStates:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReplicaDiskStates), "ReplicaDiskState", "ReplicaDiskState", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicaDiskState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicaDiskState{`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`DiskState:` + fmt.Sprintf("%v", this.DiskState) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4205,6 +5028,16 @@ func (this *PartitionInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForDicts := make([]string, 0, len(this.Dicts))
+	for k, _ := range this.Dicts {
+		keysForDicts = append(keysForDicts, k)
+	}
+	sortkeys.Strings(keysForDicts)
+	mapStringForDicts := "map[string]uint64{"
+	for _, k := range keysForDicts {
+		mapStringForDicts += fmt.Sprintf("%v: %v,", k, this.Dicts[k])
+	}
+	mapStringForDicts += "}"
 	s := strings.Join([]string{`&PartitionInfo{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`IsLeader:` + fmt.Sprintf("%v", this.IsLeader) + `,`,
@@ -4212,6 +5045,7 @@ func (this *PartitionInfo) String() string {
 		`Epoch:` + strings.Replace(strings.Replace(this.Epoch.String(), "PartitionEpoch", "meta.PartitionEpoch", 1), `&`, ``, 1) + `,`,
 		`Statistics:` + strings.Replace(strings.Replace(this.Statistics.String(), "PartitionStats", "PartitionStats", 1), `&`, ``, 1) + `,`,
 		`RaftStatus:` + strings.Replace(fmt.Sprintf("%v", this.RaftStatus), "RaftStatus", "RaftStatus", 1) + `,`,
+		`Dicts:` + mapStringForDicts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4296,6 +5130,7 @@ func (this *PartitionStats) String() string {
 		`BytesOutPerSec:` + fmt.Sprintf("%v", this.BytesOutPerSec) + `,`,
 		`TotalCommandsProcessed:` + fmt.Sprintf("%v", this.TotalCommandsProcessed) + `,`,
 		`KeyspaceMisses:` + fmt.Sprintf("%v", this.KeyspaceMisses) + `,`,
+		`DocCount:` + fmt.Sprintf("%v", this.DocCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5190,9 +6025,345 @@ func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			}
+			m.DB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DB |= (github_com_tiglabs_baudengine_proto_metapb.DBID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			}
+			m.Space = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Space |= (github_com_tiglabs_baudengine_proto_metapb.SpaceID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= (github_com_tiglabs_baudengine_proto_metapb.SlotID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			}
+			m.DB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DB |= (github_com_tiglabs_baudengine_proto_metapb.DBID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			}
+			m.Space = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Space |= (github_com_tiglabs_baudengine_proto_metapb.SpaceID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.DB = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -5202,16 +6373,16 @@ func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DB |= (github_com_tiglabs_baudengine_proto_metapb.DBID(b) & 0x7F) << shift
+				m.Type |= (RouteEventType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			m.Space = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -5221,30 +6392,22 @@ func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Space |= (github_com_tiglabs_baudengine_proto_metapb.SpaceID(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
 			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= (github_com_tiglabs_baudengine_proto_metapb.SlotID(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -5266,7 +6429,7 @@ func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5289,10 +6452,10 @@ func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5327,7 +6490,7 @@ func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5351,8 +6514,8 @@ func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, Route{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, RouteEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6389,6 +7552,36 @@ func (m *ChangeLeaderRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Replica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -6628,16 +7821,203 @@ func (m *PSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RaftHeartbeatInterval |= (int(b) & 0x7F) << shift
+				m.RaftHeartbeatInterval |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftRetainLogs", wireType)
+			}
+			m.RaftRetainLogs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaftRetainLogs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftReplicaConcurrency", wireType)
+			}
+			m.RaftReplicaConcurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaftReplicaConcurrency |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftSnapshotConcurrency", wireType)
+			}
+			m.RaftSnapshotConcurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaftSnapshotConcurrency |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PSHeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PSHeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PSHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= (github_com_tiglabs_baudengine_proto_metapb.NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftRetainLogs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
 			}
-			m.RaftRetainLogs = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, PartitionInfo{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SysStats", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -6647,16 +8027,27 @@ func (m *PSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RaftRetainLogs |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftReplicaConcurrency", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
 			}
-			m.RaftReplicaConcurrency = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SysStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dicts", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -6666,16 +8057,104 @@ func (m *PSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RaftReplicaConcurrency |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dicts == nil {
+				m.Dicts = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMaster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Dicts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftSnapshotConcurrency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskState", wireType)
 			}
-			m.RaftSnapshotConcurrency = 0
+			m.DiskState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -6685,7 +8164,7 @@ func (m *PSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RaftSnapshotConcurrency |= (int(b) & 0x7F) << shift
+				m.DiskState |= (DiskState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6711,7 +8190,7 @@ func (m *PSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PSHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *PSHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6734,15 +8213,15 @@ func (m *PSHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PSHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PSHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PSHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PSHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6766,32 +8245,13 @@ func (m *PSHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
-			}
-			m.NodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeID |= (github_com_tiglabs_baudengine_proto_metapb.NodeID(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dicts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6815,14 +8275,76 @@ func (m *PSHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Partitions = append(m.Partitions, PartitionInfo{})
-			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dicts = append(m.Dicts, meta.Dictionary{})
+			if err := m.Dicts[len(m.Dicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v github_com_tiglabs_baudengine_proto_metapb.SpaceID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (github_com_tiglabs_baudengine_proto_metapb.SpaceID(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.QuotaExceededSpaces = append(m.QuotaExceededSpaces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMaster
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v github_com_tiglabs_baudengine_proto_metapb.SpaceID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (github_com_tiglabs_baudengine_proto_metapb.SpaceID(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.QuotaExceededSpaces = append(m.QuotaExceededSpaces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaExceededSpaces", wireType)
+			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SysStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaDiskStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6846,7 +8368,8 @@ func (m *PSHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SysStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicaDiskStates = append(m.ReplicaDiskStates, ReplicaDiskState{})
+			if err := m.ReplicaDiskStates[len(m.ReplicaDiskStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6871,7 +8394,7 @@ func (m *PSHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PSHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicaDiskState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6894,17 +8417,17 @@ func (m *PSHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PSHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicaDiskState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PSHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicaDiskState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
 			}
-			var msglen int
+			m.PartitionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -6914,22 +8437,49 @@ func (m *PSHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMaster
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= (github_com_tiglabs_baudengine_proto_metapb.NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskState", wireType)
+			}
+			m.DiskState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskState |= (DiskState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -7131,6 +8681,113 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dicts == nil {
+				m.Dicts = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMaster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Dicts[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -8198,6 +9855,25 @@ func (m *PartitionStats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocCount", wireType)
+			}
+			m.DocCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -8327,138 +10003,160 @@ var (
 func init() { proto.RegisterFile("master.proto", fileDescriptorMaster) }
 
 var fileDescriptorMaster = []byte{
-	// 2114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x8c, 0x1b, 0x49,
-	0xf5, 0x77, 0xfb, 0x6b, 0xec, 0xe7, 0xf9, 0xac, 0x49, 0x66, 0x9c, 0xc9, 0xff, 0x6f, 0x87, 0x16,
-	0xca, 0x0e, 0xcb, 0x6e, 0x27, 0x99, 0x65, 0x37, 0x2c, 0x52, 0xb4, 0x89, 0xc7, 0x24, 0x31, 0xca,
-	0x87, 0x69, 0x67, 0x59, 0xb1, 0x12, 0x6a, 0xb5, 0xbb, 0x6b, 0x3c, 0xad, 0xd8, 0x5d, 0x4d, 0x57,
-	0x39, 0xd9, 0xd9, 0x13, 0x37, 0xf6, 0xc8, 0x91, 0x13, 0x12, 0x37, 0xae, 0x20, 0x21, 0xad, 0x90,
-	0x90, 0x38, 0xe6, 0xc6, 0x8a, 0x13, 0x27, 0x6b, 0x63, 0x4e, 0x1c, 0x90, 0x38, 0xa2, 0x1c, 0x10,
-	0xaa, 0x57, 0xd5, 0xed, 0xb6, 0x67, 0x82, 0x88, 0x97, 0x48, 0x9c, 0xc6, 0xf5, 0xea, 0xf7, 0xbe,
-	0x7e, 0xf5, 0xaa, 0xaa, 0x5f, 0x0d, 0xac, 0x8e, 0x5c, 0x2e, 0x68, 0x6c, 0x45, 0x31, 0x13, 0x6c,
-	0xef, 0xed, 0x41, 0x20, 0x8e, 0xc7, 0x7d, 0xcb, 0x63, 0xa3, 0x2b, 0x03, 0x36, 0x60, 0x57, 0x50,
-	0xdc, 0x1f, 0x1f, 0xe1, 0x08, 0x07, 0xf8, 0x4b, 0xc3, 0xdf, 0xcd, 0xc0, 0x45, 0x30, 0x18, 0xba,
-	0x7d, 0x7e, 0xa5, 0xef, 0x8e, 0x7d, 0x1a, 0x0e, 0x82, 0x90, 0x2a, 0xe5, 0x2b, 0x23, 0x2a, 0xdc,
-	0xa8, 0x8f, 0x7f, 0x94, 0x9a, 0xd9, 0x86, 0x95, 0x3b, 0xf7, 0xd1, 0x2d, 0x59, 0x87, 0x7c, 0xe0,
-	0xd7, 0x8d, 0x4b, 0xc6, 0xfe, 0x9a, 0x9d, 0x0f, 0x7c, 0x1c, 0x47, 0xf5, 0xfc, 0x25, 0x63, 0xbf,
-	0x6a, 0xe7, 0x83, 0x88, 0x5c, 0x80, 0x4a, 0x1c, 0x79, 0x4e, 0xc4, 0x62, 0x51, 0x2f, 0x20, 0x6a,
-	0x25, 0x8e, 0xbc, 0x2e, 0x8b, 0x85, 0xb4, 0xf2, 0xf1, 0x57, 0xb7, 0xf2, 0x6b, 0x03, 0x4a, 0x36,
-	0x1b, 0x0b, 0x4a, 0x0e, 0xa0, 0x1a, 0xb9, 0xb1, 0x08, 0x44, 0xc0, 0x42, 0xb4, 0x55, 0x3b, 0x00,
-	0xab, 0x9b, 0x48, 0x5a, 0x95, 0x67, 0x93, 0x66, 0xee, 0x8b, 0x49, 0xd3, 0xb0, 0x67, 0x30, 0x72,
-	0x11, 0x4a, 0x21, 0xf3, 0x29, 0xaf, 0xe7, 0x2f, 0x15, 0xf6, 0x6b, 0x07, 0x25, 0xeb, 0x01, 0xf3,
-	0xa9, 0xad, 0x64, 0xe4, 0x23, 0x28, 0x0f, 0xa9, 0xeb, 0xd3, 0x58, 0xf9, 0x6c, 0x7d, 0x30, 0x9d,
-	0x34, 0xcb, 0xf7, 0x50, 0xf2, 0x62, 0xd2, 0xbc, 0xf6, 0x9f, 0x73, 0x87, 0x56, 0x3b, 0x6d, 0x5b,
-	0x9b, 0x33, 0x7f, 0x08, 0xab, 0x77, 0xa8, 0x68, 0xb7, 0x6c, 0xfa, 0xe3, 0x31, 0xe5, 0x82, 0x5c,
-	0x85, 0xf2, 0xb1, 0x72, 0xa4, 0xc2, 0x5e, 0xb7, 0xf4, 0xcc, 0x5d, 0x94, 0x66, 0x42, 0xd7, 0x38,
-	0xb2, 0x0b, 0x2b, 0xed, 0x96, 0x13, 0xba, 0x23, 0xaa, 0x59, 0x2a, 0xb7, 0x5b, 0x0f, 0xdc, 0x11,
-	0x35, 0x7f, 0x04, 0x6b, 0xda, 0x34, 0x8f, 0x58, 0xc8, 0x29, 0xb9, 0xb6, 0x60, 0x7b, 0xc3, 0x4a,
-	0xa6, 0x5e, 0x6a, 0xfc, 0x02, 0xe4, 0xfd, 0x3e, 0xda, 0xad, 0x1d, 0x14, 0xac, 0x76, 0xab, 0x55,
-	0x94, 0x10, 0x3b, 0xef, 0xf7, 0xcd, 0xdf, 0x18, 0xb0, 0x71, 0x87, 0x8a, 0x5e, 0xe4, 0x7a, 0x74,
-	0xf9, 0xe8, 0x1f, 0x40, 0xc9, 0xef, 0x3b, 0x81, 0x8f, 0x3e, 0xd6, 0x5a, 0xef, 0x4f, 0x27, 0xcd,
-	0x7c, 0xa7, 0xfd, 0x62, 0xd2, 0xbc, 0xf2, 0x0a, 0x9c, 0xb6, 0x5b, 0x9d, 0xb6, 0x5d, 0xf4, 0xfb,
-	0x1d, 0x9f, 0xfc, 0x3f, 0x00, 0x46, 0xa4, 0x08, 0x29, 0x20, 0x21, 0x55, 0x94, 0x20, 0x27, 0x01,
-	0x6c, 0xce, 0x62, 0x5e, 0x9e, 0x16, 0x13, 0x4a, 0x5c, 0xda, 0xd0, 0xcc, 0x94, 0x2d, 0xb4, 0xa8,
-	0xc9, 0x51, 0x53, 0xe6, 0xe7, 0x79, 0xe4, 0x07, 0x0b, 0x72, 0x79, 0x7e, 0x3a, 0xe9, 0x02, 0x68,
-	0x72, 0xda, 0xad, 0x65, 0xc8, 0xc9, 0xfb, 0x7d, 0xf2, 0x61, 0x12, 0xf4, 0xac, 0x84, 0x4b, 0x18,
-	0xf7, 0x8b, 0x49, 0xf3, 0xe0, 0x15, 0x0c, 0xa2, 0x4e, 0xa7, 0xad, 0xf3, 0x24, 0xdf, 0x87, 0x22,
-	0x1f, 0x32, 0x51, 0x2f, 0xa2, 0xd5, 0x1b, 0xd3, 0x49, 0xb3, 0xd8, 0x1b, 0x32, 0xf1, 0x8a, 0xdb,
-	0x42, 0xaa, 0xc8, 0x45, 0x94, 0xa6, 0xcc, 0xc7, 0xb8, 0x4a, 0x9a, 0xb9, 0xe5, 0x57, 0xe9, 0xeb,
-	0x50, 0x8e, 0xa5, 0x8d, 0x64, 0x4b, 0x97, 0x2d, 0x34, 0xa9, 0x97, 0x49, 0xcf, 0x99, 0x7f, 0x35,
-	0x60, 0xab, 0xdb, 0xb3, 0xe9, 0x20, 0x90, 0xe7, 0xcf, 0xf2, 0x2b, 0xf5, 0x11, 0x94, 0x43, 0xdc,
-	0xdb, 0x7a, 0xb5, 0xf0, 0x88, 0x50, 0xbb, 0x7d, 0xc9, 0x23, 0x42, 0x99, 0xd3, 0x27, 0x60, 0x21,
-	0x3d, 0x01, 0xdf, 0x87, 0xd5, 0x78, 0x1c, 0x8a, 0x60, 0x44, 0x9d, 0x20, 0x3c, 0x62, 0x48, 0x7c,
-	0xed, 0x60, 0xd5, 0xb2, 0x95, 0xb0, 0x13, 0x1e, 0xb1, 0x4c, 0x78, 0xb5, 0x78, 0x26, 0x36, 0xff,
-	0x64, 0x00, 0xc9, 0xe6, 0xba, 0x3c, 0xb7, 0xaf, 0x2d, 0xdb, 0xab, 0x00, 0xe9, 0x99, 0xcc, 0xeb,
-	0x45, 0x5c, 0xb8, 0xec, 0xd9, 0xad, 0x16, 0x2f, 0x83, 0x31, 0x3f, 0x85, 0x9d, 0xc3, 0x98, 0xba,
-	0x82, 0xa6, 0xa0, 0xe5, 0x17, 0xd1, 0xca, 0x5e, 0x1c, 0xf9, 0x53, 0x17, 0x87, 0x72, 0x3e, 0x83,
-	0x98, 0x4f, 0x60, 0xf7, 0x94, 0xef, 0xe5, 0x49, 0xdd, 0x87, 0x95, 0x98, 0x46, 0xc3, 0xc0, 0x73,
-	0xb5, 0xef, 0x8a, 0x65, 0xab, 0xb1, 0xf6, 0x9c, 0x4c, 0x9b, 0x3f, 0xcb, 0xc3, 0x4e, 0x9b, 0x0e,
-	0xe9, 0x7f, 0x25, 0xe9, 0xc7, 0x50, 0x4b, 0x33, 0x4a, 0x17, 0xb4, 0x33, 0x9d, 0x34, 0x6b, 0xdd,
-	0x99, 0xf8, 0xc5, 0xa4, 0xf9, 0xde, 0x2b, 0xac, 0x6a, 0x46, 0xd3, 0xce, 0x5a, 0x4f, 0x0b, 0xc7,
-	0xcf, 0xde, 0xa4, 0x5f, 0xbd, 0x70, 0x7c, 0xf3, 0x1e, 0xec, 0x9e, 0x62, 0x64, 0xe9, 0xa5, 0x30,
-	0x3f, 0xcb, 0xc3, 0xb9, 0xc3, 0x63, 0x37, 0x1c, 0x50, 0xbd, 0x02, 0xcb, 0xd3, 0x7b, 0x19, 0x8a,
-	0xe2, 0x24, 0x52, 0x77, 0xc5, 0xfa, 0x01, 0x49, 0x96, 0x54, 0x59, 0x7f, 0x74, 0x12, 0x51, 0x1b,
-	0xe7, 0xc9, 0x10, 0x56, 0x53, 0xa2, 0xe4, 0x8d, 0x58, 0x78, 0x8d, 0xeb, 0xe0, 0x67, 0x6b, 0xad,
-	0xf8, 0xef, 0x6b, 0xed, 0x7b, 0x70, 0x7e, 0x81, 0x89, 0xe5, 0x69, 0xfd, 0xad, 0x01, 0xdb, 0xca,
-	0x98, 0xfa, 0x78, 0x5a, 0x9e, 0xd5, 0x45, 0xb6, 0x5e, 0x67, 0xd5, 0xfa, 0x66, 0x27, 0xa9, 0x86,
-	0x24, 0xec, 0xe5, 0x29, 0xf8, 0x67, 0x01, 0x2a, 0xdd, 0xde, 0x21, 0x0b, 0x8f, 0x82, 0x01, 0x79,
-	0x3b, 0xf3, 0x35, 0x8b, 0xdf, 0xbc, 0x2d, 0x32, 0x9d, 0x34, 0x57, 0xec, 0xee, 0xa1, 0xfc, 0xa2,
-	0x7d, 0x31, 0x69, 0x16, 0x82, 0x50, 0xa4, 0x5f, 0xb8, 0xe4, 0x32, 0x80, 0xeb, 0x8f, 0x82, 0x50,
-	0x29, 0xa8, 0x94, 0x57, 0x12, 0x54, 0x15, 0xa7, 0x10, 0xf7, 0x1e, 0x90, 0x63, 0xea, 0xc6, 0xa2,
-	0x4f, 0x5d, 0xe1, 0x04, 0xa1, 0xa0, 0xf1, 0x13, 0x77, 0xa8, 0x0b, 0x2a, 0xc5, 0x6f, 0xa5, 0x90,
-	0x8e, 0x46, 0x90, 0xeb, 0xb0, 0x1d, 0xbb, 0x47, 0xc2, 0x99, 0x29, 0xa3, 0xa3, 0xe2, 0x82, 0xa2,
-	0xc4, 0xdc, 0x4d, 0x20, 0xe8, 0x30, 0x51, 0xd4, 0x35, 0x23, 0xa8, 0x52, 0x2c, 0x9d, 0xa1, 0x68,
-	0x27, 0x10, 0x54, 0xfc, 0x00, 0x76, 0x17, 0x3c, 0xa6, 0xe1, 0x96, 0xe7, 0x95, 0xcf, 0xcf, 0x79,
-	0x4d, 0x43, 0xde, 0x87, 0x4d, 0xed, 0x59, 0xb8, 0x41, 0xe8, 0x0c, 0xd9, 0x80, 0xd7, 0x57, 0x2e,
-	0x19, 0xfb, 0x45, 0x7b, 0x5d, 0x79, 0x93, 0xe2, 0x7b, 0x6c, 0xc0, 0xc9, 0x2d, 0xa8, 0x67, 0x63,
-	0x74, 0x3c, 0x16, 0x7a, 0xe3, 0x38, 0xa6, 0xa1, 0x77, 0x52, 0xaf, 0xcc, 0xfb, 0xda, 0xc9, 0x04,
-	0x7a, 0x38, 0x83, 0x91, 0x43, 0xb8, 0x80, 0x26, 0x78, 0xe8, 0x46, 0xfc, 0x98, 0x89, 0x39, 0x1b,
-	0xd5, 0x79, 0x1b, 0x98, 0x57, 0x4f, 0x03, 0x33, 0x46, 0xcc, 0x9f, 0xe6, 0xe5, 0x25, 0x9c, 0x66,
-	0xf2, 0x3f, 0xf8, 0xc5, 0xf1, 0xad, 0xb9, 0x3b, 0xb8, 0x80, 0x77, 0xf0, 0x7a, 0x66, 0x73, 0xc8,
-	0x2f, 0x8c, 0x53, 0xf7, 0x30, 0xb9, 0x0a, 0x55, 0x7e, 0xc2, 0x1d, 0x2e, 0x5c, 0xc1, 0xf5, 0x99,
-	0xb2, 0x86, 0x96, 0x7b, 0x27, 0xbc, 0x27, 0x85, 0x5a, 0xa7, 0xc2, 0xf5, 0xd8, 0xbc, 0x0b, 0xdb,
-	0x73, 0x44, 0x2c, 0xbf, 0xa9, 0x7e, 0x97, 0x87, 0xb5, 0xb9, 0xf8, 0x48, 0x77, 0xd6, 0x47, 0xb6,
-	0x6e, 0xa6, 0x5d, 0xc5, 0xb2, 0x87, 0x81, 0xec, 0x44, 0x2f, 0x42, 0x35, 0xe0, 0x8e, 0x6e, 0x03,
-	0x25, 0xe3, 0x15, 0xbb, 0x12, 0xf0, 0x7b, 0xc9, 0xd5, 0x5d, 0x96, 0x89, 0x8f, 0x39, 0xee, 0xb2,
-	0xf5, 0x83, 0xcd, 0x99, 0x7a, 0x0f, 0xe5, 0xb6, 0x9e, 0x27, 0xdf, 0x84, 0x12, 0x8d, 0x98, 0x77,
-	0xac, 0x29, 0xda, 0x98, 0x01, 0xbf, 0x2b, 0xc5, 0x49, 0x13, 0x81, 0x18, 0xf2, 0x2e, 0x80, 0x54,
-	0x0b, 0xb8, 0x08, 0x3c, 0x8e, 0xdb, 0x69, 0x4e, 0x23, 0x4b, 0x6b, 0x06, 0x48, 0xde, 0x82, 0x9a,
-	0xaa, 0x53, 0x15, 0x52, 0x19, 0xf5, 0x6a, 0x96, 0x2d, 0x2b, 0x52, 0x45, 0x03, 0x71, 0xfa, 0xdb,
-	0xfc, 0xcc, 0x80, 0x5a, 0xe6, 0xe3, 0x91, 0x34, 0xa1, 0xe6, 0x46, 0x91, 0xf3, 0x84, 0xc6, 0x3c,
-	0xe9, 0x9f, 0xab, 0x36, 0xb8, 0x51, 0xf4, 0x03, 0x25, 0x91, 0x4d, 0x16, 0x17, 0x6e, 0x2c, 0x1c,
-	0xa9, 0xa2, 0xbb, 0xce, 0x2a, 0x4a, 0x1e, 0x05, 0x23, 0x2a, 0xa7, 0x07, 0x2c, 0x55, 0xd7, 0x3d,
-	0xd8, 0x80, 0x25, 0xda, 0x7b, 0x50, 0x89, 0x86, 0xae, 0x38, 0x62, 0xf1, 0x08, 0x39, 0xa8, 0xda,
-	0xe9, 0xd8, 0xfc, 0xa3, 0x01, 0x30, 0x8b, 0x92, 0xbc, 0x35, 0xbb, 0xa4, 0x8c, 0x85, 0x4b, 0x6a,
-	0x56, 0x03, 0x09, 0x84, 0x10, 0x28, 0x0a, 0x1a, 0x8f, 0x30, 0xa0, 0xa2, 0x8d, 0xbf, 0xc9, 0x39,
-	0x28, 0x05, 0xa1, 0x4f, 0x3f, 0xc1, 0x30, 0x8a, 0xb6, 0x1a, 0x90, 0x1d, 0x28, 0x7b, 0x6c, 0x34,
-	0x0a, 0xd4, 0xd1, 0x56, 0xb4, 0xf5, 0x88, 0xd4, 0x61, 0xc5, 0x8d, 0xa2, 0x61, 0x40, 0x7d, 0xe4,
-	0xba, 0x68, 0x27, 0x43, 0x72, 0x1d, 0xaa, 0x47, 0x6c, 0x38, 0x64, 0x4f, 0x69, 0x2c, 0xf9, 0x94,
-	0x3b, 0x62, 0x1b, 0xf9, 0xbc, 0xad, 0xa5, 0x2a, 0xe2, 0xe4, 0x0b, 0x31, 0xc5, 0x9a, 0xbf, 0x37,
-	0x80, 0x9c, 0xc6, 0xbd, 0x62, 0x66, 0xe7, 0xa0, 0x34, 0x72, 0x85, 0x77, 0xac, 0x53, 0x53, 0x83,
-	0x4c, 0x16, 0x85, 0xb9, 0x2c, 0x08, 0x14, 0x43, 0xfa, 0x49, 0x92, 0x1b, 0xfe, 0x26, 0x5f, 0x83,
-	0x55, 0x9f, 0x3d, 0x0d, 0x1d, 0x4e, 0x3d, 0x16, 0xfa, 0x5c, 0xa7, 0x57, 0x93, 0xb2, 0x9e, 0x12,
-	0x49, 0x27, 0xb2, 0x5e, 0x28, 0x96, 0x4b, 0xd5, 0x56, 0x03, 0xf3, 0x17, 0x25, 0x58, 0xcd, 0x6e,
-	0x62, 0x69, 0x69, 0x44, 0x47, 0x2c, 0x3e, 0x71, 0x04, 0x13, 0xee, 0x10, 0xc3, 0x2f, 0xda, 0x35,
-	0x25, 0x7b, 0x24, 0x45, 0xe4, 0x32, 0x6c, 0x68, 0xc8, 0x98, 0x53, 0xdf, 0x89, 0x39, 0xd7, 0x81,
-	0xaf, 0x29, 0xf1, 0x87, 0x9c, 0xfa, 0x36, 0xe7, 0xb2, 0xd0, 0x32, 0x38, 0x9d, 0x05, 0xcc, 0x30,
-	0x19, 0xc0, 0x51, 0x4c, 0xa9, 0x4e, 0x48, 0x03, 0x6e, 0xc7, 0x94, 0x92, 0x37, 0x61, 0x8b, 0x3f,
-	0x75, 0x23, 0x67, 0x2e, 0xa2, 0x32, 0xc2, 0x36, 0xe4, 0xc4, 0xfd, 0x4c, 0x54, 0xfb, 0xb0, 0x99,
-	0xc5, 0xa2, 0x4b, 0x7d, 0x53, 0xcc, 0xa0, 0xe8, 0x76, 0x01, 0x89, 0xbe, 0x2b, 0x8b, 0x48, 0xf4,
-	0x6f, 0xc2, 0x9a, 0x17, 0x8d, 0x9d, 0x28, 0x66, 0x9e, 0x13, 0x4b, 0xee, 0xe0, 0x92, 0xb1, 0x6f,
-	0xd8, 0x35, 0x2f, 0x1a, 0x77, 0x63, 0xe6, 0xd9, 0xae, 0xa0, 0xf2, 0xdc, 0x90, 0x18, 0x8f, 0x8d,
-	0x43, 0x51, 0xaf, 0xe1, 0x93, 0x55, 0xc5, 0x8b, 0xc6, 0x87, 0x72, 0x2c, 0xf7, 0x8a, 0x1f, 0xf0,
-	0xc7, 0x3a, 0xf2, 0x0d, 0x74, 0x52, 0x95, 0x12, 0x15, 0xf3, 0x45, 0xc0, 0x81, 0x0a, 0x76, 0x13,
-	0x67, 0x2b, 0x52, 0x80, 0x61, 0x26, 0x93, 0x18, 0xdf, 0xd6, 0x6c, 0x12, 0x23, 0xbb, 0x06, 0x3b,
-	0x21, 0x15, 0x4e, 0xc0, 0x9c, 0x20, 0x74, 0xfa, 0x27, 0xf2, 0x46, 0xa6, 0xb1, 0x5c, 0xfe, 0xfa,
-	0x79, 0x44, 0x6e, 0x85, 0x54, 0x74, 0x58, 0x27, 0x6c, 0x9d, 0x08, 0xda, 0xa5, 0x71, 0x8f, 0x7a,
-	0xe4, 0x1d, 0xd8, 0xd5, 0x2a, 0x6c, 0x2c, 0xe6, 0x75, 0x76, 0x50, 0x87, 0xa0, 0xce, 0xc3, 0xb1,
-	0xc8, 0x28, 0x59, 0xb0, 0x2d, 0x95, 0x84, 0x17, 0xc9, 0xcb, 0x30, 0xa4, 0x9e, 0xba, 0x34, 0x76,
-	0x31, 0x4f, 0xe9, 0xe4, 0x91, 0x17, 0x1d, 0xce, 0x26, 0xc8, 0x0d, 0xf8, 0xbf, 0x04, 0xef, 0x7a,
-	0x22, 0x78, 0x42, 0x1d, 0x16, 0xd1, 0x90, 0xa7, 0x9e, 0xea, 0xe8, 0x69, 0x57, 0x29, 0xde, 0x42,
-	0xc4, 0x43, 0x09, 0xd0, 0xee, 0x36, 0xa1, 0xc0, 0x22, 0x5e, 0xbf, 0x80, 0x28, 0xf9, 0xd3, 0xfc,
-	0x9b, 0x01, 0xeb, 0xf3, 0x07, 0xa2, 0xdc, 0x00, 0x3c, 0xf8, 0x94, 0xea, 0xd2, 0xc4, 0xdf, 0x89,
-	0x62, 0x3e, 0x55, 0x24, 0x6f, 0xc0, 0xa6, 0xcc, 0x91, 0x4b, 0x82, 0x12, 0xef, 0xaa, 0x04, 0xd7,
-	0x50, 0xde, 0x09, 0xb5, 0xcf, 0x6f, 0xc0, 0x96, 0x02, 0x4a, 0x5a, 0x12, 0xa4, 0xaa, 0xc5, 0x75,
-	0x9c, 0x78, 0x38, 0x16, 0x1a, 0xfa, 0x6d, 0xa8, 0xe3, 0x4a, 0x3a, 0x72, 0x2b, 0xba, 0xa1, 0xcf,
-	0xb1, 0x34, 0x28, 0xe7, 0xe9, 0x89, 0xb2, 0x83, 0xf3, 0x87, 0x7a, 0xba, 0x9b, 0xcc, 0x92, 0x37,
-	0x60, 0xe3, 0x31, 0x3d, 0xc1, 0x27, 0x15, 0x67, 0x14, 0x70, 0x4e, 0xb9, 0xae, 0xe3, 0xf5, 0x44,
-	0x7c, 0x1f, 0xa5, 0x6f, 0xee, 0xc3, 0xd6, 0xa9, 0x0e, 0x82, 0xac, 0x40, 0xe1, 0x96, 0xef, 0x6f,
-	0xe6, 0x08, 0x40, 0xd9, 0xa6, 0x23, 0xf6, 0x84, 0x6e, 0x1a, 0x07, 0xbf, 0x2c, 0x42, 0x55, 0xbd,
-	0xaa, 0xda, 0x91, 0x47, 0xae, 0x41, 0x25, 0x79, 0x54, 0x21, 0x9b, 0xd6, 0xc2, 0xcb, 0xd4, 0xde,
-	0x96, 0xb5, 0xf8, 0xe2, 0x62, 0xe6, 0xc8, 0x75, 0x80, 0xd9, 0x6b, 0x01, 0x21, 0xd6, 0xa9, 0x67,
-	0x92, 0xbd, 0x6d, 0xeb, 0xf4, 0x73, 0x82, 0x99, 0x23, 0xdf, 0x81, 0x5a, 0xe6, 0x62, 0x27, 0x12,
-	0xb5, 0xf8, 0xbd, 0xb3, 0x77, 0xce, 0x3a, 0xe3, 0xee, 0x37, 0x73, 0x64, 0x1f, 0x4a, 0xf8, 0x6c,
-	0x49, 0xd6, 0xac, 0xec, 0xcb, 0xe8, 0xde, 0xba, 0x35, 0xf7, 0x9a, 0x69, 0xe6, 0x74, 0x46, 0xf8,
-	0x1c, 0xa5, 0x32, 0xca, 0xbe, 0x45, 0xaa, 0x8c, 0xe6, 0x5e, 0xfa, 0xcc, 0x1c, 0xb9, 0x0d, 0x1b,
-	0x0b, 0xfd, 0x3a, 0xd9, 0xb5, 0xce, 0x7e, 0x3d, 0xd8, 0xab, 0x5b, 0x2f, 0x69, 0xed, 0x95, 0x9d,
-	0x85, 0x66, 0x93, 0xec, 0x5a, 0x67, 0x37, 0xe4, 0x7b, 0x75, 0xeb, 0x25, 0x7d, 0xa9, 0x99, 0x23,
-	0x37, 0x61, 0x6d, 0xae, 0xb7, 0x22, 0xe7, 0xad, 0xb3, 0xba, 0xce, 0xbd, 0x1d, 0xeb, 0xcc, 0x16,
-	0xcc, 0xcc, 0x91, 0x1b, 0xb0, 0x9a, 0xed, 0x4c, 0xc8, 0x39, 0xeb, 0x8c, 0xfe, 0x6a, 0xef, 0xbc,
-	0x75, 0x56, 0xfb, 0x62, 0xe6, 0x0e, 0x7c, 0x28, 0xdd, 0xb9, 0x2f, 0xcb, 0xe3, 0x75, 0xd2, 0xde,
-	0xba, 0xf9, 0xec, 0x79, 0x23, 0xf7, 0xe7, 0xe7, 0x8d, 0xdc, 0x97, 0xcf, 0x1b, 0xb9, 0xbf, 0x3f,
-	0x6f, 0xe4, 0xfe, 0xf1, 0xbc, 0x61, 0xfc, 0x64, 0xda, 0x30, 0x7e, 0x35, 0x6d, 0x18, 0x9f, 0x4f,
-	0x1b, 0xb9, 0x3f, 0x4c, 0x1b, 0xb9, 0x67, 0xd3, 0x86, 0xf1, 0xc5, 0xb4, 0x61, 0x7c, 0x39, 0x6d,
-	0x18, 0x3f, 0xff, 0x4b, 0x23, 0x77, 0xd7, 0xf8, 0xb8, 0xa2, 0xfe, 0xa3, 0x11, 0xf5, 0xfb, 0x65,
-	0xfc, 0x34, 0x7b, 0xe7, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x67, 0x75, 0xf8, 0xe4, 0x18,
-	0x00, 0x00,
+	// 2465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x73, 0x23, 0x47,
+	0x15, 0xd7, 0xe8, 0xcb, 0xd2, 0x93, 0x3f, 0xe4, 0xb6, 0xd7, 0xd6, 0x6a, 0x41, 0x32, 0x03, 0x95,
+	0x38, 0x21, 0x19, 0xef, 0x3a, 0xdf, 0x21, 0xa9, 0x24, 0xb2, 0x76, 0x37, 0xa2, 0xf6, 0xc3, 0x8c,
+	0x77, 0x09, 0xa4, 0x8a, 0x9a, 0x1a, 0xcd, 0xb4, 0xed, 0xc1, 0xd2, 0xcc, 0x64, 0xba, 0xe5, 0x44,
+	0x39, 0x71, 0xa1, 0x2a, 0xc5, 0x89, 0xbf, 0x80, 0x03, 0x55, 0x50, 0x5c, 0xe1, 0x94, 0x0b, 0x55,
+	0x1c, 0x73, 0x23, 0xc5, 0x89, 0x93, 0x93, 0xd5, 0x09, 0x6e, 0x1c, 0xa9, 0x3d, 0x50, 0x54, 0xbf,
+	0xee, 0xf9, 0x90, 0xec, 0xa4, 0x58, 0x25, 0x4b, 0x51, 0x9c, 0x34, 0xfd, 0xfa, 0xf7, 0xbe, 0xdf,
+	0x74, 0x3f, 0xbd, 0x81, 0xc5, 0xa1, 0xcd, 0x38, 0x8d, 0x8c, 0x30, 0x0a, 0x78, 0xd0, 0x7c, 0xf6,
+	0xc8, 0xe3, 0xc7, 0xa3, 0xbe, 0xe1, 0x04, 0xc3, 0x9d, 0xa3, 0xe0, 0x28, 0xd8, 0x41, 0x72, 0x7f,
+	0x74, 0x88, 0x2b, 0x5c, 0xe0, 0x93, 0x82, 0xbf, 0x90, 0x81, 0x73, 0xef, 0x68, 0x60, 0xf7, 0xd9,
+	0x4e, 0xdf, 0x1e, 0xb9, 0xd4, 0x3f, 0xf2, 0x7c, 0x2a, 0x99, 0x77, 0x86, 0x94, 0xdb, 0x61, 0x1f,
+	0x7f, 0x24, 0x9b, 0xde, 0x85, 0x85, 0x9b, 0xb7, 0x51, 0x2d, 0x59, 0x86, 0xbc, 0xe7, 0x36, 0xb4,
+	0x2d, 0x6d, 0x7b, 0xc9, 0xcc, 0x7b, 0x2e, 0xae, 0xc3, 0x46, 0x7e, 0x4b, 0xdb, 0xae, 0x9a, 0x79,
+	0x2f, 0x24, 0x97, 0xa1, 0x12, 0x85, 0x8e, 0x15, 0x06, 0x11, 0x6f, 0x14, 0x10, 0xb5, 0x10, 0x85,
+	0xce, 0x7e, 0x10, 0x71, 0x21, 0xe5, 0xdd, 0xaf, 0x2e, 0xe5, 0xf7, 0x1a, 0x94, 0xcc, 0x60, 0xc4,
+	0x29, 0xd9, 0x85, 0x6a, 0x68, 0x47, 0xdc, 0xe3, 0x5e, 0xe0, 0xa3, 0xac, 0xda, 0x2e, 0x18, 0xfb,
+	0x31, 0xa5, 0x53, 0xf9, 0xe4, 0xac, 0x9d, 0xfb, 0xf4, 0xac, 0xad, 0x99, 0x29, 0x8c, 0x5c, 0x81,
+	0x92, 0x1f, 0xb8, 0x94, 0x35, 0xf2, 0x5b, 0x85, 0xed, 0xda, 0x6e, 0xc9, 0xb8, 0x13, 0xb8, 0xd4,
+	0x94, 0x34, 0xf2, 0x0e, 0x94, 0x07, 0xd4, 0x76, 0x69, 0x24, 0x75, 0x76, 0xde, 0x98, 0x9c, 0xb5,
+	0xcb, 0xb7, 0x90, 0xf2, 0xf0, 0xac, 0x7d, 0xed, 0x3f, 0x8f, 0x1d, 0x4a, 0xed, 0x75, 0x4d, 0x25,
+	0x4e, 0xff, 0x31, 0x2c, 0xde, 0xa4, 0xbc, 0xdb, 0x31, 0xe9, 0x7b, 0x23, 0xca, 0x38, 0xb9, 0x0a,
+	0xe5, 0x63, 0xa9, 0x48, 0x9a, 0xbd, 0x6c, 0xa8, 0x9d, 0xb7, 0x91, 0x9a, 0x31, 0x5d, 0xe1, 0xc8,
+	0x26, 0x2c, 0x74, 0x3b, 0x96, 0x6f, 0x0f, 0xa9, 0x8a, 0x52, 0xb9, 0xdb, 0xb9, 0x63, 0x0f, 0xa9,
+	0xfe, 0x13, 0x58, 0x52, 0xa2, 0x59, 0x18, 0xf8, 0x8c, 0x92, 0x6b, 0x33, 0xb2, 0x57, 0x8c, 0x78,
+	0xeb, 0x0b, 0x85, 0x5f, 0x86, 0xbc, 0xdb, 0x47, 0xb9, 0xb5, 0xdd, 0x82, 0xd1, 0xed, 0x74, 0x8a,
+	0x02, 0x62, 0xe6, 0xdd, 0xbe, 0xfe, 0x07, 0x0d, 0x56, 0x6e, 0x52, 0x7e, 0x10, 0xda, 0x0e, 0x9d,
+	0xdf, 0xfa, 0x3b, 0x50, 0x72, 0xfb, 0x96, 0xe7, 0xa2, 0x8e, 0xa5, 0xce, 0x2b, 0x93, 0xb3, 0x76,
+	0xbe, 0xd7, 0x7d, 0x78, 0xd6, 0xde, 0x79, 0x84, 0x98, 0x76, 0x3b, 0xbd, 0xae, 0x59, 0x74, 0xfb,
+	0x3d, 0x97, 0x7c, 0x13, 0x00, 0x2d, 0x92, 0x01, 0x29, 0x60, 0x40, 0xaa, 0x48, 0xc1, 0x98, 0x78,
+	0x50, 0x4f, 0x6d, 0x9e, 0x3f, 0x2c, 0x3a, 0x94, 0x98, 0x90, 0xa1, 0x22, 0x53, 0x36, 0x50, 0xa2,
+	0x0a, 0x8e, 0xdc, 0xd2, 0x3f, 0xce, 0x63, 0x7c, 0xb0, 0x20, 0xe7, 0x8f, 0x4f, 0x2f, 0x49, 0x80,
+	0x0a, 0x4e, 0xb7, 0x33, 0x4f, 0x70, 0xf2, 0x6e, 0x9f, 0xdc, 0x8f, 0x8d, 0x4e, 0x4b, 0xb8, 0x84,
+	0x76, 0x3f, 0x3c, 0x6b, 0xef, 0x3e, 0x82, 0x40, 0xe4, 0xe9, 0x75, 0x95, 0x9f, 0xe4, 0x07, 0x50,
+	0x64, 0x83, 0x80, 0x37, 0x8a, 0x28, 0xf5, 0xf5, 0xc9, 0x59, 0xbb, 0x78, 0x30, 0x08, 0xf8, 0x23,
+	0xbe, 0x16, 0x82, 0x45, 0x24, 0x51, 0x88, 0xd2, 0x4f, 0xa0, 0x9e, 0x46, 0x6e, 0xfe, 0x2c, 0x7d,
+	0x07, 0xca, 0x91, 0x90, 0x11, 0xbf, 0xd2, 0x65, 0x03, 0x45, 0xaa, 0x34, 0xa9, 0x3d, 0xfd, 0x6f,
+	0x1a, 0x90, 0x77, 0x6c, 0xee, 0x1c, 0xe3, 0x26, 0xfb, 0x3f, 0x4e, 0x95, 0x7e, 0x1f, 0x00, 0x9d,
+	0xbc, 0x7e, 0x4a, 0x7d, 0x4e, 0xbe, 0x0d, 0x45, 0x3e, 0x0e, 0x29, 0xfa, 0xb7, 0x2c, 0xe2, 0x99,
+	0x6c, 0xdd, 0x1b, 0x87, 0xd4, 0xc4, 0x4d, 0x51, 0xe9, 0x18, 0xa7, 0xa4, 0xd2, 0xb3, 0x21, 0x94,
+	0x5b, 0x3a, 0x83, 0xb5, 0xa9, 0x00, 0xce, 0x9f, 0xb1, 0xa7, 0xa0, 0x4c, 0x85, 0x01, 0x71, 0xc6,
+	0x6a, 0x19, 0xa3, 0xe2, 0xb4, 0x49, 0x80, 0xfe, 0x77, 0x0d, 0x56, 0xf7, 0x0f, 0x4c, 0x7a, 0xe4,
+	0x89, 0x6b, 0x63, 0xfe, 0xac, 0xbd, 0x03, 0x65, 0x1f, 0x8f, 0xe4, 0x46, 0x3e, 0x89, 0x75, 0x59,
+	0x1e, 0xd2, 0x73, 0x9e, 0xec, 0x52, 0x9c, 0xba, 0xb8, 0x0a, 0xc9, 0xc5, 0xf5, 0x0a, 0x2c, 0x46,
+	0x23, 0x9f, 0x7b, 0x43, 0x6a, 0x79, 0xfe, 0x61, 0x80, 0xef, 0x4b, 0x6d, 0x77, 0xd1, 0x30, 0x25,
+	0xb1, 0xe7, 0x1f, 0x06, 0x19, 0xf3, 0x6a, 0x51, 0x4a, 0xd6, 0xff, 0xa2, 0x01, 0xc9, 0xfa, 0x3a,
+	0x7f, 0x80, 0x1f, 0x9b, 0xb7, 0x57, 0x01, 0x92, 0xab, 0x94, 0x35, 0x8a, 0x5b, 0x85, 0x99, 0x2b,
+	0x57, 0x26, 0x2f, 0x83, 0xd1, 0x3f, 0x84, 0x8d, 0xbd, 0x88, 0xda, 0x9c, 0x26, 0xa0, 0xf9, 0x93,
+	0x68, 0x64, 0xef, 0xfb, 0xfc, 0x96, 0x76, 0xa1, 0xf2, 0x14, 0xa2, 0x9f, 0xc2, 0xe6, 0x39, 0xdd,
+	0xf3, 0x07, 0x75, 0x1b, 0x16, 0x22, 0x1a, 0x0e, 0x3c, 0xc7, 0x56, 0xba, 0x2b, 0x86, 0x29, 0xd7,
+	0x4a, 0x73, 0xbc, 0xad, 0xff, 0x32, 0x0f, 0x1b, 0x5d, 0x3a, 0xa0, 0x5f, 0x8b, 0xd3, 0x27, 0x50,
+	0x4b, 0x3c, 0x4a, 0x12, 0xda, 0x9b, 0x9c, 0xb5, 0x6b, 0xfb, 0x29, 0xf9, 0xe1, 0x59, 0xfb, 0xc5,
+	0x47, 0xc8, 0x6a, 0x86, 0xd3, 0xcc, 0x4a, 0x4f, 0x0a, 0xc7, 0xcd, 0x36, 0x40, 0x5f, 0xbd, 0x70,
+	0x5c, 0xfd, 0x16, 0x6c, 0x9e, 0x8b, 0xc8, 0xdc, 0xa9, 0xd0, 0x3f, 0xca, 0xc3, 0xfa, 0xde, 0xb1,
+	0xed, 0x1f, 0x51, 0x95, 0x81, 0xf9, 0xc3, 0xfb, 0x84, 0x3a, 0x1e, 0xf3, 0x78, 0x3c, 0x92, 0x38,
+	0xa5, 0x52, 0x7a, 0xe6, 0x84, 0x1c, 0xc0, 0x62, 0x12, 0x28, 0xcb, 0x8b, 0xe3, 0xf3, 0x78, 0xf2,
+	0xe0, 0x66, 0x6b, 0xad, 0xf8, 0xe5, 0xb5, 0xf6, 0x7d, 0xb8, 0x34, 0x13, 0x89, 0xf9, 0xc3, 0xfa,
+	0x99, 0x06, 0x6b, 0x52, 0x98, 0xec, 0x79, 0xe7, 0x8f, 0xea, 0x6c, 0xb4, 0xf2, 0xff, 0xad, 0x68,
+	0x15, 0xbe, 0x3c, 0x5a, 0x3d, 0x58, 0x9f, 0x76, 0x70, 0xfe, 0x60, 0xfd, 0xab, 0x00, 0x95, 0xfd,
+	0x83, 0xbd, 0xc0, 0x3f, 0xf4, 0x8e, 0xc8, 0xb3, 0x99, 0xbf, 0x2b, 0xf8, 0xa7, 0xa6, 0x43, 0x26,
+	0x67, 0xed, 0x05, 0x73, 0x7f, 0x4f, 0xfc, 0x65, 0x79, 0x78, 0xd6, 0x2e, 0x78, 0x3e, 0x4f, 0xfe,
+	0xc2, 0x90, 0x27, 0x00, 0x6c, 0x77, 0xe8, 0xf9, 0x92, 0x41, 0x06, 0x67, 0x21, 0x46, 0x55, 0x71,
+	0x0b, 0x71, 0x2f, 0x02, 0x39, 0xa6, 0x76, 0xc4, 0xfb, 0xd4, 0xe6, 0x96, 0xe7, 0x73, 0x1a, 0x9d,
+	0xda, 0x83, 0x46, 0x61, 0x1a, 0xbf, 0x9a, 0x40, 0x7a, 0x0a, 0x41, 0x5e, 0x82, 0xb5, 0xc8, 0x3e,
+	0xe4, 0x56, 0xca, 0x8c, 0x8a, 0x8a, 0x33, 0x8c, 0x02, 0xf3, 0x76, 0x0c, 0x41, 0x85, 0x31, 0xa3,
+	0x8a, 0x17, 0xa7, 0x92, 0xb1, 0x74, 0x01, 0xa3, 0x19, 0x43, 0x90, 0xf1, 0x0d, 0xd8, 0x9c, 0xd1,
+	0x98, 0x98, 0x5b, 0x9e, 0x66, 0xbe, 0x34, 0xa5, 0x35, 0x31, 0x79, 0x1b, 0xea, 0x4a, 0x33, 0xb7,
+	0x3d, 0xdf, 0x1a, 0x04, 0x47, 0xac, 0xb1, 0xb0, 0xa5, 0x6d, 0x17, 0xcd, 0x65, 0xa9, 0x4d, 0x90,
+	0x6f, 0x05, 0x47, 0x8c, 0xbc, 0x05, 0x8d, 0xac, 0x8d, 0x96, 0x13, 0xf8, 0xce, 0x28, 0x8a, 0xa8,
+	0xef, 0x8c, 0x1b, 0x95, 0x69, 0x5d, 0x1b, 0x19, 0x43, 0xf7, 0x52, 0x18, 0xd9, 0x83, 0xcb, 0x28,
+	0x82, 0xf9, 0x76, 0xc8, 0x8e, 0x03, 0x3e, 0x25, 0xa3, 0x3a, 0x2d, 0x03, 0xfd, 0x3a, 0x50, 0xc0,
+	0x8c, 0x10, 0xfd, 0xd7, 0x05, 0x71, 0x5d, 0x27, 0x9e, 0xfc, 0x0f, 0xf6, 0x26, 0xcf, 0x4f, 0xdd,
+	0xd6, 0x05, 0xbc, 0xad, 0x97, 0x33, 0xaf, 0x91, 0xe8, 0x45, 0xce, 0xdd, 0xd8, 0xe4, 0x2a, 0x54,
+	0xd9, 0x98, 0x59, 0x8c, 0xdb, 0x9c, 0xa9, 0xd3, 0x67, 0x09, 0x25, 0x1f, 0x8c, 0xd9, 0x81, 0x20,
+	0x2a, 0x9e, 0x0a, 0x53, 0x6b, 0xf2, 0x3c, 0x94, 0x5c, 0xcf, 0xe1, 0xac, 0x51, 0x42, 0x15, 0x2d,
+	0xe3, 0x7c, 0x58, 0x8c, 0xae, 0x00, 0x5c, 0xf7, 0x79, 0x34, 0x36, 0x25, 0x98, 0x3c, 0x05, 0xe0,
+	0x7a, 0xec, 0x04, 0x15, 0x51, 0xac, 0x92, 0xe5, 0x5d, 0x30, 0xba, 0x1e, 0x3b, 0x11, 0x52, 0xa9,
+	0x59, 0x75, 0xe3, 0xc7, 0xe6, 0xcb, 0x00, 0x29, 0x3f, 0xa9, 0x43, 0xe1, 0x84, 0x8e, 0x31, 0xbc,
+	0x55, 0x53, 0x3c, 0x92, 0x75, 0x28, 0x9d, 0xda, 0x83, 0x91, 0x3c, 0xc5, 0x8b, 0xa6, 0x5c, 0xbc,
+	0x9a, 0x7f, 0x59, 0xd3, 0x7f, 0x9b, 0x87, 0xb5, 0x29, 0x6b, 0xe6, 0xbf, 0xff, 0x9f, 0x8c, 0xbd,
+	0x8c, 0x9b, 0x56, 0x61, 0x92, 0x17, 0xf8, 0x76, 0x34, 0x8e, 0x1b, 0x65, 0xe9, 0xd8, 0x4f, 0xe1,
+	0xd2, 0x7b, 0xa3, 0x80, 0xdb, 0x16, 0xfd, 0xc0, 0xa1, 0xd4, 0xa5, 0xae, 0x85, 0x7d, 0xb9, 0xcc,
+	0xc0, 0x52, 0xe7, 0xc5, 0x39, 0xbb, 0xfb, 0x35, 0x14, 0x7a, 0x5d, 0xc9, 0x44, 0x2a, 0x23, 0x37,
+	0x61, 0x2d, 0x7e, 0x0f, 0xd2, 0x60, 0xc6, 0x9d, 0xd9, 0x6a, 0x7c, 0x0c, 0x26, 0x41, 0x55, 0x86,
+	0xae, 0x46, 0x33, 0x74, 0xa6, 0xff, 0x3c, 0x0f, 0xf5, 0x59, 0xf4, 0xb9, 0x63, 0x5c, 0x7b, 0xac,
+	0xc7, 0xf8, 0x8f, 0x60, 0x41, 0x14, 0x6e, 0x7a, 0x5f, 0x7c, 0x5d, 0xdd, 0xc7, 0x4c, 0xa9, 0x15,
+	0xbe, 0xa4, 0xd4, 0xf4, 0xdf, 0x14, 0x60, 0x69, 0xea, 0x0d, 0x21, 0xfb, 0xe9, 0xa8, 0xaa, 0xf3,
+	0x66, 0x32, 0xb8, 0x98, 0xd7, 0x63, 0x31, 0xec, 0xba, 0x02, 0x55, 0x8f, 0x59, 0x6a, 0xd2, 0x24,
+	0x5c, 0xad, 0x98, 0x15, 0x8f, 0xdd, 0x8a, 0xdb, 0xcc, 0xb2, 0x30, 0x73, 0xc4, 0x94, 0x9d, 0xf5,
+	0x94, 0xfd, 0x00, 0xe9, 0xa6, 0xda, 0x27, 0xdf, 0x85, 0x12, 0x0d, 0x03, 0xe7, 0x58, 0xbd, 0xa4,
+	0x2b, 0x29, 0xf0, 0xba, 0x20, 0xc7, 0x45, 0x89, 0x18, 0xf2, 0x02, 0x80, 0x60, 0xf3, 0x18, 0xf7,
+	0x1c, 0xd6, 0x28, 0xcd, 0x72, 0x64, 0x5f, 0xec, 0x0c, 0x90, 0x3c, 0x03, 0x35, 0x79, 0x52, 0x4a,
+	0x93, 0xca, 0xc8, 0x57, 0x33, 0x4c, 0x71, 0x26, 0x4a, 0x6b, 0x20, 0x4a, 0x9e, 0xc9, 0x4e, 0xfc,
+	0x8a, 0x2c, 0x60, 0xfd, 0x5d, 0x9e, 0x3e, 0x6b, 0xce, 0x9f, 0x01, 0x5f, 0xe1, 0xc5, 0xfe, 0x48,
+	0x83, 0x5a, 0xe6, 0x3f, 0x15, 0x69, 0x43, 0xcd, 0x0e, 0x43, 0xeb, 0x94, 0x46, 0x2c, 0x9e, 0x06,
+	0x56, 0x4d, 0xb0, 0xc3, 0xf0, 0x87, 0x92, 0x22, 0x46, 0x46, 0x8c, 0xdb, 0x11, 0xb7, 0x04, 0x8b,
+	0x9a, 0xa1, 0x55, 0x91, 0x72, 0xcf, 0x1b, 0x52, 0xb1, 0x7d, 0x14, 0x24, 0xec, 0x6a, 0xa2, 0x74,
+	0x14, 0xc4, 0xdc, 0x4d, 0xa8, 0x84, 0x03, 0x9b, 0x1f, 0x06, 0xd1, 0x10, 0xc3, 0x5d, 0x35, 0x93,
+	0xb5, 0xfe, 0x67, 0x0d, 0x20, 0x0d, 0x08, 0x79, 0x26, 0xed, 0x46, 0xb4, 0x99, 0x6e, 0x24, 0x3d,
+	0x54, 0x62, 0x08, 0x21, 0x50, 0xe4, 0x34, 0x1a, 0x2a, 0x07, 0xf1, 0x59, 0x78, 0xed, 0xf9, 0x2e,
+	0xfd, 0x00, 0xcd, 0x28, 0x9a, 0x72, 0x41, 0x36, 0xa0, 0xec, 0x04, 0xc3, 0xa1, 0x27, 0xef, 0xf1,
+	0xa2, 0xa9, 0x56, 0xa4, 0x01, 0x0b, 0x76, 0x18, 0x0e, 0x3c, 0xea, 0x62, 0x5a, 0x8b, 0x66, 0xbc,
+	0x24, 0x2f, 0x41, 0xf5, 0x30, 0x18, 0x0c, 0x82, 0xf7, 0x69, 0x24, 0x52, 0x27, 0x52, 0xb2, 0x86,
+	0xa9, 0xbb, 0xa1, 0xa8, 0xd2, 0xe2, 0xf8, 0x8f, 0x53, 0x82, 0xd5, 0xff, 0xa8, 0x01, 0x39, 0x8f,
+	0x7b, 0x44, 0xcf, 0xd6, 0xa1, 0x34, 0x14, 0xf3, 0x82, 0x38, 0x77, 0xb8, 0xc8, 0x78, 0x51, 0x98,
+	0xf2, 0x82, 0x40, 0xd1, 0xa7, 0x1f, 0xc4, 0xbe, 0xe1, 0x33, 0xf9, 0x16, 0x2c, 0xba, 0xc1, 0xfb,
+	0xbe, 0xc5, 0xa8, 0x13, 0xf8, 0x2e, 0x53, 0xee, 0xd5, 0x04, 0xed, 0x40, 0x92, 0x84, 0x92, 0xf4,
+	0xfe, 0xa8, 0x9a, 0x72, 0xa1, 0xff, 0xaa, 0x04, 0x8b, 0xd9, 0x1b, 0x4b, 0x48, 0x1a, 0xd2, 0x61,
+	0x10, 0x8d, 0x2d, 0x1e, 0x70, 0x7b, 0x80, 0xe6, 0x17, 0xcd, 0x9a, 0xa4, 0xdd, 0x13, 0x24, 0xf2,
+	0x04, 0xac, 0x28, 0xc8, 0x88, 0x51, 0xd7, 0x8a, 0x18, 0x53, 0x86, 0x2f, 0x49, 0xf2, 0x7d, 0x46,
+	0x5d, 0x93, 0x31, 0x51, 0x68, 0x19, 0x9c, 0xf2, 0x02, 0x52, 0x4c, 0x06, 0x70, 0x18, 0x51, 0xda,
+	0x28, 0x66, 0x01, 0x37, 0x22, 0x4a, 0xc9, 0xd3, 0xb0, 0xca, 0xde, 0xb7, 0x43, 0x6b, 0xca, 0xa2,
+	0x32, 0xc2, 0x56, 0xc4, 0xc6, 0xed, 0x8c, 0x55, 0xdb, 0x50, 0xcf, 0x62, 0x51, 0xa5, 0x6a, 0x8b,
+	0x52, 0x28, 0xaa, 0x9d, 0x41, 0xa2, 0xee, 0xca, 0x2c, 0x12, 0xf5, 0xeb, 0xb0, 0xe4, 0x84, 0x23,
+	0x2b, 0x8c, 0x02, 0xc7, 0x8a, 0x44, 0xec, 0x60, 0x4b, 0xdb, 0xd6, 0xcc, 0x9a, 0x13, 0x8e, 0xf6,
+	0xa3, 0xc0, 0x31, 0xc5, 0xc9, 0x7f, 0x05, 0xaa, 0x02, 0xe3, 0x04, 0x23, 0x9f, 0x37, 0x6a, 0x38,
+	0x80, 0xaf, 0x38, 0xe1, 0x68, 0x4f, 0xac, 0xc5, 0xbb, 0x82, 0xc7, 0xa9, 0xb4, 0x7c, 0x05, 0x95,
+	0xe0, 0x11, 0x2a, 0x6d, 0xbe, 0x02, 0xb8, 0x90, 0xc6, 0xd6, 0x71, 0xb7, 0x22, 0x08, 0x68, 0x66,
+	0xbc, 0x89, 0xf6, 0xad, 0xa6, 0x9b, 0x68, 0xd9, 0x35, 0xd8, 0xf0, 0x29, 0xb7, 0xbc, 0xc0, 0xf2,
+	0x7c, 0xab, 0x3f, 0x16, 0xed, 0x27, 0x8d, 0x44, 0xfa, 0x1b, 0x97, 0x10, 0xb9, 0xea, 0x53, 0xde,
+	0x0b, 0x7a, 0x7e, 0x67, 0xcc, 0xe9, 0x3e, 0x8d, 0x0e, 0xa8, 0x43, 0x9e, 0x83, 0x4d, 0xc5, 0x12,
+	0x8c, 0xf8, 0x34, 0xcf, 0x06, 0xf2, 0x10, 0xe4, 0xb9, 0x3b, 0xe2, 0x19, 0x26, 0x03, 0xd6, 0x04,
+	0x13, 0x77, 0x42, 0xd1, 0xf9, 0xf9, 0xd4, 0x91, 0x1d, 0xd2, 0x26, 0xfa, 0x29, 0x94, 0xdc, 0x73,
+	0xc2, 0xbd, 0x74, 0x83, 0xbc, 0x0e, 0xdf, 0x88, 0xf1, 0xb6, 0xc3, 0xbd, 0x53, 0x6a, 0x05, 0x21,
+	0xf5, 0x59, 0xa2, 0xa9, 0x81, 0x9a, 0x36, 0x25, 0xe3, 0x5b, 0x88, 0xb8, 0x2b, 0x00, 0x4a, 0x5d,
+	0x1d, 0x0a, 0x41, 0xc8, 0x1a, 0x97, 0x11, 0x25, 0x1e, 0xf5, 0x5f, 0xe4, 0x61, 0x79, 0xfa, 0xec,
+	0x15, 0x2f, 0x00, 0xf3, 0x3e, 0xa4, 0xaa, 0x34, 0xf1, 0x39, 0x66, 0xcc, 0x27, 0x8c, 0xe4, 0x49,
+	0xa8, 0x0b, 0x1f, 0x99, 0x08, 0x50, 0xac, 0x5d, 0x96, 0xe0, 0x12, 0xd2, 0x7b, 0xbe, 0xd2, 0xf9,
+	0x14, 0xac, 0x4a, 0xa0, 0x08, 0x4b, 0x8c, 0x94, 0xb5, 0xb8, 0x8c, 0x1b, 0x77, 0x47, 0x5c, 0x41,
+	0x5f, 0x86, 0x06, 0x66, 0xd2, 0x12, 0xaf, 0xa2, 0xed, 0xbb, 0x0c, 0x4b, 0x83, 0x32, 0x96, 0x9c,
+	0x28, 0x1b, 0xb8, 0xbf, 0xa7, 0xb6, 0xf7, 0xe3, 0x5d, 0xf2, 0x24, 0xac, 0x9c, 0xd0, 0x31, 0x76,
+	0x37, 0xd6, 0xd0, 0x63, 0x8c, 0x32, 0x55, 0xc7, 0xcb, 0x31, 0xf9, 0x36, 0x52, 0x31, 0xeb, 0x81,
+	0xa3, 0xca, 0x69, 0x41, 0x65, 0x3d, 0x70, 0xb0, 0x9c, 0x9e, 0xde, 0x85, 0xe5, 0xe9, 0xa1, 0x24,
+	0x59, 0x81, 0x1a, 0x52, 0xee, 0x87, 0xae, 0xcd, 0x69, 0x3d, 0x97, 0x10, 0xe4, 0x0c, 0xa1, 0xae,
+	0x3d, 0xbd, 0x0d, 0xab, 0xe7, 0xfe, 0xa9, 0x93, 0x05, 0x28, 0xbc, 0xe5, 0xba, 0xf5, 0x1c, 0x01,
+	0x28, 0x9b, 0x74, 0x18, 0x9c, 0x0a, 0xe4, 0xf7, 0xa0, 0x9a, 0x36, 0x34, 0xcb, 0xe2, 0xbe, 0x61,
+	0x27, 0x77, 0x82, 0x68, 0x68, 0x0f, 0xea, 0x39, 0x52, 0x87, 0x45, 0xb1, 0x36, 0xa9, 0xed, 0xde,
+	0xf5, 0x07, 0xe3, 0xba, 0x46, 0x16, 0xa1, 0x22, 0x28, 0x37, 0x46, 0x83, 0x41, 0x3d, 0xbf, 0xfb,
+	0x59, 0x11, 0xaa, 0xf2, 0x8b, 0x95, 0x19, 0x3a, 0xe4, 0x1a, 0x54, 0xe2, 0x81, 0x35, 0xa9, 0x1b,
+	0x33, 0x53, 0xff, 0xe6, 0xaa, 0x31, 0x3b, 0xcd, 0xd6, 0x73, 0xe4, 0x25, 0x80, 0x74, 0xa4, 0x47,
+	0x88, 0x71, 0x6e, 0x96, 0xd9, 0x5c, 0x33, 0xce, 0xcf, 0xfc, 0xf4, 0x1c, 0x79, 0x15, 0x6a, 0x99,
+	0xbe, 0x95, 0xac, 0x5d, 0xd0, 0x53, 0x37, 0xd7, 0x8d, 0x0b, 0x5a, 0x5b, 0x3d, 0x47, 0xb6, 0xa1,
+	0x84, 0x9f, 0x84, 0xc8, 0x92, 0x91, 0xfd, 0xea, 0xd4, 0x5c, 0x36, 0xa6, 0xbe, 0x14, 0xe9, 0x39,
+	0xe5, 0x11, 0xf6, 0x92, 0xd2, 0xa3, 0xec, 0x77, 0x9e, 0xe6, 0x6a, 0x86, 0x92, 0xb0, 0xdc, 0x80,
+	0x95, 0x99, 0xa1, 0x1a, 0xd9, 0x34, 0x2e, 0x1e, 0xf1, 0x35, 0x1b, 0xc6, 0x17, 0xcc, 0xdf, 0xa4,
+	0x9c, 0x99, 0x89, 0x10, 0xd9, 0x34, 0x2e, 0x9e, 0x9a, 0x35, 0x1b, 0xc6, 0x17, 0x0c, 0x8f, 0xf4,
+	0x1c, 0x79, 0x13, 0x96, 0xa6, 0x06, 0x20, 0xe4, 0x92, 0x71, 0xd1, 0x68, 0xa8, 0xb9, 0x61, 0x5c,
+	0x38, 0x27, 0xd1, 0x73, 0xe4, 0x75, 0x58, 0xcc, 0x0e, 0x05, 0xc8, 0xba, 0x71, 0xc1, 0x10, 0xa4,
+	0x79, 0xc9, 0xb8, 0x68, 0x72, 0xa0, 0xe7, 0xc8, 0x6b, 0x50, 0xcb, 0xcc, 0xc5, 0xc9, 0x9a, 0x71,
+	0xfe, 0x33, 0x43, 0x73, 0xdd, 0xb8, 0x60, 0x74, 0xae, 0xe7, 0xae, 0x6a, 0xbb, 0x2e, 0x94, 0x6e,
+	0xde, 0x16, 0xc5, 0xf5, 0x38, 0x93, 0xd6, 0x79, 0xed, 0x93, 0x07, 0xad, 0xdc, 0x5f, 0x1f, 0xb4,
+	0x72, 0x9f, 0x3f, 0x68, 0xe5, 0xfe, 0xf1, 0xa0, 0x95, 0xfb, 0xe7, 0x83, 0x96, 0xf6, 0xb3, 0x49,
+	0x4b, 0xfb, 0xdd, 0xa4, 0xa5, 0x7d, 0x3c, 0x69, 0xe5, 0xfe, 0x34, 0x69, 0xe5, 0x3e, 0x99, 0xb4,
+	0xb4, 0x4f, 0x27, 0x2d, 0xed, 0xf3, 0x49, 0x4b, 0x7b, 0x5b, 0x7b, 0xb7, 0x22, 0xbf, 0x33, 0x87,
+	0xfd, 0x7e, 0x19, 0xbb, 0xd9, 0xe7, 0xfe, 0x3d, 0x00, 0xb9, 0x3a, 0x66, 0xc9, 0x7a, 0x1e, 0x00,
+	0x00,
 }
@@ -51,6 +51,10 @@ const (
 	PS_RESP_CODE_NO_LEADER      RespCode = 503
 	PS_RESP_CODE_KEY_EXISTS     RespCode = 409
 	PS_RESP_CODE_KEY_NOT_EXISTS RespCode = 410
+	PS_RESP_CODE_TOKEN_EXPIRED  RespCode = 411
+	PS_RESP_CODE_QUOTA_EXCEEDED RespCode = 507
+	PS_RESP_CODE_READONLY       RespCode = 508
+	PS_RESP_CODE_DRAINING       RespCode = 509
 )
 
 func (e *NotLeader) Error() string {
@@ -69,6 +73,18 @@ func (e *MsgTooLarge) Error() string {
 	return fmt.Sprintf("partition(%d) request message is too large(%d)", e.PartitionID, e.MsgSize)
 }
 
+func (e *QuotaExceeded) Error() string {
+	return fmt.Sprintf("space(%d) of partition(%d) exceeds its quota", e.SpaceID, e.PartitionID)
+}
+
+func (e *PartitionReadOnly) Error() string {
+	return fmt.Sprintf("partition(%d) is read-only, the disk of node(%d) is full", e.PartitionID, e.NodeID)
+}
+
+func (e *PartitionDraining) Error() string {
+	return fmt.Sprintf("partition(%d) is led by node(%d) which is draining, retry later", e.PartitionID, e.NodeID)
+}
+
 func (e *TimeoutError) Error() string {
 	return "request timeout"
 }
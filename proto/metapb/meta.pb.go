@@ -11,8 +11,11 @@
 		Zone
 		Task
 		DB
+		Quota
 		KeyPolicy
 		Space
+		ReplicaPolicy
+		Dictionary
 		PartitionEpoch
 		Partition
 		Replica
@@ -24,13 +27,16 @@
 		NoLeader
 		PartitionNotFound
 		MsgTooLarge
+		QuotaExceeded
+		PartitionReadOnly
+		PartitionDraining
 		TimeoutError
 		ServerError
 		Error
 */
 package metapb
 
-import proto "github.com/golang/protobuf/proto"
+import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
@@ -49,7 +55,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type SpaceStatus int32
 
@@ -90,17 +96,21 @@ const (
 	ST_ENTITY SpaceType = 0
 	ST_EDGE   SpaceType = 1
 	ST_BLOB   SpaceType = 2
+	// records of the cross-partition transactions
+	ST_TXN SpaceType = 3
 )
 
 var SpaceType_name = map[int32]string{
 	0: "ST_ENTITY",
 	1: "ST_EDGE",
 	2: "ST_BLOB",
+	3: "ST_TXN",
 }
 var SpaceType_value = map[string]int32{
 	"ST_ENTITY": 0,
 	"ST_EDGE":   1,
 	"ST_BLOB":   2,
+	"ST_TXN":    3,
 }
 
 func (x SpaceType) String() string {
@@ -159,14 +169,28 @@ func (*Task) ProtoMessage()               {}
 func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{1} }
 
 type DB struct {
-	ID   DBID   `protobuf:"varint,1,opt,name=id,proto3,casttype=DBID" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ID    DBID   `protobuf:"varint,1,opt,name=id,proto3,casttype=DBID" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quota *Quota `protobuf:"bytes,3,opt,name=quota" json:"quota,omitempty"`
 }
 
 func (m *DB) Reset()                    { *m = DB{} }
 func (*DB) ProtoMessage()               {}
 func (*DB) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{2} }
 
+// Quota bounds the resources of a db or a space, a zero limit is unlimited.
+type Quota struct {
+	// the bytes on disk of the leader replicas of the partitions
+	StorageBytes uint64 `protobuf:"varint,1,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	DocCount     uint64 `protobuf:"varint,2,opt,name=doc_count,json=docCount,proto3" json:"doc_count,omitempty"`
+	// the writes per second through each router
+	WriteQps uint32 `protobuf:"varint,3,opt,name=write_qps,json=writeQps,proto3" json:"write_qps,omitempty"`
+}
+
+func (m *Quota) Reset()                    { *m = Quota{} }
+func (*Quota) ProtoMessage()               {}
+func (*Quota) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{3} }
+
 type KeyPolicy struct {
 	KeyField string `protobuf:"bytes,1,opt,name=key_field,json=keyField,proto3" json:"key_field,omitempty"`
 	KeyFunc  string `protobuf:"bytes,2,opt,name=key_func,json=keyFunc,proto3" json:"key_func,omitempty"`
@@ -174,22 +198,53 @@ type KeyPolicy struct {
 
 func (m *KeyPolicy) Reset()                    { *m = KeyPolicy{} }
 func (*KeyPolicy) ProtoMessage()               {}
-func (*KeyPolicy) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{3} }
+func (*KeyPolicy) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{4} }
 
 type Space struct {
-	ID        SpaceID     `protobuf:"varint,1,opt,name=id,proto3,casttype=SpaceID" json:"id,omitempty"`
-	DB        DBID        `protobuf:"varint,2,opt,name=db,proto3,casttype=DBID" json:"db,omitempty"`
-	DbName    string      `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	Name      string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Type      SpaceType   `protobuf:"varint,5,opt,name=type,proto3,enum=SpaceType" json:"type,omitempty"`
-	Status    SpaceStatus `protobuf:"varint,6,opt,name=status,proto3,enum=SpaceStatus" json:"status,omitempty"`
-	KeyPolicy *KeyPolicy  `protobuf:"bytes,7,opt,name=key_policy,json=keyPolicy" json:"key_policy,omitempty"`
-	Schema    string      `protobuf:"bytes,8,opt,name=schema,proto3" json:"schema,omitempty"`
+	ID            SpaceID        `protobuf:"varint,1,opt,name=id,proto3,casttype=SpaceID" json:"id,omitempty"`
+	DB            DBID           `protobuf:"varint,2,opt,name=db,proto3,casttype=DBID" json:"db,omitempty"`
+	DbName        string         `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Name          string         `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Type          SpaceType      `protobuf:"varint,5,opt,name=type,proto3,enum=SpaceType" json:"type,omitempty"`
+	Status        SpaceStatus    `protobuf:"varint,6,opt,name=status,proto3,enum=SpaceStatus" json:"status,omitempty"`
+	KeyPolicy     *KeyPolicy     `protobuf:"bytes,7,opt,name=key_policy,json=keyPolicy" json:"key_policy,omitempty"`
+	Schema        string         `protobuf:"bytes,8,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReplicaPolicy *ReplicaPolicy `protobuf:"bytes,9,opt,name=replica_policy,json=replicaPolicy" json:"replica_policy,omitempty"`
+	Quota         *Quota         `protobuf:"bytes,10,opt,name=quota" json:"quota,omitempty"`
+	// the usage of the space or of its db is over the quota, the writes adding data are rejected
+	QuotaExceeded bool `protobuf:"varint,11,opt,name=quota_exceeded,json=quotaExceeded,proto3" json:"quota_exceeded,omitempty"`
 }
 
 func (m *Space) Reset()                    { *m = Space{} }
 func (*Space) ProtoMessage()               {}
-func (*Space) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{4} }
+func (*Space) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{5} }
+
+// ReplicaPolicy is the placement of the replicas of the partitions of a space.
+type ReplicaPolicy struct {
+	// the number of replicas of a partition
+	ReplicaNum uint32 `protobuf:"varint,1,opt,name=replica_num,json=replicaNum,proto3" json:"replica_num,omitempty"`
+	// no two replicas of a partition in a zone
+	OnePerZone bool `protobuf:"varint,2,opt,name=one_per_zone,json=onePerZone,proto3" json:"one_per_zone,omitempty"`
+	// the replicas of a partition span at least the zones
+	MinZones uint32 `protobuf:"varint,3,opt,name=min_zones,json=minZones,proto3" json:"min_zones,omitempty"`
+	// the leaders of the partitions are in the zone
+	LeaderZone string `protobuf:"bytes,4,opt,name=leader_zone,json=leaderZone,proto3" json:"leader_zone,omitempty"`
+}
+
+func (m *ReplicaPolicy) Reset()                    { *m = ReplicaPolicy{} }
+func (*ReplicaPolicy) ProtoMessage()               {}
+func (*ReplicaPolicy) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{6} }
+
+// Dictionary is a version of a user dictionary or stop word list of the analyzers.
+type Dictionary struct {
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version uint64   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Words   []string `protobuf:"bytes,3,rep,name=words" json:"words,omitempty"`
+}
+
+func (m *Dictionary) Reset()                    { *m = Dictionary{} }
+func (*Dictionary) ProtoMessage()               {}
+func (*Dictionary) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{7} }
 
 type PartitionEpoch struct {
 	// Conf change version, auto increment when add or remove peer
@@ -200,7 +255,7 @@ type PartitionEpoch struct {
 
 func (m *PartitionEpoch) Reset()                    { *m = PartitionEpoch{} }
 func (*PartitionEpoch) ProtoMessage()               {}
-func (*PartitionEpoch) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{5} }
+func (*PartitionEpoch) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{8} }
 
 type Partition struct {
 	ID        PartitionID     `protobuf:"varint,1,opt,name=id,proto3,casttype=PartitionID" json:"id,omitempty"`
@@ -215,7 +270,7 @@ type Partition struct {
 
 func (m *Partition) Reset()                    { *m = Partition{} }
 func (*Partition) ProtoMessage()               {}
-func (*Partition) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{6} }
+func (*Partition) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{9} }
 
 type Replica struct {
 	ID           ReplicaID `protobuf:"varint,1,opt,name=id,proto3,casttype=ReplicaID" json:"id,omitempty"`
@@ -226,7 +281,7 @@ type Replica struct {
 
 func (m *Replica) Reset()                    { *m = Replica{} }
 func (*Replica) ProtoMessage()               {}
-func (*Replica) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{7} }
+func (*Replica) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{10} }
 
 type Node struct {
 	ID           NodeID `protobuf:"varint,1,opt,name=id,proto3,casttype=NodeID" json:"id,omitempty"`
@@ -238,7 +293,7 @@ type Node struct {
 
 func (m *Node) Reset()                    { *m = Node{} }
 func (*Node) ProtoMessage()               {}
-func (*Node) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{8} }
+func (*Node) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{11} }
 
 type ReplicaAddrs struct {
 	HeartbeatAddr string `protobuf:"bytes,1,opt,name=heartbeat_addr,json=heartbeatAddr,proto3" json:"heartbeat_addr,omitempty"`
@@ -249,7 +304,7 @@ type ReplicaAddrs struct {
 
 func (m *ReplicaAddrs) Reset()                    { *m = ReplicaAddrs{} }
 func (*ReplicaAddrs) ProtoMessage()               {}
-func (*ReplicaAddrs) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{9} }
+func (*ReplicaAddrs) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{12} }
 
 type RequestHeader struct {
 	ReqId   string `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
@@ -258,7 +313,7 @@ type RequestHeader struct {
 
 func (m *RequestHeader) Reset()                    { *m = RequestHeader{} }
 func (*RequestHeader) ProtoMessage()               {}
-func (*RequestHeader) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{10} }
+func (*RequestHeader) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{13} }
 
 type ResponseHeader struct {
 	ReqId   string   `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
@@ -269,7 +324,7 @@ type ResponseHeader struct {
 
 func (m *ResponseHeader) Reset()                    { *m = ResponseHeader{} }
 func (*ResponseHeader) ProtoMessage()               {}
-func (*ResponseHeader) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{11} }
+func (*ResponseHeader) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{14} }
 
 type NotLeader struct {
 	PartitionID PartitionID    `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=PartitionID" json:"partition_id,omitempty"`
@@ -280,7 +335,7 @@ type NotLeader struct {
 
 func (m *NotLeader) Reset()                    { *m = NotLeader{} }
 func (*NotLeader) ProtoMessage()               {}
-func (*NotLeader) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{12} }
+func (*NotLeader) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{15} }
 
 type NoLeader struct {
 	PartitionID PartitionID `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=PartitionID" json:"partition_id,omitempty"`
@@ -288,7 +343,7 @@ type NoLeader struct {
 
 func (m *NoLeader) Reset()                    { *m = NoLeader{} }
 func (*NoLeader) ProtoMessage()               {}
-func (*NoLeader) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{13} }
+func (*NoLeader) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{16} }
 
 type PartitionNotFound struct {
 	PartitionID PartitionID `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=PartitionID" json:"partition_id,omitempty"`
@@ -296,7 +351,7 @@ type PartitionNotFound struct {
 
 func (m *PartitionNotFound) Reset()                    { *m = PartitionNotFound{} }
 func (*PartitionNotFound) ProtoMessage()               {}
-func (*PartitionNotFound) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{14} }
+func (*PartitionNotFound) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{17} }
 
 type MsgTooLarge struct {
 	PartitionID PartitionID `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=PartitionID" json:"partition_id,omitempty"`
@@ -305,14 +360,41 @@ type MsgTooLarge struct {
 
 func (m *MsgTooLarge) Reset()                    { *m = MsgTooLarge{} }
 func (*MsgTooLarge) ProtoMessage()               {}
-func (*MsgTooLarge) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{15} }
+func (*MsgTooLarge) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{18} }
+
+type QuotaExceeded struct {
+	PartitionID PartitionID `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=PartitionID" json:"partition_id,omitempty"`
+	SpaceID     SpaceID     `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3,casttype=SpaceID" json:"space_id,omitempty"`
+}
+
+func (m *QuotaExceeded) Reset()                    { *m = QuotaExceeded{} }
+func (*QuotaExceeded) ProtoMessage()               {}
+func (*QuotaExceeded) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{19} }
+
+type PartitionReadOnly struct {
+	PartitionID PartitionID `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=PartitionID" json:"partition_id,omitempty"`
+	NodeID      NodeID      `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3,casttype=NodeID" json:"node_id,omitempty"`
+}
+
+func (m *PartitionReadOnly) Reset()                    { *m = PartitionReadOnly{} }
+func (*PartitionReadOnly) ProtoMessage()               {}
+func (*PartitionReadOnly) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{20} }
+
+type PartitionDraining struct {
+	PartitionID PartitionID `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=PartitionID" json:"partition_id,omitempty"`
+	NodeID      NodeID      `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3,casttype=NodeID" json:"node_id,omitempty"`
+}
+
+func (m *PartitionDraining) Reset()                    { *m = PartitionDraining{} }
+func (*PartitionDraining) ProtoMessage()               {}
+func (*PartitionDraining) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{21} }
 
 type TimeoutError struct {
 }
 
 func (m *TimeoutError) Reset()                    { *m = TimeoutError{} }
 func (*TimeoutError) ProtoMessage()               {}
-func (*TimeoutError) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{16} }
+func (*TimeoutError) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{22} }
 
 type ServerError struct {
 	Cause string `protobuf:"bytes,1,opt,name=cause,proto3" json:"cause,omitempty"`
@@ -320,25 +402,31 @@ type ServerError struct {
 
 func (m *ServerError) Reset()                    { *m = ServerError{} }
 func (*ServerError) ProtoMessage()               {}
-func (*ServerError) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{17} }
+func (*ServerError) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{23} }
 
 type Error struct {
 	NotLeader         *NotLeader         `protobuf:"bytes,1,opt,name=not_leader,json=notLeader" json:"not_leader,omitempty"`
 	NoLeader          *NoLeader          `protobuf:"bytes,2,opt,name=no_leader,json=noLeader" json:"no_leader,omitempty"`
 	PartitionNotFound *PartitionNotFound `protobuf:"bytes,3,opt,name=partition_not_found,json=partitionNotFound" json:"partition_not_found,omitempty"`
 	MsgTooLarge       *MsgTooLarge       `protobuf:"bytes,4,opt,name=msg_too_large,json=msgTooLarge" json:"msg_too_large,omitempty"`
+	QuotaExceeded     *QuotaExceeded     `protobuf:"bytes,5,opt,name=quota_exceeded,json=quotaExceeded" json:"quota_exceeded,omitempty"`
+	PartitionReadOnly *PartitionReadOnly `protobuf:"bytes,6,opt,name=partition_read_only,json=partitionReadOnly" json:"partition_read_only,omitempty"`
+	PartitionDraining *PartitionDraining `protobuf:"bytes,7,opt,name=partition_draining,json=partitionDraining" json:"partition_draining,omitempty"`
 }
 
 func (m *Error) Reset()                    { *m = Error{} }
 func (*Error) ProtoMessage()               {}
-func (*Error) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{18} }
+func (*Error) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{24} }
 
 func init() {
 	proto.RegisterType((*Zone)(nil), "Zone")
 	proto.RegisterType((*Task)(nil), "Task")
 	proto.RegisterType((*DB)(nil), "DB")
+	proto.RegisterType((*Quota)(nil), "Quota")
 	proto.RegisterType((*KeyPolicy)(nil), "KeyPolicy")
 	proto.RegisterType((*Space)(nil), "Space")
+	proto.RegisterType((*ReplicaPolicy)(nil), "ReplicaPolicy")
+	proto.RegisterType((*Dictionary)(nil), "Dictionary")
 	proto.RegisterType((*PartitionEpoch)(nil), "PartitionEpoch")
 	proto.RegisterType((*Partition)(nil), "Partition")
 	proto.RegisterType((*Replica)(nil), "Replica")
@@ -350,6 +438,9 @@ func init() {
 	proto.RegisterType((*NoLeader)(nil), "NoLeader")
 	proto.RegisterType((*PartitionNotFound)(nil), "PartitionNotFound")
 	proto.RegisterType((*MsgTooLarge)(nil), "MsgTooLarge")
+	proto.RegisterType((*QuotaExceeded)(nil), "QuotaExceeded")
+	proto.RegisterType((*PartitionReadOnly)(nil), "PartitionReadOnly")
+	proto.RegisterType((*PartitionDraining)(nil), "PartitionDraining")
 	proto.RegisterType((*TimeoutError)(nil), "TimeoutError")
 	proto.RegisterType((*ServerError)(nil), "ServerError")
 	proto.RegisterType((*Error)(nil), "Error")
@@ -442,6 +533,39 @@ func (this *DB) Equal(that interface{}) bool {
 	if this.Name != that1.Name {
 		return false
 	}
+	if !this.Quota.Equal(that1.Quota) {
+		return false
+	}
+	return true
+}
+func (this *Quota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Quota)
+	if !ok {
+		that2, ok := that.(Quota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StorageBytes != that1.StorageBytes {
+		return false
+	}
+	if this.DocCount != that1.DocCount {
+		return false
+	}
+	if this.WriteQps != that1.WriteQps {
+		return false
+	}
 	return true
 }
 func (this *KeyPolicy) Equal(that interface{}) bool {
@@ -514,6 +638,83 @@ func (this *Space) Equal(that interface{}) bool {
 	if this.Schema != that1.Schema {
 		return false
 	}
+	if !this.ReplicaPolicy.Equal(that1.ReplicaPolicy) {
+		return false
+	}
+	if !this.Quota.Equal(that1.Quota) {
+		return false
+	}
+	if this.QuotaExceeded != that1.QuotaExceeded {
+		return false
+	}
+	return true
+}
+func (this *ReplicaPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicaPolicy)
+	if !ok {
+		that2, ok := that.(ReplicaPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ReplicaNum != that1.ReplicaNum {
+		return false
+	}
+	if this.OnePerZone != that1.OnePerZone {
+		return false
+	}
+	if this.MinZones != that1.MinZones {
+		return false
+	}
+	if this.LeaderZone != that1.LeaderZone {
+		return false
+	}
+	return true
+}
+func (this *Dictionary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Dictionary)
+	if !ok {
+		that2, ok := that.(Dictionary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.Words) != len(that1.Words) {
+		return false
+	}
+	for i := range this.Words {
+		if this.Words[i] != that1.Words[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *PartitionEpoch) Equal(that interface{}) bool {
@@ -863,6 +1064,87 @@ func (this *MsgTooLarge) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *QuotaExceeded) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QuotaExceeded)
+	if !ok {
+		that2, ok := that.(QuotaExceeded)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if this.SpaceID != that1.SpaceID {
+		return false
+	}
+	return true
+}
+func (this *PartitionReadOnly) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PartitionReadOnly)
+	if !ok {
+		that2, ok := that.(PartitionReadOnly)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if this.NodeID != that1.NodeID {
+		return false
+	}
+	return true
+}
+func (this *PartitionDraining) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PartitionDraining)
+	if !ok {
+		that2, ok := that.(PartitionDraining)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if this.NodeID != that1.NodeID {
+		return false
+	}
+	return true
+}
 func (this *TimeoutError) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -939,6 +1221,15 @@ func (this *Error) Equal(that interface{}) bool {
 	if !this.MsgTooLarge.Equal(that1.MsgTooLarge) {
 		return false
 	}
+	if !this.QuotaExceeded.Equal(that1.QuotaExceeded) {
+		return false
+	}
+	if !this.PartitionReadOnly.Equal(that1.PartitionReadOnly) {
+		return false
+	}
+	if !this.PartitionDraining.Equal(that1.PartitionDraining) {
+		return false
+	}
 	return true
 }
 func (m *Zone) Marshal() (dAtA []byte, err error) {
@@ -1039,6 +1330,49 @@ func (m *DB) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
+	if m.Quota != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Quota.Size()))
+		n1, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *Quota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StorageBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.StorageBytes))
+	}
+	if m.DocCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.DocCount))
+	}
+	if m.WriteQps != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.WriteQps))
+	}
 	return i, nil
 }
 
@@ -1123,11 +1457,11 @@ func (m *Space) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintMeta(dAtA, i, uint64(m.KeyPolicy.Size()))
-		n1, err := m.KeyPolicy.MarshalTo(dAtA[i:])
+		n2, err := m.KeyPolicy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n1
+		i += n2
 	}
 	if len(m.Schema) > 0 {
 		dAtA[i] = 0x42
@@ -1135,10 +1469,40 @@ func (m *Space) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMeta(dAtA, i, uint64(len(m.Schema)))
 		i += copy(dAtA[i:], m.Schema)
 	}
+	if m.ReplicaPolicy != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ReplicaPolicy.Size()))
+		n3, err := m.ReplicaPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Quota.Size()))
+		n4, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.QuotaExceeded {
+		dAtA[i] = 0x58
+		i++
+		if m.QuotaExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
-func (m *PartitionEpoch) Marshal() (dAtA []byte, err error) {
+func (m *ReplicaPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1148,25 +1512,41 @@ func (m *PartitionEpoch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PartitionEpoch) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicaPolicy) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ConfVersion != 0 {
+	if m.ReplicaNum != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.ConfVersion))
+		i = encodeVarintMeta(dAtA, i, uint64(m.ReplicaNum))
 	}
-	if m.Version != 0 {
+	if m.OnePerZone {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Version))
+		if m.OnePerZone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MinZones != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MinZones))
+	}
+	if len(m.LeaderZone) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.LeaderZone)))
+		i += copy(dAtA[i:], m.LeaderZone)
 	}
 	return i, nil
 }
 
-func (m *Partition) Marshal() (dAtA []byte, err error) {
+func (m *Dictionary) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1176,16 +1556,88 @@ func (m *Partition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Partition) MarshalTo(dAtA []byte) (int, error) {
+func (m *Dictionary) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.ID))
-	}
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Words) > 0 {
+		for _, s := range m.Words {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *PartitionEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionEpoch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ConfVersion != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ConfVersion))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *Partition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Partition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ID))
+	}
 	if m.DB != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1226,11 +1678,11 @@ func (m *Partition) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintMeta(dAtA, i, uint64(m.Epoch.Size()))
-	n2, err := m.Epoch.MarshalTo(dAtA[i:])
+	n5, err := m.Epoch.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n2
+	i += n5
 	return i, nil
 }
 
@@ -1262,11 +1714,11 @@ func (m *Replica) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintMeta(dAtA, i, uint64(m.ReplicaAddrs.Size()))
-	n3, err := m.ReplicaAddrs.MarshalTo(dAtA[i:])
+	n6, err := m.ReplicaAddrs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n6
 	if len(m.Zone) > 0 {
 		dAtA[i] = 0x22
 		i++
@@ -1316,11 +1768,11 @@ func (m *Node) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintMeta(dAtA, i, uint64(m.ReplicaAddrs.Size()))
-	n4, err := m.ReplicaAddrs.MarshalTo(dAtA[i:])
+	n7, err := m.ReplicaAddrs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n7
 	return i, nil
 }
 
@@ -1431,11 +1883,11 @@ func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMeta(dAtA, i, uint64(m.Error.Size()))
-	n5, err := m.Error.MarshalTo(dAtA[i:])
+	n8, err := m.Error.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n8
 	return i, nil
 }
 
@@ -1473,11 +1925,11 @@ func (m *NotLeader) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMeta(dAtA, i, uint64(m.Epoch.Size()))
-	n6, err := m.Epoch.MarshalTo(dAtA[i:])
+	n9, err := m.Epoch.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n9
 	return i, nil
 }
 
@@ -1555,6 +2007,90 @@ func (m *MsgTooLarge) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *QuotaExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaExceeded) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.PartitionID))
+	}
+	if m.SpaceID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.SpaceID))
+	}
+	return i, nil
+}
+
+func (m *PartitionReadOnly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionReadOnly) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.PartitionID))
+	}
+	if m.NodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.NodeID))
+	}
+	return i, nil
+}
+
+func (m *PartitionDraining) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionDraining) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.PartitionID))
+	}
+	if m.NodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.NodeID))
+	}
+	return i, nil
+}
+
 func (m *TimeoutError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1616,41 +2152,71 @@ func (m *Error) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMeta(dAtA, i, uint64(m.NotLeader.Size()))
-		n7, err := m.NotLeader.MarshalTo(dAtA[i:])
+		n10, err := m.NotLeader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n10
 	}
 	if m.NoLeader != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMeta(dAtA, i, uint64(m.NoLeader.Size()))
-		n8, err := m.NoLeader.MarshalTo(dAtA[i:])
+		n11, err := m.NoLeader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n11
 	}
 	if m.PartitionNotFound != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMeta(dAtA, i, uint64(m.PartitionNotFound.Size()))
-		n9, err := m.PartitionNotFound.MarshalTo(dAtA[i:])
+		n12, err := m.PartitionNotFound.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n12
 	}
 	if m.MsgTooLarge != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMeta(dAtA, i, uint64(m.MsgTooLarge.Size()))
-		n10, err := m.MsgTooLarge.MarshalTo(dAtA[i:])
+		n13, err := m.MsgTooLarge.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n13
+	}
+	if m.QuotaExceeded != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.QuotaExceeded.Size()))
+		n14, err := m.QuotaExceeded.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.PartitionReadOnly != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.PartitionReadOnly.Size()))
+		n15, err := m.PartitionReadOnly.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.PartitionDraining != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.PartitionDraining.Size()))
+		n16, err := m.PartitionDraining.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
 	}
 	return i, nil
 }
@@ -1688,6 +2254,19 @@ func NewPopulatedDB(r randyMeta, easy bool) *DB {
 	this := &DB{}
 	this.ID = DBID(r.Uint32())
 	this.Name = string(randStringMeta(r))
+	if r.Intn(10) != 0 {
+		this.Quota = NewPopulatedQuota(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedQuota(r randyMeta, easy bool) *Quota {
+	this := &Quota{}
+	this.StorageBytes = uint64(uint64(r.Uint32()))
+	this.DocCount = uint64(uint64(r.Uint32()))
+	this.WriteQps = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1708,12 +2287,44 @@ func NewPopulatedSpace(r randyMeta, easy bool) *Space {
 	this.DB = DBID(r.Uint32())
 	this.DbName = string(randStringMeta(r))
 	this.Name = string(randStringMeta(r))
-	this.Type = SpaceType([]int32{0, 1, 2}[r.Intn(3)])
+	this.Type = SpaceType([]int32{0, 1, 2, 3}[r.Intn(4)])
 	this.Status = SpaceStatus([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
 	if r.Intn(10) != 0 {
 		this.KeyPolicy = NewPopulatedKeyPolicy(r, easy)
 	}
 	this.Schema = string(randStringMeta(r))
+	if r.Intn(10) != 0 {
+		this.ReplicaPolicy = NewPopulatedReplicaPolicy(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Quota = NewPopulatedQuota(r, easy)
+	}
+	this.QuotaExceeded = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReplicaPolicy(r randyMeta, easy bool) *ReplicaPolicy {
+	this := &ReplicaPolicy{}
+	this.ReplicaNum = uint32(r.Uint32())
+	this.OnePerZone = bool(bool(r.Intn(2) == 0))
+	this.MinZones = uint32(r.Uint32())
+	this.LeaderZone = string(randStringMeta(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDictionary(r randyMeta, easy bool) *Dictionary {
+	this := &Dictionary{}
+	this.Name = string(randStringMeta(r))
+	this.Version = uint64(uint64(r.Uint32()))
+	v1 := r.Intn(10)
+	this.Words = make([]string, v1)
+	for i := 0; i < v1; i++ {
+		this.Words[i] = string(randStringMeta(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1736,16 +2347,16 @@ func NewPopulatedPartition(r randyMeta, easy bool) *Partition {
 	this.StartSlot = SlotID(r.Uint32())
 	this.EndSlot = SlotID(r.Uint32())
 	if r.Intn(10) != 0 {
-		v1 := r.Intn(5)
-		this.Replicas = make([]Replica, v1)
-		for i := 0; i < v1; i++ {
-			v2 := NewPopulatedReplica(r, easy)
-			this.Replicas[i] = *v2
+		v2 := r.Intn(5)
+		this.Replicas = make([]Replica, v2)
+		for i := 0; i < v2; i++ {
+			v3 := NewPopulatedReplica(r, easy)
+			this.Replicas[i] = *v3
 		}
 	}
 	this.Status = PartitionStatus([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	v3 := NewPopulatedPartitionEpoch(r, easy)
-	this.Epoch = *v3
+	v4 := NewPopulatedPartitionEpoch(r, easy)
+	this.Epoch = *v4
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1755,8 +2366,8 @@ func NewPopulatedReplica(r randyMeta, easy bool) *Replica {
 	this := &Replica{}
 	this.ID = ReplicaID(uint64(r.Uint32()))
 	this.NodeID = NodeID(r.Uint32())
-	v4 := NewPopulatedReplicaAddrs(r, easy)
-	this.ReplicaAddrs = *v4
+	v5 := NewPopulatedReplicaAddrs(r, easy)
+	this.ReplicaAddrs = *v5
 	this.Zone = string(randStringMeta(r))
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1769,8 +2380,8 @@ func NewPopulatedNode(r randyMeta, easy bool) *Node {
 	this.Ip = string(randStringMeta(r))
 	this.Zone = string(randStringMeta(r))
 	this.Version = uint32(r.Uint32())
-	v5 := NewPopulatedReplicaAddrs(r, easy)
-	this.ReplicaAddrs = *v5
+	v6 := NewPopulatedReplicaAddrs(r, easy)
+	this.ReplicaAddrs = *v6
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1801,8 +2412,8 @@ func NewPopulatedResponseHeader(r randyMeta, easy bool) *ResponseHeader {
 	this.ReqId = string(randStringMeta(r))
 	this.Code = RespCode(r.Uint32())
 	this.Message = string(randStringMeta(r))
-	v6 := NewPopulatedError(r, easy)
-	this.Error = *v6
+	v7 := NewPopulatedError(r, easy)
+	this.Error = *v7
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1813,8 +2424,8 @@ func NewPopulatedNotLeader(r randyMeta, easy bool) *NotLeader {
 	this.PartitionID = PartitionID(r.Uint32())
 	this.Leader = NodeID(r.Uint32())
 	this.LeaderAddr = string(randStringMeta(r))
-	v7 := NewPopulatedPartitionEpoch(r, easy)
-	this.Epoch = *v7
+	v8 := NewPopulatedPartitionEpoch(r, easy)
+	this.Epoch = *v8
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1845,6 +2456,33 @@ func NewPopulatedMsgTooLarge(r randyMeta, easy bool) *MsgTooLarge {
 	return this
 }
 
+func NewPopulatedQuotaExceeded(r randyMeta, easy bool) *QuotaExceeded {
+	this := &QuotaExceeded{}
+	this.PartitionID = PartitionID(r.Uint32())
+	this.SpaceID = SpaceID(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPartitionReadOnly(r randyMeta, easy bool) *PartitionReadOnly {
+	this := &PartitionReadOnly{}
+	this.PartitionID = PartitionID(r.Uint32())
+	this.NodeID = NodeID(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPartitionDraining(r randyMeta, easy bool) *PartitionDraining {
+	this := &PartitionDraining{}
+	this.PartitionID = PartitionID(r.Uint32())
+	this.NodeID = NodeID(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedTimeoutError(r randyMeta, easy bool) *TimeoutError {
 	this := &TimeoutError{}
 	if !easy && r.Intn(10) != 0 {
@@ -1862,7 +2500,7 @@ func NewPopulatedServerError(r randyMeta, easy bool) *ServerError {
 
 func NewPopulatedError(r randyMeta, easy bool) *Error {
 	this := &Error{}
-	fieldNum := r.Intn(4)
+	fieldNum := r.Intn(7)
 	switch fieldNum {
 	case 0:
 		this.NotLeader = NewPopulatedNotLeader(r, easy)
@@ -1872,6 +2510,12 @@ func NewPopulatedError(r randyMeta, easy bool) *Error {
 		this.PartitionNotFound = NewPopulatedPartitionNotFound(r, easy)
 	case 3:
 		this.MsgTooLarge = NewPopulatedMsgTooLarge(r, easy)
+	case 4:
+		this.QuotaExceeded = NewPopulatedQuotaExceeded(r, easy)
+	case 5:
+		this.PartitionReadOnly = NewPopulatedPartitionReadOnly(r, easy)
+	case 6:
+		this.PartitionDraining = NewPopulatedPartitionDraining(r, easy)
 	}
 	return this
 }
@@ -1895,9 +2539,9 @@ func randUTF8RuneMeta(r randyMeta) rune {
 	return rune(ru + 61)
 }
 func randStringMeta(r randyMeta) string {
-	v8 := r.Intn(100)
-	tmps := make([]rune, v8)
-	for i := 0; i < v8; i++ {
+	v9 := r.Intn(100)
+	tmps := make([]rune, v9)
+	for i := 0; i < v9; i++ {
 		tmps[i] = randUTF8RuneMeta(r)
 	}
 	return string(tmps)
@@ -1919,11 +2563,11 @@ func randFieldMeta(dAtA []byte, r randyMeta, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMeta(dAtA, uint64(key))
-		v9 := r.Int63()
+		v10 := r.Int63()
 		if r.Intn(2) == 0 {
-			v9 *= -1
+			v10 *= -1
 		}
-		dAtA = encodeVarintPopulateMeta(dAtA, uint64(v9))
+		dAtA = encodeVarintPopulateMeta(dAtA, uint64(v10))
 	case 1:
 		dAtA = encodeVarintPopulateMeta(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1994,6 +2638,25 @@ func (m *DB) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMeta(uint64(l))
 	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	return n
+}
+
+func (m *Quota) Size() (n int) {
+	var l int
+	_ = l
+	if m.StorageBytes != 0 {
+		n += 1 + sovMeta(uint64(m.StorageBytes))
+	}
+	if m.DocCount != 0 {
+		n += 1 + sovMeta(uint64(m.DocCount))
+	}
+	if m.WriteQps != 0 {
+		n += 1 + sovMeta(uint64(m.WriteQps))
+	}
 	return n
 }
 
@@ -2042,6 +2705,55 @@ func (m *Space) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMeta(uint64(l))
 	}
+	if m.ReplicaPolicy != nil {
+		l = m.ReplicaPolicy.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.QuotaExceeded {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReplicaPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.ReplicaNum != 0 {
+		n += 1 + sovMeta(uint64(m.ReplicaNum))
+	}
+	if m.OnePerZone {
+		n += 2
+	}
+	if m.MinZones != 0 {
+		n += 1 + sovMeta(uint64(m.MinZones))
+	}
+	l = len(m.LeaderZone)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	return n
+}
+
+func (m *Dictionary) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMeta(uint64(m.Version))
+	}
+	if len(m.Words) > 0 {
+		for _, s := range m.Words {
+			l = len(s)
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2232,7 +2944,43 @@ func (m *MsgTooLarge) Size() (n int) {
 	return n
 }
 
-func (m *TimeoutError) Size() (n int) {
+func (m *QuotaExceeded) Size() (n int) {
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		n += 1 + sovMeta(uint64(m.PartitionID))
+	}
+	if m.SpaceID != 0 {
+		n += 1 + sovMeta(uint64(m.SpaceID))
+	}
+	return n
+}
+
+func (m *PartitionReadOnly) Size() (n int) {
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		n += 1 + sovMeta(uint64(m.PartitionID))
+	}
+	if m.NodeID != 0 {
+		n += 1 + sovMeta(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *PartitionDraining) Size() (n int) {
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		n += 1 + sovMeta(uint64(m.PartitionID))
+	}
+	if m.NodeID != 0 {
+		n += 1 + sovMeta(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *TimeoutError) Size() (n int) {
 	var l int
 	_ = l
 	return n
@@ -2267,6 +3015,18 @@ func (m *Error) Size() (n int) {
 		l = m.MsgTooLarge.Size()
 		n += 1 + l + sovMeta(uint64(l))
 	}
+	if m.QuotaExceeded != nil {
+		l = m.QuotaExceeded.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.PartitionReadOnly != nil {
+		l = m.PartitionReadOnly.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.PartitionDraining != nil {
+		l = m.PartitionDraining.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
 	return n
 }
 
@@ -2314,6 +3074,19 @@ func (this *DB) String() string {
 	s := strings.Join([]string{`&DB{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Quota:` + strings.Replace(fmt.Sprintf("%v", this.Quota), "Quota", "Quota", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Quota) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Quota{`,
+		`StorageBytes:` + fmt.Sprintf("%v", this.StorageBytes) + `,`,
+		`DocCount:` + fmt.Sprintf("%v", this.DocCount) + `,`,
+		`WriteQps:` + fmt.Sprintf("%v", this.WriteQps) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2342,6 +3115,34 @@ func (this *Space) String() string {
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`KeyPolicy:` + strings.Replace(fmt.Sprintf("%v", this.KeyPolicy), "KeyPolicy", "KeyPolicy", 1) + `,`,
 		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`ReplicaPolicy:` + strings.Replace(fmt.Sprintf("%v", this.ReplicaPolicy), "ReplicaPolicy", "ReplicaPolicy", 1) + `,`,
+		`Quota:` + strings.Replace(fmt.Sprintf("%v", this.Quota), "Quota", "Quota", 1) + `,`,
+		`QuotaExceeded:` + fmt.Sprintf("%v", this.QuotaExceeded) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicaPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicaPolicy{`,
+		`ReplicaNum:` + fmt.Sprintf("%v", this.ReplicaNum) + `,`,
+		`OnePerZone:` + fmt.Sprintf("%v", this.OnePerZone) + `,`,
+		`MinZones:` + fmt.Sprintf("%v", this.MinZones) + `,`,
+		`LeaderZone:` + fmt.Sprintf("%v", this.LeaderZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Dictionary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Dictionary{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Words:` + fmt.Sprintf("%v", this.Words) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2482,6 +3283,39 @@ func (this *MsgTooLarge) String() string {
 	}, "")
 	return s
 }
+func (this *QuotaExceeded) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaExceeded{`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`SpaceID:` + fmt.Sprintf("%v", this.SpaceID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PartitionReadOnly) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PartitionReadOnly{`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PartitionDraining) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PartitionDraining{`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TimeoutError) String() string {
 	if this == nil {
 		return "nil"
@@ -2510,6 +3344,9 @@ func (this *Error) String() string {
 		`NoLeader:` + strings.Replace(fmt.Sprintf("%v", this.NoLeader), "NoLeader", "NoLeader", 1) + `,`,
 		`PartitionNotFound:` + strings.Replace(fmt.Sprintf("%v", this.PartitionNotFound), "PartitionNotFound", "PartitionNotFound", 1) + `,`,
 		`MsgTooLarge:` + strings.Replace(fmt.Sprintf("%v", this.MsgTooLarge), "MsgTooLarge", "MsgTooLarge", 1) + `,`,
+		`QuotaExceeded:` + strings.Replace(fmt.Sprintf("%v", this.QuotaExceeded), "QuotaExceeded", "QuotaExceeded", 1) + `,`,
+		`PartitionReadOnly:` + strings.Replace(fmt.Sprintf("%v", this.PartitionReadOnly), "PartitionReadOnly", "PartitionReadOnly", 1) + `,`,
+		`PartitionDraining:` + strings.Replace(fmt.Sprintf("%v", this.PartitionDraining), "PartitionDraining", "PartitionDraining", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2535,6 +3372,15 @@ func (this *Error) GetValue() interface{} {
 	if this.MsgTooLarge != nil {
 		return this.MsgTooLarge
 	}
+	if this.QuotaExceeded != nil {
+		return this.QuotaExceeded
+	}
+	if this.PartitionReadOnly != nil {
+		return this.PartitionReadOnly
+	}
+	if this.PartitionDraining != nil {
+		return this.PartitionDraining
+	}
 	return nil
 }
 
@@ -2548,6 +3394,12 @@ func (this *Error) SetValue(value interface{}) bool {
 		this.PartitionNotFound = vt
 	case *MsgTooLarge:
 		this.MsgTooLarge = vt
+	case *QuotaExceeded:
+		this.QuotaExceeded = vt
+	case *PartitionReadOnly:
+		this.PartitionReadOnly = vt
+	case *PartitionDraining:
+		this.PartitionDraining = vt
 	default:
 		return false
 	}
@@ -2904,6 +3756,146 @@ func (m *DB) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageBytes", wireType)
+			}
+			m.StorageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocCount", wireType)
+			}
+			m.DocCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteQps", wireType)
+			}
+			m.WriteQps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteQps |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -3258,39 +4250,389 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 			}
 			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMeta(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaPolicy", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PartitionEpoch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMeta
+			if m.ReplicaPolicy == nil {
+				m.ReplicaPolicy = &ReplicaPolicy{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.ReplicaPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuotaExceeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicaPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicaPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicaPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaNum", wireType)
+			}
+			m.ReplicaNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaNum |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnePerZone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnePerZone = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinZones", wireType)
+			}
+			m.MinZones = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinZones |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaderZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dictionary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dictionary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dictionary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Words = append(m.Words, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -3367,7 +4709,232 @@ func (m *PartitionEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Partition) Unmarshal(dAtA []byte) error {
+func (m *Partition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Partition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Partition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			}
+			m.DB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DB |= (DBID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			}
+			m.Space = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Space |= (SpaceID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= (SlotID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= (SlotID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, Replica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (PartitionStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,10 +4957,10 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Partition: wiretype end group for non-group")
+			return fmt.Errorf("proto: Replica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Partition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Replica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3410,16 +4977,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (PartitionID(b) & 0x7F) << shift
+				m.ID |= (ReplicaID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.DB = 0
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3429,16 +4996,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DB |= (DBID(b) & 0x7F) << shift
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaAddrs", wireType)
 			}
-			m.Space = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3448,16 +5015,27 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Space |= (SpaceID(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReplicaAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
-			m.StartSlot = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3467,16 +5045,76 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartSlot |= (SlotID(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Node) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.EndSlot = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3486,16 +5124,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndSlot |= (SlotID(b) & 0x7F) << shift
+				m.ID |= (NodeID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3505,28 +5143,55 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, Replica{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Status = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3536,14 +5201,14 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (PartitionStatus(b) & 0x7F) << shift
+				m.Version |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaAddrs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3567,7 +5232,7 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReplicaAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3592,7 +5257,7 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replica) Unmarshal(dAtA []byte) error {
+func (m *ReplicaAddrs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3615,17 +5280,17 @@ func (m *Replica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Replica: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicaAddrs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Replica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicaAddrs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatAddr", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3635,16 +5300,26 @@ func (m *Replica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (ReplicaID(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeartbeatAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicateAddr", wireType)
 			}
-			m.NodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3654,16 +5329,26 @@ func (m *Replica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= (NodeID(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicateAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaAddrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3673,25 +5358,24 @@ func (m *Replica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReplicaAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RpcAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3716,7 +5400,7 @@ func (m *Replica) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zone = string(dAtA[iNdEx:postIndex])
+			m.AdminAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3739,7 +5423,7 @@ func (m *Replica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Node) Unmarshal(dAtA []byte) error {
+func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3762,34 +5446,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= (NodeID(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			return fmt.Errorf("proto: RequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3814,11 +5479,11 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ip = string(dAtA[iNdEx:postIndex])
+			m.ReqId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3843,56 +5508,7 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zone = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaAddrs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReplicaAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3915,7 +5531,7 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicaAddrs) Unmarshal(dAtA []byte) error {
+func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3938,15 +5554,15 @@ func (m *ReplicaAddrs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicaAddrs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicaAddrs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3971,13 +5587,13 @@ func (m *ReplicaAddrs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HeartbeatAddr = string(dAtA[iNdEx:postIndex])
+			m.ReqId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicateAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3987,24 +5603,14 @@ func (m *ReplicaAddrs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Code |= (RespCode(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicateAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4029,13 +5635,13 @@ func (m *ReplicaAddrs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RpcAddr = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4045,20 +5651,21 @@ func (m *ReplicaAddrs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4081,7 +5688,7 @@ func (m *ReplicaAddrs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestHeader) Unmarshal(dAtA []byte) error {
+func (m *NotLeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4104,15 +5711,53 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotLeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotLeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			m.Leader = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Leader |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4137,13 +5782,13 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReqId = string(dAtA[iNdEx:postIndex])
+			m.LeaderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4153,20 +5798,21 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = string(dAtA[iNdEx:postIndex])
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4189,7 +5835,7 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
+func (m *NoLeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4212,17 +5858,17 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: NoLeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NoLeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
 			}
-			var stringLen uint64
+			m.PartitionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4232,74 +5878,66 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.PartitionID |= (PartitionID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReqId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= (RespCode(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionNotFound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionNotFound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionNotFound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4309,22 +5947,11 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.PartitionID |= (PartitionID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -4346,7 +5973,7 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotLeader) Unmarshal(dAtA []byte) error {
+func (m *MsgTooLarge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4369,10 +5996,10 @@ func (m *NotLeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NotLeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTooLarge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NotLeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTooLarge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4396,57 +6023,9 @@ func (m *NotLeader) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
-			}
-			m.Leader = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Leader |= (NodeID(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LeaderAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
 			}
-			var msglen int
+			m.MsgSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4456,22 +6035,11 @@ func (m *NotLeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MsgSize |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -4493,7 +6061,7 @@ func (m *NotLeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoLeader) Unmarshal(dAtA []byte) error {
+func (m *QuotaExceeded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4516,10 +6084,10 @@ func (m *NoLeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoLeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaExceeded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoLeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4541,6 +6109,25 @@ func (m *NoLeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceID", wireType)
+			}
+			m.SpaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceID |= (SpaceID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -4562,7 +6149,7 @@ func (m *NoLeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionNotFound) Unmarshal(dAtA []byte) error {
+func (m *PartitionReadOnly) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4585,10 +6172,10 @@ func (m *PartitionNotFound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionNotFound: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionReadOnly: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionNotFound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionReadOnly: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4610,6 +6197,25 @@ func (m *PartitionNotFound) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -4631,7 +6237,7 @@ func (m *PartitionNotFound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTooLarge) Unmarshal(dAtA []byte) error {
+func (m *PartitionDraining) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4654,10 +6260,10 @@ func (m *MsgTooLarge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTooLarge: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionDraining: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTooLarge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionDraining: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4681,9 +6287,9 @@ func (m *MsgTooLarge) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.MsgSize = 0
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4693,7 +6299,7 @@ func (m *MsgTooLarge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgSize |= (uint64(b) & 0x7F) << shift
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5009,6 +6615,105 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaExceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuotaExceeded == nil {
+				m.QuotaExceeded = &QuotaExceeded{}
+			}
+			if err := m.QuotaExceeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionReadOnly", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionReadOnly == nil {
+				m.PartitionReadOnly = &PartitionReadOnly{}
+			}
+			if err := m.PartitionReadOnly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDraining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionDraining == nil {
+				m.PartitionDraining = &PartitionDraining{}
+			}
+			if err := m.PartitionDraining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -5138,89 +6843,110 @@ var (
 func init() { proto.RegisterFile("meta.proto", fileDescriptorMeta) }
 
 var fileDescriptorMeta = []byte{
-	// 1339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x8f, 0xdb, 0xc4,
-	0x17, 0x8f, 0xb3, 0xce, 0x0f, 0x3f, 0x27, 0xa9, 0x3b, 0x6d, 0xbf, 0xdd, 0xf6, 0x2b, 0x9c, 0xc5,
-	0xa5, 0x68, 0xbb, 0x40, 0x5a, 0x2d, 0x12, 0x42, 0x15, 0x42, 0x6c, 0x9a, 0xb4, 0x8d, 0xd8, 0xa6,
-	0x2b, 0x27, 0x2a, 0xb4, 0x17, 0xcb, 0xb1, 0x67, 0xb3, 0xd6, 0x26, 0x1e, 0xd7, 0x9e, 0x54, 0xda,
-	0x9e, 0x7a, 0x83, 0xbf, 0x00, 0x71, 0x44, 0x82, 0x03, 0x27, 0xce, 0x1c, 0x39, 0xae, 0x38, 0xf5,
-	0x84, 0x38, 0x45, 0xdd, 0x70, 0xe4, 0xc2, 0x11, 0xed, 0x09, 0xcd, 0x0f, 0xcf, 0xa6, 0x5b, 0x09,
-	0x15, 0xa9, 0xa7, 0xcc, 0xe7, 0xbd, 0x37, 0x6f, 0x3e, 0xf3, 0x79, 0x2f, 0xcf, 0x03, 0x30, 0xc5,
-	0xd4, 0x6f, 0x25, 0x29, 0xa1, 0xe4, 0xf2, 0x07, 0xe3, 0x88, 0xee, 0xcd, 0x46, 0xad, 0x80, 0x4c,
-	0xaf, 0x8f, 0xc9, 0x98, 0x5c, 0xe7, 0xe6, 0xd1, 0x6c, 0x97, 0x23, 0x0e, 0xf8, 0x4a, 0x84, 0x3b,
-	0x5f, 0x82, 0xfe, 0x88, 0xc4, 0x18, 0x21, 0xd0, 0x63, 0x7f, 0x8a, 0x57, 0xb5, 0x35, 0x6d, 0xdd,
-	0x70, 0xf9, 0x1a, 0xbd, 0x0d, 0xb5, 0x0c, 0xa7, 0x4f, 0x70, 0xea, 0xf9, 0x61, 0x98, 0x66, 0xab,
-	0x45, 0xee, 0x33, 0x85, 0x6d, 0x8b, 0x99, 0xd0, 0x25, 0xa8, 0xa6, 0x84, 0x50, 0x2f, 0x8c, 0xd2,
-	0xd5, 0x15, 0xee, 0xae, 0x30, 0xdc, 0x89, 0x52, 0xe7, 0x36, 0xe8, 0x43, 0x3f, 0xdb, 0x47, 0x0d,
-	0x28, 0x46, 0xa1, 0xcc, 0x5b, 0x8c, 0x42, 0x76, 0x12, 0x3d, 0x48, 0xb0, 0xcc, 0xc6, 0xd7, 0xe8,
-	0x32, 0x54, 0x03, 0x12, 0x53, 0x1c, 0xd3, 0x4c, 0xa6, 0x51, 0xd8, 0xf9, 0x18, 0x8a, 0x9d, 0x36,
-	0xb2, 0x55, 0x96, 0x7a, 0xbb, 0xb1, 0x98, 0x37, 0x8b, 0xbd, 0xce, 0xf1, 0xbc, 0xa9, 0x77, 0xda,
-	0xbd, 0x4e, 0x9e, 0x95, 0xf3, 0x2f, 0x9e, 0xf0, 0x77, 0x6e, 0x81, 0xf1, 0x39, 0x3e, 0xd8, 0x21,
-	0x93, 0x28, 0x38, 0x40, 0xff, 0x07, 0x63, 0x1f, 0x1f, 0x78, 0xbb, 0x11, 0x9e, 0xe4, 0x6c, 0xaa,
-	0xfb, 0xf8, 0xe0, 0x36, 0xc3, 0xec, 0x1a, 0xdc, 0x39, 0x8b, 0x03, 0x99, 0xa1, 0xc2, 0x7c, 0xb3,
-	0x38, 0x70, 0x9e, 0x15, 0xa1, 0x34, 0x48, 0xfc, 0x80, 0xc9, 0x71, 0x42, 0xe1, 0xac, 0xa2, 0x50,
-	0xe1, 0x4e, 0xc9, 0xc2, 0x86, 0x62, 0x38, 0xe2, 0x19, 0x24, 0xcb, 0x4e, 0xfb, 0x84, 0x65, 0x38,
-	0x42, 0x17, 0xa1, 0x12, 0x8e, 0x3c, 0x4e, 0x54, 0x5c, 0xb3, 0x1c, 0x8e, 0xfa, 0x4c, 0xea, 0x9c,
-	0xbe, 0xbe, 0x24, 0xbf, 0x2d, 0x85, 0x2a, 0xad, 0x69, 0xeb, 0x8d, 0x4d, 0x68, 0xf1, 0x83, 0x86,
-	0x07, 0x09, 0x96, 0xa2, 0xbd, 0x03, 0xe5, 0x8c, 0xfa, 0x74, 0x96, 0xad, 0x96, 0x79, 0x44, 0x4d,
-	0x44, 0x0c, 0xb8, 0xcd, 0x95, 0x3e, 0x74, 0x0d, 0x80, 0x5d, 0x2d, 0xe1, 0x2a, 0xac, 0x56, 0xd6,
-	0xb4, 0x75, 0x73, 0x13, 0x5a, 0x4a, 0x17, 0x97, 0xa9, 0x22, 0x25, 0xfa, 0x1f, 0x94, 0xb3, 0x60,
-	0x0f, 0x4f, 0xfd, 0xd5, 0xaa, 0x20, 0x27, 0x90, 0x73, 0x0f, 0x1a, 0x3b, 0x7e, 0x4a, 0x23, 0x1a,
-	0x91, 0xb8, 0x9b, 0x90, 0x60, 0x8f, 0x75, 0x46, 0x40, 0xe2, 0x5d, 0xef, 0x09, 0x4e, 0xb3, 0x88,
-	0xc4, 0x5c, 0x14, 0xdd, 0x35, 0x99, 0xed, 0x81, 0x30, 0xa1, 0x55, 0xa8, 0xe4, 0xde, 0x22, 0xf7,
-	0xe6, 0xd0, 0xf9, 0xb3, 0x08, 0x86, 0xca, 0x87, 0xae, 0x2e, 0xa9, 0x7a, 0x41, 0xa9, 0x6a, 0xaa,
-	0x80, 0xd7, 0x54, 0x76, 0x03, 0x4a, 0x19, 0xbb, 0x3d, 0xd7, 0xb5, 0xde, 0x3e, 0xbf, 0x98, 0x37,
-	0x45, 0xd9, 0x96, 0x4b, 0x24, 0x42, 0xd0, 0x47, 0x00, 0x19, 0xf5, 0x53, 0xea, 0x65, 0x13, 0x42,
-	0xb9, 0xe4, 0xf5, 0xf6, 0xc5, 0xc5, 0xbc, 0x69, 0x0c, 0x98, 0x75, 0x30, 0x21, 0xf4, 0x78, 0xde,
-	0x2c, 0xb3, 0xdf, 0x5e, 0xc7, 0x35, 0xb2, 0xdc, 0x88, 0x6e, 0x40, 0x15, 0xc7, 0xa1, 0xd8, 0x55,
-	0x52, 0x84, 0x2b, 0xdd, 0x38, 0x3c, 0xb5, 0xa7, 0x82, 0x85, 0x09, 0x6d, 0x40, 0x35, 0xc5, 0xc9,
-	0x24, 0x0a, 0x7c, 0x56, 0xa4, 0x95, 0x75, 0x73, 0xb3, 0xda, 0x72, 0x85, 0xa1, 0xad, 0x1f, 0xce,
-	0x9b, 0x05, 0x57, 0xf9, 0xd1, 0xba, 0x2a, 0x67, 0x85, 0x97, 0xd3, 0x6a, 0x29, 0x0d, 0x4e, 0x95,
-	0xf4, 0x3d, 0x28, 0x61, 0x56, 0x06, 0x5e, 0x26, 0x73, 0xf3, 0x4c, 0xeb, 0xe5, 0xea, 0xc8, 0xcc,
-	0x22, 0xc6, 0xf9, 0x49, 0x83, 0x8a, 0x3c, 0x12, 0x5d, 0x51, 0x5a, 0xeb, 0xed, 0x73, 0x4a, 0x6b,
-	0x43, 0xba, 0xa5, 0xd2, 0xef, 0x43, 0x39, 0x26, 0x21, 0xee, 0x75, 0xa4, 0xda, 0x4c, 0xca, 0x72,
-	0x9f, 0x5b, 0x8e, 0xd5, 0xca, 0x95, 0x31, 0xe8, 0x13, 0xa8, 0xcb, 0x1b, 0xc8, 0x21, 0xb1, 0xc2,
-	0x39, 0xd5, 0xf3, 0x6b, 0xf2, 0x31, 0xd1, 0xae, 0x32, 0x46, 0xcf, 0xe7, 0x4d, 0xcd, 0xad, 0xa5,
-	0x4b, 0x76, 0xd6, 0xf6, 0x4f, 0x49, 0xac, 0xda, 0x9e, 0xad, 0x9d, 0x1f, 0x34, 0xd0, 0xd9, 0x21,
-	0x68, 0x6d, 0xa9, 0x33, 0x2c, 0xc5, 0x36, 0x27, 0xc0, 0xa8, 0xb2, 0xd1, 0x92, 0xc8, 0x3f, 0x6c,
-	0x31, 0x4a, 0x54, 0xba, 0x95, 0x93, 0x74, 0xcb, 0x7d, 0xc8, 0x2b, 0xad, 0xfa, 0xf0, 0x55, 0xea,
-	0xa5, 0xff, 0x40, 0xdd, 0xf9, 0x46, 0x83, 0xda, 0x72, 0x20, 0xba, 0x0a, 0x8d, 0x3d, 0xec, 0xa7,
-	0x74, 0x84, 0x7d, 0xca, 0x13, 0xca, 0x29, 0x53, 0x57, 0x56, 0x16, 0xc7, 0xc2, 0x64, 0x1e, 0x8a,
-	0x45, 0x98, 0xe0, 0x5f, 0x57, 0x56, 0x1e, 0xc6, 0x06, 0x6b, 0x12, 0x88, 0x80, 0x7c, 0xb0, 0x26,
-	0x01, 0x77, 0xbd, 0x05, 0xe0, 0x87, 0xd3, 0x28, 0x16, 0x4e, 0x21, 0x9d, 0xc1, 0x2d, 0xcc, 0xed,
-	0x7c, 0x06, 0x75, 0x17, 0x3f, 0x9e, 0xe1, 0x8c, 0xde, 0xc5, 0x7e, 0x88, 0x53, 0x74, 0x01, 0xca,
-	0x29, 0x7e, 0xec, 0xa9, 0x21, 0x5c, 0x4a, 0xf1, 0xe3, 0x5e, 0xc8, 0x84, 0xa1, 0xd1, 0x14, 0x93,
-	0x19, 0xcd, 0x47, 0x9e, 0x84, 0xce, 0x57, 0x1a, 0x34, 0x5c, 0x9c, 0x25, 0x24, 0xce, 0xf0, 0xbf,
-	0xe7, 0x58, 0x03, 0x3d, 0x20, 0x21, 0x96, 0x9d, 0x52, 0x3b, 0x9e, 0x37, 0xab, 0x6c, 0xe3, 0x2d,
-	0x12, 0x62, 0x97, 0x7b, 0xd8, 0x29, 0x53, 0x9c, 0x65, 0xfe, 0x38, 0xaf, 0x4a, 0x0e, 0x91, 0x03,
-	0x25, 0x9c, 0xa6, 0x44, 0xdc, 0xc0, 0xdc, 0x2c, 0xb7, 0xba, 0x0c, 0xa9, 0xe6, 0x65, 0xc0, 0xf9,
-	0x55, 0x03, 0xa3, 0x4f, 0xe8, 0xb6, 0x20, 0xb1, 0x05, 0xb5, 0x24, 0xef, 0x74, 0x4f, 0xb5, 0x86,
-	0xbd, 0x78, 0x79, 0x5c, 0x9c, 0x9e, 0x1e, 0xa6, 0xda, 0xd3, 0xe3, 0xcd, 0x3d, 0xe1, 0xc9, 0x96,
-	0x9b, 0x5b, 0xa4, 0x5f, 0x6e, 0x6e, 0x11, 0x83, 0x9a, 0x60, 0x8a, 0xd5, 0x72, 0x1d, 0x40, 0x98,
-	0x78, 0x29, 0xd4, 0x3f, 0x51, 0x7f, 0x8d, 0x7f, 0xe2, 0x3d, 0xa8, 0xf6, 0xc9, 0x1b, 0xbb, 0x8a,
-	0xf3, 0x00, 0xce, 0x2a, 0x5f, 0x9f, 0xd0, 0xdb, 0x64, 0x16, 0x87, 0x6f, 0x22, 0xef, 0x3e, 0x98,
-	0xf7, 0xb2, 0xf1, 0x90, 0x90, 0x6d, 0x3f, 0x1d, 0xe3, 0x37, 0x21, 0xfa, 0x25, 0xa8, 0x4e, 0xb3,
-	0xb1, 0x97, 0x45, 0x4f, 0x71, 0xfe, 0x2d, 0x98, 0x66, 0xe3, 0x41, 0xf4, 0x14, 0x3b, 0x0d, 0xa8,
-	0x0d, 0x45, 0xd7, 0xf1, 0xea, 0x3b, 0x57, 0xc0, 0x1c, 0xf0, 0xe7, 0x05, 0x87, 0xe8, 0x3c, 0x94,
-	0x02, 0x7f, 0x96, 0xe5, 0xcf, 0x12, 0x01, 0x9c, 0xdf, 0x34, 0x28, 0x09, 0xff, 0x35, 0x80, 0x98,
-	0x50, 0x4f, 0x96, 0x54, 0x93, 0x1f, 0x37, 0xd5, 0x31, 0xae, 0x11, 0xab, 0xe6, 0x79, 0x17, 0x8c,
-	0x98, 0x78, 0x4b, 0xc5, 0x37, 0x37, 0x8d, 0x56, 0x5e, 0x0f, 0xb7, 0x1a, 0xe7, 0x95, 0x69, 0xc3,
-	0xb9, 0x93, 0xfb, 0xb2, 0xe4, 0xbb, 0x4c, 0x58, 0x39, 0xd6, 0x50, 0xeb, 0x15, 0xc9, 0xdd, 0xb3,
-	0xc9, 0x2b, 0x55, 0xb8, 0x01, 0x75, 0x76, 0x61, 0x4a, 0x88, 0x37, 0x61, 0x22, 0xca, 0xf6, 0xa8,
-	0xb5, 0x96, 0x84, 0x75, 0xcd, 0xe9, 0x09, 0xb8, 0xa9, 0x1f, 0x7e, 0xd7, 0xd4, 0x36, 0x12, 0x30,
-	0x97, 0x3e, 0xe1, 0xa8, 0x01, 0x30, 0x18, 0x78, 0xbd, 0xf8, 0x89, 0x3f, 0x89, 0x42, 0xab, 0x80,
-	0x4c, 0xa8, 0x70, 0x1c, 0x51, 0x4b, 0x93, 0xce, 0x9d, 0x14, 0x27, 0x7e, 0x8a, 0xad, 0xa2, 0xc4,
-	0xee, 0x2c, 0x8e, 0xa3, 0x78, 0x6c, 0xad, 0xa0, 0x3a, 0x18, 0x83, 0x81, 0xd7, 0xc1, 0x13, 0x4c,
-	0xb1, 0xa5, 0xa3, 0x33, 0x60, 0xe6, 0x90, 0xf9, 0x4b, 0x97, 0xf5, 0xaf, 0xbf, 0xb7, 0x0b, 0x1b,
-	0x37, 0xc1, 0x50, 0xcf, 0x0a, 0xbe, 0x65, 0xe8, 0x75, 0xfb, 0xc3, 0xde, 0xf0, 0xa1, 0x3c, 0x6e,
-	0xe8, 0x75, 0x3b, 0x77, 0xba, 0x96, 0x26, 0x41, 0x7b, 0xfb, 0x7e, 0xdb, 0x2a, 0xca, 0xbd, 0x13,
-	0x38, 0x73, 0xea, 0x0b, 0xc5, 0x48, 0xec, 0x6c, 0x79, 0xbd, 0xfe, 0x83, 0xad, 0xed, 0x5e, 0xc7,
-	0x2a, 0x48, 0xdc, 0xbf, 0x3f, 0x74, 0xbb, 0x5b, 0x1d, 0x4b, 0x63, 0x2c, 0x76, 0xb6, 0x3c, 0x06,
-	0xee, 0xf7, 0xb7, 0x1f, 0x5a, 0x45, 0x64, 0x41, 0x4d, 0x1a, 0xbe, 0x70, 0x7b, 0xc3, 0xae, 0xb5,
-	0x22, 0x2d, 0x83, 0x9d, 0xed, 0xde, 0x70, 0xd8, 0xeb, 0xdf, 0xb1, 0x74, 0x71, 0x5a, 0xfb, 0xd3,
-	0xc3, 0x23, 0xbb, 0xf0, 0xfb, 0x91, 0x5d, 0x78, 0x71, 0x64, 0x17, 0xfe, 0x3a, 0xb2, 0x0b, 0x7f,
-	0x1f, 0xd9, 0xda, 0xb3, 0x85, 0xad, 0xfd, 0xb8, 0xb0, 0xb5, 0x9f, 0x17, 0x76, 0xe1, 0x97, 0x85,
-	0x5d, 0x38, 0x5c, 0xd8, 0xda, 0xf3, 0x85, 0xad, 0xbd, 0x58, 0xd8, 0xda, 0xb7, 0x7f, 0xd8, 0x85,
-	0xbb, 0xda, 0xa3, 0x32, 0x7b, 0x1b, 0x27, 0xa3, 0x51, 0x99, 0xbf, 0x77, 0x3f, 0xfc, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x4b, 0xa2, 0xb7, 0x6e, 0x2c, 0x0b, 0x00, 0x00,
+	// 1670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x3d, 0x8c, 0xe3, 0xc6,
+	0x15, 0x16, 0x25, 0x4a, 0x22, 0x1f, 0x25, 0x1d, 0x6f, 0xfc, 0x27, 0x9f, 0x13, 0x6a, 0xc3, 0x8b,
+	0x83, 0xf5, 0x25, 0x96, 0x9d, 0x0d, 0x92, 0xc2, 0x48, 0x91, 0xd5, 0x49, 0x67, 0x0b, 0xd9, 0xd3,
+	0xe9, 0x28, 0xe1, 0x62, 0xbb, 0x21, 0x28, 0x72, 0x56, 0x4b, 0xac, 0xc4, 0xe1, 0x92, 0xa3, 0x73,
+	0x74, 0x55, 0x9a, 0x20, 0x29, 0x53, 0x04, 0xa9, 0x03, 0x24, 0x45, 0xaa, 0xd4, 0x29, 0x53, 0x2e,
+	0x52, 0xb9, 0x4c, 0x25, 0x78, 0xd5, 0xa6, 0x49, 0x19, 0x2c, 0x52, 0x04, 0xf3, 0x43, 0x8a, 0x2b,
+	0x3b, 0xc6, 0x05, 0x58, 0x20, 0x95, 0xe6, 0xfd, 0xcc, 0x37, 0x8f, 0xef, 0x7d, 0xef, 0xcd, 0x08,
+	0x60, 0x89, 0xa9, 0xd7, 0x8d, 0x13, 0x42, 0xc9, 0xbd, 0x77, 0xe7, 0x21, 0x3d, 0x5b, 0xcd, 0xba,
+	0x3e, 0x59, 0xbe, 0x37, 0x27, 0x73, 0xf2, 0x1e, 0x57, 0xcf, 0x56, 0xa7, 0x5c, 0xe2, 0x02, 0x5f,
+	0x09, 0x77, 0xfb, 0x63, 0x50, 0x3f, 0x25, 0x11, 0x46, 0x08, 0xd4, 0xc8, 0x5b, 0xe2, 0xb6, 0x72,
+	0xa0, 0x1c, 0xea, 0x0e, 0x5f, 0xa3, 0x6f, 0x41, 0x23, 0xc5, 0xc9, 0x73, 0x9c, 0xb8, 0x5e, 0x10,
+	0x24, 0x69, 0xbb, 0xcc, 0x6d, 0x86, 0xd0, 0x1d, 0x33, 0x15, 0x7a, 0x13, 0xb4, 0x84, 0x10, 0xea,
+	0x06, 0x61, 0xd2, 0xae, 0x70, 0x73, 0x9d, 0xc9, 0xfd, 0x30, 0xb1, 0x1f, 0x81, 0x3a, 0xf5, 0xd2,
+	0x73, 0xd4, 0x82, 0x72, 0x18, 0x48, 0xdc, 0x72, 0x18, 0xb0, 0x93, 0xe8, 0x3a, 0xc6, 0x12, 0x8d,
+	0xaf, 0xd1, 0x3d, 0xd0, 0x7c, 0x12, 0x51, 0x1c, 0xd1, 0x54, 0xc2, 0xe4, 0xb2, 0xfd, 0x0c, 0xca,
+	0xfd, 0x1e, 0xb2, 0x72, 0x94, 0x66, 0xaf, 0xb5, 0xdd, 0x74, 0xca, 0xc3, 0xfe, 0xf5, 0xa6, 0xa3,
+	0xf6, 0x7b, 0xc3, 0x7e, 0x86, 0xca, 0xe3, 0x2f, 0x17, 0xe2, 0xff, 0x06, 0x54, 0x2f, 0x56, 0x84,
+	0x7a, 0x1c, 0xd2, 0x38, 0xaa, 0x75, 0x9f, 0x32, 0xc9, 0x11, 0x4a, 0xfb, 0x14, 0xaa, 0x5c, 0x46,
+	0xf7, 0xa1, 0x99, 0x52, 0x92, 0x78, 0x73, 0xec, 0xce, 0xd6, 0x14, 0xa7, 0xfc, 0x14, 0xd5, 0x69,
+	0x48, 0x65, 0x8f, 0xe9, 0xd0, 0x5b, 0xa0, 0x07, 0xc4, 0x77, 0x7d, 0xb2, 0x8a, 0x28, 0x3f, 0x44,
+	0x75, 0xb4, 0x80, 0xf8, 0x0f, 0x99, 0xcc, 0x8c, 0x9f, 0x25, 0x21, 0xc5, 0xee, 0x45, 0x2c, 0xe2,
+	0x6f, 0x3a, 0x1a, 0x57, 0x3c, 0x8d, 0x53, 0xfb, 0x21, 0xe8, 0x3f, 0xc5, 0xeb, 0x31, 0x59, 0x84,
+	0xfe, 0x9a, 0x79, 0x9e, 0xe3, 0xb5, 0x7b, 0x1a, 0xe2, 0x45, 0x96, 0x13, 0xed, 0x1c, 0xaf, 0x1f,
+	0x31, 0x99, 0x25, 0x93, 0x1b, 0x57, 0x91, 0x2f, 0xbf, 0xa3, 0xce, 0x6c, 0xab, 0xc8, 0xb7, 0xff,
+	0x5d, 0x86, 0xea, 0x24, 0xf6, 0x7c, 0x56, 0x94, 0x5d, 0x22, 0xee, 0xe6, 0x89, 0xa8, 0x73, 0xa3,
+	0xcc, 0x85, 0x05, 0xe5, 0x60, 0xd6, 0x2e, 0xef, 0x72, 0xd5, 0xef, 0xed, 0x72, 0x15, 0xcc, 0xd0,
+	0x1b, 0x50, 0x0f, 0x66, 0x2e, 0x4f, 0x97, 0x48, 0x76, 0x2d, 0x98, 0x8d, 0x58, 0xc2, 0xb2, 0x24,
+	0xaa, 0x85, 0x24, 0x5a, 0xb2, 0x5c, 0xd5, 0x03, 0xe5, 0xb0, 0x75, 0x04, 0x5d, 0x7e, 0xd0, 0x74,
+	0x1d, 0x63, 0x59, 0xba, 0x6f, 0x43, 0x2d, 0xa5, 0x1e, 0x5d, 0xa5, 0xed, 0x1a, 0xf7, 0x68, 0x08,
+	0x8f, 0x09, 0xd7, 0x39, 0xd2, 0x86, 0xde, 0x01, 0x60, 0x9f, 0x16, 0xf3, 0x2c, 0xb4, 0xeb, 0xbc,
+	0x1e, 0xd0, 0xcd, 0xf3, 0xe2, 0xe8, 0xe7, 0xd9, 0x12, 0xbd, 0x0e, 0xb5, 0xd4, 0x3f, 0xc3, 0x4b,
+	0xaf, 0xad, 0x89, 0xe0, 0x84, 0x84, 0x7e, 0x08, 0xad, 0x04, 0xc7, 0x8b, 0xd0, 0xf7, 0x32, 0x18,
+	0x9d, 0xc3, 0xb4, 0xba, 0x8e, 0x50, 0x4b, 0xa8, 0x66, 0x52, 0x14, 0x77, 0x24, 0x80, 0xaf, 0x20,
+	0x01, 0x7a, 0x1b, 0x5a, 0x7c, 0xe1, 0xe2, 0x9f, 0xfb, 0x18, 0x07, 0x38, 0x68, 0x1b, 0x07, 0xca,
+	0xa1, 0xe6, 0x34, 0xb9, 0x76, 0x20, 0x95, 0xf6, 0x6f, 0x14, 0x68, 0xde, 0x38, 0x05, 0x75, 0xc0,
+	0xc8, 0xa2, 0x89, 0x56, 0x4b, 0x51, 0x0f, 0x07, 0xa4, 0x6a, 0xb4, 0x5a, 0xa2, 0x03, 0x68, 0x90,
+	0x08, 0xbb, 0x31, 0x4e, 0xdc, 0x17, 0x24, 0x12, 0xc4, 0xd4, 0x1c, 0x20, 0x11, 0x1e, 0xe3, 0x84,
+	0xb7, 0xdc, 0x5b, 0xa0, 0x2f, 0xc3, 0x88, 0x5b, 0x73, 0xd6, 0x2c, 0xc3, 0x88, 0xd9, 0x52, 0x86,
+	0xbf, 0xc0, 0x5e, 0x90, 0xed, 0x16, 0x15, 0x01, 0xa1, 0x62, 0x1e, 0xf6, 0x18, 0xa0, 0x1f, 0xfa,
+	0x34, 0x24, 0x91, 0x97, 0xac, 0xbf, 0xb2, 0x7d, 0xdb, 0x50, 0x7f, 0x8e, 0x93, 0x34, 0x24, 0x91,
+	0x24, 0x6c, 0x26, 0xa2, 0x57, 0xa1, 0xfa, 0x19, 0x49, 0x02, 0x76, 0x6a, 0xe5, 0x50, 0x77, 0x84,
+	0x60, 0x3f, 0x86, 0xd6, 0xd8, 0x4b, 0x68, 0xc8, 0x30, 0x07, 0x31, 0xf1, 0xcf, 0xd8, 0x00, 0xf0,
+	0x49, 0x74, 0xea, 0x66, 0x30, 0xa2, 0x31, 0x0c, 0xa6, 0x7b, 0x26, 0xa1, 0xfe, 0xeb, 0x21, 0xf6,
+	0x3f, 0xca, 0xa0, 0xe7, 0x78, 0xe8, 0xed, 0x02, 0x6d, 0x5f, 0xcb, 0x69, 0x6b, 0xe4, 0x0e, 0x2f,
+	0x49, 0xdd, 0x07, 0x50, 0x4d, 0x19, 0xbd, 0x44, 0xbe, 0x7a, 0xaf, 0x6e, 0x37, 0x1d, 0xd1, 0x17,
+	0xc5, 0x1e, 0x10, 0x2e, 0xe8, 0x47, 0x00, 0x29, 0xf5, 0x12, 0xea, 0xa6, 0x0b, 0x42, 0x79, 0x06,
+	0x9b, 0xbd, 0x37, 0xb6, 0x9b, 0x8e, 0x3e, 0x61, 0xda, 0xc9, 0x82, 0xd0, 0xeb, 0x4d, 0xa7, 0xc6,
+	0x7e, 0x87, 0x7d, 0x47, 0x4f, 0x33, 0x25, 0x7a, 0x1f, 0x34, 0x1c, 0x05, 0x62, 0x57, 0x35, 0x0f,
+	0xb8, 0x3e, 0x88, 0x82, 0xbd, 0x3d, 0x75, 0x2c, 0x54, 0xe8, 0x01, 0x68, 0xb2, 0xf2, 0xac, 0x0b,
+	0x2a, 0x87, 0xc6, 0x91, 0x96, 0x91, 0xb2, 0xa7, 0x5e, 0x6e, 0x3a, 0x25, 0x27, 0xb7, 0xa3, 0xc3,
+	0xbc, 0x5f, 0xea, 0xbc, 0x5f, 0xcc, 0x6e, 0x9e, 0x83, 0xbd, 0x9e, 0xf9, 0x2e, 0x54, 0x31, 0x2b,
+	0x03, 0xef, 0x03, 0xe3, 0xe8, 0x4e, 0xf7, 0x66, 0x75, 0x24, 0xb2, 0xf0, 0xb1, 0xff, 0xac, 0x40,
+	0x5d, 0x1e, 0x89, 0xee, 0xe7, 0xb9, 0x56, 0x7b, 0xaf, 0xe4, 0xb9, 0xd6, 0xa5, 0x59, 0x66, 0xfa,
+	0x7b, 0x50, 0x8b, 0x48, 0x80, 0x87, 0xfd, 0x76, 0x39, 0x4f, 0x65, 0x6d, 0xc4, 0x35, 0xd7, 0xf9,
+	0xca, 0x91, 0x3e, 0xe8, 0xc7, 0x90, 0xb5, 0x95, 0xbc, 0x0b, 0xc4, 0x48, 0x6d, 0x66, 0x9f, 0xc9,
+	0x6f, 0x83, 0x9e, 0xc6, 0x22, 0xfa, 0x7c, 0xd3, 0x51, 0x9c, 0x46, 0x52, 0xd0, 0x33, 0x76, 0x16,
+	0x58, 0xcc, 0xd7, 0xf6, 0x1f, 0x15, 0x50, 0xd9, 0x21, 0xe8, 0xa0, 0xc0, 0x0c, 0x33, 0x8f, 0x36,
+	0x0b, 0x80, 0x85, 0xca, 0x6e, 0x90, 0x58, 0x4e, 0xc4, 0x72, 0x18, 0xe7, 0x70, 0x95, 0x1d, 0x5c,
+	0x91, 0x87, 0xbc, 0xd2, 0x3b, 0xb2, 0x7f, 0x29, 0xf4, 0xea, 0xff, 0x10, 0xba, 0xfd, 0x3b, 0x05,
+	0x1a, 0x45, 0x47, 0x36, 0x31, 0xce, 0xb0, 0x97, 0xd0, 0x19, 0xf6, 0x28, 0x07, 0x94, 0x3d, 0xd7,
+	0xcc, 0xb5, 0xcc, 0x8f, 0xb9, 0x49, 0x1c, 0x8a, 0x85, 0x9b, 0x88, 0xbf, 0x99, 0x6b, 0xb9, 0x1b,
+	0xbb, 0x3f, 0x63, 0x5f, 0x38, 0x64, 0xf7, 0x67, 0xec, 0x73, 0xd3, 0x37, 0x01, 0xbc, 0x80, 0x0d,
+	0x08, 0x6e, 0x14, 0xa9, 0xd3, 0xb9, 0x86, 0x99, 0xed, 0x9f, 0xb0, 0x89, 0x74, 0xb1, 0xc2, 0x29,
+	0xfd, 0x88, 0x0f, 0x05, 0xf4, 0x1a, 0xd4, 0x12, 0x7c, 0xe1, 0xe6, 0x77, 0x6d, 0x35, 0xc1, 0x17,
+	0xc3, 0x80, 0x25, 0x86, 0x86, 0x4b, 0x4c, 0x56, 0x34, 0xbb, 0x53, 0xa4, 0x68, 0xff, 0x4a, 0x81,
+	0x96, 0x83, 0xd3, 0x98, 0x44, 0x29, 0xfe, 0x7a, 0x8c, 0x03, 0x50, 0x7d, 0x12, 0x60, 0xc9, 0x94,
+	0xc6, 0xf5, 0xa6, 0xa3, 0xb1, 0x8d, 0x0f, 0x49, 0x80, 0x1d, 0x6e, 0x61, 0xa7, 0x2c, 0x71, 0x9a,
+	0x7a, 0xf3, 0xac, 0x2a, 0x99, 0x88, 0x6c, 0xa8, 0xe2, 0x24, 0x21, 0xe2, 0x0b, 0xd8, 0xfc, 0x1d,
+	0x30, 0x29, 0x27, 0x2f, 0x13, 0xec, 0xbf, 0x29, 0xa0, 0x8f, 0x08, 0x3d, 0x11, 0x41, 0x1c, 0x43,
+	0x23, 0xce, 0x98, 0xee, 0xe6, 0xd4, 0xb0, 0xb6, 0x37, 0xc7, 0xc5, 0xfe, 0xf4, 0x30, 0xf2, 0x3d,
+	0x43, 0x4e, 0x6e, 0x31, 0x2a, 0x8b, 0xe4, 0x16, 0xf0, 0x45, 0x72, 0x0b, 0x9f, 0xc2, 0xac, 0x2d,
+	0xd4, 0x41, 0xce, 0x5a, 0x5e, 0x8a, 0xbc, 0x13, 0xd5, 0x97, 0xe8, 0xc4, 0xc7, 0xa0, 0x8d, 0xc8,
+	0xad, 0x7d, 0x8a, 0xfd, 0x0c, 0xee, 0xe6, 0xb6, 0x11, 0xa1, 0x8f, 0xc8, 0x2a, 0x0a, 0x6e, 0x03,
+	0xf7, 0x1c, 0x8c, 0xc7, 0xe9, 0x7c, 0x4a, 0xc8, 0x89, 0x97, 0xcc, 0xf1, 0x6d, 0x24, 0xfd, 0x4d,
+	0xd0, 0x96, 0xe9, 0xdc, 0x4d, 0xc3, 0x17, 0x38, 0xbb, 0x0b, 0x96, 0xe9, 0x7c, 0x12, 0xbe, 0xc0,
+	0xf6, 0x2f, 0x15, 0x68, 0x3e, 0x2d, 0xde, 0xa8, 0xb7, 0x71, 0xde, 0xf7, 0x41, 0xe3, 0x83, 0x9e,
+	0x6d, 0x17, 0x65, 0x7e, 0x7d, 0xbb, 0xbb, 0x05, 0x8a, 0x17, 0x42, 0x9d, 0xfb, 0x0d, 0x03, 0x16,
+	0xc7, 0x2e, 0x9b, 0x0e, 0xf6, 0x82, 0x27, 0xd1, 0x62, 0x7d, 0x1b, 0xb1, 0xbc, 0x0b, 0x75, 0x36,
+	0x29, 0x77, 0xa1, 0x7c, 0xdd, 0x38, 0xdd, 0x8b, 0xa3, 0x9f, 0x78, 0x61, 0x14, 0x46, 0xf3, 0xff,
+	0x43, 0x1c, 0x2d, 0x68, 0x4c, 0xc5, 0x34, 0xe0, 0x5d, 0x69, 0xdf, 0x07, 0x63, 0xc2, 0x5f, 0xf7,
+	0x5c, 0x64, 0xef, 0x04, 0xdf, 0x5b, 0xa5, 0xd9, 0xb3, 0x42, 0x08, 0xf6, 0x6f, 0x2b, 0x50, 0x15,
+	0xf6, 0x77, 0x00, 0x22, 0x42, 0x5d, 0xd9, 0x6a, 0x8a, 0x7c, 0xd5, 0xe5, 0x9d, 0xec, 0xe8, 0x51,
+	0xb6, 0x44, 0xdf, 0x01, 0x3d, 0x22, 0x6e, 0xa1, 0x29, 0x8d, 0x23, 0xbd, 0x9b, 0xf5, 0x89, 0xa3,
+	0x45, 0x72, 0x85, 0x7a, 0xf0, 0xca, 0x2e, 0x07, 0x0c, 0xfc, 0x94, 0x11, 0x5e, 0x5e, 0x37, 0xa8,
+	0xfb, 0xa5, 0x56, 0x70, 0xee, 0xc6, 0xfb, 0x2a, 0xf4, 0x3e, 0x34, 0x19, 0x11, 0x29, 0x21, 0xee,
+	0x82, 0x91, 0x5b, 0xb6, 0x6d, 0xa3, 0x5b, 0x20, 0xbc, 0x63, 0x2c, 0x77, 0x02, 0x7b, 0x5b, 0xee,
+	0x3d, 0x03, 0xab, 0xf2, 0x6d, 0x79, 0x83, 0xb5, 0x7b, 0xcf, 0xc2, 0x9b, 0xc1, 0x26, 0xd8, 0x0b,
+	0x5c, 0x12, 0x2d, 0xd6, 0xed, 0xda, 0x7e, 0xb0, 0x19, 0xd3, 0x0a, 0xc1, 0x16, 0xc8, 0x87, 0x76,
+	0x18, 0x81, 0xa4, 0x42, 0xbb, 0xbe, 0x0f, 0x91, 0x91, 0xa4, 0x00, 0x91, 0xa9, 0x3e, 0x50, 0x2f,
+	0x7f, 0xdf, 0x51, 0x1e, 0xc4, 0x60, 0x14, 0x5e, 0xde, 0xa8, 0x05, 0x30, 0x99, 0xb8, 0xc3, 0xe8,
+	0xb9, 0xb7, 0x08, 0x03, 0xb3, 0x84, 0x0c, 0xa8, 0x73, 0x39, 0xa4, 0xa6, 0x22, 0x8d, 0xe3, 0x04,
+	0xc7, 0x5e, 0x82, 0xcd, 0xb2, 0x94, 0x9d, 0x55, 0xc4, 0xf0, 0xcc, 0x0a, 0x6a, 0x82, 0x3e, 0x99,
+	0xb8, 0x7d, 0xbc, 0xc0, 0x14, 0x9b, 0x2a, 0xba, 0x03, 0x46, 0x26, 0x32, 0x7b, 0xf5, 0x9e, 0xfa,
+	0xeb, 0x3f, 0x58, 0xa5, 0x07, 0x8f, 0x40, 0xcf, 0xff, 0x0d, 0xf0, 0x2d, 0x53, 0x77, 0x30, 0x9a,
+	0x0e, 0xa7, 0x9f, 0xc8, 0xe3, 0xa6, 0xee, 0xa0, 0xff, 0xe1, 0xc0, 0x54, 0xa4, 0xd0, 0x3b, 0x79,
+	0xd2, 0x33, 0xcb, 0x08, 0xa0, 0x36, 0x99, 0xba, 0xd3, 0x8f, 0x47, 0x66, 0x45, 0xe2, 0x2c, 0xe0,
+	0xce, 0xde, 0x1b, 0x88, 0x05, 0x34, 0x3e, 0x76, 0x87, 0xa3, 0x67, 0xc7, 0x27, 0xc3, 0xbe, 0x59,
+	0x92, 0xf2, 0xe8, 0xc9, 0xd4, 0x19, 0x1c, 0xf7, 0x4d, 0x85, 0x45, 0x34, 0x3e, 0x76, 0x99, 0xf0,
+	0x64, 0x74, 0xf2, 0x89, 0x59, 0x46, 0x26, 0x34, 0xa4, 0xe2, 0x67, 0xce, 0x70, 0x3a, 0x30, 0x2b,
+	0x52, 0x33, 0x19, 0x9f, 0x0c, 0xa7, 0xd3, 0xe1, 0xe8, 0x43, 0x53, 0x15, 0xa7, 0xf5, 0x3e, 0xb8,
+	0xbc, 0xb2, 0x4a, 0x7f, 0xbf, 0xb2, 0x4a, 0x5f, 0x5c, 0x59, 0xa5, 0x7f, 0x5e, 0x59, 0xa5, 0x7f,
+	0x5d, 0x59, 0xca, 0x2f, 0xb6, 0x96, 0xf2, 0xa7, 0xad, 0xa5, 0xfc, 0x65, 0x6b, 0x95, 0xfe, 0xba,
+	0xb5, 0x4a, 0x97, 0x5b, 0x4b, 0xf9, 0x7c, 0x6b, 0x29, 0x5f, 0x6c, 0x2d, 0xe5, 0x23, 0xe5, 0xd3,
+	0x1a, 0xfb, 0x83, 0x1d, 0xcf, 0x66, 0x35, 0xfe, 0xa7, 0xf9, 0x07, 0xff, 0x19, 0x00, 0x55, 0xb7,
+	0x16, 0xdf, 0x71, 0x0f, 0x00, 0x00,
 }
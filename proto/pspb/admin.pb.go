@@ -16,6 +16,10 @@
 		ChangeReplicaResponse
 		ChangeLeaderRequest
 		ChangeLeaderResponse
+		DrainRequest
+		DrainResponse
+		ChecksumRequest
+		ChecksumResponse
 */
 package pspb
 
@@ -137,6 +141,53 @@ func (m *ChangeLeaderResponse) Reset()                    { *m = ChangeLeaderRes
 func (*ChangeLeaderResponse) ProtoMessage()               {}
 func (*ChangeLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{7} }
 
+// DrainRequest stops the writes of the server and moves its leaders off before a restart, the
+// writes in flight finish first. The timeout of the header bounds the wait, resume accepts the
+// writes again.
+type DrainRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Resume             bool `protobuf:"varint,2,opt,name=resume,proto3" json:"resume,omitempty"`
+}
+
+func (m *DrainRequest) Reset()                    { *m = DrainRequest{} }
+func (*DrainRequest) ProtoMessage()               {}
+func (*DrainRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{8} }
+
+type DrainResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the partitions still led by the server when the wait ended
+	Leaders []github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,rep,packed,name=leaders,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"leaders,omitempty"`
+}
+
+func (m *DrainResponse) Reset()                    { *m = DrainResponse{} }
+func (*DrainResponse) ProtoMessage()               {}
+func (*DrainResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{9} }
+
+// ChecksumRequest with index 0 asks the leader to hash the replicas of the partition at a new raft
+// index, else it reads the checksum of the replica at the index.
+type ChecksumRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	Index              uint64                                                 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *ChecksumRequest) Reset()                    { *m = ChecksumRequest{} }
+func (*ChecksumRequest) ProtoMessage()               {}
+func (*ChecksumRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{10} }
+
+type ChecksumResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Index               uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// false while the replica has not hashed its data yet
+	Ready    bool   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Checksum uint64 `protobuf:"varint,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Keys     uint64 `protobuf:"varint,5,opt,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *ChecksumResponse) Reset()                    { *m = ChecksumResponse{} }
+func (*ChecksumResponse) ProtoMessage()               {}
+func (*ChecksumResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{11} }
+
 func init() {
 	proto.RegisterType((*CreatePartitionRequest)(nil), "CreatePartitionRequest")
 	proto.RegisterType((*CreatePartitionResponse)(nil), "CreatePartitionResponse")
@@ -146,6 +197,10 @@ func init() {
 	proto.RegisterType((*ChangeReplicaResponse)(nil), "ChangeReplicaResponse")
 	proto.RegisterType((*ChangeLeaderRequest)(nil), "ChangeLeaderRequest")
 	proto.RegisterType((*ChangeLeaderResponse)(nil), "ChangeLeaderResponse")
+	proto.RegisterType((*DrainRequest)(nil), "DrainRequest")
+	proto.RegisterType((*DrainResponse)(nil), "DrainResponse")
+	proto.RegisterType((*ChecksumRequest)(nil), "ChecksumRequest")
+	proto.RegisterType((*ChecksumResponse)(nil), "ChecksumResponse")
 	proto.RegisterEnum("ReplicaChangeType", ReplicaChangeType_name, ReplicaChangeType_value)
 }
 func (this *CreatePartitionRequest) Equal(that interface{}) bool {
@@ -358,6 +413,131 @@ func (this *ChangeLeaderResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DrainRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DrainRequest)
+	if !ok {
+		that2, ok := that.(DrainRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.Resume != that1.Resume {
+		return false
+	}
+	return true
+}
+func (this *DrainResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DrainResponse)
+	if !ok {
+		that2, ok := that.(DrainResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if len(this.Leaders) != len(that1.Leaders) {
+		return false
+	}
+	for i := range this.Leaders {
+		if this.Leaders[i] != that1.Leaders[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ChecksumRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChecksumRequest)
+	if !ok {
+		that2, ok := that.(ChecksumRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
+}
+func (this *ChecksumResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChecksumResponse)
+	if !ok {
+		that2, ok := that.(ChecksumResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Ready != that1.Ready {
+		return false
+	}
+	if this.Checksum != that1.Checksum {
+		return false
+	}
+	if this.Keys != that1.Keys {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -374,6 +554,8 @@ type AdminGrpcClient interface {
 	DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error)
 	ChangeReplica(ctx context.Context, in *ChangeReplicaRequest, opts ...grpc.CallOption) (*ChangeReplicaResponse, error)
 	ChangeLeader(ctx context.Context, in *ChangeLeaderRequest, opts ...grpc.CallOption) (*ChangeLeaderResponse, error)
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	Checksum(ctx context.Context, in *ChecksumRequest, opts ...grpc.CallOption) (*ChecksumResponse, error)
 }
 
 type adminGrpcClient struct {
@@ -420,6 +602,24 @@ func (c *adminGrpcClient) ChangeLeader(ctx context.Context, in *ChangeLeaderRequ
 	return out, nil
 }
 
+func (c *adminGrpcClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := grpc.Invoke(ctx, "/AdminGrpc/Drain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGrpcClient) Checksum(ctx context.Context, in *ChecksumRequest, opts ...grpc.CallOption) (*ChecksumResponse, error) {
+	out := new(ChecksumResponse)
+	err := grpc.Invoke(ctx, "/AdminGrpc/Checksum", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminGrpc service
 
 type AdminGrpcServer interface {
@@ -427,6 +627,8 @@ type AdminGrpcServer interface {
 	DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error)
 	ChangeReplica(context.Context, *ChangeReplicaRequest) (*ChangeReplicaResponse, error)
 	ChangeLeader(context.Context, *ChangeLeaderRequest) (*ChangeLeaderResponse, error)
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	Checksum(context.Context, *ChecksumRequest) (*ChecksumResponse, error)
 }
 
 func RegisterAdminGrpcServer(s *grpc.Server, srv AdminGrpcServer) {
@@ -505,6 +707,42 @@ func _AdminGrpc_ChangeLeader_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminGrpc_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGrpcServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminGrpc/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGrpcServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGrpc_Checksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGrpcServer).Checksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminGrpc/Checksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGrpcServer).Checksum(ctx, req.(*ChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminGrpc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "AdminGrpc",
 	HandlerType: (*AdminGrpcServer)(nil),
@@ -525,6 +763,14 @@ var _AdminGrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeLeader",
 			Handler:    _AdminGrpc_ChangeLeader_Handler,
 		},
+		{
+			MethodName: "Drain",
+			Handler:    _AdminGrpc_Drain_Handler,
+		},
+		{
+			MethodName: "Checksum",
+			Handler:    _AdminGrpc_Checksum_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
@@ -774,65 +1020,231 @@ func (m *ChangeLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func NewPopulatedCreatePartitionRequest(r randyAdmin, easy bool) *CreatePartitionRequest {
-	this := &CreatePartitionRequest{}
-	v1 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v1
-	v2 := meta.NewPopulatedPartition(r, easy)
-	this.Partition = *v2
-	if !easy && r.Intn(10) != 0 {
+func (m *DrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedCreatePartitionResponse(r randyAdmin, easy bool) *CreatePartitionResponse {
-	this := &CreatePartitionResponse{}
-	v3 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v3
-	if !easy && r.Intn(10) != 0 {
+func (m *DrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintAdmin(dAtA, i, uint64(m.RequestHeader.Size()))
+	n11, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return this
+	i += n11
+	if m.Resume {
+		dAtA[i] = 0x10
+		i++
+		if m.Resume {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func NewPopulatedDeletePartitionRequest(r randyAdmin, easy bool) *DeletePartitionRequest {
-	this := &DeletePartitionRequest{}
-	v4 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v4
-	this.ID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
+func (m *DrainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedDeletePartitionResponse(r randyAdmin, easy bool) *DeletePartitionResponse {
-	this := &DeletePartitionResponse{}
-	v5 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v5
-	if !easy && r.Intn(10) != 0 {
+func (m *DrainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintAdmin(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n12, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return this
+	i += n12
+	if len(m.Leaders) > 0 {
+		dAtA14 := make([]byte, len(m.Leaders)*10)
+		var j13 int
+		for _, num := range m.Leaders {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(j13))
+		i += copy(dAtA[i:], dAtA14[:j13])
+	}
+	return i, nil
 }
 
-func NewPopulatedChangeReplicaRequest(r randyAdmin, easy bool) *ChangeReplicaRequest {
-	this := &ChangeReplicaRequest{}
-	v6 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v6
-	this.Type = ReplicaChangeType([]int32{0, 1}[r.Intn(2)])
-	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
-	v7 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v7
-	if !easy && r.Intn(10) != 0 {
+func (m *ChecksumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
+}
+
+func (m *ChecksumRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintAdmin(dAtA, i, uint64(m.RequestHeader.Size()))
+	n15, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PartitionID))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *ChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintAdmin(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n16, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Index))
+	}
+	if m.Ready {
+		dAtA[i] = 0x18
+		i++
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Checksum != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Checksum))
+	}
+	if m.Keys != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Keys))
+	}
+	return i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedCreatePartitionRequest(r randyAdmin, easy bool) *CreatePartitionRequest {
+	this := &CreatePartitionRequest{}
+	v1 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v1
+	v2 := meta.NewPopulatedPartition(r, easy)
+	this.Partition = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreatePartitionResponse(r randyAdmin, easy bool) *CreatePartitionResponse {
+	this := &CreatePartitionResponse{}
+	v3 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v3
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeletePartitionRequest(r randyAdmin, easy bool) *DeletePartitionRequest {
+	this := &DeletePartitionRequest{}
+	v4 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v4
+	this.ID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeletePartitionResponse(r randyAdmin, easy bool) *DeletePartitionResponse {
+	this := &DeletePartitionResponse{}
+	v5 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v5
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedChangeReplicaRequest(r randyAdmin, easy bool) *ChangeReplicaRequest {
+	this := &ChangeReplicaRequest{}
+	v6 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v6
+	this.Type = ReplicaChangeType([]int32{0, 1}[r.Intn(2)])
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v7 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v7
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
 func NewPopulatedChangeReplicaResponse(r randyAdmin, easy bool) *ChangeReplicaResponse {
@@ -863,6 +1275,54 @@ func NewPopulatedChangeLeaderResponse(r randyAdmin, easy bool) *ChangeLeaderResp
 	return this
 }
 
+func NewPopulatedDrainRequest(r randyAdmin, easy bool) *DrainRequest {
+	this := &DrainRequest{}
+	v11 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v11
+	this.Resume = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDrainResponse(r randyAdmin, easy bool) *DrainResponse {
+	this := &DrainResponse{}
+	v12 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v12
+	v13 := r.Intn(10)
+	this.Leaders = make([]github_com_tiglabs_baudengine_proto_metapb.PartitionID, v13)
+	for i := 0; i < v13; i++ {
+		this.Leaders[i] = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedChecksumRequest(r randyAdmin, easy bool) *ChecksumRequest {
+	this := &ChecksumRequest{}
+	v14 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v14
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	this.Index = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedChecksumResponse(r randyAdmin, easy bool) *ChecksumResponse {
+	this := &ChecksumResponse{}
+	v15 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v15
+	this.Index = uint64(uint64(r.Uint32()))
+	this.Ready = bool(bool(r.Intn(2) == 0))
+	this.Checksum = uint64(uint64(r.Uint32()))
+	this.Keys = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyAdmin interface {
 	Float32() float32
 	Float64() float64
@@ -882,9 +1342,9 @@ func randUTF8RuneAdmin(r randyAdmin) rune {
 	return rune(ru + 61)
 }
 func randStringAdmin(r randyAdmin) string {
-	v11 := r.Intn(100)
-	tmps := make([]rune, v11)
-	for i := 0; i < v11; i++ {
+	v16 := r.Intn(100)
+	tmps := make([]rune, v16)
+	for i := 0; i < v16; i++ {
 		tmps[i] = randUTF8RuneAdmin(r)
 	}
 	return string(tmps)
@@ -906,11 +1366,11 @@ func randFieldAdmin(dAtA []byte, r randyAdmin, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateAdmin(dAtA, uint64(key))
-		v12 := r.Int63()
+		v17 := r.Int63()
 		if r.Intn(2) == 0 {
-			v12 *= -1
+			v17 *= -1
 		}
-		dAtA = encodeVarintPopulateAdmin(dAtA, uint64(v12))
+		dAtA = encodeVarintPopulateAdmin(dAtA, uint64(v17))
 	case 1:
 		dAtA = encodeVarintPopulateAdmin(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1015,6 +1475,66 @@ func (m *ChangeLeaderResponse) Size() (n int) {
 	return n
 }
 
+func (m *DrainRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.Resume {
+		n += 2
+	}
+	return n
+}
+
+func (m *DrainResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Leaders) > 0 {
+		l = 0
+		for _, e := range m.Leaders {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ChecksumRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovAdmin(uint64(m.PartitionID))
+	}
+	if m.Index != 0 {
+		n += 1 + sovAdmin(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *ChecksumResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovAdmin(uint64(m.Index))
+	}
+	if m.Ready {
+		n += 2
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovAdmin(uint64(m.Checksum))
+	}
+	if m.Keys != 0 {
+		n += 1 + sovAdmin(uint64(m.Keys))
+	}
+	return n
+}
+
 func sovAdmin(x uint64) (n int) {
 	for {
 		n++
@@ -1114,15 +1634,432 @@ func (this *ChangeLeaderResponse) String() string {
 	}, "")
 	return s
 }
+func (this *DrainRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DrainRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`Resume:` + fmt.Sprintf("%v", this.Resume) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DrainResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DrainResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Leaders:` + fmt.Sprintf("%v", this.Leaders) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChecksumRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChecksumRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChecksumResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChecksumResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Ready:` + fmt.Sprintf("%v", this.Ready) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringAdmin(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CreatePartitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePartitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Partition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatePartitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePartitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePartitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePartitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePartitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePartitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CreatePartitionRequest) Unmarshal(dAtA []byte) error {
+func (m *ChangeReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1145,10 +2082,10 @@ func (m *CreatePartitionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePartitionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1182,8 +2119,46 @@ func (m *CreatePartitionRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ReplicaChangeType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1207,7 +2182,7 @@ func (m *CreatePartitionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Partition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1232,7 +2207,7 @@ func (m *CreatePartitionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreatePartitionResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangeReplicaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1255,10 +2230,10 @@ func (m *CreatePartitionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePartitionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeReplicaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1312,7 +2287,7 @@ func (m *CreatePartitionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeletePartitionRequest) Unmarshal(dAtA []byte) error {
+func (m *ChangeLeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,10 +2310,10 @@ func (m *DeletePartitionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeletePartitionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeLeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeletePartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1373,9 +2348,9 @@ func (m *DeletePartitionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
 			}
-			m.ID = 0
+			m.PartitionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1385,7 +2360,7 @@ func (m *DeletePartitionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1411,7 +2386,7 @@ func (m *DeletePartitionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeletePartitionResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangeLeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1434,10 +2409,10 @@ func (m *DeletePartitionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeletePartitionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeLeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeletePartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1491,7 +2466,7 @@ func (m *DeletePartitionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeReplicaRequest) Unmarshal(dAtA []byte) error {
+func (m *DrainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1514,10 +2489,10 @@ func (m *ChangeReplicaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeReplicaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1552,47 +2527,9 @@ func (m *ChangeReplicaRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= (ReplicaChangeType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
-			}
-			m.PartitionID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resume", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1602,22 +2539,12 @@ func (m *ChangeReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Replica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Resume = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -1639,7 +2566,7 @@ func (m *ChangeReplicaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeReplicaResponse) Unmarshal(dAtA []byte) error {
+func (m *DrainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1662,10 +2589,10 @@ func (m *ChangeReplicaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeReplicaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1698,6 +2625,68 @@ func (m *ChangeReplicaResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v github_com_tiglabs_baudengine_proto_metapb.PartitionID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Leaders = append(m.Leaders, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v github_com_tiglabs_baudengine_proto_metapb.PartitionID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Leaders = append(m.Leaders, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -1719,7 +2708,7 @@ func (m *ChangeReplicaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeLeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *ChecksumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,10 +2731,10 @@ func (m *ChangeLeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeLeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChecksumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChecksumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1797,6 +2786,25 @@ func (m *ChangeLeaderRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -1818,7 +2826,7 @@ func (m *ChangeLeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeLeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *ChecksumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,10 +2849,10 @@ func (m *ChangeLeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeLeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChecksumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1877,6 +2885,83 @@ func (m *ChangeLeaderResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			m.Keys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keys |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -2006,41 +3091,50 @@ var (
 func init() { proto.RegisterFile("admin.proto", fileDescriptorAdmin) }
 
 var fileDescriptorAdmin = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xbf, 0x73, 0xa3, 0xb4, 0x7d, 0xe9, 0x3f, 0x8e, 0x26, 0xb1, 0x32, 0x5c, 0x90, 0x07, 0x14,
-	0x21, 0x71, 0x81, 0x20, 0x98, 0x40, 0x6a, 0xd3, 0x08, 0x1a, 0xd4, 0xa1, 0xb2, 0x98, 0x58, 0x90,
-	0x1d, 0x1f, 0xce, 0x49, 0x89, 0x7d, 0x38, 0x0e, 0x52, 0x99, 0x18, 0x19, 0xf9, 0x00, 0xfd, 0x00,
-	0x7c, 0x01, 0x24, 0x46, 0xc6, 0x8c, 0x1d, 0x99, 0xa2, 0xc6, 0x7c, 0x01, 0x46, 0xc4, 0x84, 0x72,
-	0x89, 0xd3, 0x90, 0x38, 0x12, 0x32, 0x74, 0xb2, 0xef, 0xde, 0x7b, 0xbf, 0x7b, 0xbf, 0xdf, 0xbd,
-	0xdf, 0x41, 0xce, 0x72, 0xba, 0xc2, 0x63, 0x32, 0xf0, 0x43, 0xbf, 0x74, 0xd7, 0x15, 0x61, 0xbb,
-	0x6f, 0xb3, 0x96, 0xdf, 0xad, 0xba, 0xbe, 0xeb, 0x57, 0xd5, 0xb6, 0xdd, 0x7f, 0xad, 0x56, 0x6a,
-	0xa1, 0xfe, 0xa6, 0xe9, 0x0f, 0xe7, 0xd2, 0x43, 0xe1, 0x76, 0x2c, 0xbb, 0x57, 0xb5, 0xad, 0xbe,
-	0xc3, 0x3d, 0x57, 0x78, 0x7c, 0x52, 0x5c, 0xed, 0xf2, 0xd0, 0x92, 0xb6, 0xfa, 0x4c, 0xca, 0x8c,
-	0x77, 0x50, 0x38, 0x0a, 0xb8, 0x15, 0xf2, 0x53, 0x2b, 0x08, 0x45, 0x28, 0x7c, 0xcf, 0xe4, 0x6f,
-	0xfa, 0xbc, 0x17, 0x92, 0x7b, 0x90, 0x6d, 0x73, 0xcb, 0xe1, 0x81, 0x8e, 0x6f, 0xe1, 0x4a, 0xae,
-	0xb6, 0xc3, 0xa6, 0x91, 0x63, 0xb5, 0x5b, 0xdf, 0x18, 0x0c, 0xcb, 0xe8, 0x62, 0x58, 0xc6, 0xe6,
-	0x34, 0x8f, 0x30, 0xd8, 0x94, 0x31, 0x8a, 0xae, 0xa9, 0x22, 0x60, 0x33, 0xdc, 0x7a, 0x66, 0x5c,
-	0x60, 0x5e, 0xa5, 0x18, 0x27, 0x50, 0x5c, 0x3a, 0xbb, 0x27, 0x7d, 0xaf, 0xc7, 0xc9, 0xfd, 0x85,
-	0xc3, 0x77, 0x59, 0x1c, 0x5a, 0x75, 0xba, 0x71, 0x8e, 0xa1, 0xd0, 0xe0, 0x1d, 0xfe, 0x5f, 0xa8,
-	0x9c, 0x82, 0x26, 0x1c, 0xc5, 0x61, 0xbb, 0x7e, 0x10, 0x0d, 0xcb, 0x5a, 0xb3, 0xf1, 0x6b, 0x58,
-	0x7e, 0xf4, 0xf7, 0x1a, 0x5f, 0xf1, 0x6e, 0x36, 0x4c, 0x4d, 0x38, 0x63, 0xb2, 0x4b, 0xdd, 0xa5,
-	0x27, 0xfb, 0x41, 0x83, 0xfd, 0xa3, 0xb6, 0xe5, 0xb9, 0xdc, 0xe4, 0xb2, 0x23, 0x5a, 0x56, 0x7a,
-	0xaa, 0xb7, 0x21, 0x13, 0x9e, 0x49, 0xae, 0xc8, 0xee, 0xd4, 0x08, 0x9b, 0x02, 0x4e, 0xd0, 0x5f,
-	0x9c, 0x49, 0x6e, 0xaa, 0x38, 0xe9, 0xc0, 0xd6, 0xec, 0xea, 0x5e, 0x09, 0x47, 0x5f, 0x53, 0xe2,
-	0x34, 0xa3, 0x61, 0x39, 0x37, 0xc7, 0xf5, 0x1f, 0x54, 0xca, 0xcd, 0xe0, 0x9b, 0x0e, 0xa9, 0xc0,
-	0x7a, 0x30, 0x69, 0x44, 0xcf, 0x28, 0x22, 0x1b, 0x71, 0x63, 0xd3, 0x39, 0x8a, 0xc3, 0xc6, 0x73,
-	0xc8, 0x2f, 0x28, 0x91, 0x5e, 0xd6, 0xcf, 0x18, 0x6e, 0x4e, 0xc0, 0x4e, 0xd4, 0x46, 0x7a, 0x55,
-	0x17, 0xd5, 0xd2, 0xae, 0x53, 0x2d, 0xa3, 0x19, 0x4f, 0x43, 0xdc, 0x76, 0x6a, 0x09, 0xee, 0x54,
-	0xe0, 0xc6, 0xd2, 0x04, 0x90, 0x75, 0x58, 0x3b, 0x74, 0x9c, 0x3d, 0x44, 0x00, 0xb2, 0x26, 0xef,
-	0xfa, 0x6f, 0xf9, 0x1e, 0xae, 0x9d, 0x6b, 0xb0, 0x79, 0x38, 0x7e, 0xb0, 0x9e, 0x05, 0xb2, 0x45,
-	0x9e, 0xc2, 0xee, 0x82, 0x99, 0x49, 0x91, 0x25, 0x3f, 0x2d, 0x25, 0x9d, 0xad, 0xf0, 0xbd, 0x81,
-	0xc6, 0x38, 0x0b, 0x3e, 0x21, 0x45, 0x96, 0xec, 0xeb, 0x92, 0xce, 0x56, 0x58, 0xca, 0x40, 0xe4,
-	0x00, 0xb6, 0xff, 0x18, 0x0b, 0x92, 0x67, 0x49, 0x86, 0x29, 0x15, 0x58, 0xe2, 0xf4, 0x18, 0x88,
-	0x3c, 0x81, 0xad, 0x79, 0x51, 0xc9, 0x3e, 0x4b, 0x18, 0x8d, 0x52, 0x9e, 0x25, 0x29, 0x6f, 0xa0,
-	0xfa, 0xe3, 0xc1, 0x88, 0xa2, 0x6f, 0x23, 0x8a, 0x2e, 0x47, 0x14, 0xfd, 0x18, 0x51, 0xf4, 0x73,
-	0x44, 0xf1, 0xfb, 0x88, 0xe2, 0x4f, 0x11, 0xc5, 0x5f, 0x22, 0x8a, 0xbe, 0x46, 0x14, 0x0d, 0x22,
-	0x8a, 0x2f, 0x22, 0x8a, 0x2f, 0x23, 0x8a, 0x3f, 0x7e, 0xa7, 0xe8, 0x18, 0xbf, 0xcc, 0xc8, 0x9e,
-	0xb4, 0xed, 0xac, 0xba, 0xf8, 0x07, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x90, 0xbb, 0x57, 0xcc,
-	0x13, 0x06, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xbf, 0x73, 0xd3, 0x34, 0x7d, 0x69, 0xda, 0xf4, 0x48, 0x53, 0xcb, 0x83, 0x53, 0xdd, 0x80,
+	0x22, 0x24, 0x2e, 0xb4, 0x08, 0x84, 0x90, 0x90, 0xda, 0x34, 0x82, 0x06, 0x75, 0xa8, 0xac, 0x0e,
+	0x88, 0x05, 0x39, 0xf1, 0x91, 0x58, 0x4d, 0x6c, 0x63, 0x3b, 0x88, 0x30, 0x31, 0xf2, 0x11, 0x18,
+	0x58, 0xd8, 0xf8, 0x02, 0x48, 0x8c, 0x6c, 0x74, 0xec, 0xc8, 0x14, 0x35, 0xfe, 0x04, 0x8c, 0xc0,
+	0x84, 0x72, 0x76, 0xd2, 0xd4, 0x4d, 0x25, 0xe4, 0xc2, 0xc0, 0xe4, 0x7b, 0xf7, 0xe7, 0xf7, 0xde,
+	0xef, 0xe7, 0xf7, 0x07, 0xb2, 0xba, 0xd1, 0x35, 0x2d, 0xe6, 0xb8, 0xb6, 0x6f, 0x2b, 0x37, 0x5b,
+	0xa6, 0xdf, 0xee, 0x35, 0x58, 0xd3, 0xee, 0x56, 0x5a, 0x76, 0xcb, 0xae, 0x88, 0xed, 0x46, 0xef,
+	0xb9, 0xb0, 0x84, 0x21, 0x56, 0xd1, 0xf5, 0x3b, 0x53, 0xd7, 0x7d, 0xb3, 0xd5, 0xd1, 0x1b, 0x5e,
+	0xa5, 0xa1, 0xf7, 0x0c, 0x6e, 0xb5, 0x4c, 0x8b, 0x87, 0x8f, 0x2b, 0x5d, 0xee, 0xeb, 0x4e, 0x43,
+	0x7c, 0xc2, 0x67, 0xf4, 0x35, 0x14, 0x77, 0x5d, 0xae, 0xfb, 0xfc, 0x40, 0x77, 0x7d, 0xd3, 0x37,
+	0x6d, 0x4b, 0xe3, 0x2f, 0x7a, 0xdc, 0xf3, 0xc9, 0x2d, 0x48, 0xb7, 0xb9, 0x6e, 0x70, 0x57, 0xc6,
+	0x1b, 0xb8, 0x9c, 0xdd, 0x5a, 0x66, 0xd1, 0xc9, 0x9e, 0xd8, 0xad, 0x66, 0x8e, 0x07, 0x25, 0x74,
+	0x32, 0x28, 0x61, 0x2d, 0xba, 0x47, 0x18, 0x2c, 0x3a, 0x63, 0x14, 0x59, 0x12, 0x8f, 0x80, 0x4d,
+	0x70, 0xab, 0xa9, 0xd1, 0x03, 0xed, 0xec, 0x0a, 0xdd, 0x87, 0xf5, 0x0b, 0xbe, 0x3d, 0xc7, 0xb6,
+	0x3c, 0x4e, 0x36, 0x63, 0xce, 0x57, 0xd8, 0xf8, 0xe8, 0x32, 0xef, 0xf4, 0x3d, 0x86, 0x62, 0x8d,
+	0x77, 0xf8, 0x5f, 0xa1, 0x72, 0x00, 0x92, 0x69, 0x08, 0x0e, 0xb9, 0xea, 0x76, 0x30, 0x28, 0x49,
+	0xf5, 0xda, 0xaf, 0x41, 0xe9, 0xee, 0x9f, 0x6b, 0x7c, 0xc6, 0xbb, 0x5e, 0xd3, 0x24, 0xd3, 0x18,
+	0x91, 0xbd, 0x10, 0x5d, 0x72, 0xb2, 0x6f, 0x25, 0x28, 0xec, 0xb6, 0x75, 0xab, 0xc5, 0x35, 0xee,
+	0x74, 0xcc, 0xa6, 0x9e, 0x9c, 0xea, 0x75, 0x48, 0xf9, 0x7d, 0x87, 0x0b, 0xb2, 0xcb, 0x5b, 0x84,
+	0x45, 0x80, 0x21, 0xfa, 0x61, 0xdf, 0xe1, 0x9a, 0x38, 0x27, 0x1d, 0x58, 0x9a, 0xfc, 0xba, 0x67,
+	0xa6, 0x21, 0xcf, 0x09, 0x71, 0xea, 0xc1, 0xa0, 0x94, 0x9d, 0xe2, 0x7a, 0x05, 0x95, 0xb2, 0x13,
+	0xf8, 0xba, 0x41, 0xca, 0xb0, 0xe0, 0x86, 0x81, 0xc8, 0x29, 0x41, 0x24, 0x33, 0x0e, 0x2c, 0xca,
+	0xa3, 0xf1, 0x31, 0x7d, 0x0c, 0x6b, 0x31, 0x25, 0x92, 0xcb, 0xfa, 0x09, 0xc3, 0xb5, 0x10, 0x6c,
+	0x5f, 0x6c, 0x24, 0x57, 0x35, 0xae, 0x96, 0xf4, 0x2f, 0xd5, 0xa2, 0x75, 0x28, 0x9c, 0x0f, 0x3b,
+	0xb9, 0x04, 0x4f, 0x60, 0xa9, 0xe6, 0xea, 0xe6, 0x15, 0x6a, 0xa7, 0x08, 0x69, 0x97, 0x7b, 0xbd,
+	0x6e, 0x98, 0x52, 0x19, 0x2d, 0xb2, 0xe8, 0x3b, 0x0c, 0xb9, 0x08, 0x3a, 0x71, 0x78, 0xe4, 0x10,
+	0x16, 0x3a, 0x62, 0xe5, 0xc9, 0xd2, 0xc6, 0x5c, 0x39, 0x57, 0xbd, 0x7f, 0x05, 0x0d, 0xc7, 0x50,
+	0xf4, 0x2b, 0x86, 0x95, 0xdd, 0x36, 0x6f, 0x1e, 0x79, 0xbd, 0xee, 0x7f, 0xf2, 0xcf, 0x49, 0x01,
+	0xe6, 0x4d, 0xcb, 0xe0, 0xaf, 0x44, 0x21, 0xa6, 0xb4, 0xd0, 0xa0, 0x1f, 0x30, 0xe4, 0xcf, 0x98,
+	0x24, 0xd7, 0x79, 0x82, 0x2e, 0x4d, 0xa1, 0x8f, 0x76, 0x5d, 0xae, 0x1b, 0x7d, 0xe1, 0x33, 0xa3,
+	0x85, 0x06, 0x51, 0x20, 0xd3, 0x8c, 0x5c, 0x8a, 0x62, 0x4d, 0x69, 0x13, 0x9b, 0x10, 0x48, 0x1d,
+	0xf1, 0xbe, 0x27, 0xcf, 0x8b, 0x7d, 0xb1, 0xbe, 0x51, 0x86, 0xd5, 0x0b, 0x4d, 0x86, 0x2c, 0xc0,
+	0xdc, 0x8e, 0x61, 0xe4, 0x11, 0x01, 0x48, 0x6b, 0xbc, 0x6b, 0xbf, 0xe4, 0x79, 0xbc, 0xf5, 0x53,
+	0x82, 0xc5, 0x9d, 0xd1, 0x4c, 0x7c, 0xe4, 0x3a, 0x4d, 0xf2, 0x10, 0x56, 0x62, 0xf3, 0x82, 0xac,
+	0xb3, 0xd9, 0xd3, 0x4b, 0x91, 0xd9, 0x25, 0xa3, 0x85, 0xa2, 0x11, 0x4e, 0xac, 0x15, 0x93, 0x75,
+	0x36, 0x7b, 0x74, 0x28, 0x32, 0xbb, 0xa4, 0x6b, 0x53, 0x44, 0xb6, 0x21, 0x77, 0xae, 0xf3, 0x90,
+	0x35, 0x36, 0xab, 0x27, 0x2b, 0x45, 0x36, 0xb3, 0x41, 0x51, 0x44, 0x1e, 0xc0, 0xd2, 0x74, 0xdd,
+	0x92, 0x02, 0x9b, 0xd1, 0x7d, 0x94, 0x35, 0x36, 0xab, 0xb8, 0x29, 0x22, 0x65, 0x98, 0x17, 0x05,
+	0x45, 0x72, 0x6c, 0xba, 0x66, 0x95, 0x65, 0x76, 0xae, 0xce, 0x28, 0x22, 0x9b, 0x90, 0x19, 0x67,
+	0x05, 0xc9, 0xb3, 0x58, 0xaa, 0x2b, 0xab, 0x2c, 0x9e, 0x32, 0x14, 0x55, 0xef, 0x1d, 0x0f, 0x55,
+	0xf4, 0x6d, 0xa8, 0xa2, 0xd3, 0xa1, 0x8a, 0xbe, 0x0f, 0x55, 0xf4, 0x63, 0xa8, 0xe2, 0x37, 0x81,
+	0x8a, 0x3f, 0x06, 0x2a, 0xfe, 0x1c, 0xa8, 0xe8, 0x4b, 0xa0, 0xa2, 0xe3, 0x40, 0xc5, 0x27, 0x81,
+	0x8a, 0x4f, 0x03, 0x15, 0xef, 0xe1, 0xa7, 0x29, 0xc7, 0x73, 0x1a, 0x8d, 0xb4, 0x48, 0xe0, 0xdb,
+	0xbf, 0x07, 0x00, 0xd6, 0x97, 0x72, 0xd5, 0xcf, 0x08, 0x00, 0x00,
 }
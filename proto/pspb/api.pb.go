@@ -16,7 +16,45 @@
 		UpdateResponse
 		DeleteRequest
 		DeleteResponse
+		Precondition
+		BulkRequest
+		BulkResponse
+		GetRequest
+		GetResponse
+		FieldFilter
+		MultiGetRequest
+		MultiGetDoc
+		MultiGetResponse
+		SearchRequest
+		SearchHit
+		SearchResponse
 		Failure
+		BlobMeta
+		BlobChunkRequest
+		BlobChunkResponse
+		BlobAbortRequest
+		BlobAbortResponse
+		BlobUpload
+		BlobCommitRequest
+		BlobCommitResponse
+		PutBlobRequest
+		PutBlobResponse
+		GetBlobRequest
+		GetBlobResponse
+		DeleteBlobRequest
+		DeleteBlobResponse
+		ChangeEvent
+		WatchChangesRequest
+		WatchChangesResponse
+		TxnRecord
+		TxnKey
+		TxnIntent
+		TxnRecordRequest
+		TxnRecordResponse
+		TxnPrepareRequest
+		TxnPrepareResponse
+		TxnResolveRequest
+		TxnResolveResponse
 */
 package pspb
 
@@ -24,13 +62,20 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import meta "github.com/tiglabs/baudengine/proto/metapb"
 
 import github_com_tiglabs_baudengine_proto_metapb "github.com/tiglabs/baudengine/proto/metapb"
 
 import bytes "bytes"
 
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
+import binary "encoding/binary"
+
 import strings "strings"
 import reflect "reflect"
+import sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -54,17 +99,53 @@ const (
 	OpType_UPDATE OpType = 1
 	// Deletes a document
 	OpType_DELETE OpType = 2
+	// Writes a chunk of a blob upload, it is invisible until the upload is committed.
+	OpType_BLOB_CHUNK OpType = 3
+	// Makes a blob upload visible and drops the chunks of the replaced upload.
+	OpType_BLOB_COMMIT OpType = 4
+	// Deletes a blob with all its chunks
+	OpType_BLOB_DELETE OpType = 5
+	// Creates the record of a transaction, it fails if the record exists.
+	OpType_TXN_RECORD_PUT OpType = 6
+	// Moves a pending transaction record to committed or aborted.
+	OpType_TXN_RECORD_FINISH OpType = 7
+	// Deletes a finished transaction record once its intents are resolved.
+	OpType_TXN_RECORD_DELETE OpType = 8
+	// Lays the intent of a transaction on a document, the write is provisional until resolved.
+	OpType_TXN_PREPARE OpType = 9
+	// Applies or drops the intent of a transaction on a document.
+	OpType_TXN_RESOLVE OpType = 10
+	// Drops the chunks of a blob upload that is not committed.
+	OpType_BLOB_ABORT OpType = 11
 )
 
 var OpType_name = map[int32]string{
-	0: "CREATE",
-	1: "UPDATE",
-	2: "DELETE",
+	0:  "CREATE",
+	1:  "UPDATE",
+	2:  "DELETE",
+	3:  "BLOB_CHUNK",
+	4:  "BLOB_COMMIT",
+	5:  "BLOB_DELETE",
+	6:  "TXN_RECORD_PUT",
+	7:  "TXN_RECORD_FINISH",
+	8:  "TXN_RECORD_DELETE",
+	9:  "TXN_PREPARE",
+	10: "TXN_RESOLVE",
+	11: "BLOB_ABORT",
 }
 var OpType_value = map[string]int32{
-	"CREATE": 0,
-	"UPDATE": 1,
-	"DELETE": 2,
+	"CREATE":            0,
+	"UPDATE":            1,
+	"DELETE":            2,
+	"BLOB_CHUNK":        3,
+	"BLOB_COMMIT":       4,
+	"BLOB_DELETE":       5,
+	"TXN_RECORD_PUT":    6,
+	"TXN_RECORD_FINISH": 7,
+	"TXN_RECORD_DELETE": 8,
+	"TXN_PREPARE":       9,
+	"TXN_RESOLVE":       10,
+	"BLOB_ABORT":        11,
 }
 
 func (x OpType) String() string {
@@ -102,11 +183,67 @@ func (x WriteResult) String() string {
 }
 func (WriteResult) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{1} }
 
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_INSERT ChangeType = 0
+	ChangeType_CHANGE_UPDATE ChangeType = 1
+	ChangeType_CHANGE_DELETE ChangeType = 2
+)
+
+var ChangeType_name = map[int32]string{
+	0: "CHANGE_INSERT",
+	1: "CHANGE_UPDATE",
+	2: "CHANGE_DELETE",
+}
+var ChangeType_value = map[string]int32{
+	"CHANGE_INSERT": 0,
+	"CHANGE_UPDATE": 1,
+	"CHANGE_DELETE": 2,
+}
+
+func (x ChangeType) String() string {
+	return proto.EnumName(ChangeType_name, int32(x))
+}
+func (ChangeType) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{2} }
+
+type TxnStatus int32
+
+const (
+	TxnStatus_TXN_PENDING   TxnStatus = 0
+	TxnStatus_TXN_COMMITTED TxnStatus = 1
+	TxnStatus_TXN_ABORTED   TxnStatus = 2
+)
+
+var TxnStatus_name = map[int32]string{
+	0: "TXN_PENDING",
+	1: "TXN_COMMITTED",
+	2: "TXN_ABORTED",
+}
+var TxnStatus_value = map[string]int32{
+	"TXN_PENDING":   0,
+	"TXN_COMMITTED": 1,
+	"TXN_ABORTED":   2,
+}
+
+func (x TxnStatus) String() string {
+	return proto.EnumName(TxnStatus_name, int32(x))
+}
+func (TxnStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{3} }
+
 type RequestUnion struct {
-	OpType OpType         `protobuf:"varint,1,opt,name=op_type,json=opType,proto3,enum=OpType" json:"op_type,omitempty"`
-	Create *CreateRequest `protobuf:"bytes,2,opt,name=create" json:"create,omitempty"`
-	Update *UpdateRequest `protobuf:"bytes,3,opt,name=update" json:"update,omitempty"`
-	Delete *DeleteRequest `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
+	OpType     OpType             `protobuf:"varint,1,opt,name=op_type,json=opType,proto3,enum=OpType" json:"op_type,omitempty"`
+	Create     *CreateRequest     `protobuf:"bytes,2,opt,name=create" json:"create,omitempty"`
+	Update     *UpdateRequest     `protobuf:"bytes,3,opt,name=update" json:"update,omitempty"`
+	Delete     *DeleteRequest     `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
+	BlobChunk  *BlobChunkRequest  `protobuf:"bytes,5,opt,name=blob_chunk,json=blobChunk" json:"blob_chunk,omitempty"`
+	BlobCommit *BlobCommitRequest `protobuf:"bytes,6,opt,name=blob_commit,json=blobCommit" json:"blob_commit,omitempty"`
+	// checked against the document in the raft apply, before the write
+	Precondition *Precondition      `protobuf:"bytes,7,opt,name=precondition" json:"precondition,omitempty"`
+	TxnRecord    *TxnRecordRequest  `protobuf:"bytes,8,opt,name=txn_record,json=txnRecord" json:"txn_record,omitempty"`
+	TxnPrepare   *TxnPrepareRequest `protobuf:"bytes,9,opt,name=txn_prepare,json=txnPrepare" json:"txn_prepare,omitempty"`
+	TxnResolve   *TxnResolveRequest `protobuf:"bytes,10,opt,name=txn_resolve,json=txnResolve" json:"txn_resolve,omitempty"`
+	BlobAbort    *BlobAbortRequest  `protobuf:"bytes,11,opt,name=blob_abort,json=blobAbort" json:"blob_abort,omitempty"`
 }
 
 func (m *RequestUnion) Reset()                    { *m = RequestUnion{} }
@@ -114,11 +251,17 @@ func (*RequestUnion) ProtoMessage()               {}
 func (*RequestUnion) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{0} }
 
 type ResponseUnion struct {
-	OpType  OpType          `protobuf:"varint,1,opt,name=op_type,json=opType,proto3,enum=OpType" json:"op_type,omitempty"`
-	Create  *CreateResponse `protobuf:"bytes,2,opt,name=create" json:"create,omitempty"`
-	Update  *UpdateResponse `protobuf:"bytes,3,opt,name=update" json:"update,omitempty"`
-	Delete  *DeleteResponse `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
-	Failure *Failure        `protobuf:"bytes,5,opt,name=failure" json:"failure,omitempty"`
+	OpType     OpType              `protobuf:"varint,1,opt,name=op_type,json=opType,proto3,enum=OpType" json:"op_type,omitempty"`
+	Create     *CreateResponse     `protobuf:"bytes,2,opt,name=create" json:"create,omitempty"`
+	Update     *UpdateResponse     `protobuf:"bytes,3,opt,name=update" json:"update,omitempty"`
+	Delete     *DeleteResponse     `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
+	Failure    *Failure            `protobuf:"bytes,5,opt,name=failure" json:"failure,omitempty"`
+	BlobChunk  *BlobChunkResponse  `protobuf:"bytes,6,opt,name=blob_chunk,json=blobChunk" json:"blob_chunk,omitempty"`
+	BlobCommit *BlobCommitResponse `protobuf:"bytes,7,opt,name=blob_commit,json=blobCommit" json:"blob_commit,omitempty"`
+	TxnRecord  *TxnRecordResponse  `protobuf:"bytes,8,opt,name=txn_record,json=txnRecord" json:"txn_record,omitempty"`
+	TxnPrepare *TxnPrepareResponse `protobuf:"bytes,9,opt,name=txn_prepare,json=txnPrepare" json:"txn_prepare,omitempty"`
+	TxnResolve *TxnResolveResponse `protobuf:"bytes,10,opt,name=txn_resolve,json=txnResolve" json:"txn_resolve,omitempty"`
+	BlobAbort  *BlobAbortResponse  `protobuf:"bytes,11,opt,name=blob_abort,json=blobAbort" json:"blob_abort,omitempty"`
 }
 
 func (m *ResponseUnion) Reset()                    { *m = ResponseUnion{} }
@@ -179,14 +322,458 @@ func (m *DeleteResponse) Reset()                    { *m = DeleteResponse{} }
 func (*DeleteResponse) ProtoMessage()               {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{7} }
 
+// Precondition is evaluated on the document as the earlier writes of the same command left it.
+type Precondition struct {
+	// the document must exist
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	// the document must not exist
+	NotExists bool `protobuf:"varint,2,opt,name=not_exists,json=notExists,proto3" json:"not_exists,omitempty"`
+	// a json object, the document must exist and hold these field values
+	Match github_com_tiglabs_baudengine_proto_metapb.Value `protobuf:"bytes,3,opt,name=match,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Value" json:"match,omitempty"`
+}
+
+func (m *Precondition) Reset()                    { *m = Precondition{} }
+func (*Precondition) ProtoMessage()               {}
+func (*Precondition) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{8} }
+
+type BulkRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	Requests           []RequestUnion                                         `protobuf:"bytes,3,rep,name=requests" json:"requests"`
+	// all the requests commit or none does, a failed one aborts the others
+	Atomic bool `protobuf:"varint,4,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (m *BulkRequest) Reset()                    { *m = BulkRequest{} }
+func (*BulkRequest) ProtoMessage()               {}
+func (*BulkRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{9} }
+
+type BulkResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Responses           []ResponseUnion `protobuf:"bytes,2,rep,name=responses" json:"responses"`
+}
+
+func (m *BulkResponse) Reset()                    { *m = BulkResponse{} }
+func (*BulkResponse) ProtoMessage()               {}
+func (*BulkResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+
+type GetRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	ID                 github_com_tiglabs_baudengine_proto_metapb.Key         `protobuf:"bytes,3,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+
+type GetResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Found               bool `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	// the committed fields in json
+	Data github_com_tiglabs_baudengine_proto_metapb.Value `protobuf:"bytes,3,opt,name=data,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Value" json:"data,omitempty"`
+	// the intent of an unresolved transaction on the document
+	Intent *TxnIntent `protobuf:"bytes,4,opt,name=intent" json:"intent,omitempty"`
+}
+
+func (m *GetResponse) Reset()                    { *m = GetResponse{} }
+func (*GetResponse) ProtoMessage()               {}
+func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+
+// FieldFilter matches the documents holding the field, at the value when it is set.
+type FieldFilter struct {
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// the value in json
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *FieldFilter) Reset()                    { *m = FieldFilter{} }
+func (*FieldFilter) ProtoMessage()               {}
+func (*FieldFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+
+type MultiGetRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	// the documents read as they are
+	IDs []github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,3,rep,name=ids,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"ids,omitempty"`
+	// the documents read only when they match all the filters
+	FilteredIDs []github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,4,rep,name=filtered_ids,json=filteredIds,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"filtered_ids,omitempty"`
+	Filters     []FieldFilter                                    `protobuf:"bytes,5,rep,name=filters" json:"filters"`
+}
+
+func (m *MultiGetRequest) Reset()                    { *m = MultiGetRequest{} }
+func (*MultiGetRequest) ProtoMessage()               {}
+func (*MultiGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
+
+type MultiGetDoc struct {
+	ID github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	// the committed fields in json
+	Data github_com_tiglabs_baudengine_proto_metapb.Value `protobuf:"bytes,2,opt,name=data,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Value" json:"data,omitempty"`
+}
+
+func (m *MultiGetDoc) Reset()                    { *m = MultiGetDoc{} }
+func (*MultiGetDoc) ProtoMessage()               {}
+func (*MultiGetDoc) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
+
+type MultiGetResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the documents found, missing and filtered out ones are left out
+	Docs []MultiGetDoc `protobuf:"bytes,2,rep,name=docs" json:"docs"`
+}
+
+func (m *MultiGetResponse) Reset()                    { *m = MultiGetResponse{} }
+func (*MultiGetResponse) ProtoMessage()               {}
+func (*MultiGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
+
+type SearchRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	// the query dsl in json, e.g. {"term": {"field": "value"}}
+	Query []byte `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	From  int32  `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	Limit int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the fields to sort by, "-field" for descending, by score if empty
+	Sort []string `protobuf:"bytes,6,rep,name=sort" json:"sort,omitempty"`
+	// the fields returned with the hits
+	Fields []string `protobuf:"bytes,7,rep,name=fields" json:"fields,omitempty"`
+	// the aggregation in json, see engine.Aggregation, the hits are folded into buckets
+	Aggregation []byte `protobuf:"bytes,8,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// the scroll whose snapshot is searched, a new one is opened if empty and keep_alive is set
+	ScrollID string `protobuf:"bytes,9,opt,name=scroll_id,json=scrollId,proto3" json:"scroll_id,omitempty"`
+	// the milliseconds the scroll is kept after the search, it is closed if zero
+	KeepAlive int64 `protobuf:"varint,10,opt,name=keep_alive,json=keepAlive,proto3" json:"keep_alive,omitempty"`
+}
+
+func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
+func (*SearchRequest) ProtoMessage()               {}
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
+
+type SearchHit struct {
+	ID    github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Score float64                                        `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// the fields in json
+	Source github_com_tiglabs_baudengine_proto_metapb.Value `protobuf:"bytes,3,opt,name=source,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Value" json:"source,omitempty"`
+	// the sort values of the hit, comparable across partitions
+	Sort []string `protobuf:"bytes,4,rep,name=sort" json:"sort,omitempty"`
+	// an unresolved intent is on the document, the source is its committed image
+	Intent bool `protobuf:"varint,5,opt,name=intent,proto3" json:"intent,omitempty"`
+}
+
+func (m *SearchHit) Reset()                    { *m = SearchHit{} }
+func (*SearchHit) ProtoMessage()               {}
+func (*SearchHit) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
+
+type SearchResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Total               uint64      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Hits                []SearchHit `protobuf:"bytes,3,rep,name=hits" json:"hits"`
+	// the buckets of the aggregation in json, see engine.Bucket
+	Buckets []byte `protobuf:"bytes,4,opt,name=buckets,proto3" json:"buckets,omitempty"`
+	// the scroll kept for the next search, empty if it is closed
+	ScrollID string `protobuf:"bytes,5,opt,name=scroll_id,json=scrollId,proto3" json:"scroll_id,omitempty"`
+}
+
+func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
+func (*SearchResponse) ProtoMessage()               {}
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
+
 type Failure struct {
 	ID    github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
 	Cause string                                         `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+	// rolled back for another failed write of the atomic bulk
+	Aborted bool `protobuf:"varint,3,opt,name=aborted,proto3" json:"aborted,omitempty"`
 }
 
 func (m *Failure) Reset()                    { *m = Failure{} }
 func (*Failure) ProtoMessage()               {}
-func (*Failure) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{8} }
+func (*Failure) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
+
+// BlobMeta is stored apart from the chunks, a blob is readable only once its meta is committed.
+type BlobMeta struct {
+	UploadID    string            `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Size_       uint64            `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ChunkSize   uint32            `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	Chunks      uint32            `protobuf:"varint,4,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	ContentType string            `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Metadata    map[string]string `protobuf:"bytes,6,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *BlobMeta) Reset()                    { *m = BlobMeta{} }
+func (*BlobMeta) ProtoMessage()               {}
+func (*BlobMeta) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+
+type BlobChunkRequest struct {
+	ID       github_com_tiglabs_baudengine_proto_metapb.Key   `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	UploadID string                                           `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Index    uint32                                           `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Data     github_com_tiglabs_baudengine_proto_metapb.Value `protobuf:"bytes,4,opt,name=data,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Value" json:"data,omitempty"`
+	// unix nanoseconds the upload started at on the proposer, set with the first chunk
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *BlobChunkRequest) Reset()                    { *m = BlobChunkRequest{} }
+func (*BlobChunkRequest) ProtoMessage()               {}
+func (*BlobChunkRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
+
+type BlobChunkResponse struct {
+	ID    github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Index uint32                                         `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *BlobChunkResponse) Reset()                    { *m = BlobChunkResponse{} }
+func (*BlobChunkResponse) ProtoMessage()               {}
+func (*BlobChunkResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
+
+type BlobAbortRequest struct {
+	ID       github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	UploadID string                                         `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+}
+
+func (m *BlobAbortRequest) Reset()                    { *m = BlobAbortRequest{} }
+func (*BlobAbortRequest) ProtoMessage()               {}
+func (*BlobAbortRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
+
+type BlobAbortResponse struct {
+	ID github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	// DELETED when the chunks are dropped, NOOP when the upload is the committed one
+	Result WriteResult `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+}
+
+func (m *BlobAbortResponse) Reset()                    { *m = BlobAbortResponse{} }
+func (*BlobAbortResponse) ProtoMessage()               {}
+func (*BlobAbortResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
+
+// BlobUpload marks an upload from its first chunk until it is committed or aborted.
+type BlobUpload struct {
+	ID        github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Timestamp int64                                          `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *BlobUpload) Reset()                    { *m = BlobUpload{} }
+func (*BlobUpload) ProtoMessage()               {}
+func (*BlobUpload) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
+
+type BlobCommitRequest struct {
+	ID   github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Meta BlobMeta                                       `protobuf:"bytes,2,opt,name=meta" json:"meta"`
+}
+
+func (m *BlobCommitRequest) Reset()                    { *m = BlobCommitRequest{} }
+func (*BlobCommitRequest) ProtoMessage()               {}
+func (*BlobCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
+
+type BlobCommitResponse struct {
+	ID     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Result WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+}
+
+func (m *BlobCommitResponse) Reset()                    { *m = BlobCommitResponse{} }
+func (*BlobCommitResponse) ProtoMessage()               {}
+func (*BlobCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
+
+type PutBlobRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	ID                 github_com_tiglabs_baudengine_proto_metapb.Key         `protobuf:"bytes,3,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	// only content_type and metadata are taken from the client
+	Meta *BlobMeta `protobuf:"bytes,4,opt,name=meta" json:"meta,omitempty"`
+	Data []byte    `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PutBlobRequest) Reset()                    { *m = PutBlobRequest{} }
+func (*PutBlobRequest) ProtoMessage()               {}
+func (*PutBlobRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29} }
+
+type PutBlobResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ID                  github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,2,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Result              WriteResult                                    `protobuf:"varint,3,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	Size_               uint64                                         `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *PutBlobResponse) Reset()                    { *m = PutBlobResponse{} }
+func (*PutBlobResponse) ProtoMessage()               {}
+func (*PutBlobResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{30} }
+
+type GetBlobRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	ID                 github_com_tiglabs_baudengine_proto_metapb.Key         `protobuf:"bytes,3,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Offset             uint64                                                 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// read to the end of the blob if length is zero
+	Length uint64 `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *GetBlobRequest) Reset()                    { *m = GetBlobRequest{} }
+func (*GetBlobRequest) ProtoMessage()               {}
+func (*GetBlobRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{31} }
+
+type GetBlobResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Meta                *BlobMeta `protobuf:"bytes,2,opt,name=meta" json:"meta,omitempty"`
+	Data                []byte    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *GetBlobResponse) Reset()                    { *m = GetBlobResponse{} }
+func (*GetBlobResponse) ProtoMessage()               {}
+func (*GetBlobResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{32} }
+
+type DeleteBlobRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	ID                 github_com_tiglabs_baudengine_proto_metapb.Key         `protobuf:"bytes,3,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+}
+
+func (m *DeleteBlobRequest) Reset()                    { *m = DeleteBlobRequest{} }
+func (*DeleteBlobRequest) ProtoMessage()               {}
+func (*DeleteBlobRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{33} }
+
+type DeleteBlobResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Result              WriteResult `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+}
+
+func (m *DeleteBlobResponse) Reset()                    { *m = DeleteBlobResponse{} }
+func (*DeleteBlobResponse) ProtoMessage()               {}
+func (*DeleteBlobResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{34} }
+
+// ChangeEvent is recorded in the same engine batch as the write it describes.
+type ChangeEvent struct {
+	PartitionID github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	// raft index of the write, (partition_id, index) is the resume token
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// position of the write in its raft command
+	Position uint32                                         `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Type     ChangeType                                     `protobuf:"varint,4,opt,name=type,proto3,enum=ChangeType" json:"type,omitempty"`
+	ID       github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,5,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	// fields in json, before is empty for insert and after is empty for delete
+	Before github_com_tiglabs_baudengine_proto_metapb.Value `protobuf:"bytes,6,opt,name=before,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Value" json:"before,omitempty"`
+	After  github_com_tiglabs_baudengine_proto_metapb.Value `protobuf:"bytes,7,opt,name=after,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Value" json:"after,omitempty"`
+	// unix nano of the apply on the replica
+	Timestamp int64 `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ChangeEvent) Reset()                    { *m = ChangeEvent{} }
+func (*ChangeEvent) ProtoMessage()               {}
+func (*ChangeEvent) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{35} }
+
+type WatchChangesRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	// events with a raft index greater than from_index are sent, 0 starts at the oldest retained event
+	FromIndex uint64 `protobuf:"varint,3,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
+	// skip the retained events and send only the new ones
+	FromNow bool `protobuf:"varint,4,opt,name=from_now,json=fromNow,proto3" json:"from_now,omitempty"`
+}
+
+func (m *WatchChangesRequest) Reset()                    { *m = WatchChangesRequest{} }
+func (*WatchChangesRequest) ProtoMessage()               {}
+func (*WatchChangesRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{36} }
+
+// WatchChangesResponse carries all the events of the raft indexes it covers, so a consumer can
+// resume at the index of the last event received.
+type WatchChangesResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Events              []ChangeEvent `protobuf:"bytes,2,rep,name=events" json:"events"`
+}
+
+func (m *WatchChangesResponse) Reset()                    { *m = WatchChangesResponse{} }
+func (*WatchChangesResponse) ProtoMessage()               {}
+func (*WatchChangesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
+
+// TxnRecord is kept in the transaction space keyed by the transaction id, the intents of the
+// transaction are committed or aborted by its status.
+type TxnRecord struct {
+	TxnID  string    `protobuf:"bytes,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+	Status TxnStatus `protobuf:"varint,2,opt,name=status,proto3,enum=TxnStatus" json:"status,omitempty"`
+	// unix nano, a pending transaction past its deadline may be aborted by any reader
+	Deadline int64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// the documents written, whoever finishes the transaction resolves their intents
+	Keys []TxnKey `protobuf:"bytes,4,rep,name=keys" json:"keys"`
+}
+
+func (m *TxnRecord) Reset()                    { *m = TxnRecord{} }
+func (*TxnRecord) ProtoMessage()               {}
+func (*TxnRecord) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{38} }
+
+type TxnKey struct {
+	Space string                                         `protobuf:"bytes,1,opt,name=space,proto3" json:"space,omitempty"`
+	ID    github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,2,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+}
+
+func (m *TxnKey) Reset()                    { *m = TxnKey{} }
+func (*TxnKey) ProtoMessage()               {}
+func (*TxnKey) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{39} }
+
+// TxnIntent is the provisional write of a transaction on a document.
+type TxnIntent struct {
+	TxnID string `protobuf:"bytes,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+	// a create, update or delete request
+	Write RequestUnion `protobuf:"bytes,2,opt,name=write" json:"write"`
+}
+
+func (m *TxnIntent) Reset()                    { *m = TxnIntent{} }
+func (*TxnIntent) ProtoMessage()               {}
+func (*TxnIntent) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{40} }
+
+type TxnRecordRequest struct {
+	Record TxnRecord `protobuf:"bytes,1,opt,name=record" json:"record"`
+	// finish to aborted: with now set a pending record is aborted only if its deadline is before now
+	Now int64 `protobuf:"varint,2,opt,name=now,proto3" json:"now,omitempty"`
+}
+
+func (m *TxnRecordRequest) Reset()                    { *m = TxnRecordRequest{} }
+func (*TxnRecordRequest) ProtoMessage()               {}
+func (*TxnRecordRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41} }
+
+type TxnRecordResponse struct {
+	Result WriteResult `protobuf:"varint,1,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	// the record after the write
+	Record TxnRecord `protobuf:"bytes,2,opt,name=record" json:"record"`
+}
+
+func (m *TxnRecordResponse) Reset()                    { *m = TxnRecordResponse{} }
+func (*TxnRecordResponse) ProtoMessage()               {}
+func (*TxnRecordResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{42} }
+
+type TxnPrepareRequest struct {
+	Intent TxnIntent `protobuf:"bytes,1,opt,name=intent" json:"intent"`
+}
+
+func (m *TxnPrepareRequest) Reset()                    { *m = TxnPrepareRequest{} }
+func (*TxnPrepareRequest) ProtoMessage()               {}
+func (*TxnPrepareRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{43} }
+
+type TxnPrepareResponse struct {
+	ID     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Result WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	// the intent of another transaction found on the document
+	Conflict *TxnIntent `protobuf:"bytes,3,opt,name=conflict" json:"conflict,omitempty"`
+}
+
+func (m *TxnPrepareResponse) Reset()                    { *m = TxnPrepareResponse{} }
+func (*TxnPrepareResponse) ProtoMessage()               {}
+func (*TxnPrepareResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44} }
+
+type TxnResolveRequest struct {
+	ID     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	TxnID  string                                         `protobuf:"bytes,2,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+	Commit bool                                           `protobuf:"varint,3,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (m *TxnResolveRequest) Reset()                    { *m = TxnResolveRequest{} }
+func (*TxnResolveRequest) ProtoMessage()               {}
+func (*TxnResolveRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45} }
+
+type TxnResolveResponse struct {
+	ID github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	// NOOP if the intent of the transaction is not on the document
+	Result WriteResult `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+}
+
+func (m *TxnResolveResponse) Reset()                    { *m = TxnResolveResponse{} }
+func (*TxnResolveResponse) ProtoMessage()               {}
+func (*TxnResolveResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{46} }
 
 func init() {
 	proto.RegisterType((*RequestUnion)(nil), "RequestUnion")
@@ -197,9 +784,49 @@ func init() {
 	proto.RegisterType((*UpdateResponse)(nil), "UpdateResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "DeleteResponse")
+	proto.RegisterType((*Precondition)(nil), "Precondition")
+	proto.RegisterType((*BulkRequest)(nil), "BulkRequest")
+	proto.RegisterType((*BulkResponse)(nil), "BulkResponse")
+	proto.RegisterType((*GetRequest)(nil), "GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "GetResponse")
+	proto.RegisterType((*FieldFilter)(nil), "FieldFilter")
+	proto.RegisterType((*MultiGetRequest)(nil), "MultiGetRequest")
+	proto.RegisterType((*MultiGetDoc)(nil), "MultiGetDoc")
+	proto.RegisterType((*MultiGetResponse)(nil), "MultiGetResponse")
+	proto.RegisterType((*SearchRequest)(nil), "SearchRequest")
+	proto.RegisterType((*SearchHit)(nil), "SearchHit")
+	proto.RegisterType((*SearchResponse)(nil), "SearchResponse")
 	proto.RegisterType((*Failure)(nil), "Failure")
+	proto.RegisterType((*BlobMeta)(nil), "BlobMeta")
+	proto.RegisterType((*BlobChunkRequest)(nil), "BlobChunkRequest")
+	proto.RegisterType((*BlobChunkResponse)(nil), "BlobChunkResponse")
+	proto.RegisterType((*BlobAbortRequest)(nil), "BlobAbortRequest")
+	proto.RegisterType((*BlobAbortResponse)(nil), "BlobAbortResponse")
+	proto.RegisterType((*BlobUpload)(nil), "BlobUpload")
+	proto.RegisterType((*BlobCommitRequest)(nil), "BlobCommitRequest")
+	proto.RegisterType((*BlobCommitResponse)(nil), "BlobCommitResponse")
+	proto.RegisterType((*PutBlobRequest)(nil), "PutBlobRequest")
+	proto.RegisterType((*PutBlobResponse)(nil), "PutBlobResponse")
+	proto.RegisterType((*GetBlobRequest)(nil), "GetBlobRequest")
+	proto.RegisterType((*GetBlobResponse)(nil), "GetBlobResponse")
+	proto.RegisterType((*DeleteBlobRequest)(nil), "DeleteBlobRequest")
+	proto.RegisterType((*DeleteBlobResponse)(nil), "DeleteBlobResponse")
+	proto.RegisterType((*ChangeEvent)(nil), "ChangeEvent")
+	proto.RegisterType((*WatchChangesRequest)(nil), "WatchChangesRequest")
+	proto.RegisterType((*WatchChangesResponse)(nil), "WatchChangesResponse")
+	proto.RegisterType((*TxnRecord)(nil), "TxnRecord")
+	proto.RegisterType((*TxnKey)(nil), "TxnKey")
+	proto.RegisterType((*TxnIntent)(nil), "TxnIntent")
+	proto.RegisterType((*TxnRecordRequest)(nil), "TxnRecordRequest")
+	proto.RegisterType((*TxnRecordResponse)(nil), "TxnRecordResponse")
+	proto.RegisterType((*TxnPrepareRequest)(nil), "TxnPrepareRequest")
+	proto.RegisterType((*TxnPrepareResponse)(nil), "TxnPrepareResponse")
+	proto.RegisterType((*TxnResolveRequest)(nil), "TxnResolveRequest")
+	proto.RegisterType((*TxnResolveResponse)(nil), "TxnResolveResponse")
 	proto.RegisterEnum("OpType", OpType_name, OpType_value)
 	proto.RegisterEnum("WriteResult", WriteResult_name, WriteResult_value)
+	proto.RegisterEnum("ChangeType", ChangeType_name, ChangeType_value)
+	proto.RegisterEnum("TxnStatus", TxnStatus_name, TxnStatus_value)
 }
 func (this *RequestUnion) Equal(that interface{}) bool {
 	if that == nil {
@@ -232,6 +859,27 @@ func (this *RequestUnion) Equal(that interface{}) bool {
 	if !this.Delete.Equal(that1.Delete) {
 		return false
 	}
+	if !this.BlobChunk.Equal(that1.BlobChunk) {
+		return false
+	}
+	if !this.BlobCommit.Equal(that1.BlobCommit) {
+		return false
+	}
+	if !this.Precondition.Equal(that1.Precondition) {
+		return false
+	}
+	if !this.TxnRecord.Equal(that1.TxnRecord) {
+		return false
+	}
+	if !this.TxnPrepare.Equal(that1.TxnPrepare) {
+		return false
+	}
+	if !this.TxnResolve.Equal(that1.TxnResolve) {
+		return false
+	}
+	if !this.BlobAbort.Equal(that1.BlobAbort) {
+		return false
+	}
 	return true
 }
 func (this *ResponseUnion) Equal(that interface{}) bool {
@@ -268,6 +916,24 @@ func (this *ResponseUnion) Equal(that interface{}) bool {
 	if !this.Failure.Equal(that1.Failure) {
 		return false
 	}
+	if !this.BlobChunk.Equal(that1.BlobChunk) {
+		return false
+	}
+	if !this.BlobCommit.Equal(that1.BlobCommit) {
+		return false
+	}
+	if !this.TxnRecord.Equal(that1.TxnRecord) {
+		return false
+	}
+	if !this.TxnPrepare.Equal(that1.TxnPrepare) {
+		return false
+	}
+	if !this.TxnResolve.Equal(that1.TxnResolve) {
+		return false
+	}
+	if !this.BlobAbort.Equal(that1.BlobAbort) {
+		return false
+	}
 	return true
 }
 func (this *CreateRequest) Equal(that interface{}) bool {
@@ -432,14 +1098,14 @@ func (this *DeleteResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Failure) Equal(that interface{}) bool {
+func (this *Precondition) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Failure)
+	that1, ok := that.(*Precondition)
 	if !ok {
-		that2, ok := that.(Failure)
+		that2, ok := that.(Precondition)
 		if ok {
 			that1 = &that2
 		} else {
@@ -451,824 +1117,11744 @@ func (this *Failure) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.ID, that1.ID) {
+	if this.Exists != that1.Exists {
 		return false
 	}
-	if this.Cause != that1.Cause {
+	if this.NotExists != that1.NotExists {
+		return false
+	}
+	if !bytes.Equal(this.Match, that1.Match) {
 		return false
 	}
 	return true
 }
-func (m *RequestUnion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *BulkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *RequestUnion) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
-	}
-	if m.Create != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
-		n1, err := m.Create.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*BulkRequest)
+	if !ok {
+		that2, ok := that.(BulkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n1
 	}
-	if m.Update != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
-		n2, err := m.Update.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
-		n3, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if len(this.Requests) != len(that1.Requests) {
+		return false
+	}
+	for i := range this.Requests {
+		if !this.Requests[i].Equal(&that1.Requests[i]) {
+			return false
 		}
-		i += n3
 	}
-	return i, nil
-}
-
-func (m *ResponseUnion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Atomic != that1.Atomic {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *BulkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ResponseUnion) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
-	}
-	if m.Create != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
-		n4, err := m.Create.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*BulkResponse)
+	if !ok {
+		that2, ok := that.(BulkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n4
 	}
-	if m.Update != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
-		n5, err := m.Update.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
-		n6, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	if m.Failure != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Failure.Size()))
-		n7, err := m.Failure.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(this.Responses) != len(that1.Responses) {
+		return false
+	}
+	for i := range this.Responses {
+		if !this.Responses[i].Equal(&that1.Responses[i]) {
+			return false
 		}
-		i += n7
 	}
-	return i, nil
+	return true
 }
-
-func (m *CreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *GetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *CreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	that1, ok := that.(*GetRequest)
+	if !ok {
+		that2, ok := that.(GetRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *CreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if this.PartitionID != that1.PartitionID {
+		return false
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *GetResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.Upsert {
-		dAtA[i] = 0x18
-		i++
-		if m.Upsert {
-			dAtA[i] = 1
+	that1, ok := that.(*GetResponse)
+	if !ok {
+		that2, ok := that.(GetResponse)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	return i, nil
-}
-
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if this.Found != that1.Found {
+		return false
 	}
-	return i, nil
-}
-
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if !this.Intent.Equal(that1.Intent) {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *FieldFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	that1, ok := that.(*FieldFilter)
+	if !ok {
+		that2, ok := that.(FieldFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Failure) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Failure) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if this.Field != that1.Field {
+		return false
 	}
-	if len(m.Cause) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Cause)))
-		i += copy(dAtA[i:], m.Cause)
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
 	}
-	return i, nil
+	return true
 }
+func (this *MultiGetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*MultiGetRequest)
+	if !ok {
+		that2, ok := that.(MultiGetRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func NewPopulatedRequestUnion(r randyApi, easy bool) *RequestUnion {
-	this := &RequestUnion{}
-	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
-	if r.Intn(10) != 0 {
-		this.Create = NewPopulatedCreateRequest(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Update = NewPopulatedUpdateRequest(r, easy)
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Delete = NewPopulatedDeleteRequest(r, easy)
+	if this.PartitionID != that1.PartitionID {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(this.IDs) != len(that1.IDs) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedResponseUnion(r randyApi, easy bool) *ResponseUnion {
-	this := &ResponseUnion{}
-	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
-	if r.Intn(10) != 0 {
-		this.Create = NewPopulatedCreateResponse(r, easy)
+	for i := range this.IDs {
+		if !bytes.Equal(this.IDs[i], that1.IDs[i]) {
+			return false
+		}
 	}
-	if r.Intn(10) != 0 {
-		this.Update = NewPopulatedUpdateResponse(r, easy)
+	if len(this.FilteredIDs) != len(that1.FilteredIDs) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Delete = NewPopulatedDeleteResponse(r, easy)
+	for i := range this.FilteredIDs {
+		if !bytes.Equal(this.FilteredIDs[i], that1.FilteredIDs[i]) {
+			return false
+		}
 	}
-	if r.Intn(10) != 0 {
-		this.Failure = NewPopulatedFailure(r, easy)
+	if len(this.Filters) != len(that1.Filters) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.Filters {
+		if !this.Filters[i].Equal(&that1.Filters[i]) {
+			return false
+		}
 	}
-	return this
+	return true
 }
+func (this *MultiGetDoc) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedCreateRequest(r randyApi, easy bool) *CreateRequest {
-	this := &CreateRequest{}
-	v1 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v1)
-	for i := 0; i < v1; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	that1, ok := that.(*MultiGetDoc)
+	if !ok {
+		that2, ok := that.(MultiGetDoc)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	v2 := r.Intn(100)
-	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v2)
-	for i := 0; i < v2; i++ {
-		this.Data[i] = byte(r.Intn(256))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
 	}
-	return this
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
 }
+func (this *MultiGetResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedCreateResponse(r randyApi, easy bool) *CreateResponse {
-	this := &CreateResponse{}
-	v3 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v3)
-	for i := 0; i < v3; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	that1, ok := that.(*MultiGetResponse)
+	if !ok {
+		that2, ok := that.(MultiGetResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedUpdateRequest(r randyApi, easy bool) *UpdateRequest {
-	this := &UpdateRequest{}
-	v4 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v4)
-	for i := 0; i < v4; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	v5 := r.Intn(100)
-	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v5)
-	for i := 0; i < v5; i++ {
-		this.Data[i] = byte(r.Intn(256))
+	if len(this.Docs) != len(that1.Docs) {
+		return false
 	}
-	this.Upsert = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.Docs {
+		if !this.Docs[i].Equal(&that1.Docs[i]) {
+			return false
+		}
 	}
-	return this
+	return true
 }
+func (this *SearchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedUpdateResponse(r randyApi, easy bool) *UpdateResponse {
-	this := &UpdateResponse{}
-	v6 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v6)
-	for i := 0; i < v6; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	that1, ok := that.(*SearchRequest)
+	if !ok {
+		that2, ok := that.(SearchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedDeleteRequest(r randyApi, easy bool) *DeleteRequest {
-	this := &DeleteRequest{}
-	v7 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v7)
-	for i := 0; i < v7; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.PartitionID != that1.PartitionID {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedDeleteResponse(r randyApi, easy bool) *DeleteResponse {
-	this := &DeleteResponse{}
-	v8 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v8)
-	for i := 0; i < v8; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	if !bytes.Equal(this.Query, that1.Query) {
+		return false
 	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+	if this.From != that1.From {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedFailure(r randyApi, easy bool) *Failure {
-	this := &Failure{}
-	v9 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v9)
-	for i := 0; i < v9; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	if this.Limit != that1.Limit {
+		return false
 	}
-	this.Cause = string(randStringApi(r))
-	if !easy && r.Intn(10) != 0 {
+	if len(this.Sort) != len(that1.Sort) {
+		return false
 	}
-	return this
-}
-
-type randyApi interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneApi(r randyApi) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	for i := range this.Sort {
+		if this.Sort[i] != that1.Sort[i] {
+			return false
+		}
 	}
-	return rune(ru + 61)
-}
-func randStringApi(r randyApi) string {
-	v10 := r.Intn(100)
-	tmps := make([]rune, v10)
-	for i := 0; i < v10; i++ {
-		tmps[i] = randUTF8RuneApi(r)
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	return string(tmps)
-}
-func randUnrecognizedApi(r randyApi, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApi(dAtA, r, fieldNumber, wire)
 	}
-	return dAtA
-}
-func randFieldApi(dAtA []byte, r randyApi, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		v11 := r.Int63()
-		if r.Intn(2) == 0 {
-			v11 *= -1
-		}
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(v11))
-	case 1:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	if !bytes.Equal(this.Aggregation, that1.Aggregation) {
+		return false
 	}
-	return dAtA
-}
-func encodeVarintPopulateApi(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	if this.ScrollID != that1.ScrollID {
+		return false
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	if this.KeepAlive != that1.KeepAlive {
+		return false
+	}
+	return true
 }
-func (m *RequestUnion) Size() (n int) {
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		n += 1 + sovApi(uint64(m.OpType))
+func (this *SearchHit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Create != nil {
-		l = m.Create.Size()
-		n += 1 + l + sovApi(uint64(l))
+
+	that1, ok := that.(*SearchHit)
+	if !ok {
+		that2, ok := that.(SearchHit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
 	}
-	return n
-}
-
-func (m *ResponseUnion) Size() (n int) {
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		n += 1 + sovApi(uint64(m.OpType))
+	if this.Score != that1.Score {
+		return false
 	}
-	if m.Create != nil {
-		l = m.Create.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if !bytes.Equal(this.Source, that1.Source) {
+		return false
 	}
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(this.Sort) != len(that1.Sort) {
+		return false
 	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovApi(uint64(l))
+	for i := range this.Sort {
+		if this.Sort[i] != that1.Sort[i] {
+			return false
+		}
 	}
-	if m.Failure != nil {
-		l = m.Failure.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if this.Intent != that1.Intent {
+		return false
 	}
-	return n
+	return true
 }
+func (this *SearchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*SearchResponse)
+	if !ok {
+		that2, ok := that.(SearchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *CreateResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if this.Total != that1.Total {
+		return false
 	}
-	return n
-}
-
-func (m *UpdateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(this.Hits) != len(that1.Hits) {
+		return false
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	for i := range this.Hits {
+		if !this.Hits[i].Equal(&that1.Hits[i]) {
+			return false
+		}
 	}
-	if m.Upsert {
-		n += 2
+	if !bytes.Equal(this.Buckets, that1.Buckets) {
+		return false
 	}
-	return n
+	if this.ScrollID != that1.ScrollID {
+		return false
+	}
+	return true
 }
+func (this *Failure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*Failure)
+	if !ok {
+		that2, ok := that.(Failure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *DeleteRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
 	}
-	return n
-}
-
-func (m *DeleteResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.Cause != that1.Cause {
+		return false
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if this.Aborted != that1.Aborted {
+		return false
 	}
-	return n
+	return true
 }
+func (this *BlobMeta) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Failure) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*BlobMeta)
+	if !ok {
+		that2, ok := that.(BlobMeta)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Cause)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func sovApi(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	if this.UploadID != that1.UploadID {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	if this.ChunkSize != that1.ChunkSize {
+		return false
+	}
+	if this.Chunks != that1.Chunks {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
+		return false
+	}
+	if len(this.Metadata) != len(that1.Metadata) {
+		return false
+	}
+	for i := range this.Metadata {
+		if this.Metadata[i] != that1.Metadata[i] {
+			return false
 		}
 	}
-	return n
-}
-func sozApi(x uint64) (n int) {
-	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return true
 }
-func (this *RequestUnion) String() string {
-	if this == nil {
-		return "nil"
+func (this *BlobChunkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&RequestUnion{`,
-		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
-		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateRequest", "CreateRequest", 1) + `,`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
-		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteRequest", "DeleteRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResponseUnion) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*BlobChunkRequest)
+	if !ok {
+		that2, ok := that.(BlobChunkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&ResponseUnion{`,
-		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
-		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateResponse", "CreateResponse", 1) + `,`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateResponse", "UpdateResponse", 1) + `,`,
-		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteResponse", "DeleteResponse", 1) + `,`,
-		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "Failure", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&CreateRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.UploadID != that1.UploadID {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
 }
-func (this *CreateResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *BlobChunkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&CreateResponse{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*BlobChunkResponse)
+	if !ok {
+		that2, ok := that.(BlobChunkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
 }
-func (this *UpdateRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *BlobAbortRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&UpdateRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`Upsert:` + fmt.Sprintf("%v", this.Upsert) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*BlobAbortRequest)
+	if !ok {
+		that2, ok := that.(BlobAbortRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.UploadID != that1.UploadID {
+		return false
+	}
+	return true
 }
-func (this *UpdateResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *BlobAbortResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&UpdateResponse{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*BlobAbortResponse)
+	if !ok {
+		that2, ok := that.(BlobAbortResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	return true
 }
-func (this *DeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *BlobUpload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&DeleteRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*BlobUpload)
+	if !ok {
+		that2, ok := that.(BlobUpload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
 }
-func (this *DeleteResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *BlobCommitRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&DeleteResponse{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*BlobCommitRequest)
+	if !ok {
+		that2, ok := that.(BlobCommitRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if !this.Meta.Equal(&that1.Meta) {
+		return false
+	}
+	return true
 }
-func (this *Failure) String() string {
-	if this == nil {
-		return "nil"
+func (this *BlobCommitResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&Failure{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*BlobCommitResponse)
+	if !ok {
+		that2, ok := that.(BlobCommitResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	return true
 }
-func valueToStringApi(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (this *PutBlobRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	that1, ok := that.(*PutBlobRequest)
+	if !ok {
+		that2, ok := that.(PutBlobRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if !this.Meta.Equal(that1.Meta) {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *PutBlobResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PutBlobResponse)
+	if !ok {
+		that2, ok := that.(PutBlobResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	return true
+}
+func (this *GetBlobRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetBlobRequest)
+	if !ok {
+		that2, ok := that.(GetBlobRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	return true
+}
+func (this *GetBlobResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetBlobResponse)
+	if !ok {
+		that2, ok := that.(GetBlobResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if !this.Meta.Equal(that1.Meta) {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *DeleteBlobRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteBlobRequest)
+	if !ok {
+		that2, ok := that.(DeleteBlobRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	return true
+}
+func (this *DeleteBlobResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteBlobResponse)
+	if !ok {
+		that2, ok := that.(DeleteBlobResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	return true
+}
+func (this *ChangeEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChangeEvent)
+	if !ok {
+		that2, ok := that.(ChangeEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Position != that1.Position {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if !bytes.Equal(this.Before, that1.Before) {
+		return false
+	}
+	if !bytes.Equal(this.After, that1.After) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *WatchChangesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchChangesRequest)
+	if !ok {
+		that2, ok := that.(WatchChangesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if this.FromIndex != that1.FromIndex {
+		return false
+	}
+	if this.FromNow != that1.FromNow {
+		return false
+	}
+	return true
+}
+func (this *WatchChangesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchChangesResponse)
+	if !ok {
+		that2, ok := that.(WatchChangesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !this.Events[i].Equal(&that1.Events[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TxnRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnRecord)
+	if !ok {
+		that2, ok := that.(TxnRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxnID != that1.TxnID {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !this.Keys[i].Equal(&that1.Keys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TxnKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnKey)
+	if !ok {
+		that2, ok := that.(TxnKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Space != that1.Space {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	return true
+}
+func (this *TxnIntent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnIntent)
+	if !ok {
+		that2, ok := that.(TxnIntent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxnID != that1.TxnID {
+		return false
+	}
+	if !this.Write.Equal(&that1.Write) {
+		return false
+	}
+	return true
+}
+func (this *TxnRecordRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnRecordRequest)
+	if !ok {
+		that2, ok := that.(TxnRecordRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Record.Equal(&that1.Record) {
+		return false
+	}
+	if this.Now != that1.Now {
+		return false
+	}
+	return true
+}
+func (this *TxnRecordResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnRecordResponse)
+	if !ok {
+		that2, ok := that.(TxnRecordResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if !this.Record.Equal(&that1.Record) {
+		return false
+	}
+	return true
+}
+func (this *TxnPrepareRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnPrepareRequest)
+	if !ok {
+		that2, ok := that.(TxnPrepareRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Intent.Equal(&that1.Intent) {
+		return false
+	}
+	return true
+}
+func (this *TxnPrepareResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnPrepareResponse)
+	if !ok {
+		that2, ok := that.(TxnPrepareResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if !this.Conflict.Equal(that1.Conflict) {
+		return false
+	}
+	return true
+}
+func (this *TxnResolveRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnResolveRequest)
+	if !ok {
+		that2, ok := that.(TxnResolveRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.TxnID != that1.TxnID {
+		return false
+	}
+	if this.Commit != that1.Commit {
+		return false
+	}
+	return true
+}
+func (this *TxnResolveResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnResolveResponse)
+	if !ok {
+		that2, ok := that.(TxnResolveResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiGrpc service
+
+type ApiGrpcClient interface {
+	// BulkWrite applies the writes of one partition in a single raft command, with atomic set
+	// they all commit or none does.
+	BulkWrite(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	// Get reads a document with the transaction intent laid on it.
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// MultiGet reads a batch of documents of a partition, the filters are evaluated there.
+	MultiGet(ctx context.Context, in *MultiGetRequest, opts ...grpc.CallOption) (*MultiGetResponse, error)
+	// Search runs a query on the index of a partition, the hits are sorted and cut there.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// PutBlob uploads a blob, the first message carries the id and meta, the following ones the data.
+	PutBlob(ctx context.Context, opts ...grpc.CallOption) (ApiGrpc_PutBlobClient, error)
+	// GetBlob downloads a range of a blob, the first message carries the meta.
+	GetBlob(ctx context.Context, in *GetBlobRequest, opts ...grpc.CallOption) (ApiGrpc_GetBlobClient, error)
+	DeleteBlob(ctx context.Context, in *DeleteBlobRequest, opts ...grpc.CallOption) (*DeleteBlobResponse, error)
+	// WatchChanges streams the document changes of a partition after from_index, then tails new ones.
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (ApiGrpc_WatchChangesClient, error)
+}
+
+type apiGrpcClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiGrpcClient(cc *grpc.ClientConn) ApiGrpcClient {
+	return &apiGrpcClient{cc}
+}
+
+func (c *apiGrpcClient) BulkWrite(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/BulkWrite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) MultiGet(ctx context.Context, in *MultiGetRequest, opts ...grpc.CallOption) (*MultiGetResponse, error) {
+	out := new(MultiGetResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/MultiGet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) PutBlob(ctx context.Context, opts ...grpc.CallOption) (ApiGrpc_PutBlobClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiGrpc_serviceDesc.Streams[0], c.cc, "/ApiGrpc/PutBlob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiGrpcPutBlobClient{stream}
+	return x, nil
+}
+
+type ApiGrpc_PutBlobClient interface {
+	Send(*PutBlobRequest) error
+	CloseAndRecv() (*PutBlobResponse, error)
+	grpc.ClientStream
+}
+
+type apiGrpcPutBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiGrpcPutBlobClient) Send(m *PutBlobRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *apiGrpcPutBlobClient) CloseAndRecv() (*PutBlobResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PutBlobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiGrpcClient) GetBlob(ctx context.Context, in *GetBlobRequest, opts ...grpc.CallOption) (ApiGrpc_GetBlobClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiGrpc_serviceDesc.Streams[1], c.cc, "/ApiGrpc/GetBlob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiGrpcGetBlobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiGrpc_GetBlobClient interface {
+	Recv() (*GetBlobResponse, error)
+	grpc.ClientStream
+}
+
+type apiGrpcGetBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiGrpcGetBlobClient) Recv() (*GetBlobResponse, error) {
+	m := new(GetBlobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiGrpcClient) DeleteBlob(ctx context.Context, in *DeleteBlobRequest, opts ...grpc.CallOption) (*DeleteBlobResponse, error) {
+	out := new(DeleteBlobResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/DeleteBlob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (ApiGrpc_WatchChangesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiGrpc_serviceDesc.Streams[2], c.cc, "/ApiGrpc/WatchChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiGrpcWatchChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiGrpc_WatchChangesClient interface {
+	Recv() (*WatchChangesResponse, error)
+	grpc.ClientStream
+}
+
+type apiGrpcWatchChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiGrpcWatchChangesClient) Recv() (*WatchChangesResponse, error) {
+	m := new(WatchChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for ApiGrpc service
+
+type ApiGrpcServer interface {
+	// BulkWrite applies the writes of one partition in a single raft command, with atomic set
+	// they all commit or none does.
+	BulkWrite(context.Context, *BulkRequest) (*BulkResponse, error)
+	// Get reads a document with the transaction intent laid on it.
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// MultiGet reads a batch of documents of a partition, the filters are evaluated there.
+	MultiGet(context.Context, *MultiGetRequest) (*MultiGetResponse, error)
+	// Search runs a query on the index of a partition, the hits are sorted and cut there.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// PutBlob uploads a blob, the first message carries the id and meta, the following ones the data.
+	PutBlob(ApiGrpc_PutBlobServer) error
+	// GetBlob downloads a range of a blob, the first message carries the meta.
+	GetBlob(*GetBlobRequest, ApiGrpc_GetBlobServer) error
+	DeleteBlob(context.Context, *DeleteBlobRequest) (*DeleteBlobResponse, error)
+	// WatchChanges streams the document changes of a partition after from_index, then tails new ones.
+	WatchChanges(*WatchChangesRequest, ApiGrpc_WatchChangesServer) error
+}
+
+func RegisterApiGrpcServer(s *grpc.Server, srv ApiGrpcServer) {
+	s.RegisterService(&_ApiGrpc_serviceDesc, srv)
+}
+
+func _ApiGrpc_BulkWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).BulkWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/BulkWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).BulkWrite(ctx, req.(*BulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_MultiGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).MultiGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/MultiGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).MultiGet(ctx, req.(*MultiGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_PutBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApiGrpcServer).PutBlob(&apiGrpcPutBlobServer{stream})
+}
+
+type ApiGrpc_PutBlobServer interface {
+	SendAndClose(*PutBlobResponse) error
+	Recv() (*PutBlobRequest, error)
+	grpc.ServerStream
+}
+
+type apiGrpcPutBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiGrpcPutBlobServer) SendAndClose(m *PutBlobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *apiGrpcPutBlobServer) Recv() (*PutBlobRequest, error) {
+	m := new(PutBlobRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ApiGrpc_GetBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBlobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiGrpcServer).GetBlob(m, &apiGrpcGetBlobServer{stream})
+}
+
+type ApiGrpc_GetBlobServer interface {
+	Send(*GetBlobResponse) error
+	grpc.ServerStream
+}
+
+type apiGrpcGetBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiGrpcGetBlobServer) Send(m *GetBlobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiGrpc_DeleteBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).DeleteBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/DeleteBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).DeleteBlob(ctx, req.(*DeleteBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiGrpcServer).WatchChanges(m, &apiGrpcWatchChangesServer{stream})
+}
+
+type ApiGrpc_WatchChangesServer interface {
+	Send(*WatchChangesResponse) error
+	grpc.ServerStream
+}
+
+type apiGrpcWatchChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiGrpcWatchChangesServer) Send(m *WatchChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ApiGrpc_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ApiGrpc",
+	HandlerType: (*ApiGrpcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BulkWrite",
+			Handler:    _ApiGrpc_BulkWrite_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ApiGrpc_Get_Handler,
+		},
+		{
+			MethodName: "MultiGet",
+			Handler:    _ApiGrpc_MultiGet_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _ApiGrpc_Search_Handler,
+		},
+		{
+			MethodName: "DeleteBlob",
+			Handler:    _ApiGrpc_DeleteBlob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PutBlob",
+			Handler:       _ApiGrpc_PutBlob_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetBlob",
+			Handler:       _ApiGrpc_GetBlob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _ApiGrpc_WatchChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
+
+func (m *RequestUnion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestUnion) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
+	}
+	if m.Create != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
+		n1, err := m.Create.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Update != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
+		n2, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Delete != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
+		n3, err := m.Delete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.BlobChunk != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BlobChunk.Size()))
+		n4, err := m.BlobChunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.BlobCommit != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BlobCommit.Size()))
+		n5, err := m.BlobCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Precondition != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Precondition.Size()))
+		n6, err := m.Precondition.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.TxnRecord != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxnRecord.Size()))
+		n7, err := m.TxnRecord.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.TxnPrepare != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxnPrepare.Size()))
+		n8, err := m.TxnPrepare.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.TxnResolve != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxnResolve.Size()))
+		n9, err := m.TxnResolve.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.BlobAbort != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BlobAbort.Size()))
+		n10, err := m.BlobAbort.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *ResponseUnion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseUnion) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
+	}
+	if m.Create != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
+		n11, err := m.Create.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Update != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
+		n12, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Delete != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
+		n13, err := m.Delete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Failure != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Failure.Size()))
+		n14, err := m.Failure.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.BlobChunk != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BlobChunk.Size()))
+		n15, err := m.BlobChunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.BlobCommit != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BlobCommit.Size()))
+		n16, err := m.BlobCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.TxnRecord != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxnRecord.Size()))
+		n17, err := m.TxnRecord.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.TxnPrepare != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxnPrepare.Size()))
+		n18, err := m.TxnPrepare.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.TxnResolve != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxnResolve.Size()))
+		n19, err := m.TxnResolve.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.BlobAbort != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BlobAbort.Size()))
+		n20, err := m.BlobAbort.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	return i, nil
+}
+
+func (m *CreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *CreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	return i, nil
+}
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Upsert {
+		dAtA[i] = 0x18
+		i++
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	return i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	return i, nil
+}
+
+func (m *Precondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Precondition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		dAtA[i] = 0x8
+		i++
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.NotExists {
+		dAtA[i] = 0x10
+		i++
+		if m.NotExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Match) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Match)))
+		i += copy(dAtA[i:], m.Match)
+	}
+	return i, nil
+}
+
+func (m *BulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n21, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Atomic {
+		dAtA[i] = 0x20
+		i++
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *BulkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n22, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
+	if len(m.Responses) > 0 {
+		for _, msg := range m.Responses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n23, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n23
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n24, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n24
+	if m.Found {
+		dAtA[i] = 0x10
+		i++
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Intent != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Intent.Size()))
+		n25, err := m.Intent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+
+func (m *FieldFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *MultiGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n26, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.IDs) > 0 {
+		for _, b := range m.IDs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.FilteredIDs) > 0 {
+		for _, b := range m.FilteredIDs {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Filters) > 0 {
+		for _, msg := range m.Filters {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MultiGetDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiGetDoc) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *MultiGetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiGetResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n27, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n27
+	if len(m.Docs) > 0 {
+		for _, msg := range m.Docs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n28, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.Query) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
+	}
+	if m.From != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.From))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Limit))
+	}
+	if len(m.Sort) > 0 {
+		for _, s := range m.Sort {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Aggregation) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Aggregation)))
+		i += copy(dAtA[i:], m.Aggregation)
+	}
+	if len(m.ScrollID) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ScrollID)))
+		i += copy(dAtA[i:], m.ScrollID)
+	}
+	if m.KeepAlive != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.KeepAlive))
+	}
+	return i, nil
+}
+
+func (m *SearchHit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchHit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Score != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i += 8
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Sort) > 0 {
+		for _, s := range m.Sort {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Intent {
+		dAtA[i] = 0x28
+		i++
+		if m.Intent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n29, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Total))
+	}
+	if len(m.Hits) > 0 {
+		for _, msg := range m.Hits {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Buckets) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Buckets)))
+		i += copy(dAtA[i:], m.Buckets)
+	}
+	if len(m.ScrollID) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ScrollID)))
+		i += copy(dAtA[i:], m.ScrollID)
+	}
+	return i, nil
+}
+
+func (m *Failure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Failure) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Cause) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Cause)))
+		i += copy(dAtA[i:], m.Cause)
+	}
+	if m.Aborted {
+		dAtA[i] = 0x18
+		i++
+		if m.Aborted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *BlobMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobMeta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.UploadID)))
+		i += copy(dAtA[i:], m.UploadID)
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Size_))
+	}
+	if m.ChunkSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ChunkSize))
+	}
+	if m.Chunks != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Chunks))
+	}
+	if len(m.ContentType) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ContentType)))
+		i += copy(dAtA[i:], m.ContentType)
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x32
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *BlobChunkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobChunkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.UploadID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.UploadID)))
+		i += copy(dAtA[i:], m.UploadID)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func (m *BlobChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *BlobAbortRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobAbortRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.UploadID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.UploadID)))
+		i += copy(dAtA[i:], m.UploadID)
+	}
+	return i, nil
+}
+
+func (m *BlobAbortResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobAbortResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	return i, nil
+}
+
+func (m *BlobUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobUpload) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func (m *BlobCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Meta.Size()))
+	n30, err := m.Meta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
+	return i, nil
+}
+
+func (m *BlobCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	return i, nil
+}
+
+func (m *PutBlobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutBlobRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n31, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n31
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Meta != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Meta.Size()))
+		n32, err := m.Meta.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *PutBlobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutBlobResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n33, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n33
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Size_))
+	}
+	return i, nil
+}
+
+func (m *GetBlobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlobRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n34, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n34
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Length))
+	}
+	return i, nil
+}
+
+func (m *GetBlobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlobResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n35, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n35
+	if m.Meta != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Meta.Size()))
+		n36, err := m.Meta.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *DeleteBlobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteBlobRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n37, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *DeleteBlobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteBlobResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n38, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n38
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	return i, nil
+}
+
+func (m *ChangeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Index))
+	}
+	if m.Position != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Position))
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Type))
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Before) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Before)))
+		i += copy(dAtA[i:], m.Before)
+	}
+	if len(m.After) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.After)))
+		i += copy(dAtA[i:], m.After)
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func (m *WatchChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n39, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n39
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if m.FromIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.FromIndex))
+	}
+	if m.FromNow {
+		dAtA[i] = 0x20
+		i++
+		if m.FromNow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *WatchChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n40, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n40
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TxnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxnID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TxnID)))
+		i += copy(dAtA[i:], m.TxnID)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Status))
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Deadline))
+	}
+	if len(m.Keys) > 0 {
+		for _, msg := range m.Keys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TxnKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Space) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Space)))
+		i += copy(dAtA[i:], m.Space)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *TxnIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnIntent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxnID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TxnID)))
+		i += copy(dAtA[i:], m.TxnID)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Write.Size()))
+	n41, err := m.Write.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n41
+	return i, nil
+}
+
+func (m *TxnRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Record.Size()))
+	n42, err := m.Record.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n42
+	if m.Now != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Now))
+	}
+	return i, nil
+}
+
+func (m *TxnRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Record.Size()))
+	n43, err := m.Record.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n43
+	return i, nil
+}
+
+func (m *TxnPrepareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnPrepareRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Intent.Size()))
+	n44, err := m.Intent.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n44
+	return i, nil
+}
+
+func (m *TxnPrepareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnPrepareResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	if m.Conflict != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Conflict.Size()))
+		n45, err := m.Conflict.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	return i, nil
+}
+
+func (m *TxnResolveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnResolveRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.TxnID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TxnID)))
+		i += copy(dAtA[i:], m.TxnID)
+	}
+	if m.Commit {
+		dAtA[i] = 0x18
+		i++
+		if m.Commit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TxnResolveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnResolveResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	return i, nil
+}
+
+func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedRequestUnion(r randyApi, easy bool) *RequestUnion {
+	this := &RequestUnion{}
+	this.OpType = OpType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}[r.Intn(12)])
+	if r.Intn(10) != 0 {
+		this.Create = NewPopulatedCreateRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Update = NewPopulatedUpdateRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Delete = NewPopulatedDeleteRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.BlobChunk = NewPopulatedBlobChunkRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.BlobCommit = NewPopulatedBlobCommitRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Precondition = NewPopulatedPrecondition(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.TxnRecord = NewPopulatedTxnRecordRequest(r, easy)
+	}
+	if r.Intn(10) == 0 {
+		this.TxnPrepare = NewPopulatedTxnPrepareRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.TxnResolve = NewPopulatedTxnResolveRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.BlobAbort = NewPopulatedBlobAbortRequest(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedResponseUnion(r randyApi, easy bool) *ResponseUnion {
+	this := &ResponseUnion{}
+	this.OpType = OpType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}[r.Intn(12)])
+	if r.Intn(10) != 0 {
+		this.Create = NewPopulatedCreateResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Update = NewPopulatedUpdateResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Delete = NewPopulatedDeleteResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Failure = NewPopulatedFailure(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.BlobChunk = NewPopulatedBlobChunkResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.BlobCommit = NewPopulatedBlobCommitResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.TxnRecord = NewPopulatedTxnRecordResponse(r, easy)
+	}
+	if r.Intn(10) == 0 {
+		this.TxnPrepare = NewPopulatedTxnPrepareResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.TxnResolve = NewPopulatedTxnResolveResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.BlobAbort = NewPopulatedBlobAbortResponse(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateRequest(r randyApi, easy bool) *CreateRequest {
+	this := &CreateRequest{}
+	v1 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v1)
+	for i := 0; i < v1; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	v2 := r.Intn(100)
+	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v2)
+	for i := 0; i < v2; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateResponse(r randyApi, easy bool) *CreateResponse {
+	this := &CreateResponse{}
+	v3 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v3)
+	for i := 0; i < v3; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateRequest(r randyApi, easy bool) *UpdateRequest {
+	this := &UpdateRequest{}
+	v4 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v4)
+	for i := 0; i < v4; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	v5 := r.Intn(100)
+	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v5)
+	for i := 0; i < v5; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	this.Upsert = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateResponse(r randyApi, easy bool) *UpdateResponse {
+	this := &UpdateResponse{}
+	v6 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v6)
+	for i := 0; i < v6; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteRequest(r randyApi, easy bool) *DeleteRequest {
+	this := &DeleteRequest{}
+	v7 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v7)
+	for i := 0; i < v7; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteResponse(r randyApi, easy bool) *DeleteResponse {
+	this := &DeleteResponse{}
+	v8 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v8)
+	for i := 0; i < v8; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPrecondition(r randyApi, easy bool) *Precondition {
+	this := &Precondition{}
+	this.Exists = bool(bool(r.Intn(2) == 0))
+	this.NotExists = bool(bool(r.Intn(2) == 0))
+	v9 := r.Intn(100)
+	this.Match = make(github_com_tiglabs_baudengine_proto_metapb.Value, v9)
+	for i := 0; i < v9; i++ {
+		this.Match[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBulkRequest(r randyApi, easy bool) *BulkRequest {
+	this := &BulkRequest{}
+	v10 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v10
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	if r.Intn(10) == 0 {
+		v11 := r.Intn(5)
+		this.Requests = make([]RequestUnion, v11)
+		for i := 0; i < v11; i++ {
+			v12 := NewPopulatedRequestUnion(r, easy)
+			this.Requests[i] = *v12
+		}
+	}
+	this.Atomic = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBulkResponse(r randyApi, easy bool) *BulkResponse {
+	this := &BulkResponse{}
+	v13 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v13
+	if r.Intn(10) == 0 {
+		v14 := r.Intn(5)
+		this.Responses = make([]ResponseUnion, v14)
+		for i := 0; i < v14; i++ {
+			v15 := NewPopulatedResponseUnion(r, easy)
+			this.Responses[i] = *v15
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetRequest(r randyApi, easy bool) *GetRequest {
+	this := &GetRequest{}
+	v16 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v16
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v17 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v17)
+	for i := 0; i < v17; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetResponse(r randyApi, easy bool) *GetResponse {
+	this := &GetResponse{}
+	v18 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v18
+	this.Found = bool(bool(r.Intn(2) == 0))
+	v19 := r.Intn(100)
+	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v19)
+	for i := 0; i < v19; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) == 0 {
+		this.Intent = NewPopulatedTxnIntent(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFieldFilter(r randyApi, easy bool) *FieldFilter {
+	this := &FieldFilter{}
+	this.Field = string(randStringApi(r))
+	v20 := r.Intn(100)
+	this.Value = make([]byte, v20)
+	for i := 0; i < v20; i++ {
+		this.Value[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMultiGetRequest(r randyApi, easy bool) *MultiGetRequest {
+	this := &MultiGetRequest{}
+	v21 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v21
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v22 := r.Intn(10)
+	this.IDs = make([]github_com_tiglabs_baudengine_proto_metapb.Key, v22)
+	for i := 0; i < v22; i++ {
+		v23 := r.Intn(100)
+		this.IDs[i] = make([]byte, v23)
+		for j := 0; j < v23; j++ {
+			this.IDs[i][j] = byte(r.Intn(256))
+		}
+	}
+	v24 := r.Intn(10)
+	this.FilteredIDs = make([]github_com_tiglabs_baudengine_proto_metapb.Key, v24)
+	for i := 0; i < v24; i++ {
+		v25 := r.Intn(100)
+		this.FilteredIDs[i] = make([]byte, v25)
+		for j := 0; j < v25; j++ {
+			this.FilteredIDs[i][j] = byte(r.Intn(256))
+		}
+	}
+	if r.Intn(10) != 0 {
+		v26 := r.Intn(5)
+		this.Filters = make([]FieldFilter, v26)
+		for i := 0; i < v26; i++ {
+			v27 := NewPopulatedFieldFilter(r, easy)
+			this.Filters[i] = *v27
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMultiGetDoc(r randyApi, easy bool) *MultiGetDoc {
+	this := &MultiGetDoc{}
+	v28 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v28)
+	for i := 0; i < v28; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	v29 := r.Intn(100)
+	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v29)
+	for i := 0; i < v29; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMultiGetResponse(r randyApi, easy bool) *MultiGetResponse {
+	this := &MultiGetResponse{}
+	v30 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v30
+	if r.Intn(10) != 0 {
+		v31 := r.Intn(5)
+		this.Docs = make([]MultiGetDoc, v31)
+		for i := 0; i < v31; i++ {
+			v32 := NewPopulatedMultiGetDoc(r, easy)
+			this.Docs[i] = *v32
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchRequest(r randyApi, easy bool) *SearchRequest {
+	this := &SearchRequest{}
+	v33 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v33
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v34 := r.Intn(100)
+	this.Query = make([]byte, v34)
+	for i := 0; i < v34; i++ {
+		this.Query[i] = byte(r.Intn(256))
+	}
+	this.From = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.From *= -1
+	}
+	this.Limit = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Limit *= -1
+	}
+	v35 := r.Intn(10)
+	this.Sort = make([]string, v35)
+	for i := 0; i < v35; i++ {
+		this.Sort[i] = string(randStringApi(r))
+	}
+	v36 := r.Intn(10)
+	this.Fields = make([]string, v36)
+	for i := 0; i < v36; i++ {
+		this.Fields[i] = string(randStringApi(r))
+	}
+	v37 := r.Intn(100)
+	this.Aggregation = make([]byte, v37)
+	for i := 0; i < v37; i++ {
+		this.Aggregation[i] = byte(r.Intn(256))
+	}
+	this.ScrollID = string(randStringApi(r))
+	this.KeepAlive = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.KeepAlive *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchHit(r randyApi, easy bool) *SearchHit {
+	this := &SearchHit{}
+	v38 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v38)
+	for i := 0; i < v38; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Score = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Score *= -1
+	}
+	v39 := r.Intn(100)
+	this.Source = make(github_com_tiglabs_baudengine_proto_metapb.Value, v39)
+	for i := 0; i < v39; i++ {
+		this.Source[i] = byte(r.Intn(256))
+	}
+	v40 := r.Intn(10)
+	this.Sort = make([]string, v40)
+	for i := 0; i < v40; i++ {
+		this.Sort[i] = string(randStringApi(r))
+	}
+	this.Intent = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchResponse(r randyApi, easy bool) *SearchResponse {
+	this := &SearchResponse{}
+	v41 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v41
+	this.Total = uint64(uint64(r.Uint32()))
+	if r.Intn(10) != 0 {
+		v42 := r.Intn(5)
+		this.Hits = make([]SearchHit, v42)
+		for i := 0; i < v42; i++ {
+			v43 := NewPopulatedSearchHit(r, easy)
+			this.Hits[i] = *v43
+		}
+	}
+	v44 := r.Intn(100)
+	this.Buckets = make([]byte, v44)
+	for i := 0; i < v44; i++ {
+		this.Buckets[i] = byte(r.Intn(256))
+	}
+	this.ScrollID = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFailure(r randyApi, easy bool) *Failure {
+	this := &Failure{}
+	v45 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v45)
+	for i := 0; i < v45; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Cause = string(randStringApi(r))
+	this.Aborted = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBlobMeta(r randyApi, easy bool) *BlobMeta {
+	this := &BlobMeta{}
+	this.UploadID = string(randStringApi(r))
+	this.Size_ = uint64(uint64(r.Uint32()))
+	this.ChunkSize = uint32(r.Uint32())
+	this.Chunks = uint32(r.Uint32())
+	this.ContentType = string(randStringApi(r))
+	if r.Intn(10) != 0 {
+		v46 := r.Intn(10)
+		this.Metadata = make(map[string]string)
+		for i := 0; i < v46; i++ {
+			this.Metadata[randStringApi(r)] = randStringApi(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBlobChunkRequest(r randyApi, easy bool) *BlobChunkRequest {
+	this := &BlobChunkRequest{}
+	v47 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v47)
+	for i := 0; i < v47; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.UploadID = string(randStringApi(r))
+	this.Index = uint32(r.Uint32())
+	v48 := r.Intn(100)
+	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v48)
+	for i := 0; i < v48; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBlobChunkResponse(r randyApi, easy bool) *BlobChunkResponse {
+	this := &BlobChunkResponse{}
+	v49 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v49)
+	for i := 0; i < v49; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Index = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBlobAbortRequest(r randyApi, easy bool) *BlobAbortRequest {
+	this := &BlobAbortRequest{}
+	v50 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v50)
+	for i := 0; i < v50; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.UploadID = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBlobAbortResponse(r randyApi, easy bool) *BlobAbortResponse {
+	this := &BlobAbortResponse{}
+	v51 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v51)
+	for i := 0; i < v51; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBlobUpload(r randyApi, easy bool) *BlobUpload {
+	this := &BlobUpload{}
+	v52 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v52)
+	for i := 0; i < v52; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBlobCommitRequest(r randyApi, easy bool) *BlobCommitRequest {
+	this := &BlobCommitRequest{}
+	v53 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v53)
+	for i := 0; i < v53; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	v54 := NewPopulatedBlobMeta(r, easy)
+	this.Meta = *v54
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBlobCommitResponse(r randyApi, easy bool) *BlobCommitResponse {
+	this := &BlobCommitResponse{}
+	v55 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v55)
+	for i := 0; i < v55; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPutBlobRequest(r randyApi, easy bool) *PutBlobRequest {
+	this := &PutBlobRequest{}
+	v56 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v56
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v57 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v57)
+	for i := 0; i < v57; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		this.Meta = NewPopulatedBlobMeta(r, easy)
+	}
+	v58 := r.Intn(100)
+	this.Data = make([]byte, v58)
+	for i := 0; i < v58; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPutBlobResponse(r randyApi, easy bool) *PutBlobResponse {
+	this := &PutBlobResponse{}
+	v59 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v59
+	v60 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v60)
+	for i := 0; i < v60; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Size_ = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetBlobRequest(r randyApi, easy bool) *GetBlobRequest {
+	this := &GetBlobRequest{}
+	v61 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v61
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v62 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v62)
+	for i := 0; i < v62; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Offset = uint64(uint64(r.Uint32()))
+	this.Length = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetBlobResponse(r randyApi, easy bool) *GetBlobResponse {
+	this := &GetBlobResponse{}
+	v63 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v63
+	if r.Intn(10) != 0 {
+		this.Meta = NewPopulatedBlobMeta(r, easy)
+	}
+	v64 := r.Intn(100)
+	this.Data = make([]byte, v64)
+	for i := 0; i < v64; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteBlobRequest(r randyApi, easy bool) *DeleteBlobRequest {
+	this := &DeleteBlobRequest{}
+	v65 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v65
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v66 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v66)
+	for i := 0; i < v66; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteBlobResponse(r randyApi, easy bool) *DeleteBlobResponse {
+	this := &DeleteBlobResponse{}
+	v67 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v67
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedChangeEvent(r randyApi, easy bool) *ChangeEvent {
+	this := &ChangeEvent{}
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	this.Index = uint64(uint64(r.Uint32()))
+	this.Position = uint32(r.Uint32())
+	this.Type = ChangeType([]int32{0, 1, 2}[r.Intn(3)])
+	v68 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v68)
+	for i := 0; i < v68; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	v69 := r.Intn(100)
+	this.Before = make(github_com_tiglabs_baudengine_proto_metapb.Value, v69)
+	for i := 0; i < v69; i++ {
+		this.Before[i] = byte(r.Intn(256))
+	}
+	v70 := r.Intn(100)
+	this.After = make(github_com_tiglabs_baudengine_proto_metapb.Value, v70)
+	for i := 0; i < v70; i++ {
+		this.After[i] = byte(r.Intn(256))
+	}
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedWatchChangesRequest(r randyApi, easy bool) *WatchChangesRequest {
+	this := &WatchChangesRequest{}
+	v71 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v71
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	this.FromIndex = uint64(uint64(r.Uint32()))
+	this.FromNow = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedWatchChangesResponse(r randyApi, easy bool) *WatchChangesResponse {
+	this := &WatchChangesResponse{}
+	v72 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v72
+	if r.Intn(10) != 0 {
+		v73 := r.Intn(5)
+		this.Events = make([]ChangeEvent, v73)
+		for i := 0; i < v73; i++ {
+			v74 := NewPopulatedChangeEvent(r, easy)
+			this.Events[i] = *v74
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnRecord(r randyApi, easy bool) *TxnRecord {
+	this := &TxnRecord{}
+	this.TxnID = string(randStringApi(r))
+	this.Status = TxnStatus([]int32{0, 1, 2}[r.Intn(3)])
+	this.Deadline = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Deadline *= -1
+	}
+	if r.Intn(10) != 0 {
+		v75 := r.Intn(5)
+		this.Keys = make([]TxnKey, v75)
+		for i := 0; i < v75; i++ {
+			v76 := NewPopulatedTxnKey(r, easy)
+			this.Keys[i] = *v76
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnKey(r randyApi, easy bool) *TxnKey {
+	this := &TxnKey{}
+	this.Space = string(randStringApi(r))
+	v77 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v77)
+	for i := 0; i < v77; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnIntent(r randyApi, easy bool) *TxnIntent {
+	this := &TxnIntent{}
+	this.TxnID = string(randStringApi(r))
+	v78 := NewPopulatedRequestUnion(r, easy)
+	this.Write = *v78
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnRecordRequest(r randyApi, easy bool) *TxnRecordRequest {
+	this := &TxnRecordRequest{}
+	v79 := NewPopulatedTxnRecord(r, easy)
+	this.Record = *v79
+	this.Now = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Now *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnRecordResponse(r randyApi, easy bool) *TxnRecordResponse {
+	this := &TxnRecordResponse{}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	v80 := NewPopulatedTxnRecord(r, easy)
+	this.Record = *v80
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnPrepareRequest(r randyApi, easy bool) *TxnPrepareRequest {
+	this := &TxnPrepareRequest{}
+	v81 := NewPopulatedTxnIntent(r, easy)
+	this.Intent = *v81
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnPrepareResponse(r randyApi, easy bool) *TxnPrepareResponse {
+	this := &TxnPrepareResponse{}
+	v82 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v82)
+	for i := 0; i < v82; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	if r.Intn(10) == 0 {
+		this.Conflict = NewPopulatedTxnIntent(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnResolveRequest(r randyApi, easy bool) *TxnResolveRequest {
+	this := &TxnResolveRequest{}
+	v83 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v83)
+	for i := 0; i < v83; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.TxnID = string(randStringApi(r))
+	this.Commit = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTxnResolveResponse(r randyApi, easy bool) *TxnResolveResponse {
+	this := &TxnResolveResponse{}
+	v84 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v84)
+	for i := 0; i < v84; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApi interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApi(r randyApi) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApi(r randyApi) string {
+	v85 := r.Intn(100)
+	tmps := make([]rune, v85)
+	for i := 0; i < v85; i++ {
+		tmps[i] = randUTF8RuneApi(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApi(r randyApi, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApi(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApi(dAtA []byte, r randyApi, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		v86 := r.Int63()
+		if r.Intn(2) == 0 {
+			v86 *= -1
+		}
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(v86))
+	case 1:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApi(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *RequestUnion) Size() (n int) {
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		n += 1 + sovApi(uint64(m.OpType))
+	}
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BlobChunk != nil {
+		l = m.BlobChunk.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BlobCommit != nil {
+		l = m.BlobCommit.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Precondition != nil {
+		l = m.Precondition.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TxnRecord != nil {
+		l = m.TxnRecord.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TxnPrepare != nil {
+		l = m.TxnPrepare.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TxnResolve != nil {
+		l = m.TxnResolve.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BlobAbort != nil {
+		l = m.BlobAbort.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseUnion) Size() (n int) {
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		n += 1 + sovApi(uint64(m.OpType))
+	}
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BlobChunk != nil {
+		l = m.BlobChunk.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BlobCommit != nil {
+		l = m.BlobCommit.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TxnRecord != nil {
+		l = m.TxnRecord.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TxnPrepare != nil {
+		l = m.TxnPrepare.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TxnResolve != nil {
+		l = m.TxnResolve.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BlobAbort != nil {
+		l = m.BlobAbort.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *Precondition) Size() (n int) {
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	if m.NotExists {
+		n += 2
+	}
+	l = len(m.Match)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BulkRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Atomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *BulkResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Found {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Intent != nil {
+		l = m.Intent.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *FieldFilter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiGetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	if len(m.IDs) > 0 {
+		for _, b := range m.IDs {
+			l = len(b)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.FilteredIDs) > 0 {
+		for _, b := range m.FilteredIDs {
+			l = len(b)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiGetDoc) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiGetResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.Docs) > 0 {
+		for _, e := range m.Docs {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SearchRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovApi(uint64(m.From))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovApi(uint64(m.Limit))
+	}
+	if len(m.Sort) > 0 {
+		for _, s := range m.Sort {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ScrollID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.KeepAlive != 0 {
+		n += 1 + sovApi(uint64(m.KeepAlive))
+	}
+	return n
+}
+
+func (m *SearchHit) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Sort) > 0 {
+		for _, s := range m.Sort {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Intent {
+		n += 2
+	}
+	return n
+}
+
+func (m *SearchResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Total != 0 {
+		n += 1 + sovApi(uint64(m.Total))
+	}
+	if len(m.Hits) > 0 {
+		for _, e := range m.Hits {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	l = len(m.Buckets)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ScrollID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Failure) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Aborted {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlobMeta) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovApi(uint64(m.Size_))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovApi(uint64(m.ChunkSize))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovApi(uint64(m.Chunks))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *BlobChunkRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovApi(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *BlobChunkResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovApi(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *BlobAbortRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobAbortResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *BlobUpload) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *BlobCommitRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = m.Meta.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *BlobCommitResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *PutBlobRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Meta != nil {
+		l = m.Meta.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *PutBlobResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovApi(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *GetBlobRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovApi(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovApi(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *GetBlobResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Meta != nil {
+		l = m.Meta.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteBlobRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteBlobResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *ChangeEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	if m.Index != 0 {
+		n += 1 + sovApi(uint64(m.Index))
+	}
+	if m.Position != 0 {
+		n += 1 + sovApi(uint64(m.Position))
+	}
+	if m.Type != 0 {
+		n += 1 + sovApi(uint64(m.Type))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Before)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *WatchChangesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	if m.FromIndex != 0 {
+		n += 1 + sovApi(uint64(m.FromIndex))
+	}
+	if m.FromNow {
+		n += 2
+	}
+	return n
+}
+
+func (m *WatchChangesResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxnRecord) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TxnID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovApi(uint64(m.Status))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovApi(uint64(m.Deadline))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxnKey) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Space)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *TxnIntent) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TxnID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = m.Write.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *TxnRecordRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Now != 0 {
+		n += 1 + sovApi(uint64(m.Now))
+	}
+	return n
+}
+
+func (m *TxnRecordResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *TxnPrepareRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Intent.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *TxnPrepareResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	if m.Conflict != nil {
+		l = m.Conflict.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *TxnResolveRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.TxnID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Commit {
+		n += 2
+	}
+	return n
+}
+
+func (m *TxnResolveResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	return n
+}
+
+func sovApi(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApi(x uint64) (n int) {
+	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *RequestUnion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestUnion{`,
+		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
+		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateRequest", "CreateRequest", 1) + `,`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteRequest", "DeleteRequest", 1) + `,`,
+		`BlobChunk:` + strings.Replace(fmt.Sprintf("%v", this.BlobChunk), "BlobChunkRequest", "BlobChunkRequest", 1) + `,`,
+		`BlobCommit:` + strings.Replace(fmt.Sprintf("%v", this.BlobCommit), "BlobCommitRequest", "BlobCommitRequest", 1) + `,`,
+		`Precondition:` + strings.Replace(fmt.Sprintf("%v", this.Precondition), "Precondition", "Precondition", 1) + `,`,
+		`TxnRecord:` + strings.Replace(fmt.Sprintf("%v", this.TxnRecord), "TxnRecordRequest", "TxnRecordRequest", 1) + `,`,
+		`TxnPrepare:` + strings.Replace(fmt.Sprintf("%v", this.TxnPrepare), "TxnPrepareRequest", "TxnPrepareRequest", 1) + `,`,
+		`TxnResolve:` + strings.Replace(fmt.Sprintf("%v", this.TxnResolve), "TxnResolveRequest", "TxnResolveRequest", 1) + `,`,
+		`BlobAbort:` + strings.Replace(fmt.Sprintf("%v", this.BlobAbort), "BlobAbortRequest", "BlobAbortRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResponseUnion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResponseUnion{`,
+		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
+		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateResponse", "CreateResponse", 1) + `,`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateResponse", "UpdateResponse", 1) + `,`,
+		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteResponse", "DeleteResponse", 1) + `,`,
+		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "Failure", 1) + `,`,
+		`BlobChunk:` + strings.Replace(fmt.Sprintf("%v", this.BlobChunk), "BlobChunkResponse", "BlobChunkResponse", 1) + `,`,
+		`BlobCommit:` + strings.Replace(fmt.Sprintf("%v", this.BlobCommit), "BlobCommitResponse", "BlobCommitResponse", 1) + `,`,
+		`TxnRecord:` + strings.Replace(fmt.Sprintf("%v", this.TxnRecord), "TxnRecordResponse", "TxnRecordResponse", 1) + `,`,
+		`TxnPrepare:` + strings.Replace(fmt.Sprintf("%v", this.TxnPrepare), "TxnPrepareResponse", "TxnPrepareResponse", 1) + `,`,
+		`TxnResolve:` + strings.Replace(fmt.Sprintf("%v", this.TxnResolve), "TxnResolveResponse", "TxnResolveResponse", 1) + `,`,
+		`BlobAbort:` + strings.Replace(fmt.Sprintf("%v", this.BlobAbort), "BlobAbortResponse", "BlobAbortResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Upsert:` + fmt.Sprintf("%v", this.Upsert) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Precondition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Precondition{`,
+		`Exists:` + fmt.Sprintf("%v", this.Exists) + `,`,
+		`NotExists:` + fmt.Sprintf("%v", this.NotExists) + `,`,
+		`Match:` + fmt.Sprintf("%v", this.Match) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Requests:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Requests), "RequestUnion", "RequestUnion", 1), `&`, ``, 1) + `,`,
+		`Atomic:` + fmt.Sprintf("%v", this.Atomic) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Responses:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Responses), "ResponseUnion", "ResponseUnion", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Found:` + fmt.Sprintf("%v", this.Found) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Intent:` + strings.Replace(fmt.Sprintf("%v", this.Intent), "TxnIntent", "TxnIntent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FieldFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FieldFilter{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiGetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultiGetRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`IDs:` + fmt.Sprintf("%v", this.IDs) + `,`,
+		`FilteredIDs:` + fmt.Sprintf("%v", this.FilteredIDs) + `,`,
+		`Filters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Filters), "FieldFilter", "FieldFilter", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiGetDoc) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultiGetDoc{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiGetResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultiGetResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Docs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Docs), "MultiGetDoc", "MultiGetDoc", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Sort:` + fmt.Sprintf("%v", this.Sort) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`Aggregation:` + fmt.Sprintf("%v", this.Aggregation) + `,`,
+		`ScrollID:` + fmt.Sprintf("%v", this.ScrollID) + `,`,
+		`KeepAlive:` + fmt.Sprintf("%v", this.KeepAlive) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchHit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchHit{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Score:` + fmt.Sprintf("%v", this.Score) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Sort:` + fmt.Sprintf("%v", this.Sort) + `,`,
+		`Intent:` + fmt.Sprintf("%v", this.Intent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Hits:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Hits), "SearchHit", "SearchHit", 1), `&`, ``, 1) + `,`,
+		`Buckets:` + fmt.Sprintf("%v", this.Buckets) + `,`,
+		`ScrollID:` + fmt.Sprintf("%v", this.ScrollID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Failure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Failure{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`Aborted:` + fmt.Sprintf("%v", this.Aborted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlobMeta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k, _ := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&BlobMeta{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
+		`Chunks:` + fmt.Sprintf("%v", this.Chunks) + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlobChunkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlobChunkRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlobChunkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlobChunkResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlobAbortRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlobAbortRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlobAbortResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlobAbortResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlobUpload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlobUpload{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlobCommitRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlobCommitRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Meta:` + strings.Replace(strings.Replace(this.Meta.String(), "BlobMeta", "BlobMeta", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlobCommitResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlobCommitResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PutBlobRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PutBlobRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Meta:` + strings.Replace(fmt.Sprintf("%v", this.Meta), "BlobMeta", "BlobMeta", 1) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PutBlobResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PutBlobResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetBlobRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBlobRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetBlobResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBlobResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Meta:` + strings.Replace(fmt.Sprintf("%v", this.Meta), "BlobMeta", "BlobMeta", 1) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteBlobRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteBlobRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteBlobResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteBlobResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChangeEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChangeEvent{`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Position:` + fmt.Sprintf("%v", this.Position) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Before:` + fmt.Sprintf("%v", this.Before) + `,`,
+		`After:` + fmt.Sprintf("%v", this.After) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchChangesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchChangesRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`FromIndex:` + fmt.Sprintf("%v", this.FromIndex) + `,`,
+		`FromNow:` + fmt.Sprintf("%v", this.FromNow) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchChangesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchChangesResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Events:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Events), "ChangeEvent", "ChangeEvent", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnRecord{`,
+		`TxnID:` + fmt.Sprintf("%v", this.TxnID) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`Keys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Keys), "TxnKey", "TxnKey", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnKey{`,
+		`Space:` + fmt.Sprintf("%v", this.Space) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnIntent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnIntent{`,
+		`TxnID:` + fmt.Sprintf("%v", this.TxnID) + `,`,
+		`Write:` + strings.Replace(strings.Replace(this.Write.String(), "RequestUnion", "RequestUnion", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnRecordRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnRecordRequest{`,
+		`Record:` + strings.Replace(strings.Replace(this.Record.String(), "TxnRecord", "TxnRecord", 1), `&`, ``, 1) + `,`,
+		`Now:` + fmt.Sprintf("%v", this.Now) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnRecordResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnRecordResponse{`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Record:` + strings.Replace(strings.Replace(this.Record.String(), "TxnRecord", "TxnRecord", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnPrepareRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnPrepareRequest{`,
+		`Intent:` + strings.Replace(strings.Replace(this.Intent.String(), "TxnIntent", "TxnIntent", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnPrepareResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnPrepareResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Conflict:` + strings.Replace(fmt.Sprintf("%v", this.Conflict), "TxnIntent", "TxnIntent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnResolveRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnResolveRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`TxnID:` + fmt.Sprintf("%v", this.TxnID) + `,`,
+		`Commit:` + fmt.Sprintf("%v", this.Commit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnResolveResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxnResolveResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RequestUnion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestUnion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestUnion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			}
+			m.OpType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpType |= (OpType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Create == nil {
+				m.Create = &CreateRequest{}
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &UpdateRequest{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlobChunk == nil {
+				m.BlobChunk = &BlobChunkRequest{}
+			}
+			if err := m.BlobChunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlobCommit == nil {
+				m.BlobCommit = &BlobCommitRequest{}
+			}
+			if err := m.BlobCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precondition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Precondition == nil {
+				m.Precondition = &Precondition{}
+			}
+			if err := m.Precondition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnRecord == nil {
+				m.TxnRecord = &TxnRecordRequest{}
+			}
+			if err := m.TxnRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnPrepare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnPrepare == nil {
+				m.TxnPrepare = &TxnPrepareRequest{}
+			}
+			if err := m.TxnPrepare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnResolve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnResolve == nil {
+				m.TxnResolve = &TxnResolveRequest{}
+			}
+			if err := m.TxnResolve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobAbort", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlobAbort == nil {
+				m.BlobAbort = &BlobAbortRequest{}
+			}
+			if err := m.BlobAbort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseUnion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseUnion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			}
+			m.OpType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpType |= (OpType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Create == nil {
+				m.Create = &CreateResponse{}
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &UpdateResponse{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteResponse{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlobChunk == nil {
+				m.BlobChunk = &BlobChunkResponse{}
+			}
+			if err := m.BlobChunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlobCommit == nil {
+				m.BlobCommit = &BlobCommitResponse{}
+			}
+			if err := m.BlobCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnRecord == nil {
+				m.TxnRecord = &TxnRecordResponse{}
+			}
+			if err := m.TxnRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnPrepare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnPrepare == nil {
+				m.TxnPrepare = &TxnPrepareResponse{}
+			}
+			if err := m.TxnPrepare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnResolve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnResolve == nil {
+				m.TxnResolve = &TxnResolveResponse{}
+			}
+			if err := m.TxnResolve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobAbort", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlobAbort == nil {
+				m.BlobAbort = &BlobAbortResponse{}
+			}
+			if err := m.BlobAbort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Precondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Precondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Precondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotExists = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = append(m.Match[:0], dAtA[iNdEx:postIndex]...)
+			if m.Match == nil {
+				m.Match = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, RequestUnion{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, ResponseUnion{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Intent == nil {
+				m.Intent = &TxnIntent{}
+			}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiGetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiGetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IDs = append(m.IDs, make([]byte, postIndex-iNdEx))
+			copy(m.IDs[len(m.IDs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredIDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilteredIDs = append(m.FilteredIDs, make([]byte, postIndex-iNdEx))
+			copy(m.FilteredIDs[len(m.FilteredIDs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, FieldFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiGetDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiGetDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiGetDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiGetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiGetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Docs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Docs = append(m.Docs, MultiGetDoc{})
+			if err := m.Docs[len(m.Docs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sort = append(m.Sort, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregation = append(m.Aggregation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Aggregation == nil {
+				m.Aggregation = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrollID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScrollID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAlive", wireType)
+			}
+			m.KeepAlive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepAlive |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchHit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchHit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchHit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sort = append(m.Sort, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Intent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hits = append(m.Hits, SearchHit{})
+			if err := m.Hits[len(m.Hits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buckets == nil {
+				m.Buckets = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrollID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScrollID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Failure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Failure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Failure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Aborted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobChunkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobChunkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobAbortRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobAbortRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobAbortRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobAbortResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobAbortResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobAbortResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutBlobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutBlobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutBlobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Meta == nil {
+				m.Meta = &BlobMeta{}
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutBlobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutBlobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutBlobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Meta == nil {
+				m.Meta = &BlobMeta{}
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteBlobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteBlobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteBlobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteBlobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteBlobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteBlobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ChangeType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Before = append(m.Before[:0], dAtA[iNdEx:postIndex]...)
+			if m.Before == nil {
+				m.Before = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = append(m.After[:0], dAtA[iNdEx:postIndex]...)
+			if m.After == nil {
+				m.After = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RequestUnion) Unmarshal(dAtA []byte) error {
+func (m *WatchChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,17 +12877,17 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestUnion: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestUnion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
 			}
-			m.OpType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1311,16 +12897,27 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpType |= (OpType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
 			}
-			var msglen int
+			m.PartitionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1330,28 +12927,103 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromIndex", wireType)
+			}
+			m.FromIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromIndex |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromNow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Create == nil {
-				m.Create = &CreateRequest{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1375,16 +13047,13 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Update == nil {
-				m.Update = &UpdateRequest{}
-			}
-			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1408,10 +13077,8 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteRequest{}
-			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, ChangeEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1436,7 +13103,7 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
+func (m *TxnRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1459,17 +13126,17 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseUnion: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseUnion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnID", wireType)
 			}
-			m.OpType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1479,16 +13146,26 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpType |= (OpType(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxnID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1498,28 +13175,33 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Status |= (TxnStatus(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Create == nil {
-				m.Create = &CreateResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1543,18 +13225,66 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Update == nil {
-				m.Update = &UpdateResponse{}
-			}
-			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Keys = append(m.Keys, TxnKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1564,30 +13294,26 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteResponse{}
-			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Space = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1597,23 +13323,21 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Failure == nil {
-				m.Failure = &Failure{}
-			}
-			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1637,7 +13361,7 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+func (m *TxnIntent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1660,17 +13384,17 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnIntent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnIntent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1680,28 +13404,26 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
-			}
+			m.TxnID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Write", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1711,21 +13433,20 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.Write.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1749,7 +13470,7 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateResponse) Unmarshal(dAtA []byte) error {
+func (m *TxnRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1772,17 +13493,17 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1792,28 +13513,27 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Now", wireType)
 			}
-			m.Result = 0
+			m.Now = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1823,7 +13543,7 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
+				m.Now |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1849,7 +13569,7 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *TxnRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1872,17 +13592,17 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var byteLen int
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1892,28 +13612,16 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Result |= (WriteResult(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1923,43 +13631,22 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Upsert = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -1981,7 +13668,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *TxnPrepareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,17 +13691,17 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnPrepareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnPrepareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2024,42 +13711,22 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2081,7 +13748,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *TxnPrepareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,10 +13771,10 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnPrepareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnPrepareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2141,6 +13808,58 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				m.ID = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Conflict == nil {
+				m.Conflict = &TxnIntent{}
+			}
+			if err := m.Conflict.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2162,7 +13881,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *TxnResolveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,10 +13904,10 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnResolveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2223,10 +13942,39 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxnID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			m.Result = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2236,11 +13984,12 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Commit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2262,7 +14011,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Failure) Unmarshal(dAtA []byte) error {
+func (m *TxnResolveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2285,10 +14034,10 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Failure: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnResolveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Failure: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2323,10 +14072,10 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2336,21 +14085,11 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Result |= (WriteResult(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cause = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2480,43 +14219,165 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xf6, 0xb9, 0xae, 0xd3, 0x5c, 0xfe, 0x59, 0xd6, 0x4f, 0x3f, 0x45, 0x0c, 0x97, 0xc8, 0x42,
-	0xa4, 0xaa, 0xc0, 0x41, 0x81, 0x01, 0x21, 0x16, 0x52, 0xa7, 0x0a, 0x02, 0x92, 0xca, 0x4a, 0x40,
-	0xb0, 0x54, 0x76, 0x7c, 0x0d, 0x96, 0xdc, 0xf8, 0xb0, 0xcf, 0x43, 0xb6, 0x7e, 0x04, 0x46, 0x46,
-	0x24, 0x96, 0x7e, 0x04, 0x24, 0x16, 0xc6, 0x8e, 0x1d, 0x99, 0xa2, 0xc6, 0x7c, 0x01, 0x46, 0xd4,
-	0x09, 0xf9, 0xce, 0xb1, 0xea, 0x4c, 0x20, 0x45, 0x15, 0x93, 0xdf, 0xc7, 0xcf, 0x73, 0xef, 0x3d,
-	0xef, 0x7b, 0xef, 0x1d, 0x2c, 0x5a, 0xc4, 0xd5, 0x49, 0xe0, 0x53, 0xff, 0xd6, 0xbd, 0xa9, 0x4b,
-	0xdf, 0x45, 0xb6, 0x3e, 0xf1, 0x4f, 0xda, 0x53, 0x7f, 0xea, 0xb7, 0xd9, 0x6f, 0x3b, 0x3a, 0x66,
-	0x88, 0x01, 0x16, 0x71, 0xb9, 0x76, 0x06, 0x60, 0xd9, 0xc4, 0xef, 0x23, 0x1c, 0xd2, 0xf1, 0xcc,
-	0xf5, 0x67, 0x6a, 0x13, 0x16, 0x7c, 0x72, 0x44, 0xe7, 0x04, 0xd7, 0x41, 0x13, 0xec, 0x56, 0x3b,
-	0x05, 0x7d, 0x48, 0x46, 0x73, 0x82, 0x4d, 0xd9, 0x67, 0x5f, 0xf5, 0x0e, 0x94, 0x27, 0x01, 0xb6,
-	0x28, 0xae, 0x8b, 0x4d, 0xb0, 0x5b, 0xea, 0x54, 0xf5, 0x7d, 0x06, 0xd3, 0x34, 0x66, 0xca, 0x26,
-	0xba, 0x88, 0x38, 0x89, 0x6e, 0x2b, 0xd5, 0x8d, 0x19, 0xcc, 0x74, 0x9c, 0x4d, 0x74, 0x0e, 0xf6,
-	0x30, 0xc5, 0x75, 0x29, 0xd5, 0x19, 0x0c, 0x66, 0x3a, 0xce, 0x6a, 0x17, 0x00, 0x56, 0x4c, 0x1c,
-	0x12, 0x7f, 0x16, 0xe2, 0x3f, 0xf5, 0xda, 0x5a, 0xf3, 0x5a, 0xcb, 0xbc, 0xf2, 0x3c, 0x99, 0xd9,
-	0xd6, 0x9a, 0xd9, 0x5a, 0x66, 0x76, 0x25, 0x4c, 0xdd, 0xb6, 0xd6, 0xdc, 0xd6, 0x32, 0xb7, 0x2b,
-	0x21, 0xa7, 0x55, 0x0d, 0x16, 0x8e, 0x2d, 0xd7, 0x8b, 0x02, 0x5c, 0xdf, 0x66, 0xca, 0x1d, 0xfd,
-	0x80, 0x63, 0x73, 0x45, 0x68, 0x9f, 0x01, 0xac, 0xe4, 0x9a, 0xa7, 0xf6, 0xa1, 0xe8, 0x3a, 0xac,
-	0x9a, 0x72, 0xf7, 0x51, 0xbc, 0x68, 0x88, 0xcf, 0x8c, 0xab, 0x45, 0x43, 0xbf, 0x76, 0xa8, 0xd4,
-	0x9d, 0x7a, 0x96, 0x1d, 0xb6, 0x6d, 0x2b, 0x72, 0xf0, 0x6c, 0xea, 0xce, 0x30, 0x3f, 0xe2, 0xf6,
-	0x09, 0xa6, 0x16, 0xb1, 0xf5, 0xe7, 0x78, 0x6e, 0x8a, 0xae, 0xa3, 0xf6, 0xa1, 0xe4, 0x58, 0xd4,
-	0x62, 0x85, 0x97, 0xbb, 0x0f, 0xaf, 0x16, 0x8d, 0xfb, 0x7f, 0x91, 0xe5, 0x95, 0xe5, 0x45, 0xd8,
-	0x64, 0x19, 0xb4, 0x53, 0x00, 0xab, 0xf9, 0xb6, 0x6d, 0xd0, 0xe6, 0x6d, 0x28, 0x07, 0x38, 0x8c,
-	0x3c, 0xca, 0x8c, 0x56, 0x3b, 0x65, 0xfd, 0x75, 0xe0, 0xb2, 0x9d, 0x22, 0x8f, 0x9a, 0x29, 0xa7,
-	0x7d, 0x05, 0xb0, 0x92, 0x9b, 0x9e, 0x7f, 0xb1, 0x51, 0xea, 0xff, 0xc9, 0x10, 0x85, 0x38, 0xa0,
-	0x6c, 0x88, 0x76, 0xcc, 0x14, 0xb1, 0x06, 0xe6, 0xc7, 0xe9, 0xc6, 0x1b, 0xf8, 0x06, 0x56, 0x72,
-	0xb7, 0x6a, 0x73, 0x06, 0x58, 0x75, 0xf9, 0x3b, 0x70, 0xe3, 0xd5, 0xf9, 0xb0, 0x90, 0xde, 0xad,
-	0x0d, 0x6e, 0xfd, 0x1f, 0xdc, 0x9e, 0x58, 0x51, 0xc8, 0x9f, 0x8e, 0xa2, 0xc9, 0xc1, 0x63, 0xe9,
-	0xe3, 0xa7, 0x86, 0xb0, 0x77, 0x17, 0xca, 0xfc, 0xa5, 0x51, 0x21, 0x94, 0xf7, 0xcd, 0xde, 0xd3,
-	0x51, 0x4f, 0x11, 0x92, 0x78, 0x7c, 0x68, 0x24, 0x31, 0x48, 0x62, 0xa3, 0xf7, 0xa2, 0x37, 0xea,
-	0x29, 0xe2, 0xde, 0x4b, 0x58, 0xba, 0xe6, 0x5a, 0x2d, 0xc1, 0x02, 0x5f, 0x62, 0x28, 0x42, 0x02,
-	0xf8, 0x1a, 0x43, 0x01, 0x09, 0xe0, 0x8b, 0x0c, 0x45, 0x54, 0x2b, 0xb0, 0x38, 0x18, 0x8e, 0x8e,
-	0x0e, 0x86, 0xe3, 0x81, 0xa1, 0x6c, 0xa9, 0x3b, 0x50, 0x1a, 0x0c, 0x87, 0x87, 0x8a, 0xd4, 0x7d,
-	0x72, 0xbe, 0x44, 0xc2, 0xf7, 0x25, 0x12, 0x2e, 0x97, 0x48, 0xf8, 0xb9, 0x44, 0xc2, 0xaf, 0x25,
-	0x02, 0xa7, 0x31, 0x02, 0x67, 0x31, 0x02, 0x5f, 0x62, 0x24, 0x7c, 0x8b, 0x91, 0x70, 0x1e, 0x23,
-	0x70, 0x11, 0x23, 0x70, 0x19, 0x23, 0xf0, 0xe1, 0x07, 0x12, 0xfa, 0xe0, 0xad, 0x44, 0x42, 0x62,
-	0xdb, 0x32, 0x2b, 0xf6, 0xc1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0x6a, 0x1b, 0x9b, 0x34,
-	0x06, 0x00, 0x00,
+	// 2552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xd5, 0x5c, 0xee, 0xf2, 0x67, 0x1f, 0x49, 0x89, 0x1a, 0x2b, 0xf9, 0xf8, 0x09, 0x35, 0xa5, 0x6c,
+	0x83, 0xc4, 0x51, 0xdb, 0xb5, 0x2d, 0xbb, 0xad, 0x93, 0xa2, 0x68, 0x45, 0x91, 0x92, 0x58, 0x5b,
+	0x94, 0x30, 0x92, 0x92, 0xb4, 0x17, 0x62, 0xc9, 0x1d, 0x49, 0x0b, 0x53, 0xbb, 0xcc, 0xee, 0xd0,
+	0x96, 0x7a, 0x0a, 0xfa, 0x83, 0xa0, 0xe8, 0x25, 0x2d, 0x50, 0xa4, 0xc7, 0x16, 0xbd, 0x14, 0x45,
+	0x7b, 0x2f, 0xd0, 0x4b, 0x0f, 0x3d, 0xf8, 0x68, 0xf4, 0xd4, 0x93, 0x10, 0x13, 0xe8, 0xb9, 0x3d,
+	0x15, 0x45, 0x80, 0x02, 0xc5, 0xfc, 0xec, 0x72, 0x97, 0x92, 0x0d, 0x47, 0x62, 0x9d, 0x18, 0x3e,
+	0x71, 0xdf, 0xcf, 0xcc, 0xbc, 0xbf, 0x99, 0xf7, 0xe6, 0x0d, 0x41, 0xb7, 0xfa, 0x8e, 0xd9, 0xf7,
+	0x3d, 0xea, 0xcd, 0x7d, 0x65, 0xdf, 0xa1, 0x07, 0x83, 0x8e, 0xd9, 0xf5, 0x0e, 0xaf, 0xee, 0x7b,
+	0xfb, 0xde, 0x55, 0x8e, 0xee, 0x0c, 0xf6, 0x38, 0xc4, 0x01, 0xfe, 0x25, 0xd9, 0xbf, 0x1a, 0x63,
+	0xa7, 0xce, 0x7e, 0xcf, 0xea, 0x04, 0x57, 0x3b, 0xd6, 0xc0, 0x26, 0xee, 0xbe, 0xe3, 0x12, 0x31,
+	0xf8, 0xea, 0x21, 0xa1, 0x56, 0xbf, 0xc3, 0x7f, 0xc4, 0x30, 0xe3, 0x43, 0x0d, 0x8a, 0x98, 0xbc,
+	0x37, 0x20, 0x01, 0xdd, 0x75, 0x1d, 0xcf, 0x45, 0x0b, 0x90, 0xf3, 0xfa, 0x6d, 0x7a, 0xdc, 0x27,
+	0x15, 0x65, 0x41, 0xb9, 0x32, 0xb5, 0x94, 0x33, 0x37, 0xfb, 0x3b, 0xc7, 0x7d, 0x82, 0xb3, 0x1e,
+	0xff, 0x45, 0xaf, 0x41, 0xb6, 0xeb, 0x13, 0x8b, 0x92, 0x4a, 0x7a, 0x41, 0xb9, 0x52, 0x58, 0x9a,
+	0x32, 0x57, 0x38, 0x28, 0xa7, 0xc1, 0x92, 0xca, 0xf8, 0x06, 0x7d, 0x9b, 0xf1, 0xa9, 0x92, 0x6f,
+	0xb7, 0x6f, 0xc7, 0xf9, 0x04, 0x95, 0xf1, 0xd9, 0xa4, 0x47, 0x28, 0xa9, 0x68, 0x92, 0xaf, 0xce,
+	0xc1, 0x88, 0x4f, 0x50, 0xd1, 0x35, 0x80, 0x4e, 0xcf, 0xeb, 0xb4, 0xbb, 0x07, 0x03, 0xf7, 0x6e,
+	0x25, 0xc3, 0x79, 0x67, 0xcc, 0x5a, 0xcf, 0xeb, 0xac, 0x30, 0x4c, 0xc8, 0xae, 0x77, 0x42, 0x0c,
+	0xba, 0x01, 0x05, 0x31, 0xc2, 0x3b, 0x3c, 0x74, 0x68, 0x25, 0xcb, 0x87, 0x20, 0x31, 0x84, 0xa3,
+	0xc2, 0x31, 0xd0, 0x89, 0x50, 0xe8, 0x3a, 0x14, 0xfb, 0x3e, 0xe9, 0x7a, 0xae, 0xed, 0x50, 0xc7,
+	0x73, 0x2b, 0x39, 0x3e, 0xaa, 0x64, 0x6e, 0xc5, 0x90, 0x38, 0xc1, 0xc2, 0x24, 0xa3, 0x47, 0x6e,
+	0x9b, 0xa1, 0x7c, 0xbb, 0x92, 0x97, 0x92, 0xed, 0x1c, 0xb9, 0x98, 0x63, 0x22, 0xc9, 0x68, 0x88,
+	0x61, 0x92, 0xb1, 0x11, 0x7d, 0x9f, 0xf4, 0x2d, 0x9f, 0x54, 0x74, 0x29, 0xd9, 0xce, 0x91, 0xbb,
+	0x25, 0x50, 0x91, 0x64, 0x34, 0x42, 0x85, 0x83, 0x7c, 0x12, 0x78, 0xbd, 0x7b, 0xa4, 0x02, 0xa3,
+	0x41, 0x58, 0xa0, 0xe2, 0x83, 0x24, 0x2a, 0xb2, 0x9a, 0xd5, 0xf1, 0x7c, 0x5a, 0x29, 0xc4, 0xac,
+	0xb6, 0xcc, 0x30, 0x09, 0xab, 0x71, 0x8c, 0xf1, 0x53, 0x0d, 0x4a, 0x98, 0x04, 0x7d, 0xcf, 0x0d,
+	0xc8, 0xd3, 0xc6, 0xc4, 0xeb, 0x63, 0x31, 0x31, 0x1d, 0xc5, 0x84, 0x98, 0x27, 0x0a, 0x8a, 0xd7,
+	0xc7, 0x82, 0x62, 0x3a, 0x0a, 0x8a, 0x90, 0x51, 0x46, 0xc5, 0xeb, 0x63, 0x51, 0x31, 0x1d, 0x45,
+	0x45, 0xc8, 0x28, 0xc3, 0xc2, 0x80, 0xdc, 0x9e, 0xe5, 0xf4, 0x06, 0x3e, 0x91, 0x31, 0x91, 0x37,
+	0x57, 0x05, 0x8c, 0x43, 0x02, 0xba, 0x9e, 0x08, 0x9d, 0x44, 0x1c, 0x88, 0xd0, 0x91, 0x73, 0xc6,
+	0x62, 0xe7, 0x66, 0x32, 0x76, 0x44, 0x14, 0x5c, 0x4a, 0xc4, 0x8e, 0x1c, 0x94, 0x0c, 0x9e, 0xd3,
+	0x91, 0x80, 0xe2, 0x91, 0x10, 0x2e, 0x34, 0x0a, 0x85, 0x9b, 0x67, 0x85, 0xc2, 0xa5, 0x44, 0x28,
+	0x84, 0x0b, 0xc5, 0x62, 0xe1, 0xe6, 0x59, 0xb1, 0x70, 0x29, 0x11, 0x0b, 0xb1, 0x51, 0x61, 0x30,
+	0x5c, 0x3f, 0x23, 0x18, 0x50, 0x3c, 0x18, 0xe2, 0x76, 0x10, 0xd1, 0xf0, 0x1b, 0x05, 0x4a, 0x89,
+	0xfd, 0x8d, 0xd6, 0x21, 0xed, 0xd8, 0x3c, 0x10, 0x8a, 0xb5, 0x5b, 0xc3, 0x93, 0xf9, 0x74, 0xb3,
+	0xfe, 0xc9, 0xc9, 0xbc, 0xf9, 0xf4, 0xe7, 0x8f, 0x79, 0x9b, 0x1c, 0xe3, 0xb4, 0x63, 0xa3, 0x75,
+	0xd0, 0x6c, 0x8b, 0x5a, 0x3c, 0x66, 0x8a, 0xb5, 0x9b, 0x9f, 0x9c, 0xcc, 0x5f, 0xfb, 0x14, 0xb3,
+	0xbc, 0x6d, 0xf5, 0x06, 0x04, 0xf3, 0x19, 0x8c, 0xf7, 0x15, 0x98, 0x4a, 0x46, 0xdc, 0x04, 0xc5,
+	0x7c, 0x15, 0xb2, 0x3e, 0x09, 0x06, 0x3d, 0xca, 0x05, 0x9d, 0x5a, 0x2a, 0x9a, 0xef, 0xf8, 0x0e,
+	0x5f, 0x69, 0xd0, 0xa3, 0x58, 0xd2, 0x8c, 0x3f, 0x29, 0x50, 0x4a, 0x1c, 0x70, 0x9f, 0x47, 0x43,
+	0xa1, 0x97, 0xd9, 0xfe, 0x0b, 0x88, 0x4f, 0xf9, 0xfe, 0xcb, 0x63, 0x09, 0x71, 0x03, 0x26, 0x77,
+	0xe2, 0x33, 0x37, 0xe0, 0x77, 0xa1, 0x94, 0x38, 0xf8, 0x27, 0x27, 0x00, 0xd7, 0x2e, 0x79, 0x7c,
+	0x3c, 0x73, 0xed, 0x7e, 0xa6, 0x40, 0x31, 0x9e, 0x42, 0x98, 0x27, 0xc8, 0x91, 0x13, 0xd0, 0x80,
+	0x0b, 0x91, 0xc7, 0x12, 0x42, 0x97, 0x01, 0x5c, 0x8f, 0xb6, 0x25, 0x2d, 0xcd, 0x69, 0xba, 0xeb,
+	0xd1, 0x86, 0x20, 0x7f, 0x07, 0x32, 0x87, 0x16, 0xed, 0x1e, 0x54, 0xd4, 0x0b, 0xc4, 0x82, 0x98,
+	0xc2, 0xf8, 0x97, 0x02, 0x85, 0xda, 0xa0, 0x17, 0xa6, 0x4e, 0x74, 0x0d, 0xb2, 0x07, 0xc4, 0xb2,
+	0x89, 0x5f, 0x51, 0x64, 0x26, 0x96, 0x94, 0x75, 0x8e, 0xad, 0xe5, 0x1f, 0x9c, 0xcc, 0xa7, 0x1e,
+	0x9e, 0xcc, 0x2b, 0x58, 0xf2, 0xa1, 0x1e, 0x14, 0xfb, 0x96, 0x4f, 0xb9, 0x46, 0x6d, 0xc7, 0xe6,
+	0xe2, 0x96, 0x6a, 0xcd, 0xe1, 0xc9, 0x7c, 0x61, 0x2b, 0xc4, 0x73, 0xc3, 0x7e, 0xed, 0x53, 0xc8,
+	0x18, 0x1b, 0x89, 0x0b, 0xd1, 0xf4, 0x4d, 0x1b, 0x5d, 0x85, 0xbc, 0x2f, 0x04, 0x0a, 0x2a, 0xea,
+	0x82, 0xca, 0xd3, 0x72, 0xbc, 0x78, 0xa9, 0x69, 0x4c, 0x40, 0x1c, 0x31, 0x31, 0x1b, 0x5b, 0xd4,
+	0x3b, 0x74, 0xba, 0x3c, 0x89, 0xe4, 0xb1, 0x84, 0x8c, 0x01, 0x14, 0x85, 0xde, 0x32, 0x18, 0xae,
+	0x8f, 0x29, 0x3e, 0x6d, 0x86, 0xa4, 0xc7, 0x6a, 0xbe, 0x04, 0xba, 0x2f, 0x79, 0x98, 0x97, 0x54,
+	0x69, 0xae, 0x58, 0xda, 0x94, 0xd2, 0x8c, 0xd8, 0x98, 0xbd, 0x61, 0x8d, 0xd0, 0xe7, 0xc5, 0xdc,
+	0x62, 0x8b, 0xa8, 0x13, 0xd8, 0x7f, 0x7f, 0x51, 0xa0, 0xc0, 0x15, 0x3f, 0xbf, 0xbd, 0x67, 0x21,
+	0xb3, 0xe7, 0x0d, 0x5c, 0x5b, 0xee, 0x08, 0x01, 0x44, 0x07, 0xa3, 0x7a, 0xe1, 0x83, 0xd1, 0x80,
+	0xac, 0xe3, 0x52, 0xe2, 0x52, 0x59, 0x6f, 0x00, 0xcb, 0xa5, 0x4d, 0x8e, 0xc1, 0x92, 0x62, 0xbc,
+	0x09, 0x85, 0x55, 0x87, 0xf4, 0xec, 0x55, 0xa7, 0x47, 0xa5, 0x48, 0x0c, 0xe4, 0x4a, 0xe8, 0x58,
+	0x00, 0x0c, 0x7b, 0x8f, 0xcd, 0x2b, 0x0e, 0x6b, 0x2c, 0x00, 0xe3, 0xe7, 0x2a, 0x4c, 0x6f, 0x0c,
+	0x7a, 0xd4, 0x79, 0x8e, 0xfc, 0x7f, 0x1b, 0x54, 0xc7, 0x16, 0x3b, 0xad, 0x58, 0x7b, 0x73, 0x78,
+	0x32, 0xaf, 0x36, 0xeb, 0xc1, 0x39, 0x22, 0x80, 0xcd, 0x82, 0x6c, 0x28, 0xee, 0x71, 0xb3, 0x11,
+	0xbb, 0xcd, 0x66, 0xd5, 0xf8, 0xac, 0xcb, 0x4c, 0xf4, 0x55, 0x89, 0x3f, 0xdf, 0xec, 0x85, 0x70,
+	0xda, 0xa6, 0x1d, 0xa0, 0x2f, 0x43, 0x4e, 0x80, 0x41, 0x25, 0xc3, 0xf7, 0x64, 0xd1, 0x8c, 0x79,
+	0x4c, 0xee, 0xc8, 0x90, 0xc5, 0xf8, 0xb5, 0x02, 0x85, 0xd0, 0x29, 0x75, 0xaf, 0xfb, 0xb9, 0xac,
+	0x6c, 0x0e, 0xa1, 0x3c, 0x8a, 0x9b, 0xf3, 0x6f, 0x9f, 0xd7, 0x40, 0xb3, 0xbd, 0x6e, 0x78, 0x52,
+	0x15, 0xcd, 0x98, 0xda, 0xd2, 0x2a, 0x9c, 0x6e, 0x7c, 0xa0, 0x42, 0x69, 0x9b, 0x58, 0x7e, 0xf7,
+	0xe0, 0x79, 0x89, 0xd2, 0x59, 0xc8, 0xbc, 0x37, 0x20, 0xfe, 0xb1, 0x38, 0x03, 0xb0, 0x00, 0x10,
+	0x02, 0x6d, 0xcf, 0xf7, 0x0e, 0xf9, 0x66, 0xce, 0x60, 0xfe, 0xcd, 0x38, 0x7b, 0x0e, 0x2b, 0xe6,
+	0x33, 0x1c, 0x29, 0x00, 0xc6, 0x19, 0xb0, 0x6a, 0x38, 0xbb, 0xa0, 0x5e, 0xd1, 0x31, 0xff, 0x66,
+	0x79, 0x83, 0x6f, 0xe6, 0xa0, 0x92, 0xe3, 0x58, 0x09, 0xa1, 0x05, 0x28, 0x58, 0xfb, 0xfb, 0x3e,
+	0xd9, 0xb7, 0xf8, 0xd5, 0x30, 0xcf, 0x57, 0x8c, 0xa3, 0xd0, 0x1b, 0xa0, 0x07, 0x5d, 0xdf, 0xeb,
+	0xf5, 0x98, 0xe2, 0xac, 0x96, 0xd7, 0x6b, 0xc5, 0xe1, 0xc9, 0x7c, 0x7e, 0x9b, 0x23, 0x9b, 0x75,
+	0x9c, 0x17, 0xe4, 0xa6, 0xcd, 0x12, 0xfd, 0x5d, 0x42, 0xfa, 0x6d, 0xab, 0xe7, 0xc8, 0x0a, 0x5e,
+	0xc5, 0x3a, 0xc3, 0x2c, 0x33, 0x84, 0xf1, 0x77, 0x05, 0x74, 0xe1, 0x89, 0x75, 0x67, 0x92, 0xb5,
+	0xe4, 0x2c, 0x64, 0x82, 0xae, 0xe7, 0x8b, 0xf3, 0x49, 0xc1, 0x02, 0x40, 0x77, 0x20, 0x1b, 0x78,
+	0x03, 0xbf, 0x4b, 0x2e, 0x74, 0x94, 0xca, 0x39, 0x22, 0x9b, 0x6a, 0x49, 0x9b, 0xca, 0x03, 0x36,
+	0x23, 0x72, 0xb1, 0x80, 0x58, 0x6e, 0x98, 0x0a, 0x23, 0xee, 0x42, 0xe9, 0x81, 0x7a, 0xd4, 0xea,
+	0x71, 0xad, 0x34, 0x2c, 0x00, 0xf4, 0x2a, 0x68, 0x07, 0x4e, 0x54, 0x2c, 0x80, 0x19, 0xd9, 0x33,
+	0x8c, 0x79, 0x46, 0x45, 0x15, 0xc8, 0x75, 0x06, 0xdd, 0xbb, 0x84, 0x06, 0x3c, 0x5c, 0x8a, 0x38,
+	0x04, 0x93, 0xde, 0xcc, 0x3c, 0xc9, 0x9b, 0xc6, 0x4f, 0x14, 0xc8, 0xc9, 0x7b, 0xe7, 0x64, 0x9d,
+	0xd5, 0xb5, 0x06, 0x81, 0x70, 0x96, 0x8e, 0x05, 0xc0, 0x04, 0xe6, 0x37, 0x38, 0x62, 0xcb, 0x2a,
+	0x3e, 0x04, 0xdf, 0xd2, 0x7e, 0xf9, 0xab, 0xf9, 0x94, 0xf1, 0x8b, 0x34, 0xe4, 0xd9, 0xa5, 0x6e,
+	0x83, 0x50, 0x8b, 0xe9, 0x30, 0xe8, 0xf7, 0x3c, 0xcb, 0x6e, 0x4b, 0x99, 0xa4, 0x0e, 0xbb, 0x1c,
+	0xc9, 0x74, 0x10, 0xe4, 0xa6, 0xcd, 0xdd, 0xe6, 0x7c, 0x9f, 0x48, 0x1b, 0xf2, 0x6f, 0x16, 0xa5,
+	0xfc, 0xd6, 0xdc, 0xe6, 0x14, 0xb6, 0x5c, 0x09, 0xeb, 0x1c, 0xb3, 0xcd, 0xc8, 0x2f, 0x43, 0x96,
+	0x03, 0xc2, 0x74, 0x25, 0x2c, 0x21, 0xf4, 0x0a, 0x14, 0xbb, 0x1e, 0x77, 0xb0, 0xe8, 0x1b, 0x70,
+	0xe3, 0xe1, 0x82, 0xc4, 0xf1, 0x9e, 0xc1, 0x0d, 0xc8, 0x33, 0x6d, 0xf9, 0x39, 0x99, 0xe5, 0x0e,
+	0xfa, 0x3f, 0x33, 0x94, 0xda, 0xdc, 0x90, 0x94, 0x86, 0x4b, 0xfd, 0x63, 0x1c, 0x31, 0xce, 0x7d,
+	0x03, 0x4a, 0x09, 0x12, 0x2a, 0x83, 0x7a, 0x97, 0x1c, 0xcb, 0x14, 0xcc, 0x3e, 0x93, 0x09, 0x58,
+	0x97, 0x09, 0xf8, 0xad, 0xf4, 0x2d, 0xc5, 0xf8, 0x71, 0x1a, 0xca, 0xe3, 0xfd, 0xa2, 0x09, 0x3a,
+	0x2b, 0x61, 0xe9, 0xf4, 0x13, 0x2d, 0x3d, 0x0b, 0x19, 0xc7, 0xb5, 0xc9, 0x91, 0x34, 0xa8, 0x00,
+	0xa2, 0xac, 0xa1, 0x5d, 0xb8, 0x9a, 0xf9, 0x02, 0xe8, 0xd4, 0x39, 0x24, 0x01, 0xb5, 0x0e, 0xfb,
+	0xdc, 0xf6, 0x2a, 0x1e, 0x21, 0x8c, 0x00, 0x66, 0x4e, 0xf5, 0x3e, 0x26, 0x1b, 0xb4, 0x42, 0xb9,
+	0x74, 0x4c, 0x39, 0xe3, 0x03, 0x45, 0x18, 0x3f, 0xde, 0x76, 0xfa, 0x4c, 0x8c, 0x6f, 0xfc, 0x50,
+	0x81, 0x99, 0x98, 0x24, 0x9f, 0xd1, 0x85, 0x90, 0x02, 0x30, 0x21, 0x84, 0x7c, 0x13, 0x5c, 0x3d,
+	0xe1, 0xfa, 0xf4, 0xb8, 0xeb, 0x7f, 0x20, 0x75, 0x4f, 0xf4, 0x3f, 0x27, 0xb8, 0xfa, 0x17, 0x41,
+	0x63, 0x18, 0xd9, 0x06, 0xd4, 0xa3, 0x0d, 0x1d, 0x1e, 0xb8, 0x8c, 0x68, 0xfc, 0x48, 0x01, 0x74,
+	0xba, 0x91, 0xf6, 0xcc, 0x3d, 0xf0, 0x87, 0x34, 0x4c, 0x6d, 0x0d, 0x28, 0x93, 0xe4, 0x85, 0xbb,
+	0x92, 0xa1, 0xcb, 0xd2, 0x51, 0xda, 0x98, 0xa3, 0x84, 0x8b, 0x58, 0x2a, 0xe0, 0x47, 0x51, 0x86,
+	0x27, 0x44, 0xfe, 0x6d, 0x3c, 0x50, 0x60, 0x3a, 0xb2, 0xd7, 0xf9, 0x53, 0xb5, 0xd0, 0x21, 0x3d,
+	0x51, 0x37, 0xab, 0x8f, 0x77, 0x73, 0x94, 0xd5, 0xb4, 0x51, 0x56, 0x33, 0x7e, 0x97, 0x86, 0xa9,
+	0x35, 0xf2, 0x82, 0xba, 0xfe, 0x65, 0xc8, 0x7a, 0x7b, 0x7b, 0x01, 0xa1, 0xd2, 0x24, 0x12, 0x62,
+	0xf8, 0x1e, 0x71, 0xf7, 0xe9, 0x01, 0xf7, 0xba, 0x86, 0x25, 0x64, 0xdc, 0x87, 0xe9, 0xc8, 0x56,
+	0xe7, 0x77, 0xfb, 0xe5, 0xc7, 0x9c, 0x0c, 0x63, 0x01, 0xa7, 0xc6, 0x02, 0xee, 0x3f, 0x0a, 0xcc,
+	0x88, 0xb6, 0xdd, 0x0b, 0xe9, 0x28, 0xe3, 0x10, 0x50, 0x5c, 0xfd, 0xf3, 0xdb, 0xfe, 0xe9, 0xce,
+	0xc3, 0x87, 0x2a, 0x14, 0x56, 0x0e, 0x2c, 0x77, 0x9f, 0x34, 0xee, 0x11, 0x97, 0x9e, 0x32, 0x9b,
+	0xf2, 0xbf, 0xbe, 0xc7, 0x8d, 0xaa, 0x06, 0x2d, 0x2c, 0x89, 0xe6, 0x20, 0xdf, 0xf7, 0x02, 0xce,
+	0x23, 0x6b, 0xa5, 0x08, 0x46, 0xf3, 0xa0, 0xf1, 0xda, 0x52, 0xe3, 0x3a, 0x15, 0x4c, 0x21, 0x3b,
+	0x7f, 0x97, 0xe2, 0x04, 0xe9, 0x89, 0xcc, 0x04, 0xb6, 0xcc, 0x1d, 0xc8, 0x76, 0xc8, 0x1e, 0xbb,
+	0x35, 0x65, 0x2f, 0x72, 0x3d, 0x12, 0x73, 0xb0, 0x1e, 0xae, 0xb5, 0x47, 0x89, 0x5f, 0xc9, 0x5d,
+	0x60, 0x32, 0x31, 0x45, 0x32, 0xdd, 0xe7, 0xc7, 0xd3, 0xfd, 0x3f, 0x14, 0xb8, 0xf4, 0x0e, 0xeb,
+	0xf5, 0x0a, 0xdb, 0x04, 0xcf, 0xcb, 0x1e, 0xba, 0x0c, 0xc0, 0xae, 0xec, 0xed, 0x51, 0x91, 0xac,
+	0x61, 0x9d, 0x61, 0x9a, 0x3c, 0x2a, 0xfe, 0x1f, 0xf2, 0x9c, 0xec, 0x7a, 0xf7, 0x65, 0x67, 0x37,
+	0xc7, 0xe0, 0x96, 0x77, 0xdf, 0x18, 0xc0, 0x6c, 0x52, 0xe1, 0xf3, 0xef, 0x9a, 0x45, 0xc8, 0x12,
+	0xb6, 0x11, 0x46, 0x5d, 0x93, 0xd8, 0xee, 0x90, 0x05, 0x8d, 0xe4, 0x30, 0x3e, 0x54, 0x40, 0x8f,
+	0x9e, 0xf9, 0xd0, 0x02, 0x64, 0xe9, 0x51, 0xb4, 0x67, 0xf4, 0x9a, 0x3e, 0x3c, 0x99, 0xcf, 0xb0,
+	0x7e, 0x62, 0x1d, 0x67, 0xe8, 0x11, 0x53, 0xd0, 0x80, 0x6c, 0x40, 0x2d, 0x3a, 0x08, 0xe4, 0x8e,
+	0xe4, 0xed, 0xc6, 0x6d, 0x8e, 0xc1, 0x92, 0xc2, 0x62, 0xdf, 0x26, 0x96, 0xdd, 0x73, 0x5c, 0x71,
+	0xf1, 0x52, 0x71, 0x04, 0xa3, 0x57, 0x40, 0xbb, 0x4b, 0x8e, 0x45, 0x1b, 0xad, 0xb0, 0x94, 0x63,
+	0xa3, 0x6f, 0x93, 0xe3, 0xb0, 0xca, 0x62, 0x24, 0xe3, 0x00, 0xb2, 0x02, 0xcb, 0xaf, 0xfc, 0x7d,
+	0xab, 0x4b, 0xc2, 0x46, 0x25, 0x07, 0x26, 0x97, 0x87, 0x8d, 0x77, 0x41, 0x8f, 0x9a, 0xa5, 0x4f,
+	0xa1, 0xfb, 0x1b, 0x90, 0xb9, 0xcf, 0x8e, 0x1f, 0x99, 0x0a, 0xce, 0xec, 0xe1, 0x0b, 0x0e, 0xa3,
+	0x05, 0xe5, 0xf1, 0x67, 0x74, 0x74, 0x85, 0x1d, 0x66, 0x0c, 0x21, 0x3d, 0x09, 0xa3, 0xf7, 0xd5,
+	0xd0, 0x29, 0x82, 0xce, 0xee, 0x86, 0x2c, 0x42, 0x44, 0x11, 0xcc, 0x3e, 0x8d, 0x2e, 0xcc, 0x9c,
+	0x7a, 0x8c, 0x8d, 0x9d, 0x8e, 0xca, 0x13, 0xca, 0x88, 0xd1, 0xb2, 0xe9, 0x27, 0x2f, 0x6b, 0x7c,
+	0x93, 0x2f, 0x92, 0x7c, 0xc8, 0x67, 0xc3, 0x65, 0xfb, 0x43, 0x19, 0xef, 0x2f, 0x87, 0xc3, 0x05,
+	0xdd, 0xf8, 0xbd, 0x02, 0xe8, 0xf4, 0xeb, 0xef, 0xb3, 0xae, 0x8e, 0xd1, 0x6b, 0x90, 0xef, 0x7a,
+	0xee, 0x5e, 0xcf, 0xe9, 0xd2, 0x8a, 0x3a, 0x2e, 0x32, 0x8e, 0x68, 0xc6, 0x47, 0x8a, 0xb4, 0x69,
+	0xfc, 0x2f, 0x08, 0x13, 0x94, 0x76, 0x14, 0x4f, 0xe9, 0xc7, 0xc4, 0x13, 0xeb, 0x41, 0x88, 0x57,
+	0x7a, 0xf9, 0xa6, 0x29, 0x20, 0x7e, 0xcd, 0x38, 0xfd, 0x20, 0xfe, 0xac, 0x0d, 0xb9, 0xf8, 0x57,
+	0x05, 0xb2, 0xe2, 0xef, 0x12, 0x08, 0x20, 0xbb, 0x82, 0x1b, 0xcb, 0x3b, 0x8d, 0x72, 0x8a, 0x7d,
+	0xef, 0x6e, 0xd5, 0xd9, 0xb7, 0xc2, 0xbe, 0xeb, 0x8d, 0x3b, 0x8d, 0x9d, 0x46, 0x39, 0x8d, 0xa6,
+	0x00, 0x6a, 0x77, 0x36, 0x6b, 0xed, 0x95, 0xf5, 0xdd, 0xd6, 0xed, 0xb2, 0x8a, 0xa6, 0xa1, 0x20,
+	0xe0, 0xcd, 0x8d, 0x8d, 0xe6, 0x4e, 0x59, 0x8b, 0x10, 0x72, 0x44, 0x06, 0x21, 0x98, 0xda, 0x79,
+	0xb7, 0xd5, 0xc6, 0x8d, 0x95, 0x4d, 0x5c, 0x6f, 0x6f, 0xed, 0xee, 0x94, 0xb3, 0xe8, 0x25, 0x98,
+	0x89, 0xe1, 0x56, 0x9b, 0xad, 0xe6, 0xf6, 0x7a, 0x39, 0x37, 0x86, 0x96, 0x33, 0xe4, 0xd9, 0x94,
+	0x0c, 0xbd, 0x85, 0x1b, 0x5b, 0xcb, 0xb8, 0x51, 0xd6, 0x43, 0x04, 0x6e, 0x6c, 0x6f, 0xde, 0x79,
+	0xbb, 0x51, 0x86, 0x48, 0xaa, 0xe5, 0xda, 0x26, 0xde, 0x29, 0x17, 0x16, 0x37, 0xa0, 0x10, 0xd3,
+	0x15, 0x15, 0x20, 0x27, 0x14, 0xab, 0x97, 0x53, 0x0c, 0x10, 0x9a, 0xd5, 0xcb, 0x0a, 0x03, 0xc4,
+	0x32, 0xf5, 0x72, 0x1a, 0x95, 0x40, 0x6f, 0x6d, 0xee, 0xb4, 0x57, 0x37, 0x77, 0x5b, 0xf5, 0xb2,
+	0x8a, 0xf2, 0xa0, 0xb5, 0x36, 0x37, 0xb7, 0xca, 0xda, 0x62, 0x03, 0x60, 0x94, 0xbd, 0xd1, 0x0c,
+	0x94, 0x56, 0xd6, 0x97, 0x5b, 0x6b, 0x8d, 0x76, 0xb3, 0xb5, 0xdd, 0xc0, 0x3b, 0xe5, 0x54, 0x0c,
+	0x15, 0x19, 0x6d, 0x84, 0x0a, 0x6d, 0xb7, 0xf8, 0x6d, 0xd0, 0xa3, 0x63, 0x34, 0x52, 0xaa, 0xd1,
+	0xaa, 0x37, 0x5b, 0x6b, 0x62, 0x0e, 0x86, 0x10, 0x86, 0x14, 0xd2, 0x49, 0x1e, 0xae, 0x15, 0x93,
+	0x70, 0xe9, 0x23, 0x15, 0x72, 0xcb, 0x7d, 0x67, 0xcd, 0xef, 0x77, 0xd1, 0x22, 0xe8, 0xec, 0x95,
+	0x90, 0xeb, 0x89, 0x8a, 0x66, 0xec, 0xa5, 0x74, 0xae, 0x64, 0xc6, 0xdf, 0x0f, 0x8d, 0x14, 0x32,
+	0x40, 0x5d, 0x23, 0x14, 0x15, 0xcc, 0xd1, 0xfb, 0xce, 0x5c, 0xd1, 0x8c, 0x35, 0xed, 0x8d, 0x14,
+	0xba, 0x0e, 0xf9, 0xb0, 0xed, 0x8e, 0xca, 0xe6, 0xd8, 0x6b, 0xd0, 0xdc, 0x8c, 0x39, 0xde, 0xe7,
+	0x37, 0x52, 0xe8, 0x4b, 0x90, 0x15, 0x3d, 0x4b, 0x34, 0x65, 0x26, 0xda, 0xf2, 0x73, 0xd3, 0x66,
+	0xb2, 0x69, 0x6a, 0xa4, 0xd0, 0x35, 0xc8, 0xc9, 0xeb, 0x19, 0x9a, 0x36, 0x93, 0x17, 0xdb, 0xb9,
+	0xb2, 0x39, 0x76, 0x73, 0x33, 0x52, 0x57, 0x14, 0x36, 0x42, 0x56, 0xf6, 0x68, 0xda, 0x4c, 0xde,
+	0x87, 0xe6, 0xca, 0xe6, 0x58, 0xd1, 0x6f, 0xa4, 0xae, 0x29, 0xe8, 0xeb, 0x00, 0xa3, 0x92, 0x14,
+	0x21, 0xf3, 0x54, 0x79, 0x3e, 0x77, 0xc9, 0x3c, 0x5d, 0xb3, 0x1a, 0x29, 0xf4, 0x2d, 0x28, 0xc6,
+	0xf3, 0x32, 0x9a, 0x35, 0xcf, 0xa8, 0x4b, 0xe6, 0x5e, 0x32, 0xcf, 0x4a, 0xde, 0x6c, 0xe5, 0xda,
+	0xad, 0x07, 0x8f, 0xaa, 0xa9, 0xbf, 0x3d, 0xaa, 0xa6, 0x3e, 0x7e, 0x54, 0x4d, 0xfd, 0xf3, 0x51,
+	0x35, 0xf5, 0xef, 0x47, 0x55, 0xe5, 0xfd, 0x61, 0x55, 0xf9, 0xed, 0xb0, 0xaa, 0xfc, 0x71, 0x58,
+	0x4d, 0xfd, 0x79, 0x58, 0x4d, 0x3d, 0x18, 0x56, 0x95, 0x87, 0xc3, 0xaa, 0xf2, 0xf1, 0xb0, 0xaa,
+	0xac, 0x2b, 0xdf, 0xd3, 0xfa, 0x41, 0xbf, 0xd3, 0xc9, 0xf2, 0xcd, 0x7b, 0xe3, 0xbf, 0x03, 0x00,
+	0x53, 0x26, 0xc9, 0xc3, 0x5d, 0x27, 0x00, 0x00,
 }
@@ -18,6 +18,9 @@ func CreateRaftCommand() *RaftCommand {
 // Close reset and put to pool
 func (c *RaftCommand) Close() error {
 	c.WriteCommands = nil
+	c.Atomic = false
+	c.Timestamp = 0
+	c.ChangeFloor = 0
 	raftCmdPool.Put(c)
 	return nil
 }
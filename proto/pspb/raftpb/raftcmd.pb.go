@@ -39,15 +39,23 @@ type CmdType int32
 const (
 	CmdType_WRITE CmdType = 0
 	CmdType_ADMIN CmdType = 1
+	// hashes the data of the replica at the index of the command
+	CmdType_CHECKSUM CmdType = 2
+	// drops the change events up to change_floor
+	CmdType_CHANGE_GC CmdType = 3
 )
 
 var CmdType_name = map[int32]string{
 	0: "WRITE",
 	1: "ADMIN",
+	2: "CHECKSUM",
+	3: "CHANGE_GC",
 }
 var CmdType_value = map[string]int32{
-	"WRITE": 0,
-	"ADMIN": 1,
+	"WRITE":     0,
+	"ADMIN":     1,
+	"CHECKSUM":  2,
+	"CHANGE_GC": 3,
 }
 
 func (x CmdType) String() string {
@@ -58,6 +66,12 @@ func (CmdType) EnumDescriptor() ([]byte, []int) { return fileDescriptorRaftcmd,
 type RaftCommand struct {
 	Type          CmdType            `protobuf:"varint,1,opt,name=type,proto3,enum=CmdType" json:"type,omitempty"`
 	WriteCommands []api.RequestUnion `protobuf:"bytes,2,rep,name=write_commands,json=writeCommands" json:"write_commands"`
+	// the write commands commit all or none
+	Atomic bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// unix nanoseconds the command was proposed at, the time of its change events
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// the greatest raft index whose change events are dropped by a CHANGE_GC
+	ChangeFloor uint64 `protobuf:"varint,5,opt,name=change_floor,json=changeFloor,proto3" json:"change_floor,omitempty"`
 }
 
 func (m *RaftCommand) Reset()                    { *m = RaftCommand{} }
@@ -98,6 +112,15 @@ func (this *RaftCommand) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Atomic != that1.Atomic {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.ChangeFloor != that1.ChangeFloor {
+		return false
+	}
 	return true
 }
 func (m *RaftCommand) Marshal() (dAtA []byte, err error) {
@@ -132,6 +155,26 @@ func (m *RaftCommand) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Atomic {
+		dAtA[i] = 0x18
+		i++
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRaftcmd(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.ChangeFloor != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRaftcmd(dAtA, i, uint64(m.ChangeFloor))
+	}
 	return i, nil
 }
 
@@ -146,8 +189,8 @@ func encodeVarintRaftcmd(dAtA []byte, offset int, v uint64) int {
 }
 func NewPopulatedRaftCommand(r randyRaftcmd, easy bool) *RaftCommand {
 	this := &RaftCommand{}
-	this.Type = CmdType([]int32{0, 1}[r.Intn(2)])
-	if r.Intn(10) != 0 {
+	this.Type = CmdType([]int32{0, 1, 2, 3}[r.Intn(4)])
+	if r.Intn(10) == 0 {
 		v1 := r.Intn(5)
 		this.WriteCommands = make([]api.RequestUnion, v1)
 		for i := 0; i < v1; i++ {
@@ -155,6 +198,12 @@ func NewPopulatedRaftCommand(r randyRaftcmd, easy bool) *RaftCommand {
 			this.WriteCommands[i] = *v2
 		}
 	}
+	this.Atomic = bool(bool(r.Intn(2) == 0))
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	this.ChangeFloor = uint64(uint64(r.Uint32()))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -244,6 +293,15 @@ func (m *RaftCommand) Size() (n int) {
 			n += 1 + l + sovRaftcmd(uint64(l))
 		}
 	}
+	if m.Atomic {
+		n += 2
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRaftcmd(uint64(m.Timestamp))
+	}
+	if m.ChangeFloor != 0 {
+		n += 1 + sovRaftcmd(uint64(m.ChangeFloor))
+	}
 	return n
 }
 
@@ -267,6 +325,9 @@ func (this *RaftCommand) String() string {
 	s := strings.Join([]string{`&RaftCommand{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`WriteCommands:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.WriteCommands), "RequestUnion", "api.RequestUnion", 1), `&`, ``, 1) + `,`,
+		`Atomic:` + fmt.Sprintf("%v", this.Atomic) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`ChangeFloor:` + fmt.Sprintf("%v", this.ChangeFloor) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -358,6 +419,64 @@ func (m *RaftCommand) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeFloor", wireType)
+			}
+			m.ChangeFloor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeFloor |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmd(dAtA[iNdEx:])
@@ -487,24 +606,29 @@ var (
 func init() { proto.RegisterFile("raftcmd.proto", fileDescriptorRaftcmd) }
 
 var fileDescriptorRaftcmd = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x4a, 0x4c, 0x2b,
-	0x49, 0xce, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x97, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0x0b, 0x27, 0x95, 0xa6,
-	0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0x55, 0x6e, 0x84, 0xa4, 0xbc, 0x24, 0x33, 0x3d, 0x27, 0x31,
-	0xa9, 0x58, 0x3f, 0x29, 0xb1, 0x34, 0x25, 0x35, 0x2f, 0x3d, 0x33, 0x2f, 0x15, 0xa2, 0x59, 0xbf,
-	0xa0, 0xb8, 0x20, 0x49, 0x3f, 0xb1, 0x20, 0x13, 0xa2, 0x47, 0x29, 0x9d, 0x8b, 0x3b, 0x28, 0x31,
-	0xad, 0xc4, 0x39, 0x3f, 0x37, 0x37, 0x31, 0x2f, 0x45, 0x48, 0x86, 0x8b, 0xa5, 0xa4, 0xb2, 0x20,
-	0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xcf, 0x88, 0x43, 0xcf, 0x39, 0x37, 0x25, 0xa4, 0xb2, 0x20,
-	0x35, 0x08, 0x2c, 0x2a, 0x64, 0xc5, 0xc5, 0x57, 0x5e, 0x94, 0x59, 0x92, 0x1a, 0x9f, 0x0c, 0x51,
-	0x5e, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xc4, 0xab, 0x17, 0x94, 0x5a, 0x58, 0x9a, 0x5a,
-	0x5c, 0x12, 0x9a, 0x97, 0x99, 0x9f, 0xe7, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x2f, 0x58,
-	0x29, 0xd4, 0xe0, 0x62, 0x2d, 0x79, 0x2e, 0x76, 0xa8, 0x61, 0x42, 0x9c, 0x5c, 0xac, 0xe1, 0x41,
-	0x9e, 0x21, 0xae, 0x02, 0x0c, 0x20, 0xa6, 0xa3, 0x8b, 0xaf, 0xa7, 0x9f, 0x00, 0xa3, 0x93, 0xdd,
-	0x89, 0x87, 0x72, 0x0c, 0x37, 0x1e, 0xca, 0x31, 0x3c, 0x78, 0x28, 0xc7, 0xf0, 0xe1, 0xa1, 0x1c,
-	0xc3, 0x8f, 0x87, 0x72, 0x8c, 0x0d, 0x8f, 0xe4, 0x18, 0x57, 0x3c, 0x92, 0x63, 0xdc, 0xf1, 0x48,
-	0x8e, 0xe1, 0xc0, 0x23, 0x39, 0x86, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x06, 0x0f, 0xc6, 0x28, 0x36, 0x50, 0x98, 0x15, 0x24, 0x25,
-	0xb1, 0x81, 0x3d, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x4f, 0x67, 0x0c, 0x44, 0x01,
-	0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x6a, 0xdc, 0x30,
+	0x00, 0x86, 0xa5, 0xd8, 0xb9, 0xde, 0xe9, 0xe2, 0x60, 0x34, 0x14, 0x53, 0x82, 0xea, 0x76, 0x32,
+	0x85, 0xda, 0x70, 0xdd, 0x42, 0x97, 0xc4, 0xbd, 0xe6, 0x42, 0x49, 0x06, 0x35, 0xa1, 0xd0, 0xe5,
+	0x90, 0x6d, 0xd9, 0x11, 0x44, 0x96, 0x6a, 0xcb, 0x94, 0x6c, 0x7d, 0x9c, 0x3e, 0x42, 0xc7, 0x1b,
+	0x6f, 0xec, 0xd8, 0xa9, 0x9c, 0xfd, 0x04, 0x1d, 0x3b, 0x96, 0xb3, 0x0d, 0xcd, 0xf6, 0xff, 0x1f,
+	0xdf, 0x2f, 0x84, 0x84, 0x9c, 0x8a, 0xe5, 0x26, 0x95, 0x59, 0xa8, 0x2b, 0x65, 0xd4, 0xb3, 0xd7,
+	0x85, 0x30, 0x77, 0x4d, 0x12, 0xa6, 0x4a, 0x46, 0x85, 0x2a, 0x54, 0xd4, 0xe3, 0xa4, 0xc9, 0xfb,
+	0xd6, 0x97, 0x3e, 0x8d, 0xfa, 0xe2, 0x91, 0x6e, 0x44, 0x71, 0xcf, 0x92, 0x3a, 0x4a, 0x58, 0x93,
+	0xf1, 0xb2, 0x10, 0x25, 0x1f, 0xc6, 0x91, 0xae, 0x75, 0x12, 0x31, 0x2d, 0x86, 0xcd, 0xcb, 0x0d,
+	0x44, 0x73, 0xca, 0x72, 0x13, 0x2b, 0x29, 0x59, 0x99, 0xe1, 0x13, 0x64, 0x9b, 0x07, 0xcd, 0x3d,
+	0xe8, 0xc3, 0xe0, 0x78, 0x31, 0x0d, 0x63, 0x99, 0xdd, 0x3c, 0x68, 0x4e, 0x7b, 0x8a, 0x4f, 0xd1,
+	0xf1, 0xd7, 0x4a, 0x18, 0xbe, 0x4e, 0x07, 0xbd, 0xf6, 0x0e, 0x7c, 0x2b, 0x98, 0x2f, 0x9c, 0x90,
+	0xf2, 0x2f, 0x0d, 0xaf, 0xcd, 0x6d, 0x29, 0x54, 0x79, 0x6e, 0x6f, 0x7f, 0x3f, 0x07, 0xd4, 0xe9,
+	0xd5, 0xf1, 0xe0, 0x1a, 0x3f, 0x45, 0x13, 0x66, 0x94, 0x14, 0xa9, 0x67, 0xf9, 0x30, 0x98, 0xd2,
+	0xb1, 0xe1, 0x13, 0x34, 0x33, 0x42, 0xf2, 0xda, 0x30, 0xa9, 0x3d, 0xdb, 0x87, 0x81, 0x45, 0xff,
+	0x03, 0xfc, 0x02, 0x1d, 0xa5, 0x77, 0xac, 0x2c, 0xf8, 0x3a, 0xbf, 0x57, 0xaa, 0xf2, 0x0e, 0x7d,
+	0x18, 0xd8, 0x74, 0x3e, 0xb0, 0xf7, 0x7b, 0xf4, 0xea, 0x2d, 0x7a, 0x32, 0xde, 0x12, 0xcf, 0xd0,
+	0xe1, 0x27, 0x7a, 0x79, 0xb3, 0x74, 0xc1, 0x3e, 0x9e, 0xbd, 0xbb, 0xba, 0xbc, 0x76, 0x21, 0x3e,
+	0x42, 0xd3, 0x78, 0xb5, 0x8c, 0x3f, 0x7c, 0xbc, 0xbd, 0x72, 0x0f, 0xb0, 0x83, 0x66, 0xf1, 0xea,
+	0xec, 0xfa, 0x62, 0xb9, 0xbe, 0x88, 0x5d, 0xeb, 0xfc, 0x74, 0xdb, 0x12, 0xf0, 0xab, 0x25, 0x60,
+	0xd7, 0x12, 0xf0, 0xa7, 0x25, 0xe0, 0x6f, 0x4b, 0xe0, 0xb7, 0x8e, 0xc0, 0xef, 0x1d, 0x81, 0x3f,
+	0x3a, 0x02, 0x36, 0x1d, 0x01, 0xdb, 0x8e, 0xc0, 0x9f, 0x1d, 0x81, 0xbb, 0x8e, 0xc0, 0x15, 0xfc,
+	0x3c, 0xd9, 0x7f, 0x93, 0x4e, 0x92, 0x49, 0xff, 0x86, 0x6f, 0xfe, 0x0d, 0x00, 0x67, 0x2c, 0x3f,
+	0x03, 0xb7, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestBlobSpans(t *testing.T) {
+	meta := &pspb.BlobMeta{Size_: 10, ChunkSize: 4, Chunks: 3}
+	cases := []struct {
+		offset, length uint64
+		expect         []blobSpan
+	}{
+		{0, 0, []blobSpan{{0, 0, 4}, {1, 0, 4}, {2, 0, 2}}},
+		{0, 10, []blobSpan{{0, 0, 4}, {1, 0, 4}, {2, 0, 2}}},
+		{0, 100, []blobSpan{{0, 0, 4}, {1, 0, 4}, {2, 0, 2}}},
+		{1, 2, []blobSpan{{0, 1, 3}}},
+		{3, 2, []blobSpan{{0, 3, 4}, {1, 0, 1}}},
+		{4, 4, []blobSpan{{1, 0, 4}}},
+		{5, 0, []blobSpan{{1, 1, 4}, {2, 0, 2}}},
+		{9, 1, []blobSpan{{2, 1, 2}}},
+		{10, 0, nil},
+		{12, 5, nil},
+	}
+	for _, c := range cases {
+		if spans := blobSpans(meta, c.offset, c.length); !reflect.DeepEqual(spans, c.expect) {
+			t.Fatalf("read [%d, +%d): expect %v, got %v", c.offset, c.length, c.expect, spans)
+		}
+	}
+
+	if spans := blobSpans(&pspb.BlobMeta{ChunkSize: 4}, 0, 0); spans != nil {
+		t.Fatalf("unexpected spans of an empty blob %v", spans)
+	}
+}
@@ -13,10 +13,26 @@ import (
 	"github.com/tiglabs/baudengine/util/multierror"
 )
 
+const (
+	// a chunk is one raft entry, keep it well under the message size limits
+	defaultBlobChunkSize = 1 << 20
+	maxBlobChunkSize     = 4 << 20
+
+	// change events are retained for a day by default
+	defaultChangesRetention = 24 * 3600
+
+	// the disk usage percentages of the watermarks
+	defaultDiskLowWatermark   = 85
+	defaultDiskHighWatermark  = 90
+	defaultDiskFloodWatermark = 95
+)
+
 // Config ps server config
 type Config struct {
 	ClusterID         string        `json:"cluster-id,omitempty"`
 	NodeID            metapb.NodeID `json:"node-id,omitempty"`
+	// the comma separated addresses of the masters of the zone, the leader is followed among them,
+	// the list is the only way to find the masters, the elections in the topo server are not asked
 	MasterServer      string        `json:"master-server,omitempty"`
 	PartitionStore    string        `json:"partition-store,omitempty"`
 	StoreEngine       string        `json:"store-engine,omitempty"`
@@ -26,6 +42,13 @@ type Config struct {
 	RPCPort           int           `json:"rpc-port,omitempty"`
 	AdminPort         int           `json:"admin-port,omitempty"`
 	HeartbeatInterval int           `json:"heartbeat-interval,omitempty"`
+	BlobChunkSize     int           `json:"blob-chunk-size,omitempty"`
+	ChangesRetention  int           `json:"changes-retention,omitempty"`
+	// over the high watermark of disk usage the partitions are read-only until the usage is back
+	// under the low watermark, over the flood watermark new replicas are refused as well
+	DiskLowWatermark   int `json:"disk-low-watermark,omitempty"`
+	DiskHighWatermark  int `json:"disk-high-watermark,omitempty"`
+	DiskFloodWatermark int `json:"disk-flood-watermark,omitempty"`
 
 	RaftHeartbeatPort      int    `json:"raft-heartbeat-port,omitempty"`
 	RaftReplicatePort      int    `json:"raft-replicate-port,omitempty"`
@@ -80,6 +103,26 @@ func LoadConfig(conf *config.Config) *Config {
 	if heartbeat := conf.GetString("heartbeat.interval"); heartbeat != "" {
 		c.HeartbeatInterval, _ = strconv.Atoi(heartbeat)
 	}
+	c.BlobChunkSize = defaultBlobChunkSize
+	if chunkSize := conf.GetString("blob.chunk.size"); chunkSize != "" {
+		c.BlobChunkSize, _ = strconv.Atoi(chunkSize)
+	}
+	c.ChangesRetention = defaultChangesRetention
+	if retention := conf.GetString("changes.retention"); retention != "" {
+		c.ChangesRetention, _ = strconv.Atoi(retention)
+	}
+	c.DiskLowWatermark = defaultDiskLowWatermark
+	if watermark := conf.GetString("disk.watermark.low"); watermark != "" {
+		c.DiskLowWatermark, _ = strconv.Atoi(watermark)
+	}
+	c.DiskHighWatermark = defaultDiskHighWatermark
+	if watermark := conf.GetString("disk.watermark.high"); watermark != "" {
+		c.DiskHighWatermark, _ = strconv.Atoi(watermark)
+	}
+	c.DiskFloodWatermark = defaultDiskFloodWatermark
+	if watermark := conf.GetString("disk.watermark.flood"); watermark != "" {
+		c.DiskFloodWatermark, _ = strconv.Atoi(watermark)
+	}
 
 	if raftHbPort := conf.GetString("raft.heartbeat.port"); raftHbPort != "" {
 		c.RaftHeartbeatPort, _ = strconv.Atoi(raftHbPort)
@@ -137,6 +180,16 @@ func (c *Config) Validate() error {
 	if c.HeartbeatInterval <= 0 {
 		multierr.Append(errors.New("heartbeat.interval not specified"))
 	}
+	if c.BlobChunkSize <= 0 || c.BlobChunkSize > maxBlobChunkSize {
+		multierr.Append(fmt.Errorf("blob.chunk.size must be in (0, %d]", maxBlobChunkSize))
+	}
+	if c.ChangesRetention < 0 {
+		multierr.Append(errors.New("changes.retention must not be negative"))
+	}
+	if c.DiskLowWatermark <= 0 || c.DiskLowWatermark >= c.DiskHighWatermark ||
+		c.DiskHighWatermark > c.DiskFloodWatermark || c.DiskFloodWatermark > 100 {
+		multierr.Append(errors.New("disk.watermark must be 0 < low < high <= flood <= 100"))
+	}
 
 	if c.isRaftStore {
 		if c.RaftHeartbeatPort <= 0 {
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+// diskGuard follows the disk usage against the watermarks. Over the high watermark the partitions
+// turn read-only, they take writes again only once the usage is back under the low watermark, so
+// the state does not flap around a single line.
+type diskGuard struct {
+	lock  sync.RWMutex
+	state masterpb.DiskState
+}
+
+func (g *diskGuard) getState() masterpb.DiskState {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return g.state
+}
+
+func (g *diskGuard) readOnly() bool {
+	return g.getState() != masterpb.DiskState_DiskNormal
+}
+
+func (g *diskGuard) full() bool {
+	return g.getState() == masterpb.DiskState_DiskFull
+}
+
+// update moves the state by the used percentage of the disk, it returns the previous state.
+func (g *diskGuard) update(used float64, low, high, flood int) (prev, state masterpb.DiskState) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	prev = g.state
+	switch {
+	case used >= float64(flood):
+		g.state = masterpb.DiskState_DiskFull
+	case used >= float64(high):
+		g.state = masterpb.DiskState_DiskReadOnly
+	case used < float64(low):
+		g.state = masterpb.DiskState_DiskNormal
+	case g.state == masterpb.DiskState_DiskFull:
+		g.state = masterpb.DiskState_DiskReadOnly
+	}
+	return prev, g.state
+}
+
+// checkDisk updates the disk state by the stats of the heartbeat, and switches the writes of every
+// replica of the node off or back on when the state crosses a watermark. A replica becoming the
+// leader reads the state again.
+func (s *Server) checkDisk(stats *masterpb.NodeSysStats) masterpb.DiskState {
+	if stats.DiskTotal == 0 {
+		return s.diskGuard.getState()
+	}
+
+	used := float64(stats.DiskUsed) * 100 / float64(stats.DiskTotal)
+	prev, state := s.diskGuard.update(used, s.DiskLowWatermark, s.DiskHighWatermark, s.DiskFloodWatermark)
+	if prev == state {
+		return state
+	}
+
+	log.Warn("Server disk used %.1f%%, state changed from %s to %s", used, prev, state)
+	readOnly := state != masterpb.DiskState_DiskNormal
+	if readOnly != (prev != masterpb.DiskState_DiskNormal) {
+		s.partitions.Range(func(key, value interface{}) bool {
+			value.(PartitionStore).SetReadOnly(readOnly)
+			return true
+		})
+	}
+	return state
+}
+
+// replicaDiskGuard keeps the partitions led by the server with a replica on a node over the high
+// disk watermark, as the master reports them on the heartbeats. The leader rejects the writes
+// adding data to them, the followers could not store the data either.
+type replicaDiskGuard struct {
+	lock       sync.RWMutex
+	partitions map[metapb.PartitionID]metapb.NodeID
+}
+
+func (g *replicaDiskGuard) set(states []masterpb.ReplicaDiskState) {
+	partitions := make(map[metapb.PartitionID]metapb.NodeID, len(states))
+	for _, state := range states {
+		if state.DiskState != masterpb.DiskState_DiskNormal {
+			partitions[state.PartitionID] = state.NodeID
+		}
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	for partitionId, nodeId := range partitions {
+		if _, ok := g.partitions[partitionId]; !ok {
+			log.Warn("the disk of node[%d] with a replica of partition[%d] is full, the writes are rejected", nodeId, partitionId)
+		}
+	}
+	for partitionId := range g.partitions {
+		if _, ok := partitions[partitionId]; !ok {
+			log.Info("the replicas of partition[%d] are back under the disk watermark", partitionId)
+		}
+	}
+	g.partitions = partitions
+}
+
+// full returns the node of a replica of the partition over the watermark.
+func (g *replicaDiskGuard) full(partitionId metapb.PartitionID) (metapb.NodeID, bool) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	nodeId, ok := g.partitions[partitionId]
+	return nodeId, ok
+}
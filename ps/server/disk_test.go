@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestDiskGuard(t *testing.T) {
+	var g diskGuard
+	for _, step := range []struct {
+		used  float64
+		state masterpb.DiskState
+	}{
+		{80, masterpb.DiskState_DiskNormal},
+		{90, masterpb.DiskState_DiskReadOnly},
+		// between the watermarks the state is kept
+		{80, masterpb.DiskState_DiskReadOnly},
+		{95, masterpb.DiskState_DiskFull},
+		{80, masterpb.DiskState_DiskReadOnly},
+		{70, masterpb.DiskState_DiskNormal},
+		{80, masterpb.DiskState_DiskNormal},
+	} {
+		if _, state := g.update(step.used, 75, 85, 95); state != step.state {
+			t.Fatalf("disk used %.0f%%: expect %s, got %s", step.used, step.state, state)
+		}
+	}
+}
+
+func TestReplicaDiskFull(t *testing.T) {
+	s := new(Server)
+	store := &bulkStore{meta: metapb.Partition{ID: 1, Space: 2}}
+	create := []pspb.RequestUnion{{OpType: pspb.OpType_DELETE}, {OpType: pspb.OpType_CREATE}}
+	free := []pspb.RequestUnion{{OpType: pspb.OpType_DELETE}}
+
+	// a replica over the watermark stops the writes adding data to its partition only
+	s.replicaDiskGuard.set([]masterpb.ReplicaDiskState{
+		{PartitionID: 1, NodeID: 3, DiskState: masterpb.DiskState_DiskReadOnly},
+		{PartitionID: 4, NodeID: 3, DiskState: masterpb.DiskState_DiskNormal},
+	})
+	_, err := s.bulk(store, create, false, "")
+	if readOnly, ok := err.(*metapb.PartitionReadOnly); !ok || readOnly.PartitionID != 1 || readOnly.NodeID != 3 {
+		t.Fatalf("unexpected error of a write with a replica over the watermark: %v", err)
+	}
+	if _, err := s.bulk(store, free, false, ""); err != nil {
+		t.Fatal(err)
+	}
+	other := &bulkStore{meta: metapb.Partition{ID: 4, Space: 2}}
+	if _, err := s.bulk(other, create, false, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// the replicas back under the watermark are no longer reported
+	s.replicaDiskGuard.set(nil)
+	if _, err := s.bulk(store, create, false, ""); err != nil {
+		t.Fatal(err)
+	}
+	if store.bulks != 2 || other.bulks != 1 {
+		t.Fatalf("unexpected bulks %d, %d", store.bulks, other.bulks)
+	}
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/routine"
+	"github.com/tiglabs/baudengine/util/uuid"
+)
+
+const (
+	// the wait for the writes in flight to finish and the leaders to move, it stays well under the
+	// time limit of a graceful shutdown
+	defaultDrainTimeout = 10 * time.Second
+	drainCheckInterval  = 100 * time.Millisecond
+	changeLeaderTimeout = time.Second
+)
+
+var errNoFollower = errors.New("no follower alive to take the leadership")
+
+// writeGate counts the Bulk proposals in flight, the drain closes it and waits for them.
+type writeGate struct {
+	lock     sync.Mutex
+	draining bool
+	writes   int
+	// closed when the writes in flight a drain waits for finish
+	idle chan struct{}
+}
+
+// enter counts a write in flight, it returns false once the gate is closed.
+func (g *writeGate) enter() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.draining {
+		return false
+	}
+	g.writes++
+	return true
+}
+
+func (g *writeGate) leave() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.writes--
+	if g.writes == 0 && g.idle != nil {
+		close(g.idle)
+		g.idle = nil
+	}
+}
+
+func (g *writeGate) close() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.draining = true
+}
+
+func (g *writeGate) open() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.draining = false
+}
+
+// wait waits for the writes in flight, it returns false when ctx is done first. A wait timed out
+// leaves nothing behind, the gate can be waited for again by the next drain.
+func (g *writeGate) wait(ctx context.Context) bool {
+	g.lock.Lock()
+	if g.writes == 0 {
+		g.lock.Unlock()
+		return true
+	}
+	if g.idle == nil {
+		g.idle = make(chan struct{})
+	}
+	idle := g.idle
+	g.lock.Unlock()
+
+	select {
+	case <-idle:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// bulk submits the writes unless the server drains. A drained partition led elsewhere answers
+// NotLeader with its new leader from the store, one the server still leads answers
+// PartitionDraining, which the router retries. The writes adding data to a space over its quota,
+// or to a partition with a replica on a full disk, are rejected.
+func (s *Server) bulk(store PartitionStore, requests []pspb.RequestUnion, atomic bool, timeout string) ([]pspb.ResponseUnion, error) {
+	meta := store.GetMeta()
+	if !s.writeGate.enter() {
+		if store.GetStats().IsLeader {
+			return nil, &metapb.PartitionDraining{PartitionID: meta.ID, NodeID: s.NodeID}
+		}
+		// the proposal of a follower is refused with the leader it knows
+		return store.Bulk(requests, atomic, timeout)
+	}
+	defer s.writeGate.leave()
+
+	if s.quotaGuard.exceeded(meta.Space) && addsData(requests) {
+		return nil, &metapb.QuotaExceeded{PartitionID: meta.ID, SpaceID: meta.Space}
+	}
+	if nodeId, full := s.replicaDiskGuard.full(meta.ID); full && addsData(requests) {
+		return nil, &metapb.PartitionReadOnly{PartitionID: meta.ID, NodeID: nodeId}
+	}
+
+	return store.Bulk(requests, atomic, timeout)
+}
+
+// drain moves the leaderships of the partitions to their followers first, so the writes go on
+// there, then rejects the new writes and waits for the writes in flight. It returns the
+// partitions still led by the server.
+func (s *Server) drain(ctx context.Context) []metapb.PartitionID {
+	log.Info("Server draining...")
+	if s.raftServer != nil {
+		s.transferLeaders(ctx)
+	}
+
+	s.writeGate.close()
+	if !s.writeGate.wait(ctx) {
+		log.Warn("Server drain timeout to wait the writes in flight")
+	}
+
+	var leaders []metapb.PartitionID
+	s.partitions.Range(func(key, value interface{}) bool {
+		id := value.(PartitionStore).GetMeta().ID
+		if s.raftServer != nil && s.raftServer.IsLeader(id) {
+			leaders = append(leaders, id)
+		}
+		return true
+	})
+	log.Info("Server drained, still leading partitions %v", leaders)
+	return leaders
+}
+
+// resume accepts the writes again after a drain, the leaderships come back by the elections.
+func (s *Server) resume() {
+	s.writeGate.open()
+	log.Info("Server resumed from drain")
+}
+
+func (s *Server) transferLeaders(ctx context.Context) {
+	wg := new(sync.WaitGroup)
+	s.partitions.Range(func(key, value interface{}) bool {
+		store := value.(PartitionStore)
+		if !s.raftServer.IsLeader(store.GetMeta().ID) {
+			return true
+		}
+
+		wg.Add(1)
+		routine.RunWorkAsync("TRANSFER-LEADER", func() {
+			defer wg.Done()
+
+			if err := s.transferLeader(ctx, store); err != nil {
+				log.Warn("transfer leader of partition[%d] error: %s", store.GetMeta().ID, err)
+			}
+		}, routine.LogPanic(false))
+		return true
+	})
+
+	wg.Wait()
+}
+
+// transferLeader asks the follower matching the most of the log to take the leadership of the
+// partition, and waits until the server is no longer the leader.
+func (s *Server) transferLeader(ctx context.Context, store PartitionStore) error {
+	stats := store.GetStats()
+	if !stats.IsLeader || stats.RaftStatus == nil {
+		return nil
+	}
+
+	var target *metapb.Replica
+	var targetMatch uint64
+	for i, follower := range stats.RaftStatus.Followers {
+		if follower.DownSeconds > 0 {
+			continue
+		}
+		if target == nil || follower.Match > targetMatch {
+			target, targetMatch = &stats.RaftStatus.Followers[i].Replica, follower.Match
+		}
+	}
+	if target == nil {
+		return errNoFollower
+	}
+
+	adminClient, err := s.adminClient.GetGrpcClient(target.ReplicaAddrs.AdminAddr)
+	if err != nil {
+		return fmt.Errorf("get admin rpc client[%s] error: %s", target.ReplicaAddrs.AdminAddr, err)
+	}
+	request := &pspb.ChangeLeaderRequest{
+		RequestHeader: metapb.RequestHeader{ReqId: uuid.FlakeUUID()},
+		PartitionID:   stats.ID,
+	}
+	goCtx, cancel := context.WithTimeout(ctx, changeLeaderTimeout)
+	resp, err := adminClient.(pspb.AdminGrpcClient).ChangeLeader(goCtx, request)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("change leader requeset[%s] failed error: %s", request.ReqId, err)
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		return fmt.Errorf("change leader requeset[%s] ack code not ok, response is: %s", request.ReqId, resp)
+	}
+
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for s.raftServer.IsLeader(stats.ID) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	log.Info("leader of partition[%d] moved to node[%d]", stats.ID, target.NodeID)
+	return nil
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestWriteGate(t *testing.T) {
+	var gate writeGate
+	if !gate.enter() {
+		t.Fatal("write rejected by an open gate")
+	}
+	gate.close()
+	if gate.enter() {
+		t.Fatal("write accepted by a closed gate")
+	}
+
+	// the drain times out, the write in flight still finishes and the next drain waits again
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if gate.wait(ctx) {
+		t.Fatal("wait returns with a write in flight")
+	}
+	gate.open()
+	if !gate.enter() {
+		t.Fatal("write rejected by a gate opened again")
+	}
+	gate.close()
+	done := make(chan bool)
+	go func() { done <- gate.wait(context.Background()) }()
+	gate.leave()
+	select {
+	case <-done:
+		t.Fatal("wait returns with a write in flight")
+	case <-time.After(10 * time.Millisecond):
+	}
+	gate.leave()
+	if !<-done {
+		t.Fatal("wait does not return when the writes finish")
+	}
+	if !gate.wait(context.Background()) {
+		t.Fatal("wait of no write in flight does not return")
+	}
+}
+
+// leaderStore is a bulk store led by the server or not, a follower refuses the bulks with the
+// leader it knows.
+type leaderStore struct {
+	bulkStore
+	leader bool
+}
+
+func (s *leaderStore) GetStats() *masterpb.PartitionInfo {
+	return &masterpb.PartitionInfo{ID: s.meta.ID, IsLeader: s.leader}
+}
+
+func (s *leaderStore) Bulk(requests []pspb.RequestUnion, atomic bool, timeout string) ([]pspb.ResponseUnion, error) {
+	if !s.leader {
+		return nil, &metapb.NotLeader{PartitionID: s.meta.ID, Leader: 2, LeaderAddr: "ps2"}
+	}
+	return s.bulkStore.Bulk(requests, atomic, timeout)
+}
+
+func TestDrainingBulk(t *testing.T) {
+	s := new(Server)
+	s.NodeID = 1
+	led := &leaderStore{bulkStore: bulkStore{meta: metapb.Partition{ID: 1}}, leader: true}
+	moved := &leaderStore{bulkStore: bulkStore{meta: metapb.Partition{ID: 2}}}
+	writes := []pspb.RequestUnion{{OpType: pspb.OpType_CREATE}}
+
+	s.writeGate.close()
+	_, err := s.bulk(led, writes, false, "")
+	if draining, ok := err.(*metapb.PartitionDraining); !ok || draining.PartitionID != 1 || draining.NodeID != 1 {
+		t.Fatalf("unexpected error of a write to a draining leader: %v", err)
+	}
+	// the leadership moved, the router is sent to the new leader
+	_, err = s.bulk(moved, writes, false, "")
+	if notLeader, ok := err.(*metapb.NotLeader); !ok || notLeader.LeaderAddr != "ps2" {
+		t.Fatalf("unexpected error of a write to a moved partition: %v", err)
+	}
+	if led.bulks != 0 || moved.bulks != 0 {
+		t.Fatalf("writes applied while draining: %d, %d", led.bulks, moved.bulks)
+	}
+
+	s.writeGate.open()
+	if _, err := s.bulk(led, writes, false, ""); err != nil || led.bulks != 1 {
+		t.Fatalf("write rejected after the drain: %v", err)
+	}
+}
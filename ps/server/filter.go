@@ -0,0 +1,47 @@
+package server
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/json"
+)
+
+// fieldFilter is a pspb.FieldFilter with its value decoded, a nil value matches any value.
+type fieldFilter struct {
+	field string
+	value interface{}
+}
+
+func decodeFieldFilters(filters []pspb.FieldFilter) ([]fieldFilter, error) {
+	decoded := make([]fieldFilter, 0, len(filters))
+	for _, f := range filters {
+		filter := fieldFilter{field: f.Field}
+		if len(f.Value) > 0 {
+			if err := json.Unmarshal(f.Value, &filter.value); err != nil {
+				return nil, fmt.Errorf("invalid value of filter[%s]: %v", f.Field, err)
+			}
+		}
+		decoded = append(decoded, filter)
+	}
+	return decoded, nil
+}
+
+// matchFieldFilters reports whether the fields of the document in json match all the filters.
+func matchFieldFilters(data []byte, filters []fieldFilter) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false, err
+	}
+	for _, f := range filters {
+		v, ok := fields[f.field]
+		if !ok || (f.value != nil && !reflect.DeepEqual(v, f.value)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/util"
+	"github.com/tiglabs/baudengine/util/dict"
 	"github.com/tiglabs/baudengine/util/log"
 	"github.com/tiglabs/baudengine/util/routine"
 	"github.com/tiglabs/baudengine/util/uuid"
@@ -99,29 +100,41 @@ func (h *heartbeatWork) update(timer *time.Timer) {
 	h.nextHeartbeat = now.Add(h.tickerInterval)
 }
 
+// storeDicts hot swaps the dictionaries the master pushed into the analyzers, the requests being
+// analyzed keep the versions they loaded.
+func (h *heartbeatWork) storeDicts(dicts []metapb.Dictionary) {
+	for _, d := range dicts {
+		if dict.Store(dict.New(d.Name, d.Version, d.Words)) {
+			log.Info("stored the version %d of the dictionary %s of %d words", d.Version, d.Name, len(d.Words))
+		}
+	}
+}
+
 func (h *heartbeatWork) doHeartbeat() {
 	retryOpt := util.DefaultRetryOption
 	retryOpt.MaxRetries = 3
 	retryOpt.Context = h.server.ctx
 
-	err := util.RetryMaxAttempt(&retryOpt, func() error {
-		masterAddr := h.server.MasterServer
-		if h.server.masterLeader != "" {
-			masterAddr = h.server.masterLeader
-		}
+	err := h.server.masters.Call(&retryOpt, func(masterAddr string) error {
 		masterClient, err := h.server.masterClient.GetGrpcClient(masterAddr)
 		if err != nil {
 			return fmt.Errorf("get master heartbeat rpc client[%s] error: %s", masterAddr, err)
 		}
 
 		stats, _ := h.server.systemMetric.Export()
+		diskState := h.server.checkDisk(stats)
+		// the analyzers of all partitions follow the dictionaries of the server
+		dicts := dict.Versions()
 		req := &masterpb.PSHeartbeatRequest{
 			RequestHeader: metapb.RequestHeader{ReqId: uuid.FlakeUUID()},
 			NodeID:        h.server.NodeID,
 			Partitions:    make([]masterpb.PartitionInfo, 0),
+			Dicts:         dicts,
+			DiskState:     diskState,
 		}
 		h.server.partitions.Range(func(key, value interface{}) bool {
 			pinfo := value.(PartitionStore).GetStats()
+			pinfo.Dicts = dicts
 			req.Partitions = append(req.Partitions, *pinfo)
 			stats.Ops += pinfo.Statistics.Ops
 			return true
@@ -138,6 +151,9 @@ func (h *heartbeatWork) doHeartbeat() {
 		}
 
 		if resp.Code == metapb.RESP_CODE_OK {
+			h.storeDicts(resp.Dicts)
+			h.server.quotaGuard.set(resp.QuotaExceededSpaces)
+			h.server.replicaDiskGuard.set(resp.ReplicaDiskStates)
 			return nil
 		}
 
@@ -149,10 +165,8 @@ func (h *heartbeatWork) doHeartbeat() {
 			return nil
 		}
 
-		if resp.Error.NoLeader != nil {
-			h.server.masterLeader = ""
-		} else if resp.Error.NotLeader != nil {
-			h.server.masterLeader = resp.Error.NotLeader.LeaderAddr
+		if resp.Error.NotLeader != nil && resp.Error.NotLeader.LeaderAddr != "" {
+			h.server.masters.Redirect(resp.Error.NotLeader.LeaderAddr)
 		}
 		return fmt.Errorf("master heartbeat requeset[%s] ack code not ok, response is: %s", req.ReqId, resp.String())
 	})
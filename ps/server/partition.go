@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tiglabs/baudengine/engine"
 	"github.com/tiglabs/baudengine/proto/masterpb"
@@ -17,8 +18,23 @@ type PartitionStore interface {
 	GetStats() *masterpb.PartitionInfo
 
 	Get(docID engine.DOC_ID, timeout string) (doc engine.DOCUMENT, found bool, err error)
+	GetIntent(id metapb.Key) (*pspb.TxnIntent, error)
+	Search(request *engine.SearchRequest, timeout string) (*engine.SearchResult, error)
+	ScrollSearch(request *engine.SearchRequest, scrollID string, keepAlive time.Duration, timeout string) (*engine.SearchResult, string, error)
+	Aggregate(request *engine.SearchRequest, agg *engine.Aggregation, timeout string) (total uint64, buckets []*engine.Bucket, err error)
 
-	Bulk(requests []pspb.RequestUnion, timeout string) (responses []pspb.ResponseUnion, err error)
+	Bulk(requests []pspb.RequestUnion, atomic bool, timeout string) (responses []pspb.ResponseUnion, err error)
+	SetReadOnly(readOnly bool)
+
+	GetBlobMeta(id metapb.Key) (*pspb.BlobMeta, error)
+	GetBlobChunk(meta *pspb.BlobMeta, index uint32) ([]byte, error)
+
+	GetApplyID() (uint64, error)
+	GetChanges(fromIndex uint64, limit int) ([]pspb.ChangeEvent, error)
+	WaitChanges() <-chan struct{}
+
+	ComputeChecksum(timeout string) (uint64, error)
+	GetChecksum(index uint64) (*raftstore.Checksum, error)
 }
 
 func (s *Server) CreatePartitionStore(p metapb.Partition) (PartitionStore, error) {
@@ -41,7 +57,9 @@ func (s *Server) CreatePartitionStore(p metapb.Partition) (PartitionStore, error
 		conf.RaftPath = raftPath
 		conf.RaftConfig = s.raftConfig
 		conf.RaftServer = s.raftServer
+		conf.ChangesRetention = time.Duration(s.ChangesRetention) * time.Second
 		conf.EventListener = s
+		conf.DiskReadOnly = s.diskGuard.readOnly
 		return raftstore.CreateStore(s.ctx, conf), nil
 
 	default:
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+// quotaGuard keeps the spaces over their quota the master reports on the heartbeats, the writes
+// adding data to them are rejected until the usage falls under the quota again.
+type quotaGuard struct {
+	lock   sync.RWMutex
+	spaces map[metapb.SpaceID]struct{}
+}
+
+func (g *quotaGuard) set(spaces []metapb.SpaceID) {
+	exceeded := make(map[metapb.SpaceID]struct{}, len(spaces))
+	for _, space := range spaces {
+		exceeded[space] = struct{}{}
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	for space := range exceeded {
+		if _, ok := g.spaces[space]; !ok {
+			log.Warn("space[%d] exceeds its quota, the writes are rejected", space)
+		}
+	}
+	for space := range g.spaces {
+		if _, ok := exceeded[space]; !ok {
+			log.Info("space[%d] is back under its quota", space)
+		}
+	}
+	g.spaces = exceeded
+}
+
+func (g *quotaGuard) exceeded(space metapb.SpaceID) bool {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	_, ok := g.spaces[space]
+	return ok
+}
+
+// addsData reports whether one of the writes grows the data, the deletes and the ends of the
+// transactions still pass over the quota so that the usage can go down.
+func addsData(requests []pspb.RequestUnion) bool {
+	for _, request := range requests {
+		switch request.OpType {
+		case pspb.OpType_CREATE, pspb.OpType_UPDATE, pspb.OpType_BLOB_CHUNK, pspb.OpType_BLOB_COMMIT,
+			pspb.OpType_TXN_RECORD_PUT, pspb.OpType_TXN_PREPARE:
+			return true
+		}
+	}
+	return false
+}
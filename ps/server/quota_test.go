@@ -0,0 +1,79 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+// bulkStore is a partition store taking every bulk.
+type bulkStore struct {
+	PartitionStore
+	meta  metapb.Partition
+	bulks int
+}
+
+func (s *bulkStore) GetMeta() metapb.Partition {
+	return s.meta
+}
+
+func (s *bulkStore) Bulk(requests []pspb.RequestUnion, atomic bool, timeout string) ([]pspb.ResponseUnion, error) {
+	s.bulks++
+	return make([]pspb.ResponseUnion, len(requests)), nil
+}
+
+func TestQuotaExceeded(t *testing.T) {
+	s := new(Server)
+	store := &bulkStore{meta: metapb.Partition{ID: 1, Space: 2}}
+	create := []pspb.RequestUnion{{OpType: pspb.OpType_DELETE}, {OpType: pspb.OpType_CREATE}}
+	free := []pspb.RequestUnion{{OpType: pspb.OpType_DELETE}, {OpType: pspb.OpType_TXN_RESOLVE}}
+
+	if _, err := s.bulk(store, create, false, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// the space over its quota takes the writes freeing data only
+	s.quotaGuard.set([]metapb.SpaceID{2, 3})
+	_, err := s.bulk(store, create, false, "")
+	if exceeded, ok := err.(*metapb.QuotaExceeded); !ok || exceeded.PartitionID != 1 || exceeded.SpaceID != 2 {
+		t.Fatalf("unexpected error of a write over the quota: %v", err)
+	}
+	if _, err := s.bulk(store, free, false, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// the other spaces are not limited, nor the space back under its quota
+	other := &bulkStore{meta: metapb.Partition{ID: 4, Space: 5}}
+	if _, err := s.bulk(other, create, false, ""); err != nil {
+		t.Fatal(err)
+	}
+	s.quotaGuard.set([]metapb.SpaceID{3})
+	if _, err := s.bulk(store, create, false, ""); err != nil {
+		t.Fatal(err)
+	}
+	if store.bulks != 3 || other.bulks != 1 {
+		t.Fatalf("unexpected bulks %d, %d", store.bulks, other.bulks)
+	}
+}
+
+func TestAddsData(t *testing.T) {
+	for op, adds := range map[pspb.OpType]bool{
+		pspb.OpType_CREATE:            true,
+		pspb.OpType_UPDATE:            true,
+		pspb.OpType_BLOB_CHUNK:        true,
+		pspb.OpType_BLOB_COMMIT:       true,
+		pspb.OpType_TXN_PREPARE:       true,
+		pspb.OpType_TXN_RECORD_PUT:    true,
+		pspb.OpType_DELETE:            false,
+		pspb.OpType_BLOB_ABORT:        false,
+		pspb.OpType_BLOB_DELETE:       false,
+		pspb.OpType_TXN_RECORD_FINISH: false,
+		pspb.OpType_TXN_RECORD_DELETE: false,
+		pspb.OpType_TXN_RESOLVE:       false,
+	} {
+		if addsData([]pspb.RequestUnion{{OpType: op}}) != adds {
+			t.Fatalf("write %s adds data: expect %v", op, adds)
+		}
+	}
+}
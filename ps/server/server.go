@@ -19,6 +19,7 @@ import (
 	"github.com/tiglabs/baudengine/util"
 	"github.com/tiglabs/baudengine/util/atomic"
 	"github.com/tiglabs/baudengine/util/build"
+	"github.com/tiglabs/baudengine/util/discovery"
 	"github.com/tiglabs/baudengine/util/log"
 	"github.com/tiglabs/baudengine/util/netutil"
 	"github.com/tiglabs/baudengine/util/routine"
@@ -38,9 +39,10 @@ type Server struct {
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 
-	ip           string
-	masterLeader string
-	meta         *serverMeta
+	ip   string
+	meta *serverMeta
+	// the masters of the zone, tracking which is the leader
+	masters *discovery.Masters
 
 	raftResolver *RaftResolver
 	raftConfig   *raft.Config
@@ -48,14 +50,20 @@ type Server struct {
 
 	connMgr         *rpc.ConnectionMgr
 	adminServer     *grpc.Server
+	apiServer       *grpc.Server
 	masterClient    *rpc.Client
+	adminClient     *rpc.Client
 	masterHeartbeat *heartbeatWork
 
 	systemMetric *metric.SystemMetric
 	partitions   sync.Map
 	adminEventCh chan proto.Message
 
-	stopping atomic.AtomicBool
+	writeGate        writeGate
+	quotaGuard       quotaGuard
+	diskGuard        diskGuard
+	replicaDiskGuard replicaDiskGuard
+	stopping         atomic.AtomicBool
 }
 
 // NewServer create server instance
@@ -64,6 +72,7 @@ func NewServer(conf *Config) *Server {
 		Config:       *conf,
 		ip:           netutil.GetPrivateIP().String(),
 		meta:         newServerMeta(conf.StorePath),
+		masters:      discovery.NewMasters(discovery.ParseAddrs(conf.MasterServer), nil),
 		raftResolver: NewRaftResolver(),
 		systemMetric: metric.NewSystemMetric(conf.StorePath, conf.DiskQuota),
 		adminEventCh: make(chan proto.Message, 64),
@@ -73,6 +82,7 @@ func NewServer(conf *Config) *Server {
 	serverOpt := rpc.DefaultServerOption
 	serverOpt.ClusterID = conf.ClusterID
 	s.adminServer = rpc.NewGrpcServer(&serverOpt)
+	s.apiServer = rpc.NewGrpcServer(&serverOpt)
 
 	connMgrOpt := rpc.DefaultManagerOption
 	s.connMgr = rpc.NewConnectionMgr(s.ctx, &connMgrOpt)
@@ -82,6 +92,9 @@ func NewServer(conf *Config) *Server {
 	clientOpt.ConnectMgr = s.connMgr
 	clientOpt.CreateFunc = func(cc *grpc.ClientConn) interface{} { return masterpb.NewMasterRpcClient(cc) }
 	s.masterClient = rpc.NewClient(1, &clientOpt)
+	adminClientOpt := clientOpt
+	adminClientOpt.CreateFunc = func(cc *grpc.ClientConn) interface{} { return pspb.NewAdminGrpcClient(cc) }
+	s.adminClient = rpc.NewClient(1, &adminClientOpt)
 	s.masterHeartbeat = newHeartbeatWork(s)
 
 	return s
@@ -179,6 +192,19 @@ func (s *Server) doStart(init bool) error {
 			log.Info("Server admin grpc listen on: %s", fmt.Sprintf(":%d", s.AdminPort))
 		}
 
+		if ln, err := net.Listen("tcp", fmt.Sprintf(":%d", s.RPCPort)); err != nil {
+			return fmt.Errorf("Server failed to listen api port: %s", err)
+		} else {
+			pspb.RegisterApiGrpcServer(s.apiServer, s)
+			reflection.Register(s.apiServer)
+			go func() {
+				if err = s.apiServer.Serve(ln); err != nil {
+					log.Fatal("Server failed to start api grpc: %s", err)
+				}
+			}()
+			log.Info("Server api grpc listen on: %s", fmt.Sprintf(":%d", s.RPCPort))
+		}
+
 		routine.RunWorkDaemon("ADMIN-EVENTHANDLER", s.adminEventHandler, s.ctx.Done())
 	}
 
@@ -193,8 +219,14 @@ func (s *Server) doStart(init bool) error {
 	return nil
 }
 
-// Stop stop server
+// Stop stop server, the leaders move off and the writes in flight finish first
 func (s *Server) Close() error {
+	if !s.stopping.Get() {
+		ctx, cancel := context.WithTimeout(s.ctx, defaultDrainTimeout)
+		s.drain(ctx)
+		cancel()
+	}
+
 	s.stopping.Set(true)
 	s.ctxCancel()
 
@@ -204,6 +236,9 @@ func (s *Server) Close() error {
 	if s.adminServer != nil {
 		s.adminServer.GracefulStop()
 	}
+	if s.apiServer != nil {
+		s.apiServer.GracefulStop()
+	}
 
 	routine.Stop()
 	s.closeAllRange()
@@ -214,6 +249,9 @@ func (s *Server) Close() error {
 	if s.masterClient != nil {
 		s.masterClient.Close()
 	}
+	if s.adminClient != nil {
+		s.adminClient.Close()
+	}
 	if s.connMgr != nil {
 		s.connMgr.Close()
 	}
@@ -254,11 +292,7 @@ func (s *Server) register() (*masterpb.PSRegisterResponse, error) {
 	}
 	var response *masterpb.PSRegisterResponse
 
-	err := util.RetryMaxAttempt(&retryOpt, func() error {
-		masterAddr := s.MasterServer
-		if s.masterLeader != "" {
-			masterAddr = s.masterLeader
-		}
+	err := s.masters.Call(&retryOpt, func(masterAddr string) error {
 		masterClient, err := s.masterClient.GetGrpcClient(masterAddr)
 		if err != nil {
 			return fmt.Errorf("get master register rpc client[%s] error: %s", masterAddr, err)
@@ -272,10 +306,8 @@ func (s *Server) register() (*masterpb.PSRegisterResponse, error) {
 			return fmt.Errorf("master register requeset[%s] failed error: %s", request.ReqId, err)
 		}
 		if resp.Code != metapb.RESP_CODE_OK {
-			if resp.Error.NoLeader != nil {
-				s.masterLeader = ""
-			} else if resp.Error.NotLeader != nil {
-				s.masterLeader = resp.Error.NotLeader.LeaderAddr
+			if resp.Error.NotLeader != nil && resp.Error.NotLeader.LeaderAddr != "" {
+				s.masters.Redirect(resp.Error.NotLeader.LeaderAddr)
 			}
 			return fmt.Errorf("master register requeset[%s] ack code not ok, response is: %s", request.ReqId, resp)
 		}
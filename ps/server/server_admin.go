@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -26,6 +27,9 @@ func (s *Server) CreatePartition(ctx context.Context, request *pspb.CreatePartit
 	if s.stopping.Get() {
 		response.Code = metapb.RESP_CODE_SERVER_STOP
 		response.Message = "server is stopping"
+	} else if s.diskGuard.full() {
+		response.Code = metapb.PS_RESP_CODE_READONLY
+		response.Message = "disk is full"
 	} else {
 		s.adminEventCh <- request
 	}
@@ -119,6 +123,78 @@ func (s *Server) ChangeLeader(ctx context.Context, request *pspb.ChangeLeaderReq
 	return response, nil
 }
 
+// Drain admin grpc service for a rolling restart, the writes stop and the leaders move off until
+// the server restarts or resumes
+func (s *Server) Drain(ctx context.Context, request *pspb.DrainRequest) (*pspb.DrainResponse, error) {
+	log.Info("Drain recive request: %s", request)
+
+	response := &pspb.DrainResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "server is stopping"
+		return response, nil
+	}
+	if request.Resume {
+		s.resume()
+		return response, nil
+	}
+
+	timeout := defaultDrainTimeout
+	if request.Timeout != "" {
+		if t, err := time.ParseDuration(request.Timeout); err == nil {
+			timeout = t
+		}
+	}
+	drainCtx, cancel := context.WithTimeout(ctx, timeout)
+	response.Leaders = s.drain(drainCtx)
+	cancel()
+
+	return response, nil
+}
+
+// Checksum admin grpc service for the consistency check of the replicas of partition
+func (s *Server) Checksum(ctx context.Context, request *pspb.ChecksumRequest) (*pspb.ChecksumResponse, error) {
+	response := &pspb.ChecksumResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return response, nil
+	}
+
+	index := request.Index
+	if index == 0 {
+		var err error
+		if index, err = store.ComputeChecksum(request.Timeout); err != nil {
+			fillResponseHeader(&response.ResponseHeader, err)
+			return response, nil
+		}
+	}
+	checksum, err := store.GetChecksum(index)
+	if err == nil {
+		err = checksum.Err
+	}
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return response, nil
+	}
+
+	response.Index = index
+	response.Ready = checksum.Ready
+	response.Checksum = checksum.Checksum
+	response.Keys = checksum.Keys
+	return response, nil
+}
+
 func (s *Server) doPartitionCreate(p metapb.Partition) {
 	partition, err := s.CreatePartitionStore(p)
 	if err != nil {
@@ -129,6 +205,8 @@ func (s *Server) doPartitionCreate(p metapb.Partition) {
 	if _, ok := s.partitions.LoadOrStore(p.ID, partition); ok {
 		partition.Close()
 	} else {
+		// registered, the store follows the changes of the disk state from now on
+		partition.SetReadOnly(s.diskGuard.readOnly())
 		for _, r := range p.Replicas {
 			s.raftResolver.AddNode(r.NodeID, r.ReplicaAddrs)
 		}
@@ -0,0 +1,533 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/ps/storage/raftstore"
+	"github.com/tiglabs/baudengine/util/json"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/uuid"
+)
+
+// BulkWrite api grpc service for bulk write, the requests are applied in one raft command. A failed
+// request of an atomic bulk rolls back the others, the failures are reported per request.
+func (s *Server) BulkWrite(ctx context.Context, request *pspb.BulkRequest) (*pspb.BulkResponse, error) {
+	response := &pspb.BulkResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return response, nil
+	}
+
+	responses, err := s.bulk(store, request.Requests, request.Atomic, request.Timeout)
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+	} else {
+		response.Responses = responses
+	}
+	return response, nil
+}
+
+// Get api grpc service for read document, the intent on the document is returned with the
+// committed fields, the caller resolves it by the record of its transaction.
+func (s *Server) Get(ctx context.Context, request *pspb.GetRequest) (*pspb.GetResponse, error) {
+	response := &pspb.GetResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return response, nil
+	}
+
+	intent, err := store.GetIntent(request.ID)
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return response, nil
+	}
+	doc, found, err := store.Get(engine.DOC_ID(request.ID), request.Timeout)
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return response, nil
+	}
+	if found {
+		if response.Data, err = json.Marshal(doc); err != nil {
+			fillResponseHeader(&response.ResponseHeader, err)
+			return response, nil
+		}
+	}
+	response.Found = found
+	response.Intent = intent
+	return response, nil
+}
+
+// MultiGet api grpc service for reading a batch of documents of a partition in one call, the
+// documents of FilteredIDs are left out unless their fields match all the filters.
+func (s *Server) MultiGet(ctx context.Context, request *pspb.MultiGetRequest) (*pspb.MultiGetResponse, error) {
+	response := &pspb.MultiGetResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return response, nil
+	}
+
+	filters, err := decodeFieldFilters(request.Filters)
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return response, nil
+	}
+	read := func(id metapb.Key, filtered bool) error {
+		doc, found, err := store.Get(engine.DOC_ID(id), request.Timeout)
+		if err != nil || !found {
+			return err
+		}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		if filtered {
+			if ok, err := matchFieldFilters(data, filters); err != nil || !ok {
+				return err
+			}
+		}
+		response.Docs = append(response.Docs, pspb.MultiGetDoc{ID: id, Data: data})
+		return nil
+	}
+	for _, id := range request.IDs {
+		if err := read(id, false); err != nil {
+			fillResponseHeader(&response.ResponseHeader, err)
+			return response, nil
+		}
+	}
+	for _, id := range request.FilteredIDs {
+		if err := read(id, true); err != nil {
+			fillResponseHeader(&response.ResponseHeader, err)
+			return response, nil
+		}
+	}
+	return response, nil
+}
+
+// Search api grpc service for search, the hits are the top from+limit of the partition, the router
+// merges them across the partitions by their sort values.
+func (s *Server) Search(ctx context.Context, request *pspb.SearchRequest) (*pspb.SearchResponse, error) {
+	response := &pspb.SearchResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return response, nil
+	}
+
+	searchReq := engine.NewSearchQuery("", "")
+	searchReq.SetQuery(request.Query)
+	searchReq.SetFrom(int(request.From))
+	searchReq.SetSize(int(request.Limit))
+	searchReq.Sort = request.Sort
+	if len(request.Fields) > 0 {
+		searchReq.SetFields(request.Fields)
+	}
+	if len(request.Aggregation) > 0 {
+		s.aggregate(store, searchReq, request, response)
+		return response, nil
+	}
+	var (
+		result *engine.SearchResult
+		err    error
+	)
+	if request.ScrollID != "" || request.KeepAlive > 0 {
+		keepAlive := time.Duration(request.KeepAlive) * time.Millisecond
+		result, response.ScrollID, err = store.ScrollSearch(searchReq, request.ScrollID, keepAlive, request.Timeout)
+	} else {
+		result, err = store.Search(searchReq, request.Timeout)
+	}
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return response, nil
+	}
+
+	response.Total = result.Hits.Total
+	response.Hits = make([]pspb.SearchHit, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		source, err := json.Marshal(hit.Source)
+		if err != nil {
+			fillResponseHeader(&response.ResponseHeader, err)
+			return response, nil
+		}
+		// the router finishes the transaction of a stale intent before replying the hit
+		intent, err := store.GetIntent(metapb.Key(hit.Id))
+		if err != nil {
+			fillResponseHeader(&response.ResponseHeader, err)
+			return response, nil
+		}
+		response.Hits = append(response.Hits, pspb.SearchHit{
+			ID:     metapb.Key(hit.Id),
+			Score:  hit.Score,
+			Source: source,
+			Sort:   hit.Sort,
+			Intent: intent != nil,
+		})
+	}
+	return response, nil
+}
+
+// aggregate folds all the hits of the search into the buckets of the aggregation, only the buckets
+// are returned.
+func (s *Server) aggregate(store PartitionStore, searchReq *engine.SearchRequest, request *pspb.SearchRequest, response *pspb.SearchResponse) {
+	agg := new(engine.Aggregation)
+	if err := json.Unmarshal(request.Aggregation, agg); err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return
+	}
+	total, buckets, err := store.Aggregate(searchReq, agg, request.Timeout)
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return
+	}
+	if response.Buckets, err = json.Marshal(buckets); err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return
+	}
+	response.Total = total
+}
+
+// PutBlob api grpc service for upload blob, the data is cut into chunks of BlobChunkSize and
+// each chunk is replicated on its own, so the upload is never held in memory as a whole.
+func (s *Server) PutBlob(stream pspb.ApiGrpc_PutBlobServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	log.Debug("PutBlob recive request: partition[%d] blob[%s]", request.PartitionID, request.ID)
+
+	response := &pspb.PutBlobResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+		ID: request.ID,
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return stream.SendAndClose(response)
+	}
+
+	id, timeout := request.ID, request.Timeout
+	meta := pspb.BlobMeta{UploadID: uuid.FlakeUUID(), ChunkSize: uint32(s.BlobChunkSize)}
+	if request.Meta != nil {
+		meta.ContentType = request.Meta.ContentType
+		meta.Metadata = request.Meta.Metadata
+	}
+
+	chunk := make([]byte, 0, s.BlobChunkSize)
+	flush := func() error {
+		chunkReq := &pspb.BlobChunkRequest{ID: id, UploadID: meta.UploadID, Index: meta.Chunks, Data: chunk}
+		if meta.Chunks == 0 {
+			chunkReq.Timestamp = time.Now().UnixNano()
+		}
+		_, err := s.bulkOne(store, pspb.RequestUnion{OpType: pspb.OpType_BLOB_CHUNK, BlobChunk: chunkReq}, timeout)
+		if err == nil {
+			meta.Chunks++
+			chunk = chunk[:0]
+		}
+		return err
+	}
+	// abort drops the chunks written so far, a chunk that failed may have been applied still
+	abort := func() {
+		if _, err := s.bulkOne(store, pspb.RequestUnion{
+			OpType:    pspb.OpType_BLOB_ABORT,
+			BlobAbort: &pspb.BlobAbortRequest{ID: id, UploadID: meta.UploadID},
+		}, timeout); err != nil {
+			log.Error("PutBlob abort upload[%s] of blob[%s] error: %s", meta.UploadID, id, err)
+		}
+	}
+
+	for {
+		data := request.Data
+		for len(data) > 0 {
+			n := cap(chunk) - len(chunk)
+			if n > len(data) {
+				n = len(data)
+			}
+			chunk = append(chunk, data[:n]...)
+			data = data[n:]
+			meta.Size_ += uint64(n)
+			if len(chunk) == cap(chunk) {
+				if err := flush(); err != nil {
+					abort()
+					fillResponseHeader(&response.ResponseHeader, err)
+					return stream.SendAndClose(response)
+				}
+			}
+		}
+
+		if request, err = stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			log.Error("PutBlob receive blob[%s] error: %s", id, err)
+			if meta.Chunks > 0 {
+				abort()
+			}
+			return err
+		}
+	}
+	if len(chunk) > 0 {
+		if err := flush(); err != nil {
+			abort()
+			fillResponseHeader(&response.ResponseHeader, err)
+			return stream.SendAndClose(response)
+		}
+	}
+
+	resp, err := s.bulkOne(store, pspb.RequestUnion{
+		OpType:     pspb.OpType_BLOB_COMMIT,
+		BlobCommit: &pspb.BlobCommitRequest{ID: id, Meta: meta},
+	}, timeout)
+	if err != nil {
+		// a no-op if the commit was applied after all
+		if meta.Chunks > 0 {
+			abort()
+		}
+		fillResponseHeader(&response.ResponseHeader, err)
+	} else {
+		response.Result = resp.BlobCommit.Result
+		response.Size_ = meta.Size_
+	}
+	return stream.SendAndClose(response)
+}
+
+// GetBlob api grpc service for download blob, the chunks in range are read and sent one by one.
+func (s *Server) GetBlob(request *pspb.GetBlobRequest, stream pspb.ApiGrpc_GetBlobServer) error {
+	response := &pspb.GetBlobResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return stream.Send(response)
+	}
+	meta, err := store.GetBlobMeta(request.ID)
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+		return stream.Send(response)
+	}
+	if meta == nil {
+		response.Code = metapb.PS_RESP_CODE_KEY_NOT_EXISTS
+		response.Message = fmt.Sprintf("blob[%s] not exists", request.ID)
+		return stream.Send(response)
+	}
+	response.Meta = meta
+	if err := stream.Send(response); err != nil {
+		return err
+	}
+
+	for _, span := range blobSpans(meta, request.Offset, request.Length) {
+		data, err := store.GetBlobChunk(meta, span.index)
+		if err == nil && uint64(len(data)) < span.stop {
+			err = fmt.Errorf("chunk %d of upload %s is short", span.index, meta.UploadID)
+		}
+		if err != nil {
+			log.Error("GetBlob read blob[%s] error: %s", request.ID, err)
+			return err
+		}
+		if err := stream.Send(&pspb.GetBlobResponse{ResponseHeader: metapb.ResponseHeader{ReqId: request.ReqId}, Data: data[span.start:span.stop]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blobSpan is the part [start, stop) of a chunk a read covers.
+type blobSpan struct {
+	index       uint32
+	start, stop uint64
+}
+
+// blobSpans cuts the read of [offset, offset+length) of the blob into the chunks it covers, a zero
+// length reads to the end.
+func blobSpans(meta *pspb.BlobMeta, offset, length uint64) []blobSpan {
+	end := meta.Size_
+	if length > 0 && offset+length < end {
+		end = offset + length
+	}
+	chunkSize := uint64(meta.ChunkSize)
+	var spans []blobSpan
+	for pos := offset; pos < end; {
+		index := pos / chunkSize
+		stop := chunkSize
+		if index*chunkSize+stop > end {
+			stop = end - index*chunkSize
+		}
+		spans = append(spans, blobSpan{index: uint32(index), start: pos - index*chunkSize, stop: stop})
+		pos = index*chunkSize + stop
+	}
+	return spans
+}
+
+// DeleteBlob api grpc service for delete blob
+func (s *Server) DeleteBlob(ctx context.Context, request *pspb.DeleteBlobRequest) (*pspb.DeleteBlobResponse, error) {
+	response := &pspb.DeleteBlobResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return response, nil
+	}
+
+	resp, err := s.bulkOne(store, pspb.RequestUnion{
+		OpType: pspb.OpType_BLOB_DELETE,
+		Delete: &pspb.DeleteRequest{ID: request.ID},
+	}, request.Timeout)
+	if err != nil {
+		fillResponseHeader(&response.ResponseHeader, err)
+	} else {
+		response.Result = resp.Delete.Result
+	}
+	return response, nil
+}
+
+const (
+	// the events read for one response, the events of one raft index are never split
+	changesBatchSize = 256
+	// a watch re-reads its partition at least this often, so it notices a closed partition
+	changesPollInterval = 5 * time.Second
+)
+
+// WatchChanges api grpc service for change data capture, the retained events after the token are
+// sent first, then the stream tails the new ones until the client goes away.
+func (s *Server) WatchChanges(request *pspb.WatchChangesRequest, stream pspb.ApiGrpc_WatchChangesServer) error {
+	response := &pspb.WatchChangesResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	store := s.getPartitionStore(&response.ResponseHeader, request.PartitionID)
+	if store == nil {
+		return stream.Send(response)
+	}
+
+	fromIndex := request.FromIndex
+	if request.FromNow {
+		index, err := store.GetApplyID()
+		if err != nil {
+			fillResponseHeader(&response.ResponseHeader, err)
+			return stream.Send(response)
+		}
+		fromIndex = index
+	}
+
+	for {
+		notify := store.WaitChanges()
+		events, err := store.GetChanges(fromIndex, changesBatchSize)
+		if err != nil {
+			fillResponseHeader(&response.ResponseHeader, err)
+			return stream.Send(response)
+		}
+		if len(events) > 0 {
+			response.Events = events
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+			fromIndex = events[len(events)-1].Index
+			continue
+		}
+
+		select {
+		case <-notify:
+		case <-time.After(changesPollInterval):
+		case <-stream.Context().Done():
+			return nil
+		case <-s.ctx.Done():
+			response.Events = nil
+			response.Code = metapb.RESP_CODE_SERVER_STOP
+			response.Message = "server is stopping"
+			return stream.Send(response)
+		}
+	}
+}
+
+func (s *Server) getPartitionStore(header *metapb.ResponseHeader, id metapb.PartitionID) PartitionStore {
+	if s.stopping.Get() {
+		header.Code = metapb.RESP_CODE_SERVER_STOP
+		header.Message = "server is stopping"
+		return nil
+	}
+	p, ok := s.partitions.Load(id)
+	if !ok {
+		fillResponseHeader(header, &metapb.PartitionNotFound{PartitionID: id})
+		return nil
+	}
+	return p.(PartitionStore)
+}
+
+// bulkOne submits a single write and turns its failure into an error
+func (s *Server) bulkOne(store PartitionStore, request pspb.RequestUnion, timeout string) (*pspb.ResponseUnion, error) {
+	responses, err := s.bulk(store, []pspb.RequestUnion{request}, false, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if responses[0].Failure != nil {
+		return nil, &metapb.ServerError{Cause: responses[0].Failure.Cause}
+	}
+	return &responses[0], nil
+}
+
+func fillResponseHeader(header *metapb.ResponseHeader, err error) {
+	header.Message = err.Error()
+	switch e := err.(type) {
+	case *metapb.NotLeader:
+		header.Code = metapb.PS_RESP_CODE_NOT_LEADER
+		header.Error.NotLeader = e
+	case *metapb.NoLeader:
+		header.Code = metapb.PS_RESP_CODE_NO_LEADER
+		header.Error.NoLeader = e
+	case *metapb.PartitionNotFound:
+		header.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		header.Error.PartitionNotFound = e
+	case *metapb.MsgTooLarge:
+		header.Code = metapb.RESP_CODE_MSG_TOOLARGE
+		header.Error.MsgTooLarge = e
+	case *metapb.QuotaExceeded:
+		header.Code = metapb.PS_RESP_CODE_QUOTA_EXCEEDED
+		header.Error.QuotaExceeded = e
+	case *metapb.PartitionReadOnly:
+		header.Code = metapb.PS_RESP_CODE_READONLY
+		header.Error.PartitionReadOnly = e
+	case *metapb.PartitionDraining:
+		header.Code = metapb.PS_RESP_CODE_DRAINING
+		header.Error.PartitionDraining = e
+	case *metapb.TimeoutError:
+		header.Code = metapb.RESP_CODE_TIMEOUT
+	default:
+		if err == raftstore.ErrChangesExpired {
+			header.Code = metapb.PS_RESP_CODE_TOKEN_EXPIRED
+			return
+		}
+		header.Code = metapb.RESP_CODE_SERVER_ERROR
+	}
+}
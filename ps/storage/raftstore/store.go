@@ -23,6 +23,17 @@ type Store struct {
 	RaftConfig    *raft.Config
 	RaftServer    *raft.RaftServer
 	EventListener EventListener
+
+	// ChangesRetention is how long the change events are kept, changes are not captured if it is zero
+	ChangesRetention time.Duration
+	changeNotify     changeNotifier
+	checksums        checksums
+	usage            usage
+	scrolls          scrolls
+	// the writes are off while the disk of the node is full, the disk state is read again when the
+	// store becomes the leader
+	readOnly     bool
+	diskReadOnly func() bool
 }
 
 type StoreConfig struct {
@@ -35,7 +46,10 @@ type StoreConfig struct {
 	RaftConfig *raft.Config
 	RaftServer *raft.RaftServer
 
-	EventListener EventListener
+	ChangesRetention time.Duration
+	EventListener    EventListener
+	// DiskReadOnly tells whether the disk of the node is over the high watermark
+	DiskReadOnly func() bool
 }
 
 // CreateStore create an instance of Store.
@@ -49,6 +63,11 @@ func CreateStore(ctx context.Context, conf *StoreConfig) *Store {
 	s.RaftConfig = conf.RaftConfig
 	s.RaftServer = conf.RaftServer
 	s.EventListener = conf.EventListener
+	s.ChangesRetention = conf.ChangesRetention
+	s.diskReadOnly = conf.DiskReadOnly
+	if s.diskReadOnly != nil {
+		s.readOnly = s.diskReadOnly()
+	}
 	s.Ctx, s.CtxCancel = context.WithCancel(ctx)
 	s.Meta.Status = metapb.PA_NOTREAD
 
@@ -113,9 +132,31 @@ func (s *Store) Start() {
 	s.Lock()
 	s.Meta.Status = metapb.PA_READONLY
 	s.Unlock()
+	s.startChangeGC()
+	s.startBlobGC()
 	log.Info("start partition[%d] success", s.Meta.ID)
 }
 
+// SetReadOnly switches the writes of the partition off or back on, the leader reports PA_READONLY
+// while they are off.
+func (s *Store) SetReadOnly(readOnly bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.readOnly = readOnly
+	if s.Leader == uint64(s.NodeID) && (s.Meta.Status == metapb.PA_READWRITE || s.Meta.Status == metapb.PA_READONLY) {
+		s.Meta.Status = s.leaderStatus()
+	}
+}
+
+// leaderStatus internal use, need to lock external
+func (s *Store) leaderStatus() metapb.PartitionStatus {
+	if s.readOnly {
+		return metapb.PA_READONLY
+	}
+	return metapb.PA_READWRITE
+}
+
 // Close close store for once
 func (s *Store) Close() error {
 	s.CloseOnce.Do(func() {
@@ -125,6 +166,7 @@ func (s *Store) Close() error {
 
 		s.CtxCancel()
 		s.RaftServer.RemoveRaft(s.Meta.ID)
+		s.scrolls.closeAll()
 		if s.Engine != nil {
 			s.Engine.Close()
 			s.Engine = nil
@@ -145,6 +187,7 @@ func (s *Store) GetStats() *masterpb.PartitionInfo {
 	info.Statistics = s.Stats
 	replicas := s.Meta.Replicas
 	s.RUnlock()
+	info.Statistics.Size_, info.Statistics.DocCount = s.getUsage()
 
 	if info.IsLeader {
 		raftStatus := s.RaftServer.Status(s.Meta.ID)
@@ -0,0 +1,330 @@
+package raftstore
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/routine"
+)
+
+// Blobs are kept in the internal keyspace of the engine, out of the search index:
+//
+//	meta:   [blobMetaPrefix][id]                       -> pspb.BlobMeta
+//	chunk:  [blobChunkPrefix][upload id]/[chunk index] -> data
+//	upload: [blobUploadPrefix][upload id]              -> pspb.BlobUpload, until committed or aborted
+//
+// The chunks of an upload are keyed by its upload id, so an upload never overwrites the chunks
+// of the committed one, and readers see either the old or the new blob. The chunks of an upload
+// that fails are dropped by BLOB_ABORT, the ones of an upload whose ps died are dropped by the
+// leader once the upload is older than blobUploadTTL.
+var (
+	blobMetaPrefix   = []byte("_blob_meta_")
+	blobChunkPrefix  = []byte("_blob_chunk_")
+	blobUploadPrefix = []byte("_blob_upload_")
+
+	errBlobUploadAborted = errors.New("the blob upload is aborted")
+)
+
+const (
+	blobGCInterval = 10 * time.Minute
+	blobGCBatch    = 100
+	blobUploadTTL  = 24 * time.Hour
+)
+
+func blobMetaKey(id metapb.Key) []byte {
+	key := make([]byte, 0, len(blobMetaPrefix)+len(id))
+	key = append(key, blobMetaPrefix...)
+	return append(key, id...)
+}
+
+func blobChunkKey(uploadID string, index uint32) []byte {
+	key := make([]byte, 0, len(blobChunkPrefix)+len(uploadID)+5)
+	key = append(key, blobChunkPrefix...)
+	key = append(key, uploadID...)
+	key = append(key, '/')
+	var buff [4]byte
+	binary.BigEndian.PutUint32(buff[:], index)
+	return append(key, buff[:]...)
+}
+
+// blobChunksRange returns the range of the chunk keys of an upload.
+func blobChunksRange(uploadID string) (start, end []byte) {
+	start = make([]byte, 0, len(blobChunkPrefix)+len(uploadID)+1)
+	start = append(start, blobChunkPrefix...)
+	start = append(start, uploadID...)
+	start = append(start, '/')
+	end = append([]byte(nil), start...)
+	end[len(end)-1]++
+	return start, end
+}
+
+func blobUploadKey(uploadID string) []byte {
+	key := make([]byte, 0, len(blobUploadPrefix)+len(uploadID))
+	key = append(key, blobUploadPrefix...)
+	return append(key, uploadID...)
+}
+
+func blobUploadEnd() []byte {
+	end := append([]byte(nil), blobUploadPrefix...)
+	end[len(end)-1]++
+	return end
+}
+
+// GetBlobMeta returns the committed meta of the blob, nil if the blob not exists.
+func (s *Store) GetBlobMeta(id metapb.Key) (*pspb.BlobMeta, error) {
+	if err := s.checkReadable(true); err != nil {
+		return nil, err
+	}
+	return s.getBlobMeta(id)
+}
+
+// GetBlobChunk reads one chunk of the blob described by meta.
+func (s *Store) GetBlobChunk(meta *pspb.BlobMeta, index uint32) ([]byte, error) {
+	if err := s.checkReadable(true); err != nil {
+		return nil, err
+	}
+	data, err := s.Engine.GetInternal(blobChunkKey(meta.UploadID, index))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		// the upload has been replaced or deleted while reading
+		return nil, fmt.Errorf("chunk %d of upload %s not found", index, meta.UploadID)
+	}
+	return data, nil
+}
+
+func (s *Store) getBlobMeta(id metapb.Key) (*pspb.BlobMeta, error) {
+	data, err := s.Engine.GetInternal(blobMetaKey(id))
+	if err != nil || data == nil {
+		return nil, err
+	}
+	meta := new(pspb.BlobMeta)
+	if err := meta.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+func (s *Store) blobChunkInternal(request *pspb.BlobChunkRequest, docs *commandDocs, batch engine.Batch) (*pspb.BlobChunkResponse, error) {
+	if request.UploadID == "" {
+		return nil, fmt.Errorf("blob chunk without upload id")
+	}
+	if request.Index == 0 {
+		upload := &pspb.BlobUpload{ID: request.ID, Timestamp: request.Timestamp}
+		if err := docs.setBlobUpload(batch, request.UploadID, upload); err != nil {
+			return nil, err
+		}
+	} else if ok, err := docs.hasBlobUpload(request.UploadID); err != nil {
+		return nil, err
+	} else if !ok {
+		// a chunk after the abort would never be dropped
+		return nil, errBlobUploadAborted
+	}
+	if err := batch.SetInternal(blobChunkKey(request.UploadID, request.Index), request.Data); err != nil {
+		return nil, err
+	}
+	return &pspb.BlobChunkResponse{ID: request.ID, Index: request.Index}, nil
+}
+
+func (s *Store) blobCommitInternal(request *pspb.BlobCommitRequest, docs *commandDocs, batch engine.Batch) (*pspb.BlobCommitResponse, error) {
+	if request.Meta.Chunks > 0 {
+		if ok, err := docs.hasBlobUpload(request.Meta.UploadID); err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, errBlobUploadAborted
+		}
+		if err := docs.deleteBlobUpload(batch, request.Meta.UploadID); err != nil {
+			return nil, err
+		}
+	}
+	old, err := s.getBlobMeta(request.ID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := request.Meta.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := batch.SetInternal(blobMetaKey(request.ID), data); err != nil {
+		return nil, err
+	}
+
+	result := pspb.WriteResult_CREATED
+	if old != nil {
+		result = pspb.WriteResult_UPDATED
+		if old.UploadID != request.Meta.UploadID {
+			if err := deleteBlobChunks(old, batch); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &pspb.BlobCommitResponse{ID: request.ID, Result: result}, nil
+}
+
+// blobAbortInternal drops the chunks of an upload, the committed upload of the blob is left alone
+// since a commit may be applied even though its proposer saw it fail.
+func (s *Store) blobAbortInternal(request *pspb.BlobAbortRequest, docs *commandDocs, batch engine.Batch) (*pspb.BlobAbortResponse, error) {
+	if request.UploadID == "" {
+		return nil, fmt.Errorf("blob abort without upload id")
+	}
+	meta, err := s.getBlobMeta(request.ID)
+	if err != nil {
+		return nil, err
+	}
+	if meta != nil && meta.UploadID == request.UploadID {
+		return &pspb.BlobAbortResponse{ID: request.ID, Result: pspb.WriteResult_NOOP}, nil
+	}
+
+	start, end := blobChunksRange(request.UploadID)
+	iter, err := s.Engine.NewInternalIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.DeleteInternal(append([]byte(nil), iter.Key()...)); err != nil {
+			return nil, err
+		}
+	}
+	if err := docs.deleteBlobUpload(batch, request.UploadID); err != nil {
+		return nil, err
+	}
+	return &pspb.BlobAbortResponse{ID: request.ID, Result: pspb.WriteResult_DELETED}, nil
+}
+
+// hasBlobUpload tells whether the upload is started and not yet committed or aborted, by the
+// earlier writes of the command too.
+func (d *commandDocs) hasBlobUpload(uploadID string) (bool, error) {
+	if started, ok := d.uploads[uploadID]; ok {
+		return started, nil
+	}
+	data, err := d.store.Engine.GetInternal(blobUploadKey(uploadID))
+	if err != nil {
+		return false, err
+	}
+	d.uploads[uploadID] = data != nil
+	return data != nil, nil
+}
+
+func (d *commandDocs) setBlobUpload(batch engine.Batch, uploadID string, upload *pspb.BlobUpload) error {
+	data, err := upload.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := batch.SetInternal(blobUploadKey(uploadID), data); err != nil {
+		return err
+	}
+	d.uploads[uploadID] = true
+	return nil
+}
+
+func (d *commandDocs) deleteBlobUpload(batch engine.Batch, uploadID string) error {
+	if err := batch.DeleteInternal(blobUploadKey(uploadID)); err != nil {
+		return err
+	}
+	d.uploads[uploadID] = false
+	return nil
+}
+
+func (s *Store) blobDeleteInternal(request *pspb.DeleteRequest, batch engine.Batch) (*pspb.DeleteResponse, error) {
+	old, err := s.getBlobMeta(request.ID)
+	if err != nil {
+		return nil, err
+	}
+	if old == nil {
+		return &pspb.DeleteResponse{ID: request.ID, Result: pspb.WriteResult_NOT_FOUND}, nil
+	}
+	if err := batch.DeleteInternal(blobMetaKey(request.ID)); err != nil {
+		return nil, err
+	}
+	if err := deleteBlobChunks(old, batch); err != nil {
+		return nil, err
+	}
+	return &pspb.DeleteResponse{ID: request.ID, Result: pspb.WriteResult_DELETED}, nil
+}
+
+func deleteBlobChunks(meta *pspb.BlobMeta, batch engine.Batch) error {
+	for i := uint32(0); i < meta.Chunks; i++ {
+		if err := batch.DeleteInternal(blobChunkKey(meta.UploadID, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) startBlobGC() {
+	routine.RunWorkDaemon("PARTITION-BLOB-GC", func() {
+		ticker := time.NewTicker(blobGCInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.Ctx.Done():
+				return
+			case <-ticker.C:
+				s.RLock()
+				leader := s.Leader == uint64(s.NodeID)
+				s.RUnlock()
+				if !leader {
+					continue
+				}
+				if n, err := s.gcBlobUploads(time.Now().Add(-blobUploadTTL)); err != nil {
+					log.Error("partition[%d] abort expired blob uploads error: %s", s.Meta.ID, err)
+				} else if n > 0 {
+					log.Info("partition[%d] aborted %d expired blob uploads", s.Meta.ID, n)
+				}
+			}
+		}
+	}, s.Ctx.Done())
+}
+
+// gcBlobUploads aborts a batch of the uploads started before expire and never committed, they are
+// left by the partition servers that died while uploading. The aborts go through raft, so only the
+// leader runs it.
+func (s *Store) gcBlobUploads(expire time.Time) (int, error) {
+	requests, err := s.expiredBlobUploads(expire.UnixNano())
+	if err != nil || len(requests) == 0 {
+		return 0, err
+	}
+	responses, err := s.Bulk(requests, false, "")
+	if err != nil {
+		return 0, err
+	}
+	for i := range responses {
+		if responses[i].Failure != nil {
+			return 0, errors.New(responses[i].Failure.Cause)
+		}
+	}
+	return len(requests), nil
+}
+
+func (s *Store) expiredBlobUploads(expire int64) ([]pspb.RequestUnion, error) {
+	iter, err := s.Engine.NewInternalIterator(blobUploadPrefix, blobUploadEnd())
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var requests []pspb.RequestUnion
+	for ; iter.Valid() && len(requests) < blobGCBatch; iter.Next() {
+		var upload pspb.BlobUpload
+		if err := upload.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		if upload.Timestamp >= expire {
+			continue
+		}
+		requests = append(requests, pspb.RequestUnion{
+			OpType:    pspb.OpType_BLOB_ABORT,
+			BlobAbort: &pspb.BlobAbortRequest{ID: upload.ID, UploadID: string(iter.Key()[len(blobUploadPrefix):])},
+		})
+	}
+	return requests, nil
+}
@@ -0,0 +1,167 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func chunkCmd(id, uploadID string, index uint32, data string, timestamp int64) pspb.RequestUnion {
+	return pspb.RequestUnion{
+		OpType: pspb.OpType_BLOB_CHUNK,
+		BlobChunk: &pspb.BlobChunkRequest{ID: metapb.Key(id), UploadID: uploadID, Index: index,
+			Data: metapb.Value(data), Timestamp: timestamp},
+	}
+}
+
+func commitCmd(id, uploadID string, chunks uint32, size uint64) pspb.RequestUnion {
+	return pspb.RequestUnion{
+		OpType: pspb.OpType_BLOB_COMMIT,
+		BlobCommit: &pspb.BlobCommitRequest{ID: metapb.Key(id),
+			Meta: pspb.BlobMeta{UploadID: uploadID, Size_: size, ChunkSize: 4, Chunks: chunks}},
+	}
+}
+
+func abortCmd(id, uploadID string) pspb.RequestUnion {
+	return pspb.RequestUnion{
+		OpType:    pspb.OpType_BLOB_ABORT,
+		BlobAbort: &pspb.BlobAbortRequest{ID: metapb.Key(id), UploadID: uploadID},
+	}
+}
+
+// applyOne applies the command as the raft index and returns its response.
+func applyOne(t *testing.T, s *Store, index uint64, cmd pspb.RequestUnion) pspb.ResponseUnion {
+	resp, err := s.execRaftCommand(index, []pspb.RequestUnion{cmd}, false, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp[0]
+}
+
+func chunkExists(t *testing.T, s *Store, uploadID string, index uint32) bool {
+	data, err := s.Engine.GetInternal(blobChunkKey(uploadID, index))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data != nil
+}
+
+func TestBlobUpload(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+
+	now := time.Now().UnixNano()
+	for i, cmd := range []pspb.RequestUnion{
+		chunkCmd("b", "u1", 0, "0123", now),
+		chunkCmd("b", "u1", 1, "45", 0),
+		commitCmd("b", "u1", 2, 6),
+	} {
+		if resp := applyOne(t, s, uint64(i+1), cmd); resp.Failure != nil {
+			t.Fatalf("write %d failed: %s", i, resp.Failure.Cause)
+		}
+	}
+	meta, err := s.getBlobMeta(metapb.Key("b"))
+	if err != nil || meta == nil || meta.UploadID != "u1" || meta.Size_ != 6 {
+		t.Fatalf("unexpected meta %v, %v", meta, err)
+	}
+	if ok, err := s.newCommandDocs().hasBlobUpload("u1"); err != nil || ok {
+		t.Fatalf("the upload is still marked after the commit, %v", err)
+	}
+
+	// a failed replacement is aborted and the committed blob is kept
+	applyOne(t, s, 4, chunkCmd("b", "u2", 0, "abcd", now))
+	applyOne(t, s, 5, chunkCmd("b", "u2", 1, "ef", 0))
+	if resp := applyOne(t, s, 6, abortCmd("b", "u2")); resp.Failure != nil || resp.BlobAbort.Result != pspb.WriteResult_DELETED {
+		t.Fatalf("unexpected response of the abort %v", resp)
+	}
+	if chunkExists(t, s, "u2", 0) || chunkExists(t, s, "u2", 1) {
+		t.Fatal("the chunks of the aborted upload are kept")
+	}
+	if !chunkExists(t, s, "u1", 0) || !chunkExists(t, s, "u1", 1) {
+		t.Fatal("the chunks of the committed upload are dropped")
+	}
+	// the chunks and the commit after the abort fail, so they leave nothing behind
+	if resp := applyOne(t, s, 7, chunkCmd("b", "u2", 2, "gh", 0)); resp.Failure == nil {
+		t.Fatal("a chunk is written after the abort")
+	}
+	if resp := applyOne(t, s, 8, commitCmd("b", "u2", 2, 6)); resp.Failure == nil {
+		t.Fatal("an aborted upload is committed")
+	}
+
+	// the abort of the committed upload, after a commit its proposer saw failing, is a no-op
+	if resp := applyOne(t, s, 9, abortCmd("b", "u1")); resp.Failure != nil || resp.BlobAbort.Result != pspb.WriteResult_NOOP {
+		t.Fatalf("unexpected response of the abort %v", resp)
+	}
+	if !chunkExists(t, s, "u1", 0) {
+		t.Fatal("the chunks of the committed upload are dropped")
+	}
+
+	// the replacement drops the chunks of the replaced upload
+	applyOne(t, s, 10, chunkCmd("b", "u3", 0, "xy", now))
+	applyOne(t, s, 11, commitCmd("b", "u3", 1, 2))
+	if chunkExists(t, s, "u1", 0) || chunkExists(t, s, "u1", 1) || !chunkExists(t, s, "u3", 0) {
+		t.Fatal("the chunks of the replaced upload are kept")
+	}
+}
+
+func TestBlobUploadInOneCommand(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+
+	// the chunks after the first one and the commit see the upload the command started
+	resp, err := s.execRaftCommand(1, []pspb.RequestUnion{
+		chunkCmd("b", "u1", 0, "0123", time.Now().UnixNano()),
+		chunkCmd("b", "u1", 1, "45", 0),
+		commitCmd("b", "u1", 2, 6),
+		chunkCmd("b", "u1", 2, "67", 0),
+	}, false, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if resp[i].Failure != nil {
+			t.Fatalf("write %d failed: %s", i, resp[i].Failure.Cause)
+		}
+	}
+	// nor is a chunk written after the commit of the command
+	if resp[3].Failure == nil || resp[3].Failure.Cause != errBlobUploadAborted.Error() {
+		t.Fatalf("unexpected response of the chunk after the commit %v", resp[3])
+	}
+	if meta, err := s.getBlobMeta(metapb.Key("b")); err != nil || meta == nil || meta.UploadID != "u1" {
+		t.Fatalf("unexpected meta %v, %v", meta, err)
+	}
+	if !chunkExists(t, s, "u1", 1) || chunkExists(t, s, "u1", 2) {
+		t.Fatal("unexpected chunks of the upload")
+	}
+}
+
+func TestExpiredBlobUploads(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+
+	now := time.Now()
+	applyOne(t, s, 1, chunkCmd("old", "u1", 0, "0123", now.Add(-2*blobUploadTTL).UnixNano()))
+	applyOne(t, s, 2, chunkCmd("new", "u2", 0, "0123", now.UnixNano()))
+	applyOne(t, s, 3, chunkCmd("done", "u3", 0, "0123", now.Add(-2*blobUploadTTL).UnixNano()))
+	applyOne(t, s, 4, commitCmd("done", "u3", 1, 4))
+
+	requests, err := s.expiredBlobUploads(now.Add(-blobUploadTTL).UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 || requests[0].OpType != pspb.OpType_BLOB_ABORT ||
+		string(requests[0].BlobAbort.ID) != "old" || requests[0].BlobAbort.UploadID != "u1" {
+		t.Fatalf("unexpected expired uploads %v", requests)
+	}
+	if resp := applyOne(t, s, 5, requests[0]); resp.Failure != nil {
+		t.Fatal(resp.Failure.Cause)
+	}
+	if chunkExists(t, s, "u1", 0) || !chunkExists(t, s, "u2", 0) {
+		t.Fatal("the expired upload is not dropped")
+	}
+	if requests, err := s.expiredBlobUploads(now.Add(-blobUploadTTL).UnixNano()); err != nil || len(requests) != 0 {
+		t.Fatalf("unexpected expired uploads %v, %v", requests, err)
+	}
+}
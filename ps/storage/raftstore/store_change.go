@@ -0,0 +1,311 @@
+package raftstore
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/proto/pspb/raftpb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/routine"
+)
+
+// Change events are kept in the internal keyspace of the engine, written by the same batch as the
+// documents they describe, so every replica holds the same events and snapshots carry them:
+//
+//	event: [changeLogPrefix][raft index][position] -> pspb.ChangeEvent
+//	floor: [changeFloorKey]                         -> the greatest raft index dropped by the retention
+var (
+	changeLogPrefix = []byte("_change_log_")
+	changeFloorKey  = []byte("_change_floor")
+
+	// ErrChangesExpired is returned when the events after a resume token are no longer retained.
+	ErrChangesExpired = errors.New("the changes after the token are expired")
+)
+
+const (
+	changeGCInterval = time.Minute
+	changeGCBatch    = 1000
+)
+
+func changeKey(index uint64, position uint32) []byte {
+	key := make([]byte, len(changeLogPrefix)+12)
+	copy(key, changeLogPrefix)
+	binary.BigEndian.PutUint64(key[len(changeLogPrefix):], index)
+	binary.BigEndian.PutUint32(key[len(changeLogPrefix)+8:], position)
+	return key
+}
+
+func changeLogEnd() []byte {
+	end := make([]byte, len(changeLogPrefix))
+	copy(end, changeLogPrefix)
+	end[len(end)-1]++
+	return end
+}
+
+// changeNotifier wakes up the watchers when new events are applied.
+type changeNotifier struct {
+	sync.Mutex
+	ch chan struct{}
+}
+
+func (n *changeNotifier) wait() <-chan struct{} {
+	n.Lock()
+	if n.ch == nil {
+		n.ch = make(chan struct{})
+	}
+	ch := n.ch
+	n.Unlock()
+	return ch
+}
+
+func (n *changeNotifier) notify() {
+	n.Lock()
+	if n.ch != nil {
+		close(n.ch)
+		n.ch = nil
+	}
+	n.Unlock()
+}
+
+// changeRecorder records the events of one raft command.
+type changeRecorder struct {
+	store     *Store
+	index     uint64
+	timestamp int64
+	events    int
+}
+
+// newChangeRecorder returns nil when changes are not captured, a nil recorder records nothing.
+// The events carry the time the command was proposed at, so every replica records the same ones.
+func (s *Store) newChangeRecorder(index uint64, timestamp int64) *changeRecorder {
+	if s.ChangesRetention <= 0 {
+		return nil
+	}
+	if timestamp == 0 {
+		// proposed before the commands carried their time
+		timestamp = time.Now().UnixNano()
+	}
+	return &changeRecorder{store: s, index: index, timestamp: timestamp}
+}
+
+func (r *changeRecorder) record(batch engine.Batch, position int, id metapb.Key, before, after metapb.Value) {
+	if r == nil {
+		return
+	}
+
+	event := &pspb.ChangeEvent{
+		PartitionID: r.store.Meta.ID,
+		Index:       r.index,
+		Position:    uint32(position),
+		ID:          id,
+		Before:      before,
+		After:       after,
+		Timestamp:   r.timestamp,
+	}
+	switch {
+	case after == nil:
+		event.Type = pspb.ChangeType_CHANGE_DELETE
+	case before == nil:
+		event.Type = pspb.ChangeType_CHANGE_INSERT
+	default:
+		event.Type = pspb.ChangeType_CHANGE_UPDATE
+	}
+	data, err := event.Marshal()
+	if err == nil {
+		err = batch.SetInternal(changeKey(r.index, uint32(position)), data)
+	}
+	if err != nil {
+		log.Error("partition[%d] record change of document[%s] error: %s", r.store.Meta.ID, id, err)
+		return
+	}
+	r.events++
+}
+
+// GetChanges returns the events with a raft index greater than fromIndex. The events of one raft
+// index are never split, so more than limit events may be returned.
+func (s *Store) GetChanges(fromIndex uint64, limit int) ([]pspb.ChangeEvent, error) {
+	if err := s.checkReadable(false); err != nil {
+		return nil, err
+	}
+
+	floor, err := s.getChangeFloor()
+	if err != nil {
+		return nil, err
+	}
+	if fromIndex > 0 && fromIndex < floor {
+		return nil, ErrChangesExpired
+	}
+
+	iter, err := s.Engine.NewInternalIterator(changeKey(fromIndex+1, 0), changeLogEnd())
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var events []pspb.ChangeEvent
+	for ; iter.Valid(); iter.Next() {
+		var event pspb.ChangeEvent
+		if err := event.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		if len(events) >= limit && events[len(events)-1].Index != event.Index {
+			break
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// WaitChanges returns a channel closed when new events are applied, it has to be taken before
+// reading the events, or the events applied between the read and the wait are missed.
+func (s *Store) WaitChanges() <-chan struct{} {
+	return s.changeNotify.wait()
+}
+
+// GetApplyID returns the raft index applied, the events up to it are readable.
+func (s *Store) GetApplyID() (uint64, error) {
+	if err := s.checkReadable(false); err != nil {
+		return 0, err
+	}
+	return s.Engine.GetApplyID()
+}
+
+func (s *Store) getChangeFloor() (uint64, error) {
+	v, err := s.Engine.GetInternal(changeFloorKey)
+	if err != nil || len(v) == 0 {
+		return 0, err
+	}
+	if len(v) != 8 {
+		return 0, errors.New("invalid change floor value")
+	}
+	return binary.BigEndian.Uint64(v), nil
+}
+
+func (s *Store) startChangeGC() {
+	if s.ChangesRetention <= 0 {
+		return
+	}
+	routine.RunWorkDaemon("PARTITION-CHANGE-GC", func() {
+		ticker := time.NewTicker(changeGCInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.Ctx.Done():
+				return
+			case <-ticker.C:
+				for {
+					s.RLock()
+					leader := s.Leader == uint64(s.NodeID)
+					s.RUnlock()
+					if !leader {
+						break
+					}
+					n, err := s.gcChanges()
+					if err != nil {
+						log.Error("partition[%d] drop expired changes error: %s", s.Meta.ID, err)
+					}
+					if err != nil || n < changeGCBatch {
+						break
+					}
+				}
+			}
+		}
+	}, s.Ctx.Done())
+}
+
+// gcChanges proposes to drop a batch of the events older than the retention of the leader. The
+// floor goes through raft, so every replica drops the same events and resumes the same tokens.
+func (s *Store) gcChanges() (int, error) {
+	floor, n, err := s.expiredChangeFloor(time.Now().Add(-s.ChangesRetention).UnixNano())
+	if err != nil || n == 0 {
+		return 0, err
+	}
+
+	raftCmd := raftpb.CreateRaftCommand()
+	raftCmd.Type = raftpb.CmdType_CHANGE_GC
+	raftCmd.ChangeFloor = floor
+	data, err := raftCmd.Marshal()
+	raftCmd.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(s.Ctx, changeGCInterval)
+	defer cancel()
+	future := s.RaftServer.Submit(s.Meta.ID, data)
+	respCh, errCh := future.AsyncResponse()
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case err := <-errCh:
+		return 0, err
+	case result := <-respCh:
+		return result.(int), nil
+	}
+}
+
+// expiredChangeFloor returns the greatest raft index of a batch of the events older than expire,
+// with the count of the events up to it. The events of one raft index are dropped together.
+func (s *Store) expiredChangeFloor(expire int64) (floor uint64, n int, err error) {
+	iter, err := s.Engine.NewInternalIterator(changeLogPrefix, changeLogEnd())
+	if err != nil {
+		return 0, 0, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var event pspb.ChangeEvent
+		if err := event.Unmarshal(iter.Value()); err != nil {
+			return 0, 0, err
+		}
+		if event.Timestamp >= expire || (n >= changeGCBatch && event.Index != floor) {
+			break
+		}
+		floor = event.Index
+		n++
+	}
+	return floor, n, nil
+}
+
+// applyChangeGC drops the events up to the floor in the apply of the raft index, it returns the
+// count of the events dropped.
+func (s *Store) applyChangeGC(index, floor uint64) (int, error) {
+	old, err := s.getChangeFloor()
+	if err != nil {
+		s.Engine.SetApplyID(index)
+		return 0, err
+	}
+	iter, err := s.Engine.NewInternalIterator(changeLogPrefix, changeKey(floor+1, 0))
+	if err != nil {
+		s.Engine.SetApplyID(index)
+		return 0, err
+	}
+	batch := s.Engine.NewWriteBatch()
+	var n int
+	for ; iter.Valid(); iter.Next() {
+		batch.DeleteInternal(append([]byte(nil), iter.Key()...))
+		n++
+	}
+	iter.Close()
+
+	if floor > old {
+		var buff [8]byte
+		binary.BigEndian.PutUint64(buff[:], floor)
+		batch.SetInternal(changeFloorKey, buff[:])
+	}
+	batch.SetApplyID(index)
+	if err := batch.Commit(); err != nil {
+		s.Engine.SetApplyID(index)
+		log.Error("partition[%d] drop changes up to index %d error: %s", s.Meta.ID, floor, err)
+		return 0, err
+	}
+	log.Debug("partition[%d] dropped %d expired changes up to index %d", s.Meta.ID, n, floor)
+	return n, nil
+}
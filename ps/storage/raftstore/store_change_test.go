@@ -0,0 +1,91 @@
+package raftstore
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func readChanges(t *testing.T, s *Store) []pspb.ChangeEvent {
+	iter, err := s.Engine.NewInternalIterator(changeLogPrefix, changeLogEnd())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+	var events []pspb.ChangeEvent
+	for ; iter.Valid(); iter.Next() {
+		var event pspb.ChangeEvent
+		if err := event.Unmarshal(iter.Value()); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestChangesReplicated(t *testing.T) {
+	var replicas []*Store
+	for i := 0; i < 2; i++ {
+		s, closer := newTestStore(t)
+		defer closer()
+		s.ChangesRetention = time.Hour
+		replicas = append(replicas, s)
+	}
+
+	old := time.Now().Add(-2 * time.Hour).UnixNano()
+	commands := []struct {
+		cmds      []pspb.RequestUnion
+		timestamp int64
+	}{
+		{[]pspb.RequestUnion{createCmd("a", `{"name": "a"}`, nil), createCmd("b", `{"name": "b"}`, nil)}, old},
+		{[]pspb.RequestUnion{deleteCmd("a", nil)}, old + 1},
+		{[]pspb.RequestUnion{createCmd("c", `{"name": "c"}`, nil)}, time.Now().UnixNano()},
+	}
+	for _, s := range replicas {
+		for i, c := range commands {
+			if _, err := s.execRaftCommand(uint64(i+1), c.cmds, false, c.timestamp); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// the replicas apply at different times
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	events := readChanges(t, replicas[0])
+	if len(events) != 4 || events[0].Timestamp != old || events[2].Timestamp != old+1 {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if !reflect.DeepEqual(events, readChanges(t, replicas[1])) {
+		t.Fatal("the replicas record different events")
+	}
+
+	// the leader picks the floor, every replica drops the events up to it
+	floor, n, err := replicas[0].expiredChangeFloor(time.Now().Add(-time.Hour).UnixNano())
+	if err != nil || floor != 2 || n != 3 {
+		t.Fatalf("unexpected floor %d of %d events, %v", floor, n, err)
+	}
+	for _, s := range replicas {
+		if dropped, err := s.applyChangeGC(4, floor); err != nil || dropped != 3 {
+			t.Fatalf("unexpected drop of %d events, %v", dropped, err)
+		}
+		if events := readChanges(t, s); len(events) != 1 || events[0].Index != 3 {
+			t.Fatalf("unexpected events after the gc %v", events)
+		}
+		if got, err := s.getChangeFloor(); err != nil || got != floor {
+			t.Fatalf("unexpected floor %d, %v", got, err)
+		}
+		if index, err := s.Engine.GetApplyID(); err != nil || index != 4 {
+			t.Fatalf("unexpected apply id %d, %v", index, err)
+		}
+	}
+
+	// a stale floor never moves the floor back
+	if _, err := replicas[0].applyChangeGC(5, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := replicas[0].getChangeFloor(); got != floor {
+		t.Fatalf("the floor moved back to %d", got)
+	}
+}
@@ -0,0 +1,204 @@
+package raftstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc64"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/pspb/raftpb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/routine"
+)
+
+// A checksum is computed by the CHECKSUM raft command, every replica hashes the snapshot of its
+// engine taken when it applies the command, so the checksums of the replicas cover the same index.
+// The snapshot iterator leaves the raft keys out, and the change events are left out too, they
+// are dropped by the retention of each replica.
+var (
+	checksumTable = crc64.MakeTable(crc64.ECMA)
+	// the engine keeps the internal keys behind a one byte row prefix
+	changeKeysPrefix = []byte("_change_")
+
+	// ErrChecksumNotFound is returned for an index applied without a checksum, or whose checksum
+	// is no longer kept.
+	ErrChecksumNotFound = errors.New("no checksum at the index")
+)
+
+const maxChecksums = 8
+
+// Checksum is the hash of the data of a replica at a raft index.
+type Checksum struct {
+	Index    uint64
+	Ready    bool
+	Checksum uint64
+	Keys     uint64
+	Err      error
+}
+
+// checksums keeps the last checksums of the replica by their index.
+type checksums struct {
+	sync.Mutex
+	results map[uint64]*Checksum
+	indexes []uint64
+}
+
+func (c *checksums) add(result *Checksum) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.results == nil {
+		c.results = make(map[uint64]*Checksum)
+	}
+	c.results[result.Index] = result
+	c.indexes = append(c.indexes, result.Index)
+	if len(c.indexes) > maxChecksums {
+		delete(c.results, c.indexes[0])
+		c.indexes = c.indexes[1:]
+	}
+}
+
+func (c *checksums) get(index uint64) (Checksum, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	result, ok := c.results[index]
+	if !ok {
+		return Checksum{}, false
+	}
+	return *result, true
+}
+
+func (c *checksums) finish(index, checksum, keys uint64, err error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if result, ok := c.results[index]; ok {
+		result.Ready, result.Checksum, result.Keys, result.Err = true, checksum, keys, err
+	}
+}
+
+// ComputeChecksum proposes a checksum of the replicas on the leader, it returns the index the
+// replicas hash their data at.
+func (s *Store) ComputeChecksum(timeout string) (uint64, error) {
+	if err := s.checkReadable(true); err != nil {
+		return 0, err
+	}
+
+	raftCmd := raftpb.CreateRaftCommand()
+	raftCmd.Type = raftpb.CmdType_CHECKSUM
+	data, err := raftCmd.Marshal()
+	raftCmd.Close()
+	if err != nil {
+		log.Error("marshal raftCommand error: [%s]", err)
+		return 0, err
+	}
+
+	var (
+		timeCtx = s.Ctx
+		cancel  context.CancelFunc
+
+		done   bool
+		result interface{}
+	)
+	if timeout != "" {
+		if timeout, e := time.ParseDuration(timeout); e == nil {
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+		}
+	}
+	future := s.RaftServer.Submit(s.Meta.ID, data)
+	respCh, errCh := future.AsyncResponse()
+	select {
+	case <-timeCtx.Done():
+		err = timeCtx.Err()
+		done = true
+
+	case err = <-errCh:
+
+	case result = <-respCh:
+	}
+	if cancel != nil {
+		cancel()
+	}
+
+	if err != nil {
+		_, err = s.fillBulkResponse(nil, nil, err, done)
+		return 0, err
+	}
+	return result.(uint64), nil
+}
+
+// GetChecksum returns the checksum of the replica at the index, it is not ready while the replica
+// has not applied the index or is still hashing.
+func (s *Store) GetChecksum(index uint64) (*Checksum, error) {
+	if err := s.checkReadable(false); err != nil {
+		return nil, err
+	}
+	if result, ok := s.checksums.get(index); ok {
+		return &result, nil
+	}
+
+	applied, err := s.Engine.GetApplyID()
+	if err != nil {
+		return nil, err
+	}
+	if applied < index {
+		return &Checksum{Index: index}, nil
+	}
+	return nil, ErrChecksumNotFound
+}
+
+// startChecksum hashes the data applied up to the index in the background.
+func (s *Store) startChecksum(index uint64) {
+	snap, err := s.Engine.NewSnapshot()
+	if err != nil {
+		log.Error("partition[%d] snapshot for checksum error: %s", s.Meta.ID, err)
+		s.checksums.add(&Checksum{Index: index, Ready: true, Err: err})
+		return
+	}
+
+	s.checksums.add(&Checksum{Index: index})
+	routine.RunWorkAsync("PARTITION-CHECKSUM", func() {
+		defer snap.Close()
+
+		checksum, keys, err := s.hashSnapshot(snap)
+		if err != nil {
+			log.Error("partition[%d] checksum at index[%d] error: %s", s.Meta.ID, index, err)
+		} else {
+			log.Info("partition[%d] checksum at index[%d] is %x of %d keys", s.Meta.ID, index, checksum, keys)
+		}
+		s.checksums.finish(index, checksum, keys, err)
+	}, routine.LogPanic(false))
+}
+
+// hashSnapshot rolls a crc64 over the lengths and bytes of the keys and values of the snapshot.
+func (s *Store) hashSnapshot(snap engine.Snapshot) (checksum uint64, keys uint64, err error) {
+	hash := crc64.New(checksumTable)
+	var length [4]byte
+
+	iter := snap.NewIterator()
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if err = s.Ctx.Err(); err != nil {
+			return
+		}
+
+		key := iter.Key()
+		if len(key) > 0 && bytes.HasPrefix(key[1:], changeKeysPrefix) {
+			continue
+		}
+		value := iter.Value()
+		binary.BigEndian.PutUint32(length[:], uint32(len(key)))
+		hash.Write(length[:])
+		hash.Write(key)
+		binary.BigEndian.PutUint32(length[:], uint32(len(value)))
+		hash.Write(length[:])
+		hash.Write(value)
+		keys++
+	}
+	return hash.Sum64(), keys, nil
+}
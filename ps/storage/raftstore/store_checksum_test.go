@@ -0,0 +1,108 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb/raftpb"
+)
+
+// applyChecksum applies the CHECKSUM command as the raft index and waits for the checksum.
+func applyChecksum(t *testing.T, s *Store, index uint64) *Checksum {
+	raftCmd := raftpb.CreateRaftCommand()
+	raftCmd.Type = raftpb.CmdType_CHECKSUM
+	data, err := raftCmd.Marshal()
+	raftCmd.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := s.Apply(data, index); err != nil || resp.(uint64) != index {
+		t.Fatalf("unexpected apply of the checksum %v, %v", resp, err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		result, err := s.GetChecksum(index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Ready {
+			if result.Err != nil {
+				t.Fatal(result.Err)
+			}
+			return result
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("checksum at index %d is not ready", index)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	var replicas []*Store
+	for i := 0; i < 2; i++ {
+		s, closer := newTestStore(t)
+		defer closer()
+		s.NodeID, s.Leader, s.Meta.Status = metapb.NodeID(i+1), 1, metapb.PA_READWRITE
+		replicas = append(replicas, s)
+	}
+	// only the first replica captures the changes, its change events are not hashed
+	replicas[0].ChangesRetention = time.Hour
+
+	for _, s := range replicas {
+		applyOne(t, s, 1, createCmd("a", `{"name": "a"}`, nil))
+		applyOne(t, s, 2, createCmd("b", `{"name": "b"}`, nil))
+		applyOne(t, s, 3, deleteCmd("a", nil))
+	}
+	if events := readChanges(t, replicas[0]); len(events) != 3 {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if events := readChanges(t, replicas[1]); len(events) != 0 {
+		t.Fatalf("unexpected events %v", events)
+	}
+
+	first, second := applyChecksum(t, replicas[0], 4), applyChecksum(t, replicas[1], 4)
+	if first.Keys == 0 || first.Checksum != second.Checksum || first.Keys != second.Keys {
+		t.Fatalf("the replicas differ at the same index: %+v, %+v", first, second)
+	}
+	if index, err := replicas[0].Engine.GetApplyID(); err != nil || index != 4 {
+		t.Fatalf("unexpected apply id %d, %v", index, err)
+	}
+
+	// the replicas writing different documents differ
+	applyOne(t, replicas[0], 5, createCmd("c", `{"name": "c"}`, nil))
+	applyOne(t, replicas[1], 5, createCmd("d", `{"name": "d"}`, nil))
+	first, second = applyChecksum(t, replicas[0], 6), applyChecksum(t, replicas[1], 6)
+	if first.Checksum == second.Checksum || first.Keys != second.Keys {
+		t.Fatalf("the replicas of different data match: %+v, %+v", first, second)
+	}
+
+	// the checksum of an index not applied yet is not ready, an index applied has none
+	if result, err := replicas[0].GetChecksum(10); err != nil || result.Ready {
+		t.Fatalf("unexpected checksum of an index not applied %+v, %v", result, err)
+	}
+	if _, err := replicas[0].GetChecksum(5); err != ErrChecksumNotFound {
+		t.Fatalf("unexpected checksum of an index applied without checksum: %v", err)
+	}
+}
+
+func TestChecksumsKept(t *testing.T) {
+	var c checksums
+	for i := uint64(1); i <= maxChecksums+1; i++ {
+		c.add(&Checksum{Index: i})
+	}
+	c.finish(2, 0xff, 3, nil)
+	if _, ok := c.get(1); ok {
+		t.Fatal("the oldest checksum is kept")
+	}
+	if result, ok := c.get(2); !ok || !result.Ready || result.Checksum != 0xff || result.Keys != 3 {
+		t.Fatalf("unexpected checksum %+v", result)
+	}
+	// finishing a checksum no longer kept adds nothing
+	c.finish(1, 0xff, 3, nil)
+	if _, ok := c.get(1); ok {
+		t.Fatal("the checksum no longer kept is added back")
+	}
+}
@@ -18,7 +18,15 @@ func (s *Store) Apply(command []byte, index uint64) (resp interface{}, err error
 
 	switch raftCmd.Type {
 	case raftpb.CmdType_WRITE:
-		resp, err = s.execRaftCommand(index, raftCmd.WriteCommands)
+		resp, err = s.execRaftCommand(index, raftCmd.WriteCommands, raftCmd.Atomic, raftCmd.Timestamp)
+
+	case raftpb.CmdType_CHANGE_GC:
+		resp, err = s.applyChangeGC(index, raftCmd.ChangeFloor)
+
+	case raftpb.CmdType_CHECKSUM:
+		s.Engine.SetApplyID(index)
+		s.startChecksum(index)
+		resp = index
 
 	default:
 		s.Engine.SetApplyID(index)
@@ -103,7 +111,10 @@ func (s *Store) HandleLeaderChange(leader uint64) {
 		}
 
 		if leader == uint64(s.NodeID) {
-			s.Meta.Status = metapb.PA_READWRITE
+			if s.diskReadOnly != nil {
+				s.readOnly = s.diskReadOnly()
+			}
+			s.Meta.Status = s.leaderStatus()
 			s.EventListener.HandleRaftLeaderEvent(&RaftLeaderEvent{Store: s})
 		} else {
 			s.Meta.Status = metapb.PA_READONLY
@@ -48,6 +48,82 @@ func (s *Store) Get(docID engine.DOC_ID, timeout string) (doc engine.DOCUMENT, f
 	return
 }
 
+// Search runs the query on the index of the partition, the timeout bounds the search.
+func (s *Store) Search(request *engine.SearchRequest, timeout string) (*engine.SearchResult, error) {
+	if err := s.checkReadable(true); err != nil {
+		log.Error("search error: [%s]", err)
+		return nil, err
+	}
+
+	timeCtx := s.Ctx
+	if timeout != "" {
+		if timeout, err := time.ParseDuration(timeout); err == nil {
+			var cancel context.CancelFunc
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+			defer cancel()
+			request.Timeout = timeout
+		}
+	}
+	result, err := s.Engine.Search(timeCtx, request)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			err = storage.ErrorTimeout
+		}
+		log.Error("search error: [%s]", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// aggregatePageSize is the hits an aggregation reads at a time
+const aggregatePageSize = 1000
+
+// Aggregate folds the hits of the query into the buckets of the aggregation. The hits are read a
+// page at a time in id order, the partition holds no more than a page and the buckets.
+func (s *Store) Aggregate(request *engine.SearchRequest, agg *engine.Aggregation, timeout string) (total uint64, buckets []*engine.Bucket, err error) {
+	if err = s.checkReadable(true); err != nil {
+		log.Error("aggregate error: [%s]", err)
+		return
+	}
+	aggregator, err := engine.NewAggregator(agg)
+	if err != nil {
+		return
+	}
+
+	timeCtx := s.Ctx
+	if timeout != "" {
+		if timeout, err := time.ParseDuration(timeout); err == nil {
+			var cancel context.CancelFunc
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+			defer cancel()
+			request.Timeout = timeout
+		}
+	}
+	request.Fields = aggregator.Fields()
+	request.Sort = []string{"_id"}
+	request.Size = aggregatePageSize
+	for request.From = 0; ; request.From += aggregatePageSize {
+		var result *engine.SearchResult
+		if result, err = s.Engine.Search(timeCtx, request); err != nil {
+			if err == context.DeadlineExceeded {
+				err = storage.ErrorTimeout
+			}
+			log.Error("aggregate error: [%s]", err)
+			return
+		}
+		total = result.Hits.Total
+		for _, hit := range result.Hits.Hits {
+			doc, _ := hit.Source.(map[string]interface{})
+			if err = aggregator.Add(doc); err != nil {
+				return
+			}
+		}
+		if len(result.Hits.Hits) < aggregatePageSize {
+			return total, aggregator.Buckets(), nil
+		}
+	}
+}
+
 func (s *Store) checkReadable(readLeader bool) (err error) {
 	s.RLock()
 
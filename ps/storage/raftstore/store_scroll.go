@@ -0,0 +1,149 @@
+package raftstore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/ps/storage"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+// A scroll keeps a search snapshot of the engine open between the searches of a client, the pages
+// it reads are cut from the documents as they were when the scroll was opened. The scrolls live on
+// the leader that opened them, they are lost with its leadership.
+var (
+	// ErrScrollNotFound is returned for a scroll expired, closed or opened on another replica.
+	ErrScrollNotFound = errors.New("scroll not found, it is expired or closed")
+	// ErrTooManyScrolls is returned when maxScrolls are open on the replica.
+	ErrTooManyScrolls = errors.New("too many open scrolls")
+)
+
+const maxScrolls = 64
+
+type scroll struct {
+	snapshot engine.SearchSnapshot
+	expire   time.Time
+}
+
+// scrolls keeps the open scrolls of the replica by their id.
+type scrolls struct {
+	sync.Mutex
+	seq  uint64
+	open map[string]*scroll
+}
+
+// take removes the scroll from the open ones while it is searched, the expired scrolls are closed.
+func (sc *scrolls) take(id string) (*scroll, error) {
+	sc.Lock()
+	defer sc.Unlock()
+
+	sc.sweep(time.Now())
+	s, ok := sc.open[id]
+	if !ok {
+		return nil, ErrScrollNotFound
+	}
+	delete(sc.open, id)
+	return s, nil
+}
+
+// put keeps the scroll until the expire, a new scroll gets an id.
+func (sc *scrolls) put(id string, s *scroll) (string, error) {
+	sc.Lock()
+	defer sc.Unlock()
+
+	sc.sweep(time.Now())
+	if len(sc.open) >= maxScrolls {
+		return "", ErrTooManyScrolls
+	}
+	if sc.open == nil {
+		sc.open = make(map[string]*scroll)
+	}
+	if id == "" {
+		// the ids of a restarted replica do not match the ones handed out before
+		if sc.seq == 0 {
+			sc.seq = uint64(time.Now().UnixNano())
+		}
+		sc.seq++
+		id = strconv.FormatUint(sc.seq, 36)
+	}
+	sc.open[id] = s
+	return id, nil
+}
+
+func (sc *scrolls) sweep(now time.Time) {
+	for id, s := range sc.open {
+		if now.After(s.expire) {
+			s.snapshot.Close()
+			delete(sc.open, id)
+		}
+	}
+}
+
+func (sc *scrolls) closeAll() {
+	sc.Lock()
+	defer sc.Unlock()
+
+	for id, s := range sc.open {
+		s.snapshot.Close()
+		delete(sc.open, id)
+	}
+}
+
+// ScrollSearch searches the snapshot of the scroll, a new one is opened if the id is empty. The
+// scroll is kept for keepAlive after the search and its id is returned, it is closed if keepAlive
+// is zero.
+func (s *Store) ScrollSearch(request *engine.SearchRequest, scrollID string, keepAlive time.Duration, timeout string) (*engine.SearchResult, string, error) {
+	if err := s.checkReadable(true); err != nil {
+		log.Error("scroll search error: [%s]", err)
+		return nil, "", err
+	}
+
+	var sc *scroll
+	if scrollID == "" {
+		snapshot, err := s.Engine.NewSearchSnapshot()
+		if err != nil {
+			log.Error("open search snapshot error: [%s]", err)
+			return nil, "", err
+		}
+		sc = &scroll{snapshot: snapshot}
+	} else {
+		var err error
+		if sc, err = s.scrolls.take(scrollID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	timeCtx := s.Ctx
+	if timeout != "" {
+		if timeout, err := time.ParseDuration(timeout); err == nil {
+			var cancel context.CancelFunc
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+			defer cancel()
+			request.Timeout = timeout
+		}
+	}
+	result, err := sc.snapshot.Search(timeCtx, request)
+	if err != nil {
+		sc.snapshot.Close()
+		if err == context.DeadlineExceeded {
+			err = storage.ErrorTimeout
+		}
+		log.Error("scroll search error: [%s]", err)
+		return nil, "", err
+	}
+	if keepAlive <= 0 {
+		sc.snapshot.Close()
+		return result, "", nil
+	}
+
+	sc.expire = time.Now().Add(keepAlive)
+	if scrollID, err = s.scrolls.put(scrollID, sc); err != nil {
+		sc.snapshot.Close()
+		return nil, "", err
+	}
+	return result, scrollID, nil
+}
@@ -0,0 +1,57 @@
+package raftstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+func TestScrollSearch(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+	s.NodeID, s.Leader, s.Meta.Status = 1, 1, metapb.PA_READWRITE
+
+	for i := 0; i < 3; i++ {
+		applyOne(t, s, uint64(i+1), createCmd(fmt.Sprintf("d%d", i), fmt.Sprintf(`{"name": "d%d", "age": %d}`, i, i), nil))
+	}
+	search := func(from int) *engine.SearchRequest {
+		req := engine.NewSearchQuery("", "")
+		req.SetQuery([]byte(`{"match_all": {}}`))
+		req.SetFrom(from)
+		req.SetSize(2)
+		req.Sort = []string{"age"}
+		return req
+	}
+
+	result, scrollID, err := s.ScrollSearch(search(0), "", time.Minute, "")
+	if err != nil || scrollID == "" || len(result.Hits.Hits) != 2 {
+		t.Fatalf("unexpected first page %v, %q, %v", result, scrollID, err)
+	}
+	// the writes after the scroll is opened are not seen by its pages
+	applyOne(t, s, 4, deleteCmd("d2", nil))
+	applyOne(t, s, 5, createCmd("d3", `{"name": "d3", "age": 3}`, nil))
+	result, next, err := s.ScrollSearch(search(2), scrollID, 0, "")
+	if err != nil || next != "" || result.Hits.Total != 3 || len(result.Hits.Hits) != 1 || result.Hits.Hits[0].Id != "d2" {
+		t.Fatalf("unexpected last page %v, %q, %v", result, next, err)
+	}
+	// a scroll without keep alive is closed
+	if _, _, err := s.ScrollSearch(search(0), scrollID, time.Minute, ""); err != ErrScrollNotFound {
+		t.Fatalf("the closed scroll is searched: %v", err)
+	}
+
+	// an expired scroll is closed by the next call
+	_, scrollID, err = s.ScrollSearch(search(0), "", time.Millisecond, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if _, _, err := s.ScrollSearch(search(0), scrollID, time.Minute, ""); err != ErrScrollNotFound {
+		t.Fatalf("the expired scroll is searched: %v", err)
+	}
+	if len(s.scrolls.open) != 0 {
+		t.Fatalf("%d scrolls are left open", len(s.scrolls.open))
+	}
+}
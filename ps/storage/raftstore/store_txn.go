@@ -0,0 +1,299 @@
+package raftstore
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/ps/storage"
+)
+
+// Transactions across partitions are coordinated by the router, the partitions keep their state in
+// the internal keyspace of the engine:
+//
+//	record: [txnRecordPrefix][txn id]      -> pspb.TxnRecord, on the partition of the transaction space
+//	intent: [txnIntentPrefix][document id] -> pspb.TxnIntent, on the partition of the document
+//
+// A document holds one intent at most, the other writes to it fail with a conflict until the
+// intent is resolved.
+var (
+	txnRecordPrefix = []byte("_txn_record_")
+	txnIntentPrefix = []byte("_txn_intent_")
+)
+
+func txnRecordKey(txnID string) []byte {
+	key := make([]byte, 0, len(txnRecordPrefix)+len(txnID))
+	key = append(key, txnRecordPrefix...)
+	return append(key, txnID...)
+}
+
+func txnIntentKey(id metapb.Key) []byte {
+	key := make([]byte, 0, len(txnIntentPrefix)+len(id))
+	key = append(key, txnIntentPrefix...)
+	return append(key, id...)
+}
+
+// GetIntent returns the unresolved intent on the document, nil if there is none.
+func (s *Store) GetIntent(id metapb.Key) (*pspb.TxnIntent, error) {
+	if err := s.checkReadable(true); err != nil {
+		return nil, err
+	}
+	return s.getIntent(id)
+}
+
+func (s *Store) getIntent(id metapb.Key) (*pspb.TxnIntent, error) {
+	data, err := s.Engine.GetInternal(txnIntentKey(id))
+	if err != nil || data == nil {
+		return nil, err
+	}
+	intent := new(pspb.TxnIntent)
+	if err := intent.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return intent, nil
+}
+
+func (s *Store) getTxnRecord(txnID string) (*pspb.TxnRecord, error) {
+	data, err := s.Engine.GetInternal(txnRecordKey(txnID))
+	if err != nil || data == nil {
+		return nil, err
+	}
+	record := new(pspb.TxnRecord)
+	if err := record.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (s *Store) txnRecordPutInternal(request *pspb.TxnRecordRequest, docs *commandDocs, batch engine.Batch) (*pspb.TxnRecordResponse, error) {
+	record := request.Record
+	if record.TxnID == "" {
+		return nil, storage.ErrorTxnID
+	}
+	old, err := docs.record(record.TxnID)
+	if err != nil {
+		return nil, err
+	}
+	if old != nil {
+		return nil, storage.ErrorTxnExists
+	}
+	record.Status = pspb.TxnStatus_TXN_PENDING
+	if err := docs.setRecord(batch, &record); err != nil {
+		return nil, err
+	}
+	return &pspb.TxnRecordResponse{Result: pspb.WriteResult_CREATED, Record: record}, nil
+}
+
+// txnRecordFinishInternal moves a pending record to the requested status. The status of a finished
+// record never changes, the record is returned as it is. A missing record is reported aborted,
+// the record of a committed transaction is kept until all its intents are resolved.
+func (s *Store) txnRecordFinishInternal(request *pspb.TxnRecordRequest, docs *commandDocs, batch engine.Batch) (*pspb.TxnRecordResponse, error) {
+	txnID := request.Record.TxnID
+	record, err := docs.record(txnID)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return &pspb.TxnRecordResponse{
+			Result: pspb.WriteResult_NOT_FOUND,
+			Record: pspb.TxnRecord{TxnID: txnID, Status: pspb.TxnStatus_TXN_ABORTED},
+		}, nil
+	}
+
+	resp := &pspb.TxnRecordResponse{Result: pspb.WriteResult_NOOP, Record: *record}
+	if record.Status != pspb.TxnStatus_TXN_PENDING {
+		return resp, nil
+	}
+	switch request.Record.Status {
+	case pspb.TxnStatus_TXN_COMMITTED:
+	case pspb.TxnStatus_TXN_ABORTED:
+		// the time is from the request, so every replica makes the same decision
+		if request.Now != 0 && record.Deadline >= request.Now {
+			return resp, nil
+		}
+	default:
+		return nil, storage.ErrorTxnStatus
+	}
+
+	resp.Record.Status = request.Record.Status
+	if err := docs.setRecord(batch, &resp.Record); err != nil {
+		return nil, err
+	}
+	resp.Result = pspb.WriteResult_UPDATED
+	return resp, nil
+}
+
+func (s *Store) txnRecordDeleteInternal(request *pspb.TxnRecordRequest, docs *commandDocs, batch engine.Batch) (*pspb.TxnRecordResponse, error) {
+	txnID := request.Record.TxnID
+	record, err := docs.record(txnID)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return &pspb.TxnRecordResponse{Result: pspb.WriteResult_NOT_FOUND, Record: request.Record}, nil
+	}
+	if record.Status == pspb.TxnStatus_TXN_PENDING {
+		return nil, storage.ErrorTxnStatus
+	}
+	if err := docs.deleteRecord(batch, txnID); err != nil {
+		return nil, err
+	}
+	return &pspb.TxnRecordResponse{Result: pspb.WriteResult_DELETED, Record: *record}, nil
+}
+
+// txnPrepareInternal lays the intent after checking the precondition of its write against the
+// committed document, the write itself is not applied until the intent is resolved.
+func (s *Store) txnPrepareInternal(request *pspb.TxnPrepareRequest, docs *commandDocs, batch engine.Batch) (*pspb.TxnPrepareResponse, error) {
+	intent := request.Intent
+	id := writeCommandID(&intent.Write)
+	if intent.TxnID == "" {
+		return nil, storage.ErrorTxnID
+	}
+	switch intent.Write.OpType {
+	case pspb.OpType_CREATE, pspb.OpType_UPDATE, pspb.OpType_DELETE:
+	default:
+		return nil, storage.ErrorCommand
+	}
+	if id == nil {
+		return nil, storage.ErrorCommand
+	}
+	// the write is applied by the resolve, it must not fail there
+	if data := intentData(&intent.Write); data != nil && !json.Valid(data) {
+		return nil, storage.ErrorTxnData
+	}
+
+	resp := &pspb.TxnPrepareResponse{ID: id, Result: pspb.WriteResult_CREATED}
+	old, err := docs.intent(id)
+	if err != nil {
+		return nil, err
+	}
+	if old != nil {
+		if old.TxnID == intent.TxnID {
+			// a retried prepare
+			resp.Result = pspb.WriteResult_NOOP
+			return resp, nil
+		}
+		resp.Conflict = old
+		return resp, storage.ErrorTxnConflict
+	}
+	if err := checkPrecondition(intent.Write.Precondition, docs.get(id)); err != nil {
+		return nil, err
+	}
+
+	if err := docs.setIntent(batch, id, &intent); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func intentData(write *pspb.RequestUnion) metapb.Value {
+	switch {
+	case write.Create != nil:
+		return write.Create.Data
+	case write.Update != nil:
+		return write.Update.Data
+	}
+	return nil
+}
+
+// txnResolveInternal applies the write of the intent on commit and drops the intent. Resolving
+// an intent which is not on the document is a noop, so resolving is idempotent.
+func (s *Store) txnResolveInternal(request *pspb.TxnResolveRequest, docs *commandDocs, changes *changeRecorder, position int, batch engine.Batch) (*pspb.TxnResolveResponse, error) {
+	resp := &pspb.TxnResolveResponse{ID: request.ID, Result: pspb.WriteResult_NOOP}
+	intent, err := docs.intent(request.ID)
+	if err != nil {
+		return nil, err
+	}
+	if intent == nil || intent.TxnID != request.TxnID {
+		return resp, nil
+	}
+
+	if err := docs.deleteIntent(batch, request.ID); err != nil {
+		return nil, err
+	}
+	if !request.Commit {
+		resp.Result = pspb.WriteResult_DELETED
+		return resp, nil
+	}
+
+	// the precondition was checked by the prepare, the intent kept others from the document since
+	write := intent.Write
+	write.Precondition = nil
+	var writeResp pspb.ResponseUnion
+	s.execWriteCommand(batch, docs, changes, position, &write, &writeResp)
+	if writeResp.Failure != nil {
+		// keep the intent, so the resolve can be retried
+		if err := docs.setIntent(batch, request.ID, intent); err != nil {
+			return nil, err
+		}
+		return nil, errors.New(writeResp.Failure.Cause)
+	}
+	resp.Result = pspb.WriteResult_UPDATED
+	return resp, nil
+}
+
+func (d *commandDocs) intent(id metapb.Key) (*pspb.TxnIntent, error) {
+	if intent, ok := d.intents[string(id)]; ok {
+		return intent, nil
+	}
+	intent, err := d.store.getIntent(id)
+	if err != nil {
+		return nil, err
+	}
+	d.intents[string(id)] = intent
+	return intent, nil
+}
+
+func (d *commandDocs) setIntent(batch engine.Batch, id metapb.Key, intent *pspb.TxnIntent) error {
+	data, err := intent.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := batch.SetInternal(txnIntentKey(id), data); err != nil {
+		return err
+	}
+	d.intents[string(id)] = intent
+	return nil
+}
+
+func (d *commandDocs) deleteIntent(batch engine.Batch, id metapb.Key) error {
+	if err := batch.DeleteInternal(txnIntentKey(id)); err != nil {
+		return err
+	}
+	d.intents[string(id)] = nil
+	return nil
+}
+
+func (d *commandDocs) record(txnID string) (*pspb.TxnRecord, error) {
+	if record, ok := d.records[txnID]; ok {
+		return record, nil
+	}
+	record, err := d.store.getTxnRecord(txnID)
+	if err != nil {
+		return nil, err
+	}
+	d.records[txnID] = record
+	return record, nil
+}
+
+func (d *commandDocs) setRecord(batch engine.Batch, record *pspb.TxnRecord) error {
+	data, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := batch.SetInternal(txnRecordKey(record.TxnID), data); err != nil {
+		return err
+	}
+	d.records[record.TxnID] = record
+	return nil
+}
+
+func (d *commandDocs) deleteRecord(batch engine.Batch, txnID string) error {
+	if err := batch.DeleteInternal(txnRecordKey(txnID)); err != nil {
+		return err
+	}
+	d.records[txnID] = nil
+	return nil
+}
@@ -0,0 +1,128 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/ps/storage"
+)
+
+func prepareCmd(txnID string, write pspb.RequestUnion) pspb.RequestUnion {
+	return pspb.RequestUnion{
+		OpType:     pspb.OpType_TXN_PREPARE,
+		TxnPrepare: &pspb.TxnPrepareRequest{Intent: pspb.TxnIntent{TxnID: txnID, Write: write}},
+	}
+}
+
+func resolveCmd(id, txnID string, commit bool) pspb.RequestUnion {
+	return pspb.RequestUnion{
+		OpType:     pspb.OpType_TXN_RESOLVE,
+		TxnResolve: &pspb.TxnResolveRequest{ID: metapb.Key(id), TxnID: txnID, Commit: commit},
+	}
+}
+
+func recordCmd(op pspb.OpType, record pspb.TxnRecord, now int64) pspb.RequestUnion {
+	return pspb.RequestUnion{OpType: op, TxnRecord: &pspb.TxnRecordRequest{Record: record, Now: now}}
+}
+
+func TestTxnIntent(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+
+	if resp := applyOne(t, s, 1, prepareCmd("t1", createCmd("a", `{"name": "a"}`, nil))); resp.Failure != nil {
+		t.Fatal(resp.Failure.Cause)
+	}
+	if intent, err := s.getIntent(metapb.Key("a")); err != nil || intent == nil || intent.TxnID != "t1" {
+		t.Fatalf("unexpected intent %v, %v", intent, err)
+	}
+	if docExists(s, "a") {
+		t.Fatal("the write of the intent is applied before the resolve")
+	}
+
+	// the intent keeps the others from the document
+	resp := applyOne(t, s, 2, prepareCmd("t2", createCmd("a", `{"name": "a2"}`, nil)))
+	if resp.Failure == nil || resp.Failure.Cause != storage.ErrorTxnConflict.Error() ||
+		resp.TxnPrepare == nil || resp.TxnPrepare.Conflict == nil || resp.TxnPrepare.Conflict.TxnID != "t1" {
+		t.Fatalf("unexpected response of the conflicting prepare %v", resp)
+	}
+	if resp := applyOne(t, s, 3, createCmd("a", `{"name": "a3"}`, nil)); resp.Failure == nil {
+		t.Fatal("a write on a locked document is applied")
+	}
+	// a retried prepare is a no-op, the resolve of another transaction too
+	if resp := applyOne(t, s, 4, prepareCmd("t1", createCmd("a", `{"name": "a"}`, nil))); resp.Failure != nil ||
+		resp.TxnPrepare.Result != pspb.WriteResult_NOOP {
+		t.Fatalf("unexpected response of the retried prepare %v", resp)
+	}
+	if resp := applyOne(t, s, 5, resolveCmd("a", "t2", true)); resp.Failure != nil ||
+		resp.TxnResolve.Result != pspb.WriteResult_NOOP {
+		t.Fatalf("unexpected response of the foreign resolve %v", resp)
+	}
+
+	if resp := applyOne(t, s, 6, resolveCmd("a", "t1", true)); resp.Failure != nil ||
+		resp.TxnResolve.Result != pspb.WriteResult_UPDATED {
+		t.Fatalf("unexpected response of the resolve %v", resp)
+	}
+	if intent, err := s.getIntent(metapb.Key("a")); err != nil || intent != nil || !docExists(s, "a") {
+		t.Fatalf("the committed intent is not applied: %v, %v", intent, err)
+	}
+
+	// the precondition is checked by the prepare, an aborted intent leaves nothing behind
+	if resp := applyOne(t, s, 7, prepareCmd("t3", createCmd("a", `{}`, &pspb.Precondition{NotExists: true}))); resp.Failure == nil {
+		t.Fatal("an intent failing its precondition is laid")
+	}
+	applyOne(t, s, 8, prepareCmd("t4", deleteCmd("a", nil)))
+	if resp := applyOne(t, s, 9, resolveCmd("a", "t4", false)); resp.Failure != nil ||
+		resp.TxnResolve.Result != pspb.WriteResult_DELETED {
+		t.Fatalf("unexpected response of the abort %v", resp)
+	}
+	if intent, err := s.getIntent(metapb.Key("a")); err != nil || intent != nil || !docExists(s, "a") {
+		t.Fatalf("the aborted intent is applied: %v, %v", intent, err)
+	}
+}
+
+func TestTxnRecord(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+
+	now := time.Now().UnixNano()
+	record := pspb.TxnRecord{TxnID: "t1", Deadline: now}
+	if resp := applyOne(t, s, 1, recordCmd(pspb.OpType_TXN_RECORD_PUT, record, 0)); resp.Failure != nil {
+		t.Fatal(resp.Failure.Cause)
+	}
+	if resp := applyOne(t, s, 2, recordCmd(pspb.OpType_TXN_RECORD_PUT, record, 0)); resp.Failure == nil {
+		t.Fatal("a record is put twice")
+	}
+	if resp := applyOne(t, s, 3, recordCmd(pspb.OpType_TXN_RECORD_DELETE, record, 0)); resp.Failure == nil {
+		t.Fatal("a pending record is deleted")
+	}
+
+	// a reader aborts a pending record only after its deadline
+	record.Status = pspb.TxnStatus_TXN_ABORTED
+	resp := applyOne(t, s, 4, recordCmd(pspb.OpType_TXN_RECORD_FINISH, record, now-1))
+	if resp.Failure != nil || resp.TxnRecord.Record.Status != pspb.TxnStatus_TXN_PENDING {
+		t.Fatalf("a live record is aborted: %v", resp)
+	}
+	resp = applyOne(t, s, 5, recordCmd(pspb.OpType_TXN_RECORD_FINISH, record, now+1))
+	if resp.Failure != nil || resp.TxnRecord.Record.Status != pspb.TxnStatus_TXN_ABORTED {
+		t.Fatalf("an expired record is not aborted: %v", resp)
+	}
+
+	// a finished record never changes
+	record.Status = pspb.TxnStatus_TXN_COMMITTED
+	resp = applyOne(t, s, 6, recordCmd(pspb.OpType_TXN_RECORD_FINISH, record, 0))
+	if resp.Failure != nil || resp.TxnRecord.Result != pspb.WriteResult_NOOP ||
+		resp.TxnRecord.Record.Status != pspb.TxnStatus_TXN_ABORTED {
+		t.Fatalf("a finished record is changed: %v", resp)
+	}
+	if resp := applyOne(t, s, 7, recordCmd(pspb.OpType_TXN_RECORD_DELETE, record, 0)); resp.Failure != nil {
+		t.Fatal(resp.Failure.Cause)
+	}
+	// a missing record is reported aborted
+	resp = applyOne(t, s, 8, recordCmd(pspb.OpType_TXN_RECORD_FINISH, record, 0))
+	if resp.Failure != nil || resp.TxnRecord.Result != pspb.WriteResult_NOT_FOUND ||
+		resp.TxnRecord.Record.Status != pspb.TxnStatus_TXN_ABORTED {
+		t.Fatalf("unexpected finish of a missing record: %v", resp)
+	}
+}
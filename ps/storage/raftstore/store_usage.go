@@ -0,0 +1,64 @@
+package raftstore
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+// the size is measured by walking the engine directory, it is too slow to repeat on every heartbeat
+const usageRefreshInterval = 30 * time.Second
+
+// usage caches the bytes on disk and the document count of the replica, the master sums them up
+// for the quotas of the spaces.
+type usage struct {
+	sync.Mutex
+	size      uint64
+	docCount  uint64
+	refreshed time.Time
+}
+
+// getUsage returns the size and the document count of the replica, refreshed at most once in
+// usageRefreshInterval.
+func (s *Store) getUsage() (size, docCount uint64) {
+	s.usage.Lock()
+	defer s.usage.Unlock()
+
+	if s.Engine == nil || time.Since(s.usage.refreshed) < usageRefreshInterval {
+		return s.usage.size, s.usage.docCount
+	}
+
+	if count, err := s.Engine.DocCount(); err != nil {
+		log.Warn("partition[%d] count documents error: %s", s.Meta.ID, err)
+	} else {
+		s.usage.docCount = count
+	}
+	if size, err := dirSize(s.EngineConf.Path); err != nil {
+		log.Warn("partition[%d] measure engine directory[%s] error: %s", s.Meta.ID, s.EngineConf.Path, err)
+	} else {
+		s.usage.size = size
+	}
+	s.usage.refreshed = time.Now()
+	return s.usage.size, s.usage.docCount
+}
+
+func dirSize(dir string) (uint64, error) {
+	var size uint64
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// the engine removes the files it compacts while walking
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,52 @@
+package raftstore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestUsage(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+	dir, err := ioutil.TempDir("", "usage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s.EngineConf.Path = dir
+	writeFile := func(name string, size int) {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeFile("a", 100)
+	writeFile("sub/b", 20)
+	applyOne(t, s, 1, createCmd("a", `{"name": "a"}`, nil))
+	applyOne(t, s, 2, createCmd("b", `{"name": "b"}`, nil))
+	if size, docCount := s.getUsage(); size != 120 || docCount != 2 {
+		t.Fatalf("unexpected usage of %d bytes and %d documents", size, docCount)
+	}
+
+	// the usage is measured again only after the refresh interval
+	writeFile("c", 30)
+	applyOne(t, s, 3, createCmd("c", `{"name": "c"}`, nil))
+	if size, docCount := s.getUsage(); size != 120 || docCount != 2 {
+		t.Fatalf("unexpected usage of %d bytes and %d documents", size, docCount)
+	}
+	s.usage.refreshed = time.Now().Add(-usageRefreshInterval)
+	if size, docCount := s.getUsage(); size != 150 || docCount != 3 {
+		t.Fatalf("unexpected usage of %d bytes and %d documents", size, docCount)
+	}
+
+	// the directory removed measures nothing
+	if size, err := dirSize(filepath.Join(dir, "none")); err != nil || size != 0 {
+		t.Fatalf("unexpected size %d of a missing directory, %v", size, err)
+	}
+}
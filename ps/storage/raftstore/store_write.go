@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"reflect"
 	"time"
 
 	"github.com/tiglabs/baudengine/engine"
@@ -15,15 +16,22 @@ import (
 	"github.com/tiglabs/raft"
 )
 
-// Bulk perform many index/delete operations in a single API call.
-func (s *Store) Bulk(requests []pspb.RequestUnion, timeout string) (responses []pspb.ResponseUnion, err error) {
+// Bulk perform many index/delete operations in a single API call, with atomic set they all commit
+// or none does.
+func (s *Store) Bulk(requests []pspb.RequestUnion, atomic bool, timeout string) (responses []pspb.ResponseUnion, err error) {
 	s.RLock()
 	pstatus := s.Meta.Status
+	// a follower leaves the writes to the leader, which may have disk space
+	readOnly := s.readOnly && s.Leader == uint64(s.NodeID)
 	s.RUnlock()
 	if pstatus == metapb.PA_INVALID || pstatus == metapb.PA_NOTREAD {
 		err = &metapb.PartitionNotFound{s.Meta.ID}
 		return
 	}
+	if readOnly && !freesSpace(requests) {
+		err = &metapb.PartitionReadOnly{PartitionID: s.Meta.ID, NodeID: s.NodeID}
+		return
+	}
 
 	var (
 		timeCtx = s.Ctx
@@ -41,6 +49,8 @@ func (s *Store) Bulk(requests []pspb.RequestUnion, timeout string) (responses []
 	raftCmd := raftpb.CreateRaftCommand()
 	raftCmd.Type = raftpb.CmdType_WRITE
 	raftCmd.WriteCommands = requests
+	raftCmd.Atomic = atomic
+	raftCmd.Timestamp = time.Now().UnixNano()
 	if data, e := raftCmd.Marshal(); e != nil {
 		err = e
 		log.Error("marshal raftCommand error: [%s]", err)
@@ -66,6 +76,16 @@ func (s *Store) Bulk(requests []pspb.RequestUnion, timeout string) (responses []
 	return s.fillBulkResponse(requests, result, err, done)
 }
 
+// freesSpace reports whether the requests only drop data, they are let through a full disk.
+func freesSpace(requests []pspb.RequestUnion) bool {
+	for i := range requests {
+		if requests[i].OpType != pspb.OpType_BLOB_ABORT {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *Store) fillBulkResponse(requests []pspb.RequestUnion, result interface{}, err error, done bool) ([]pspb.ResponseUnion, error) {
 	if err == nil {
 		return result.([]pspb.ResponseUnion), nil
@@ -106,52 +126,299 @@ func (s *Store) fillBulkResponse(requests []pspb.RequestUnion, result interface{
 	return nil, err
 }
 
-func (s *Store) execRaftCommand(index uint64, cmds []pspb.RequestUnion) ([]pspb.ResponseUnion, error) {
+func (s *Store) execRaftCommand(index uint64, cmds []pspb.RequestUnion, atomic bool, timestamp int64) ([]pspb.ResponseUnion, error) {
 	batch := s.Engine.NewWriteBatch()
 	resp := make([]pspb.ResponseUnion, len(cmds))
+	docs := s.newCommandDocs()
+	changes := s.newChangeRecorder(index, timestamp)
+
+	for i := range cmds {
+		s.execWriteCommand(batch, docs, changes, i, &cmds[i], &resp[i])
+
+		// an atomic command applies nothing but its apply id once a write fails
+		if atomic && resp[i].Failure != nil {
+			batch.Rollback()
+			abortWriteCommands(cmds, resp, i)
+			batch = s.Engine.NewWriteBatch()
+			changes = nil
+			break
+		}
+	}
 
-	for i, cmd := range cmds {
-		resp[i].OpType = cmd.OpType
+	batch.SetApplyID(index)
+	if err := batch.Commit(); err != nil {
+		s.Engine.SetApplyID(index)
+		log.Error("could not commit batch,error is:[%s]", err)
+		return nil, errors.New("could not commit batch")
+	}
+	if changes != nil && changes.events > 0 {
+		s.changeNotify.notify()
+	}
+
+	return resp, nil
+}
+
+func (s *Store) execWriteCommand(batch engine.Batch, docs *commandDocs, changes *changeRecorder, position int, cmd *pspb.RequestUnion, resp *pspb.ResponseUnion) {
+	resp.OpType = cmd.OpType
+
+	switch cmd.OpType {
+	case pspb.OpType_CREATE, pspb.OpType_UPDATE, pspb.OpType_DELETE:
+		id := writeCommandID(cmd)
+		// a document under the intent of a transaction is written by the transaction only
+		if intent, err := docs.intent(id); err != nil || intent != nil {
+			if err == nil {
+				err = storage.ErrorTxnConflict
+			}
+			resp.Failure = &pspb.Failure{ID: id, Cause: err.Error()}
+			return
+		}
+		// the document as the earlier writes of the command left it, the engine sees the
+		// committed documents only. It is read for the precondition and the change only, the
+		// other writes need to know if it exists.
+		var before metapb.Value
+		if cmd.Precondition != nil || changes != nil {
+			before = docs.get(id)
+		}
+		if err := checkPrecondition(cmd.Precondition, before); err != nil {
+			resp.Failure = &pspb.Failure{ID: id, Cause: err.Error()}
+			return
+		}
 
 		switch cmd.OpType {
 		case pspb.OpType_CREATE:
 			if createResp, err := s.createInternal(cmd.Create, batch); err == nil {
-				resp[i].Create = createResp
+				resp.Create = createResp
+				docs.set(id, cmd.Create.Data)
+				changes.record(batch, position, id, before, cmd.Create.Data)
 			} else {
 				log.Error("create document error:[%s],\n create request is:[%s]", err, cmd.Create)
-				resp[i].Failure = &pspb.Failure{ID: cmd.Create.ID, Cause: err.Error()}
+				resp.Failure = &pspb.Failure{ID: id, Cause: err.Error()}
 			}
 
 		case pspb.OpType_UPDATE:
-			if updateResp, err := s.updateInternal(cmd.Update, batch); err == nil {
-				resp[i].Update = updateResp
+			if updateResp, err := s.updateInternal(cmd.Update, docs.exists(id), batch); err == nil {
+				resp.Update = updateResp
+				if updateResp.Result != pspb.WriteResult_NOT_FOUND {
+					docs.set(id, cmd.Update.Data)
+					changes.record(batch, position, id, before, cmd.Update.Data)
+				}
 			} else {
 				log.Error("update document error:[%s],\n update request is:[%s]", err, cmd.Update)
-				resp[i].Failure = &pspb.Failure{ID: cmd.Update.ID, Cause: err.Error()}
+				resp.Failure = &pspb.Failure{ID: id, Cause: err.Error()}
 			}
 
 		case pspb.OpType_DELETE:
-			if delResp, err := s.deleteInternal(cmd.Delete, batch); err == nil {
-				resp[i].Delete = delResp
+			if delResp, err := s.deleteInternal(cmd.Delete, docs.exists(id), batch); err == nil {
+				resp.Delete = delResp
+				if delResp.Result == pspb.WriteResult_DELETED {
+					docs.set(id, nil)
+					changes.record(batch, position, id, before, nil)
+				}
 			} else {
 				log.Error("delete document error:[%s],\n delete request is:[%s]", err, cmd.Delete)
-				resp[i].Failure = &pspb.Failure{ID: cmd.Delete.ID, Cause: err.Error()}
+				resp.Failure = &pspb.Failure{ID: id, Cause: err.Error()}
 			}
+		}
+
+	case pspb.OpType_BLOB_CHUNK:
+		if chunkResp, err := s.blobChunkInternal(cmd.BlobChunk, docs, batch); err == nil {
+			resp.BlobChunk = chunkResp
+		} else {
+			log.Error("write blob chunk error:[%s], blob is:[%s], chunk is:[%d]", err, cmd.BlobChunk.ID, cmd.BlobChunk.Index)
+			resp.Failure = &pspb.Failure{ID: cmd.BlobChunk.ID, Cause: err.Error()}
+		}
+
+	case pspb.OpType_BLOB_COMMIT:
+		if commitResp, err := s.blobCommitInternal(cmd.BlobCommit, docs, batch); err == nil {
+			resp.BlobCommit = commitResp
+		} else {
+			log.Error("commit blob error:[%s],\n commit request is:[%s]", err, cmd.BlobCommit)
+			resp.Failure = &pspb.Failure{ID: cmd.BlobCommit.ID, Cause: err.Error()}
+		}
+
+	case pspb.OpType_BLOB_ABORT:
+		if abortResp, err := s.blobAbortInternal(cmd.BlobAbort, docs, batch); err == nil {
+			resp.BlobAbort = abortResp
+		} else {
+			log.Error("abort blob upload error:[%s],\n abort request is:[%s]", err, cmd.BlobAbort)
+			resp.Failure = &pspb.Failure{ID: cmd.BlobAbort.ID, Cause: err.Error()}
+		}
 
+	case pspb.OpType_BLOB_DELETE:
+		if delResp, err := s.blobDeleteInternal(cmd.Delete, batch); err == nil {
+			resp.Delete = delResp
+		} else {
+			log.Error("delete blob error:[%s],\n delete request is:[%s]", err, cmd.Delete)
+			resp.Failure = &pspb.Failure{ID: cmd.Delete.ID, Cause: err.Error()}
+		}
+
+	case pspb.OpType_TXN_RECORD_PUT, pspb.OpType_TXN_RECORD_FINISH, pspb.OpType_TXN_RECORD_DELETE:
+		var recordResp *pspb.TxnRecordResponse
+		var err error
+		switch cmd.OpType {
+		case pspb.OpType_TXN_RECORD_PUT:
+			recordResp, err = s.txnRecordPutInternal(cmd.TxnRecord, docs, batch)
+		case pspb.OpType_TXN_RECORD_FINISH:
+			recordResp, err = s.txnRecordFinishInternal(cmd.TxnRecord, docs, batch)
 		default:
-			log.Error("unsupported command[%v]", cmd)
-			resp[i].Failure = &pspb.Failure{Cause: storage.ErrorCommand.Error()}
+			recordResp, err = s.txnRecordDeleteInternal(cmd.TxnRecord, docs, batch)
+		}
+		if err == nil {
+			resp.TxnRecord = recordResp
+		} else {
+			log.Error("write txn record error:[%s],\n txn record request is:[%s]", err, cmd.TxnRecord)
+			resp.Failure = &pspb.Failure{ID: metapb.Key(cmd.TxnRecord.Record.TxnID), Cause: err.Error()}
+		}
+
+	case pspb.OpType_TXN_PREPARE:
+		prepareResp, err := s.txnPrepareInternal(cmd.TxnPrepare, docs, batch)
+		resp.TxnPrepare = prepareResp
+		if err != nil {
+			log.Debug("prepare txn intent error:[%s],\n prepare request is:[%s]", err, cmd.TxnPrepare)
+			resp.Failure = &pspb.Failure{ID: writeCommandID(&cmd.TxnPrepare.Intent.Write), Cause: err.Error()}
+		}
+
+	case pspb.OpType_TXN_RESOLVE:
+		if resolveResp, err := s.txnResolveInternal(cmd.TxnResolve, docs, changes, position, batch); err == nil {
+			resp.TxnResolve = resolveResp
+		} else {
+			log.Error("resolve txn intent error:[%s],\n resolve request is:[%s]", err, cmd.TxnResolve)
+			resp.Failure = &pspb.Failure{ID: cmd.TxnResolve.ID, Cause: err.Error()}
 		}
+
+	default:
+		log.Error("unsupported command[%v]", cmd)
+		resp.Failure = &pspb.Failure{Cause: storage.ErrorCommand.Error()}
 	}
+}
 
-	batch.SetApplyID(index)
-	if err := batch.Commit(); err != nil {
-		s.Engine.SetApplyID(index)
-		log.Error("could not commit batch,error is:[%s]", err)
-		return nil, errors.New("could not commit batch")
+// abortWriteCommands turns the responses of an atomic command into failures, the write at failed
+// keeps its own cause.
+func abortWriteCommands(cmds []pspb.RequestUnion, resp []pspb.ResponseUnion, failed int) {
+	for i := range resp {
+		if i == failed {
+			continue
+		}
+		resp[i] = pspb.ResponseUnion{
+			OpType:  cmds[i].OpType,
+			Failure: &pspb.Failure{ID: writeCommandID(&cmds[i]), Cause: storage.ErrorAborted.Error(), Aborted: true},
+		}
 	}
+}
 
-	return resp, nil
+func writeCommandID(cmd *pspb.RequestUnion) metapb.Key {
+	switch {
+	case cmd.Create != nil:
+		return cmd.Create.ID
+	case cmd.Update != nil:
+		return cmd.Update.ID
+	case cmd.Delete != nil:
+		return cmd.Delete.ID
+	case cmd.BlobChunk != nil:
+		return cmd.BlobChunk.ID
+	case cmd.BlobCommit != nil:
+		return cmd.BlobCommit.ID
+	case cmd.BlobAbort != nil:
+		return cmd.BlobAbort.ID
+	case cmd.TxnPrepare != nil:
+		return writeCommandID(&cmd.TxnPrepare.Intent.Write)
+	case cmd.TxnResolve != nil:
+		return cmd.TxnResolve.ID
+	case cmd.TxnRecord != nil:
+		return metapb.Key(cmd.TxnRecord.Record.TxnID)
+	}
+	return nil
+}
+
+// commandDocs reads the documents as the earlier writes of the raft command left them, so the
+// writes of one command see each other.
+type commandDocs struct {
+	store *Store
+	// the fields in json, nil for a document that does not exist
+	images map[string]metapb.Value
+	// whether the documents not read into images exist
+	found map[string]bool
+	// the transaction intents by document id and records by transaction id, nil for deleted ones
+	intents map[string]*pspb.TxnIntent
+	records map[string]*pspb.TxnRecord
+	// whether the blob uploads by upload id are started and not yet committed or aborted
+	uploads map[string]bool
+}
+
+func (s *Store) newCommandDocs() *commandDocs {
+	return &commandDocs{
+		store:   s,
+		images:  make(map[string]metapb.Value),
+		found:   make(map[string]bool),
+		intents: make(map[string]*pspb.TxnIntent),
+		records: make(map[string]*pspb.TxnRecord),
+		uploads: make(map[string]bool),
+	}
+}
+
+func (d *commandDocs) get(id metapb.Key) metapb.Value {
+	if image, ok := d.images[string(id)]; ok {
+		return image
+	}
+	var image metapb.Value
+	if doc, found := d.store.Engine.GetDocument(d.store.Ctx, engine.DOC_ID(id)); found {
+		var err error
+		if image, err = json.Marshal(doc); err != nil {
+			log.Error("partition[%d] marshal document[%s] error: %s", d.store.Meta.ID, id, err)
+		}
+	}
+	d.images[string(id)] = image
+	return image
+}
+
+// exists tells whether the document exists, without reading its fields if they are not read yet.
+func (d *commandDocs) exists(id metapb.Key) bool {
+	if image, ok := d.images[string(id)]; ok {
+		return image != nil
+	}
+	found, ok := d.found[string(id)]
+	if !ok {
+		_, found = d.store.Engine.GetDocument(d.store.Ctx, engine.DOC_ID(id))
+		d.found[string(id)] = found
+	}
+	return found
+}
+
+func (d *commandDocs) set(id metapb.Key, image metapb.Value) {
+	d.images[string(id)] = image
+}
+
+// checkPrecondition checks the document before the write, image is nil if it does not exist.
+func checkPrecondition(cond *pspb.Precondition, image metapb.Value) error {
+	if cond == nil {
+		return nil
+	}
+	if cond.Exists && image == nil {
+		return storage.ErrorPrecondition
+	}
+	if cond.NotExists && image != nil {
+		return storage.ErrorPrecondition
+	}
+	if len(cond.Match) == 0 {
+		return nil
+	}
+	if image == nil {
+		return storage.ErrorPrecondition
+	}
+
+	var match, fields map[string]interface{}
+	if err := json.Unmarshal(cond.Match, &match); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(image, &fields); err != nil {
+		return err
+	}
+	for name, value := range match {
+		if v, ok := fields[name]; !ok || !reflect.DeepEqual(v, value) {
+			return storage.ErrorPrecondition
+		}
+	}
+	return nil
 }
 
 func (s *Store) createInternal(request *pspb.CreateRequest, batch engine.Batch) (*pspb.CreateResponse, error) {
@@ -167,35 +434,36 @@ func (s *Store) createInternal(request *pspb.CreateRequest, batch engine.Batch)
 	return &pspb.CreateResponse{ID: request.ID, Result: pspb.WriteResult_CREATED}, nil
 }
 
-func (s *Store) updateInternal(request *pspb.UpdateRequest, batch engine.Batch) (*pspb.UpdateResponse, error) {
+// updateInternal replaces the document, exists tells whether the earlier writes of the command
+// left it in place.
+func (s *Store) updateInternal(request *pspb.UpdateRequest, exists bool, batch engine.Batch) (*pspb.UpdateResponse, error) {
+	if !exists && !request.Upsert {
+		return &pspb.UpdateResponse{ID: request.ID, Result: pspb.WriteResult_NOT_FOUND}, nil
+	}
 	var data interface{}
 	if err := json.Unmarshal(request.Data, &data); err != nil {
 		return nil, err
 	}
 
-	found, err := batch.UpdateDocument(s.Ctx, engine.DOC_ID(request.ID), data, request.Upsert)
-	if err != nil {
+	if _, err := batch.UpdateDocument(s.Ctx, engine.DOC_ID(request.ID), data, true); err != nil {
 		return nil, err
 	}
 
-	result := pspb.WriteResult_NOT_FOUND
-	if found {
+	result := pspb.WriteResult_CREATED
+	if exists {
 		result = pspb.WriteResult_UPDATED
-	} else if request.Upsert {
-		result = pspb.WriteResult_CREATED
 	}
 	return &pspb.UpdateResponse{ID: request.ID, Result: result}, nil
 }
 
-func (s *Store) deleteInternal(request *pspb.DeleteRequest, batch engine.Batch) (*pspb.DeleteResponse, error) {
-	n, err := batch.DeleteDocument(s.Ctx, engine.DOC_ID(request.ID))
-	if err != nil {
-		return nil, err
+// deleteInternal removes the document, exists tells whether the earlier writes of the command
+// left it in place.
+func (s *Store) deleteInternal(request *pspb.DeleteRequest, exists bool, batch engine.Batch) (*pspb.DeleteResponse, error) {
+	if !exists {
+		return &pspb.DeleteResponse{ID: request.ID, Result: pspb.WriteResult_NOT_FOUND}, nil
 	}
-
-	result := pspb.WriteResult_NOT_FOUND
-	if n > 0 {
-		result = pspb.WriteResult_DELETED
+	if _, err := batch.DeleteDocument(s.Ctx, engine.DOC_ID(request.ID)); err != nil {
+		return nil, err
 	}
-	return &pspb.DeleteResponse{ID: request.ID, Result: result}, nil
+	return &pspb.DeleteResponse{ID: request.ID, Result: pspb.WriteResult_DELETED}, nil
 }
@@ -0,0 +1,272 @@
+package raftstore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/engine/bleve"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/ps/storage"
+)
+
+const testSchema = `{
+  "mappings": {
+    "baud": {
+      "properties": {
+        "name": { "type": "string", "store": true },
+        "age":  { "type": "integer", "store": true }
+      }
+    }
+  }
+}`
+
+func newTestStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "raftstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := bleve.New(engine.EngineConfig{Path: dir, Schema: testSchema})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	s := new(Store)
+	s.Ctx = context.Background()
+	s.Engine = e
+	s.Meta = metapb.Partition{ID: 1}
+	return s, func() {
+		e.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func createCmd(id, data string, cond *pspb.Precondition) pspb.RequestUnion {
+	return pspb.RequestUnion{
+		OpType:       pspb.OpType_CREATE,
+		Create:       &pspb.CreateRequest{ID: metapb.Key(id), Data: metapb.Value(data)},
+		Precondition: cond,
+	}
+}
+
+func updateCmd(id, data string, upsert bool) pspb.RequestUnion {
+	return pspb.RequestUnion{
+		OpType: pspb.OpType_UPDATE,
+		Update: &pspb.UpdateRequest{ID: metapb.Key(id), Data: metapb.Value(data), Upsert: upsert},
+	}
+}
+
+func deleteCmd(id string, cond *pspb.Precondition) pspb.RequestUnion {
+	return pspb.RequestUnion{
+		OpType:       pspb.OpType_DELETE,
+		Delete:       &pspb.DeleteRequest{ID: metapb.Key(id)},
+		Precondition: cond,
+	}
+}
+
+func docExists(s *Store, id string) bool {
+	_, found := s.Engine.GetDocument(s.Ctx, engine.DOC_ID(id))
+	return found
+}
+
+func TestAtomicBulk(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+
+	resp, err := s.execRaftCommand(1, []pspb.RequestUnion{
+		createCmd("a", `{"name": "a", "age": 1}`, &pspb.Precondition{NotExists: true}),
+		createCmd("b", `{"name": "b", "age": 2}`, nil),
+	}, true, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range resp {
+		if resp[i].Failure != nil {
+			t.Fatalf("write %d failed: %s", i, resp[i].Failure.Cause)
+		}
+	}
+	if !docExists(s, "a") || !docExists(s, "b") {
+		t.Fatal("atomic bulk not applied")
+	}
+
+	// the second write fails its precondition, so the first one is rolled back
+	resp, err = s.execRaftCommand(2, []pspb.RequestUnion{
+		deleteCmd("a", &pspb.Precondition{Exists: true}),
+		createCmd("b", `{"name": "b2"}`, &pspb.Precondition{NotExists: true}),
+		createCmd("c", `{"name": "c"}`, nil),
+	}, true, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp[1].Failure == nil || resp[1].Failure.Cause != storage.ErrorPrecondition.Error() {
+		t.Fatalf("unexpected response of the failed write: %v", resp[1])
+	}
+	for _, i := range []int{0, 2} {
+		if resp[i].Failure == nil || resp[i].Failure.Cause != storage.ErrorAborted.Error() {
+			t.Fatalf("write %d is not aborted: %v", i, resp[i])
+		}
+	}
+	if !docExists(s, "a") || docExists(s, "c") {
+		t.Fatal("aborted bulk is partially applied")
+	}
+	if index, err := s.Engine.GetApplyID(); err != nil || index != 2 {
+		t.Fatalf("apply id of the aborted bulk is %d, %v", index, err)
+	}
+
+	// the writes of a command see the earlier ones
+	resp, err = s.execRaftCommand(3, []pspb.RequestUnion{
+		deleteCmd("a", &pspb.Precondition{Exists: true}),
+		createCmd("a", `{"name": "a2"}`, &pspb.Precondition{NotExists: true}),
+	}, true, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range resp {
+		if resp[i].Failure != nil {
+			t.Fatalf("write %d failed: %s", i, resp[i].Failure.Cause)
+		}
+	}
+	if resp[0].Delete.Result != pspb.WriteResult_DELETED || !docExists(s, "a") {
+		t.Fatalf("unexpected responses: %v", resp)
+	}
+}
+
+func TestWritesSeeEarlierWrites(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+
+	resp, err := s.execRaftCommand(1, []pspb.RequestUnion{
+		createCmd("a", `{"name": "a"}`, nil),
+		updateCmd("a", `{"name": "a2"}`, false),
+		createCmd("b", `{"name": "b"}`, nil),
+		deleteCmd("b", nil),
+		updateCmd("b", `{"name": "b2"}`, false),
+	}, true, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range resp {
+		if resp[i].Failure != nil {
+			t.Fatalf("write %d failed: %s", i, resp[i].Failure.Cause)
+		}
+	}
+	if resp[1].Update.Result != pspb.WriteResult_UPDATED || resp[3].Delete.Result != pspb.WriteResult_DELETED ||
+		resp[4].Update.Result != pspb.WriteResult_NOT_FOUND {
+		t.Fatalf("unexpected responses: %v", resp)
+	}
+	if !docExists(s, "a") || docExists(s, "b") {
+		t.Fatal("the writes of the command are not applied in order")
+	}
+
+	// an upsert after a delete creates the document again
+	resp, err = s.execRaftCommand(2, []pspb.RequestUnion{
+		deleteCmd("a", nil),
+		updateCmd("a", `{"name": "a3"}`, true),
+	}, true, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp[0].Failure != nil || resp[1].Failure != nil || resp[1].Update.Result != pspb.WriteResult_CREATED || !docExists(s, "a") {
+		t.Fatalf("unexpected responses: %v", resp)
+	}
+}
+
+func TestCheckPrecondition(t *testing.T) {
+	image := metapb.Value(`{"name": "a", "age": 1}`)
+	tests := []struct {
+		cond  *pspb.Precondition
+		image metapb.Value
+		ok    bool
+	}{
+		{nil, nil, true},
+		{&pspb.Precondition{Exists: true}, image, true},
+		{&pspb.Precondition{Exists: true}, nil, false},
+		{&pspb.Precondition{NotExists: true}, nil, true},
+		{&pspb.Precondition{NotExists: true}, image, false},
+		{&pspb.Precondition{Match: metapb.Value(`{"age": 1}`)}, image, true},
+		{&pspb.Precondition{Match: metapb.Value(`{"name": "a", "age": 1}`)}, image, true},
+		{&pspb.Precondition{Match: metapb.Value(`{"age": 2}`)}, image, false},
+		{&pspb.Precondition{Match: metapb.Value(`{"title": "a"}`)}, image, false},
+		{&pspb.Precondition{Match: metapb.Value(`{"age": 1}`)}, nil, false},
+	}
+	for i, test := range tests {
+		if err := checkPrecondition(test.cond, test.image); (err == nil) != test.ok {
+			t.Errorf("case %d: expect ok %v, got error %v", i, test.ok, err)
+		}
+	}
+}
+
+func TestNonAtomicBulk(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+
+	resp, err := s.execRaftCommand(1, []pspb.RequestUnion{
+		createCmd("a", `{"name": "a"}`, &pspb.Precondition{Exists: true}),
+		createCmd("b", `{"name": "b"}`, nil),
+	}, false, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp[0].Failure == nil || resp[1].Failure != nil {
+		t.Fatalf("unexpected responses: %v", resp)
+	}
+	if docExists(s, "a") || !docExists(s, "b") {
+		t.Fatal("failed write is applied or the others are rolled back")
+	}
+}
+
+func TestWriteReadsDocumentWhenNeeded(t *testing.T) {
+	s, closer := newTestStore(t)
+	defer closer()
+	if _, err := s.execRaftCommand(1, []pspb.RequestUnion{createCmd("a", `{"name": "a"}`, nil)}, false, time.Now().UnixNano()); err != nil {
+		t.Fatal(err)
+	}
+
+	batch := s.Engine.NewWriteBatch()
+	defer batch.Rollback()
+	docs := s.newCommandDocs()
+	var resp pspb.ResponseUnion
+	// no precondition and no change recorded, the existence is enough
+	update := updateCmd("a", `{"name": "a2"}`, false)
+	s.execWriteCommand(batch, docs, nil, 0, &update, &resp)
+	if resp.Failure != nil || resp.Update.Result != pspb.WriteResult_UPDATED {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	// found by the existence only, the image is the one written
+	if !docs.found["a"] || string(docs.images["a"]) != `{"name": "a2"}` {
+		t.Fatalf("the document is read: %v %v", docs.images, docs.found)
+	}
+
+	remove := deleteCmd("b", &pspb.Precondition{NotExists: true})
+	s.execWriteCommand(batch, docs, nil, 1, &remove, &resp)
+	if resp.Failure != nil || resp.Delete.Result != pspb.WriteResult_NOT_FOUND {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	if image, ok := docs.images["b"]; !ok || image != nil {
+		t.Fatalf("the document of the precondition is not read: %v", docs.images)
+	}
+}
+
+func TestStoreReadsDiskState(t *testing.T) {
+	s := CreateStore(context.Background(), &StoreConfig{NodeID: 1, DiskReadOnly: func() bool { return true }})
+	defer s.CtxCancel()
+	if !s.readOnly {
+		t.Fatal("store created on a full disk accepts the writes")
+	}
+
+	// the leader reports the state switched by the server
+	s.Leader = 1
+	s.Meta.Status = metapb.PA_READONLY
+	s.SetReadOnly(false)
+	if s.Meta.Status != metapb.PA_READWRITE {
+		t.Fatalf("unexpected status %s", s.Meta.Status)
+	}
+	s.SetReadOnly(true)
+	if s.Meta.Status != metapb.PA_READONLY {
+		t.Fatalf("unexpected status %s", s.Meta.Status)
+	}
+}
@@ -13,6 +13,15 @@ import (
 var (
 	ErrorTimeout = new(metapb.TimeoutError)
 	ErrorCommand = errors.New("unsupported command")
+
+	ErrorPrecondition = errors.New("precondition failed")
+	ErrorAborted      = errors.New("aborted by another failed write of the atomic bulk")
+
+	ErrorTxnID       = errors.New("transaction id not specified")
+	ErrorTxnExists   = errors.New("transaction record exists")
+	ErrorTxnStatus   = errors.New("invalid transaction status")
+	ErrorTxnConflict = errors.New("document is locked by the intent of another transaction")
+	ErrorTxnData     = errors.New("transaction write with invalid json data")
 )
 
 // StoreBase is the base class of partition store.
@@ -0,0 +1,130 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/mygate/auth"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+// accessControl authenticates the requests by http basic authentication against the users MyGate
+// keeps in the cluster (see mygate/auth) and checks their grants, a space is a table of the db.
+// The users are cached for authCacheTTL, root is not stored, its password is rootPassword.
+type accessControl struct {
+	router *Router
+	root   *auth.User
+	ttl    time.Duration
+
+	lock  sync.Mutex
+	users map[string]*cachedUser
+}
+
+type cachedUser struct {
+	user   *auth.User
+	expire time.Time
+}
+
+func newAccessControl(router *Router, cfg *ModuleConfig) *accessControl {
+	return &accessControl{
+		router: router,
+		root:   auth.Root(cfg.RootPassword),
+		ttl:    time.Duration(cfg.AuthCacheTTL) * time.Millisecond,
+		users:  make(map[string]*cachedUser),
+	}
+}
+
+// user returns the user of the name, nil if there is none or it can not be read.
+func (ac *accessControl) user(ctx context.Context, name string) (user *auth.User) {
+	if name == auth.RootUser {
+		return ac.root
+	}
+	ac.lock.Lock()
+	cached, ok := ac.users[name]
+	ac.lock.Unlock()
+	if ok && time.Now().Before(cached.expire) {
+		return cached.user
+	}
+
+	defer func() {
+		// the system db does not exist before MyGate created a user
+		if p := recover(); p != nil {
+			log.Error("read user[%s] failed: %v", name, p)
+			user = nil
+		}
+	}()
+	resp, err := NewTxnCoordinator(ac.router.GetDB(auth.SystemDB)).Get(ctx, auth.UsersSpace, metapb.Key(name))
+	if err != nil {
+		log.Error("read user[%s] failed: %v", name, err)
+		return nil
+	}
+	if resp.Found {
+		user = new(auth.User)
+		if err := json.Unmarshal(resp.Data, user); err != nil {
+			log.Error("decode user[%s] failed: %v", name, err)
+			return nil
+		}
+	}
+	ac.lock.Lock()
+	ac.users[name] = &cachedUser{user: user, expire: time.Now().Add(ac.ttl)}
+	ac.lock.Unlock()
+	return user
+}
+
+// check authenticates the request and checks the privileges of its user on the space of the db,
+// on all the spaces of the db if the space is "*". It panics with an access denied reply.
+func (ac *accessControl) check(request *http.Request, db, space string, privileges ...string) {
+	name, password, ok := request.BasicAuth()
+	if !ok {
+		panic(&HttpReply{ERRCODE_ACCESS_DENIED, ErrAccessDenied.Error(), nil})
+	}
+	user := ac.user(request.Context(), name)
+	if user == nil || !user.CheckPassword(password) {
+		panic(&HttpReply{ERRCODE_ACCESS_DENIED, fmt.Sprintf("access denied for user '%s'", name), nil})
+	}
+	for _, privilege := range privileges {
+		if !user.Allowed(privilege, db, space) {
+			panic(&HttpReply{ERRCODE_ACCESS_DENIED, fmt.Sprintf("%s denied to user '%s' for space '%s.%s'", privilege, name, db, space), nil})
+		}
+	}
+}
+
+// bulkPrivileges returns the privileges the writes need, as the statements of MyGate doing them.
+func bulkPrivileges(items []bulkWriteItem) []string {
+	var privileges []string
+	add := func(privilege string) {
+		for _, p := range privileges {
+			if p == privilege {
+				return
+			}
+		}
+		privileges = append(privileges, privilege)
+	}
+	for _, item := range items {
+		switch item.Op {
+		case "create":
+			add(auth.Insert)
+		case "update":
+			add(auth.Update)
+			if item.Upsert {
+				add(auth.Insert)
+			}
+		case "delete":
+			add(auth.Delete)
+		}
+	}
+	return privileges
+}
+
+// checkAccess checks the privileges of the user of the request on the space of the db when the
+// router is configured with auth.
+func (router *Router) checkAccess(request *http.Request, db, space string, privileges ...string) {
+	if router.access != nil {
+		router.access.check(request, db, space, privileges...)
+	}
+}
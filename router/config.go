@@ -1,6 +1,7 @@
 package router
 
 import (
+	"errors"
 	"github.com/BurntSushi/toml"
 	"github.com/tiglabs/baudengine/util/log"
 	"time"
@@ -15,10 +16,30 @@ clusterId = "1"
 ip = "0.0.0.0"
 httpPort = 9000
 pprof = 10088
+# the comma separated addresses of the zone masters
 masterAddr = "localhost:18817"
+# the leader of the zone masters is found by their elections in the topo server when set
+topoAddrs = ""
+topoRootDir = "/"
+zoneName = ""
 logDir = "/export/log/ps"
 masterConnPoolSize = 10
 psConnPoolSize = 10
+# partitions read concurrently by one gremlin query
+gremlinMaxFanout = 16
+# ms
+gremlinTimeout = 10000
+gremlinMaxTraversers = 100000
+# space of type txn keeping the transaction records of a db
+txnSpace = "_txn"
+# ms, a pending transaction older than this is aborted by its readers
+txnTTL = 10000
+# check the requests by http basic authentication against the users of MyGate
+auth = false
+# the password of root, the user holding every privilege, needed with auth
+rootPassword = ""
+# ms, how long a user is cached
+authCacheTTL = 10000
 
 [log]
 log-path = "/tmp/baudengine/router/log"
@@ -27,6 +48,10 @@ level="debug"
 `
 
 const rpcTimeoutDef  = 5 * time.Second
+// the wait before the watch of the routes of a space restarts
+const routeWatchRetryDef = time.Second
+// the tries of a call to the zone masters, they back off as util.DefaultRetryOption
+const masterRetryDef = 5
 
 type ModuleConfig struct {
 	Role               string `toml:"role,omitempty" json:"role"`
@@ -35,8 +60,19 @@ type ModuleConfig struct {
 	HttpPort           uint16
 	Pprof              uint16
 	MasterAddr         string
+	TopoAddrs          string
+	TopoRootDir        string
+	ZoneName           string
 	MasterConnPoolSize uint16
 	PsConnPoolSize     uint16
+	GremlinMaxFanout     uint32
+	GremlinTimeout       uint32
+	GremlinMaxTraversers uint32
+	TxnSpace             string
+	TxnTTL               uint32
+	Auth                 bool
+	RootPassword         string
+	AuthCacheTTL         uint32
 }
 
 type LogConfig struct {
@@ -70,5 +106,9 @@ func (config *Config) LoadFromFile(fileName string) error {
 }
 
 func (config *Config) validate() error {
+	// root would log in without a password
+	if config.ModuleCfg.Auth && config.ModuleCfg.RootPassword == "" {
+		return errors.New("auth needs the rootPassword")
+	}
 	return nil
 }
@@ -20,9 +20,101 @@ dbname max 100 char
 spacename max 100 char
 docid 64bit
 
+## Bulk API
+bulk write: POST /_bulk/dbname/spacename
+http body {"atomic": true, "requests": [{"op": "create|update|delete", "id": "docid", "doc": {...},
+"upsert": false, "precondition": {"exists": true, "not_exists": false, "match": {"field": value}}}]}
+the writes are placed by the slot of their docid, as the document api places them, and sent to each
+partition in one raft command. a precondition is
+evaluated in the raft apply against the document as the earlier writes of the bulk left it.
+with atomic set the writes must be in one partition, all of them commit or none does, a failed
+write reports its own cause and the others are reported aborted. without atomic the writes of a
+partition that fails report its error, the ones of the other partitions their results.
+
+## Search API
+search: POST /_search/dbname/spacename
+http body {"query": {"term": {"field": "value"}}, "from": 0, "size": 10, "sort": ["-field"],
+"fields": ["field"], "timeout": "1s"}
+the query is the dsl of the engine, match_all if missing. every partition returns its top from+size
+hits, the router merges them by the sort values and replies {"total": n, "hits": [{"_id", "_score",
+"_source"}]}, in score order if sort is empty. the merge is a k-way merge of the sorted hits of the
+partitions which stops at from+size.
+scroll: "scroll": "1m" has every partition leader keep a snapshot of its index for that long, the
+reply carries "scroll_id", and the next pages are asked with it and their own from and size. the
+pages are cut from the documents as they were at the first page, the writes after it are not seen.
+a page without "scroll" closes the snapshots, the scroll is lost with a leader change or a split.
+aggregation: "aggregation": {"group_by": ["field"], "metrics": [{"func": "count|sum|min|max",
+"field": "field"}], "max_groups": n} folds all the hits of every partition into buckets in the
+partition (see engine.Aggregation), the reply carries the buckets of all the partitions,
+"buckets": [{"key": [values], "values": [metric values]}], a group found in several partitions has a
+bucket of each to be merged by the client.
+
+## Transaction API
+transaction: POST /_txn/dbname
+http body {"writes": [{"space": "spacename", "op": "create", "id": "docid", "doc": {...}}, ...]}
+the writes are bulk writes with their space, they may be in any partitions and spaces of the db.
+the router coordinates two phases (see router/txn): a pending record in the space named by
+txnSpace (of type txn), the writes laid as intents on their documents, then the record committed.
+the reply carries "_txn", the uuid of the transaction.
+read: GET /_txn/dbname/spacename/docid returns the committed document. an intent of a committed
+transaction is resolved by the read, one pending past txnTTL is aborted, so a transaction whose
+router died is finished by its readers. the document api read does the same, and so does a search
+for its hits under an intent: the hit of a finished transaction is read again, or dropped if the
+transaction deleted it.
+
+## Graph API
+gremlin traversal: POST /gremlin/dbname
+http body {"query": "g.V('person/docid').out('knows').values('name')", "timeout": 3000}
+supported steps: V(ids...), has(key[, value]), out/in/both(labels...), outE/inE/bothE(labels...),
+outV, inV, values(keys...), limit, count, path, dedup
+vertices and edges are addressed as spacename/docid, the adjacency of a vertex is kept in its
+"_out" and "_in" fields (see router/gremlin). the documents a step leads to are read by one
+multi-get per partition, has() on the vertices runs there and has() on the edges on the router. the
+traversal between partitions is joined on the router with bounded fan-out.
+
+## Blob API
+for spaces of type blob:
+upload: PUT /blob/dbname/spacename/id, the body is the raw bytes, Content-Type and
+X-Baud-Meta-* headers are kept with the blob
+download: GET /blob/dbname/spacename/id, a single "Range: bytes=start-end" is answered with 206
+delete: DELETE /blob/dbname/spacename/id
+the body is streamed to the ps which cuts it into chunks (blob.chunk.size of ps), each chunk is a
+raft write of its own. blobs are kept out of the search index, a download streams chunk by chunk.
+the chunks of a failed upload are dropped, the ones of an upload cut by a ps crash a day later.
+
+## Changes API
+change feed: GET /_changes/dbname/spacename?since=partition:index,...
+the response is newline delimited JSON, one insert/update/delete event per line with the before and
+after images of the document. "_token" of an event resumes its partition after it, since=now starts
+at the current end, partitions missing from since start at the oldest event retained.
+events are kept by the ps for changes.retention seconds, a token older than that gets an "_error"
+line and its partition stops.
+
+## Analyze API
+analyze: POST /_analyze/dbname/spacename, POST /_analyze for the registered analysis only
+http body {"text": "Wi-Fi Routers", "analyzer": "name"} or {"text": ..., "tokenizer": "name",
+"char_filter": ["name"], "filter": ["name"]} or {"text": ..., "field": "path"}, "explain": true
+the text is analyzed on the router by the analysis of the schema of the space, the one its partitions
+index with (see engine/bleve.Analyzer): the analyzer named, the tokenizer with the filters or the
+analyzer of the field in the mapping, the default analyzer without any. the reply carries "tokens",
+[{"term", "start", "end", "position", "type"}], and with explain "explain", the text after every char
+filter and the tokens after the tokenizer and every token filter. it needs SELECT on the space.
+
+## Access control
+with auth in the config every request is authenticated by http basic authentication against the
+users MyGate keeps in the space "users" of the db "system" (see mygate/auth), root holds every
+privilege and has the password rootPassword, the router does not start with auth and no
+rootPassword. the users are cached for authCacheTTL ms. a space is a table of its db: reads (GET
+doc, search, changes, GET /_txn, GET blob) need SELECT, creates INSERT, updates UPDATE (and INSERT
+with upsert), deletes DELETE, the writes of a bulk or a transaction the privileges of their ops on
+their spaces and gremlin SELECT on all the spaces of the db. a request denied replies code 7, access
+denied.
+
 update:
 1、retrieve single db+space+slots info from master when missing cache
 2、retrieve single db+space+slots info from master when ps returned error code
+3、apply the epoch, leader and slots changes the master pushes by WatchRoutes, the cache of a
+   space is dropped whenever its watch (re)starts
 
 */
 package router
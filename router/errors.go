@@ -7,6 +7,13 @@ var (
 	ErrInternalError 			= errors.New("internal error")
 	ErrSysBusy          		= errors.New("system busy")
 	ErrParamError				= errors.New("param error")
+	ErrNotFound					= errors.New("not found")
+	ErrTxnConflict				= errors.New("transaction conflict")
+	ErrTxnAborted				= errors.New("transaction aborted")
+	ErrAccessDenied				= errors.New("access denied")
+	ErrQuotaExceeded			= errors.New("quota exceeded")
+	ErrWriteThrottled			= errors.New("write rate over the quota")
+	ErrPartitionReadOnly		= errors.New("partition read-only, disk full")
 )
 
 const (
@@ -14,6 +21,13 @@ const (
 	ERRCODE_INTERNAL_ERROR
 	ERRCODE_SYSBUSY
 	ERRCODE_PARAM_ERROR
+	ERRCODE_NOT_FOUND
+	ERRCODE_TXN_CONFLICT
+	ERRCODE_TXN_ABORTED
+	ERRCODE_ACCESS_DENIED
+	ERRCODE_QUOTA_EXCEEDED
+	ERRCODE_WRITE_THROTTLED
+	ERRCODE_READONLY
 )
 
 var Err2CodeMap = map[error]int32 {
@@ -21,4 +35,11 @@ var Err2CodeMap = map[error]int32 {
 	ErrInternalError: ERRCODE_INTERNAL_ERROR,
 	ErrSysBusy:       ERRCODE_SYSBUSY,
 	ErrParamError:    ERRCODE_PARAM_ERROR,
+	ErrNotFound:      ERRCODE_NOT_FOUND,
+	ErrTxnConflict:   ERRCODE_TXN_CONFLICT,
+	ErrTxnAborted:    ERRCODE_TXN_ABORTED,
+	ErrAccessDenied:  ERRCODE_ACCESS_DENIED,
+	ErrQuotaExceeded:  ERRCODE_QUOTA_EXCEEDED,
+	ErrWriteThrottled: ERRCODE_WRITE_THROTTLED,
+	ErrPartitionReadOnly: ERRCODE_READONLY,
 }
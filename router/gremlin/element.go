@@ -0,0 +1,200 @@
+package gremlin
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Graph data model
+//
+// A vertex is a document addressed by its ref "space/docId". Its adjacency is kept in two
+// reserved fields, predicates as keys and arrays of neighbours as values:
+//
+//	"_out": {"knows": ["person/2", {"v": "person/3", "e": "knows/9"}]}
+//	"_in":  {"created_by": ["software/1"]}
+//
+// A neighbour is either the ref of the vertex, or an object whose "v" is the vertex ref and
+// "e" the ref of a document in an edge space holding the properties of the edge.
+const (
+	FieldOut = "_out"
+	FieldIn  = "_in"
+)
+
+type direction int
+
+const (
+	dirOut direction = iota
+	dirIn
+	dirBoth
+)
+
+type elementKind int
+
+const (
+	kindVertex elementKind = iota
+	kindEdge
+)
+
+// element is a vertex or an edge visited by a traversal
+type element struct {
+	kind  elementKind
+	ref   string
+	label string
+	props map[string]interface{}
+
+	// vertex adjacency, label -> neighbours
+	out map[string][]adjacent
+	in  map[string][]adjacent
+
+	// edge end points
+	outV string
+	inV  string
+}
+
+type adjacent struct {
+	vertex string
+	edge   string
+}
+
+func spaceOf(ref string) string {
+	if pos := strings.IndexByte(ref, '/'); pos > 0 {
+		return ref[:pos]
+	}
+	return ""
+}
+
+func newVertex(ref string, body []byte) (*element, error) {
+	doc := make(map[string]interface{})
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("gremlin: bad vertex %s: %v", ref, err)
+	}
+	v := &element{kind: kindVertex, ref: ref, label: spaceOf(ref), props: doc}
+	var err error
+	if v.out, err = parseAdjacency(doc[FieldOut]); err != nil {
+		return nil, fmt.Errorf("gremlin: bad %s of vertex %s: %v", FieldOut, ref, err)
+	}
+	if v.in, err = parseAdjacency(doc[FieldIn]); err != nil {
+		return nil, fmt.Errorf("gremlin: bad %s of vertex %s: %v", FieldIn, ref, err)
+	}
+	delete(doc, FieldOut)
+	delete(doc, FieldIn)
+	return v, nil
+}
+
+func parseAdjacency(field interface{}) (map[string][]adjacent, error) {
+	if field == nil {
+		return nil, nil
+	}
+	labels, ok := field.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expect an object")
+	}
+	adj := make(map[string][]adjacent, len(labels))
+	for label, list := range labels {
+		items, ok := list.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expect an array for %s", label)
+		}
+		for _, item := range items {
+			switch t := item.(type) {
+			case string:
+				adj[label] = append(adj[label], adjacent{vertex: t})
+			case map[string]interface{}:
+				v, _ := t["v"].(string)
+				e, _ := t["e"].(string)
+				if v == "" {
+					return nil, fmt.Errorf("missing v in %s", label)
+				}
+				adj[label] = append(adj[label], adjacent{vertex: v, edge: e})
+			default:
+				return nil, fmt.Errorf("bad neighbour in %s", label)
+			}
+		}
+	}
+	return adj, nil
+}
+
+// setEdgeProps fills the properties of an edge from the document in its edge space,
+// the reserved fields of the document are ignored.
+func (e *element) setEdgeProps(body []byte) error {
+	doc := make(map[string]interface{})
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return fmt.Errorf("gremlin: bad edge %s: %v", e.ref, err)
+	}
+	for k := range doc {
+		if strings.HasPrefix(k, "_") {
+			delete(doc, k)
+		}
+	}
+	e.props = doc
+	return nil
+}
+
+// id identifies the element for dedup, edges without a document are named by their end points
+func (e *element) id() string {
+	if e.kind == kindEdge && e.ref == "" {
+		return e.outV + "-" + e.label + "->" + e.inV
+	}
+	return e.ref
+}
+
+func (e *element) output() map[string]interface{} {
+	m := map[string]interface{}{
+		"id":    e.id(),
+		"label": e.label,
+	}
+	if e.kind == kindVertex {
+		m["type"] = "vertex"
+	} else {
+		m["type"] = "edge"
+		m["outV"] = e.outV
+		m["inV"] = e.inV
+	}
+	if len(e.props) > 0 {
+		m["properties"] = e.props
+	}
+	return m
+}
+
+// edges returns the edges of the vertex in the direction, restricted to labels if any
+func (e *element) edges(dir direction, labels []string) []*element {
+	var result []*element
+	collect := func(adj map[string][]adjacent, out bool) {
+		names := make([]string, 0, len(adj))
+		for label := range adj {
+			if len(labels) == 0 || contains(labels, label) {
+				names = append(names, label)
+			}
+		}
+		sort.Strings(names)
+		for _, label := range names {
+			for _, a := range adj[label] {
+				edge := &element{kind: kindEdge, ref: a.edge, label: label}
+				if out {
+					edge.outV, edge.inV = e.ref, a.vertex
+				} else {
+					edge.outV, edge.inV = a.vertex, e.ref
+				}
+				result = append(result, edge)
+			}
+		}
+	}
+	if dir == dirOut || dir == dirBoth {
+		collect(e.out, true)
+	}
+	if dir == dirIn || dir == dirBoth {
+		collect(e.in, false)
+	}
+	return result
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,426 @@
+package gremlin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrTimeout           = errors.New("gremlin: query timeout")
+	ErrTooManyTraversers = errors.New("gremlin: too many traversers")
+)
+
+// QueryError is a query the parser or the planner rejects.
+type QueryError struct {
+	Err error
+}
+
+func (e *QueryError) Error() string {
+	return e.Err.Error()
+}
+
+// Graph is the storage the traversal runs on, a ref is "space/docId".
+type Graph interface {
+	// Locate returns the partition holding ref, refs of the same partition are read by one MultiGet.
+	Locate(ref string) (string, error)
+	// MultiGet reads the vertices and edges living on one partition, missing documents are left
+	// out. The filters are evaluated by the storage on the vertices only, the ones failing them
+	// are left out too.
+	MultiGet(ctx context.Context, vertexRefs, edgeRefs []string, filters []Filter) (map[string][]byte, error)
+}
+
+type Options struct {
+	// MaxFanout bounds the partitions read concurrently by one query
+	MaxFanout int
+	// Timeout bounds the whole query
+	Timeout time.Duration
+	// MaxTraversers bounds the intermediate results of one query
+	MaxTraversers int
+}
+
+var DefaultOptions = Options{
+	MaxFanout:     16,
+	Timeout:       10 * time.Second,
+	MaxTraversers: 100000,
+}
+
+type traverser struct {
+	// pending is set when the document at ref has to be read by the next fetch
+	pending bool
+	ref     string
+	elem    *element
+	value   interface{}
+	path    []interface{}
+}
+
+func (t *traverser) extend(elem *element, value interface{}) *traverser {
+	nt := &traverser{elem: elem, value: value, path: make([]interface{}, len(t.path), len(t.path)+1)}
+	copy(nt.path, t.path)
+	return nt
+}
+
+// Execute runs the gremlin query and returns its results, each of them is a vertex or an edge
+// as a map, a property value, a path as an array or a count.
+func Execute(ctx context.Context, graph Graph, query string, opts Options) ([]interface{}, error) {
+	steps, err := Parse(query)
+	if err != nil {
+		return nil, &QueryError{Err: err}
+	}
+	plan, err := compile(steps)
+	if err != nil {
+		return nil, &QueryError{Err: err}
+	}
+	if opts.MaxFanout <= 0 {
+		opts.MaxFanout = DefaultOptions.MaxFanout
+	}
+	if opts.MaxTraversers <= 0 {
+		opts.MaxTraversers = DefaultOptions.MaxTraversers
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	e := &executor{graph: graph, opts: opts}
+	var trs []*traverser
+	for _, o := range plan {
+		if ctx.Err() != nil {
+			return nil, ErrTimeout
+		}
+		if trs, err = e.apply(ctx, o, trs); err != nil {
+			return nil, err
+		}
+		if len(trs) > opts.MaxTraversers {
+			return nil, ErrTooManyTraversers
+		}
+	}
+
+	results := make([]interface{}, 0, len(trs))
+	for _, t := range trs {
+		results = append(results, output(t.elem, t.value))
+	}
+	return results, nil
+}
+
+type executor struct {
+	graph Graph
+	opts  Options
+}
+
+func (e *executor) apply(ctx context.Context, o *op, trs []*traverser) ([]*traverser, error) {
+	switch o.kind {
+	case opStart:
+		result := make([]*traverser, 0, len(o.ids))
+		for _, id := range o.ids {
+			result = append(result, &traverser{pending: true, ref: id})
+		}
+		return result, nil
+
+	case opFetch:
+		return e.fetch(ctx, trs, o.filters)
+
+	case opHas:
+		result := trs[:0]
+		for _, t := range trs {
+			if t.elem != nil && matchAll(o.filters, t.elem) {
+				result = append(result, t)
+			}
+		}
+		return result, nil
+
+	case opVertices:
+		var result []*traverser
+		for _, t := range trs {
+			if t.elem == nil || t.elem.kind != kindVertex {
+				continue
+			}
+			for _, edge := range t.elem.edges(o.dir, o.labels) {
+				nt := t.extend(nil, nil)
+				nt.pending = true
+				if edge.outV == t.elem.ref {
+					nt.ref = edge.inV
+				} else {
+					nt.ref = edge.outV
+				}
+				result = append(result, nt)
+			}
+		}
+		return result, nil
+
+	case opEdges:
+		var result []*traverser
+		for _, t := range trs {
+			if t.elem == nil || t.elem.kind != kindVertex {
+				continue
+			}
+			for _, edge := range t.elem.edges(o.dir, o.labels) {
+				nt := t.extend(edge, nil)
+				nt.path = append(nt.path, edge)
+				if edge.ref != "" {
+					nt.pending = true
+					nt.ref = edge.ref
+				}
+				result = append(result, nt)
+			}
+		}
+		return result, nil
+
+	case opEdgeVertex:
+		var result []*traverser
+		for _, t := range trs {
+			if t.elem == nil || t.elem.kind != kindEdge {
+				continue
+			}
+			nt := t.extend(nil, nil)
+			nt.pending = true
+			if o.dir == dirOut {
+				nt.ref = t.elem.outV
+			} else {
+				nt.ref = t.elem.inV
+			}
+			result = append(result, nt)
+		}
+		return result, nil
+
+	case opValues:
+		var result []*traverser
+		for _, t := range trs {
+			if t.elem == nil {
+				continue
+			}
+			keys := o.labels
+			if len(keys) == 0 {
+				for k := range t.elem.props {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+			}
+			for _, k := range keys {
+				if v, ok := t.elem.props[k]; ok {
+					nt := t.extend(nil, v)
+					nt.path = append(nt.path, v)
+					result = append(result, nt)
+				}
+			}
+		}
+		return result, nil
+
+	case opLimit:
+		if len(trs) > o.limit {
+			trs = trs[:o.limit]
+		}
+		return trs, nil
+
+	case opCount:
+		return []*traverser{{value: len(trs)}}, nil
+
+	case opPath:
+		for _, t := range trs {
+			path := make([]interface{}, 0, len(t.path))
+			for _, p := range t.path {
+				if elem, ok := p.(*element); ok {
+					path = append(path, output(elem, nil))
+				} else {
+					path = append(path, p)
+				}
+			}
+			t.elem, t.value = nil, path
+		}
+		return trs, nil
+
+	case opDedup:
+		seen := make(map[string]struct{}, len(trs))
+		result := trs[:0]
+		for _, t := range trs {
+			var key string
+			if t.elem != nil {
+				key = "e:" + t.elem.id()
+			} else {
+				key = fmt.Sprintf("v:%#v", t.value)
+			}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			result = append(result, t)
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("gremlin: unknown op %d", o.kind)
+}
+
+// partitionRead is the partition-local part of a fetch
+type partitionRead struct {
+	seen       map[string]struct{}
+	vertexRefs []string
+	edgeRefs   []string
+
+	vertices map[string]*element
+	edges    map[string][]byte
+	err      error
+}
+
+// fetch reads the pending documents with at most MaxFanout partitions in flight. The filters
+// are handed to the partitions, so vertices failing them never reach the router. Edges are
+// filtered here as their end points come from the adjacency of the vertex they were reached from.
+func (e *executor) fetch(ctx context.Context, trs []*traverser, filters []Filter) ([]*traverser, error) {
+	reads := make(map[string]*partitionRead)
+	for _, t := range trs {
+		if !t.pending {
+			continue
+		}
+		partition, err := e.graph.Locate(t.ref)
+		if err != nil {
+			return nil, err
+		}
+		read, ok := reads[partition]
+		if !ok {
+			read = &partitionRead{seen: make(map[string]struct{})}
+			reads[partition] = read
+		}
+		if _, ok := read.seen[t.ref]; ok {
+			continue
+		}
+		read.seen[t.ref] = struct{}{}
+		if t.elem != nil {
+			read.edgeRefs = append(read.edgeRefs, t.ref)
+		} else {
+			read.vertexRefs = append(read.vertexRefs, t.ref)
+		}
+	}
+
+	if len(reads) > 0 {
+		if err := e.readPartitions(ctx, reads, filters); err != nil {
+			return nil, err
+		}
+	}
+
+	vertices := make(map[string]*element)
+	edges := make(map[string][]byte)
+	for _, read := range reads {
+		for ref, v := range read.vertices {
+			vertices[ref] = v
+		}
+		for ref, body := range read.edges {
+			edges[ref] = body
+		}
+	}
+
+	result := trs[:0]
+	for _, t := range trs {
+		switch {
+		case t.pending && t.elem == nil:
+			v, ok := vertices[t.ref]
+			if !ok {
+				continue
+			}
+			t.pending, t.elem = false, v
+			t.path = append(t.path, v)
+		case t.pending:
+			t.pending = false
+			if body, ok := edges[t.ref]; ok {
+				if err := t.elem.setEdgeProps(body); err != nil {
+					return nil, err
+				}
+			}
+			if !matchAll(filters, t.elem) {
+				continue
+			}
+		default:
+			if t.elem == nil || !matchAll(filters, t.elem) {
+				continue
+			}
+		}
+		result = append(result, t)
+	}
+	return result, nil
+}
+
+func (e *executor) readPartitions(ctx context.Context, reads map[string]*partitionRead, filters []Filter) error {
+	sem := make(chan struct{}, e.opts.MaxFanout)
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for _, read := range reads {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				wg.Wait()
+				return
+			}
+			wg.Add(1)
+			go func(read *partitionRead) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				read.err = e.readPartition(ctx, read, filters)
+			}(read)
+		}
+		wg.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ErrTimeout
+	}
+	if ctx.Err() != nil {
+		return ErrTimeout
+	}
+	for _, read := range reads {
+		if read.err != nil {
+			return read.err
+		}
+	}
+	return nil
+}
+
+func (e *executor) readPartition(ctx context.Context, read *partitionRead, filters []Filter) error {
+	docs, err := e.graph.MultiGet(ctx, read.vertexRefs, read.edgeRefs, filters)
+	if err != nil {
+		return err
+	}
+	read.vertices = make(map[string]*element, len(read.vertexRefs))
+	for _, ref := range read.vertexRefs {
+		body, ok := docs[ref]
+		if !ok {
+			continue
+		}
+		v, err := newVertex(ref, body)
+		if err != nil {
+			return err
+		}
+		read.vertices[ref] = v
+	}
+	read.edges = make(map[string][]byte, len(read.edgeRefs))
+	for _, ref := range read.edgeRefs {
+		if body, ok := docs[ref]; ok {
+			read.edges[ref] = body
+		}
+	}
+	return nil
+}
+
+func matchAll(filters []Filter, e *element) bool {
+	for _, f := range filters {
+		if !f.Match(e.props) {
+			return false
+		}
+	}
+	return true
+}
+
+func output(elem *element, value interface{}) interface{} {
+	if elem != nil {
+		return elem.output()
+	}
+	return value
+}
@@ -0,0 +1,224 @@
+package gremlin
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type memGraph struct {
+	docs  map[string]string
+	reads int32
+	delay time.Duration
+
+	mu      sync.Mutex
+	filters [][]Filter
+}
+
+func (g *memGraph) Locate(ref string) (string, error) {
+	return spaceOf(ref), nil
+}
+
+// MultiGet filters the vertices as a partition does and records the filters it is given.
+func (g *memGraph) MultiGet(ctx context.Context, vertexRefs, edgeRefs []string, filters []Filter) (map[string][]byte, error) {
+	atomic.AddInt32(&g.reads, 1)
+	if g.delay > 0 {
+		time.Sleep(g.delay)
+	}
+	if len(filters) > 0 {
+		g.mu.Lock()
+		g.filters = append(g.filters, filters)
+		g.mu.Unlock()
+	}
+	docs := make(map[string][]byte)
+	for _, ref := range vertexRefs {
+		doc, ok := g.docs[ref]
+		if !ok {
+			continue
+		}
+		props := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(doc), &props); err != nil {
+			return nil, err
+		}
+		match := true
+		for _, f := range filters {
+			match = match && f.Match(props)
+		}
+		if match {
+			docs[ref] = []byte(doc)
+		}
+	}
+	for _, ref := range edgeRefs {
+		if doc, ok := g.docs[ref]; ok {
+			docs[ref] = []byte(doc)
+		}
+	}
+	return docs, nil
+}
+
+func newMemGraph() *memGraph {
+	return &memGraph{docs: map[string]string{
+		"person/1":   `{"name":"marko","age":29,"_out":{"knows":["person/2",{"v":"person/4","e":"knows/1"}],"created":["software/3"]}}`,
+		"person/2":   `{"name":"vadas","age":27,"_in":{"knows":["person/1"]}}`,
+		"person/4":   `{"name":"josh","age":32,"_in":{"knows":[{"v":"person/1","e":"knows/1"}]},"_out":{"created":["software/3"]}}`,
+		"software/3": `{"name":"lop","lang":"java","_in":{"created":["person/1","person/4"]}}`,
+		"knows/1":    `{"weight":1.0}`,
+	}}
+}
+
+func run(t *testing.T, g Graph, query string) []interface{} {
+	result, err := Execute(context.Background(), g, query, DefaultOptions)
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	// round trip through json as the router does
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestParse(t *testing.T) {
+	steps, err := Parse(`g.V('person/1', "person/2").has('age', 29).limit(2)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []Step{
+		{Name: "V", Args: []interface{}{"person/1", "person/2"}},
+		{Name: "has", Args: []interface{}{"age", float64(29)}},
+		{Name: "limit", Args: []interface{}{float64(2)}},
+	}
+	if !reflect.DeepEqual(steps, expect) {
+		t.Fatalf("unexpected steps %v", steps)
+	}
+	for _, query := range []string{"", "x.V(1)", "g.V('a'", "g.V('a').", "g.V('a)", "g.V(foo)"} {
+		if _, err := Parse(query); err == nil {
+			t.Fatalf("expect error for %q", query)
+		}
+	}
+}
+
+func TestCompile(t *testing.T) {
+	for _, query := range []string{"g.out()", "g.V()", "g.V('a').V('b')", "g.V('a').limit(-1)", "g.V('a').foo()", "g.V('a').count(1)"} {
+		steps, err := Parse(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := compile(steps); err == nil {
+			t.Fatalf("expect error for %q", query)
+		}
+	}
+	steps, _ := Parse("g.V('a').has('x').out().has('y', 1)")
+	plan, err := compile(steps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) != 4 || len(plan[1].filters) != 1 || len(plan[3].filters) != 1 {
+		t.Fatal("has() is not pushed down into the fetch")
+	}
+}
+
+func TestTraversal(t *testing.T) {
+	g := newMemGraph()
+	cases := []struct {
+		query  string
+		expect string
+	}{
+		{"g.V('person/1').values('name')", `["marko"]`},
+		{"g.V('person/1').out('knows').values('name')", `["vadas","josh"]`},
+		{"g.V('person/1').out('knows').has('age', 32).values('name')", `["josh"]`},
+		{"g.V('software/3').in('created').values('name')", `["marko","josh"]`},
+		{"g.V('person/4').both().values('name')", `["lop","marko"]`},
+		{"g.V('person/1').out().out().dedup().values('name')", `["lop"]`},
+		{"g.V('person/1').out().count()", `[3]`},
+		{"g.V('person/1').out().limit(1).values('name')", `["lop"]`},
+		{"g.V('person/1').outE('knows').has('weight').inV().values('name')", `["josh"]`},
+		{"g.V('person/4').inE().outV().values('age')", `[29]`},
+		{"g.V('person/1', 'person/404').values('name')", `["marko"]`},
+	}
+	for _, c := range cases {
+		var expect []interface{}
+		if err := json.Unmarshal([]byte(c.expect), &expect); err != nil {
+			t.Fatal(err)
+		}
+		if result := run(t, g, c.query); !reflect.DeepEqual(result, expect) {
+			t.Fatalf("%s: expect %v, got %v", c.query, expect, result)
+		}
+	}
+}
+
+func TestPathAndElements(t *testing.T) {
+	g := newMemGraph()
+	result := run(t, g, "g.V('person/1').outE('knows').inV().has('name', 'josh').path()")
+	if len(result) != 1 {
+		t.Fatalf("unexpected result %v", result)
+	}
+	path := result[0].([]interface{})
+	if len(path) != 3 {
+		t.Fatalf("unexpected path %v", path)
+	}
+	edge := path[1].(map[string]interface{})
+	if edge["type"] != "edge" || edge["id"] != "knows/1" || edge["outV"] != "person/1" || edge["inV"] != "person/4" {
+		t.Fatalf("unexpected edge %v", edge)
+	}
+	if edge["properties"].(map[string]interface{})["weight"] != 1.0 {
+		t.Fatalf("edge properties are not read %v", edge)
+	}
+	vertex := path[2].(map[string]interface{})
+	if vertex["label"] != "person" {
+		t.Fatalf("unexpected vertex %v", vertex)
+	}
+	if _, ok := vertex["properties"].(map[string]interface{})[FieldOut]; ok {
+		t.Fatal("adjacency must not be returned as properties")
+	}
+}
+
+func TestFanoutAndTimeout(t *testing.T) {
+	g := newMemGraph()
+	run(t, g, "g.V('person/1').out()")
+	// person and software are read once per fetch
+	if reads := atomic.LoadInt32(&g.reads); reads != 3 {
+		t.Fatalf("expect 3 partition reads, got %d", reads)
+	}
+
+	slow := newMemGraph()
+	slow.delay = 100 * time.Millisecond
+	opts := DefaultOptions
+	opts.Timeout = 10 * time.Millisecond
+	if _, err := Execute(context.Background(), slow, "g.V('person/1')", opts); err != ErrTimeout {
+		t.Fatalf("expect timeout, got %v", err)
+	}
+
+	opts = DefaultOptions
+	opts.MaxTraversers = 2
+	if _, err := Execute(context.Background(), g, "g.V('person/1').out()", opts); err != ErrTooManyTraversers {
+		t.Fatalf("expect too many traversers, got %v", err)
+	}
+}
+
+func TestFilterPushdown(t *testing.T) {
+	g := newMemGraph()
+	result := run(t, g, "g.V('person/1').out('knows').has('age', 32).has('name').values('name')")
+	if len(result) != 1 || result[0] != "josh" {
+		t.Fatalf("unexpected result %v", result)
+	}
+	expect := [][]Filter{{{Key: "age", Value: 32.0}, {Key: "name", Any: true}}}
+	if !reflect.DeepEqual(g.filters, expect) {
+		t.Fatalf("expect the filters %v handed to the storage, got %v", expect, g.filters)
+	}
+
+	if _, err := Execute(context.Background(), g, "g.V('person/1').has()", DefaultOptions); err == nil {
+		t.Fatal("expect a query error")
+	} else if _, ok := err.(*QueryError); !ok {
+		t.Fatalf("expect a query error, got %T", err)
+	}
+}
@@ -0,0 +1,171 @@
+package gremlin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Step is one call in a traversal, e.g. out('knows') is Step{Name: "out", Args: ["knows"]}.
+// Args are string, float64 or bool.
+type Step struct {
+	Name string
+	Args []interface{}
+}
+
+// Parse parses a gremlin traversal of the form g.V(...).step(...)...
+func Parse(query string) ([]Step, error) {
+	p := &parser{input: query}
+	return p.parse()
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) parse() ([]Step, error) {
+	p.skipSpace()
+	if name := p.ident(); name != "g" {
+		return nil, p.errorf("traversal must start with g")
+	}
+	var steps []Step
+	for {
+		p.skipSpace()
+		if p.eof() {
+			break
+		}
+		if !p.consume('.') {
+			return nil, p.errorf("expect '.'")
+		}
+		p.skipSpace()
+		name := p.ident()
+		if name == "" {
+			return nil, p.errorf("expect step name")
+		}
+		p.skipSpace()
+		if !p.consume('(') {
+			return nil, p.errorf("expect '(' after %s", name)
+		}
+		args, err := p.args()
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, Step{Name: name, Args: args})
+	}
+	if len(steps) == 0 {
+		return nil, p.errorf("empty traversal")
+	}
+	return steps, nil
+}
+
+func (p *parser) args() ([]interface{}, error) {
+	var args []interface{}
+	p.skipSpace()
+	if p.consume(')') {
+		return args, nil
+	}
+	for {
+		p.skipSpace()
+		arg, err := p.literal()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		p.skipSpace()
+		if p.consume(')') {
+			return args, nil
+		}
+		if !p.consume(',') {
+			return nil, p.errorf("expect ',' or ')'")
+		}
+	}
+}
+
+func (p *parser) literal() (interface{}, error) {
+	if p.eof() {
+		return nil, p.errorf("unexpected end of query")
+	}
+	c := p.input[p.pos]
+	switch {
+	case c == '\'' || c == '"':
+		return p.str(c)
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for !p.eof() && strings.IndexByte("0123456789.eE+-", p.input[p.pos]) >= 0 {
+			p.pos++
+		}
+		f, err := strconv.ParseFloat(p.input[start:p.pos], 64)
+		if err != nil {
+			return nil, p.errorf("bad number %q", p.input[start:p.pos])
+		}
+		return f, nil
+	default:
+		switch word := p.ident(); word {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		default:
+			return nil, p.errorf("unsupported argument %q", word)
+		}
+	}
+}
+
+func (p *parser) str(quote byte) (string, error) {
+	p.pos++
+	var sb strings.Builder
+	for !p.eof() {
+		c := p.input[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return sb.String(), nil
+		case '\\':
+			if p.eof() {
+				return "", p.errorf("unterminated string")
+			}
+			sb.WriteByte(p.input[p.pos])
+			p.pos++
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *parser) ident() string {
+	start := p.pos
+	for !p.eof() {
+		r := rune(p.input[p.pos])
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_') {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *parser) consume(c byte) bool {
+	if !p.eof() && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("gremlin: %s at offset %d", fmt.Sprintf(format, args...), p.pos)
+}
@@ -0,0 +1,174 @@
+package gremlin
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type opKind int
+
+const (
+	// partition-local: read the pending documents, grouped by partition, and filter them there
+	opFetch opKind = iota
+	// router-side
+	opStart
+	opHas
+	opVertices
+	opEdges
+	opEdgeVertex
+	opValues
+	opLimit
+	opCount
+	opPath
+	opDedup
+)
+
+type op struct {
+	kind    opKind
+	dir     direction
+	ids     []string
+	labels  []string
+	filters []Filter
+	limit   int
+}
+
+// Filter is a has() step, the property Key holds Value, or any value when Any is set. Value is
+// a string, a float64 or a bool as the parser reads it.
+type Filter struct {
+	Key   string
+	Value interface{}
+	Any   bool
+}
+
+// Match reports whether the properties of an element, decoded from json, pass the filter.
+func (f Filter) Match(props map[string]interface{}) bool {
+	v, ok := props[f.Key]
+	if !ok {
+		return false
+	}
+	if f.Any {
+		return true
+	}
+	return reflect.DeepEqual(v, f.Value)
+}
+
+// compile turns the steps into a plan, the documents a step leads to are read by an opFetch
+// right after it and the has() following it are pushed down into that fetch.
+func compile(steps []Step) ([]*op, error) {
+	if steps[0].Name != "V" {
+		return nil, fmt.Errorf("gremlin: traversal must start with V()")
+	}
+	var plan []*op
+	for i, step := range steps {
+		if step.Name == "V" && i > 0 {
+			return nil, fmt.Errorf("gremlin: V() is only supported as the start step")
+		}
+		switch step.Name {
+		case "V":
+			ids, err := stringArgs(step)
+			if err != nil {
+				return nil, err
+			}
+			if len(ids) == 0 {
+				return nil, fmt.Errorf("gremlin: V() requires vertex ids, full scans are not supported")
+			}
+			plan = append(plan, &op{kind: opStart, ids: ids}, &op{kind: opFetch})
+		case "has":
+			filter, err := hasArgs(step)
+			if err != nil {
+				return nil, err
+			}
+			if last := plan[len(plan)-1]; last.kind == opFetch {
+				last.filters = append(last.filters, filter)
+			} else {
+				plan = append(plan, &op{kind: opHas, filters: []Filter{filter}})
+			}
+		case "out", "in", "both":
+			labels, err := stringArgs(step)
+			if err != nil {
+				return nil, err
+			}
+			plan = append(plan, &op{kind: opVertices, dir: stepDirection(step.Name), labels: labels}, &op{kind: opFetch})
+		case "outE", "inE", "bothE":
+			labels, err := stringArgs(step)
+			if err != nil {
+				return nil, err
+			}
+			plan = append(plan, &op{kind: opEdges, dir: stepDirection(step.Name), labels: labels}, &op{kind: opFetch})
+		case "outV", "inV":
+			if len(step.Args) != 0 {
+				return nil, fmt.Errorf("gremlin: %s() takes no argument", step.Name)
+			}
+			plan = append(plan, &op{kind: opEdgeVertex, dir: stepDirection(step.Name)}, &op{kind: opFetch})
+		case "values":
+			keys, err := stringArgs(step)
+			if err != nil {
+				return nil, err
+			}
+			plan = append(plan, &op{kind: opValues, labels: keys})
+		case "limit":
+			n, ok := intArg(step)
+			if !ok {
+				return nil, fmt.Errorf("gremlin: limit() requires a non-negative integer")
+			}
+			plan = append(plan, &op{kind: opLimit, limit: n})
+		case "count", "path", "dedup":
+			if len(step.Args) != 0 {
+				return nil, fmt.Errorf("gremlin: %s() takes no argument", step.Name)
+			}
+			kind := map[string]opKind{"count": opCount, "path": opPath, "dedup": opDedup}[step.Name]
+			plan = append(plan, &op{kind: kind})
+		default:
+			return nil, fmt.Errorf("gremlin: unsupported step %s()", step.Name)
+		}
+	}
+	return plan, nil
+}
+
+func stepDirection(name string) direction {
+	switch name {
+	case "out", "outE", "outV":
+		return dirOut
+	case "in", "inE", "inV":
+		return dirIn
+	default:
+		return dirBoth
+	}
+}
+
+func stringArgs(step Step) ([]string, error) {
+	strs := make([]string, 0, len(step.Args))
+	for _, arg := range step.Args {
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("gremlin: %s() only accepts strings", step.Name)
+		}
+		strs = append(strs, s)
+	}
+	return strs, nil
+}
+
+func hasArgs(step Step) (Filter, error) {
+	if len(step.Args) == 0 || len(step.Args) > 2 {
+		return Filter{}, fmt.Errorf("gremlin: has() requires a key and an optional value")
+	}
+	key, ok := step.Args[0].(string)
+	if !ok {
+		return Filter{}, fmt.Errorf("gremlin: has() key must be a string")
+	}
+	if len(step.Args) == 1 {
+		return Filter{Key: key, Any: true}, nil
+	}
+	return Filter{Key: key, Value: step.Args[1]}, nil
+}
+
+func intArg(step Step) (int, bool) {
+	if len(step.Args) != 1 {
+		return 0, false
+	}
+	f, ok := step.Args[0].(float64)
+	if !ok || f < 0 || f != float64(int(f)) {
+		return 0, false
+	}
+	return int(f), true
+}
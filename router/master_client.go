@@ -5,6 +5,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util"
+	"github.com/tiglabs/baudengine/util/discovery"
 	"github.com/tiglabs/baudengine/util/rpc"
 	"google.golang.org/grpc"
 	"github.com/tiglabs/baudengine/util/log"
@@ -13,13 +16,30 @@ import (
 
 type MasterClient struct {
 	client     *rpc.Client
-	masterAddr string
+	masters    *discovery.Masters
+	topoServer *topo.TopoServer
 	context    context.Context
 	cancelFunc context.CancelFunc
 }
 
-func NewMasterClient(masterAddr string) *MasterClient {
-	mc := &MasterClient{masterAddr: masterAddr}
+// NewMasterClient calls the zone masters at the comma separated masterAddrs, the leader is found
+// by the elections of the zone masters in the topo server when topoAddrs is set.
+func NewMasterClient(masterAddrs, topoAddrs, topoRootDir, zoneName string) *MasterClient {
+	mc := &MasterClient{}
+	var elections discovery.Elections
+	if topoAddrs != "" {
+		topoServer, err := topo.OpenServer("etcd3", topoAddrs, topoRootDir)
+		if err != nil {
+			log.Panic("open topo server %s failed, err %v", topoAddrs, err)
+		}
+		// the participation only reads the elected master, it never runs for it
+		participation, err := topoServer.NewMasterParticipation(zoneName, "")
+		if err != nil {
+			log.Panic("new master participation of zone %s failed, err %v", zoneName, err)
+		}
+		mc.topoServer, elections = topoServer, participation
+	}
+	mc.masters = discovery.NewMasters(discovery.ParseAddrs(masterAddrs), elections)
 	connMgrOpt := rpc.DefaultManagerOption
 	mc.context, mc.cancelFunc = context.WithCancel(context.Background())
 	connMgr := rpc.NewConnectionMgr(mc.context, &connMgrOpt)
@@ -33,12 +53,23 @@ func NewMasterClient(masterAddr string) *MasterClient {
 	return mc
 }
 
+func (mc *MasterClient) Close() {
+	mc.cancelFunc()
+	mc.client.Close()
+	if mc.topoServer != nil {
+		mc.topoServer.Close()
+	}
+}
+
 func (mc *MasterClient) GetRoute(dbId metapb.DBID, spaceId metapb.SpaceID, slotId metapb.SlotID) []masterpb.Route {
 	request := &masterpb.GetRouteRequest{DB: dbId, Space: spaceId, Slot: slotId}
-	ctx, cancel := mc.getContext()
-	defer cancel()
-	resp, err := mc.getClient().GetRoute(ctx, request)
-	mc.checkResponseOk(&resp.ResponseHeader, err)
+	var resp *masterpb.GetRouteResponse
+	mc.call(func(ctx context.Context, client masterpb.MasterRpcClient) (header *metapb.ResponseHeader, err error) {
+		if resp, err = client.GetRoute(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
 	text, err := json.Marshal(resp)
 	if err == nil {
 		log.Debug("GetRoute(slotId=%d) %s", slotId, string(text))
@@ -46,21 +77,45 @@ func (mc *MasterClient) GetRoute(dbId metapb.DBID, spaceId metapb.SpaceID, slotI
 	return resp.Routes
 }
 
+// WatchRoutes opens the stream of the route changes of the space, it lives as long as ctx.
+func (mc *MasterClient) WatchRoutes(ctx context.Context, dbId metapb.DBID, spaceId metapb.SpaceID) (masterpb.MasterRpc_WatchRoutesClient, error) {
+	request := &masterpb.WatchRoutesRequest{DB: dbId, Space: spaceId}
+	masterAddr := mc.masters.Leader()
+	if masterAddr == "" {
+		return nil, discovery.ErrNoMaster
+	}
+	client, err := mc.client.GetGrpcClient(masterAddr)
+	if err == nil {
+		var stream masterpb.MasterRpc_WatchRoutesClient
+		if stream, err = client.(masterpb.MasterRpcClient).WatchRoutes(ctx, request); err == nil {
+			return stream, nil
+		}
+	}
+	mc.masters.Fail(masterAddr)
+	return nil, err
+}
+
 func (mc *MasterClient) GetDB(dbName string) metapb.DB {
 	request := &masterpb.GetDBRequest{DBName: dbName}
-	ctx, cancel := mc.getContext()
-	defer cancel()
-	resp, err := mc.getClient().GetDB(ctx, request)
-	mc.checkResponseOk(&resp.ResponseHeader, err)
+	var resp *masterpb.GetDBResponse
+	mc.call(func(ctx context.Context, client masterpb.MasterRpcClient) (header *metapb.ResponseHeader, err error) {
+		if resp, err = client.GetDB(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
 	return resp.Db
 }
 
 func (mc *MasterClient) GetSpace(id metapb.DBID, spaceName string) metapb.Space {
 	request := &masterpb.GetSpaceRequest{ID: id, SpaceName: spaceName}
-	ctx, cancel := mc.getContext()
-	defer cancel()
-	resp, err := mc.getClient().GetSpace(ctx, request)
-	mc.checkResponseOk(&resp.ResponseHeader, err)
+	var resp *masterpb.GetSpaceResponse
+	mc.call(func(ctx context.Context, client masterpb.MasterRpcClient) (header *metapb.ResponseHeader, err error) {
+		if resp, err = client.GetSpace(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
 	return resp.Space
 }
 
@@ -68,23 +123,66 @@ func (mc *MasterClient) getContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(mc.context, rpcTimeoutDef)
 }
 
-func (mc *MasterClient) getClient() masterpb.MasterRpcClient {
-	client, err := mc.client.GetGrpcClient(mc.masterAddr)
+// call calls fn at the leader of the zone masters, following the masters redirecting to the leader
+// and trying the others with backoff when the leader is not known or down. It panics by the error
+// of the last try, or of the response of the leader.
+func (mc *MasterClient) call(fn func(ctx context.Context, client masterpb.MasterRpcClient) (*metapb.ResponseHeader, error)) {
+	retryOpt := util.DefaultRetryOption
+	retryOpt.MaxRetries = masterRetryDef
+	retryOpt.Context = mc.context
+
+	var respErr error
+	err := mc.masters.Call(&retryOpt, func(masterAddr string) error {
+		client, err := mc.client.GetGrpcClient(masterAddr)
+		if err != nil {
+			log.Warn("get master client for %s failed", masterAddr)
+			return err
+		}
+		ctx, cancel := mc.getContext()
+		defer cancel()
+		header, err := fn(ctx, client.(masterpb.MasterRpcClient))
+		if err != nil {
+			return err
+		}
+		if err := mc.checkLeader(header); err != nil {
+			return err
+		}
+		if header.Code != metapb.RESP_CODE_OK {
+			respErr = errors.New(header.Message)
+		}
+		return nil
+	})
+	if err == nil {
+		err = respErr
+	}
 	if err != nil {
-		log.Error("get master client for %s failed", mc.masterAddr)
 		panic(err)
 	}
-	return client.(masterpb.MasterRpcClient)
+}
+
+// checkLeader returns the error of a response of a master which is not the leader, following the
+// leader it named.
+func (mc *MasterClient) checkLeader(header *metapb.ResponseHeader) error {
+	switch header.Code {
+	case metapb.MASTER_RESP_CODE_NOT_LEADER:
+		if header.Error.NotLeader != nil && header.Error.NotLeader.LeaderAddr != "" {
+			mc.masters.Redirect(header.Error.NotLeader.LeaderAddr)
+		}
+		return errors.New(header.Message)
+	case metapb.MASTER_RESP_CODE_NO_LEADER:
+		return errors.New(header.Message)
+	}
+	return nil
 }
 
 func (mc *MasterClient) checkResponseOk(header *metapb.ResponseHeader, err error) {
 	if err != nil {
 		panic(err)
 	}
+	if err := mc.checkLeader(header); err != nil {
+		panic(err)
+	}
 	if header.Code != metapb.RESP_CODE_OK {
-		if header.Code == metapb.MASTER_RESP_CODE_NOT_LEADER {
-			mc.masterAddr = header.Error.NotLeader.LeaderAddr
-		}
 		panic(errors.New(header.Message))
 	}
 }
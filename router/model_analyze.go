@@ -0,0 +1,45 @@
+package router
+
+import (
+	"sync"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/engine/bleve"
+)
+
+var (
+	// registeredAnalyzer analyzes the requests without a space, it knows the registered analysis only
+	registeredAnalyzer     *bleve.Analyzer
+	registeredAnalyzerOnce sync.Once
+)
+
+// analyze runs the request on the router, by the analysis the partitions of the space index with,
+// the registered one without a space.
+func analyze(space *Space, req *engine.AnalyzeRequest) (*engine.AnalyzeResult, error) {
+	var analyzer *bleve.Analyzer
+	var err error
+	if space == nil {
+		registeredAnalyzerOnce.Do(func() {
+			registeredAnalyzer, _ = bleve.NewAnalyzer(nil)
+		})
+		analyzer = registeredAnalyzer
+	} else if analyzer, err = space.Analyzer(); err != nil {
+		return nil, err
+	}
+	return analyzer.Analyze(req)
+}
+
+// Analyzer returns the analyzer of the schema of the space, built on the first use.
+func (space *Space) Analyzer() (*bleve.Analyzer, error) {
+	space.lock.Lock()
+	defer space.lock.Unlock()
+
+	if space.analyzer == nil {
+		analyzer, err := bleve.NewAnalyzer([]byte(space.meta.Schema))
+		if err != nil {
+			return nil, err
+		}
+		space.analyzer = analyzer
+	}
+	return space.analyzer, nil
+}
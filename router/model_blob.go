@@ -0,0 +1,129 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/spaolacci/murmur3"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+// blobPieceSize is the size of the body pieces streamed to the PS, the PS joins them into chunks
+const blobPieceSize = 64 * 1024
+
+// keySlot places a blob by its id, it has no document id to carry the slot
+func keySlot(id string) metapb.SlotID {
+	h32 := murmur3.New32()
+	h32.Write([]byte(id))
+	return metapb.SlotID(h32.Sum32())
+}
+
+// PutBlob streams the body to the leader, the body is never held in memory as a whole.
+func (partition *Partition) PutBlob(id string, blobMeta *pspb.BlobMeta, body io.Reader) *pspb.PutBlobResponse {
+	ctx, cancel := context.WithCancel(partition.parent.parent.context)
+	defer cancel()
+
+	stream, err := partition.getClient().PutBlob(ctx)
+	if err != nil {
+		panic(err)
+	}
+	request := &pspb.PutBlobRequest{
+		PartitionID: partition.meta.ID,
+		ID:          metapb.Key(id),
+		Meta:        blobMeta,
+	}
+	buf := make([]byte, blobPieceSize)
+	for {
+		n, err := io.ReadFull(body, buf)
+		if n > 0 || request.Meta != nil {
+			request.Data = buf[:n]
+			if err := stream.Send(request); err != nil {
+				break // the error is returned by CloseAndRecv
+			}
+			request = &pspb.PutBlobRequest{}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			panic(err)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Error("put blob[%s] failed: %s", id, err.Error())
+		panic(err)
+	}
+	partition.checkResponse(&resp.ResponseHeader)
+	return resp
+}
+
+// GetBlob opens a read of [offset, offset+length) of the blob, nil is returned if the blob not exists.
+// The data follows the meta on the stream, the caller has to call cancel when done.
+func (partition *Partition) GetBlob(id string, offset, length uint64) (*pspb.BlobMeta, pspb.ApiGrpc_GetBlobClient, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(partition.parent.parent.context)
+	request := &pspb.GetBlobRequest{
+		PartitionID: partition.meta.ID,
+		ID:          metapb.Key(id),
+		Offset:      offset,
+		Length:      length,
+	}
+	stream, err := partition.getClient().GetBlob(ctx, request)
+	if err != nil {
+		cancel()
+		panic(err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		cancel()
+		log.Error("get blob[%s] failed: %s", id, err.Error())
+		panic(err)
+	}
+	if resp.Code == metapb.PS_RESP_CODE_KEY_NOT_EXISTS {
+		cancel()
+		return nil, nil, nil
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		cancel()
+	}
+	partition.checkResponse(&resp.ResponseHeader)
+	return resp.Meta, stream, cancel
+}
+
+func (partition *Partition) DeleteBlob(id string) bool {
+	request := &pspb.DeleteBlobRequest{
+		PartitionID: partition.meta.ID,
+		ID:          metapb.Key(id),
+	}
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp, err := partition.getClient().DeleteBlob(ctx, request)
+	if err != nil {
+		log.Error("delete blob[%s] failed: %s", id, err.Error())
+		panic(err)
+	}
+	partition.checkResponse(&resp.ResponseHeader)
+	return resp.Result == pspb.WriteResult_DELETED
+}
+
+func (partition *Partition) checkResponse(header *metapb.ResponseHeader) {
+	if header.Code == metapb.RESP_CODE_OK {
+		return
+	}
+	if header.Code == metapb.PS_RESP_CODE_NO_LEADER || header.Code == metapb.PS_RESP_CODE_NO_PARTITION {
+		partition.parent.refreshRoute(partition)
+	} else if header.Code == metapb.PS_RESP_CODE_NOT_LEADER {
+		partition.onNotLeader(header.Error.NotLeader)
+	} else if header.Code == metapb.PS_RESP_CODE_QUOTA_EXCEEDED {
+		panic(&HttpReply{ERRCODE_QUOTA_EXCEEDED, header.Message, nil})
+	} else if header.Code == metapb.PS_RESP_CODE_READONLY {
+		panic(&HttpReply{ERRCODE_READONLY, header.Message, nil})
+	} else if header.Code == metapb.PS_RESP_CODE_DRAINING {
+		panic(&HttpReply{ERRCODE_SYSBUSY, header.Message, nil})
+	}
+	log.Error("response of partition[%d] failed(%d): %s", partition.meta.ID, header.Code, header.Message)
+	panic(errors.New(header.Message))
+}
@@ -0,0 +1,170 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/tiglabs/baudengine/common/keys"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+var errAtomicCrossPartition = errors.New("the writes of an atomic bulk must be in one partition")
+
+// docSlot places a document by the slot its DocID carries, as the document api does, so the
+// documents of a bulk or a transaction are found there.
+func docSlot(id string) (metapb.SlotID, error) {
+	docId, err := keys.DecodeDocIDFromString(id)
+	if err != nil {
+		return 0, err
+	}
+	return docId.SlotID, nil
+}
+
+type bulkRequest struct {
+	// all the writes commit or none does
+	Atomic   bool            `json:"atomic,omitempty"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Requests []bulkWriteItem `json:"requests"`
+}
+
+type bulkWriteItem struct {
+	Op           string            `json:"op"` // create, update or delete
+	ID           string            `json:"id"`
+	Doc          json.RawMessage   `json:"doc,omitempty"`
+	Upsert       bool              `json:"upsert,omitempty"`
+	Precondition *bulkPrecondition `json:"precondition,omitempty"`
+}
+
+type bulkPrecondition struct {
+	Exists    bool            `json:"exists,omitempty"`
+	NotExists bool            `json:"not_exists,omitempty"`
+	Match     json.RawMessage `json:"match,omitempty"`
+}
+
+type bulkItemResult struct {
+	ID     string `json:"_id"`
+	Result string `json:"_result,omitempty"`
+	Error  string `json:"_error,omitempty"`
+}
+
+func (item *bulkWriteItem) toRequest() (pspb.RequestUnion, error) {
+	var request pspb.RequestUnion
+	if item.ID == "" {
+		return request, errors.New("bulk write without id")
+	}
+	id := metapb.Key(item.ID)
+	switch item.Op {
+	case "create":
+		request.OpType = pspb.OpType_CREATE
+		request.Create = &pspb.CreateRequest{ID: id, Data: metapb.Value(item.Doc)}
+	case "update":
+		request.OpType = pspb.OpType_UPDATE
+		request.Update = &pspb.UpdateRequest{ID: id, Data: metapb.Value(item.Doc), Upsert: item.Upsert}
+	case "delete":
+		request.OpType = pspb.OpType_DELETE
+		request.Delete = &pspb.DeleteRequest{ID: id}
+	default:
+		return request, errors.New("unknown bulk op " + item.Op)
+	}
+	if cond := item.Precondition; cond != nil {
+		request.Precondition = &pspb.Precondition{Exists: cond.Exists, NotExists: cond.NotExists, Match: metapb.Value(cond.Match)}
+	}
+	return request, nil
+}
+
+// Bulk writes the requests of the partition in one raft command, the responses are in request order.
+func (partition *Partition) Bulk(ctx context.Context, requests []pspb.RequestUnion, atomic bool, timeout string) []pspb.ResponseUnion {
+	request := &pspb.BulkRequest{
+		PartitionID: partition.meta.ID,
+		Requests:    requests,
+		Atomic:      atomic,
+	}
+	request.Timeout = timeout
+	resp, err := partition.getClient().BulkWrite(ctx, request)
+	if err != nil {
+		log.Error("bulk write to partition[%d] failed: %s", partition.meta.ID, err.Error())
+		panic(err)
+	}
+	partition.checkResponse(&resp.ResponseHeader)
+	if len(resp.Responses) != len(requests) {
+		panic(errors.New("bad response count of bulk write"))
+	}
+	return resp.Responses
+}
+
+// Bulk splits the writes by partition. An atomic bulk has to stay in one partition, it commits
+// all or nothing there. The writes of a partition failing in a bulk not atomic fail with its error,
+// the other partitions keep their results.
+func (space *Space) Bulk(bulk *bulkRequest) []bulkItemResult {
+	type partitionBulk struct {
+		partition *Partition
+		requests  []pspb.RequestUnion
+		positions []int
+	}
+	var bulks []*partitionBulk
+	byPartition := make(map[metapb.PartitionID]*partitionBulk)
+	for i := range bulk.Requests {
+		request, err := bulk.Requests[i].toRequest()
+		if err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+		slot, err := docSlot(bulk.Requests[i].ID)
+		if err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+		partition := space.GetPartition(slot)
+		pb, ok := byPartition[partition.meta.ID]
+		if !ok {
+			if bulk.Atomic && len(bulks) > 0 {
+				panic(&HttpReply{ERRCODE_PARAM_ERROR, errAtomicCrossPartition.Error(), nil})
+			}
+			pb = &partitionBulk{partition: partition}
+			byPartition[partition.meta.ID] = pb
+			bulks = append(bulks, pb)
+		}
+		pb.requests = append(pb.requests, request)
+		pb.positions = append(pb.positions, i)
+	}
+
+	write := func(pb *partitionBulk) (responses []pspb.ResponseUnion, err error) {
+		if !bulk.Atomic {
+			defer func() {
+				if p := recover(); p != nil {
+					err = panicToError(p)
+				}
+			}()
+		}
+		ctx, cancel := pb.partition.getContext()
+		defer cancel()
+		return pb.partition.Bulk(ctx, pb.requests, bulk.Atomic, bulk.Timeout), nil
+	}
+
+	results := make([]bulkItemResult, len(bulk.Requests))
+	for _, pb := range bulks {
+		responses, err := write(pb)
+		if err != nil {
+			for _, position := range pb.positions {
+				results[position] = bulkItemResult{ID: bulk.Requests[position].ID, Error: err.Error()}
+			}
+			continue
+		}
+		for j, resp := range responses {
+			result := &results[pb.positions[j]]
+			result.ID = bulk.Requests[pb.positions[j]].ID
+			switch {
+			case resp.Failure != nil:
+				result.Error = resp.Failure.Cause
+			case resp.Create != nil:
+				result.Result = resp.Create.Result.String()
+			case resp.Update != nil:
+				result.Result = resp.Update.Result.String()
+			case resp.Delete != nil:
+				result.Result = resp.Delete.Result.String()
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,168 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+// a broken watch is reopened at its last token after this delay
+const changesRetryDelay = time.Second
+
+var errTokenExpired = errors.New("the changes after the token are expired")
+
+// ChangeEvent is one line of the _changes stream, Token resumes the partition of the event.
+type ChangeEvent struct {
+	Token     string             `json:"_token"`
+	Partition metapb.PartitionID `json:"partition"`
+	Index     uint64             `json:"index"`
+	Type      string             `json:"type"`
+	ID        string             `json:"id"`
+	Before    json.RawMessage    `json:"before,omitempty"`
+	After     json.RawMessage    `json:"after,omitempty"`
+	Timestamp int64              `json:"timestamp"`
+	Error     string             `json:"_error,omitempty"`
+}
+
+var changeTypeNames = map[pspb.ChangeType]string{
+	pspb.ChangeType_CHANGE_INSERT: "insert",
+	pspb.ChangeType_CHANGE_UPDATE: "update",
+	pspb.ChangeType_CHANGE_DELETE: "delete",
+}
+
+func changeToken(partitionID metapb.PartitionID, index uint64) string {
+	return fmt.Sprintf("%d:%d", partitionID, index)
+}
+
+// parseChangeTokens parses "partition:index,..." into the index to resume each partition at.
+func parseChangeTokens(since string) (map[metapb.PartitionID]uint64, error) {
+	tokens := make(map[metapb.PartitionID]uint64)
+	if since == "" {
+		return tokens, nil
+	}
+	for _, token := range strings.Split(since, ",") {
+		pos := strings.IndexByte(token, ':')
+		if pos <= 0 {
+			return nil, fmt.Errorf("bad change token %q", token)
+		}
+		partitionID, err := strconv.ParseUint(token[:pos], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("bad change token %q", token)
+		}
+		index, err := strconv.ParseUint(token[pos+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad change token %q", token)
+		}
+		tokens[metapb.PartitionID(partitionID)] = index
+	}
+	return tokens, nil
+}
+
+// GetPartitions returns all the partitions of the space in slot order.
+func (space *Space) GetPartitions() []*Partition {
+	var partitions []*Partition
+	for slot := uint64(0); slot <= math.MaxUint32; {
+		partition := space.GetPartition(metapb.SlotID(slot))
+		partitions = append(partitions, partition)
+		slot = uint64(partition.meta.EndSlot) + 1
+	}
+	return partitions
+}
+
+// WatchChanges sends the events of the partition after fromIndex to events until ctx is done. A
+// broken stream is reopened at the last index sent, so the consumer sees every event once.
+func (partition *Partition) WatchChanges(ctx context.Context, fromIndex uint64, fromNow bool, events chan<- *ChangeEvent) {
+	meta := partition.meta
+	space := partition.parent
+	for {
+		lastIndex, err := partition.watchChanges(ctx, fromIndex, fromNow, events)
+		if lastIndex > 0 {
+			fromIndex, fromNow = lastIndex, false
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err == errTokenExpired {
+			select {
+			case events <- &ChangeEvent{Token: changeToken(meta.ID, fromIndex), Partition: meta.ID, Error: err.Error()}:
+			case <-ctx.Done():
+			}
+			return
+		}
+		log.Warn("watch changes of partition[%d] broken: %v", meta.ID, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(changesRetryDelay):
+		}
+		// the partition may have been dropped from the route cache on error
+		if p, err := getPartitionSafe(space, meta.StartSlot); err == nil {
+			partition = p
+		}
+	}
+}
+
+func (partition *Partition) watchChanges(ctx context.Context, fromIndex uint64, fromNow bool, events chan<- *ChangeEvent) (lastIndex uint64, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicToError(p)
+		}
+	}()
+
+	request := &pspb.WatchChangesRequest{
+		PartitionID: partition.meta.ID,
+		FromIndex:   fromIndex,
+		FromNow:     fromNow,
+	}
+	stream, err := partition.getClient().WatchChanges(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return lastIndex, err
+		}
+		if resp.Code == metapb.PS_RESP_CODE_TOKEN_EXPIRED {
+			return lastIndex, errTokenExpired
+		}
+		partition.checkResponse(&resp.ResponseHeader)
+		for i := range resp.Events {
+			event := &resp.Events[i]
+			select {
+			case events <- &ChangeEvent{
+				Token:     changeToken(event.PartitionID, event.Index),
+				Partition: event.PartitionID,
+				Index:     event.Index,
+				Type:      changeTypeNames[event.Type],
+				ID:        string(event.ID),
+				Before:    json.RawMessage(event.Before),
+				After:     json.RawMessage(event.After),
+				Timestamp: event.Timestamp,
+			}:
+			case <-ctx.Done():
+				return lastIndex, ctx.Err()
+			}
+			lastIndex = event.Index
+		}
+	}
+}
+
+func getPartitionSafe(space *Space, slot metapb.SlotID) (partition *Partition, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicToError(p)
+		}
+	}()
+	return space.GetPartition(slot), nil
+}
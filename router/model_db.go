@@ -11,11 +11,13 @@ type DB struct {
 	masterClient *MasterClient
 	spaceMap     sync.Map
 	context      context.Context
+	// the write quota of the db on this router
+	writeLimit *tokenBucket
 }
 
 func NewDB(masterClient *MasterClient, meta metapb.DB) *DB {
 	ctx, _ := context.WithCancel(context.Background())
-	return &DB{meta: meta, masterClient: masterClient, context: ctx}
+	return &DB{meta: meta, masterClient: masterClient, context: ctx, writeLimit: newWriteLimit(meta.Quota)}
 }
 
 func (db *DB) GetSpace(spaceName string) *Space {
@@ -23,6 +25,9 @@ func (db *DB) GetSpace(spaceName string) *Space {
 	if !ok {
 		spaceMeta := db.masterClient.GetSpace(db.meta.ID, spaceName)
 		space, ok = db.spaceMap.LoadOrStore(spaceMeta.Name, NewSpace(db, spaceMeta))
+		if !ok {
+			go space.(*Space).watchRoutes()
+		}
 	}
 	return space.(*Space)
 }
@@ -0,0 +1,139 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/tiglabs/baudengine/common/keys"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/router/gremlin"
+)
+
+// Graph serves gremlin traversals over the vertex and edge spaces of one db,
+// refs are "spaceName/docId".
+type Graph struct {
+	db        *DB
+	locations sync.Map
+}
+
+type graphLocation struct {
+	partition *Partition
+	id        metapb.Key
+}
+
+func NewGraph(db *DB) *Graph {
+	return &Graph{db: db}
+}
+
+func (graph *Graph) Locate(ref string) (key string, err error) {
+	loc, err := graph.locate(ref)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d/%d", loc.partition.meta.Space, loc.partition.meta.ID), nil
+}
+
+// MultiGet reads the refs of one partition, as Locate grouped them, by one rpc. The filters are
+// evaluated by the partition server on the vertices.
+func (graph *Graph) MultiGet(ctx context.Context, vertexRefs, edgeRefs []string, filters []gremlin.Filter) (docs map[string][]byte, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicToError(p)
+		}
+	}()
+
+	var partition *Partition
+	refs := make(map[metapb.Key]string, len(vertexRefs)+len(edgeRefs))
+	keysOf := func(group []string) ([]metapb.Key, error) {
+		ids := make([]metapb.Key, 0, len(group))
+		for _, ref := range group {
+			loc, err := graph.locate(ref)
+			if err != nil {
+				return nil, err
+			}
+			partition = loc.partition
+			refs[loc.id] = ref
+			ids = append(ids, loc.id)
+		}
+		return ids, nil
+	}
+	vertexIds, err := keysOf(vertexRefs)
+	if err != nil {
+		return nil, err
+	}
+	edgeIds, err := keysOf(edgeRefs)
+	if err != nil {
+		return nil, err
+	}
+	if partition == nil {
+		return nil, nil
+	}
+
+	fieldFilters := make([]pspb.FieldFilter, 0, len(filters))
+	for _, f := range filters {
+		filter := pspb.FieldFilter{Field: f.Key}
+		if !f.Any {
+			if filter.Value, err = json.Marshal(f.Value); err != nil {
+				return nil, err
+			}
+		}
+		fieldFilters = append(fieldFilters, filter)
+	}
+
+	found := partition.MultiGet(ctx, edgeIds, vertexIds, fieldFilters)
+	docs = make(map[string][]byte, len(found))
+	for _, doc := range found {
+		docs[refs[doc.ID]] = doc.Data
+	}
+	return docs, nil
+}
+
+func (graph *Graph) locate(ref string) (loc *graphLocation, err error) {
+	if l, ok := graph.locations.Load(ref); ok {
+		return l.(*graphLocation), nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicToError(p)
+		}
+	}()
+
+	pos := strings.IndexByte(ref, '/')
+	if pos <= 0 || pos == len(ref)-1 {
+		return nil, fmt.Errorf("bad vertex or edge ref %q", ref)
+	}
+	space := graph.db.GetSpace(ref[:pos])
+	docId, err := keys.DecodeDocIDFromString(ref[pos+1:])
+	if err != nil {
+		return nil, err
+	}
+	loc = &graphLocation{partition: space.GetPartition(docId.SlotID), id: metapb.Key(ref[pos+1:])}
+	graph.locations.Store(ref, loc)
+	return loc, nil
+}
+
+// replyError carries a reply panicked by a partition through the traversal, so the query fails
+// with its code.
+type replyError struct {
+	reply *HttpReply
+}
+
+func (e *replyError) Error() string {
+	return e.reply.Msg
+}
+
+func panicToError(p interface{}) error {
+	switch t := p.(type) {
+	case error:
+		return t
+	case *HttpReply:
+		return &replyError{reply: t}
+	default:
+		return fmt.Errorf("%v", t)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/tiglabs/baudengine/util/rpc"
 	"google.golang.org/grpc"
 	"github.com/tiglabs/baudengine/util/log"
+	"sync"
 )
 
 type Partition struct {
@@ -19,6 +20,8 @@ type Partition struct {
 	psClient      *rpc.Client
 	leaderAddr    string
 	requestHeader pspb.ActionRequestHeader
+	// guards meta, route and leaderAddr changed by the routes pushed
+	lock sync.RWMutex
 }
 
 func NewPartition(parent *Space, route masterpb.Route) *Partition {
@@ -30,16 +33,30 @@ func NewPartition(parent *Space, route masterpb.Route) *Partition {
 	clientOpt.ConnectMgr = connMgr
 	clientOpt.CreateFunc = func(clientConn *grpc.ClientConn) interface{} { return pspb.NewApiGrpcClient(clientConn) }
 	partition.psClient = rpc.NewClient(1, &clientOpt)
+	partition.leaderAddr = leaderAddrOf(route)
+	return partition
+}
+
+// setRoute applies the route of a newer epoch or leader to the partition.
+func (partition *Partition) setRoute(route masterpb.Route) {
+	partition.lock.Lock()
+	defer partition.lock.Unlock()
+
+	partition.meta = route.Partition
+	partition.route = route
+	if leaderAddr := leaderAddrOf(route); leaderAddr != "" {
+		partition.leaderAddr = leaderAddr
+	}
+}
+
+func leaderAddrOf(route masterpb.Route) string {
 	for _, node := range route.Nodes {
 		if node.ID == route.Leader {
-			partition.leaderAddr = node.RpcAddr
-			break
+			return node.RpcAddr
 		}
 	}
-	if partition.leaderAddr == "" {
-		log.Error("cannot found address for leader node %d", route.Leader)
-	}
-	return partition
+	log.Error("cannot found address for leader node %d", route.Leader)
+	return ""
 }
 
 func (partition *Partition) Create(docBody []byte) *metapb.DocID {
@@ -61,15 +78,22 @@ func (partition *Partition) Create(docBody []byte) *metapb.DocID {
 	return docId
 }
 
-func (partition *Partition) Read(docId *metapb.DocID) metapb.Value {
-	request := &pspb.GetRequest{ActionRequestHeader: partition.requestHeader, Id:*docId}
-	ctx, cancel := partition.getContext()
-	defer cancel()
-	resp, err := partition.getClient().Get(ctx, request)
+// MultiGet reads the documents of ids, and the documents of filteredIds matching all the filters,
+// missing documents are left out.
+func (partition *Partition) MultiGet(ctx context.Context, ids, filteredIds []metapb.Key, filters []pspb.FieldFilter) []pspb.MultiGetDoc {
+	request := &pspb.MultiGetRequest{
+		PartitionID: partition.meta.ID,
+		IDs:         ids,
+		FilteredIDs: filteredIds,
+		Filters:     filters,
+	}
+	resp, err := partition.getClient().MultiGet(ctx, request)
 	if err != nil {
+		log.Error("multi get from partition[%d] failed: %s", partition.meta.ID, err.Error())
 		panic(err)
 	}
-	return resp.Fields
+	partition.checkResponse(&resp.ResponseHeader)
+	return resp.Docs
 }
 
 func (partition *Partition) Update(docId *metapb.DocID, docBody []byte) {
@@ -109,9 +133,13 @@ func (partition *Partition) Delete(docId *metapb.DocID) bool {
 }
 
 func (partition *Partition) getClient() pspb.ApiGrpcClient {
-	psClient, err := partition.psClient.GetGrpcClient(partition.leaderAddr)
+	partition.lock.RLock()
+	leaderAddr := partition.leaderAddr
+	partition.lock.RUnlock()
+
+	psClient, err := partition.psClient.GetGrpcClient(leaderAddr)
 	if err != nil {
-		log.Warn("get ps client for %s failed", partition.leaderAddr)
+		log.Warn("get ps client for %s failed", leaderAddr)
 		panic(err)
 	}
 	return psClient.(pspb.ApiGrpcClient)
@@ -128,9 +156,15 @@ func (partition *Partition) getSingleResponse(resp *pspb.BulkResponse, err error
 	}
 	if resp.Code != metapb.RESP_CODE_OK {
 		if resp.Code == metapb.PS_RESP_CODE_NO_LEADER || resp.Code == metapb.PS_RESP_CODE_NO_PARTITION {
-			partition.parent.Delete(partition.meta)
+			partition.parent.refreshRoute(partition)
 		} else if resp.Code == metapb.PS_RESP_CODE_NOT_LEADER {
-			partition.leaderAddr = resp.Error.NotLeader.LeaderAddr
+			partition.onNotLeader(resp.Error.NotLeader)
+		} else if resp.Code == metapb.PS_RESP_CODE_QUOTA_EXCEEDED {
+			panic(&HttpReply{ERRCODE_QUOTA_EXCEEDED, resp.Message, nil})
+		} else if resp.Code == metapb.PS_RESP_CODE_READONLY {
+			panic(&HttpReply{ERRCODE_READONLY, resp.Message, nil})
+		} else if resp.Code == metapb.PS_RESP_CODE_DRAINING {
+			panic(&HttpReply{ERRCODE_SYSBUSY, resp.Message, nil})
 		}
 		log.Error("bulk response failed(%d): %s", resp.Code, resp.Message)
 		panic(errors.New(resp.Message))
@@ -140,3 +174,18 @@ func (partition *Partition) getSingleResponse(resp *pspb.BulkResponse, err error
 	}
 	return &resp.Responses[0]
 }
+
+// onNotLeader follows the leader the partition server knows, or refreshes the route when the
+// epoch of the partition server is newer than the cached one.
+func (partition *Partition) onNotLeader(notLeader *metapb.NotLeader) {
+	partition.lock.Lock()
+	stale := notLeader == nil || notLeader.LeaderAddr == "" || epochBefore(partition.meta.Epoch, notLeader.Epoch)
+	if !stale {
+		partition.leaderAddr = notLeader.LeaderAddr
+	}
+	partition.lock.Unlock()
+
+	if stale {
+		partition.parent.refreshRoute(partition)
+	}
+}
@@ -0,0 +1,290 @@
+package router
+
+import (
+	"container/heap"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+const defaultSearchSize = 10
+
+var (
+	errSearchWindow = errors.New("from and size of a search must not be negative")
+	errScrollID     = errors.New("invalid scroll id")
+)
+
+type searchRequest struct {
+	Query json.RawMessage `json:"query"`
+	From  int             `json:"from,omitempty"`
+	Size  *int            `json:"size,omitempty"`
+	// "-field" sorts descending, by score if empty
+	Sort    []string `json:"sort,omitempty"`
+	Fields  []string `json:"fields,omitempty"`
+	Timeout string   `json:"timeout,omitempty"`
+	// folds the hits of every partition into buckets, see engine.Aggregation
+	Aggregation json.RawMessage `json:"aggregation,omitempty"`
+	// keeps the snapshots of the partitions for the next pages this long, e.g. "1m"
+	Scroll string `json:"scroll,omitempty"`
+	// the scroll of the earlier page, it is closed after the search if scroll is not set
+	ScrollID string `json:"scroll_id,omitempty"`
+}
+
+type SearchHit struct {
+	ID     string          `json:"_id"`
+	Score  float64         `json:"_score"`
+	Source json.RawMessage `json:"_source,omitempty"`
+	sort   []string
+	// an unresolved intent is on the document
+	intent bool
+}
+
+type SearchResult struct {
+	Total uint64      `json:"total"`
+	Hits  []SearchHit `json:"hits"`
+	// the buckets of the aggregation of all the partitions, the buckets of a group in different
+	// partitions are merged by the client
+	Buckets []json.RawMessage `json:"buckets,omitempty"`
+	// the scroll of the next page
+	ScrollID string `json:"scroll_id,omitempty"`
+}
+
+// Search runs the query on the partition, the top limit hits are returned in sort order. With a
+// scroll the snapshot of the scroll is searched, it is kept for keepAlive.
+func (partition *Partition) Search(ctx context.Context, req *searchRequest, limit int, scrollID string, keepAlive time.Duration) *pspb.SearchResponse {
+	request := &pspb.SearchRequest{
+		PartitionID: partition.meta.ID,
+		Query:       req.Query,
+		Limit:       int32(limit),
+		Sort:        req.Sort,
+		Fields:      req.Fields,
+		Aggregation: req.Aggregation,
+		ScrollID:    scrollID,
+		KeepAlive:   int64(keepAlive / time.Millisecond),
+	}
+	request.Timeout = req.Timeout
+	resp, err := partition.getClient().Search(ctx, request)
+	if err != nil {
+		panic(err)
+	}
+	partition.checkResponse(&resp.ResponseHeader)
+	return resp
+}
+
+// Search scatters the query to all the partitions of the space, each one returns its top from+size
+// hits, which are merged by their sort values and cut to the window asked. The pages of a scroll
+// are cut the same way from the snapshots the partitions took for its first page.
+func (space *Space) Search(req *searchRequest) *SearchResult {
+	size := defaultSearchSize
+	if req.Size != nil {
+		size = *req.Size
+	}
+	if req.From < 0 || size < 0 {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, errSearchWindow.Error(), nil})
+	}
+	if len(req.Query) == 0 {
+		req.Query = json.RawMessage(`{"match_all": {}}`)
+	}
+	var keepAlive time.Duration
+	if req.Scroll != "" {
+		var err error
+		if keepAlive, err = time.ParseDuration(req.Scroll); err != nil || keepAlive <= 0 {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+		}
+	}
+	scrolls, err := decodeScrollID(req.ScrollID)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+
+	partitions := space.GetPartitions()
+	// the scroll is lost if the partitions changed since it was opened
+	if scrolls != nil && !scrollsCover(scrolls, partitions) {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, errScrollID.Error(), nil})
+	}
+	responses := make([]*pspb.SearchResponse, len(partitions))
+	errs := make([]error, len(partitions))
+	var wg sync.WaitGroup
+	for i, partition := range partitions {
+		wg.Add(1)
+		go func(i int, partition *Partition) {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					errs[i] = panicToError(p)
+				}
+			}()
+			ctx, cancel := partition.getContext()
+			defer cancel()
+			responses[i] = partition.Search(ctx, req, req.From+size, scrolls[partition.meta.ID], keepAlive)
+		}(i, partition)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	result := &SearchResult{Hits: []SearchHit{}}
+	for _, resp := range responses {
+		result.Total += resp.Total
+		if len(resp.Buckets) > 0 {
+			var buckets []json.RawMessage
+			if err := json.Unmarshal(resp.Buckets, &buckets); err != nil {
+				panic(err)
+			}
+			result.Buckets = append(result.Buckets, buckets...)
+		}
+	}
+	result.Hits = mergeHits(responses, req.Sort, req.From, size)
+	if keepAlive > 0 {
+		scrolls = make(map[metapb.PartitionID]string, len(partitions))
+		for i, partition := range partitions {
+			scrolls[partition.meta.ID] = responses[i].ScrollID
+		}
+		result.ScrollID = encodeScrollID(scrolls)
+	}
+	return result
+}
+
+func scrollsCover(scrolls map[metapb.PartitionID]string, partitions []*Partition) bool {
+	if len(scrolls) != len(partitions) {
+		return false
+	}
+	for _, partition := range partitions {
+		if _, ok := scrolls[partition.meta.ID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeScrollID packs the scrolls of the partitions into the scroll id of the client.
+func encodeScrollID(scrolls map[metapb.PartitionID]string) string {
+	data, _ := json.Marshal(scrolls)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeScrollID unpacks the scrolls of the partitions, nil for an empty id.
+func decodeScrollID(id string) (map[metapb.PartitionID]string, error) {
+	if id == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return nil, errScrollID
+	}
+	var scrolls map[metapb.PartitionID]string
+	if err := json.Unmarshal(data, &scrolls); err != nil || len(scrolls) == 0 {
+		return nil, errScrollID
+	}
+	return scrolls, nil
+}
+
+// hitCursor is the next hit of the sorted hits of a partition.
+type hitCursor struct {
+	partition int
+	hits      []pspb.SearchHit
+}
+
+// hitHeap orders the cursors by their next hits, the earlier partition first on a tie.
+type hitHeap struct {
+	cursors []*hitCursor
+	sort    []string
+}
+
+func (h *hitHeap) Len() int { return len(h.cursors) }
+
+func (h *hitHeap) Less(i, j int) bool {
+	a, b := searchHit(&h.cursors[i].hits[0]), searchHit(&h.cursors[j].hits[0])
+	if c := compareHits(&a, &b, h.sort); c != 0 {
+		return c < 0
+	}
+	return h.cursors[i].partition < h.cursors[j].partition
+}
+
+func (h *hitHeap) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *hitHeap) Push(x interface{}) { h.cursors = append(h.cursors, x.(*hitCursor)) }
+
+func (h *hitHeap) Pop() interface{} {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
+
+func searchHit(hit *pspb.SearchHit) SearchHit {
+	return SearchHit{ID: string(hit.ID), Score: hit.Score, Source: json.RawMessage(hit.Source), sort: hit.Sort,
+		intent: hit.Intent}
+}
+
+// mergeHits merges the hits of the partitions, each sorted by the engine, by a k-way merge which
+// stops at the end of the window: the hits before from are skipped and the ones after it are never
+// compared.
+func mergeHits(responses []*pspb.SearchResponse, sort []string, from, size int) []SearchHit {
+	h := &hitHeap{sort: sort}
+	for i, resp := range responses {
+		if len(resp.Hits) > 0 {
+			h.cursors = append(h.cursors, &hitCursor{partition: i, hits: resp.Hits})
+		}
+	}
+	heap.Init(h)
+
+	hits := []SearchHit{}
+	for n := 0; h.Len() > 0 && len(hits) < size; n++ {
+		cursor := h.cursors[0]
+		if n >= from {
+			hits = append(hits, searchHit(&cursor.hits[0]))
+		}
+		if cursor.hits = cursor.hits[1:]; len(cursor.hits) == 0 {
+			heap.Pop(h)
+		} else {
+			heap.Fix(h, 0)
+		}
+	}
+	return hits
+}
+
+// compareHits orders the hits of different partitions as the engine orders them in one, the sort
+// values of the engine compare as strings, numbers included.
+func compareHits(a, b *SearchHit, fields []string) int {
+	if len(fields) == 0 {
+		return compareScores(a.Score, b.Score)
+	}
+	for i, field := range fields {
+		var c int
+		desc := strings.HasPrefix(field, "-")
+		if strings.TrimPrefix(field, "-") == "_score" {
+			// "_score" is ascending as the other fields, "-_score" the default order
+			c = -compareScores(a.Score, b.Score)
+		} else if i < len(a.sort) && i < len(b.sort) {
+			c = strings.Compare(a.sort[i], b.sort[i])
+		}
+		if desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareScores puts the higher score first
+func compareScores(a, b float64) int {
+	switch {
+	case a > b:
+		return -1
+	case a < b:
+		return 1
+	}
+	return 0
+}
@@ -1,13 +1,16 @@
 package router
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/pkg/errors"
+	"github.com/tiglabs/baudengine/engine/bleve"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"sort"
 	"sync"
 	"github.com/tiglabs/baudengine/util/log"
+	"time"
 )
 
 type Space struct {
@@ -15,13 +18,17 @@ type Space struct {
 	parent     *DB
 	partitions []*Partition
 	lock       sync.RWMutex
+	// analyzer analyzes the texts of _analyze, see Analyzer
+	analyzer   *bleve.Analyzer
+	// the write quota of the space on this router
+	writeLimit *tokenBucket
 }
 
 func NewSpace(parent *DB, meta metapb.Space) *Space {
 	if str, err := json.Marshal(meta); err == nil {
 		log.Debug("NewSpace(): %s", string(str))
 	}
-	return &Space{meta: meta, parent: parent}
+	return &Space{meta: meta, parent: parent, writeLimit: newWriteLimit(meta.Quota)}
 }
 
 func (space *Space) GetPartition(slotId metapb.SlotID) *Partition {
@@ -55,15 +62,117 @@ func (space *Space) addRoutes(routes []masterpb.Route) {
 	defer space.lock.Unlock()
 
 	for _, route := range routes {
-		pos := sort.Search(len(space.partitions), func(i int) bool {
-			return space.partitions[i].meta.EndSlot >= route.StartSlot
-		})
-		newPartition := NewPartition(space, route)
-		if pos >= len(space.partitions) {
-			space.partitions = append(space.partitions, newPartition)
-		} else if space.partitions[pos].meta.EndSlot >= route.StartSlot {
-			space.partitions = append(space.partitions[:pos], append([]*Partition{newPartition}, space.partitions[pos:]...)...)
+		space.putRoute(route)
+	}
+}
+
+// putRoute caches the route in place of the partitions of its slots, unless the cached partition
+// is newer. internal use, need to write lock external
+func (space *Space) putRoute(route masterpb.Route) {
+	for _, partition := range space.partitions {
+		if partition.meta.ID != route.ID {
+			continue
+		}
+		if epochBefore(route.Epoch, partition.meta.Epoch) {
+			return
+		}
+		if partition.meta.StartSlot == route.StartSlot && partition.meta.EndSlot == route.EndSlot {
+			partition.setRoute(route)
+			return
 		}
+		break
+	}
+
+	partitions := make([]*Partition, 0, len(space.partitions)+1)
+	for _, partition := range space.partitions {
+		if partition.meta.EndSlot < route.StartSlot || partition.meta.StartSlot > route.EndSlot {
+			partitions = append(partitions, partition)
+		}
+	}
+	pos := sort.Search(len(partitions), func(i int) bool {
+		return partitions[i].meta.StartSlot > route.EndSlot
+	})
+	partitions = append(partitions[:pos], append([]*Partition{NewPartition(space, route)}, partitions[pos:]...)...)
+	space.partitions = partitions
+}
+
+// refreshRoute replaces the cached partition by the routes of its slots from the master, the
+// partition is dropped only when the master has none.
+func (space *Space) refreshRoute(partition *Partition) {
+	defer func() {
+		if e := recover(); e != nil {
+			log.Error("refresh route of partition %d failed: %v", partition.meta.ID, e)
+			space.Delete(partition.meta)
+		}
+	}()
+	routes := space.parent.masterClient.GetRoute(space.meta.DB, space.meta.ID, partition.meta.StartSlot)
+	space.addRoutes(routes)
+}
+
+// watchRoutes applies the route changes the master pushes until the db goes away, and rewatches
+// when the stream breaks.
+func (space *Space) watchRoutes() {
+	for {
+		if err := space.watchRoutesOnce(); err != nil {
+			log.Warn("watch routes of space %s failed: %v", space.meta.Name, err)
+		}
+		select {
+		case <-space.parent.context.Done():
+			return
+		case <-time.After(routeWatchRetryDef):
+		}
+	}
+}
+
+func (space *Space) watchRoutesOnce() (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = errors.Errorf("%v", e)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(space.parent.context)
+	defer cancel()
+	stream, err := space.parent.masterClient.WatchRoutes(ctx, space.meta.DB, space.meta.ID)
+	if err != nil {
+		return err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	space.parent.masterClient.checkResponseOk(&resp.ResponseHeader, nil)
+
+	// the changes before the watch are unknown, GetPartition pulls the routes again
+	space.lock.Lock()
+	space.partitions = nil
+	space.lock.Unlock()
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		space.parent.masterClient.checkResponseOk(&resp.ResponseHeader, nil)
+		space.applyRouteEvents(resp.Events)
+	}
+}
+
+func (space *Space) applyRouteEvents(events []masterpb.RouteEvent) {
+	space.lock.Lock()
+	defer space.lock.Unlock()
+
+	for _, event := range events {
+		if event.Type == masterpb.RouteEventType_RouteDelete {
+			for i, partition := range space.partitions {
+				if partition.meta.ID == event.Route.ID {
+					space.partitions = append(space.partitions[:i], space.partitions[i+1:]...)
+					break
+				}
+			}
+			continue
+		}
+		space.putRoute(event.Route)
 	}
 }
 
@@ -80,3 +189,8 @@ func (space *Space) Delete(partition metapb.Partition) {
 		space.partitions = append(space.partitions[:pos], space.partitions[pos + 1:]...)
 	}
 }
+
+// epochBefore reports whether the epoch a is older than b.
+func epochBefore(a, b metapb.PartitionEpoch) bool {
+	return a.Version < b.Version || (a.Version == b.Version && a.ConfVersion < b.ConfVersion)
+}
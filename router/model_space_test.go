@@ -0,0 +1,184 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"google.golang.org/grpc"
+)
+
+// routeMaster is a master serving the routes of one space, the routes pushed to the watch are
+// sent to the watching router.
+type routeMaster struct {
+	masterpb.MasterRpcServer
+	lock   sync.Mutex
+	routes []masterpb.Route
+	// a watch opened, the events pushed to it and its breaks
+	watches chan struct{}
+	events  chan []masterpb.RouteEvent
+	breaks  chan struct{}
+}
+
+func newRouteMaster() *routeMaster {
+	return &routeMaster{
+		watches: make(chan struct{}, 1),
+		events:  make(chan []masterpb.RouteEvent),
+		breaks:  make(chan struct{}),
+	}
+}
+
+func (m *routeMaster) GetRoute(ctx context.Context, req *masterpb.GetRouteRequest) (*masterpb.GetRouteResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	resp := &masterpb.GetRouteResponse{ResponseHeader: metapb.ResponseHeader{Code: metapb.RESP_CODE_OK}}
+	for _, route := range m.routes {
+		if route.StartSlot <= req.Slot && req.Slot <= route.EndSlot {
+			resp.Routes = append(resp.Routes, route)
+		}
+	}
+	return resp, nil
+}
+
+func (m *routeMaster) WatchRoutes(req *masterpb.WatchRoutesRequest, stream masterpb.MasterRpc_WatchRoutesServer) error {
+	if err := stream.Send(&masterpb.WatchRoutesResponse{ResponseHeader: metapb.ResponseHeader{Code: metapb.RESP_CODE_OK}}); err != nil {
+		return err
+	}
+	m.watches <- struct{}{}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-m.breaks:
+			return errors.New("watch broken")
+		case events := <-m.events:
+			resp := &masterpb.WatchRoutesResponse{ResponseHeader: metapb.ResponseHeader{Code: metapb.RESP_CODE_OK}, Events: events}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func testRoute(id metapb.PartitionID, startSlot, endSlot metapb.SlotID, version uint64, leader metapb.NodeID) masterpb.Route {
+	route := masterpb.Route{Leader: leader}
+	route.ID, route.DB, route.Space = id, 1, 2
+	route.StartSlot, route.EndSlot = startSlot, endSlot
+	route.Epoch.Version = version
+	for _, nodeId := range []metapb.NodeID{1, 2} {
+		node := &metapb.Node{ID: nodeId}
+		node.RpcAddr = testNodeAddr(nodeId)
+		route.Nodes = append(route.Nodes, node)
+	}
+	return route
+}
+
+func testNodeAddr(nodeId metapb.NodeID) string {
+	return []string{"", "ps1:8000", "ps2:8000"}[nodeId]
+}
+
+// waitPartition waits for the partition of the slot to be cached as cond wants it, nil if it
+// should not be cached.
+func waitPartition(t *testing.T, space *Space, slotId metapb.SlotID, cond func(partition *Partition) bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		partition, _ := space.getPartition(slotId)
+		if cond(partition) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the route of slot %d is not applied: %v", slotId, partition)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// routeOf returns the partition meta and the leader the routes pushed set.
+func routeOf(partition *Partition) (metapb.Partition, string) {
+	partition.lock.RLock()
+	defer partition.lock.RUnlock()
+	return partition.meta, partition.leaderAddr
+}
+
+// hasRoute reports whether the partition is cached with the id, the end slot and the leader.
+func hasRoute(partition *Partition, id metapb.PartitionID, endSlot metapb.SlotID, leader metapb.NodeID) bool {
+	if partition == nil {
+		return false
+	}
+	meta, leaderAddr := routeOf(partition)
+	return meta.ID == id && meta.EndSlot == endSlot && leaderAddr == testNodeAddr(leader)
+}
+
+func TestWatchRoutes(t *testing.T) {
+	routerCfg = &Config{}
+	master := newRouteMaster()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	masterpb.RegisterMasterRpcServer(server, master)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	masterClient := NewMasterClient(listener.Addr().String(), "", "", "")
+	defer masterClient.Close()
+	db := NewDB(masterClient, metapb.DB{ID: 1, Name: "db"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db.context = ctx
+	space := NewSpace(db, metapb.Space{ID: 2, DB: 1, Name: "space"})
+	go space.watchRoutes()
+	waitWatch := func() {
+		select {
+		case <-master.watches:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the routes are not watched")
+		}
+	}
+	push := func(events ...masterpb.RouteEvent) {
+		master.events <- events
+	}
+	update := func(route masterpb.Route) masterpb.RouteEvent {
+		return masterpb.RouteEvent{Type: masterpb.RouteEventType_RouteUpdate, Route: route}
+	}
+	waitWatch()
+
+	// a new partition, then a leader change of it
+	push(update(testRoute(10, 0, 99, 1, 1)))
+	waitPartition(t, space, 50, func(p *Partition) bool { return hasRoute(p, 10, 99, 1) })
+	push(update(testRoute(10, 0, 99, 1, 2)))
+	waitPartition(t, space, 50, func(p *Partition) bool { return hasRoute(p, 10, 99, 2) })
+	// a route of an older epoch is ignored
+	push(update(testRoute(10, 0, 99, 0, 1)), update(testRoute(13, 200, 299, 1, 1)))
+	waitPartition(t, space, 250, func(p *Partition) bool { return hasRoute(p, 13, 299, 1) })
+	waitPartition(t, space, 50, func(p *Partition) bool { return hasRoute(p, 10, 99, 2) })
+
+	// a split replaces the partition by the two halves, a delete drops one
+	push(update(testRoute(10, 0, 49, 2, 2)), update(testRoute(11, 50, 99, 2, 1)))
+	waitPartition(t, space, 70, func(p *Partition) bool { return hasRoute(p, 11, 99, 1) })
+	waitPartition(t, space, 20, func(p *Partition) bool { return hasRoute(p, 10, 49, 2) })
+	push(masterpb.RouteEvent{Type: masterpb.RouteEventType_RouteDelete, Route: testRoute(11, 50, 99, 2, 1)})
+	waitPartition(t, space, 70, func(p *Partition) bool { return p == nil })
+
+	// the watch broken is resumed, the routes cached before are pulled again
+	master.lock.Lock()
+	master.routes = []masterpb.Route{testRoute(10, 0, 49, 3, 1)}
+	master.lock.Unlock()
+	master.breaks <- struct{}{}
+	waitWatch()
+	waitPartition(t, space, 20, func(p *Partition) bool { return p == nil })
+	waitPartition(t, space, 250, func(p *Partition) bool { return p == nil })
+	if partition := space.GetPartition(20); !hasRoute(partition, 10, 49, 1) {
+		meta, leaderAddr := routeOf(partition)
+		t.Fatalf("the route pulled after the rewatch is %v led by %s", meta, leaderAddr)
+	}
+	push(update(testRoute(12, 100, 199, 1, 2)))
+	waitPartition(t, space, 150, func(p *Partition) bool { return hasRoute(p, 12, 199, 2) })
+}
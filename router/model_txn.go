@@ -0,0 +1,147 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/router/txn"
+)
+
+type txnRequest struct {
+	Writes []txnWriteItem `json:"writes"`
+}
+
+// txnWriteItem is a bulk write to a space of the db
+type txnWriteItem struct {
+	Space string `json:"space"`
+	bulkWriteItem
+}
+
+// TxnClient runs the transactions of one db over its routes, the documents are placed by their
+// id as bulk writes are.
+type TxnClient struct {
+	db         *DB
+	partitions sync.Map
+}
+
+func NewTxnClient(db *DB) *TxnClient {
+	return &TxnClient{db: db}
+}
+
+func NewTxnCoordinator(db *DB) *txn.Coordinator {
+	return txn.NewCoordinator(NewTxnClient(db), txn.Options{
+		RecordSpace: routerCfg.ModuleCfg.TxnSpace,
+		TTL:         time.Duration(routerCfg.ModuleCfg.TxnTTL) * time.Millisecond,
+	})
+}
+
+type txnPartitionKey struct {
+	space     string
+	partition metapb.PartitionID
+}
+
+func (c *TxnClient) PartitionOf(space string, id metapb.Key) (partitionID metapb.PartitionID, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicToError(p)
+		}
+	}()
+
+	slot, err := docSlot(string(id))
+	if err != nil {
+		return 0, err
+	}
+	partition := c.db.GetSpace(space).GetPartition(slot)
+	c.partitions.Store(txnPartitionKey{space, partition.meta.ID}, partition)
+	return partition.meta.ID, nil
+}
+
+func (c *TxnClient) Bulk(ctx context.Context, space string, partitionID metapb.PartitionID, requests []pspb.RequestUnion, atomic bool) (responses []pspb.ResponseUnion, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicToError(p)
+		}
+	}()
+
+	partition, ok := c.partitions.Load(txnPartitionKey{space, partitionID})
+	if !ok {
+		return nil, fmt.Errorf("partition[%d] of space[%s] not located", partitionID, space)
+	}
+	return partition.(*Partition).Bulk(ctx, requests, atomic, ""), nil
+}
+
+func (c *TxnClient) Get(ctx context.Context, space string, id metapb.Key) (resp *pspb.GetResponse, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicToError(p)
+		}
+	}()
+
+	slot, err := docSlot(string(id))
+	if err != nil {
+		return nil, err
+	}
+	return c.db.GetSpace(space).GetPartition(slot).Get(ctx, id), nil
+}
+
+// Get reads the committed document with the intent on it.
+func (partition *Partition) Get(ctx context.Context, id metapb.Key) *pspb.GetResponse {
+	request := &pspb.GetRequest{PartitionID: partition.meta.ID, ID: id}
+	resp, err := partition.getClient().Get(ctx, request)
+	if err != nil {
+		panic(err)
+	}
+	partition.checkResponse(&resp.ResponseHeader)
+	return resp
+}
+
+// resolveHits finishes the transactions of the stale intents on the hits. The source of a hit
+// whose transaction is finished is read again, a hit deleted by it is dropped, the hit of a live
+// transaction keeps its committed image.
+func (space *Space) resolveHits(ctx context.Context, hits []SearchHit, fields []string) []SearchHit {
+	coordinator := NewTxnCoordinator(space.parent)
+	resolved := hits[:0]
+	for _, hit := range hits {
+		if !hit.intent {
+			resolved = append(resolved, hit)
+			continue
+		}
+		resp, err := coordinator.Get(ctx, space.meta.Name, metapb.Key(hit.ID))
+		if err != nil {
+			panic(err)
+		}
+		if !resp.Found {
+			continue
+		}
+		if resp.Intent == nil {
+			if hit.Source, err = projectFields(resp.Data, fields); err != nil {
+				panic(err)
+			}
+		}
+		resolved = append(resolved, hit)
+	}
+	return resolved
+}
+
+// projectFields keeps the fields asked by a search in the document, all of them if none is asked.
+func projectFields(data metapb.Value, fields []string) (json.RawMessage, error) {
+	if len(fields) == 0 {
+		return json.RawMessage(data), nil
+	}
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	projected := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := doc[field]; ok {
+			projected[field] = value
+		}
+	}
+	return json.Marshal(projected)
+}
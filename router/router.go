@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"github.com/spaolacci/murmur3"
 	"github.com/tiglabs/baudengine/common/keys"
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/mygate/auth"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/util/log"
 	"net/http"
@@ -11,6 +13,14 @@ import (
 	"sync"
 	"github.com/tiglabs/baudengine/util/netutil"
 	"errors"
+	"context"
+	"time"
+	"github.com/tiglabs/baudengine/router/gremlin"
+	"github.com/tiglabs/baudengine/router/txn"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"fmt"
+	"io"
+	"strings"
 )
 
 var routerCfg 	*Config
@@ -20,6 +30,8 @@ type Router struct {
 	masterClient *MasterClient
 	dbMap        sync.Map
 	lock         sync.RWMutex
+	// access checks the users of the requests, nil without auth
+	access       *accessControl
 }
 
 type HttpReply struct {
@@ -34,7 +46,11 @@ func NewServer() *Router {
 
 func (router *Router) Start(cfg *Config) error {
 	routerCfg = cfg
-	router.masterClient = NewMasterClient(cfg.ModuleCfg.MasterAddr)
+	router.masterClient = NewMasterClient(cfg.ModuleCfg.MasterAddr, cfg.ModuleCfg.TopoAddrs,
+		cfg.ModuleCfg.TopoRootDir, cfg.ModuleCfg.ZoneName)
+	if cfg.ModuleCfg.Auth {
+		router.access = newAccessControl(router, &cfg.ModuleCfg)
+	}
 
 	httpServerConfig := &netutil.ServerConfig{
 		Name: "router",
@@ -47,18 +63,32 @@ func (router *Router) Start(cfg *Config) error {
 	router.httpServer.Handle(netutil.GET, "/doc/:db/:space/:docId", router.handleRead)
 	router.httpServer.Handle(netutil.POST,"/doc/:db/:space/:docId", router.handleUpdate)
 	router.httpServer.Handle(netutil.DELETE, "/doc/:db/:space/:docId", router.handleDelete)
+	router.httpServer.Handle(netutil.POST, "/_bulk/:db/:space", router.handleBulk)
+	router.httpServer.Handle(netutil.POST, "/_search/:db/:space", router.handleSearch)
+	router.httpServer.Handle(netutil.POST, "/_txn/:db", router.handleTxn)
+	router.httpServer.Handle(netutil.GET, "/_txn/:db/:space/:id", router.handleTxnGet)
+	router.httpServer.Handle(netutil.POST, "/gremlin/:db", router.handleGremlin)
+	router.httpServer.Handle(netutil.PUT, "/blob/:db/:space/:id", router.handleBlobPut)
+	router.httpServer.Handle(netutil.GET, "/blob/:db/:space/:id", router.handleBlobGet)
+	router.httpServer.Handle(netutil.DELETE, "/blob/:db/:space/:id", router.handleBlobDelete)
+	router.httpServer.Handle(netutil.GET, "/_changes/:db/:space", router.handleChanges)
+	router.httpServer.Handle(netutil.POST, "/_analyze", router.handleAnalyze)
+	router.httpServer.Handle(netutil.POST, "/_analyze/:db/:space", router.handleAnalyze)
 
 	return router.httpServer.Run()
 }
 
 func (router *Router) Shutdown() {
 	router.httpServer.Close()
+	router.masterClient.Close()
 }
 
 func (router *Router) handleCreate(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
 	defer router.catchPanic(writer)
 
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Insert)
 	db, space, _, _ := router.getParams(params, false)
+	space.throttleWrites(1)
 	docBody := router.readDocBody(request)
 	var partition *Partition
 	keyField := space.GetKeyField()
@@ -98,15 +128,30 @@ func (router *Router) handleCreate(writer http.ResponseWriter, request *http.Req
 func (router *Router) handleRead(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
 	defer router.catchPanic(writer)
 
-	_, _, partition, docId := router.getParams(params, true)
-	docBody := partition.Read(docId)
-	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), docBody})
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Select)
+	router.getParams(params, true)
+	router.readDoc(writer, request, params.ByName("db"), params.ByName("space"), params.ByName("docId"))
+}
+
+// readDoc replies the committed document, the transaction of a stale intent on it is finished first
+func (router *Router) readDoc(writer http.ResponseWriter, request *http.Request, dbName, spaceName, id string) {
+	db := router.GetDB(dbName)
+	resp, err := NewTxnCoordinator(db).Get(request.Context(), spaceName, metapb.Key(id))
+	if err != nil {
+		panic(err)
+	}
+	if !resp.Found {
+		panic(&HttpReply{ERRCODE_NOT_FOUND, ErrNotFound.Error(), nil})
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), json.RawMessage(resp.Data)})
 }
 
 func (router *Router) handleUpdate(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
 	defer router.catchPanic(writer)
 
-	_, _, partition, docId := router.getParams(params, true)
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Update)
+	_, space, partition, docId := router.getParams(params, true)
+	space.throttleWrites(1)
 	docBody := router.readDocBody(request)
 	partition.Update(docId, docBody)
 	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), nil})
@@ -115,7 +160,9 @@ func (router *Router) handleUpdate(writer http.ResponseWriter, request *http.Req
 func (router *Router) handleDelete(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
 	defer router.catchPanic(writer)
 
-	_, _, partition, docId := router.getParams(params, true)
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Delete)
+	_, space, partition, docId := router.getParams(params, true)
+	space.throttleWrites(1)
 	if ok := partition.Delete(docId); ok {
 		sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), nil})
 	} else {
@@ -123,6 +170,322 @@ func (router *Router) handleDelete(writer http.ResponseWriter, request *http.Req
 	}
 }
 
+func (router *Router) handleBulk(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	var bulk bulkRequest
+	if err := json.Unmarshal(router.readDocBody(request), &bulk); err != nil || len(bulk.Requests) == 0 {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), bulkPrivileges(bulk.Requests)...)
+	_, space, _, _ := router.getParams(params, false)
+	space.throttleWrites(len(bulk.Requests))
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), space.Bulk(&bulk)})
+}
+
+func (router *Router) handleSearch(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Select)
+	_, space, _, _ := router.getParams(params, false)
+	var searchReq searchRequest
+	if request.ContentLength > 0 {
+		if err := json.Unmarshal(router.readDocBody(request), &searchReq); err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+		}
+	}
+	result := space.Search(&searchReq)
+	result.Hits = space.resolveHits(request.Context(), result.Hits, searchReq.Fields)
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), result})
+}
+
+func (router *Router) handleTxn(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	var txnReq txnRequest
+	if err := json.Unmarshal(router.readDocBody(request), &txnReq); err != nil || len(txnReq.Writes) == 0 {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	writes := make([]txn.Write, 0, len(txnReq.Writes))
+	for i := range txnReq.Writes {
+		write, err := txnReq.Writes[i].toRequest()
+		if err != nil || txnReq.Writes[i].Space == "" {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+		}
+		router.checkAccess(request, params.ByName("db"), txnReq.Writes[i].Space, bulkPrivileges([]bulkWriteItem{txnReq.Writes[i].bulkWriteItem})...)
+		writes = append(writes, txn.Write{Space: txnReq.Writes[i].Space, Request: write})
+	}
+	db := router.GetDB(params.ByName("db"))
+	spaceWrites := make(map[string]int)
+	for _, write := range writes {
+		spaceWrites[write.Space]++
+	}
+	for spaceName, n := range spaceWrites {
+		db.GetSpace(spaceName).throttleWrites(n)
+	}
+
+	txnID, err := NewTxnCoordinator(db).Commit(request.Context(), writes)
+	respMap := map[string]interface{}{"_txn": txnID}
+	switch err {
+	case nil:
+		sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+	case txn.ErrConflict:
+		sendReply(writer, &HttpReply{ERRCODE_TXN_CONFLICT, err.Error(), respMap})
+	case txn.ErrCommitUnknown:
+		sendReply(writer, &HttpReply{ERRCODE_INTERNAL_ERROR, err.Error(), respMap})
+	default:
+		log.Error("transaction[%s] aborted: %v", txnID, err)
+		sendReply(writer, &HttpReply{ERRCODE_TXN_ABORTED, err.Error(), respMap})
+	}
+}
+
+// handleTxnGet reads a document, finishing the transaction of a stale intent on it
+func (router *Router) handleTxnGet(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Select)
+	router.readDoc(writer, request, params.ByName("db"), params.ByName("space"), params.ByName("id"))
+}
+
+type gremlinRequest struct {
+	Query   string `json:"query"`
+	Timeout int64  `json:"timeout,omitempty"` // ms
+}
+
+func (router *Router) handleGremlin(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	// a traversal crosses the spaces of the db
+	router.checkAccess(request, params.ByName("db"), auth.Wildcard, auth.Select)
+	db := router.GetDB(params.ByName("db"))
+	var gremlinReq gremlinRequest
+	if err := json.Unmarshal(router.readDocBody(request), &gremlinReq); err != nil || gremlinReq.Query == "" {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+
+	opts := gremlin.Options{
+		MaxFanout:     int(routerCfg.ModuleCfg.GremlinMaxFanout),
+		Timeout:       time.Duration(routerCfg.ModuleCfg.GremlinTimeout) * time.Millisecond,
+		MaxTraversers: int(routerCfg.ModuleCfg.GremlinMaxTraversers),
+	}
+	if gremlinReq.Timeout > 0 && (opts.Timeout <= 0 || time.Duration(gremlinReq.Timeout)*time.Millisecond < opts.Timeout) {
+		opts.Timeout = time.Duration(gremlinReq.Timeout) * time.Millisecond
+	}
+	result, err := gremlin.Execute(context.Background(), NewGraph(db), gremlinReq.Query, opts)
+	if err != nil {
+		log.Error("gremlin query[%s] failed: %v", gremlinReq.Query, err)
+		switch e := err.(type) {
+		case *gremlin.QueryError:
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		case *replyError:
+			panic(e.reply)
+		}
+		if err == gremlin.ErrTimeout || err == gremlin.ErrTooManyTraversers {
+			panic(&HttpReply{ERRCODE_SYSBUSY, err.Error(), nil})
+		}
+		panic(err)
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), result})
+}
+
+const blobMetaHeaderPrefix = "X-Baud-Meta-"
+
+func (router *Router) handleBlobPut(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Insert)
+	partition, id := router.getBlobParams(params)
+	partition.parent.throttleWrites(1)
+	blobMeta := &pspb.BlobMeta{ContentType: request.Header.Get("Content-Type"), Metadata: make(map[string]string)}
+	for name, values := range request.Header {
+		if strings.HasPrefix(name, blobMetaHeaderPrefix) && len(values) > 0 {
+			blobMeta.Metadata[strings.ToLower(name[len(blobMetaHeaderPrefix):])] = values[0]
+		}
+	}
+	resp := partition.PutBlob(id, blobMeta, request.Body)
+
+	respMap := map[string]interface{}{
+		"_id":     id,
+		"_result": resp.Result.String(),
+		"_size":   resp.Size_,
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
+func (router *Router) handleBlobGet(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Select)
+	partition, id := router.getBlobParams(params)
+	offset, length, ranged := parseRange(request.Header.Get("Range"))
+	blobMeta, stream, cancel := partition.GetBlob(id, offset, length)
+	if blobMeta == nil {
+		panic(&HttpReply{ERRCODE_NOT_FOUND, ErrNotFound.Error(), nil})
+	}
+	defer cancel()
+
+	header := writer.Header()
+	size := blobMeta.Size_
+	if ranged && offset >= size {
+		header.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+		writer.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	end := size
+	if length > 0 && offset+length < end {
+		end = offset + length
+	}
+	contentType := blobMeta.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Length", strconv.FormatUint(end-offset, 10))
+	header.Set("Accept-Ranges", "bytes")
+	for k, v := range blobMeta.Metadata {
+		header.Set(blobMetaHeaderPrefix+k, v)
+	}
+	if ranged {
+		header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, end-1, size))
+		writer.WriteHeader(http.StatusPartialContent)
+	} else {
+		writer.WriteHeader(http.StatusOK)
+	}
+
+	// the status is sent, errors from here on can only cut the body short
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return
+		} else if err != nil {
+			log.Error("read blob[%s] failed: %s", id, err.Error())
+			return
+		}
+		if _, err := writer.Write(resp.Data); err != nil {
+			log.Error("write blob[%s] failed: %s", id, err.Error())
+			return
+		}
+	}
+}
+
+func (router *Router) handleBlobDelete(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Delete)
+	partition, id := router.getBlobParams(params)
+	partition.parent.throttleWrites(1)
+	if ok := partition.DeleteBlob(id); ok {
+		sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), nil})
+	} else {
+		sendReply(writer, &HttpReply{ERRCODE_NOT_FOUND, ErrNotFound.Error(), nil})
+	}
+}
+
+// handleChanges streams the change events of the space as newline delimited json until the client goes away.
+func (router *Router) handleChanges(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Select)
+	_, space, _, _ := router.getParams(params, false)
+	since := request.URL.Query().Get("since")
+	fromNow := since == "now"
+	var tokens map[metapb.PartitionID]uint64
+	if !fromNow {
+		var err error
+		if tokens, err = parseChangeTokens(since); err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+	}
+	partitions := space.GetPartitions()
+
+	ctx, cancel := context.WithCancel(request.Context())
+	defer cancel()
+	events := make(chan *ChangeEvent, 64)
+	for _, partition := range partitions {
+		go partition.WatchChanges(ctx, tokens[partition.meta.ID], fromNow, events)
+	}
+
+	writer.Header().Set("Content-Type", "application/x-ndjson")
+	writer.WriteHeader(http.StatusOK)
+	flusher, _ := writer.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			line, err := json.Marshal(event)
+			if err != nil {
+				log.Error("marshal change event of partition[%d] failed: %s", event.Partition, err.Error())
+				continue
+			}
+			if _, err := writer.Write(append(line, '\n')); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// handleAnalyze returns the tokens the analysis of the space makes of the text, the registered
+// analysis without the space.
+func (router *Router) handleAnalyze(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	var space *Space
+	if params.ByName("space") != "" {
+		router.checkAccess(request, params.ByName("db"), params.ByName("space"), auth.Select)
+		_, space, _, _ = router.getParams(params, false)
+	}
+	var analyzeReq engine.AnalyzeRequest
+	if err := json.Unmarshal(router.readDocBody(request), &analyzeReq); err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	result, err := analyze(space, &analyzeReq)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), result})
+}
+
+func (router *Router) getBlobParams(params netutil.UriParams) (*Partition, string) {
+	_, space, _, _ := router.getParams(params, false)
+	id := params.ByName("id")
+	if space.meta.Type != metapb.ST_BLOB || id == "" {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	return space.GetPartition(keySlot(id)), id
+}
+
+// parseRange parses a single range "bytes=start-end" or "bytes=start-", other forms are
+// ignored and the whole blob is returned as http allows.
+func parseRange(value string) (offset, length uint64, ok bool) {
+	if !strings.HasPrefix(value, "bytes=") || strings.Contains(value, ",") {
+		return 0, 0, false
+	}
+	pos := strings.IndexByte(value, '-')
+	if pos < 0 {
+		return 0, 0, false
+	}
+	start, end := strings.TrimSpace(value[len("bytes="):pos]), strings.TrimSpace(value[pos+1:])
+	offset, err := strconv.ParseUint(start, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if end == "" {
+		return offset, 0, true
+	}
+	last, err := strconv.ParseUint(end, 10, 64)
+	if err != nil || last < offset {
+		return 0, 0, false
+	}
+	return offset, last - offset + 1, true
+}
+
 func (router *Router) getParams(params netutil.UriParams, decodeDocId bool) (db *DB, space *Space, partition *Partition, docId *metapb.DocID) {
 	defer func() {
 		if p := recover(); p != nil {
@@ -0,0 +1,29 @@
+package router
+
+import "testing"
+
+func TestParseRange(t *testing.T) {
+	cases := []struct {
+		value          string
+		offset, length uint64
+		ok             bool
+	}{
+		{"bytes=0-99", 0, 100, true},
+		{"bytes=100-100", 100, 1, true},
+		{"bytes=5-", 5, 0, true},
+		{"bytes= 5 - 9 ", 5, 5, true},
+		{"bytes=9-5", 0, 0, false},
+		{"bytes=-500", 0, 0, false},
+		{"bytes=0-1,4-5", 0, 0, false},
+		{"bytes=a-b", 0, 0, false},
+		{"bytes=0", 0, 0, false},
+		{"items=0-1", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, c := range cases {
+		offset, length, ok := parseRange(c.value)
+		if offset != c.offset || length != c.length || ok != c.ok {
+			t.Fatalf("%q: expect %d, %d, %v, got %d, %d, %v", c.value, c.offset, c.length, c.ok, offset, length, ok)
+		}
+	}
+}
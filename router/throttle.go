@@ -0,0 +1,87 @@
+package router
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+// tokenBucket limits the writes per second of a db or a space on this router. The bucket holds up
+// to one second of writes, so a burst after a quiet second passes.
+type tokenBucket struct {
+	lock   sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns nil for a zero rate, a nil bucket takes everything.
+func newTokenBucket(qps uint32) *tokenBucket {
+	if qps == 0 {
+		return nil
+	}
+	return &tokenBucket{rate: float64(qps), tokens: float64(qps), last: time.Now()}
+}
+
+func newWriteLimit(quota *metapb.Quota) *tokenBucket {
+	if quota == nil {
+		return nil
+	}
+	return newTokenBucket(quota.WriteQps)
+}
+
+// take takes a token for each of the n writes, it takes none and returns false when the bucket
+// holds less than n. A bulk larger than the bucket passes on a full bucket and leaves it in debt.
+func (b *tokenBucket) take(n int) bool {
+	if b == nil {
+		return true
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+
+	need := float64(n)
+	if need > b.rate {
+		need = b.rate
+	}
+	if b.tokens < need {
+		return false
+	}
+	b.tokens -= float64(n)
+	return true
+}
+
+// giveBack returns the tokens of the writes another limit rejected.
+func (b *tokenBucket) giveBack(n int) {
+	if b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens += float64(n)
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
+
+// throttleWrites takes the n writes from the write quotas of the space and of its db, it panics
+// with a throttled reply when one of them is used up. Each router applies the quotas on its own.
+func (space *Space) throttleWrites(n int) {
+	if !space.writeLimit.take(n) {
+		panic(&HttpReply{ERRCODE_WRITE_THROTTLED, ErrWriteThrottled.Error(), nil})
+	}
+	if !space.parent.writeLimit.take(n) {
+		space.writeLimit.giveBack(n)
+		panic(&HttpReply{ERRCODE_WRITE_THROTTLED, ErrWriteThrottled.Error(), nil})
+	}
+}
@@ -0,0 +1,78 @@
+package router
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+func TestTokenBucket(t *testing.T) {
+	if newWriteLimit(nil) != nil || newWriteLimit(&metapb.Quota{StorageBytes: 100}) != nil {
+		t.Fatal("a quota without write qps limits the writes")
+	}
+	var unlimited *tokenBucket
+	if !unlimited.take(1000) {
+		t.Fatal("the writes without limit are throttled")
+	}
+
+	b := newWriteLimit(&metapb.Quota{WriteQps: 10})
+	if !b.take(6) || b.take(6) || !b.take(4) {
+		t.Fatal("the bucket does not hold one second of writes")
+	}
+	b.giveBack(3)
+	if !b.take(3) || b.take(1) {
+		t.Fatal("the tokens given back are not taken again")
+	}
+
+	// the bucket refills at the rate, up to one second of writes
+	b.last = time.Now().Add(-500 * time.Millisecond)
+	if !b.take(5) || b.take(1) {
+		t.Fatal("the bucket does not refill at the rate")
+	}
+	b.last = time.Now().Add(-time.Hour)
+	if !b.take(10) || b.take(1) {
+		t.Fatal("the bucket refills over one second of writes")
+	}
+
+	// a bulk larger than the bucket passes on a full bucket and leaves it in debt
+	b.last = time.Now().Add(-time.Second)
+	if !b.take(15) || b.tokens != -5 {
+		t.Fatalf("the large bulk on a full bucket leaves %v tokens", b.tokens)
+	}
+	b.last = time.Now().Add(-time.Second)
+	if b.take(6) {
+		t.Fatal("the bucket in debt takes more than it refilled")
+	}
+}
+
+// throttled reports whether the writes are throttled by the quotas of the space or of its db.
+func throttled(space *Space, n int) (throttled bool) {
+	defer func() {
+		if e := recover(); e != nil {
+			reply, ok := e.(*HttpReply)
+			throttled = ok && reply.Code == ERRCODE_WRITE_THROTTLED
+		}
+	}()
+	space.throttleWrites(n)
+	return false
+}
+
+func TestThrottleWrites(t *testing.T) {
+	db := &DB{writeLimit: newTokenBucket(10)}
+	space := &Space{parent: db, writeLimit: newTokenBucket(5)}
+	other := &Space{parent: db}
+
+	if throttled(space, 4) || !throttled(space, 2) {
+		t.Fatal("the writes are not throttled by the quota of the space")
+	}
+	// the writes of all the spaces count to the quota of the db
+	if throttled(other, 6) || !throttled(other, 1) {
+		t.Fatal("the writes are not throttled by the quota of the db")
+	}
+	// the tokens of the space are given back when the db throttles
+	space.writeLimit.giveBack(5)
+	if !throttled(space, 5) || space.writeLimit.tokens != 5 {
+		t.Fatalf("the space keeps %v tokens after the db throttled", space.writeLimit.tokens)
+	}
+}
@@ -0,0 +1,318 @@
+package txn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/uuid"
+)
+
+// Transactions across partitions, two phases coordinated by the router
+//
+// 1, the record of the transaction is created pending in the record space, keyed by its uuid
+// 2, the writes are laid as intents on their documents, an atomic bulk per partition
+// 3, the record is moved to committed, this is the commit point
+// 4, the intents are resolved, the writes are applied and the record is deleted
+//
+// The coordinator may die at any step. A reader hitting an intent finishes the transaction from
+// its record: a committed one is resolved, a pending one past its deadline is aborted, and so is
+// one without a record. Only the record decides, so all the writes commit or none does.
+
+var (
+	ErrAborted       = errors.New("txn: transaction aborted")
+	ErrConflict      = errors.New("txn: document is locked by another transaction")
+	ErrCommitUnknown = errors.New("txn: commit status unknown, the transaction is finished by its readers")
+	ErrEmpty         = errors.New("txn: no writes")
+)
+
+const defaultTTL = 10 * time.Second
+
+// Write is one write of a transaction, a create, update or delete request on a document of the space.
+type Write struct {
+	Space   string
+	Request pspb.RequestUnion
+}
+
+// Client is how the coordinator reaches the partitions, the router implements it over its routes.
+type Client interface {
+	// PartitionOf returns the partition holding the document of the space.
+	PartitionOf(space string, id metapb.Key) (metapb.PartitionID, error)
+	// Bulk writes the requests to the partition of the space in one raft command.
+	Bulk(ctx context.Context, space string, partition metapb.PartitionID, requests []pspb.RequestUnion, atomic bool) ([]pspb.ResponseUnion, error)
+	// Get reads the committed document with the intent on it.
+	Get(ctx context.Context, space string, id metapb.Key) (*pspb.GetResponse, error)
+}
+
+type Options struct {
+	// the space of type ST_TXN keeping the transaction records
+	RecordSpace string
+	// a pending transaction older than this may be aborted by its readers
+	TTL time.Duration
+}
+
+type Coordinator struct {
+	client Client
+	opts   Options
+}
+
+func NewCoordinator(client Client, opts Options) *Coordinator {
+	if opts.TTL <= 0 {
+		opts.TTL = defaultTTL
+	}
+	return &Coordinator{client: client, opts: opts}
+}
+
+// the writes of a transaction to one partition
+type partitionWrites struct {
+	space     string
+	partition metapb.PartitionID
+	ids       []metapb.Key
+	requests  []pspb.RequestUnion
+}
+
+// Commit runs the writes as one transaction and returns its id. Unless the error is
+// ErrCommitUnknown, the transaction is committed if and only if the error is nil.
+func (c *Coordinator) Commit(ctx context.Context, writes []Write) (string, error) {
+	if len(writes) == 0 {
+		return "", ErrEmpty
+	}
+	txnID := uuid.FlakeUUID()
+	record := pspb.TxnRecord{TxnID: txnID, Deadline: time.Now().Add(c.opts.TTL).UnixNano()}
+	groups := make(map[groupKey]*partitionWrites)
+	for _, w := range writes {
+		key := pspb.TxnKey{Space: w.Space, ID: writeID(&w.Request)}
+		group, added, err := c.addKey(groups, key)
+		if err != nil {
+			return txnID, err
+		}
+		if !added {
+			return txnID, fmt.Errorf("txn: document[%s/%s] is written twice", key.Space, key.ID)
+		}
+		record.Keys = append(record.Keys, key)
+		group.requests = append(group.requests, pspb.RequestUnion{
+			OpType:     pspb.OpType_TXN_PREPARE,
+			TxnPrepare: &pspb.TxnPrepareRequest{Intent: pspb.TxnIntent{TxnID: txnID, Write: w.Request}},
+		})
+	}
+
+	if _, err := c.writeRecord(ctx, pspb.OpType_TXN_RECORD_PUT, &pspb.TxnRecordRequest{Record: record}); err != nil {
+		return txnID, err
+	}
+
+	if err := c.prepare(ctx, groups); err != nil {
+		// abort before anyone could commit it, the readers finish it if the abort fails
+		record.Status = pspb.TxnStatus_TXN_ABORTED
+		if resp, e := c.writeRecord(ctx, pspb.OpType_TXN_RECORD_FINISH, &pspb.TxnRecordRequest{Record: record}); e == nil {
+			c.finish(ctx, &resp.Record, groups)
+		}
+		return txnID, err
+	}
+
+	record.Status = pspb.TxnStatus_TXN_COMMITTED
+	resp, err := c.writeRecord(ctx, pspb.OpType_TXN_RECORD_FINISH, &pspb.TxnRecordRequest{Record: record})
+	if err != nil {
+		log.Error("commit txn[%s] failed: %v", txnID, err)
+		return txnID, ErrCommitUnknown
+	}
+	c.finish(ctx, &resp.Record, groups)
+	if resp.Record.Status != pspb.TxnStatus_TXN_COMMITTED {
+		// aborted by a reader after the deadline
+		return txnID, ErrAborted
+	}
+	return txnID, nil
+}
+
+// Get reads the committed document. An intent on it is resolved if its transaction is finished,
+// or aborted if the transaction is past its deadline, the intent of a live one is skipped.
+func (c *Coordinator) Get(ctx context.Context, space string, id metapb.Key) (*pspb.GetResponse, error) {
+	resp, err := c.client.Get(ctx, space, id)
+	if err != nil || resp.Intent == nil {
+		return resp, err
+	}
+
+	record := pspb.TxnRecord{TxnID: resp.Intent.TxnID, Status: pspb.TxnStatus_TXN_ABORTED}
+	recordResp, err := c.writeRecord(ctx, pspb.OpType_TXN_RECORD_FINISH, &pspb.TxnRecordRequest{Record: record, Now: time.Now().UnixNano()})
+	if err != nil {
+		return nil, err
+	}
+	if recordResp.Record.Status == pspb.TxnStatus_TXN_PENDING {
+		return resp, nil
+	}
+
+	log.Info("finish txn[%s] found on document[%s/%s] as %s", record.TxnID, space, id, recordResp.Record.Status)
+	finished := recordResp.Record
+	// the record may be gone, the document read has the intent anyway
+	finished.Keys = append(finished.Keys, pspb.TxnKey{Space: space, ID: id})
+	groups, err := c.groupKeys(finished.Keys)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.finish(ctx, &finished, groups); err != nil {
+		return nil, err
+	}
+	return c.client.Get(ctx, space, id)
+}
+
+// prepare lays the intents on all the partitions, an intent of a finished or expired transaction
+// in the way is cleared once.
+func (c *Coordinator) prepare(ctx context.Context, groups map[groupKey]*partitionWrites) error {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		first error
+	)
+	for _, group := range groups {
+		wg.Add(1)
+		go func(group *partitionWrites) {
+			defer wg.Done()
+			err := c.preparePartition(ctx, group)
+			if err == ErrConflict {
+				err = c.preparePartition(ctx, group)
+			}
+			if err != nil {
+				mu.Lock()
+				if first == nil {
+					first = err
+				}
+				mu.Unlock()
+			}
+		}(group)
+	}
+	wg.Wait()
+	return first
+}
+
+func (c *Coordinator) preparePartition(ctx context.Context, group *partitionWrites) error {
+	responses, err := c.client.Bulk(ctx, group.space, group.partition, group.requests, true)
+	if err != nil {
+		return err
+	}
+	for i := range responses {
+		resp := &responses[i]
+		if resp.Failure == nil || resp.Failure.Aborted {
+			continue
+		}
+		if resp.TxnPrepare != nil && resp.TxnPrepare.Conflict != nil {
+			// clear the intent if its transaction is done, the caller retries
+			if _, err := c.Get(ctx, group.space, resp.TxnPrepare.ID); err != nil {
+				return err
+			}
+			return ErrConflict
+		}
+		return fmt.Errorf("txn: write on document[%s/%s] failed: %s", group.space, group.ids[i], resp.Failure.Cause)
+	}
+	return nil
+}
+
+// finish resolves the intents of a committed or aborted transaction and deletes its record
+// once all of them are resolved.
+func (c *Coordinator) finish(ctx context.Context, record *pspb.TxnRecord, groups map[groupKey]*partitionWrites) error {
+	commit := record.Status == pspb.TxnStatus_TXN_COMMITTED
+	var first error
+	for _, group := range groups {
+		requests := make([]pspb.RequestUnion, 0, len(group.ids))
+		for _, id := range group.ids {
+			requests = append(requests, pspb.RequestUnion{
+				OpType:     pspb.OpType_TXN_RESOLVE,
+				TxnResolve: &pspb.TxnResolveRequest{ID: id, TxnID: record.TxnID, Commit: commit},
+			})
+		}
+		responses, err := c.client.Bulk(ctx, group.space, group.partition, requests, false)
+		if err == nil {
+			for i := range responses {
+				if responses[i].Failure != nil {
+					err = errors.New(responses[i].Failure.Cause)
+					break
+				}
+			}
+		}
+		if err != nil {
+			log.Error("resolve intents of txn[%s] on partition[%d] failed: %v", record.TxnID, group.partition, err)
+			if first == nil {
+				first = err
+			}
+		}
+	}
+	if first != nil {
+		return first
+	}
+
+	_, err := c.writeRecord(ctx, pspb.OpType_TXN_RECORD_DELETE, &pspb.TxnRecordRequest{Record: pspb.TxnRecord{TxnID: record.TxnID}})
+	return err
+}
+
+func (c *Coordinator) writeRecord(ctx context.Context, op pspb.OpType, request *pspb.TxnRecordRequest) (*pspb.TxnRecordResponse, error) {
+	id := metapb.Key(request.Record.TxnID)
+	partition, err := c.client.PartitionOf(c.opts.RecordSpace, id)
+	if err != nil {
+		return nil, err
+	}
+	responses, err := c.client.Bulk(ctx, c.opts.RecordSpace, partition, []pspb.RequestUnion{{OpType: op, TxnRecord: request}}, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) != 1 {
+		return nil, errors.New("txn: bad response count of record write")
+	}
+	if responses[0].Failure != nil {
+		return nil, fmt.Errorf("txn: %s record of txn[%s] failed: %s", op, request.Record.TxnID, responses[0].Failure.Cause)
+	}
+	return responses[0].TxnRecord, nil
+}
+
+type groupKey struct {
+	space     string
+	partition metapb.PartitionID
+}
+
+func (c *Coordinator) groupKeys(keys []pspb.TxnKey) (map[groupKey]*partitionWrites, error) {
+	groups := make(map[groupKey]*partitionWrites)
+	for _, key := range keys {
+		if _, _, err := c.addKey(groups, key); err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+// addKey adds the document to the writes of its partition, added is false if it is there already.
+func (c *Coordinator) addKey(groups map[groupKey]*partitionWrites, key pspb.TxnKey) (group *partitionWrites, added bool, err error) {
+	if len(key.ID) == 0 {
+		return nil, false, errors.New("txn: write without document id")
+	}
+	partition, err := c.client.PartitionOf(key.Space, key.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	gk := groupKey{key.Space, partition}
+	group, ok := groups[gk]
+	if !ok {
+		group = &partitionWrites{space: key.Space, partition: partition}
+		groups[gk] = group
+	}
+	for _, id := range group.ids {
+		if string(id) == string(key.ID) {
+			return group, false, nil
+		}
+	}
+	group.ids = append(group.ids, key.ID)
+	return group, true, nil
+}
+
+func writeID(write *pspb.RequestUnion) metapb.Key {
+	switch {
+	case write.Create != nil:
+		return write.Create.ID
+	case write.Update != nil:
+		return write.Update.ID
+	case write.Delete != nil:
+		return write.Delete.ID
+	}
+	return nil
+}
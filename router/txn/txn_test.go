@@ -0,0 +1,399 @@
+package txn
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+const (
+	docSpace = "doc"
+	txnSpace = "txn"
+)
+
+var (
+	errCrashed = errors.New("coordinator crashed")
+	errFailed  = errors.New("write failed")
+)
+
+// memPartition keeps the documents, intents and records of a partition as the ps applies them.
+type memPartition struct {
+	id      metapb.PartitionID
+	docs    map[string]metapb.Value
+	intents map[string]pspb.TxnIntent
+	records map[string]pspb.TxnRecord
+}
+
+func newMemPartition(id metapb.PartitionID) *memPartition {
+	return &memPartition{
+		id:      id,
+		docs:    make(map[string]metapb.Value),
+		intents: make(map[string]pspb.TxnIntent),
+		records: make(map[string]pspb.TxnRecord),
+	}
+}
+
+func (p *memPartition) clone() *memPartition {
+	c := newMemPartition(p.id)
+	for k, v := range p.docs {
+		c.docs[k] = v
+	}
+	for k, v := range p.intents {
+		c.intents[k] = v
+	}
+	for k, v := range p.records {
+		c.records[k] = v
+	}
+	return c
+}
+
+// write applies a create, update or delete, the intent check is left to the caller.
+func (p *memPartition) write(request *pspb.RequestUnion, resp *pspb.ResponseUnion) error {
+	id := string(writeID(request))
+	_, exists := p.docs[id]
+	if cond := request.Precondition; cond != nil && (cond.Exists && !exists || cond.NotExists && exists) {
+		return errFailed
+	}
+	switch request.OpType {
+	case pspb.OpType_CREATE:
+		p.docs[id] = request.Create.Data
+		resp.Create = &pspb.CreateResponse{ID: request.Create.ID, Result: pspb.WriteResult_CREATED}
+	case pspb.OpType_UPDATE:
+		if !exists && !request.Update.Upsert {
+			return errFailed
+		}
+		p.docs[id] = request.Update.Data
+		resp.Update = &pspb.UpdateResponse{ID: request.Update.ID, Result: pspb.WriteResult_UPDATED}
+	case pspb.OpType_DELETE:
+		delete(p.docs, id)
+		resp.Delete = &pspb.DeleteResponse{ID: request.Delete.ID, Result: pspb.WriteResult_DELETED}
+	default:
+		return errFailed
+	}
+	return nil
+}
+
+func (p *memPartition) apply(request *pspb.RequestUnion) (resp pspb.ResponseUnion, err error) {
+	resp.OpType = request.OpType
+	switch request.OpType {
+	case pspb.OpType_TXN_RECORD_PUT:
+		record := request.TxnRecord.Record
+		if _, ok := p.records[record.TxnID]; ok {
+			return resp, errFailed
+		}
+		record.Status = pspb.TxnStatus_TXN_PENDING
+		p.records[record.TxnID] = record
+		resp.TxnRecord = &pspb.TxnRecordResponse{Result: pspb.WriteResult_CREATED, Record: record}
+	case pspb.OpType_TXN_RECORD_FINISH:
+		txnID := request.TxnRecord.Record.TxnID
+		record, ok := p.records[txnID]
+		if !ok {
+			resp.TxnRecord = &pspb.TxnRecordResponse{Result: pspb.WriteResult_NOT_FOUND,
+				Record: pspb.TxnRecord{TxnID: txnID, Status: pspb.TxnStatus_TXN_ABORTED}}
+			break
+		}
+		resp.TxnRecord = &pspb.TxnRecordResponse{Result: pspb.WriteResult_NOOP, Record: record}
+		now := request.TxnRecord.Now
+		if record.Status != pspb.TxnStatus_TXN_PENDING ||
+			request.TxnRecord.Record.Status == pspb.TxnStatus_TXN_ABORTED && now != 0 && record.Deadline >= now {
+			break
+		}
+		record.Status = request.TxnRecord.Record.Status
+		p.records[txnID] = record
+		resp.TxnRecord = &pspb.TxnRecordResponse{Result: pspb.WriteResult_UPDATED, Record: record}
+	case pspb.OpType_TXN_RECORD_DELETE:
+		txnID := request.TxnRecord.Record.TxnID
+		if record, ok := p.records[txnID]; ok && record.Status == pspb.TxnStatus_TXN_PENDING {
+			return resp, errFailed
+		}
+		delete(p.records, txnID)
+		resp.TxnRecord = &pspb.TxnRecordResponse{Result: pspb.WriteResult_DELETED, Record: request.TxnRecord.Record}
+	case pspb.OpType_TXN_PREPARE:
+		intent := request.TxnPrepare.Intent
+		id := writeID(&intent.Write)
+		resp.TxnPrepare = &pspb.TxnPrepareResponse{ID: id, Result: pspb.WriteResult_CREATED}
+		if old, ok := p.intents[string(id)]; ok {
+			if old.TxnID == intent.TxnID {
+				resp.TxnPrepare.Result = pspb.WriteResult_NOOP
+				break
+			}
+			resp.TxnPrepare.Conflict = &old
+			return resp, errFailed
+		}
+		// the precondition is checked on a copy, the write waits for the resolve
+		var writeResp pspb.ResponseUnion
+		if err := p.clone().write(&intent.Write, &writeResp); err != nil {
+			return resp, err
+		}
+		p.intents[string(id)] = intent
+	case pspb.OpType_TXN_RESOLVE:
+		resolve := request.TxnResolve
+		resp.TxnResolve = &pspb.TxnResolveResponse{ID: resolve.ID, Result: pspb.WriteResult_NOOP}
+		intent, ok := p.intents[string(resolve.ID)]
+		if !ok || intent.TxnID != resolve.TxnID {
+			break
+		}
+		delete(p.intents, string(resolve.ID))
+		resp.TxnResolve.Result = pspb.WriteResult_DELETED
+		if resolve.Commit {
+			write := intent.Write
+			write.Precondition = nil
+			var writeResp pspb.ResponseUnion
+			if err := p.write(&write, &writeResp); err != nil {
+				return resp, err
+			}
+			resp.TxnResolve.Result = pspb.WriteResult_UPDATED
+		}
+	default:
+		if _, ok := p.intents[string(writeID(request))]; ok {
+			return resp, errFailed
+		}
+		return resp, p.write(request, &resp)
+	}
+	return resp, nil
+}
+
+// memClient places a document on the partition of its first byte, the doc space has two
+// partitions so that "a" and "b" live apart.
+type memClient struct {
+	sync.Mutex
+	spaces map[string][]*memPartition
+	// crash fails the matching writes, as if the coordinator died before sending them
+	crash func(requests []pspb.RequestUnion) bool
+}
+
+func newMemClient() *memClient {
+	return &memClient{spaces: map[string][]*memPartition{
+		docSpace: {newMemPartition(1), newMemPartition(2)},
+		txnSpace: {newMemPartition(3)},
+	}}
+}
+
+func (c *memClient) partition(space string, id metapb.Key) *memPartition {
+	partitions := c.spaces[space]
+	return partitions[int(id[0])%len(partitions)]
+}
+
+func (c *memClient) PartitionOf(space string, id metapb.Key) (metapb.PartitionID, error) {
+	return c.partition(space, id).id, nil
+}
+
+func (c *memClient) Bulk(ctx context.Context, space string, partitionID metapb.PartitionID, requests []pspb.RequestUnion, atomic bool) ([]pspb.ResponseUnion, error) {
+	c.Lock()
+	defer c.Unlock()
+	if c.crash != nil && c.crash(requests) {
+		return nil, errCrashed
+	}
+	for i, p := range c.spaces[space] {
+		if p.id != partitionID {
+			continue
+		}
+		target := p
+		if atomic {
+			target = p.clone()
+		}
+		responses := make([]pspb.ResponseUnion, len(requests))
+		failed := false
+		for j := range requests {
+			resp, err := target.apply(&requests[j])
+			if err != nil {
+				resp.Failure = &pspb.Failure{ID: writeID(&requests[j]), Cause: err.Error()}
+				failed = true
+			}
+			responses[j] = resp
+		}
+		if atomic && failed {
+			for j := range responses {
+				if responses[j].Failure == nil {
+					responses[j].Failure = &pspb.Failure{Cause: "aborted", Aborted: true}
+				}
+			}
+		} else if atomic {
+			c.spaces[space][i] = target
+		}
+		return responses, nil
+	}
+	return nil, &metapb.PartitionNotFound{PartitionID: partitionID}
+}
+
+func (c *memClient) Get(ctx context.Context, space string, id metapb.Key) (*pspb.GetResponse, error) {
+	c.Lock()
+	defer c.Unlock()
+	p := c.partition(space, id)
+	resp := new(pspb.GetResponse)
+	if intent, ok := p.intents[string(id)]; ok {
+		resp.Intent = &intent
+	}
+	resp.Data, resp.Found = p.docs[string(id)]
+	return resp, nil
+}
+
+func (c *memClient) crashAt(op pspb.OpType) {
+	c.Lock()
+	c.crash = func(requests []pspb.RequestUnion) bool { return requests[0].OpType == op }
+	c.Unlock()
+}
+
+func (c *memClient) recover() {
+	c.Lock()
+	c.crash = nil
+	c.Unlock()
+}
+
+func (c *memClient) checkDoc(t *testing.T, id string, exists bool) {
+	c.Lock()
+	defer c.Unlock()
+	p := c.partition(docSpace, metapb.Key(id))
+	if intent, ok := p.intents[id]; ok {
+		t.Fatalf("intent on document[%s] not resolved: %v", id, intent)
+	}
+	if _, ok := p.docs[id]; ok != exists {
+		t.Fatalf("document[%s] exists %v, expect %v", id, ok, exists)
+	}
+}
+
+func (c *memClient) checkRecord(t *testing.T, txnID string, exists bool) {
+	c.Lock()
+	defer c.Unlock()
+	if record, ok := c.partition(txnSpace, metapb.Key(txnID)).records[txnID]; ok != exists {
+		t.Fatalf("record of txn[%s] is %v", txnID, record)
+	}
+}
+
+func createWrite(id, data string, cond *pspb.Precondition) Write {
+	return Write{Space: docSpace, Request: pspb.RequestUnion{
+		OpType:       pspb.OpType_CREATE,
+		Create:       &pspb.CreateRequest{ID: metapb.Key(id), Data: metapb.Value(data)},
+		Precondition: cond,
+	}}
+}
+
+func deleteWrite(id string, cond *pspb.Precondition) Write {
+	return Write{Space: docSpace, Request: pspb.RequestUnion{
+		OpType:       pspb.OpType_DELETE,
+		Delete:       &pspb.DeleteRequest{ID: metapb.Key(id)},
+		Precondition: cond,
+	}}
+}
+
+// the documents "a" and "b" live on different partitions
+func TestTxnCommit(t *testing.T) {
+	c := newMemClient()
+	coordinator := NewCoordinator(c, Options{RecordSpace: txnSpace})
+
+	txnID, err := coordinator.Commit(context.Background(), []Write{
+		createWrite("a", `{"name": "a"}`, nil),
+		createWrite("b", `{"name": "b"}`, nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.checkDoc(t, "a", true)
+	c.checkDoc(t, "b", true)
+	c.checkRecord(t, txnID, false)
+
+	// the precondition on d fails, so a is not deleted either
+	_, err = coordinator.Commit(context.Background(), []Write{
+		deleteWrite("a", nil),
+		createWrite("c", `{"name": "c"}`, &pspb.Precondition{NotExists: true}),
+		deleteWrite("d", &pspb.Precondition{Exists: true}),
+	})
+	if err == nil {
+		t.Fatal("transaction with a failed precondition committed")
+	}
+	c.checkDoc(t, "a", true)
+	c.checkDoc(t, "c", false)
+}
+
+func TestTxnCoordinatorCrashAfterCommit(t *testing.T) {
+	c := newMemClient()
+	coordinator := NewCoordinator(c, Options{RecordSpace: txnSpace})
+
+	// the coordinator dies after the commit point, before resolving any intent
+	c.crashAt(pspb.OpType_TXN_RESOLVE)
+	txnID, err := coordinator.Commit(context.Background(), []Write{
+		createWrite("a", `{"name": "a"}`, nil),
+		createWrite("b", `{"name": "b"}`, nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.recover()
+
+	// a reader of a finishes the transaction on both partitions
+	reader := NewCoordinator(c, Options{RecordSpace: txnSpace})
+	resp, err := reader.Get(context.Background(), docSpace, metapb.Key("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Found || resp.Intent != nil || string(resp.Data) != `{"name": "a"}` {
+		t.Fatalf("committed document not visible: %v", resp)
+	}
+	c.checkDoc(t, "a", true)
+	c.checkDoc(t, "b", true)
+	c.checkRecord(t, txnID, false)
+}
+
+func TestTxnCoordinatorCrashBeforeCommit(t *testing.T) {
+	c := newMemClient()
+	ttl := 200 * time.Millisecond
+	coordinator := NewCoordinator(c, Options{RecordSpace: txnSpace, TTL: ttl})
+
+	// the coordinator dies with the intents laid, before the commit point
+	c.crashAt(pspb.OpType_TXN_RECORD_FINISH)
+	txnID, err := coordinator.Commit(context.Background(), []Write{
+		createWrite("a", `{"name": "a"}`, nil),
+		createWrite("b", `{"name": "b"}`, nil),
+	})
+	if err != ErrCommitUnknown {
+		t.Fatalf("unexpected commit error: %v", err)
+	}
+	c.recover()
+
+	reader := NewCoordinator(c, Options{RecordSpace: txnSpace, TTL: ttl})
+	// the transaction is alive, the reader sees the committed document and the intent stays
+	resp, err := reader.Get(context.Background(), docSpace, metapb.Key("b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Found || resp.Intent == nil {
+		t.Fatalf("pending write visible: %v", resp)
+	}
+	// the intent keeps the others from the document
+	if _, err := reader.Commit(context.Background(), []Write{createWrite("b", `{"name": "b2"}`, nil)}); err != ErrConflict {
+		t.Fatalf("write on a locked document: %v", err)
+	}
+	partition, _ := c.PartitionOf(docSpace, metapb.Key("b"))
+	bulkResp, err := c.Bulk(context.Background(), docSpace, partition, []pspb.RequestUnion{createWrite("b", `{"name": "b3"}`, nil).Request}, false)
+	if err != nil || bulkResp[0].Failure == nil {
+		t.Fatalf("bulk write on a locked document: %v, %v", bulkResp, err)
+	}
+
+	// past the deadline the reader aborts it on both partitions
+	time.Sleep(ttl + 50*time.Millisecond)
+	resp, err = reader.Get(context.Background(), docSpace, metapb.Key("b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Found || resp.Intent != nil {
+		t.Fatalf("aborted write visible: %v", resp)
+	}
+	c.checkDoc(t, "a", false)
+	c.checkDoc(t, "b", false)
+	c.checkRecord(t, txnID, false)
+
+	// the documents are free again
+	if _, err := reader.Commit(context.Background(), []Write{
+		createWrite("a", `{"name": "a"}`, nil),
+		createWrite("b", `{"name": "b"}`, nil),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	c.checkDoc(t, "a", true)
+	c.checkDoc(t, "b", true)
+}
@@ -0,0 +1,184 @@
+package topo
+
+import (
+	"context"
+	"github.com/golang/protobuf/proto"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+	"path"
+	"strings"
+)
+
+type DictTopo struct {
+	Version Version
+	*metapb.Dictionary
+}
+
+type DictWatchData struct {
+	*DictTopo
+	Err error
+}
+
+func (s *TopoServer) GetAllDicts(ctx context.Context) ([]*DictTopo, error) {
+	if ctx == nil {
+		return nil, ErrNoNode
+	}
+
+	names, _, err := s.backend.ListDir(ctx, GlobalZone, dictsPath)
+	if err != nil {
+		return nil, err
+	}
+	if names == nil || len(names) == 0 {
+		return nil, nil
+	}
+
+	dicts := make([]*DictTopo, 0, len(names))
+	for _, name := range names {
+		dict, err := s.GetDict(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		dicts = append(dicts, dict)
+	}
+
+	return dicts, nil
+}
+
+func (s *TopoServer) GetDict(ctx context.Context, name string) (*DictTopo, error) {
+	if ctx == nil || len(name) == 0 {
+		return nil, ErrNoNode
+	}
+
+	contents, version, err := s.backend.Get(ctx, GlobalZone, path.Join(dictsPath, name, DictTopoFile))
+	if err != nil {
+		return nil, err
+	}
+
+	dictMeta := &metapb.Dictionary{}
+	if err := proto.Unmarshal(contents, dictMeta); err != nil {
+		log.Error("Fail to unmarshal meta data for dict[%s]. err[%v]", name, err)
+		return nil, err
+	}
+
+	return &DictTopo{Version: version, Dictionary: dictMeta}, nil
+}
+
+func (s *TopoServer) AddDict(ctx context.Context, dict *metapb.Dictionary) (*DictTopo, error) {
+	if ctx == nil || dict == nil {
+		return nil, ErrNoNode
+	}
+
+	contents, err := proto.Marshal(dict)
+	if err != nil {
+		log.Error("Fail to marshal meta data for dict[%s]. err[%v]", dict.Name, err)
+		return nil, err
+	}
+
+	version, err := s.backend.Create(ctx, GlobalZone, path.Join(dictsPath, dict.Name, DictTopoFile), contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DictTopo{Version: version, Dictionary: dict}, nil
+}
+
+func (s *TopoServer) UpdateDict(ctx context.Context, dict *DictTopo) error {
+	if ctx == nil || dict == nil {
+		return ErrNoNode
+	}
+
+	contents, err := proto.Marshal(dict.Dictionary)
+	if err != nil {
+		log.Error("Fail to marshal meta data for dict[%s]. err[%v]", dict.Name, err)
+		return err
+	}
+
+	newVersion, err := s.backend.Update(ctx, GlobalZone, path.Join(dictsPath, dict.Name, DictTopoFile),
+		contents, dict.Version)
+	if err != nil {
+		return err
+	}
+	dict.Version = newVersion
+
+	return nil
+}
+
+func (s *TopoServer) DeleteDict(ctx context.Context, dict *DictTopo) error {
+	if ctx == nil || dict == nil {
+		return ErrNoNode
+	}
+
+	return s.backend.Delete(ctx, GlobalZone, path.Join(dictsPath, dict.Name, DictTopoFile), dict.Version)
+}
+
+// get current children and watch dicts
+// []*DictTopo : initial children dicts returned
+// error       : error returned when first watching
+func (s *TopoServer) WatchDicts(ctx context.Context) (error, []*DictTopo, <-chan *DictWatchData, CancelFunc) {
+	if ctx == nil {
+		return ErrNoNode, nil, nil, nil
+	}
+
+	dirPath := path.Join(dictsPath) + "/"
+	names, dirVersion, err := s.backend.ListDir(ctx, GlobalZone, dirPath)
+	if err != nil && err != ErrNoNode {
+		return err, nil, nil, nil
+	}
+
+	var dicts []*DictTopo
+	if err != ErrNoNode && len(names) != 0 {
+		dicts = make([]*DictTopo, 0, len(names))
+		for _, name := range names {
+			dict, err := s.GetDict(ctx, name)
+			if err != nil {
+				return err, nil, nil, nil
+			}
+			dicts = append(dicts, dict)
+		}
+	}
+
+	wdChannel, cancel, err := s.backend.WatchDir(ctx, GlobalZone, dirPath, dirVersion)
+	if err != nil {
+		return err, nil, nil, nil
+	}
+
+	changes := make(chan *DictWatchData, 10)
+
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil && wd.Err != ErrNoNode {
+				changes <- &DictWatchData{Err: wd.Err}
+				return
+			}
+
+			if wd.Err == ErrNoNode { // node deleted
+				keyDel := string(wd.Contents)
+				segs := strings.Split(keyDel, "/")
+				if len(segs) < 3 {
+					changes <- &DictWatchData{Err: ErrInvalidPath}
+					return
+				}
+
+				value := &metapb.Dictionary{Name: segs[1]}
+				changes <- &DictWatchData{Err: ErrNoNode, DictTopo: &DictTopo{Dictionary: value, Version: wd.Version}}
+
+			} else { // node added or updated
+				value := &metapb.Dictionary{}
+				if err := proto.Unmarshal(wd.Contents, value); err != nil {
+					log.Error("Fail to unmarshal meta data for dict from watch. err[%v]", err)
+					cancel()
+					for range wdChannel {
+					}
+					changes <- &DictWatchData{Err: err}
+					return
+				}
+
+				changes <- &DictWatchData{DictTopo: &DictTopo{Dictionary: value, Version: wd.Version}}
+			}
+		}
+	}()
+
+	return nil, dicts, changes, cancel
+}
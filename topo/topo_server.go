@@ -18,6 +18,7 @@ const (
 	partitionsPath       = "partitions"
 	partitionServersPath = "servers"
 	tasksPath            = "tasks"
+	dictsPath            = "dicts"
 	membersPath          = "members"
 
 	// Filenames for all object types.
@@ -29,6 +30,7 @@ const (
 	PartitionTopoFile       = "partition_info"
 	partitionGroupTopoFile  = "partition_group_info"
 	TaskTopoFile            = "task_info"
+	DictTopoFile            = "dict_info"
 	IdGeneratorTopoFile     = "idgen"
 )
 
@@ -96,6 +98,13 @@ type Service interface {
 	DeleteSpace(ctx context.Context, space *SpaceTopo) error
 	WatchSpaces(ctx context.Context) (error, []*SpaceTopo, <-chan *SpaceWatchData, CancelFunc)
 
+	GetAllDicts(ctx context.Context) ([]*DictTopo, error)
+	GetDict(ctx context.Context, name string) (*DictTopo, error)
+	AddDict(ctx context.Context, dict *metapb.Dictionary) (*DictTopo, error)
+	UpdateDict(ctx context.Context, dict *DictTopo) error
+	DeleteDict(ctx context.Context, dict *DictTopo) error
+	WatchDicts(ctx context.Context) (error, []*DictTopo, <-chan *DictWatchData, CancelFunc)
+
 	GetAllPartitions(ctx context.Context) ([]*PartitionTopo, error)
 	GetPartition(ctx context.Context, partitionId metapb.PartitionID) (*PartitionTopo, error)
 	UpdatePartition(ctx context.Context, partition *PartitionTopo) error
@@ -0,0 +1,104 @@
+// Package dict keeps the dictionaries of the cluster in the process, the user dictionaries and the
+// stop word lists the analyzers follow by name. The masters push the versions of the dictionaries
+// to the partition servers, a version stored replaces the older one in the analyzers at once.
+package dict
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// Dict is a version of a dictionary, the words of it.
+type Dict struct {
+	Name    string
+	Version uint64
+	Words   []string
+
+	set    map[string]struct{}
+	maxLen int
+}
+
+// New returns the version of the dictionary of the words.
+func New(name string, version uint64, words []string) *Dict {
+	d := &Dict{Name: name, Version: version, Words: words, set: make(map[string]struct{}, len(words))}
+	for _, word := range words {
+		d.set[word] = struct{}{}
+		if n := utf8.RuneCountInString(word); n > d.maxLen {
+			d.maxLen = n
+		}
+	}
+	return d
+}
+
+// Contains reports whether the word is in the dictionary.
+func (d *Dict) Contains(word string) bool {
+	_, ok := d.set[word]
+	return ok
+}
+
+// MaxLen returns the length in runes of the longest word.
+func (d *Dict) MaxLen() int {
+	return d.maxLen
+}
+
+var (
+	lock     sync.RWMutex
+	dicts    = make(map[string]*Dict)
+	watchers = make(map[string][]func(*Dict))
+	// the watchers are called in the order of the versions stored
+	notifyLock sync.Mutex
+)
+
+// Store stores the version of the dictionary and calls the watchers of it, a version older than
+// the stored one or the same is ignored.
+func Store(d *Dict) bool {
+	lock.Lock()
+	if old, ok := dicts[d.Name]; ok && old.Version >= d.Version {
+		lock.Unlock()
+		return false
+	}
+	dicts[d.Name] = d
+	fs := watchers[d.Name]
+	notifyLock.Lock()
+	lock.Unlock()
+
+	defer notifyLock.Unlock()
+	for _, f := range fs {
+		f(d)
+	}
+	return true
+}
+
+// Load returns the stored version of the dictionary, nil if none is stored.
+func Load(name string) *Dict {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return dicts[name]
+}
+
+// Watch calls f with the stored version of the dictionary and with each version stored later.
+func Watch(name string, f func(*Dict)) {
+	lock.Lock()
+	watchers[name] = append(watchers[name], f)
+	d := dicts[name]
+	notifyLock.Lock()
+	lock.Unlock()
+
+	defer notifyLock.Unlock()
+	if d != nil {
+		f(d)
+	}
+}
+
+// Versions returns the versions of the stored dictionaries by name.
+func Versions() map[string]uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	versions := make(map[string]uint64, len(dicts))
+	for name, d := range dicts {
+		versions[name] = d.Version
+	}
+	return versions
+}
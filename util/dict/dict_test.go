@@ -0,0 +1,32 @@
+package dict
+
+import "testing"
+
+func TestStore(t *testing.T) {
+	var versions []uint64
+	Watch("brands", func(d *Dict) {
+		versions = append(versions, d.Version)
+	})
+	if !Store(New("brands", 2, []string{"华为", "小米手机"})) {
+		t.Fatal("not stored")
+	}
+	if Store(New("brands", 1, []string{"华为"})) || Store(New("brands", 2, nil)) {
+		t.Fatal("stored an older version")
+	}
+	d := Load("brands")
+	if d.Version != 2 || !d.Contains("小米手机") || d.Contains("小米") || d.MaxLen() != 4 {
+		t.Fatalf("%+v", d)
+	}
+
+	var watched uint64
+	Watch("brands", func(d *Dict) {
+		watched = d.Version
+	})
+	Store(New("brands", 3, []string{"vivo"}))
+	if watched != 3 || len(versions) != 2 || versions[1] != 3 {
+		t.Fatalf("watched %d %v", watched, versions)
+	}
+	if Load("stop") != nil || Versions()["brands"] != 3 {
+		t.Fatal(Versions())
+	}
+}
@@ -0,0 +1,124 @@
+// Package discovery finds the leader of the masters of a zone, from the list of their addresses or
+// from the elections of the masters in the topo server.
+package discovery
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/util"
+	"github.com/tiglabs/baudengine/util/log"
+	"golang.org/x/net/context"
+)
+
+const (
+	// how long the elections are asked for the elected master
+	DISCOVERY_TIMEOUT = time.Second
+)
+
+var ErrNoMaster = errors.New("no master known")
+
+// Elections returns the id of the master elected, "" when none is. The zone masters run for the
+// elections with their rpc address, ip:port. topo.MasterParticipation implements it by the
+// elections in the topo server.
+type Elections interface {
+	GetCurrentMasterID(ctx context.Context) (string, error)
+}
+
+// Masters tracks the leader of the masters. The masters are called at the leader last known, then
+// at the master elected, then at the addresses in turn.
+type Masters struct {
+	lock   sync.Mutex
+	addrs  []string
+	next   int
+	leader string
+
+	elections Elections
+}
+
+// ParseAddrs splits the comma separated addresses of the masters.
+func ParseAddrs(addrs string) []string {
+	var result []string
+	for _, addr := range strings.Split(addrs, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			result = append(result, addr)
+		}
+	}
+	return result
+}
+
+// NewMasters tracks the masters at addrs, the elections are asked first when not nil. Only the
+// router has the elections of the zone masters, the partition servers and MyGate follow the leader
+// across the addresses only.
+func NewMasters(addrs []string, elections Elections) *Masters {
+	return &Masters{addrs: addrs, elections: elections}
+}
+
+// Leader returns the address of the master to call, "" when none is known.
+func (m *Masters) Leader() string {
+	m.lock.Lock()
+	leader := m.leader
+	m.lock.Unlock()
+	if leader != "" {
+		return leader
+	}
+
+	if m.elections != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), DISCOVERY_TIMEOUT)
+		id, err := m.elections.GetCurrentMasterID(ctx)
+		cancel()
+		if err != nil {
+			log.Warn("elections GetCurrentMasterID error. err:[%v]", err)
+		} else if _, _, err := net.SplitHostPort(id); id != "" && err != nil {
+			log.Warn("master elected [%s] is not an address, the addresses are called", id)
+		} else if id != "" {
+			m.Redirect(id)
+			return id
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.leader == "" && len(m.addrs) != 0 {
+		m.leader = m.addrs[m.next%len(m.addrs)]
+		m.next++
+	}
+	return m.leader
+}
+
+// Redirect follows the master answering it is not the leader to the leader it named.
+func (m *Masters) Redirect(leader string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.leader = leader
+}
+
+// Fail forgets the master failing a call as the leader, the next call tries another one.
+func (m *Masters) Fail(addr string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.leader == addr {
+		m.leader = ""
+	}
+}
+
+// Call calls fn at the leader until it succeeds, backing off by opt between the tries. A failed
+// try goes on at the master fn redirected to, else at the next master.
+func (m *Masters) Call(opt *util.RetryOption, fn func(addr string) error) error {
+	return util.RetryMaxAttempt(opt, func() error {
+		addr := m.Leader()
+		if addr == "" {
+			return ErrNoMaster
+		}
+		if err := fn(addr); err != nil {
+			m.Fail(addr)
+			return err
+		}
+		return nil
+	})
+}
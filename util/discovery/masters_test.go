@@ -0,0 +1,66 @@
+package discovery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tiglabs/baudengine/util"
+	"golang.org/x/net/context"
+)
+
+func TestMasters(t *testing.T) {
+	if addrs := ParseAddrs(" m1:18817, ,m2:18817,m3:18817"); len(addrs) != 3 || addrs[1] != "m2:18817" {
+		t.Fatalf("addrs: %v", addrs)
+	}
+
+	masters := NewMasters(ParseAddrs("m1,m2,m3"), nil)
+	if leader := masters.Leader(); leader != "m1" {
+		t.Fatalf("leader: %s", leader)
+	}
+	masters.Fail("m2")
+	if leader := masters.Leader(); leader != "m1" {
+		t.Fatalf("leader after a failed follower: %s", leader)
+	}
+	masters.Fail("m1")
+	if leader := masters.Leader(); leader != "m2" {
+		t.Fatalf("leader after a failed leader: %s", leader)
+	}
+
+	// m2 redirects to m3, which succeeds
+	var called []string
+	opt := util.DefaultRetryOption
+	err := masters.Call(&opt, func(addr string) error {
+		called = append(called, addr)
+		if addr == "m2" {
+			masters.Redirect("m3")
+			return errors.New("not leader")
+		}
+		return nil
+	})
+	if err != nil || len(called) != 2 || called[1] != "m3" {
+		t.Fatalf("call: %v %v", called, err)
+	}
+	if leader := masters.Leader(); leader != "m3" {
+		t.Fatalf("leader after the redirect: %s", leader)
+	}
+
+	elected := NewMasters(ParseAddrs("m1:18817,m2:18817"), elections("m2:18817"))
+	if leader := elected.Leader(); leader != "m2:18817" {
+		t.Fatalf("leader elected: %s", leader)
+	}
+	// a master elected by its node id is not dialed
+	elected = NewMasters(ParseAddrs("m1:18817,m2:18817"), elections("1"))
+	if leader := elected.Leader(); leader != "m1:18817" {
+		t.Fatalf("leader elected by node id: %s", leader)
+	}
+
+	if err := NewMasters(nil, nil).Call(&opt, func(addr string) error { return nil }); err != ErrNoMaster {
+		t.Fatalf("call without masters: %v", err)
+	}
+}
+
+type elections string
+
+func (e elections) GetCurrentMasterID(ctx context.Context) (string, error) {
+	return string(e), nil
+}
@@ -3,6 +3,7 @@ package zm
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/util"
 	"github.com/tiglabs/baudengine/util/log"
 	"github.com/tiglabs/baudengine/util/netutil"
@@ -26,6 +27,9 @@ const (
 	PARTITION_KEY   = "partition_key"
 	PARTITION_FUNC  = "partition_func"
 	PARTITION_NUM   = "partition_num"
+	PARTITION_ID    = "partition_id"
+	PS_ID           = "id"
+	DURATION        = "duration"
 )
 
 type ApiServer struct {
@@ -87,7 +91,17 @@ func (s *ApiServer) initAdminHandler() {
 	s.httpServer.Handle(netutil.GET, "/manage/space/detail", s.handleSpaceDetail)
 
 	s.httpServer.Handle(netutil.GET, "/manage/partition/list", s.handlePartitionList)
+	s.httpServer.Handle(netutil.GET, "/manage/partition/detail", s.handlePartitionDetail)
+	s.httpServer.Handle(netutil.GET, "/manage/consistency/stats", s.handleConsistencyStats)
 	s.httpServer.Handle(netutil.GET, "/manage/ps/list", s.handlePSList)
+	s.httpServer.Handle(netutil.GET, "/manage/ps/status", s.handlePSStatus)
+
+	s.httpServer.Handle(netutil.POST, "/manage/ps/decommission", s.handlePSDecommission)
+	s.httpServer.Handle(netutil.GET, "/manage/ps/decommission", s.handlePSDecommissionProgress)
+	s.httpServer.Handle(netutil.DELETE, "/manage/ps/decommission", s.handlePSDecommissionCancel)
+
+	s.httpServer.Handle(netutil.POST, "/manage/ps/maintenance", s.handlePSMaintenance)
+	s.httpServer.Handle(netutil.DELETE, "/manage/ps/maintenance", s.handlePSMaintenanceEnd)
 }
 
 func (s *ApiServer) handleDbList(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
@@ -156,11 +170,154 @@ func (s *ApiServer) handlePartitionList(w http.ResponseWriter, r *http.Request,
 	sendReply(w, newHttpSucReply(partitions))
 }
 
+func (s *ApiServer) handlePartitionDetail(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	idStr, err := checkMissingParam(w, r, PARTITION_ID)
+	if err != nil {
+		return
+	}
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		reply := newHttpErrReply(ErrParamError)
+		reply.Msg = fmt.Sprintf("%s. invalid[%s]", reply.Msg, PARTITION_ID)
+		sendReply(w, reply)
+		return
+	}
+
+	partition := s.cluster.PartitionCache.FindPartitionById(metapb.PartitionID(id))
+	if partition == nil {
+		sendReply(w, newHttpErrReply(ErrPartitionNotExists))
+		return
+	}
+	sendReply(w, newHttpSucReply(partition))
+}
+
+// handleConsistencyStats returns the counts of the consistency checks of the replicas, the result
+// of each partition is in its detail.
+func (s *ApiServer) handleConsistencyStats(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	sendReply(w, newHttpSucReply(s.cluster.consistency.getStats()))
+}
+
 func (s *ApiServer) handlePSList(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
 	allPs := s.cluster.PsCache.GetAllServers()
 	sendReply(w, newHttpSucReply(allPs))
 }
 
+// handlePSStatus returns the states of the partition servers by their heartbeats, with the counts
+// of the replicas and the leaders on each.
+func (s *ApiServer) handlePSStatus(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	allPs := s.cluster.PsCache.GetAllServers()
+	states := make([]*PSState, 0, len(allPs))
+	for _, ps := range allPs {
+		state := ps.getState()
+		for _, partition := range s.cluster.PartitionCache.FindPartitionsByNode(ps.ID) {
+			state.Replicas++
+			if partition.pickLeaderNodeId() == ps.ID {
+				state.Leaders++
+			}
+		}
+		states = append(states, state)
+	}
+	sendReply(w, newHttpSucReply(states))
+}
+
+// handlePSDecommission starts to move the leaders and the replicas off the ps, which logs out when
+// they are all moved. GET /manage/ps/decommission returns how far it went, DELETE cancels it.
+func (s *ApiServer) handlePSDecommission(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := checkLeader(w); err != nil {
+		return
+	}
+	nodeId, err := checkPSIdParam(w, r)
+	if err != nil {
+		return
+	}
+
+	progress, err := s.cluster.Decommission(nodeId)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+	sendReply(w, newHttpSucReply(progress))
+}
+
+func (s *ApiServer) handlePSDecommissionProgress(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := checkLeader(w); err != nil {
+		return
+	}
+	nodeId, err := checkPSIdParam(w, r)
+	if err != nil {
+		return
+	}
+
+	progress, err := s.cluster.GetDecommission(nodeId)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+	sendReply(w, newHttpSucReply(progress))
+}
+
+func (s *ApiServer) handlePSDecommissionCancel(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := checkLeader(w); err != nil {
+		return
+	}
+	nodeId, err := checkPSIdParam(w, r)
+	if err != nil {
+		return
+	}
+
+	if err := s.cluster.CancelDecommission(nodeId); err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+	sendReply(w, newHttpSucReply(""))
+}
+
+// handlePSMaintenance puts the ps in maintenance for the duration in seconds, its partitions keep
+// their replicas while it restarts. DELETE /manage/ps/maintenance ends the maintenance.
+func (s *ApiServer) handlePSMaintenance(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := checkLeader(w); err != nil {
+		return
+	}
+	nodeId, err := checkPSIdParam(w, r)
+	if err != nil {
+		return
+	}
+	duration := DEFAULT_MAINTENANCE_DURATION
+	if durationStr := r.FormValue(DURATION); durationStr != "" {
+		seconds, err := strconv.ParseUint(durationStr, 10, 32)
+		if err != nil {
+			reply := newHttpErrReply(ErrParamError)
+			reply.Msg = fmt.Sprintf("%s. invalid[%s]", reply.Msg, DURATION)
+			sendReply(w, reply)
+			return
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+
+	until, err := s.cluster.Maintain(nodeId, duration)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+	sendReply(w, newHttpSucReply(until))
+}
+
+func (s *ApiServer) handlePSMaintenanceEnd(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := checkLeader(w); err != nil {
+		return
+	}
+	nodeId, err := checkPSIdParam(w, r)
+	if err != nil {
+		return
+	}
+
+	if err := s.cluster.EndMaintenance(nodeId); err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+	sendReply(w, newHttpSucReply(""))
+}
+
 type HttpReply struct {
 	Code int32       `json:"code"`
 	Msg  string      `json:"msg"`
@@ -206,6 +363,32 @@ func checkMissingParam(w http.ResponseWriter, r *http.Request, paramName string)
 	return paramVal, nil
 }
 
+// checkLeader replies the requests only the leader serves with ErrNotMSLeader on a follower.
+func checkLeader(w http.ResponseWriter) error {
+	if !MineIsLeader {
+		reply := newHttpErrReply(ErrNotMSLeader)
+		reply.Msg = fmt.Sprintf("%s, current leader[%s]", reply.Msg, LeaderNodeId)
+		sendReply(w, reply)
+		return ErrNotMSLeader
+	}
+	return nil
+}
+
+func checkPSIdParam(w http.ResponseWriter, r *http.Request) (metapb.NodeID, error) {
+	idStr, err := checkMissingParam(w, r, PS_ID)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		reply := newHttpErrReply(ErrParamError)
+		reply.Msg = fmt.Sprintf("%s. invalid[%s]", reply.Msg, PS_ID)
+		sendReply(w, reply)
+		return 0, ErrParamError
+	}
+	return metapb.NodeID(id), nil
+}
+
 func sendReply(w http.ResponseWriter, httpReply *HttpReply) {
 	reply, err := json.Marshal(httpReply)
 	if err != nil {
@@ -3,10 +3,13 @@ package zm
 import (
 	"context"
 	"github.com/pkg/errors"
+	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util/deepcopy"
 	"github.com/tiglabs/baudengine/util/log"
 	"sync"
+	"time"
 )
 
 type Cluster struct {
@@ -21,8 +24,23 @@ type Cluster struct {
 	PsCache        *PSCache
 	PartitionCache *PartitionCache
 
+	// the dictionaries of the cluster, name -> *metapb.Dictionary
+	dicts sync.Map
+	// the routers watching the routes of the spaces
+	routeWatchers *RouteWatchers
+	// the partition servers being decommissioned
+	decommissions *Decommissions
+	// the counts of the consistency checks of the replicas
+	consistency *ConsistencyChecker
+	// the offline partition servers their replicas are moving off, node id -> struct{}
+	repairing sync.Map
+	// the wait for a leader change, and the interval of its checks
+	changeLeaderTimeout       time.Duration
+	changeLeaderCheckInterval time.Duration
+
 	cancelDBWatch    topo.CancelFunc
 	cancelSpaceWatch topo.CancelFunc
+	cancelDictWatch  topo.CancelFunc
 
 	clusterLock sync.RWMutex
 }
@@ -34,6 +52,13 @@ func NewCluster(ctx context.Context, config *Config, topoServer *topo.TopoServer
 		topoServer: topoServer,
 		DbCache:    NewDBCache(),
 		PsCache:    NewPSCache(),
+
+		routeWatchers: NewRouteWatchers(),
+		decommissions: NewDecommissions(),
+		consistency:   NewConsistencyChecker(),
+
+		changeLeaderTimeout:       CHANGE_LEADER_TIMEOUT,
+		changeLeaderCheckInterval: CHANGE_LEADER_CHECK_INTERVAL,
 	}
 }
 
@@ -55,6 +80,10 @@ func (c *Cluster) Start() error {
 		log.Error("fail to recovery PartitionCache. err[%v]", err)
 		return err
 	}
+	if err := c.recoveryDicts(); err != nil {
+		log.Error("fail to recovery dicts. err[%v]", err)
+		return err
+	}
 	log.Info("finish to recovery whole cluster")
 
 	log.Info("Cluster has started")
@@ -144,6 +173,9 @@ func (c *Cluster) watchPartitionChange() []*topo.PartitionTopo {
 		for partition := range partitionChannel {
 			if partition.Err != nil {
 				if partition.Err == topo.ErrNoNode {
+					if oldPartition := c.PartitionCache.FindPartitionById(partition.ID); oldPartition != nil {
+						c.notifyRouteDelete(oldPartition)
+					}
 					c.PartitionCache.DelPartition(partition.ID)
 					continue
 				}
@@ -153,10 +185,12 @@ func (c *Cluster) watchPartitionChange() []*topo.PartitionTopo {
 			log.Debug("watched partition[%v]", partition.Partition)
 			oldPartition := c.PartitionCache.FindPartitionById(partition.ID)
 			if oldPartition == nil {
-				c.PartitionCache.AddPartition(NewPartitionByMeta(partition.PartitionTopo))
+				oldPartition = NewPartitionByMeta(partition.PartitionTopo)
+				c.PartitionCache.AddPartition(oldPartition)
 			} else {
 				oldPartition.Update(partition.PartitionTopo)
 			}
+			c.notifyRouteUpdate(oldPartition)
 		}
 	}()
 
@@ -270,3 +304,168 @@ func (c *Cluster) clearAllCache() {
 	c.DbCache.Clear()
 	c.PartitionCache.Clear()
 }
+
+// changeLeader asks the partition server of the replica to be the leader of the partition, and
+// waits for the leader change reported by the heartbeats.
+func (c *Cluster) changeLeader(ctx context.Context, partition *Partition, replica *metapb.Replica) error {
+	if leader := partition.getLeader(); leader != nil && leader.ID == replica.ID {
+		return nil
+	}
+
+	ps := c.PsCache.FindServerById(replica.NodeID)
+	if ps == nil {
+		log.Error("cannot find ps %d of replica %d", replica.NodeID, replica.ID)
+		return ErrPSNotExists
+	}
+
+	if err := GetPSRpcClientSingle(nil).ChangeLeader(ps.getRpcAddr(), partition.ID); err != nil {
+		log.Error("Rpc fail to change leader of partition[%v] to replica[%v]. err[%v]", partition.ID, replica, err)
+		return err
+	}
+
+	timer := time.NewTimer(c.changeLeaderTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(c.changeLeaderCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			log.Warn("timeout to wait leader of partition[%v] changed to replica[%v]", partition.ID, replica)
+			return ErrLeaderChangeTimeout
+		case <-ticker.C:
+			if leader := partition.getLeader(); leader != nil && leader.ID == replica.ID {
+				log.Info("leader of partition[%v] changed to replica[%v]", partition.ID, replica)
+				return nil
+			}
+		}
+	}
+}
+
+// createReplica creates a replica of the partition on the partition server, and adds it to the
+// raft group of the leader if the partition has one.
+func (c *Cluster) createReplica(partition *Partition, ps *PartitionServer) (*metapb.Replica, error) {
+	leaderPS := c.PsCache.FindServerById(partition.pickLeaderNodeId())
+	// leaderPS is nil when create first partition
+
+	replicaId, err := GetIdGeneratorSingle(nil).GenID()
+	if err != nil {
+		log.Error("fail to allocate new replica ßid. err:[%v]", err)
+		return nil, err
+	}
+	var newMetaReplica = &metapb.Replica{ID: metapb.ReplicaID(replicaId), NodeID: ps.ID,
+		ReplicaAddrs: metapb.ReplicaAddrs{
+			HeartbeatAddr: ps.HeartbeatAddr,
+			ReplicateAddr: ps.ReplicateAddr,
+			RpcAddr:       ps.RpcAddr,
+			AdminAddr:     ps.AdminAddr,
+		}}
+
+	partition.propertyLock.RLock()
+	partitionCopy := deepcopy.Iface(partition.Partition).(*metapb.Partition)
+	partition.propertyLock.RUnlock()
+	partitionCopy.Replicas = append(partitionCopy.Replicas, *newMetaReplica)
+	if err := GetPSRpcClientSingle(nil).CreatePartition(ps.getRpcAddr(), partitionCopy); err != nil {
+		log.Error("Rpc fail to create partition[%v] into ps. err:[%v]", partition.Partition, err)
+		return nil, err
+	}
+
+	if leaderPS != nil {
+		if err := GetPSRpcClientSingle(nil).AddReplica(leaderPS.getRpcAddr(), partition.ID,
+			&ps.ReplicaAddrs, newMetaReplica.ID, newMetaReplica.NodeID); err != nil {
+			log.Error("Rpc fail to add replica[%v] into leader ps. err[%v]", newMetaReplica, err)
+			return nil, err
+		}
+	}
+	return newMetaReplica, nil
+}
+
+// Maintain puts the partition server in maintenance for the duration, so a rolling restart takes
+// it down without the replicas of its partitions being repaired meanwhile.
+func (c *Cluster) Maintain(nodeId metapb.NodeID, duration time.Duration) (time.Time, error) {
+	ps := c.PsCache.FindServerById(nodeId)
+	if ps == nil {
+		return time.Time{}, ErrPSNotExists
+	}
+	if duration <= 0 || duration > MAX_MAINTENANCE_DURATION {
+		return time.Time{}, ErrParamError
+	}
+
+	until := time.Now().Add(duration)
+	if err := ps.setMaintenance(until); err != nil {
+		return time.Time{}, err
+	}
+	log.Info("ps[%v] is in maintenance until %v", nodeId, until)
+	return until, nil
+}
+
+func (c *Cluster) EndMaintenance(nodeId metapb.NodeID) error {
+	ps := c.PsCache.FindServerById(nodeId)
+	if ps == nil {
+		return ErrPSNotExists
+	}
+
+	ps.endMaintenance()
+	log.Info("ps[%v] is out of maintenance", nodeId)
+	return nil
+}
+
+// repairOffline moves the replicas of the offline partition server to the others, as a
+// decommission moves them. It stops when the partition server is back, a failed move is retried by
+// the next check of the heartbeats.
+func (c *Cluster) repairOffline(ctx context.Context, ps *PartitionServer) {
+	if _, running := c.repairing.LoadOrStore(ps.ID, struct{}{}); running {
+		return
+	}
+	defer c.repairing.Delete(ps.ID)
+
+	for _, partition := range c.PartitionCache.FindPartitionsByNode(ps.ID) {
+		if ctx.Err() != nil || ps.getStatus() != PS_OFFLINE {
+			return
+		}
+		if c.decommissions.isMigrating(partition.ID) {
+			continue
+		}
+		if err := c.migrateReplica(ctx, partition, ps); err != nil {
+			log.Error("fail to move replica of partition[%v] off offline ps[%v]. err[%v]", partition.ID, ps.ID, err)
+			continue
+		}
+		log.Info("moved replica of partition[%v] off offline ps[%v]", partition.ID, ps.ID)
+	}
+}
+
+// inMaintenance reports whether a replica of the partition is on a partition server in maintenance.
+func (c *Cluster) inMaintenance(partition *Partition) bool {
+	for _, replica := range partition.getReplicas() {
+		if ps := c.PsCache.FindServerById(replica.NodeID); ps != nil && ps.inMaintenance() {
+			return true
+		}
+	}
+	return false
+}
+
+// replicaDiskStates returns the disk states over the watermark of the replicas of the partitions
+// led by the ps, so that the leader stops the writes adding data while a replica can not take them.
+func (c *Cluster) replicaDiskStates(partitions []masterpb.PartitionInfo) []masterpb.ReplicaDiskState {
+	var states []masterpb.ReplicaDiskState
+	for _, info := range partitions {
+		if !info.IsLeader {
+			continue
+		}
+		partition := c.PartitionCache.FindPartitionById(info.ID)
+		if partition == nil {
+			continue
+		}
+		for _, replica := range partition.getReplicas() {
+			ps := c.PsCache.FindServerById(replica.NodeID)
+			if ps == nil {
+				continue
+			}
+			if state := ps.getDiskState(); state != masterpb.DiskState_DiskNormal {
+				states = append(states, masterpb.ReplicaDiskState{PartitionID: info.ID, NodeID: replica.NodeID, DiskState: state})
+			}
+		}
+	}
+	return states
+}
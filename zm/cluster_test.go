@@ -0,0 +1,145 @@
+package zm
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util/assert"
+	"testing"
+	"time"
+)
+
+const T_ADMIN_PORT = 8000
+
+// newTestCluster returns a cluster of the partition servers and a partition with a replica on
+// each of them, the replica on the first one leads.
+func newTestCluster(nodes int) (*Cluster, *Partition) {
+	cluster := NewCluster(context.Background(), nil, nil)
+	cluster.PartitionCache = NewPartitionCache()
+
+	replicas := make([]metapb.Replica, 0, nodes)
+	for i := 0; i < nodes; i++ {
+		nodeId := metapb.NodeID(T_PSID_START + i)
+		cluster.PsCache.AddServer(NewPartitionServerByMeta(&PsConfig{AdminPort: T_ADMIN_PORT},
+			&topo.PsTopo{Node: &metapb.Node{ID: nodeId, Ip: testPSIp(nodeId)}}))
+		replicas = append(replicas, metapb.Replica{ID: metapb.ReplicaID(T_REPLICAID_START + i), NodeID: nodeId})
+	}
+	partition := NewPartitionByMeta(&topo.PartitionTopo{Partition: &metapb.Partition{ID: T_PARTITIONID_START, Replicas: replicas}})
+	partition.Leader = &partition.Replicas[0]
+	cluster.PartitionCache.AddPartition(partition)
+
+	return cluster, partition
+}
+
+func testPSIp(nodeId metapb.NodeID) string {
+	return fmt.Sprintf("127.0.0.%d", nodeId)
+}
+
+func testPSAddr(nodeId metapb.NodeID) string {
+	return fmt.Sprintf("%s:%d", testPSIp(nodeId), T_ADMIN_PORT)
+}
+
+// mockPSRpcClient replaces the ps rpc client by a mock until the returned func is called.
+func mockPSRpcClient(ctrl *gomock.Controller) (*MockPSRpcClient, func()) {
+	mockPSClient := NewMockPSRpcClient(ctrl)
+	psClientSingle = mockPSClient
+	return mockPSClient, func() { psClientSingle = nil }
+}
+
+// reportLeader updates the leader of the partition as the heartbeat of the replica does.
+func reportLeader(partition *Partition, replica metapb.Replica) bool {
+	_, _, ok := partition.ValidateAndUpdateLeaderByCond(&masterpb.PartitionInfo{ID: partition.ID}, &replica)
+	return ok
+}
+
+func TestChangeLeader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, partition := newTestCluster(T_PSID_MAX)
+	rpcServer := &RpcServer{cluster: cluster}
+	leader, follower := partition.Replicas[0], partition.Replicas[1]
+	mockPSClient, restore := mockPSRpcClient(ctrl)
+	defer restore()
+	MineIsLeader = true
+	defer func() { MineIsLeader = false }()
+	cluster.changeLeaderTimeout = 500 * time.Millisecond
+
+	changeLeader := func(replicaId metapb.ReplicaID) metapb.RespCode {
+		req := &masterpb.ChangeLeaderRequest{PartitionID: partition.ID, Replica: metapb.Replica{ID: replicaId}}
+		resp, err := rpcServer.ChangeLeader(context.Background(), req)
+		assert.Nil(t, err)
+		return resp.Code
+	}
+
+	// the follower does not take the leadership in time
+	mockPSClient.EXPECT().ChangeLeader(testPSAddr(follower.NodeID), partition.ID).Return(nil)
+	assert.Equal(t, changeLeader(follower.ID), metapb.RESP_CODE_TIMEOUT, "leader change not timed out")
+	assert.Equal(t, partition.getLeader().ID, leader.ID, "leader changed")
+
+	// the ps of the follower fails
+	mockPSClient.EXPECT().ChangeLeader(testPSAddr(follower.NodeID), partition.ID).Return(ErrRpcInvokeFailed)
+	assert.Equal(t, changeLeader(follower.ID), metapb.RESP_CODE_SERVER_ERROR, "leader change of a failed ps")
+
+	// the manual transfer returns once the heartbeat of the new leader reports it
+	mockPSClient.EXPECT().ChangeLeader(testPSAddr(follower.NodeID), partition.ID).DoAndReturn(
+		func(addr string, partitionId metapb.PartitionID) error {
+			go func() {
+				time.Sleep(2 * cluster.changeLeaderCheckInterval)
+				reportLeader(partition, follower)
+			}()
+			return nil
+		})
+	assert.Equal(t, changeLeader(follower.ID), metapb.RESP_CODE_OK, "leader not changed")
+	assert.Equal(t, partition.getLeader().ID, follower.ID, "unmatched leader replicaid")
+
+	// the leader is not asked again, an unknown replica is not asked at all
+	assert.Equal(t, changeLeader(follower.ID), metapb.RESP_CODE_OK, "change to the leader")
+	assert.Equal(t, changeLeader(T_REPLICAID_START+T_PSID_MAX), metapb.RESP_CODE_SERVER_ERROR, "change to an unknown replica")
+
+	// the replica of a ps not cached
+	lost := metapb.Replica{ID: T_REPLICAID_START + T_PSID_MAX, NodeID: T_PSID_START + T_PSID_MAX}
+	partition.Replicas = append(partition.Replicas, lost)
+	assert.Equal(t, changeLeader(lost.ID), metapb.MASTER_RESP_CODE_PS_NOTEXISTS, "change to a replica without ps")
+
+	MineIsLeader = false
+	assert.Equal(t, changeLeader(leader.ID), metapb.MASTER_RESP_CODE_NOT_LEADER, "change on a follower master")
+}
+
+func TestChangeLeaderCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, partition := newTestCluster(2)
+	follower := partition.Replicas[1]
+	mockPSClient, restore := mockPSRpcClient(ctrl)
+	defer restore()
+
+	// the wait for the heartbeat ends with the request
+	ctx, cancel := context.WithTimeout(context.Background(), 2*cluster.changeLeaderCheckInterval)
+	defer cancel()
+	mockPSClient.EXPECT().ChangeLeader(testPSAddr(follower.NodeID), partition.ID).Return(nil)
+	assert.Equal(t, cluster.changeLeader(ctx, partition, &follower), context.DeadlineExceeded, "wait not canceled")
+}
+
+func TestReplicaDiskStates(t *testing.T) {
+	cluster, partition := newTestCluster(3)
+	leader := []masterpb.PartitionInfo{{ID: partition.ID, IsLeader: true}}
+	assert.Equal(t, len(cluster.replicaDiskStates(leader)), 0, "replicas over the watermark")
+
+	cluster.PsCache.FindServerById(T_PSID_START + 1).updateDiskState(masterpb.DiskState_DiskFull)
+	states := cluster.replicaDiskStates(leader)
+	assert.Equal(t, len(states), 1, "replicas over the watermark")
+	assert.Equal(t, states[0], masterpb.ReplicaDiskState{PartitionID: partition.ID, NodeID: T_PSID_START + 1,
+		DiskState: masterpb.DiskState_DiskFull}, "replica over the watermark")
+
+	// the partitions not led, or unknown, have no states
+	follower := []masterpb.PartitionInfo{{ID: partition.ID}, {ID: T_PARTITIONID_START + 1, IsLeader: true}}
+	assert.Equal(t, len(cluster.replicaDiskStates(follower)), 0, "replicas over the watermark")
+
+	cluster.PsCache.FindServerById(T_PSID_START + 1).updateDiskState(masterpb.DiskState_DiskNormal)
+	assert.Equal(t, len(cluster.replicaDiskStates(leader)), 0, "replicas over the watermark")
+}
@@ -38,6 +38,8 @@ cluster-id = "1"
 node-id = 1
 global-server-addrs = "0.0.0.0:1234"
 global-root-dir = "/"
+# rebuild the replicas the consistency check finds divergent from the leader
+consistency-repair = false
 
 log]
 log-path = "/tmp/zm_log"
@@ -115,6 +117,7 @@ type ClusterConfig struct {
 	CurNodeId         string         `toml:"node-id,omitempty" json:"node-id"`
 	GlobalServerAddrs string         `toml:"global-server-addrs,omitempty" json:"global-server-addrs"`
 	GlobalRootDir     string         `toml:"global-root-dir,omitempty" json:"global-root-dir"`
+	ConsistencyRepair bool           `toml:"consistency-repair,omitempty" json:"consistency-repair"`
 }
 
 type LogConfig struct {
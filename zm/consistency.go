@@ -0,0 +1,241 @@
+package zm
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+	"sync"
+	"time"
+)
+
+const (
+	CONSISTENCY_CHECK_INTERVAL = time.Hour
+	// the wait for a replica to hash its data
+	CONSISTENCY_CHECKSUM_TIMEOUT = 10 * time.Minute
+	CONSISTENCY_POLL_INTERVAL    = time.Second
+)
+
+// ConsistencyResult is the last consistency check of the replicas of a partition, the replicas
+// hash their data at the same raft index.
+type ConsistencyResult struct {
+	Index     uint64                      `json:"index"`
+	Checksums map[metapb.ReplicaID]uint64 `json:"checksums"`
+	Divergent []metapb.ReplicaID          `json:"divergent,omitempty"`
+	Repaired  bool                        `json:"repaired"`
+	Error     string                      `json:"error,omitempty"`
+	CheckTime time.Time                   `json:"check_time"`
+}
+
+// ConsistencyStats counts the partitions checked by the consistency checks of this master.
+type ConsistencyStats struct {
+	Checked    uint64    `json:"checked"`
+	Consistent uint64    `json:"consistent"`
+	Divergent  uint64    `json:"divergent"`
+	Repaired   uint64    `json:"repaired"`
+	Failed     uint64    `json:"failed"`
+	LastRun    time.Time `json:"last_run"`
+}
+
+type ConsistencyChecker struct {
+	lock  sync.Mutex
+	stats ConsistencyStats
+}
+
+func NewConsistencyChecker() *ConsistencyChecker {
+	return new(ConsistencyChecker)
+}
+
+func (cc *ConsistencyChecker) getStats() ConsistencyStats {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+
+	return cc.stats
+}
+
+func (cc *ConsistencyChecker) count(result *ConsistencyResult) {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+
+	cc.stats.Checked++
+	switch {
+	case result.Error != "" && len(result.Divergent) == 0:
+		cc.stats.Failed++
+	case len(result.Divergent) == 0:
+		cc.stats.Consistent++
+	default:
+		cc.stats.Divergent++
+		if result.Repaired {
+			cc.stats.Repaired++
+		}
+	}
+}
+
+type ConsistencyCheckWorker struct {
+	cluster *Cluster
+}
+
+func NewConsistencyCheckWorker(cluster *Cluster) *ConsistencyCheckWorker {
+	return &ConsistencyCheckWorker{
+		cluster: cluster,
+	}
+}
+
+func (w *ConsistencyCheckWorker) getName() string {
+	return "Consistency-Check-Worker"
+}
+
+func (w *ConsistencyCheckWorker) getInterval() time.Duration {
+	return CONSISTENCY_CHECK_INTERVAL
+}
+
+func (w *ConsistencyCheckWorker) run() {
+	if !MineIsLeader {
+		return
+	}
+
+	for _, partition := range w.cluster.PartitionCache.getPartitions() {
+		if !MineIsLeader || w.cluster.masterCtx.Err() != nil {
+			return
+		}
+		w.cluster.CheckConsistency(w.cluster.masterCtx, partition)
+	}
+
+	w.cluster.consistency.lock.Lock()
+	w.cluster.consistency.stats.LastRun = time.Now()
+	w.cluster.consistency.lock.Unlock()
+}
+
+// CheckConsistency compares the checksums of the replicas of the partition. The replicas holding
+// a checksum other than the one of the majority diverge, they are rebuilt on other partition
+// servers from the snapshot of the leader when the repair is on.
+func (c *Cluster) CheckConsistency(ctx context.Context, partition *Partition) *ConsistencyResult {
+	leader := partition.getLeader()
+	replicas := partition.getReplicas()
+	if leader == nil || len(replicas) < 2 {
+		return nil
+	}
+
+	result := &ConsistencyResult{Checksums: make(map[metapb.ReplicaID]uint64), CheckTime: time.Now()}
+	defer func() {
+		partition.setConsistency(result)
+		c.consistency.count(result)
+	}()
+
+	leaderPS := c.PsCache.FindServerById(leader.NodeID)
+	if leaderPS == nil {
+		result.Error = ErrPSNotExists.Error()
+		return result
+	}
+	resp, err := GetPSRpcClientSingle(nil).Checksum(leaderPS.getRpcAddr(), partition.ID, 0)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	index := resp.Index
+	result.Index = index
+
+	for _, replica := range replicas {
+		checksum, err := c.waitChecksum(ctx, partition, &replica, index)
+		if err != nil {
+			log.Error("fail to get checksum of replica[%v] of partition[%v]. err[%v]", replica.ID, partition.ID, err)
+			result.Error = fmt.Sprintf("replica[%v]: %v", replica.ID, err)
+			return result
+		}
+		result.Checksums[replica.ID] = checksum
+	}
+
+	counts := make(map[uint64]int)
+	for _, checksum := range result.Checksums {
+		counts[checksum]++
+	}
+	if len(counts) == 1 {
+		return result
+	}
+	var majority uint64
+	var majorityCount int
+	for checksum, count := range counts {
+		if count > majorityCount {
+			majority, majorityCount = checksum, count
+		}
+	}
+	for replicaId, checksum := range result.Checksums {
+		if checksum != majority || majorityCount*2 <= len(replicas) {
+			result.Divergent = append(result.Divergent, replicaId)
+		}
+	}
+	log.Error("replicas %v of partition[%v] diverge at index[%v], checksums[%v]",
+		result.Divergent, partition.ID, index, result.Checksums)
+
+	if majorityCount*2 <= len(replicas) {
+		result.Error = "no majority of the replicas agrees"
+		return result
+	}
+	if !c.config.ClusterCfg.ConsistencyRepair {
+		return result
+	}
+	for _, replicaId := range result.Divergent {
+		if err := c.rebuildReplica(ctx, partition, replicaId); err != nil {
+			log.Error("fail to rebuild replica[%v] of partition[%v]. err[%v]", replicaId, partition.ID, err)
+			result.Error = fmt.Sprintf("replica[%v]: %v", replicaId, err)
+			return result
+		}
+	}
+	result.Repaired = true
+	return result
+}
+
+// waitChecksum waits until the replica hashed its data at the index.
+func (c *Cluster) waitChecksum(ctx context.Context, partition *Partition, replica *metapb.Replica, index uint64) (uint64, error) {
+	ps := c.PsCache.FindServerById(replica.NodeID)
+	if ps == nil {
+		return 0, ErrPSNotExists
+	}
+
+	timer := time.NewTimer(CONSISTENCY_CHECKSUM_TIMEOUT)
+	defer timer.Stop()
+	ticker := time.NewTicker(CONSISTENCY_POLL_INTERVAL)
+	defer ticker.Stop()
+	for {
+		resp, err := GetPSRpcClientSingle(nil).Checksum(ps.getRpcAddr(), partition.ID, index)
+		if err != nil {
+			return 0, err
+		}
+		if resp.Ready {
+			return resp.Checksum, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-timer.C:
+			return 0, ErrChecksumTimeout
+		case <-ticker.C:
+		}
+	}
+}
+
+// rebuildReplica replaces the divergent replica by a new one on another partition server, which
+// the leader fills from its snapshot. A divergent leader hands its leadership to a follower first.
+func (c *Cluster) rebuildReplica(ctx context.Context, partition *Partition, replicaId metapb.ReplicaID) error {
+	replica := partition.findReplicaById(replicaId)
+	if replica == nil {
+		return nil
+	}
+	ps := c.PsCache.FindServerById(replica.NodeID)
+	if ps == nil {
+		return ErrPSNotExists
+	}
+
+	if leader := partition.getLeader(); leader != nil && leader.ID == replicaId {
+		moved, err := c.moveLeaderOff(ctx, partition, replica.NodeID)
+		if err != nil {
+			return err
+		}
+		if !moved {
+			return ErrPartitionNoLeader
+		}
+	}
+	log.Info("rebuild replica[%v] of partition[%v] from the leader", replicaId, partition.ID)
+	return c.migrateReplica(ctx, partition, ps)
+}
@@ -0,0 +1,338 @@
+package zm
+
+import (
+	"context"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+	"sync"
+	"time"
+)
+
+const (
+	DECOMMISSION_RUNNING   = "running"
+	DECOMMISSION_DONE      = "done"
+	DECOMMISSION_CANCELLED = "cancelled"
+	DECOMMISSION_FAILED    = "failed"
+
+	// the wait for a new replica to match the log the leader committed when it was added
+	DECOMMISSION_CATCH_UP_TIMEOUT = 10 * time.Minute
+	DECOMMISSION_CHECK_INTERVAL   = time.Second
+)
+
+// DecommissionProgress is how far moving the leaders and the replicas off a partition server went.
+type DecommissionProgress struct {
+	NodeID       metapb.NodeID      `json:"node_id"`
+	State        string             `json:"state"`
+	Partitions   int                `json:"partitions"`
+	LeadersMoved int                `json:"leaders_moved"`
+	Migrated     int                `json:"migrated"`
+	Current      metapb.PartitionID `json:"current"`
+	Error        string             `json:"error,omitempty"`
+	StartTime    time.Time          `json:"start_time"`
+	EndTime      time.Time          `json:"end_time"`
+}
+
+// Decommission moves the leaders and then the replicas off a partition server, which logs out
+// at the end. It lives in the memory of the leader of the zone masters.
+type Decommission struct {
+	progress DecommissionProgress
+	cancel   context.CancelFunc
+	lock     sync.RWMutex
+}
+
+func (d *Decommission) getProgress() DecommissionProgress {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	return d.progress
+}
+
+func (d *Decommission) isRunning() bool {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	return d.progress.State == DECOMMISSION_RUNNING
+}
+
+func (d *Decommission) update(fn func(progress *DecommissionProgress)) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	fn(&d.progress)
+}
+
+func (d *Decommission) finish(state string, err error) {
+	d.update(func(progress *DecommissionProgress) {
+		progress.State = state
+		progress.Current = 0
+		progress.EndTime = time.Now()
+		if err != nil {
+			progress.Error = err.Error()
+		}
+	})
+}
+
+type Decommissions struct {
+	lock          sync.Mutex
+	decommissions map[metapb.NodeID]*Decommission
+	// the partitions a replica is moving for, the heartbeats leave their replica count alone
+	migrating sync.Map
+	selector  Selector
+	// the wait for a new replica to catch up, and the interval of its checks
+	catchUpTimeout time.Duration
+	checkInterval  time.Duration
+}
+
+func NewDecommissions() *Decommissions {
+	return &Decommissions{
+		decommissions: make(map[metapb.NodeID]*Decommission),
+		selector:      NewIdleSelector(),
+
+		catchUpTimeout: DECOMMISSION_CATCH_UP_TIMEOUT,
+		checkInterval:  DECOMMISSION_CHECK_INTERVAL,
+	}
+}
+
+func (ds *Decommissions) isMigrating(partitionId metapb.PartitionID) bool {
+	_, ok := ds.migrating.Load(partitionId)
+	return ok
+}
+
+// Decommission marks the partition server draining, so it gets no new replicas, and starts to move
+// its leaders and replicas to the others.
+func (c *Cluster) Decommission(nodeId metapb.NodeID) (*DecommissionProgress, error) {
+	ps := c.PsCache.FindServerById(nodeId)
+	if ps == nil {
+		return nil, ErrPSNotExists
+	}
+
+	c.decommissions.lock.Lock()
+	defer c.decommissions.lock.Unlock()
+
+	if d, ok := c.decommissions.decommissions[nodeId]; ok && d.isRunning() {
+		return nil, ErrDecommissionExists
+	}
+	ctx, cancel := context.WithCancel(c.masterCtx)
+	d := &Decommission{
+		progress: DecommissionProgress{NodeID: nodeId, State: DECOMMISSION_RUNNING, StartTime: time.Now()},
+		cancel:   cancel,
+	}
+	c.decommissions.decommissions[nodeId] = d
+	ps.setDraining(true)
+	log.Info("start to decommission ps[%v]", nodeId)
+
+	go c.runDecommission(ctx, d, ps)
+
+	progress := d.getProgress()
+	return &progress, nil
+}
+
+func (c *Cluster) GetDecommission(nodeId metapb.NodeID) (*DecommissionProgress, error) {
+	c.decommissions.lock.Lock()
+	defer c.decommissions.lock.Unlock()
+
+	d, ok := c.decommissions.decommissions[nodeId]
+	if !ok {
+		return nil, ErrDecommissionNotExists
+	}
+	progress := d.getProgress()
+	return &progress, nil
+}
+
+// CancelDecommission stops the decommission of the partition server, the replicas moved stay where
+// they are and the partition server gets new replicas again.
+func (c *Cluster) CancelDecommission(nodeId metapb.NodeID) error {
+	c.decommissions.lock.Lock()
+	defer c.decommissions.lock.Unlock()
+
+	d, ok := c.decommissions.decommissions[nodeId]
+	if !ok || !d.isRunning() {
+		return ErrDecommissionNotExists
+	}
+	d.cancel()
+	return nil
+}
+
+func (c *Cluster) runDecommission(ctx context.Context, d *Decommission, ps *PartitionServer) {
+	defer d.cancel()
+
+	err := c.decommission(ctx, d, ps)
+	switch {
+	case err == nil:
+		ps.changeStatus(PS_LOGOUT)
+		d.finish(DECOMMISSION_DONE, nil)
+		log.Info("ps[%v] is decommissioned", ps.ID)
+	case ctx.Err() != nil:
+		ps.setDraining(false)
+		d.finish(DECOMMISSION_CANCELLED, nil)
+		log.Info("decommission of ps[%v] is cancelled", ps.ID)
+	default:
+		ps.setDraining(false)
+		d.finish(DECOMMISSION_FAILED, err)
+		log.Error("fail to decommission ps[%v]. err[%v]", ps.ID, err)
+	}
+}
+
+// decommission moves the leaders off the partition server first, so it serves no more writes,
+// then moves every replica by adding a replica elsewhere and removing its own once the new one
+// caught up.
+func (c *Cluster) decommission(ctx context.Context, d *Decommission, ps *PartitionServer) error {
+	partitions := c.PartitionCache.FindPartitionsByNode(ps.ID)
+	d.update(func(progress *DecommissionProgress) {
+		progress.Partitions = len(partitions)
+	})
+
+	for _, partition := range partitions {
+		if leader := partition.getLeader(); leader != nil && leader.NodeID == ps.ID {
+			moved, err := c.moveLeaderOff(ctx, partition, ps.ID)
+			if err != nil {
+				return err
+			}
+			if moved {
+				d.update(func(progress *DecommissionProgress) {
+					progress.LeadersMoved++
+				})
+			}
+		}
+	}
+
+	for _, partition := range partitions {
+		d.update(func(progress *DecommissionProgress) {
+			progress.Current = partition.ID
+		})
+		if err := c.migrateReplica(ctx, partition, ps); err != nil {
+			return err
+		}
+		d.update(func(progress *DecommissionProgress) {
+			progress.Migrated++
+		})
+	}
+	return nil
+}
+
+// moveLeaderOff moves the leader of the partition to the replica of another node which matches
+// the most of the log. It returns false when the partition has no such replica.
+func (c *Cluster) moveLeaderOff(ctx context.Context, partition *Partition, nodeId metapb.NodeID) (bool, error) {
+	var target *metapb.Replica
+	var targetMatch uint64
+	replicas := partition.getReplicas()
+	for i := range replicas {
+		if replicas[i].NodeID == nodeId {
+			continue
+		}
+		match, ok := partition.followerMatch(replicas[i].ID)
+		if !ok {
+			continue
+		}
+		if target == nil || match > targetMatch {
+			target, targetMatch = &replicas[i], match
+		}
+	}
+	if target == nil {
+		return false, nil
+	}
+
+	if err := c.changeLeader(ctx, partition, target); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// migrateReplica adds a replica of the partition on another partition server, waits for it to
+// catch up with the leader, and then removes the replica of the partition server.
+func (c *Cluster) migrateReplica(ctx context.Context, partition *Partition, ps *PartitionServer) error {
+	var oldReplica *metapb.Replica
+	replicas := partition.getReplicas()
+	for i := range replicas {
+		if replicas[i].NodeID == ps.ID {
+			oldReplica = &replicas[i]
+			break
+		}
+	}
+	if oldReplica == nil {
+		return nil
+	}
+
+	c.decommissions.migrating.Store(partition.ID, struct{}{})
+	defer c.decommissions.migrating.Delete(partition.ID)
+
+	if partition.pickLeaderNodeId() == 0 {
+		log.Error("partition[%v] has no leader to add the replica to", partition.ID)
+		return ErrPartitionNoLeader
+	}
+	target := c.selectReplicaPS(partition, replicas)
+	if target == nil {
+		log.Error("no ps for the new replica of partition[%v]", partition.ID)
+		return ErrNoPSForReplica
+	}
+	// the new replica is in sync once it matches the log committed before it joined
+	commit := partition.leaderCommit()
+	newReplica, err := c.createReplica(partition, target)
+	if err != nil {
+		return err
+	}
+	log.Info("added replica[%v] of partition[%v] on ps[%v], waiting for it to catch up to index %d",
+		newReplica.ID, partition.ID, target.ID, commit)
+	if err := c.waitCatchUp(ctx, partition, newReplica.ID, commit); err != nil {
+		return err
+	}
+
+	if leader := partition.getLeader(); leader != nil && leader.NodeID == ps.ID {
+		if err := c.changeLeader(ctx, partition, newReplica); err != nil {
+			return err
+		}
+	}
+	leaderPS := c.PsCache.FindServerById(partition.pickLeaderNodeId())
+	if leaderPS == nil {
+		return ErrPSNotExists
+	}
+	if err := GetPSRpcClientSingle(nil).RemoveReplica(leaderPS.getRpcAddr(), partition.ID,
+		&oldReplica.ReplicaAddrs, oldReplica.ID, oldReplica.NodeID); err != nil {
+		log.Error("Rpc fail to remove replica[%v] from leader ps. err[%v]", oldReplica.ID, err)
+		return err
+	}
+	// the partition server is leaving, the replica it keeps is only a waste of its disk
+	if err := GetPSRpcClientSingle(nil).DeletePartition(ps.getRpcAddr(), partition.ID); err != nil {
+		log.Warn("Rpc fail to delete partition[%v] from ps[%v]. err:[%v]", partition.ID, ps.ID, err)
+	}
+	return nil
+}
+
+// selectReplicaPS returns a partition server taking new replicas without a replica of the partition.
+func (c *Cluster) selectReplicaPS(partition *Partition, replicas []metapb.Replica) *PartitionServer {
+	servers := make([]*PartitionServer, 0)
+	for _, ps := range c.PsCache.GetAllServers() {
+		var hosting bool
+		for _, replica := range replicas {
+			if replica.NodeID == ps.ID {
+				hosting = true
+				break
+			}
+		}
+		if !hosting {
+			servers = append(servers, ps)
+		}
+	}
+	return c.decommissions.selector.SelectTarget(servers, partition.ID)
+}
+
+// waitCatchUp waits until the leader reports the replica matching its log up to the index.
+func (c *Cluster) waitCatchUp(ctx context.Context, partition *Partition, replicaId metapb.ReplicaID, index uint64) error {
+	timer := time.NewTimer(c.decommissions.catchUpTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(c.decommissions.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			log.Warn("timeout to wait replica[%v] of partition[%v] catching up", replicaId, partition.ID)
+			return ErrCatchUpTimeout
+		case <-ticker.C:
+			if match, ok := partition.followerMatch(replicaId); ok && match >= index {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package zm
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const T_NEW_REPLICAID = 200
+
+// seqIDGenerator generates the ids counting up from its last one.
+type seqIDGenerator struct {
+	last uint64
+}
+
+func (g *seqIDGenerator) GenID() (uint64, error) {
+	return atomic.AddUint64(&g.last, 1), nil
+}
+
+func (g *seqIDGenerator) Close() {}
+
+// newDecommissionCluster returns a cluster whose partition has a replica on each partition
+// server but the last one, which takes the replicas moved. The catch up is checked often.
+func newDecommissionCluster(nodes int) (*Cluster, *Partition, *PartitionServer) {
+	cluster, partition := newTestCluster(nodes - 1)
+	cluster.decommissions.checkInterval = 10 * time.Millisecond
+	nodeId := metapb.NodeID(T_PSID_START + nodes - 1)
+	target := NewPartitionServerByMeta(&PsConfig{AdminPort: T_ADMIN_PORT},
+		&topo.PsTopo{Node: &metapb.Node{ID: nodeId, Ip: testPSIp(nodeId)}})
+	cluster.PsCache.AddServer(target)
+	return cluster, partition, target
+}
+
+// reportMatch sets the logs of the replicas the leader reports matching, along with its commit.
+func reportMatch(partition *Partition, commit uint64, matches map[metapb.ReplicaID]uint64) {
+	raftStatus := &masterpb.RaftStatus{Commit: commit}
+	for replicaId, match := range matches {
+		follower := masterpb.RaftFollowerStatus{Match: match}
+		follower.ID = replicaId
+		raftStatus.Followers = append(raftStatus.Followers, follower)
+	}
+	partition.updateRaftStatus(raftStatus)
+}
+
+func mockIDGenerator() func() {
+	idGeneratorSingle = &seqIDGenerator{last: T_NEW_REPLICAID - 1}
+	return func() { idGeneratorSingle = nil }
+}
+
+// waitDecommission waits for the decommission of the partition server to end.
+func waitDecommission(t *testing.T, cluster *Cluster, nodeId metapb.NodeID) *DecommissionProgress {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		progress, err := cluster.GetDecommission(nodeId)
+		assert.Nil(t, err)
+		if progress.State != DECOMMISSION_RUNNING {
+			return progress
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("decommission of ps[%v] does not end: %+v", nodeId, progress)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestDecommission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, partition, target := newDecommissionCluster(4)
+	leader, follower := partition.Replicas[0], partition.Replicas[1]
+	ps := cluster.PsCache.FindServerById(leader.NodeID)
+	mockPSClient, restore := mockPSRpcClient(ctrl)
+	defer restore()
+	defer mockIDGenerator()()
+	reportMatch(partition, 10, map[metapb.ReplicaID]uint64{follower.ID: 10, partition.Replicas[2].ID: 8})
+
+	gomock.InOrder(
+		// the leader moves to the follower matching the most of the log
+		mockPSClient.EXPECT().ChangeLeader(testPSAddr(follower.NodeID), partition.ID).DoAndReturn(
+			func(addr string, partitionId metapb.PartitionID) error {
+				go reportLeader(partition, follower)
+				return nil
+			}),
+		// the new replica is added to the new leader, and catches up
+		mockPSClient.EXPECT().CreatePartition(testPSAddr(target.ID), gomock.Any()).Return(nil),
+		mockPSClient.EXPECT().AddReplica(testPSAddr(follower.NodeID), partition.ID, gomock.Any(),
+			uint64(T_NEW_REPLICAID), target.ID).DoAndReturn(
+			func(addr string, partitionId metapb.PartitionID, addrs *metapb.ReplicaAddrs, replicaId metapb.ReplicaID, nodeId metapb.NodeID) error {
+				go func() {
+					time.Sleep(2 * cluster.decommissions.checkInterval)
+					reportMatch(partition, 12, map[metapb.ReplicaID]uint64{replicaId: 10})
+				}()
+				return nil
+			}),
+		// the replica of the ps leaving is removed then
+		mockPSClient.EXPECT().RemoveReplica(testPSAddr(follower.NodeID), partition.ID, gomock.Any(),
+			leader.ID, leader.NodeID).Return(nil),
+		mockPSClient.EXPECT().DeletePartition(testPSAddr(leader.NodeID), partition.ID).Return(ErrRpcInvokeFailed),
+	)
+
+	progress, err := cluster.Decommission(leader.NodeID)
+	assert.Nil(t, err)
+	assert.Equal(t, progress.State, DECOMMISSION_RUNNING, "decommission not running")
+	assert.True(t, ps.isDraining())
+	assert.False(t, ps.acceptsReplicas())
+
+	progress = waitDecommission(t, cluster, leader.NodeID)
+	assert.Equal(t, progress.State, DECOMMISSION_DONE, "decommission not done: "+progress.Error)
+	assert.Equal(t, progress.Partitions, 1, "unmatched partitions")
+	assert.Equal(t, progress.LeadersMoved, 1, "unmatched leaders moved")
+	assert.Equal(t, progress.Migrated, 1, "unmatched replicas migrated")
+	assert.Equal(t, progress.Current, metapb.PartitionID(0), "current partition left")
+	assert.Equal(t, ps.getStatus(), PS_LOGOUT, "ps not logged out")
+	assert.False(t, cluster.decommissions.isMigrating(partition.ID))
+
+	// a decommission ended can not be cancelled, a new one can start
+	assert.Equal(t, cluster.CancelDecommission(leader.NodeID), ErrDecommissionNotExists, "cancel of a decommission done")
+	_, err = cluster.Decommission(T_PSID_START + 9)
+	assert.Equal(t, err, ErrPSNotExists, "decommission of an unknown ps")
+	_, err = cluster.GetDecommission(T_PSID_START + 9)
+	assert.Equal(t, err, ErrDecommissionNotExists, "progress of an unknown decommission")
+}
+
+func TestCancelDecommission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, partition, target := newDecommissionCluster(4)
+	leader, follower := partition.Replicas[0], partition.Replicas[1]
+	ps := cluster.PsCache.FindServerById(follower.NodeID)
+	mockPSClient, restore := mockPSRpcClient(ctrl)
+	defer restore()
+	defer mockIDGenerator()()
+	assert.Equal(t, cluster.CancelDecommission(follower.NodeID), ErrDecommissionNotExists, "cancel of no decommission")
+
+	// the new replica never catches up
+	added := make(chan struct{})
+	mockPSClient.EXPECT().CreatePartition(testPSAddr(target.ID), gomock.Any()).Return(nil)
+	mockPSClient.EXPECT().AddReplica(testPSAddr(leader.NodeID), partition.ID, gomock.Any(),
+		uint64(T_NEW_REPLICAID), target.ID).DoAndReturn(
+		func(addr string, partitionId metapb.PartitionID, addrs *metapb.ReplicaAddrs, replicaId metapb.ReplicaID, nodeId metapb.NodeID) error {
+			close(added)
+			return nil
+		})
+
+	_, err := cluster.Decommission(follower.NodeID)
+	assert.Nil(t, err)
+	<-added
+	progress, err := cluster.GetDecommission(follower.NodeID)
+	assert.Nil(t, err)
+	assert.Equal(t, progress.Current, partition.ID, "unmatched current partition")
+	assert.Equal(t, progress.LeadersMoved, 0, "unmatched leaders moved")
+	assert.True(t, cluster.decommissions.isMigrating(partition.ID))
+	_, err = cluster.Decommission(follower.NodeID)
+	assert.Equal(t, err, ErrDecommissionExists, "decommission started twice")
+
+	assert.Nil(t, cluster.CancelDecommission(follower.NodeID))
+	progress = waitDecommission(t, cluster, follower.NodeID)
+	assert.Equal(t, progress.State, DECOMMISSION_CANCELLED, "decommission not cancelled")
+	assert.Equal(t, progress.Migrated, 0, "unmatched replicas migrated")
+	assert.False(t, ps.isDraining())
+	assert.Equal(t, ps.getStatus(), PS_INIT, "ps of a decommission cancelled logged out")
+	assert.False(t, cluster.decommissions.isMigrating(partition.ID))
+}
+
+func TestDecommissionFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, partition, target := newDecommissionCluster(4)
+	follower := partition.Replicas[1]
+	ps := cluster.PsCache.FindServerById(follower.NodeID)
+	mockPSClient, restore := mockPSRpcClient(ctrl)
+	defer restore()
+	defer mockIDGenerator()()
+
+	mockPSClient.EXPECT().CreatePartition(testPSAddr(target.ID), gomock.Any()).Return(ErrRpcInvokeFailed)
+	_, err := cluster.Decommission(follower.NodeID)
+	assert.Nil(t, err)
+	progress := waitDecommission(t, cluster, follower.NodeID)
+	assert.Equal(t, progress.State, DECOMMISSION_FAILED, "decommission not failed")
+	assert.Equal(t, progress.Error, ErrRpcInvokeFailed.Error(), "unmatched error")
+	assert.False(t, ps.isDraining())
+	assert.Equal(t, ps.getStatus(), PS_INIT, "ps of a decommission failed logged out")
+}
+
+func TestMigrateReplicaFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster, partition, target := newDecommissionCluster(3)
+	leader, follower := partition.Replicas[0], partition.Replicas[1]
+	ps := cluster.PsCache.FindServerById(follower.NodeID)
+	mockPSClient, restore := mockPSRpcClient(ctrl)
+	defer restore()
+	defer mockIDGenerator()()
+	cluster.decommissions.catchUpTimeout = 100 * time.Millisecond
+	ctx := context.Background()
+
+	// the ps leaving hosts no replica
+	assert.Nil(t, cluster.migrateReplica(ctx, partition, target))
+
+	// the new replica does not catch up in time
+	reportMatch(partition, 10, nil)
+	mockPSClient.EXPECT().CreatePartition(testPSAddr(target.ID), gomock.Any()).Return(nil)
+	mockPSClient.EXPECT().AddReplica(testPSAddr(leader.NodeID), partition.ID, gomock.Any(),
+		uint64(T_NEW_REPLICAID), target.ID).DoAndReturn(
+		func(addr string, partitionId metapb.PartitionID, addrs *metapb.ReplicaAddrs, replicaId metapb.ReplicaID, nodeId metapb.NodeID) error {
+			reportMatch(partition, 10, map[metapb.ReplicaID]uint64{replicaId: 9})
+			return nil
+		})
+	assert.Equal(t, cluster.migrateReplica(ctx, partition, ps), ErrCatchUpTimeout, "catch up not timed out")
+	assert.False(t, cluster.decommissions.isMigrating(partition.ID))
+
+	// the leader fails to add the replica, or to remove the one leaving
+	mockPSClient.EXPECT().CreatePartition(testPSAddr(target.ID), gomock.Any()).Return(nil)
+	mockPSClient.EXPECT().AddReplica(testPSAddr(leader.NodeID), partition.ID, gomock.Any(),
+		uint64(T_NEW_REPLICAID+1), target.ID).Return(ErrRpcInvokeFailed)
+	assert.Equal(t, cluster.migrateReplica(ctx, partition, ps), ErrRpcInvokeFailed, "add replica not failed")
+	mockPSClient.EXPECT().CreatePartition(testPSAddr(target.ID), gomock.Any()).Return(nil)
+	mockPSClient.EXPECT().AddReplica(testPSAddr(leader.NodeID), partition.ID, gomock.Any(),
+		uint64(T_NEW_REPLICAID+2), target.ID).DoAndReturn(
+		func(addr string, partitionId metapb.PartitionID, addrs *metapb.ReplicaAddrs, replicaId metapb.ReplicaID, nodeId metapb.NodeID) error {
+			reportMatch(partition, 10, map[metapb.ReplicaID]uint64{replicaId: 10})
+			return nil
+		})
+	mockPSClient.EXPECT().RemoveReplica(testPSAddr(leader.NodeID), partition.ID, gomock.Any(),
+		follower.ID, follower.NodeID).Return(ErrRpcInvokeFailed)
+	assert.Equal(t, cluster.migrateReplica(ctx, partition, ps), ErrRpcInvokeFailed, "remove replica not failed")
+
+	// no other ps takes the replica, or no leader to add it to
+	target.setDraining(true)
+	assert.Equal(t, cluster.migrateReplica(ctx, partition, ps), ErrNoPSForReplica, "replica migrated to no ps")
+	partition.Leader = nil
+	assert.Equal(t, cluster.migrateReplica(ctx, partition, ps), ErrPartitionNoLeader, "replica migrated without leader")
+}
+
+func TestWaitCatchUp(t *testing.T) {
+	cluster, partition, _ := newDecommissionCluster(3)
+	follower := partition.Replicas[1]
+	cluster.decommissions.catchUpTimeout = 100 * time.Millisecond
+
+	reportMatch(partition, 10, map[metapb.ReplicaID]uint64{follower.ID: 10})
+	assert.Nil(t, cluster.waitCatchUp(context.Background(), partition, follower.ID, 10))
+	// a replica the leader does not report never catches up
+	assert.Equal(t, cluster.waitCatchUp(context.Background(), partition, T_NEW_REPLICAID, 0), ErrCatchUpTimeout,
+		"unknown replica caught up")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, cluster.waitCatchUp(ctx, partition, follower.ID, 11), context.Canceled, "wait not canceled")
+}
@@ -0,0 +1,84 @@
+package zm
+
+import (
+	"context"
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+// the dictionaries are managed by the global master, the zone master pushes the versions of them
+// to the partition servers by the heartbeats
+func (c *Cluster) recoveryDicts() error {
+	err, currentDictTopos, dictChannel, cancel := c.topoServer.WatchDicts(c.masterCtx)
+	if err != nil {
+		log.Error("WatchDicts err[%v]", err)
+		return err
+	}
+	c.cancelDictWatch = cancel
+	for _, dict := range currentDictTopos {
+		c.dicts.Store(dict.Name, dict.Dictionary)
+	}
+
+	go func() {
+		for dict := range dictChannel {
+			if dict.Err != nil {
+				if dict.Err == topo.ErrNoNode {
+					c.dicts.Delete(dict.Name)
+					continue
+				}
+				log.Error("watch err[%v]", dict.Err)
+				return
+			}
+			log.Debug("watched dict[%v] version[%v]", dict.Name, dict.Dictionary.Version)
+			c.dicts.Store(dict.Name, dict.Dictionary)
+		}
+	}()
+
+	return nil
+}
+
+// newerDicts returns the dictionaries newer than the versions of the ps.
+func (c *Cluster) newerDicts(versions map[string]uint64) []metapb.Dictionary {
+	var dicts []metapb.Dictionary
+	c.dicts.Range(func(key, value interface{}) bool {
+		dict := value.(*metapb.Dictionary)
+		if version, ok := versions[dict.Name]; !ok || version < dict.Version {
+			dicts = append(dicts, *dict)
+		}
+		return true
+	})
+	return dicts
+}
+
+// updateDicts records the versions of the dictionaries the partition indexes with, the leader
+// reported, for the global master to tell the partitions of a version.
+func (p *Partition) updateDicts(cluster *Cluster, info *masterpb.PartitionInfo) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	if dictsEqual(p.Dicts, info.Dicts) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), TOPO_TIMEOUT)
+	defer cancel()
+	if err := cluster.topoServer.SetPartitionInfoByZone(ctx, cluster.config.ClusterCfg.ZoneID, info); err != nil {
+		log.Error("fail to set the info of partition[%v]. err[%v]", info.ID, err)
+		return
+	}
+	p.Dicts = info.Dicts
+}
+
+func dictsEqual(a, b map[string]uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, version := range a {
+		if v, ok := b[name]; !ok || v != version {
+			return false
+		}
+	}
+	return true
+}
@@ -26,12 +26,22 @@ var (
 	ErrUnknownRaftCmdType = errors.New("unknown raft command type")
 	ErrRouteNotFound      = errors.New("route not found")
 
+	ErrLeaderChangeTimeout   = errors.New("leader change timeout")
+	ErrPartitionNoLeader     = errors.New("partition has no leader")
+	ErrNoPSForReplica        = errors.New("no partition server for the replica")
+	ErrCatchUpTimeout        = errors.New("replica catch up timeout")
+	ErrDecommissionExists    = errors.New("partition server is being decommissioned")
+	ErrDecommissionNotExists = errors.New("partition server is not being decommissioned")
+	ErrChecksumTimeout       = errors.New("replica checksum timeout")
+	ErrPartitionNotExists    = errors.New("partition not exists")
+
 	ErrRpcGetClientFailed  = errors.New("get rpc client handle is failed")
 	ErrRpcInvalidResp      = errors.New("invalid rpc response")
 	ErrRpcInvokeFailed     = errors.New("invoke rpc is failed")
 	ErrRpcParamError       = errors.New("rpc param error")
 	ErrRpcEmptyFollowers   = errors.New("reported empty followers")
 	ErrRpcNoFollowerLeader = errors.New("Follower leader not found")
+	ErrRpcWatcherBehind    = errors.New("route watcher falls behind")
 
 	ErrRaftNotRegHandler          = errors.New("have no register raft handler")
 	ErrRaftInvalidNode            = errors.New("invalid raft node")
@@ -63,6 +73,9 @@ const (
 
 	ERRCODE_GENID_FAILED
 	ERRCODE_LOCALDB_OPTFAILED
+	ERRCODE_DECOMMISSION_EXISTS
+	ERRCODE_DECOMMISSION_NOTEXISTS
+	ERRCODE_PARTITION_NOTEXISTS
 
 //	ERRCODE_UNKNOWN_RAFTCMDTYPE
 )
@@ -82,8 +95,11 @@ var Err2CodeMap = map[error]int32{
 	ErrSpaceNotExists: ERRCODE_SPACE_NOTEXISTS,
 	ErrPSNotExists:    ERRCODE_PS_NOTEXISTS,
 
-	ErrGenIdFailed:      ERRCODE_GENID_FAILED,
-	ErrLocalDbOpsFailed: ERRCODE_LOCALDB_OPTFAILED,
+	ErrGenIdFailed:           ERRCODE_GENID_FAILED,
+	ErrLocalDbOpsFailed:      ERRCODE_LOCALDB_OPTFAILED,
+	ErrDecommissionExists:    ERRCODE_DECOMMISSION_EXISTS,
+	ErrDecommissionNotExists: ERRCODE_DECOMMISSION_NOTEXISTS,
+	ErrPartitionNotExists:    ERRCODE_PARTITION_NOTEXISTS,
 }
 
 var Err2RpcCodeMap = map[error]metapb.RespCode{
@@ -30,7 +30,18 @@ type Partition struct {
 	taskTimeout time.Time
 
 	LastHeartbeat time.Time `json:"last_heartbeat"`
-	propertyLock  sync.RWMutex
+	// the raft status the leader reported last
+	raftStatus *masterpb.RaftStatus
+	// the versions of the dictionaries the partition indexes with by name
+	Dicts        map[string]uint64 `json:"dicts"`
+	propertyLock sync.RWMutex
+
+	// the last consistency check of the replicas
+	Consistency *ConsistencyResult `json:"consistency,omitempty"`
+
+	// the statistics the leader reported last, and when they were written to the topo
+	Statistics      masterpb.PartitionStats `json:"statistics"`
+	statsReportTime time.Time
 }
 
 func NewPartitionByMeta(metaPartition *topo.PartitionTopo) *Partition {
@@ -141,6 +152,58 @@ func (p *Partition) getAllReplicas() []*metapb.Replica {
 	return replicas
 }
 
+// getReplicas returns a copy of the replicas of the partition.
+func (p *Partition) getReplicas() []metapb.Replica {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	replicas := make([]metapb.Replica, len(p.Replicas))
+	copy(replicas, p.Replicas)
+	return replicas
+}
+
+func (p *Partition) setConsistency(result *ConsistencyResult) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	p.Consistency = result
+}
+
+func (p *Partition) updateRaftStatus(raftStatus *masterpb.RaftStatus) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	p.raftStatus = raftStatus
+}
+
+// leaderCommit returns the commit index the leader reported last.
+func (p *Partition) leaderCommit() uint64 {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	if p.raftStatus == nil {
+		return 0
+	}
+	return p.raftStatus.Commit
+}
+
+// followerMatch returns the index of the log of the leader the replica matches, as the leader
+// reported last, false when the leader reported no such follower.
+func (p *Partition) followerMatch(replicaId metapb.ReplicaID) (uint64, bool) {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	if p.raftStatus == nil {
+		return 0, false
+	}
+	for _, follower := range p.raftStatus.Followers {
+		if follower.ID == replicaId {
+			return follower.Match, true
+		}
+	}
+	return 0, false
+}
+
 func (p *Partition) pickLeaderNodeId() metapb.NodeID {
 	p.propertyLock.RLock()
 	defer p.propertyLock.RUnlock()
@@ -152,6 +215,13 @@ func (p *Partition) pickLeaderNodeId() metapb.NodeID {
 	}
 }
 
+func (p *Partition) getLeader() *metapb.Replica {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	return p.Leader
+}
+
 func (p *Partition) findReplicaById(replicaId metapb.ReplicaID) *metapb.Replica {
 	p.propertyLock.RLock()
 	defer p.propertyLock.RUnlock()
@@ -234,6 +304,34 @@ func (c *PartitionCache) DelPartition(ID metapb.PartitionID) {
 	delete(c.partitions, ID)
 }
 
+// FindPartitionsByNode returns the partitions with a replica on the node.
+func (c *PartitionCache) FindPartitionsByNode(nodeId metapb.NodeID) []*Partition {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	partitions := make([]*Partition, 0)
+	for _, partition := range c.partitions {
+		for _, replica := range partition.getReplicas() {
+			if replica.NodeID == nodeId {
+				partitions = append(partitions, partition)
+				break
+			}
+		}
+	}
+	return partitions
+}
+
+func (c *PartitionCache) getPartitions() []*Partition {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	partitions := make([]*Partition, 0, len(c.partitions))
+	for _, partition := range c.partitions {
+		partitions = append(partitions, partition)
+	}
+	return partitions
+}
+
 func (c *PartitionCache) GetAllPartitions() *[]Partition {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
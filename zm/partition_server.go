@@ -14,6 +14,14 @@ import (
 const (
 	DEFAULT_REPLICA_LIMIT_PER_PS = 1
 	PREFIX_PARTITION_SERVER      = "schema ps "
+
+	// the window a ps restarts in, its replicas are left alone meanwhile
+	DEFAULT_MAINTENANCE_DURATION = 10 * time.Minute
+	MAX_MAINTENANCE_DURATION     = time.Hour
+
+	// a ps without heartbeat for the timeout is offline, its replicas are moved to the others
+	PS_HEARTBEAT_TIMEOUT        = 30 * time.Second
+	PS_HEARTBEAT_CHECK_INTERVAL = 10 * time.Second
 )
 
 type PSStatus int32
@@ -25,8 +33,26 @@ const (
 	PS_OFFLINE
 	PS_TOMBSTONE
 	PS_LOGOUT
+	PS_MAINTENANCE
 )
 
+var psStatusNames = map[PSStatus]string{
+	PS_INVALID:     "invalid",
+	PS_INIT:        "init",
+	PS_REGISTERED:  "registered",
+	PS_OFFLINE:     "offline",
+	PS_TOMBSTONE:   "tombstone",
+	PS_LOGOUT:      "logout",
+	PS_MAINTENANCE: "maintenance",
+}
+
+func (s PSStatus) String() string {
+	if name, ok := psStatusNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
 type PartitionServer struct {
 	*topo.PsTopo
 	*masterpb.NodeSysStats
@@ -36,6 +62,14 @@ type PartitionServer struct {
 	lastHeartbeat  time.Time
 	partitionCache *PartitionCache
 	propertyLock   sync.RWMutex
+
+	// a draining ps is being decommissioned, it gets no new replicas
+	draining bool
+	// the end of the maintenance window of a ps in PS_MAINTENANCE, it restarts meanwhile: it is
+	// not taken offline, it gets no new replicas and the replicas of its partitions are not repaired
+	maintenanceUntil time.Time
+	// a ps over the high disk watermark holds its partitions read-only, it gets no new replicas
+	diskState masterpb.DiskState
 }
 
 func NewPartitionServer(ip string, psCfg *PsConfig) (*PartitionServer, error) {
@@ -97,11 +131,82 @@ func (p *PartitionServer) addPartition(partition *Partition) {
 	p.partitionCache.AddPartition(partition)
 }
 
+// updateHb records the heartbeat, an offline ps is back.
 func (p *PartitionServer) updateHb() {
 	p.propertyLock.Lock()
 	defer p.propertyLock.Unlock()
 
 	p.lastHeartbeat = time.Now()
+	if p.status == PS_OFFLINE {
+		p.status = PS_REGISTERED
+		log.Info("ps[%v] is back online", p.ID)
+	}
+}
+
+// checkHeartbeat takes the ps offline when it sent no heartbeat for the timeout since the later
+// of its last heartbeat and since, it returns whether the ps is offline. A ps in maintenance is
+// left alone until its window ends.
+func (p *PartitionServer) checkHeartbeat(since time.Time, timeout time.Duration) bool {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	now := time.Now()
+	if p.status == PS_MAINTENANCE {
+		if now.Before(p.maintenanceUntil) {
+			return false
+		}
+		p.status, p.maintenanceUntil = PS_REGISTERED, time.Time{}
+		log.Info("the maintenance of ps[%v] is over", p.ID)
+	}
+	if p.lastHeartbeat.After(since) {
+		since = p.lastHeartbeat
+	}
+	if (p.status == PS_INIT || p.status == PS_REGISTERED) && now.Sub(since) > timeout {
+		p.status = PS_OFFLINE
+		log.Warn("ps[%v] is offline, no heartbeat since %v", p.ID, p.lastHeartbeat)
+	}
+	return p.status == PS_OFFLINE
+}
+
+// updateSysStats records the system stats the ps reported on its heartbeat.
+func (p *PartitionServer) updateSysStats(stats masterpb.NodeSysStats) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	p.NodeSysStats = &stats
+}
+
+// PSState is what the heartbeats of a ps tell about it, the admin console sums the states up into
+// the status of the cluster.
+type PSState struct {
+	ID               metapb.NodeID          `json:"id"`
+	Ip               string                 `json:"ip"`
+	Zone             string                 `json:"zone"`
+	Status           string                 `json:"status"`
+	LastHeartbeat    time.Time              `json:"last_heartbeat"`
+	DiskState        string                 `json:"disk_state"`
+	Draining         bool                   `json:"draining"`
+	MaintenanceUntil time.Time              `json:"maintenance_until"`
+	Replicas         int                    `json:"replicas"`
+	Leaders          int                    `json:"leaders"`
+	SysStats         *masterpb.NodeSysStats `json:"sys_stats"`
+}
+
+func (p *PartitionServer) getState() *PSState {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	return &PSState{
+		ID:               p.ID,
+		Ip:               p.Ip,
+		Zone:             p.Zone,
+		Status:           p.status.String(),
+		LastHeartbeat:    p.lastHeartbeat,
+		DiskState:        p.diskState.String(),
+		Draining:         p.draining,
+		MaintenanceUntil: p.maintenanceUntil,
+		SysStats:         p.NodeSysStats,
+	}
 }
 
 func (p *PartitionServer) changeStatus(newStatus PSStatus) {
@@ -116,6 +221,14 @@ func (p *PartitionServer) changeStatus(newStatus PSStatus) {
 		if oldStatus != PS_INIT {
 			isConfusing = true
 		}
+	case PS_REGISTERED:
+		if oldStatus == PS_MAINTENANCE {
+			// the restart of the maintenance, the window goes on
+			return
+		}
+		if oldStatus == PS_LOGOUT {
+			isConfusing = true
+		}
 	case PS_OFFLINE:
 	case PS_LOGOUT:
 	default:
@@ -130,6 +243,83 @@ func (p *PartitionServer) changeStatus(newStatus PSStatus) {
 	}
 }
 
+func (p *PartitionServer) getStatus() PSStatus {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	return p.status
+}
+
+func (p *PartitionServer) setDraining(draining bool) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	p.draining = draining
+}
+
+func (p *PartitionServer) isDraining() bool {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	return p.draining
+}
+
+// setMaintenance puts the ps in PS_MAINTENANCE until the time, a ps decommissioned stays logged out.
+func (p *PartitionServer) setMaintenance(until time.Time) error {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	if p.status == PS_LOGOUT || p.status == PS_TOMBSTONE {
+		return ErrPSNotExists
+	}
+	p.status, p.maintenanceUntil = PS_MAINTENANCE, until
+	return nil
+}
+
+// endMaintenance takes the ps out of PS_MAINTENANCE, the heartbeats tell again whether it is online.
+func (p *PartitionServer) endMaintenance() {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	if p.status == PS_MAINTENANCE {
+		p.status, p.maintenanceUntil = PS_REGISTERED, time.Time{}
+	}
+}
+
+func (p *PartitionServer) inMaintenance() bool {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	return p.status == PS_MAINTENANCE && time.Now().Before(p.maintenanceUntil)
+}
+
+// updateDiskState records the disk state the ps reported on its heartbeat.
+func (p *PartitionServer) updateDiskState(state masterpb.DiskState) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	if p.diskState != state {
+		log.Warn("the disk state of ps[%v] changed from %v to %v", p.ID, p.diskState, state)
+		p.diskState = state
+	}
+}
+
+func (p *PartitionServer) getDiskState() masterpb.DiskState {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	return p.diskState
+}
+
+// acceptsReplicas reports whether new replicas can be placed on the ps.
+func (p *PartitionServer) acceptsReplicas() bool {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	return !p.draining && p.status != PS_LOGOUT && p.status != PS_OFFLINE && p.status != PS_MAINTENANCE &&
+		p.diskState == masterpb.DiskState_DiskNormal
+}
+
 func (p *PartitionServer) getRpcAddr() string {
 	p.propertyLock.RLock()
 	defer p.propertyLock.RUnlock()
@@ -0,0 +1,60 @@
+package zm
+
+import (
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util/assert"
+	"testing"
+	"time"
+)
+
+func newTestPS() *PartitionServer {
+	return NewPartitionServerByMeta(&PsConfig{AdminPort: T_ADMIN_PORT},
+		&topo.PsTopo{Node: &metapb.Node{ID: T_PSID_START, Ip: testPSIp(T_PSID_START)}})
+}
+
+func TestCheckHeartbeat(t *testing.T) {
+	ps := newTestPS()
+	ps.status = PS_REGISTERED
+	timeout := 50 * time.Millisecond
+
+	assert.False(t, ps.checkHeartbeat(time.Time{}, timeout))
+	ps.lastHeartbeat = time.Now().Add(-time.Second)
+	// the master leading for less than the timeout waits for the heartbeats
+	assert.False(t, ps.checkHeartbeat(time.Now(), timeout))
+	assert.True(t, ps.checkHeartbeat(time.Time{}, timeout))
+	assert.Equal(t, ps.getStatus(), PS_OFFLINE, "ps without heartbeat not offline")
+	assert.False(t, ps.acceptsReplicas())
+
+	ps.updateHb()
+	assert.Equal(t, ps.getStatus(), PS_REGISTERED, "ps with a heartbeat not back")
+	assert.False(t, ps.checkHeartbeat(time.Time{}, timeout))
+}
+
+func TestMaintenanceState(t *testing.T) {
+	ps := newTestPS()
+	ps.status = PS_REGISTERED
+	ps.lastHeartbeat = time.Now().Add(-time.Second)
+	timeout := 50 * time.Millisecond
+
+	// the ps restarting in maintenance is not taken offline
+	assert.Nil(t, ps.setMaintenance(time.Now().Add(time.Hour)))
+	assert.False(t, ps.checkHeartbeat(time.Time{}, timeout))
+	assert.Equal(t, ps.getStatus(), PS_MAINTENANCE, "ps in maintenance left it")
+	assert.True(t, ps.inMaintenance())
+	assert.False(t, ps.acceptsReplicas())
+	ps.changeStatus(PS_REGISTERED)
+	assert.Equal(t, ps.getStatus(), PS_MAINTENANCE, "ps registered again left the maintenance")
+
+	// the window over, the ps without heartbeat goes offline
+	ps.maintenanceUntil = time.Now().Add(-time.Millisecond)
+	assert.True(t, ps.checkHeartbeat(time.Time{}, timeout))
+	assert.False(t, ps.inMaintenance())
+
+	assert.Nil(t, ps.setMaintenance(time.Now().Add(time.Hour)))
+	ps.endMaintenance()
+	assert.Equal(t, ps.getStatus(), PS_REGISTERED, "ps out of maintenance not registered")
+
+	ps.changeStatus(PS_LOGOUT)
+	assert.Equal(t, ps.setMaintenance(time.Now().Add(time.Hour)), ErrPSNotExists, "ps logged out in maintenance")
+}
@@ -3,7 +3,6 @@ package zm
 import (
 	"context"
 	"github.com/tiglabs/baudengine/proto/metapb"
-	"github.com/tiglabs/baudengine/util/deepcopy"
 	"github.com/tiglabs/baudengine/util/log"
 	"runtime/debug"
 	"sync"
@@ -255,38 +254,7 @@ func (p *PartitionProcessor) Close() {
 }
 
 func (p *PartitionProcessor) createPartition(partitionToCreate *Partition, psToCreate *PartitionServer) {
-	leaderPS := p.cluster.PsCache.FindServerById(partitionToCreate.pickLeaderNodeId())
-	// leaderPS is nil when create first partition
-
-	replicaId, err := GetIdGeneratorSingle(nil).GenID()
-	if err != nil {
-		log.Error("fail to allocate new replica ßid. err:[%v]", err)
-		return
-	}
-	var newMetaReplica = &metapb.Replica{ID: metapb.ReplicaID(replicaId), NodeID: psToCreate.ID,
-		ReplicaAddrs: metapb.ReplicaAddrs{
-			HeartbeatAddr: psToCreate.HeartbeatAddr,
-			ReplicateAddr: psToCreate.ReplicateAddr,
-			RpcAddr:       psToCreate.RpcAddr,
-			AdminAddr:     psToCreate.AdminAddr,
-		}}
-
-	partitionCopy := deepcopy.Iface(partitionToCreate.Partition).(*metapb.Partition)
-	partitionCopy.Replicas = append(partitionCopy.Replicas, *newMetaReplica)
-	if err := GetPSRpcClientSingle(nil).CreatePartition(psToCreate.getRpcAddr(),
-		partitionCopy); err != nil {
-		log.Error("Rpc fail to create partition[%v] into ps. err:[%v]",
-			partitionToCreate.Partition, err)
-		return
-	}
-
-	if leaderPS != nil {
-		if err := GetPSRpcClientSingle(nil).AddReplica(leaderPS.getRpcAddr(), partitionToCreate.ID,
-			&psToCreate.ReplicaAddrs, newMetaReplica.ID, newMetaReplica.NodeID); err != nil {
-			log.Error("Rpc fail to add replica[%v] into leader ps. err[%v]", newMetaReplica, err)
-			return
-		}
-	}
+	p.cluster.createReplica(partitionToCreate, psToCreate)
 }
 
 func (p *PartitionProcessor) deletePartition(partitionId metapb.PartitionID, leaderNodeId metapb.NodeID,
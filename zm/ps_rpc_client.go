@@ -19,7 +19,8 @@ const (
 )
 
 var (
-	psClientSingle     *PSRpcClientImpl
+	// psClientSingle is an interface to be replaced by a mock in the tests
+	psClientSingle     PSRpcClient
 	psClientSingleLock sync.Mutex
 	psClientSingleDone uint32
 )
@@ -31,6 +32,8 @@ type PSRpcClient interface {
 		replicaId metapb.ReplicaID, replicaNodeId metapb.NodeID) error
 	RemoveReplica(addr string, partitionId metapb.PartitionID, replicaAddrs *metapb.ReplicaAddrs,
 		replicaId metapb.ReplicaID, replicaNodeId metapb.NodeID) error
+	ChangeLeader(addr string, partitionId metapb.PartitionID) error
+	Checksum(addr string, partitionId metapb.PartitionID, index uint64) (*pspb.ChecksumResponse, error)
 	Close()
 }
 
@@ -56,16 +59,17 @@ func GetPSRpcClientSingle(config *Config) PSRpcClient {
 			log.Error("config should not be nil at first time when create PSRpcClient single")
 		}
 
-		psClientSingle = new(PSRpcClientImpl)
-		psClientSingle.ctx, psClientSingle.cancel = context.WithCancel(context.Background())
+		client := new(PSRpcClientImpl)
+		client.ctx, client.cancel = context.WithCancel(context.Background())
 
 		connMgrOpt := rpc.DefaultManagerOption
-		connMgr := rpc.NewConnectionMgr(psClientSingle.ctx, &connMgrOpt)
+		connMgr := rpc.NewConnectionMgr(client.ctx, &connMgrOpt)
 		clientOpt := rpc.DefaultClientOption
 		clientOpt.ClusterID = config.ClusterCfg.ZoneID
 		clientOpt.ConnectMgr = connMgr
 		clientOpt.CreateFunc = func(cc *grpc.ClientConn) interface{} { return pspb.NewAdminGrpcClient(cc) }
-		psClientSingle.rpcClient = rpc.NewClient(1, &clientOpt)
+		client.rpcClient = rpc.NewClient(1, &clientOpt)
+		psClientSingle = client
 
 		atomic.StoreUint32(&psClientSingleDone, 1)
 
@@ -235,3 +239,65 @@ func (c *PSRpcClientImpl) RemoveReplica(addr string, partitionId metapb.Partitio
 		return ErrRpcInvokeFailed
 	}
 }
+
+func (c *PSRpcClientImpl) ChangeLeader(addr string, partitionId metapb.PartitionID) error {
+	log.Info("change leader of partition[%v] to addr[%v]", partitionId, addr)
+	client, err := c.getClient(addr)
+	if err != nil {
+		return err
+	}
+
+	req := &pspb.ChangeLeaderRequest{
+		RequestHeader: metapb.RequestHeader{},
+		PartitionID:   partitionId,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), PS_GRPC_REQUEST_TIMEOUT)
+	resp, err := client.ChangeLeader(ctx, req)
+	cancel()
+	if err != nil {
+		if status, ok := status.FromError(err); ok {
+			err = status.Err()
+		}
+		log.Error("grpc invoke is failed. err[%v]", err)
+		return ErrRpcInvokeFailed
+	}
+
+	if resp.ResponseHeader.Code == metapb.RESP_CODE_OK {
+		return nil
+	} else {
+		log.Error("grpc ChangeLeader response err[%v]", resp.ResponseHeader)
+		return ErrRpcInvokeFailed
+	}
+}
+
+// Checksum asks the leader at addr to hash the replicas of the partition when index is 0, else it
+// reads the checksum of the replica at addr at the index.
+func (c *PSRpcClientImpl) Checksum(addr string, partitionId metapb.PartitionID, index uint64) (*pspb.ChecksumResponse, error) {
+	client, err := c.getClient(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pspb.ChecksumRequest{
+		RequestHeader: metapb.RequestHeader{},
+		PartitionID:   partitionId,
+		Index:         index,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), PS_GRPC_REQUEST_TIMEOUT)
+	resp, err := client.Checksum(ctx, req)
+	cancel()
+	if err != nil {
+		if status, ok := status.FromError(err); ok {
+			err = status.Err()
+		}
+		log.Error("grpc invoke is failed. err[%v]", err)
+		return nil, ErrRpcInvokeFailed
+	}
+
+	if resp.ResponseHeader.Code == metapb.RESP_CODE_OK {
+		return resp, nil
+	} else {
+		log.Error("grpc Checksum response err[%v]", resp.ResponseHeader)
+		return nil, ErrRpcInvokeFailed
+	}
+}
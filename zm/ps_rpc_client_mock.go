@@ -7,6 +7,7 @@ package zm
 import (
 	gomock "github.com/golang/mock/gomock"
 	metapb "github.com/tiglabs/baudengine/proto/metapb"
+	pspb "github.com/tiglabs/baudengine/proto/pspb"
 	reflect "reflect"
 )
 
@@ -45,6 +46,31 @@ func (mr *MockPSRpcClientMockRecorder) AddReplica(arg0, arg1, arg2, arg3, arg4 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplica", reflect.TypeOf((*MockPSRpcClient)(nil).AddReplica), arg0, arg1, arg2, arg3, arg4)
 }
 
+// ChangeLeader mocks base method
+func (m *MockPSRpcClient) ChangeLeader(arg0 string, arg1 uint64) error {
+	ret := m.ctrl.Call(m, "ChangeLeader", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeLeader indicates an expected call of ChangeLeader
+func (mr *MockPSRpcClientMockRecorder) ChangeLeader(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeLeader", reflect.TypeOf((*MockPSRpcClient)(nil).ChangeLeader), arg0, arg1)
+}
+
+// Checksum mocks base method
+func (m *MockPSRpcClient) Checksum(arg0 string, arg1 metapb.PartitionID, arg2 uint64) (*pspb.ChecksumResponse, error) {
+	ret := m.ctrl.Call(m, "Checksum", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*pspb.ChecksumResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checksum indicates an expected call of Checksum
+func (mr *MockPSRpcClientMockRecorder) Checksum(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checksum", reflect.TypeOf((*MockPSRpcClient)(nil).Checksum), arg0, arg1, arg2)
+}
+
 // Close mocks base method
 func (m *MockPSRpcClient) Close() {
 	m.ctrl.Call(m, "Close")
@@ -0,0 +1,51 @@
+package zm
+
+import (
+	"context"
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+	"time"
+)
+
+const (
+	// how often the statistics of a partition are written to the topo, the global master sums them
+	// up into the usage of the spaces against their quotas
+	PARTITION_STATS_REPORT_INTERVAL = time.Minute
+)
+
+// updateStatistics records the statistics the leader reported, they reach the topo at most once in
+// PARTITION_STATS_REPORT_INTERVAL.
+func (p *Partition) updateStatistics(cluster *Cluster, info *masterpb.PartitionInfo) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	p.Statistics = info.Statistics
+	if time.Since(p.statsReportTime) < PARTITION_STATS_REPORT_INTERVAL {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), TOPO_TIMEOUT)
+	defer cancel()
+	if err := cluster.topoServer.SetPartitionInfoByZone(ctx, cluster.config.ClusterCfg.ZoneID, info); err != nil {
+		log.Error("fail to set the info of partition[%v]. err[%v]", info.ID, err)
+		return
+	}
+	p.statsReportTime = time.Now()
+}
+
+// quotaExceededSpaces returns the spaces the global master marked over their quota, the partition
+// servers reject the writes adding data to them.
+func (c *Cluster) quotaExceededSpaces() []metapb.SpaceID {
+	var spaces []metapb.SpaceID
+	for _, db := range c.DbCache.GetAllDBs() {
+		for _, space := range db.SpaceCache.GetAllSpaces() {
+			space.propertyLock.RLock()
+			if space.QuotaExceeded {
+				spaces = append(spaces, space.ID)
+			}
+			space.propertyLock.RUnlock()
+		}
+	}
+	return spaces
+}
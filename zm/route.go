@@ -0,0 +1,106 @@
+package zm
+
+import (
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+	"sync"
+)
+
+const (
+	// the route events buffered for a watcher, a watcher falling behind more is closed and
+	// resyncs by GetRoute
+	ROUTE_WATCHER_BUFFER_SIZE = 256
+)
+
+type RouteWatcher struct {
+	db     metapb.DBID
+	space  metapb.SpaceID
+	events chan masterpb.RouteEvent
+	// closed when the watcher fell behind
+	overflow chan struct{}
+	once     sync.Once
+}
+
+// RouteWatchers fans the route changes of the partitions out to the routers watching the spaces.
+type RouteWatchers struct {
+	lock     sync.RWMutex
+	watchers map[*RouteWatcher]struct{}
+}
+
+func NewRouteWatchers() *RouteWatchers {
+	return &RouteWatchers{
+		watchers: make(map[*RouteWatcher]struct{}),
+	}
+}
+
+func (w *RouteWatchers) Watch(db metapb.DBID, space metapb.SpaceID) *RouteWatcher {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	watcher := &RouteWatcher{
+		db:       db,
+		space:    space,
+		events:   make(chan masterpb.RouteEvent, ROUTE_WATCHER_BUFFER_SIZE),
+		overflow: make(chan struct{}),
+	}
+	w.watchers[watcher] = struct{}{}
+	return watcher
+}
+
+func (w *RouteWatchers) Unwatch(watcher *RouteWatcher) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	delete(w.watchers, watcher)
+}
+
+// Notify sends the event to the watchers of the space of the partition without blocking.
+func (w *RouteWatchers) Notify(event masterpb.RouteEvent) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+
+	for watcher := range w.watchers {
+		if watcher.db != event.Route.DB || watcher.space != event.Route.Space {
+			continue
+		}
+		select {
+		case watcher.events <- event:
+		default:
+			log.Warn("route watcher of db[%v] space[%v] falls behind, close it", watcher.db, watcher.space)
+			watcher.once.Do(func() { close(watcher.overflow) })
+		}
+	}
+}
+
+// makeRoute returns the route of the partition with the partition servers of its replicas.
+func (c *Cluster) makeRoute(partition *Partition) masterpb.Route {
+	partition.propertyLock.RLock()
+	defer partition.propertyLock.RUnlock()
+
+	route := masterpb.Route{
+		Partition: *partition.Partition,
+	}
+	if partition.Leader != nil {
+		route.Leader = partition.Leader.NodeID
+	}
+	if len(partition.Replicas) != 0 {
+		nodes := make([]*metapb.Node, 0, len(partition.Replicas))
+		for _, replica := range partition.Replicas {
+			ps := c.PsCache.FindServerById(replica.NodeID)
+			if ps != nil {
+				nodes = append(nodes, ps.Node)
+			}
+		}
+		route.Nodes = nodes
+	}
+	return route
+}
+
+func (c *Cluster) notifyRouteUpdate(partition *Partition) {
+	c.routeWatchers.Notify(masterpb.RouteEvent{Type: masterpb.RouteEventType_RouteUpdate, Route: c.makeRoute(partition)})
+}
+
+func (c *Cluster) notifyRouteDelete(partition *Partition) {
+	c.routeWatchers.Notify(masterpb.RouteEvent{Type: masterpb.RouteEventType_RouteDelete, Route: c.makeRoute(partition)})
+}
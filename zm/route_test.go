@@ -0,0 +1,170 @@
+package zm
+
+import (
+	"context"
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util/assert"
+	"google.golang.org/grpc"
+	"testing"
+	"time"
+)
+
+// routeStream is the stream of a router watching the routes, the responses sent are received
+// from resps.
+type routeStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	resps chan *masterpb.WatchRoutesResponse
+}
+
+func (s *routeStream) Send(resp *masterpb.WatchRoutesResponse) error {
+	select {
+	case s.resps <- resp:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *routeStream) Context() context.Context {
+	return s.ctx
+}
+
+// watchRoutes watches the routes of the space in the background, the error the watch ends with
+// is sent to the returned channel.
+func watchRoutes(rpcServer *RpcServer, ctx context.Context, spaceId metapb.SpaceID) (*routeStream, chan error) {
+	stream := &routeStream{ctx: ctx, resps: make(chan *masterpb.WatchRoutesResponse)}
+	done := make(chan error, 1)
+	go func() {
+		done <- rpcServer.WatchRoutes(&masterpb.WatchRoutesRequest{DB: T_DBID, Space: spaceId}, stream)
+	}()
+	return stream, done
+}
+
+func (s *routeStream) recv(t *testing.T) *masterpb.WatchRoutesResponse {
+	select {
+	case resp := <-s.resps:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("no route response")
+		return nil
+	}
+}
+
+func waitWatchDone(t *testing.T, done chan error) error {
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("the watch does not end")
+		return nil
+	}
+}
+
+// newRouteCluster returns a cluster with the partition in the space of a db.
+func newRouteCluster() (*Cluster, *Partition) {
+	cluster, partition := newTestCluster(2)
+	db := NewDBByMeta(cluster, &topo.DBTopo{DB: &metapb.DB{ID: T_DBID, Name: T_DB1}})
+	db.SpaceCache.AddSpace(NewSpaceByMeta(&topo.SpaceTopo{Space: &metapb.Space{ID: 1, DB: T_DBID, Name: T_SPACE1}}))
+	cluster.DbCache.AddDb(db)
+	partition.DB, partition.Space = T_DBID, 1
+	return cluster, partition
+}
+
+func TestWatchRoutes(t *testing.T) {
+	cluster, partition := newRouteCluster()
+	rpcServer := &RpcServer{cluster: cluster}
+	MineIsLeader = true
+	defer func() { MineIsLeader = false }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, done := watchRoutes(rpcServer, ctx, 1)
+	assert.Equal(t, stream.recv(t).Code, metapb.RESP_CODE_OK, "watch not confirmed")
+
+	// the changes of the space reach the watcher, the ones of other spaces do not
+	other := NewPartitionByMeta(&topo.PartitionTopo{Partition: &metapb.Partition{ID: T_PARTITIONID_START + 1, DB: T_DBID, Space: 2}})
+	cluster.notifyRouteUpdate(other)
+	cluster.notifyRouteUpdate(partition)
+	resp := stream.recv(t)
+	assert.Equal(t, len(resp.Events), 1, "unmatched events")
+	event := resp.Events[0]
+	assert.Equal(t, event.Type, masterpb.RouteEventType_RouteUpdate, "unmatched event type")
+	assert.Equal(t, event.Route.ID, partition.ID, "unmatched partition")
+	assert.Equal(t, event.Route.Leader, partition.Leader.NodeID, "unmatched leader")
+	assert.Equal(t, len(event.Route.Nodes), 2, "unmatched nodes")
+	assert.Equal(t, event.Route.Nodes[1].Ip, testPSIp(partition.Replicas[1].NodeID), "unmatched node")
+
+	cluster.notifyRouteDelete(partition)
+	resp = stream.recv(t)
+	assert.Equal(t, len(resp.Events), 1, "unmatched events")
+	assert.Equal(t, resp.Events[0].Type, masterpb.RouteEventType_RouteDelete, "unmatched event type")
+
+	// the router goes away
+	cancel()
+	assert.Equal(t, waitWatchDone(t, done), context.Canceled, "watch not canceled")
+	cluster.routeWatchers.lock.RLock()
+	assert.Equal(t, len(cluster.routeWatchers.watchers), 0, "watcher left")
+	cluster.routeWatchers.lock.RUnlock()
+}
+
+func TestWatchRoutesBehind(t *testing.T) {
+	cluster, partition := newRouteCluster()
+	rpcServer := &RpcServer{cluster: cluster}
+	MineIsLeader = true
+	defer func() { MineIsLeader = false }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, done := watchRoutes(rpcServer, ctx, 1)
+	assert.Equal(t, stream.recv(t).Code, metapb.RESP_CODE_OK, "watch not confirmed")
+
+	// the router reads nothing while more changes than buffered happen, one is held by the send
+	for i := 0; i < ROUTE_WATCHER_BUFFER_SIZE+2; i++ {
+		cluster.notifyRouteUpdate(partition)
+	}
+	go func() {
+		for {
+			select {
+			case <-stream.resps:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	assert.Equal(t, waitWatchDone(t, done), ErrRpcWatcherBehind, "watcher behind not closed")
+}
+
+func TestWatchRoutesRejected(t *testing.T) {
+	cluster, _ := newRouteCluster()
+	rpcServer := &RpcServer{cluster: cluster}
+
+	watch := func(spaceId metapb.SpaceID) metapb.RespCode {
+		stream, done := watchRoutes(rpcServer, context.Background(), spaceId)
+		resp := stream.recv(t)
+		assert.Nil(t, waitWatchDone(t, done))
+		return resp.Code
+	}
+	assert.Equal(t, watch(1), metapb.MASTER_RESP_CODE_NOT_LEADER, "watch on a follower master")
+
+	MineIsLeader = true
+	defer func() { MineIsLeader = false }()
+	assert.NotEqual(t, watch(2), metapb.RESP_CODE_OK, "watch of an unknown space")
+	delete(cluster.DbCache.dbs, T_DBID)
+	assert.Equal(t, watch(1), metapb.MASTER_RESP_CODE_DB_NOTEXISTS, "watch of an unknown db")
+}
+
+func TestWatchRoutesLeaderLost(t *testing.T) {
+	cluster, _ := newRouteCluster()
+	rpcServer := &RpcServer{cluster: cluster}
+	MineIsLeader = true
+	defer func() { MineIsLeader = false }()
+
+	stream, done := watchRoutes(rpcServer, context.Background(), 1)
+	assert.Equal(t, stream.recv(t).Code, metapb.RESP_CODE_OK, "watch not confirmed")
+	// the router is told to watch on the new leader
+	MineIsLeader = false
+	assert.Equal(t, stream.recv(t).Code, metapb.MASTER_RESP_CODE_NOT_LEADER, "leader lost not sent")
+	assert.Nil(t, waitWatchDone(t, done))
+}
@@ -13,6 +13,13 @@ import (
 	"google.golang.org/grpc/reflection"
 	"net"
 	"sync"
+	"time"
+)
+
+const (
+	// the time to wait for the leader change of a partition reported by the heartbeats
+	CHANGE_LEADER_TIMEOUT        = 10 * time.Second
+	CHANGE_LEADER_CHECK_INTERVAL = 100 * time.Millisecond
 )
 
 type RpcServer struct {
@@ -211,8 +218,53 @@ func (rpcSrv *RpcServer) ChangeReplica(ctx context.Context, req *masterpb.Change
 	}, nil
 }
 
-func (rpcSrv *RpcServer) ChangeLeader(context.Context, *masterpb.ChangeLeaderRequest) (*masterpb.ChangeLeaderResponse, error) {
-	return nil, nil
+// ChangeLeader asks the partition server of the replica to be the leader of the partition, and
+// waits for the leader change reported by the heartbeats.
+func (rpcSrv *RpcServer) ChangeLeader(ctx context.Context, req *masterpb.ChangeLeaderRequest) (*masterpb.ChangeLeaderResponse, error) {
+	if !rpcSrv.validateLeader() {
+		resp := &masterpb.ChangeLeaderResponse{ResponseHeader: metapb.ResponseHeader{
+			ReqId: req.ReqId,
+			Code:  metapb.MASTER_RESP_CODE_NOT_LEADER,
+			Error: metapb.Error{NotLeader: &metapb.NotLeader{LeaderAddr: LeaderNodeId}},
+		}}
+		return resp, nil
+	}
+
+	partition := rpcSrv.cluster.PartitionCache.FindPartitionById(req.PartitionID)
+	if partition == nil {
+		log.Error("cannot find partition %d", req.PartitionID)
+		resp := &masterpb.ChangeLeaderResponse{
+			ResponseHeader: metapb.ResponseHeader{ReqId: req.ReqId, Code: metapb.RESP_CODE_SERVER_ERROR, Message: "cannot find partition!"},
+		}
+		return resp, nil
+	}
+
+	replica := partition.findReplicaById(req.Replica.ID)
+	if replica == nil {
+		log.Error("cannot find replica %d of partition %d", req.Replica.ID, req.PartitionID)
+		resp := &masterpb.ChangeLeaderResponse{
+			ResponseHeader: metapb.ResponseHeader{ReqId: req.ReqId, Code: metapb.RESP_CODE_SERVER_ERROR, Message: "cannot find replica of partition!"},
+		}
+		return resp, nil
+	}
+	if err := rpcSrv.cluster.changeLeader(ctx, partition, replica); err != nil {
+		resp := &masterpb.ChangeLeaderResponse{ResponseHeader: metapb.ResponseHeader{ReqId: req.ReqId}}
+		switch err {
+		case ErrPSNotExists:
+			resp.Code, resp.Message = metapb.MASTER_RESP_CODE_PS_NOTEXISTS, "cannot find ps of replica!"
+		case ErrLeaderChangeTimeout:
+			resp.Code, resp.Message = metapb.RESP_CODE_TIMEOUT, "timeout to wait leader changed"
+		case ctx.Err():
+			return nil, err
+		default:
+			resp.Code, resp.Message = metapb.RESP_CODE_SERVER_ERROR, "fail to change leader in ps"
+		}
+		return resp, nil
+	}
+
+	return &masterpb.ChangeLeaderResponse{
+		ResponseHeader: metapb.ResponseHeader{ReqId: req.ReqId, Code: metapb.RESP_CODE_OK},
+	}, nil
 }
 
 func (rpcSrv *RpcServer) GetRoute(ctx context.Context,
@@ -239,24 +291,7 @@ func (rpcSrv *RpcServer) GetRoute(ctx context.Context,
 
 	resp.Routes = make([]masterpb.Route, 0, len(partitions))
 	for _, partition := range partitions {
-		route := masterpb.Route{
-			Partition: *partition.Partition,
-			Leader:    partition.pickLeaderNodeId(),
-		}
-
-		replicas := partition.Replicas
-		if replicas != nil || len(replicas) != 0 {
-			nodes := make([]*metapb.Node, 0, len(replicas))
-			for _, replica := range replicas {
-				ps := rpcSrv.cluster.PsCache.FindServerById(replica.NodeID)
-				if ps != nil {
-					nodes = append(nodes, ps.Node)
-				}
-			}
-			route.Nodes = nodes
-		}
-
-		resp.Routes = append(resp.Routes, route)
+		resp.Routes = append(resp.Routes, rpcSrv.cluster.makeRoute(partition))
 	}
 	log.Debug("GetRoutes:[%v]", resp.Routes)
 	resp.ResponseHeader = *makeRpcRespHeader(ErrSuc)
@@ -264,6 +299,62 @@ func (rpcSrv *RpcServer) GetRoute(ctx context.Context,
 	return resp, nil
 }
 
+// WatchRoutes streams the route changes of the partitions of the space until the router goes
+// away or this master loses the leadership. The first response confirms the watch, and the
+// stream is closed when the router falls behind, the router resyncs by GetRoute then.
+func (rpcSrv *RpcServer) WatchRoutes(req *masterpb.WatchRoutesRequest, stream masterpb.MasterRpc_WatchRoutesServer) error {
+	if !rpcSrv.validateLeader() {
+		return stream.Send(&masterpb.WatchRoutesResponse{ResponseHeader: metapb.ResponseHeader{
+			ReqId: req.ReqId,
+			Code:  metapb.MASTER_RESP_CODE_NOT_LEADER,
+			Error: metapb.Error{NotLeader: &metapb.NotLeader{LeaderAddr: LeaderNodeId}},
+		}})
+	}
+
+	db := rpcSrv.cluster.DbCache.FindDbById(req.DB)
+	if db == nil {
+		return stream.Send(&masterpb.WatchRoutesResponse{ResponseHeader: *makeRpcRespHeader(ErrDbNotExists)})
+	}
+	if space := db.SpaceCache.FindSpaceById(req.Space); space == nil {
+		return stream.Send(&masterpb.WatchRoutesResponse{ResponseHeader: *makeRpcRespHeader(ErrSpaceNotExists)})
+	}
+
+	watcher := rpcSrv.cluster.routeWatchers.Watch(req.DB, req.Space)
+	defer rpcSrv.cluster.routeWatchers.Unwatch(watcher)
+	if err := stream.Send(&masterpb.WatchRoutesResponse{ResponseHeader: *makeRpcRespHeader(ErrSuc)}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-watcher.overflow:
+			return ErrRpcWatcherBehind
+		case <-ticker.C:
+			if !rpcSrv.validateLeader() {
+				return stream.Send(&masterpb.WatchRoutesResponse{ResponseHeader: metapb.ResponseHeader{
+					ReqId: req.ReqId,
+					Code:  metapb.MASTER_RESP_CODE_NOT_LEADER,
+					Error: metapb.Error{NotLeader: &metapb.NotLeader{LeaderAddr: LeaderNodeId}},
+				}})
+			}
+		case event := <-watcher.events:
+			resp := &masterpb.WatchRoutesResponse{ResponseHeader: *makeRpcRespHeader(ErrSuc)}
+			resp.Events = append(resp.Events, event)
+			// send the pending events together
+			for pending := len(watcher.events); pending > 0; pending-- {
+				resp.Events = append(resp.Events, <-watcher.events)
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (rpcSrv *RpcServer) GetDB(ctx context.Context, req *masterpb.GetDBRequest) (*masterpb.GetDBResponse, error) {
 	resp := new(masterpb.GetDBResponse)
 
@@ -378,6 +469,11 @@ func (rpcSrv *RpcServer) PSHeartbeat(ctx context.Context,
 		return resp, nil
 	}
 	ps.updateHb()
+	ps.updateSysStats(req.SysStats)
+	ps.updateDiskState(req.DiskState)
+	resp.Dicts = rpcSrv.cluster.newerDicts(req.Dicts)
+	resp.QuotaExceededSpaces = rpcSrv.cluster.quotaExceededSpaces()
+	resp.ReplicaDiskStates = rpcSrv.cluster.replicaDiskStates(req.Partitions)
 
 	partitionInfos := req.Partitions
 	if partitionInfos == nil {
@@ -397,6 +493,13 @@ func (rpcSrv *RpcServer) PSHeartbeat(ctx context.Context,
 			}
 			continue
 		}
+		if partitionInfo.IsLeader {
+			partitionMS.updateDicts(rpcSrv.cluster, &partitionInfo)
+			partitionMS.updateStatistics(rpcSrv.cluster, &partitionInfo)
+			if partitionInfo.RaftStatus != nil {
+				partitionMS.updateRaftStatus(partitionInfo.RaftStatus)
+			}
+		}
 
 		confVerMS := partitionMS.Epoch.ConfVersion
 		confVerHb := partitionInfo.Epoch.ConfVersion
@@ -426,6 +529,7 @@ func (rpcSrv *RpcServer) PSHeartbeat(ctx context.Context,
 					partitionInfo.ID, ok)
 				continue
 			}
+			rpcSrv.cluster.notifyRouteUpdate(partitionMS)
 
 			needToCheckingReplicasCount = true
 
@@ -436,6 +540,7 @@ func (rpcSrv *RpcServer) PSHeartbeat(ctx context.Context,
 			}
 
 			// To delete invalid replica group for the leader, because its replicaid is not exists in cluster
+			oldLeader := partitionMS.getLeader()
 			expired, illegal, ok := partitionMS.ValidateAndUpdateLeaderByCond(&partitionInfo, leaderReplicaHb)
 			if expired {
 				log.Debug("Fail to update partition[%v] info. waiting next heartbeat.", partitionInfo.ID)
@@ -459,13 +564,22 @@ func (rpcSrv *RpcServer) PSHeartbeat(ctx context.Context,
 						partitionInfo.ID, ok)
 					continue
 				}
+				rpcSrv.cluster.notifyRouteUpdate(partitionMS)
+			} else if oldLeader == nil || oldLeader.ID != leaderReplicaHb.ID {
+				rpcSrv.cluster.notifyRouteUpdate(partitionMS)
 			}
 
 			log.Debug("Updated leader of partition[%v]", partitionInfo.ID)
 			needToCheckingReplicasCount = true
 		}
 
-		if needToCheckingReplicasCount {
+		if needToCheckingReplicasCount && rpcSrv.cluster.decommissions.isMigrating(partitionId) {
+			// the decommission adds a replica and then removes the one of the partition server leaving
+			log.Info("replica of partition[%v] is moving, leave its replicas alone", partitionId)
+		} else if needToCheckingReplicasCount && rpcSrv.cluster.inMaintenance(partitionMS) {
+			// the partition server in maintenance is coming back with its replica
+			log.Info("replica of partition[%v] is in maintenance, leave its replicas alone", partitionId)
+		} else if needToCheckingReplicasCount {
 			// add or delete replica
 			replicaCount := partitionMS.countReplicas()
 			if replicaCount > FIXED_REPLICA_NUM {
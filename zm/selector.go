@@ -28,6 +28,9 @@ func (s *IdleSelector) SelectTarget(servers []*PartitionServer, partitionId meta
 		if ps.partitionCache.FindPartitionById(partitionId) != nil {
 			continue
 		}
+		if !ps.acceptsReplicas() {
+			continue
+		}
 
 		candidatePs = append(candidatePs, ps)
 	}
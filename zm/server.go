@@ -3,6 +3,7 @@ package zm
 import (
 	"context"
 	"github.com/tiglabs/baudengine/topo"
+	"github.com/tiglabs/baudengine/util"
 	"github.com/tiglabs/baudengine/util/log"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 
 var (
 	MineIsLeader = false
+	// the rpc address the leader runs for the elections with, see electionID
 	LeaderNodeId = ""
 )
 
@@ -69,7 +71,12 @@ func (zm *ZoneMaster) Start(config *Config) error {
 
 	zm.psRpcClient = GetPSRpcClientSingle(config)
 
-	zm.participation, err = zm.topoServer.NewMasterParticipation(config.ClusterCfg.ZoneID, config.ClusterCfg.CurNodeId)
+	zm.workerManager = NewWorkerManager(zm.cluster)
+	zm.workerManager.StartWorker(NewConsistencyCheckWorker(zm.cluster))
+	zm.workerManager.StartWorker(NewPSHealthWorker(zm.cluster))
+
+	myId := electionID(config)
+	zm.participation, err = zm.topoServer.NewMasterParticipation(config.ClusterCfg.ZoneID, myId)
 	if err != nil {
 		return err
 	}
@@ -79,12 +86,12 @@ func (zm *ZoneMaster) Start(config *Config) error {
 		switch err {
 		case nil:
 			MineIsLeader = true
-			LeaderNodeId = config.ClusterCfg.CurNodeId
+			LeaderNodeId = myId
 			for {
 				ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 				id, err := zm.participation.GetCurrentMasterID(ctx)
 				cancel()
-				if err != nil || id != config.ClusterCfg.CurNodeId {
+				if err != nil || id != myId {
 					MineIsLeader = false
 					LeaderNodeId = id
 					break
@@ -102,6 +109,12 @@ func (zm *ZoneMaster) Start(config *Config) error {
 	return nil
 }
 
+// electionID is the id the zone master runs for the elections with, its rpc address. The routers
+// dial the master elected, and the followers name it as the leader in their replies.
+func electionID(config *Config) string {
+	return util.BuildAddr(config.ClusterCfg.CurNode.Host, config.ClusterCfg.CurNode.RpcPort)
+}
+
 func (zm *ZoneMaster) Shutdown() {
 	if zm.apiServer != nil {
 		zm.apiServer.Close()
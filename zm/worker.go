@@ -44,6 +44,12 @@ func (wm *WorkerManager) Start() error {
 	return nil
 }
 
+// StartWorker adds the worker and runs it beside the workers Start runs.
+func (wm *WorkerManager) StartWorker(worker Worker) {
+	wm.addWorker(worker)
+	wm.runWorker(worker)
+}
+
 func (wm *WorkerManager) Shutdown() {
 	wm.cancel()
 	wm.wg.Wait()
@@ -175,3 +181,43 @@ func (w *SpaceStateTransitionWorker) run() {
 		}
 	}
 }
+
+// PSHealthWorker takes the partition servers without heartbeat offline and moves their replicas to
+// the others, the partition servers in maintenance are left alone.
+type PSHealthWorker struct {
+	cluster *Cluster
+	timeout time.Duration
+	// when this master became the leader, the heartbeats went to the former one before
+	leaderSince time.Time
+}
+
+func NewPSHealthWorker(cluster *Cluster) *PSHealthWorker {
+	return &PSHealthWorker{
+		cluster: cluster,
+		timeout: PS_HEARTBEAT_TIMEOUT,
+	}
+}
+
+func (w *PSHealthWorker) getName() string {
+	return "PS-Health-Worker"
+}
+
+func (w *PSHealthWorker) getInterval() time.Duration {
+	return PS_HEARTBEAT_CHECK_INTERVAL
+}
+
+func (w *PSHealthWorker) run() {
+	if !MineIsLeader {
+		w.leaderSince = time.Time{}
+		return
+	}
+	if w.leaderSince.IsZero() {
+		w.leaderSince = time.Now()
+	}
+
+	for _, ps := range w.cluster.PsCache.GetAllServers() {
+		if ps.checkHeartbeat(w.leaderSince, w.timeout) && !ps.isDraining() {
+			go w.cluster.repairOffline(w.cluster.masterCtx, ps)
+		}
+	}
+}